@@ -3,6 +3,8 @@ package autumn_clientv1
 import (
 	"bytes"
 	"context"
+	"crypto/rand"
+	"encoding/binary"
 	"fmt"
 	"io"
 	"sort"
@@ -10,14 +12,23 @@ import (
 
 	"github.com/journeymidnight/autumn/etcd_utils"
 	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/journeymidnight/autumn/secondary_index"
 	"github.com/journeymidnight/autumn/utils"
 	"github.com/journeymidnight/autumn/xlog"
 	"github.com/pkg/errors"
 	"go.etcd.io/etcd/api/v3/mvccpb"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
+//WithTenant attaches tenant id to ctx, ps applies the tenant's rate limits and quotas
+func WithTenant(ctx context.Context, tenant string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "tenant", tenant)
+}
+
 type AutumnLib struct {
 	etcdClient      *clientv3.Client
 	etcdAddr        []string
@@ -277,6 +288,11 @@ func (lib *AutumnLib) StreamPut(ctx context.Context, key []byte, reader io.Reade
 }
 
 func (lib *AutumnLib) Put(ctx context.Context, key, value []byte) error {
+	return lib.PutWithExpiresAt(ctx, key, value, 0)
+}
+
+//PutWithExpiresAt writes key which expires at expiresAt in unix seconds, 0 if it never expires
+func (lib *AutumnLib) PutWithExpiresAt(ctx context.Context, key, value []byte, expiresAt uint64) error {
 	if len(key) == 0 || len(value) == 0 {
 		return errors.New("key or value is empty")
 	}
@@ -297,14 +313,23 @@ func (lib *AutumnLib) Put(ctx context.Context, key, value []byte) error {
 	conn := lib.getConn(lib.getPSAddr((sortedRegions[idx].PSID)))
 	client := pspb.NewPartitionKVClient(conn)
 	_, err := client.Put(ctx, &pspb.PutRequest{
-		Key:    key,
-		Value:  value,
-		Partid: sortedRegions[idx].PartID,
+		Key:       key,
+		Value:     value,
+		ExpiresAt: expiresAt,
+		Partid:    sortedRegions[idx].PartID,
 	})
 	return err
 }
 
 func (lib *AutumnLib) Get(ctx context.Context, key []byte) ([]byte, error) {
+	res, err := lib.get(ctx, key, false, false)
+	if err != nil {
+		return nil, err
+	}
+	return res.Value, nil
+}
+
+func (lib *AutumnLib) get(ctx context.Context, key []byte, allowNotFound bool, withOrigin bool) (*pspb.GetResponse, error) {
 	sortedRegions := lib.getRegions()
 	if len(sortedRegions) == 0 {
 		return nil, errors.New("no regions to write")
@@ -319,16 +344,13 @@ func (lib *AutumnLib) Get(ctx context.Context, key []byte) ([]byte, error) {
 
 	conn := lib.getConn(lib.getPSAddr((sortedRegions[idx].PSID)))
 	client := pspb.NewPartitionKVClient(conn)
-	res, err := client.Get(ctx, &pspb.GetRequest{
-		Key:    key,
-		Partid: sortedRegions[idx].PartID,
+	return client.Get(ctx, &pspb.GetRequest{
+		Key:           key,
+		Partid:        sortedRegions[idx].PartID,
+		AllowNotFound: allowNotFound,
+		WithOrigin:    withOrigin,
 	})
 
-	if err != nil {
-		return nil, err
-	}
-	return res.Value, err
-
 }
 
 func (lib *AutumnLib) Range(ctx context.Context, prefix []byte, start []byte, limit uint32) ([][]byte, bool, error) {
@@ -376,6 +398,134 @@ func (lib *AutumnLib) Range(ctx context.Context, prefix []byte, start []byte, li
 	return results, more, nil
 }
 
+//Regions returns regions sorted by start key
+func (lib *AutumnLib) Regions() []*pspb.RegionInfo {
+	return lib.getRegions()
+}
+
+//RangePartition ranges keys in the partition of region, if sinceSeq > 0, only keys changed after
+//sinceSeq are returned, including deleted keys
+func (lib *AutumnLib) RangePartition(ctx context.Context, region *pspb.RegionInfo, prefix []byte, start []byte, limit uint32, sinceSeq uint64) (*pspb.RangeResponse, error) {
+	conn := lib.getConn(lib.getPSAddr(region.PSID))
+	client := pspb.NewPartitionKVClient(conn)
+	return client.Range(ctx, &pspb.RangeRequest{
+		Prefix:   prefix,
+		Start:    start,
+		Limit:    limit,
+		Partid:   region.PartID,
+		SinceSeq: sinceSeq,
+	})
+}
+
+//IngestTables sends table files to partition partID, they are added to the partition at once. it returns
+//the seq of ingested keys
+func (lib *AutumnLib) IngestTables(ctx context.Context, partID uint64, tables []io.Reader) (uint64, error) {
+	var region *pspb.RegionInfo
+	for _, r := range lib.getRegions() {
+		if r.PartID == partID {
+			region = r
+			break
+		}
+	}
+	if region == nil {
+		return 0, errors.Errorf("no such partition %d", partID)
+	}
+
+	conn := lib.getConn(lib.getPSAddr(region.PSID))
+	client := pspb.NewPartitionKVClient(conn)
+	stream, err := client.IngestTables(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if err = stream.Send(&pspb.IngestTablesRequest{
+		Data: &pspb.IngestTablesRequest_Header{
+			Header: &pspb.IngestTablesRequestHeader{
+				Partid:      partID,
+				NumOfTables: uint32(len(tables)),
+			},
+		},
+	}); err != nil {
+		return 0, err
+	}
+
+	buf := make([]byte, 512*1024)
+	for _, reader := range tables {
+		for {
+			n, err := reader.Read(buf)
+			if n > 0 {
+				if err := stream.Send(&pspb.IngestTablesRequest{
+					Data: &pspb.IngestTablesRequest_Payload{
+						Payload: buf[:n],
+					},
+				}); err != nil {
+					return 0, err
+				}
+			}
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				return 0, err
+			}
+		}
+	}
+	res, err := stream.CloseAndRecv()
+	if err != nil {
+		return 0, err
+	}
+	return res.Seq, nil
+}
+
+//GetIfExists sets NotFound of response instead of returning an error if key does not exist
+func (lib *AutumnLib) GetIfExists(ctx context.Context, key []byte) (*pspb.GetResponse, error) {
+	return lib.get(ctx, key, true, false)
+}
+
+//GetWithOrigin is GetIfExists, and sets origin of response if the value is written by replication
+func (lib *AutumnLib) GetWithOrigin(ctx context.Context, key []byte) (*pspb.GetResponse, error) {
+	return lib.get(ctx, key, true, true)
+}
+
+//IndexRange returns primary keys whose field of index has prefix value,
+//if exact, the field must be equal to value
+func (lib *AutumnLib) IndexRange(ctx context.Context, index string, value []byte, exact bool, limit uint32) ([]*pspb.IndexEntry, bool, error) {
+	sortedRegions := lib.getRegions()
+	if len(sortedRegions) == 0 {
+		return nil, false, errors.New("no regions to read")
+	}
+	prefix := secondary_index.ValuePrefix(index, value, exact)
+	idx := sort.Search(len(sortedRegions), func(i int) bool {
+		if len(sortedRegions[i].Rg.EndKey) == 0 {
+			return true
+		}
+		return bytes.Compare(sortedRegions[i].Rg.EndKey, prefix) > 0
+	})
+	results := make([]*pspb.IndexEntry, 0)
+	var more bool
+	for i := idx; i < len(sortedRegions) && limit > 0; i++ {
+		if i != idx {
+			if !bytes.HasPrefix(sortedRegions[i].Rg.StartKey, prefix) {
+				break
+			}
+		}
+		conn := lib.getConn(lib.getPSAddr((sortedRegions[i].PSID)))
+		client := pspb.NewPartitionKVClient(conn)
+		res, err := client.IndexRange(ctx, &pspb.IndexRangeRequest{
+			Index:  index,
+			Value:  value,
+			Exact:  exact,
+			Limit:  limit,
+			Partid: sortedRegions[i].PartID,
+		})
+		if err != nil {
+			return nil, false, err
+		}
+		limit -= uint32(len(res.Entries))
+		more = res.Truncated
+		results = append(results, res.Entries...)
+	}
+	return results, more, nil
+}
+
 func (lib *AutumnLib) SplitPart(ctx context.Context, partID uint64) error {
 	sortedRegions := lib.getRegions()
 	foundRegion := -1
@@ -506,3 +656,157 @@ func (lib *AutumnLib) Head(ctx context.Context, key []byte) ([]byte, uint32, err
 	}
 	return res.Info.Key, res.Info.Len, err
 }
+
+var (
+	ErrWatchCompacted = errors.New("events to watch have been GC'd, resync and watch from now")
+	ErrWatchTooSlow   = errors.New("watcher can not keep up with writes, resume from the last checkpoint later")
+)
+
+//Watch calls f with events of keys which have prefix and seq > fromSeq, if fromNow, fromSeq is ignored.
+//prefix must be in one partition, because seq is per partition. if ps fails over, Watch reconnects
+//and resumes from the last checkpoint. Watch returns ErrWatchCompacted if events have been GC'd,
+//and ErrWatchTooSlow if f can not keep up with writes
+func (lib *AutumnLib) Watch(ctx context.Context, prefix []byte, fromSeq uint64, fromNow bool, f func(*pspb.WatchResponse) error) error {
+	checkpoint := fromSeq
+	backoff := 100 * time.Millisecond
+	for {
+		sortedRegions := lib.getRegions()
+		if len(sortedRegions) == 0 {
+			return errors.New("no regions to watch")
+		}
+		idx := sort.Search(len(sortedRegions), func(i int) bool {
+			if len(sortedRegions[i].Rg.EndKey) == 0 {
+				return true
+			}
+			return bytes.Compare(sortedRegions[i].Rg.EndKey, prefix) > 0
+		})
+		region := sortedRegions[idx]
+		if len(region.Rg.EndKey) > 0 && bytes.HasPrefix(region.Rg.EndKey, prefix) {
+			return errors.Errorf("prefix %q is in more than one partition", prefix)
+		}
+
+		client := pspb.NewPartitionKVClient(lib.getConn(lib.getPSAddr(region.PSID)))
+		stream, err := client.Watch(ctx, &pspb.WatchRequest{
+			Prefix:  prefix,
+			FromSeq: checkpoint,
+			FromNow: fromNow,
+			Partid:  region.PartID,
+		})
+		for err == nil {
+			var res *pspb.WatchResponse
+			if res, err = stream.Recv(); err != nil {
+				break
+			}
+			fromNow = false
+			checkpoint = res.Checkpoint
+			backoff = 100 * time.Millisecond
+			if err := f(res); err != nil {
+				return err
+			}
+		}
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		switch status.Code(err) {
+		case codes.OutOfRange:
+			return ErrWatchCompacted
+		case codes.Aborted:
+			return ErrWatchTooSlow
+		}
+		xlog.Logger.Warnf("watch on partition %d is broken: %v, resume from %d", region.PartID, err, checkpoint)
+		time.Sleep(backoff)
+		if backoff < 5*time.Second {
+			backoff *= 2
+		}
+	}
+}
+
+//WatchPartition watches all keys of the partition of region from fromSeq, unlike Watch, it returns
+//when the stream is broken, so the caller could check if the partition has been split
+func (lib *AutumnLib) WatchPartition(ctx context.Context, region *pspb.RegionInfo, fromSeq uint64, f func(*pspb.WatchResponse) error) error {
+	client := pspb.NewPartitionKVClient(lib.getConn(lib.getPSAddr(region.PSID)))
+	stream, err := client.Watch(ctx, &pspb.WatchRequest{
+		FromSeq: fromSeq,
+		Partid:  region.PartID,
+	})
+	if err != nil {
+		return err
+	}
+	for {
+		res, err := stream.Recv()
+		if status.Code(err) == codes.OutOfRange {
+			return ErrWatchCompacted
+		} else if status.Code(err) == codes.Aborted {
+			return ErrWatchTooSlow
+		} else if err != nil {
+			return err
+		}
+		if err = f(res); err != nil {
+			return err
+		}
+	}
+}
+
+//Replicate applies ops from another cluster, an op is skipped if the current value has a newer origin.
+//clusterID is the id of this cluster. ops are sent to partitions in the order of their keys' regions
+func (lib *AutumnLib) Replicate(ctx context.Context, ops []*pspb.ReplicateOp, clusterID uint64) (int, error) {
+	sortedRegions := lib.getRegions()
+	if len(sortedRegions) == 0 {
+		return 0, errors.New("no regions to write")
+	}
+	groups := make(map[int][]*pspb.ReplicateOp)
+	for _, op := range ops {
+		idx := sort.Search(len(sortedRegions), func(i int) bool {
+			if len(sortedRegions[i].Rg.EndKey) == 0 {
+				return true
+			}
+			return bytes.Compare(sortedRegions[i].Rg.EndKey, op.Key) > 0
+		})
+		groups[idx] = append(groups[idx], op)
+	}
+
+	applied := 0
+	for idx, group := range groups {
+		conn := lib.getConn(lib.getPSAddr(sortedRegions[idx].PSID))
+		client := pspb.NewPartitionKVClient(conn)
+		res, err := client.Replicate(ctx, &pspb.ReplicateRequest{
+			Ops:       group,
+			ClusterID: clusterID,
+			Partid:    sortedRegions[idx].PartID,
+		})
+		if err != nil {
+			return applied, err
+		}
+		applied += int(res.Applied)
+	}
+	return applied, nil
+}
+
+const clusterIDKey = "clusterID"
+
+//ClusterID returns the id of this cluster, it is created at the first call
+func (lib *AutumnLib) ClusterID() (uint64, error) {
+	for {
+		data, _, err := etcd_utils.EtcdGetKV(lib.etcdClient, clusterIDKey)
+		if err != nil {
+			return 0, err
+		}
+		if len(data) == 8 {
+			return binary.BigEndian.Uint64(data), nil
+		}
+		var buf [8]byte
+		if _, err = rand.Read(buf[:]); err != nil {
+			return 0, err
+		}
+		if binary.BigEndian.Uint64(buf[:]) == 0 {
+			continue
+		}
+		//if another client creates it first, read it again
+		etcd_utils.EtcdSetKVS(lib.etcdClient, []clientv3.Cmp{
+			clientv3.Compare(clientv3.CreateRevision(clusterIDKey), "=", 0),
+		}, []clientv3.Op{
+			clientv3.OpPut(clusterIDKey, string(buf[:])),
+		})
+	}
+}
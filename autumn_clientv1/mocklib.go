@@ -0,0 +1,318 @@
+package autumn_clientv1
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/journeymidnight/autumn/range_partition"
+	"github.com/journeymidnight/autumn/range_partition/table"
+	"github.com/journeymidnight/autumn/range_partition/y"
+	"github.com/pkg/errors"
+)
+
+/*
+MockLib is an in-memory cluster for tests of tools built on AutumnLib, such as kv_archive,
+bulk_load and geo_replication. each key keeps its last event, partitions keep logs of events
+for WatchPartition until GCWatchLog.
+*/
+type MockLib struct {
+	sync.Mutex
+	regions     []*pspb.RegionInfo
+	kvs         map[string]*pspb.WatchEvent   //last event of each key
+	logs        map[uint64][]*pspb.WatchEvent //events to watch of each partition
+	watchGCSeq  map[uint64]uint64             //events before watchGCSeq are GC'd
+	broken      map[uint64]bool               //next WatchPartition fails like the partition is closed
+	seq         uint64
+	deleteGCSeq uint64 //deletions whose seq <= deleteGCSeq are dropped
+
+	FailRangeAfter int //RangePartition fails after FailRangeAfter calls if > 0
+	RangeCalls     int
+	IngestCalls    int
+}
+
+//NewMockLib returns a cluster of regions, or of one partition 1 of all keys if regions is empty
+func NewMockLib(regions ...*pspb.RegionInfo) *MockLib {
+	if len(regions) == 0 {
+		regions = []*pspb.RegionInfo{{PartID: 1, Rg: &pspb.Range{}}}
+	}
+	return &MockLib{
+		regions:    regions,
+		kvs:        make(map[string]*pspb.WatchEvent),
+		logs:       make(map[uint64][]*pspb.WatchEvent),
+		watchGCSeq: make(map[uint64]uint64),
+		broken:     make(map[uint64]bool),
+	}
+}
+
+func inMockRange(rg *pspb.Range, key []byte) bool {
+	return bytes.Compare(key, rg.StartKey) >= 0 && (len(rg.EndKey) == 0 || bytes.Compare(key, rg.EndKey) < 0)
+}
+
+func (m *MockLib) partOf(key []byte) uint64 {
+	for _, region := range m.regions {
+		if inMockRange(region.Rg, key) {
+			return region.PartID
+		}
+	}
+	panic(fmt.Sprintf("no region of key %s", key))
+}
+
+func (m *MockLib) currentRange(partID uint64) *pspb.Range {
+	for _, region := range m.regions {
+		if region.PartID == partID {
+			return region.Rg
+		}
+	}
+	return nil
+}
+
+func (m *MockLib) write(e *pspb.WatchEvent, logged bool) {
+	m.seq++
+	e.Seq = m.seq
+	m.kvs[string(e.Key)] = e
+	if logged {
+		partID := m.partOf(e.Key)
+		m.logs[partID] = append(m.logs[partID], e)
+	}
+}
+
+//Write applies an event, if !logged, the event has been GC'd from the log of its partition
+func (m *MockLib) Write(e *pspb.WatchEvent, logged bool) {
+	m.Lock()
+	defer m.Unlock()
+	m.write(e, logged)
+}
+
+func (m *MockLib) Put(ctx context.Context, key, value []byte) error {
+	return m.PutWithExpiresAt(ctx, key, value, 0)
+}
+
+func (m *MockLib) PutWithExpiresAt(ctx context.Context, key, value []byte, expiresAt uint64) error {
+	m.Write(&pspb.WatchEvent{Key: key, Value: value, ExpiresAt: expiresAt}, true)
+	return nil
+}
+
+func (m *MockLib) Delete(ctx context.Context, key []byte) error {
+	m.Write(&pspb.WatchEvent{Key: key, Type: pspb.WatchEvent_DELETE}, true)
+	return nil
+}
+
+//Split splits partID at mid, newPartID shares the log of partID, the next watch of partID fails
+func (m *MockLib) Split(partID, newPartID uint64, mid string) {
+	m.Lock()
+	defer m.Unlock()
+	var regions []*pspb.RegionInfo
+	for _, region := range m.regions {
+		if region.PartID != partID {
+			regions = append(regions, region)
+			continue
+		}
+		regions = append(regions,
+			&pspb.RegionInfo{PartID: partID, Rg: &pspb.Range{StartKey: region.Rg.StartKey, EndKey: []byte(mid)}},
+			&pspb.RegionInfo{PartID: newPartID, Rg: &pspb.Range{StartKey: []byte(mid), EndKey: region.Rg.EndKey}})
+	}
+	m.regions = regions
+	m.logs[newPartID] = append([]*pspb.WatchEvent{}, m.logs[partID]...)
+	m.broken[partID] = true
+}
+
+//CompactDeletions drops deletions like a major compaction
+func (m *MockLib) CompactDeletions() {
+	m.Lock()
+	defer m.Unlock()
+	for k, e := range m.kvs {
+		if e.Type == pspb.WatchEvent_DELETE {
+			delete(m.kvs, k)
+		}
+	}
+	m.deleteGCSeq = m.seq
+}
+
+//GCWatchLog drops the log of partID, the running watch of partID fails
+func (m *MockLib) GCWatchLog(partID uint64) {
+	m.Lock()
+	defer m.Unlock()
+	m.watchGCSeq[partID] = m.seq
+	m.broken[partID] = true
+}
+
+//Values returns keys which are not deleted
+func (m *MockLib) Values() map[string]string {
+	m.Lock()
+	defer m.Unlock()
+	ret := make(map[string]string)
+	for k, e := range m.kvs {
+		if e.Type == pspb.WatchEvent_PUT {
+			ret[k] = string(e.Value)
+		}
+	}
+	return ret
+}
+
+func (m *MockLib) Regions() []*pspb.RegionInfo {
+	m.Lock()
+	defer m.Unlock()
+	return append([]*pspb.RegionInfo{}, m.regions...)
+}
+
+func (m *MockLib) RangePartition(ctx context.Context, region *pspb.RegionInfo, prefix []byte, start []byte, limit uint32, sinceSeq uint64) (*pspb.RangeResponse, error) {
+	m.Lock()
+	defer m.Unlock()
+	m.RangeCalls++
+	if m.FailRangeAfter > 0 && m.RangeCalls > m.FailRangeAfter {
+		return nil, errors.New("range failed")
+	}
+	var keys []string
+	for k, e := range m.kvs {
+		key := []byte(k)
+		if m.partOf(key) != region.PartID || bytes.Compare(key, start) < 0 || !bytes.HasPrefix(key, prefix) {
+			continue
+		}
+		//like ps, deletions are only returned to ranges since sinceSeq
+		if e.Seq <= sinceSeq || (sinceSeq == 0 && e.Type == pspb.WatchEvent_DELETE) {
+			continue
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	res := &pspb.RangeResponse{Seq: m.seq, DeleteGCSeq: m.deleteGCSeq}
+	for _, k := range keys {
+		if uint32(len(res.Keys)) == limit {
+			res.Truncated = true
+			break
+		}
+		res.Keys = append(res.Keys, []byte(k))
+		if sinceSeq > 0 {
+			res.Deleted = append(res.Deleted, m.kvs[k].Type == pspb.WatchEvent_DELETE)
+		}
+	}
+	return res, nil
+}
+
+func (m *MockLib) GetIfExists(ctx context.Context, key []byte) (*pspb.GetResponse, error) {
+	return m.GetWithOrigin(ctx, key)
+}
+
+func (m *MockLib) GetWithOrigin(ctx context.Context, key []byte) (*pspb.GetResponse, error) {
+	m.Lock()
+	defer m.Unlock()
+	e := m.kvs[string(key)]
+	if e == nil {
+		return &pspb.GetResponse{Key: key, NotFound: true}, nil
+	}
+	//deletions keep their origin
+	return &pspb.GetResponse{
+		Key:           key,
+		Value:         e.Value,
+		Version:       e.Seq,
+		NotFound:      e.Type == pspb.WatchEvent_DELETE,
+		OriginCluster: e.OriginCluster,
+		OriginSeq:     e.OriginSeq,
+		ExpiresAt:     e.ExpiresAt,
+	}, nil
+}
+
+func (m *MockLib) WatchPartition(ctx context.Context, region *pspb.RegionInfo, fromSeq uint64, f func(*pspb.WatchResponse) error) error {
+	sent := fromSeq
+	for {
+		m.Lock()
+		if m.broken[region.PartID] {
+			m.broken[region.PartID] = false
+			m.Unlock()
+			return errors.New("partition is closed")
+		}
+		if fromSeq < m.watchGCSeq[region.PartID] {
+			m.Unlock()
+			return ErrWatchCompacted
+		}
+		rg := m.currentRange(region.PartID)
+		res := &pspb.WatchResponse{Checkpoint: m.seq, Rg: rg}
+		for _, e := range m.logs[region.PartID] {
+			if e.Seq > sent && inMockRange(rg, e.Key) {
+				res.Events = append(res.Events, e)
+			}
+		}
+		m.Unlock()
+
+		if res.Checkpoint > sent {
+			if err := f(res); err != nil {
+				return err
+			}
+			sent = res.Checkpoint
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(5 * time.Millisecond):
+		}
+	}
+}
+
+//Replicate keeps the op with the newest origin of each key, local writes have the oldest origin
+func (m *MockLib) Replicate(ctx context.Context, ops []*pspb.ReplicateOp, clusterID uint64) (int, error) {
+	m.Lock()
+	defer m.Unlock()
+	applied := 0
+	for _, op := range ops {
+		if cur := m.kvs[string(op.Key)]; cur != nil && (cur.OriginSeq > op.OriginSeq ||
+			(cur.OriginSeq == op.OriginSeq && cur.OriginCluster >= op.OriginCluster)) {
+			continue
+		}
+		e := &pspb.WatchEvent{Key: op.Key, Value: op.Value, ExpiresAt: op.ExpiresAt, OriginCluster: op.OriginCluster, OriginSeq: op.OriginSeq}
+		if op.Deleted {
+			e.Type = pspb.WatchEvent_DELETE
+		}
+		m.write(e, true)
+		applied++
+	}
+	return applied, nil
+}
+
+//IngestTables applies tables to partID at one seq, like ps ingests them
+func (m *MockLib) IngestTables(ctx context.Context, partID uint64, tables []io.Reader) (uint64, error) {
+	m.Lock()
+	defer m.Unlock()
+	m.IngestCalls++
+	rg := m.currentRange(partID)
+	if rg == nil {
+		return 0, errors.Errorf("no such partition %d", partID)
+	}
+	m.seq++
+	for _, r := range tables {
+		blocks, err := table.ReadTableFile(r)
+		if err != nil {
+			return 0, err
+		}
+		tbl, err := blocks.Open()
+		if err != nil {
+			return 0, err
+		}
+		it := tbl.NewIterator(false)
+		for it.Rewind(); it.Valid(); it.Next() {
+			key := y.ParseKey(it.Key())
+			if !inMockRange(rg, key) {
+				tbl.Close()
+				return 0, errors.Errorf("key %s is not in partition %d", key, partID)
+			}
+			e := &pspb.WatchEvent{Key: key, Seq: m.seq}
+			if it.Value().Meta&range_partition.BitDelete > 0 {
+				e.Type = pspb.WatchEvent_DELETE
+			} else {
+				e.Value = append([]byte{}, it.Value().Value...)
+			}
+			m.kvs[string(key)] = e
+		}
+		err = it.Error()
+		tbl.Close()
+		if err != nil {
+			return 0, err
+		}
+	}
+	return m.seq, nil
+}
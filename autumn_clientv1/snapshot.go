@@ -0,0 +1,123 @@
+package autumn_clientv1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/journeymidnight/autumn/etcd_utils"
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/pkg/errors"
+)
+
+func (lib *AutumnLib) findRegion(partID uint64) (*pspb.RegionInfo, error) {
+	for _, region := range lib.getRegions() {
+		if region.PartID == partID {
+			return region, nil
+		}
+	}
+	return nil, errors.New("partition not found")
+}
+
+//SnapshotPartition creates a read-only point-in-time snapshot of partID
+func (lib *AutumnLib) SnapshotPartition(ctx context.Context, partID uint64) (*pspb.PartitionSnapshot, error) {
+	region, err := lib.findRegion(partID)
+	if err != nil {
+		return nil, err
+	}
+	client := pspb.NewPartitionKVClient(lib.getConn(lib.getPSAddr(region.PSID)))
+	res, err := client.SnapshotPart(ctx, &pspb.SnapshotPartRequest{Partid: partID})
+	if err != nil {
+		return nil, err
+	}
+	return res.Snapshot, nil
+}
+
+//RestorePartition replaces data of the partition of snapshotID with the snapshot, the snapshot is kept
+func (lib *AutumnLib) RestorePartition(ctx context.Context, snapshotID uint64) error {
+	snapshot, region, err := lib.snapshotRegion(snapshotID)
+	if err != nil {
+		return err
+	}
+	client := pspb.NewPartitionKVClient(lib.getConn(lib.getPSAddr(region.PSID)))
+	_, err = client.RestorePart(ctx, &pspb.RestorePartRequest{
+		Partid:     snapshot.PartID,
+		SnapshotID: snapshotID,
+	})
+	return err
+}
+
+//Snapshots returns all snapshots of partitions
+func (lib *AutumnLib) Snapshots() ([]*pspb.PartitionSnapshot, error) {
+	kvs, _, err := etcd_utils.EtcdRange(lib.etcdClient, "snapshots/")
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*pspb.PartitionSnapshot, 0, len(kvs))
+	for _, kv := range kvs {
+		var snapshot pspb.PartitionSnapshot
+		if err = snapshot.Unmarshal(kv.Value); err != nil {
+			return nil, errors.Wrapf(err, "bad snapshot %s", kv.Key)
+		}
+		ret = append(ret, &snapshot)
+	}
+	return ret, nil
+}
+
+//snapshotRegion returns snapshotID and the region of its partition, reads of snapshots are served by
+//the ps of the partition
+func (lib *AutumnLib) snapshotRegion(snapshotID uint64) (*pspb.PartitionSnapshot, *pspb.RegionInfo, error) {
+	data, _, err := etcd_utils.EtcdGetKV(lib.etcdClient, fmt.Sprintf("snapshots/%d", snapshotID))
+	if err != nil {
+		return nil, nil, err
+	}
+	if data == nil {
+		return nil, nil, errors.Errorf("snapshot %d not found", snapshotID)
+	}
+	var snapshot pspb.PartitionSnapshot
+	if err = snapshot.Unmarshal(data); err != nil {
+		return nil, nil, err
+	}
+	region, err := lib.findRegion(snapshot.PartID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &snapshot, region, nil
+}
+
+//GetSnapshot reads key from snapshotID
+func (lib *AutumnLib) GetSnapshot(ctx context.Context, snapshotID uint64, key []byte) ([]byte, error) {
+	snapshot, region, err := lib.snapshotRegion(snapshotID)
+	if err != nil {
+		return nil, err
+	}
+	client := pspb.NewPartitionKVClient(lib.getConn(lib.getPSAddr(region.PSID)))
+	res, err := client.Get(ctx, &pspb.GetRequest{
+		Key:        key,
+		Partid:     snapshot.PartID,
+		SnapshotID: snapshotID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Value, nil
+}
+
+//RangeSnapshot ranges keys in snapshotID
+func (lib *AutumnLib) RangeSnapshot(ctx context.Context, snapshotID uint64, prefix []byte, start []byte, limit uint32) ([][]byte, bool, error) {
+	snapshot, region, err := lib.snapshotRegion(snapshotID)
+	if err != nil {
+		return nil, false, err
+	}
+	client := pspb.NewPartitionKVClient(lib.getConn(lib.getPSAddr(region.PSID)))
+	res, err := client.Range(ctx, &pspb.RangeRequest{
+		Prefix:     prefix,
+		Start:      start,
+		Limit:      limit,
+		Partid:     snapshot.PartID,
+		SnapshotID: snapshotID,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return res.Keys, res.Truncated, nil
+}
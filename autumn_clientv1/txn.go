@@ -0,0 +1,271 @@
+package autumn_clientv1
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/journeymidnight/autumn/transaction"
+	"github.com/journeymidnight/autumn/xlog"
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+Txn buffers writes in memory and commits them with two-phase commit, AutumnLib is the coordinator.
+Txn.Get reads the last committed value and skips pending intents. Commit first writes intents of
+written keys, then checks versions of keys only read, both on their partitions. if any key read has
+been updated, or is being written by another pending txn, Commit returns ErrTxnConflict, so a
+committed txn read the same values as it would at its commit point, and two txns writing each
+other's read keys can not both commit.
+reads of a txn without writes are not checked, and plain writes are not ordered with txns, a plain
+write after the check is not seen by the txn
+*/
+
+const txnTimeout = 30 * time.Second
+
+var (
+	ErrTxnConflict = errors.New("txn conflicts with other writes")
+	ErrTxnAborted  = errors.New("txn has been aborted")
+	errTxnNotFound = errors.New("not found")
+)
+
+type Txn struct {
+	lib    *AutumnLib
+	id     string
+	writes map[string]*pspb.TxnMutation
+	reads  map[string]uint64 //key => version
+}
+
+func (lib *AutumnLib) NewTxn() *Txn {
+	return &Txn{
+		lib:    lib,
+		id:     uuid.NewV4().String(),
+		writes: make(map[string]*pspb.TxnMutation),
+		reads:  make(map[string]uint64),
+	}
+}
+
+func (txn *Txn) ID() string {
+	return txn.id
+}
+
+//Get returns the value written in txn or the last committed value
+func (txn *Txn) Get(ctx context.Context, key []byte) ([]byte, error) {
+	if m, ok := txn.writes[string(key)]; ok {
+		if m.Delete {
+			return nil, errTxnNotFound
+		}
+		return m.Value, nil
+	}
+	res, err := txn.lib.get(ctx, key, true, false)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := txn.reads[string(key)]; !ok {
+		txn.reads[string(key)] = res.Version
+	}
+	if res.NotFound {
+		return nil, errTxnNotFound
+	}
+	return res.Value, nil
+}
+
+func (txn *Txn) Put(key, value []byte) {
+	txn.writes[string(key)] = &pspb.TxnMutation{
+		Key:   key,
+		Value: value,
+	}
+}
+
+func (txn *Txn) Delete(key []byte) {
+	txn.writes[string(key)] = &pspb.TxnMutation{
+		Key:    key,
+		Delete: true,
+	}
+}
+
+func (lib *AutumnLib) regionOf(sortedRegions []*pspb.RegionInfo, key []byte) *pspb.RegionInfo {
+	idx := sort.Search(len(sortedRegions), func(i int) bool {
+		if len(sortedRegions[i].Rg.EndKey) == 0 {
+			return true
+		}
+		return bytes.Compare(sortedRegions[i].Rg.EndKey, key) > 0
+	})
+	return sortedRegions[idx]
+}
+
+//Commit writes all mutations atomically
+func (txn *Txn) Commit(ctx context.Context) error {
+	if len(txn.writes) == 0 {
+		return nil
+	}
+	sortedRegions := txn.lib.getRegions()
+	if len(sortedRegions) == 0 {
+		return errors.New("no regions to write")
+	}
+
+	keys := make([][]byte, 0, len(txn.writes))
+	writes := newPrepares(txn.id)
+	for _, m := range txn.writes {
+		keys = append(keys, m.Key)
+		if version, ok := txn.reads[string(m.Key)]; ok {
+			m.CheckVersion = true
+			m.Version = version
+		}
+		writes.add(txn.lib.regionOf(sortedRegions, m.Key), m)
+	}
+	checks := newPrepares(txn.id)
+	for key, version := range txn.reads {
+		if _, ok := txn.writes[key]; !ok {
+			checks.add(txn.lib.regionOf(sortedRegions, []byte(key)), &pspb.TxnMutation{
+				Key:          []byte(key),
+				CheckVersion: true,
+				Version:      version,
+				CheckOnly:    true,
+			})
+		}
+	}
+
+	record := &pspb.TxnRecord{
+		TxnID:    txn.id,
+		Status:   pspb.TxnStatus_PENDING,
+		Deadline: time.Now().Add(txnTimeout).UnixNano(),
+		Keys:     keys,
+	}
+	if err := transaction.Create(txn.lib.etcdClient, record); err != nil {
+		return err
+	}
+
+	//phase 1: write intents on all partitions, then check keys read. checking after intents are
+	//written makes sure one of two txns reading keys written by each other sees the other's intent
+	err := txn.lib.prepare(ctx, writes)
+	if err == nil {
+		err = txn.lib.prepare(ctx, checks)
+	}
+	if err != nil {
+		txn.abort(keys)
+		if status.Code(err) == codes.Aborted {
+			return errors.Wrap(ErrTxnConflict, err.Error())
+		}
+		return err
+	}
+
+	//phase 2: commit point
+	txnStatus, err := transaction.Decide(txn.lib.etcdClient, txn.id, pspb.TxnStatus_COMMITTED)
+	if err != nil {
+		//status is unknown, CronTaskResolveTxn on ps will resolve it
+		return err
+	}
+	if txnStatus != pspb.TxnStatus_COMMITTED {
+		txn.abort(keys)
+		return ErrTxnAborted
+	}
+
+	//intents can be resolved by readers or ps, so it is not necessary to wait
+	if err = txn.lib.ResolveTxn(ctx, txn.id, keys, true); err != nil {
+		xlog.Logger.Warnf("resolve txn %s: %v", txn.id, err)
+		return nil
+	}
+	if err = transaction.Delete(txn.lib.etcdClient, txn.id); err != nil {
+		xlog.Logger.Warnf("remove txn record %s: %v", txn.id, err)
+	}
+	return nil
+}
+
+//prepares are TxnPrepareRequests of a txn grouped by partitions
+type prepares struct {
+	txnID string
+	reqs  map[uint64]*pspb.TxnPrepareRequest
+	psIDs map[uint64]uint64 //partID => psID
+}
+
+func newPrepares(txnID string) *prepares {
+	return &prepares{
+		txnID: txnID,
+		reqs:  make(map[uint64]*pspb.TxnPrepareRequest),
+		psIDs: make(map[uint64]uint64),
+	}
+}
+
+func (p *prepares) add(region *pspb.RegionInfo, m *pspb.TxnMutation) {
+	req, ok := p.reqs[region.PartID]
+	if !ok {
+		req = &pspb.TxnPrepareRequest{
+			TxnID:  p.txnID,
+			Partid: region.PartID,
+		}
+		p.reqs[region.PartID] = req
+		p.psIDs[region.PartID] = region.PSID
+	}
+	req.Mutations = append(req.Mutations, m)
+}
+
+//prepare sends prepares to all partitions in parallel, returns the first error
+func (lib *AutumnLib) prepare(ctx context.Context, p *prepares) error {
+	var wg sync.WaitGroup
+	errs := make(chan error, len(p.reqs))
+	for partID, req := range p.reqs {
+		wg.Add(1)
+		go func(psID uint64, req *pspb.TxnPrepareRequest) {
+			defer wg.Done()
+			client := pspb.NewPartitionKVClient(lib.getConn(lib.getPSAddr(psID)))
+			if _, err := client.TxnPrepare(ctx, req); err != nil {
+				errs <- err
+			}
+		}(p.psIDs[partID], req)
+	}
+	wg.Wait()
+	close(errs)
+	return <-errs
+}
+
+func (txn *Txn) abort(keys [][]byte) {
+	txnStatus, err := transaction.Decide(txn.lib.etcdClient, txn.id, pspb.TxnStatus_ABORTED)
+	if err != nil || txnStatus != pspb.TxnStatus_ABORTED {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err = txn.lib.ResolveTxn(ctx, txn.id, keys, false); err != nil {
+		xlog.Logger.Warnf("resolve txn %s: %v", txn.id, err)
+		return
+	}
+	transaction.Delete(txn.lib.etcdClient, txn.id)
+}
+
+//ResolveTxn resolves intents of txnID on keys, commit must be the decided status of txn
+func (lib *AutumnLib) ResolveTxn(ctx context.Context, txnID string, keys [][]byte, commit bool) error {
+	sortedRegions := lib.getRegions()
+	if len(sortedRegions) == 0 {
+		return errors.New("no regions to write")
+	}
+	reqs := make(map[uint64]*pspb.TxnResolveRequest)
+	psIDs := make(map[uint64]uint64)
+	for _, key := range keys {
+		region := lib.regionOf(sortedRegions, key)
+		req, ok := reqs[region.PartID]
+		if !ok {
+			req = &pspb.TxnResolveRequest{
+				TxnID:  txnID,
+				Commit: commit,
+				Partid: region.PartID,
+			}
+			reqs[region.PartID] = req
+			psIDs[region.PartID] = region.PSID
+		}
+		req.Keys = append(req.Keys, key)
+	}
+	for partID, req := range reqs {
+		client := pspb.NewPartitionKVClient(lib.getConn(lib.getPSAddr(psIDs[partID])))
+		if _, err := client.TxnResolve(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,194 @@
+package bulk_load
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/journeymidnight/autumn/range_partition"
+	"github.com/journeymidnight/autumn/range_partition/table"
+	"github.com/journeymidnight/autumn/range_partition/y"
+	"github.com/pkg/errors"
+)
+
+/*
+bulk load builds table files out of the cluster, and adds them to partitions by IngestTables.
+
+keys are sorted in memory in runs of RunSize bytes, a sorted run is split by regions and TableSize into
+table files named <run>-<partID>-<n>.sst. tables of one run and one partition do not overlap, they are
+ingested together. runs are ingested in order, so a key added several times gets the last value
+*/
+
+const (
+	defaultTableSize = 64 << 20
+	defaultRunSize   = 128 << 20 //tables of a run and a partition are sent by one IngestTables, which is limited to 256MB
+	tableFileSuffix  = ".sst"
+)
+
+type Options struct {
+	TableSize   int //bytes of keys and values in a table
+	RunSize     int //bytes of keys and values sorted in memory
+	Compression table.CompressionType
+}
+
+type entry struct {
+	key     []byte
+	value   []byte
+	deleted bool
+}
+
+type Builder struct {
+	dir     string
+	regions []*pspb.RegionInfo
+	opt     Options
+	entries []entry
+	size    int
+	run     int
+}
+
+//NewBuilder writes table files in dir for regions, which are sorted by start key
+func NewBuilder(dir string, regions []*pspb.RegionInfo, opt Options) (*Builder, error) {
+	if len(regions) == 0 {
+		return nil, errors.New("no regions")
+	}
+	if opt.TableSize <= 0 {
+		opt.TableSize = defaultTableSize
+	}
+	if opt.RunSize <= 0 {
+		opt.RunSize = defaultRunSize
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &Builder{dir: dir, regions: regions, opt: opt}, nil
+}
+
+//Add adds key and value, b keeps them until they are written
+func (b *Builder) Add(key, value []byte) error {
+	return b.add(entry{key: key, value: value})
+}
+
+//Delete adds a tombstone of key
+func (b *Builder) Delete(key []byte) error {
+	return b.add(entry{key: key, deleted: true})
+}
+
+func (b *Builder) add(e entry) error {
+	if len(e.key) == 0 {
+		return errors.New("key is empty")
+	}
+	b.entries = append(b.entries, e)
+	b.size += len(e.key) + len(e.value)
+	if b.size >= b.opt.RunSize {
+		return b.flushRun()
+	}
+	return nil
+}
+
+//Finish writes the last run
+func (b *Builder) Finish() error {
+	if len(b.entries) == 0 {
+		return nil
+	}
+	return b.flushRun()
+}
+
+func inRegion(region *pspb.RegionInfo, key []byte) bool {
+	return bytes.Compare(key, region.Rg.StartKey) >= 0 &&
+		(len(region.Rg.EndKey) == 0 || bytes.Compare(key, region.Rg.EndKey) < 0)
+}
+
+func (b *Builder) flushRun() error {
+	entries := b.entries
+	sort.SliceStable(entries, func(i, j int) bool {
+		return bytes.Compare(entries[i].key, entries[j].key) < 0
+	})
+	//keep the last one of the same keys
+	n := 0
+	for i := range entries {
+		if n > 0 && bytes.Equal(entries[n-1].key, entries[i].key) {
+			entries[n-1] = entries[i]
+			continue
+		}
+		entries[n] = entries[i]
+		n++
+	}
+	entries = entries[:n]
+
+	r := 0
+	for len(entries) > 0 {
+		for r < len(b.regions) && !inRegion(b.regions[r], entries[0].key) {
+			r++
+		}
+		if r == len(b.regions) {
+			return errors.Errorf("key %q is not in any region", entries[0].key)
+		}
+		end := sort.Search(len(entries), func(i int) bool {
+			return !inRegion(b.regions[r], entries[i].key)
+		})
+		if err := b.writeTables(b.regions[r].PartID, entries[:end]); err != nil {
+			return err
+		}
+		entries = entries[end:]
+	}
+
+	b.entries = nil
+	b.size = 0
+	b.run++
+	return nil
+}
+
+//writeTables splits sorted entries of a partition into tables
+func (b *Builder) writeTables(partID uint64, entries []entry) error {
+	for i := 0; len(entries) > 0; i++ {
+		size := 0
+		n := 0
+		for n < len(entries) && (n == 0 || size < b.opt.TableSize) {
+			size += len(entries[n].key) + len(entries[n].value)
+			n++
+		}
+		name := fmt.Sprintf("%06d-%d-%04d%s", b.run, partID, i, tableFileSuffix)
+		if err := b.writeTable(filepath.Join(b.dir, name), entries[:n]); err != nil {
+			return err
+		}
+		entries = entries[n:]
+	}
+	return nil
+}
+
+func (b *Builder) writeTable(path string, entries []entry) error {
+	blocks := new(table.MemBlocks)
+	tb := table.NewTableBuilder(blocks, b.opt.Compression)
+	var size uint64
+	for _, e := range entries {
+		vs := y.ValueStruct{Value: e.value}
+		if e.deleted {
+			vs = y.ValueStruct{Meta: range_partition.BitDelete}
+		}
+		//ts is rewritten by partition when the table is ingested
+		key := y.KeyWithTs(e.key, 0)
+		tb.Add(key, vs)
+		size += uint64(len(key) + len(e.value))
+	}
+	tb.FinishBlock()
+	if _, _, err := tb.FinishAll(0, 0, 0, nil, size); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err = blocks.WriteTo(f); err != nil {
+		f.Close()
+		return err
+	}
+	if err = f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,77 @@
+package bulk_load
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/journeymidnight/autumn/autumn_clientv1"
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/journeymidnight/autumn/range_partition/table"
+	"github.com/journeymidnight/autumn/xlog"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+var testRegions = []*pspb.RegionInfo{
+	{PartID: 1, Rg: &pspb.Range{StartKey: []byte(""), EndKey: []byte("m")}},
+	{PartID: 2, Rg: &pspb.Range{StartKey: []byte("m"), EndKey: []byte("")}},
+}
+
+func TestBuildIngest(t *testing.T) {
+	xlog.InitLog([]string{"bulk.log"}, zapcore.DebugLevel)
+	defer os.Remove("bulk.log")
+	dir, err := ioutil.TempDir(os.TempDir(), "bulktest")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	b, err := NewBuilder(dir, testRegions, Options{TableSize: 100, RunSize: 1000, Compression: table.Snappy})
+	require.Nil(t, err)
+	expected := make(map[string]string)
+	for i := 0; i < 200; i++ {
+		key := fmt.Sprintf("%c%03d", 'a'+i%26, i%50)
+		value := fmt.Sprintf("val%d", i)
+		require.Nil(t, b.Add([]byte(key), []byte(value)))
+		expected[key] = value
+	}
+	require.Nil(t, b.Delete([]byte("a000")))
+	delete(expected, "a000")
+	require.Nil(t, b.Finish())
+	require.NotNil(t, b.Add(nil, []byte("value")))
+
+	groups, err := ListGroups(dir)
+	require.Nil(t, err)
+	require.True(t, len(groups) > 2)
+	for i := 1; i < len(groups); i++ {
+		require.True(t, groups[i-1].Run < groups[i].Run ||
+			(groups[i-1].Run == groups[i].Run && groups[i-1].PartID < groups[i].PartID))
+	}
+
+	sink := autumn_clientv1.NewMockLib(testRegions...)
+	n := 0
+	require.Nil(t, Ingest(context.Background(), dir, sink, func(g *Group, seq uint64) { n++ }))
+	require.Equal(t, len(groups), n)
+	require.Equal(t, expected, sink.Values())
+
+	//ingested files are skipped
+	require.Nil(t, Ingest(context.Background(), dir, sink, nil))
+	require.Equal(t, len(groups), sink.IngestCalls)
+	matches, err := filepath.Glob(filepath.Join(dir, "*"+ingestedSuffix))
+	require.Nil(t, err)
+	require.True(t, len(matches) >= len(groups))
+}
+
+func TestParseTableFileName(t *testing.T) {
+	run, partID, err := parseTableFileName("000003-12-0001.sst")
+	require.Nil(t, err)
+	require.Equal(t, 3, run)
+	require.Equal(t, uint64(12), partID)
+
+	for _, name := range []string{"000003-12.sst", "a-12-0001.sst", "000003-12-0001.sst.ingested"} {
+		_, _, err = parseTableFileName(name)
+		require.NotNil(t, err, name)
+	}
+}
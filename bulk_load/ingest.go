@@ -0,0 +1,128 @@
+package bulk_load
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+//ingestedSuffix is appended to the name of table files which have been ingested
+const ingestedSuffix = ".ingested"
+
+//Sink is implemented by autumn_clientv1.AutumnLib
+type Sink interface {
+	IngestTables(ctx context.Context, partID uint64, tables []io.Reader) (uint64, error)
+}
+
+//Group is table files of one run and one partition, they are ingested together
+type Group struct {
+	Run    int
+	PartID uint64
+	Paths  []string
+}
+
+//parseTableFileName parses <run>-<partID>-<n>.sst
+func parseTableFileName(name string) (int, uint64, error) {
+	parts := strings.Split(strings.TrimSuffix(name, tableFileSuffix), "-")
+	if !strings.HasSuffix(name, tableFileSuffix) || len(parts) != 3 {
+		return 0, 0, errors.Errorf("%s is not a table file", name)
+	}
+	run, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, err
+	}
+	partID, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	return run, partID, nil
+}
+
+//ListGroups returns groups of table files in dir which have not been ingested, in the order of ingestion
+func ListGroups(dir string) ([]*Group, error) {
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	type groupID struct {
+		run    int
+		partID uint64
+	}
+	groups := make(map[groupID]*Group)
+	for _, info := range infos {
+		if info.IsDir() || !strings.HasSuffix(info.Name(), tableFileSuffix) {
+			continue
+		}
+		run, partID, err := parseTableFileName(info.Name())
+		if err != nil {
+			return nil, err
+		}
+		id := groupID{run, partID}
+		if groups[id] == nil {
+			groups[id] = &Group{Run: run, PartID: partID}
+		}
+		groups[id].Paths = append(groups[id].Paths, filepath.Join(dir, info.Name()))
+	}
+
+	ret := make([]*Group, 0, len(groups))
+	for _, g := range groups {
+		sort.Strings(g.Paths)
+		ret = append(ret, g)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Run != ret[j].Run {
+			return ret[i].Run < ret[j].Run
+		}
+		return ret[i].PartID < ret[j].PartID
+	})
+	return ret, nil
+}
+
+//ingestGroup sends tables of g to sink, files are renamed after they are ingested, so an interrupted
+//ingestion could be run again
+func ingestGroup(ctx context.Context, g *Group, sink Sink) (uint64, error) {
+	readers := make([]io.Reader, len(g.Paths))
+	for i, path := range g.Paths {
+		f, err := os.Open(path)
+		if err != nil {
+			return 0, err
+		}
+		defer f.Close()
+		readers[i] = f
+	}
+	seq, err := sink.IngestTables(ctx, g.PartID, readers)
+	if err != nil {
+		return 0, errors.Wrapf(err, "ingest run %d of partition %d", g.Run, g.PartID)
+	}
+	for _, path := range g.Paths {
+		if err = os.Rename(path, path+ingestedSuffix); err != nil {
+			return 0, err
+		}
+	}
+	return seq, nil
+}
+
+//Ingest ingests table files in dir group by group, done is called after each group
+func Ingest(ctx context.Context, dir string, sink Sink, done func(g *Group, seq uint64)) error {
+	groups, err := ListGroups(dir)
+	if err != nil {
+		return err
+	}
+	for _, g := range groups {
+		seq, err := ingestGroup(ctx, g, sink)
+		if err != nil {
+			return err
+		}
+		if done != nil {
+			done(g, seq)
+		}
+	}
+	return nil
+}
@@ -14,10 +14,12 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	"encoding/binary"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"math"
 	"math/rand"
@@ -35,12 +37,22 @@ import (
 
 	"github.com/BurntSushi/toml"
 	"github.com/journeymidnight/autumn/autumn_clientv1"
+	"github.com/journeymidnight/autumn/bulk_load"
+	"github.com/journeymidnight/autumn/conn"
 	"github.com/journeymidnight/autumn/etcd_utils"
+	"github.com/journeymidnight/autumn/geo_replication"
+	"github.com/journeymidnight/autumn/kv_archive"
 	"github.com/journeymidnight/autumn/manager/smclient"
 	"github.com/journeymidnight/autumn/manager/stream_manager"
+	"github.com/journeymidnight/autumn/meta_backup"
 	"github.com/journeymidnight/autumn/node"
+	"github.com/journeymidnight/autumn/partition_server"
+	"github.com/journeymidnight/autumn/proto/pb"
 	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/journeymidnight/autumn/range_partition/table"
+	"github.com/journeymidnight/autumn/secondary_index"
 	"github.com/journeymidnight/autumn/utils"
+	"github.com/journeymidnight/autumn/wire_errors"
 	"github.com/journeymidnight/autumn/xlog"
 	_ "github.com/journeymidnight/autumn/xlog"
 	"github.com/pkg/errors"
@@ -346,6 +358,130 @@ func bootstrap(c *cli.Context) error {
 	return nil
 }
 
+func setTenant(c *cli.Context) error {
+	etcdUrls := utils.SplitAndTrim(c.String("etcd-urls"), ",")
+	tenant := c.Args().First()
+	if len(tenant) == 0 {
+		return errors.New("no tenant")
+	}
+
+	limit := pspb.TenantLimit{
+		Tenant:      tenant,
+		OpsPerSec:   c.Uint64("ops"),
+		BytesPerSec: c.Uint64("bytes"),
+	}
+	//prefix=maxBytes
+	for _, q := range c.StringSlice("quota") {
+		i := strings.LastIndex(q, "=")
+		if i < 0 {
+			return errors.Errorf("quota %s should be prefix=maxBytes", q)
+		}
+		maxBytes, err := strconv.ParseUint(q[i+1:], 10, 64)
+		if err != nil {
+			return errors.Errorf("quota %s should be prefix=maxBytes", q)
+		}
+		limit.Quotas = append(limit.Quotas, &pspb.PrefixQuota{
+			Prefix:   []byte(q[:i]),
+			MaxBytes: maxBytes,
+		})
+	}
+
+	etcdClient, err := clientv3.New(clientv3.Config{
+		Endpoints:   etcdUrls,
+		DialTimeout: time.Second,
+	})
+	if err != nil {
+		return err
+	}
+	defer etcdClient.Close()
+
+	if err = etcd_utils.EtcdSetKV(etcdClient, partition_server.FormatTenantKey(tenant), utils.MustMarshal(&limit)); err != nil {
+		return err
+	}
+	fmt.Printf("tenant %s: %+v\n", tenant, limit)
+	return nil
+}
+
+func createIndex(c *cli.Context) error {
+	etcdUrls := utils.SplitAndTrim(c.String("etcd-urls"), ",")
+	info := pspb.IndexInfo{
+		Name:      c.Args().First(),
+		KeyPrefix: []byte(c.String("prefix")),
+	}
+	if len(c.String("json-path")) > 0 {
+		info.Field = &pspb.IndexInfo_JsonPath{JsonPath: c.String("json-path")}
+	} else if c.Uint("length") > 0 {
+		info.Field = &pspb.IndexInfo_ByteRange{ByteRange: &pspb.ByteRange{
+			Offset: uint32(c.Uint("offset")),
+			Length: uint32(c.Uint("length")),
+		}}
+	}
+	if err := secondary_index.ValidIndexInfo(&info); err != nil {
+		return err
+	}
+
+	etcdClient, err := clientv3.New(clientv3.Config{
+		Endpoints:   etcdUrls,
+		DialTimeout: time.Second,
+	})
+	if err != nil {
+		return err
+	}
+	defer etcdClient.Close()
+
+	//index is immutable, drop it and create a new one if field changed
+	key := secondary_index.FormatIndexInfoKey(info.Name)
+	err = etcd_utils.EtcdSetKVS(etcdClient, []clientv3.Cmp{
+		clientv3.Compare(clientv3.CreateRevision(key), "=", 0),
+	}, []clientv3.Op{
+		clientv3.OpPut(key, string(utils.MustMarshal(&info))),
+	})
+	if err != nil {
+		return errors.Errorf("create index %s failed: %v", info.Name, err)
+	}
+	fmt.Printf("index %s created, existing keys will be indexed by index checker\n", info.Name)
+	return nil
+}
+
+func indexRange(c *cli.Context) error {
+	client, err := connectToAutumn(c)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	index := c.Args().First()
+	if len(index) == 0 {
+		return errors.New("no index")
+	}
+	out, _, err := client.IndexRange(context.Background(), index, []byte(c.String("value")), c.Bool("exact"), uint32(c.Int("limit")))
+	if err != nil {
+		return err
+	}
+	for i := range out {
+		fmt.Printf("%s\t%s\n", out[i].Value, out[i].Key)
+	}
+	return nil
+}
+
+func watch(c *cli.Context) error {
+	client, err := connectToAutumn(c)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	prefix := c.Args().First()
+	fromSeq := c.Uint64("from-seq")
+	return client.Watch(context.Background(), []byte(prefix), fromSeq, fromSeq == 0, func(res *pspb.WatchResponse) error {
+		for _, e := range res.Events {
+			fmt.Printf("%d\t%s\t%s\n", e.Seq, e.Type, e.Key)
+		}
+		fmt.Printf("checkpoint %d\n", res.Checkpoint)
+		return nil
+	})
+}
+
 func del(c *cli.Context) error {
 	client, err := connectToAutumn(c)
 	if err != nil {
@@ -456,153 +592,839 @@ func streamPut(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
-	fileSize := info.Size()
+	fileSize := info.Size()
+	f, err := os.Open(fileName)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return client.StreamPut(context.Background(), []byte(key), f, uint32(fileSize))
+}
+
+func put(c *cli.Context) error {
+	client, err := connectToAutumn(c)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	key := c.Args().First()
+	if len(key) == 0 {
+		return errors.New("no key")
+	}
+	fileName := c.Args().Get(1)
+	if len(fileName) == 0 {
+		return errors.New("no fileName")
+	}
+	value, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return errors.Errorf("read file %s: err: %s", fileName, err.Error())
+	}
+	if err := client.Put(context.Background(), []byte(key), value); err != nil {
+		return errors.Errorf(("put key:%s failed: reason:%s"), key, err)
+	}
+	fmt.Println("success")
+	return nil
+}
+
+func gc(c *cli.Context) error {
+	client, err := connectToAutumn(c)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	partIDString := c.Args().First()
+
+	if len(partIDString) == 0 {
+		return errors.New("partID is nil")
+
+	}
+	partID, err := strconv.ParseUint(partIDString, 10, 64)
+	if err != nil {
+		return errors.Errorf("partID is not int: %s", partIDString)
+	}
+	return client.Maintenance(context.Background(), partID, autumn_clientv1.AutoGCTask{})
+}
+
+func forcegc(c *cli.Context) error {
+	client, err := connectToAutumn(c)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	partIDString := c.Args().First()
+
+	if len(partIDString) == 0 {
+		return errors.New("partID is nil")
+
+	}
+	partID, err := strconv.ParseUint(partIDString, 10, 64)
+	if err != nil {
+		return errors.Errorf("partID is not int: %s", partIDString)
+	}
+
+	tail := c.Args().Tail()
+
+	if len(tail) == 0 {
+		return errors.New("must have at least one extent id")
+	}
+	intTails := make([]uint64, 0, len(tail))
+	var exID uint64
+	for _, t := range tail {
+		exID, err = strconv.ParseUint(t, 10, 64)
+		if err != nil {
+			return errors.Errorf("exID is not int: %s", t)
+		}
+		intTails = append(intTails, exID)
+	}
+
+	return client.Maintenance(context.Background(), partID, autumn_clientv1.ForceGCTask{ExIDs: intTails})
+}
+
+func compact(c *cli.Context) error {
+	client, err := connectToAutumn(c)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	partIDString := c.Args().First()
+
+	if len(partIDString) == 0 {
+		return errors.New("partID is nil")
+
+	}
+	partID, err := strconv.ParseUint(partIDString, 10, 64)
+	if err != nil {
+		return errors.Errorf("partID is not int: %s", partIDString)
+	}
+	return client.Maintenance(context.Background(), partID, autumn_clientv1.CompactTask{})
+}
+
+func splitPartition(c *cli.Context) error {
+	client, err := connectToAutumn(c)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	partIDString := c.Args().First()
+
+	if len(partIDString) == 0 {
+		return errors.New("partID is nil")
+
+	}
+	partID, err := strconv.ParseUint(partIDString, 10, 64)
+	if err != nil {
+		return errors.Errorf("partID is not int: %s", partIDString)
+	}
+	return client.SplitPart(context.Background(), partID)
+}
+
+func parseID(c *cli.Context, name string) (uint64, error) {
+	s := c.Args().First()
+	if len(s) == 0 {
+		return 0, errors.Errorf("%s is nil", name)
+	}
+	id, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, errors.Errorf("%s is not int: %s", name, s)
+	}
+	return id, nil
+}
+
+func printSnapshot(snapshot *pspb.PartitionSnapshot) {
+	fmt.Printf("snapshot %d: partition %d, range [%s, %s), created at %s, streams log %d row %d meta %d\n",
+		snapshot.SnapshotID, snapshot.PartID, snapshot.Rg.StartKey, snapshot.Rg.EndKey,
+		time.Unix(snapshot.CreatedAt, 0).Format(time.RFC3339),
+		snapshot.LogStream, snapshot.RowStream, snapshot.MetaStream)
+}
+
+func createSnapshot(c *cli.Context) error {
+	partID, err := parseID(c, "partID")
+	if err != nil {
+		return err
+	}
+	client, err := connectToAutumn(c)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	snapshot, err := client.SnapshotPartition(context.Background(), partID)
+	if err != nil {
+		return err
+	}
+	printSnapshot(snapshot)
+	return nil
+}
+
+func listSnapshots(c *cli.Context) error {
+	client, err := connectToAutumn(c)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	snapshots, err := client.Snapshots()
+	if err != nil {
+		return err
+	}
+	for _, snapshot := range snapshots {
+		printSnapshot(snapshot)
+	}
+	return nil
+}
+
+func getSnapshot(c *cli.Context) error {
+	snapshotID, err := parseID(c, "snapshotID")
+	if err != nil {
+		return err
+	}
+	key := c.Args().Get(1)
+	if len(key) == 0 {
+		return errors.New("key is nil")
+	}
+	client, err := connectToAutumn(c)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	value, err := client.GetSnapshot(context.Background(), snapshotID, []byte(key))
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%s\n", value)
+	return nil
+}
+
+func restoreSnapshot(c *cli.Context) error {
+	snapshotID, err := parseID(c, "snapshotID")
+	if err != nil {
+		return err
+	}
+	client, err := connectToAutumn(c)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	return client.RestorePartition(context.Background(), snapshotID)
+}
+
+func deleteSnapshot(c *cli.Context) error {
+	snapshotID, err := parseID(c, "snapshotID")
+	if err != nil {
+		return err
+	}
+	client := smclient.NewSMClient(utils.SplitAndTrim(c.String("sm-urls"), ","))
+	if err := client.Connect(); err != nil {
+		return err
+	}
+	defer client.Close()
+	return client.DeleteSnapshot(context.Background(), snapshotID)
+}
+
+func info(c *cli.Context) error {
+	smUrls := utils.SplitAndTrim(c.String("sm-urls"), ",")
+	client := smclient.NewSMClient(smUrls)
+	if err := client.Connect(); err != nil {
+		return err
+	}
+	streams, extents, err := client.StreamInfo(context.Background(), nil)
+	if err != nil {
+		return err
+	}
+
+	nodes, err := client.NodesInfo(context.Background())
+	if err != nil {
+		return err
+	}
+	progress, err := client.DecommissionProgress(context.Background())
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%v\n", streams)
+	fmt.Printf("%v\n", extents)
+	fmt.Printf("%v\n", nodes)
+	for nodeID, p := range progress {
+		fmt.Printf("node %d is decommissioning: %d extents remaining, %d being copied, %d waiting for sealing\n",
+			nodeID, p.Remaining, p.Running, p.Unsealed)
+	}
+	return nil
+}
+
+func decommissionNode(c *cli.Context) error {
+	smUrls := utils.SplitAndTrim(c.String("sm-urls"), ",")
+	client := smclient.NewSMClient(smUrls)
+	if err := client.Connect(); err != nil {
+		return err
+	}
+	if c.Args().Len() != 1 {
+		return errors.New("decommission <nodeID>")
+	}
+	nodeID, err := strconv.ParseUint(c.Args().First(), 10, 64)
+	if err != nil {
+		return errors.Errorf("invalid nodeID %s", c.Args().First())
+	}
+	if err = client.DecommissionNode(context.Background(), nodeID, c.Bool("cancel")); err != nil {
+		return err
+	}
+	if c.Bool("cancel") {
+		fmt.Printf("decommissioning of node %d is cancelled\n", nodeID)
+	} else {
+		fmt.Printf("node %d is decommissioning, run \"info\" to see the progress\n", nodeID)
+	}
+	return nil
+}
+
+func setECPolicy(c *cli.Context) error {
+	smUrls := utils.SplitAndTrim(c.String("sm-urls"), ",")
+	client := smclient.NewSMClient(smUrls)
+	if err := client.Connect(); err != nil {
+		return err
+	}
+	streamID, err := strconv.ParseUint(c.Args().First(), 10, 64)
+	if err != nil {
+		return errors.Errorf("invalid streamID %s", c.Args().First())
+	}
+	var policy *pb.ECPolicy
+	if !c.Bool("disable") {
+		r, s, err := utils.ParseReplicationString(c.String("replication"))
+		if err != nil {
+			return err
+		}
+		policy = &pb.ECPolicy{
+			DataShard:   uint32(r),
+			ParityShard: uint32(s),
+			MinAge:      int64(c.Duration("min-age").Seconds()),
+		}
+		if groups := c.Int("lrc-groups"); groups > 0 {
+			policy.Codec = &pb.ErasureCodec{Type: pb.CodecType_LRC, LocalGroups: uint32(groups)}
+		}
+	}
+	if err = client.SetECPolicy(context.Background(), streamID, policy); err != nil {
+		return err
+	}
+	fmt.Printf("EC policy of stream %d is %v\n", streamID, policy)
+	return nil
+}
+
+func ecStatus(c *cli.Context) error {
+	smUrls := utils.SplitAndTrim(c.String("sm-urls"), ",")
+	client := smclient.NewSMClient(smUrls)
+	if err := client.Connect(); err != nil {
+		return err
+	}
+	var streamID uint64
+	if c.Args().Len() > 0 {
+		var err error
+		if streamID, err = strconv.ParseUint(c.Args().First(), 10, 64); err != nil {
+			return errors.Errorf("invalid streamID %s", c.Args().First())
+		}
+	}
+	progress, err := client.ECConversionStatus(context.Background(), streamID)
+	if err != nil {
+		return err
+	}
+	for _, p := range progress {
+		fmt.Printf("stream %d, policy %v: converted %d (%s), running %d, pending %d (%s)\n",
+			p.StreamID, p.Policy, p.Converted, utils.HumanReadableSize(p.ConvertedBytes),
+			p.Running, p.Pending, utils.HumanReadableSize(p.PendingBytes))
+		if len(p.LastError) > 0 {
+			fmt.Printf("    last error: %s\n", p.LastError)
+		}
+	}
+	return nil
+}
+
+func verifyExtent(c *cli.Context) error {
+	smUrls := utils.SplitAndTrim(c.String("sm-urls"), ",")
+	client := smclient.NewSMClient(smUrls)
+	if err := client.Connect(); err != nil {
+		return err
+	}
+	if c.Args().Len() != 1 {
+		return errors.New("verify <extentID>")
+	}
+	extentID, err := strconv.ParseUint(c.Args().First(), 10, 64)
+	if err != nil {
+		return errors.Errorf("invalid extentID %s", c.Args().First())
+	}
+	res, err := client.VerifyExtent(context.Background(), extentID)
+	if err != nil {
+		return err
+	}
+	nodes := append(append([]uint64{}, res.Extent.Replicates...), res.Extent.Parity...)
+	for i, sum := range res.Checksums {
+		status := "ok"
+		if sum < 0 {
+			status = "no response"
+		} else if res.Corrupted&(1<<i) > 0 {
+			status = "corrupted"
+		}
+		fmt.Printf("copy %d on node %d: checksum %x, %s\n", i, nodes[i], sum, status)
+	}
+	return nil
+}
+
+//connectNode connects to the node of the first argument
+func connectNode(c *cli.Context) (pb.ExtentServiceClient, error) {
+	smUrls := utils.SplitAndTrim(c.String("sm-urls"), ",")
+	client := smclient.NewSMClient(smUrls)
+	if err := client.Connect(); err != nil {
+		return nil, err
+	}
+	nodeID, err := strconv.ParseUint(c.Args().First(), 10, 64)
+	if err != nil {
+		return nil, errors.Errorf("invalid nodeID %s", c.Args().First())
+	}
+	nodes, err := client.NodesInfo(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	nodeInfo, ok := nodes[nodeID]
+	if !ok {
+		return nil, errors.Errorf("no such node %d", nodeID)
+	}
+	pool := conn.GetPools().Connect(nodeInfo.Address)
+	if pool == nil {
+		return nil, errors.Errorf("can not connect to %s", nodeInfo.Address)
+	}
+	return pb.NewExtentServiceClient(pool.Get()), nil
+}
+
+func reconcileNode(c *cli.Context) error {
+	if c.Args().Len() != 1 {
+		return errors.New("reconcile <nodeID>")
+	}
+	client, err := connectNode(c)
+	if err != nil {
+		return err
+	}
+	res, err := client.Reconcile(context.Background(), &pb.ReconcileRequest{
+		DryRun: c.Bool("dry-run"),
+	})
+	if err != nil {
+		return err
+	}
+	if res.Code != pb.Code_OK {
+		return wire_errors.FromPBCode(res.Code, res.CodeDes)
+	}
+	action := "quarantined"
+	if c.Bool("dry-run") {
+		action = "to be quarantined"
+	}
+	fmt.Printf("orphan extents, %s: %v\n", action, res.Orphans)
+	for _, sc := range res.StaleCopies {
+		fmt.Printf("stale copy of extent %d replacing node %d on disk %d, %s\n", sc.ExtentID, sc.ReplaceID, sc.DiskID, action)
+	}
+	fmt.Printf("missing extents: %v\n", res.Missing)
+	fmt.Printf("mismatched extents: %v\n", res.Mismatched)
+	return nil
+}
+
+func walStats(c *cli.Context) error {
+	if c.Args().Len() != 1 {
+		return errors.New("walstats <nodeID>")
+	}
+	client, err := connectNode(c)
+	if err != nil {
+		return err
+	}
+	res, err := client.WalStats(context.Background(), &pb.WalStatsRequest{})
+	if err != nil {
+		return err
+	}
+	if res.Code != pb.Code_OK {
+		return wire_errors.FromPBCode(res.Code, res.CodeDes)
+	}
+	if len(res.Dirs) == 0 {
+		fmt.Printf("wal is disabled\n")
+		return nil
+	}
+	for _, stats := range res.Dirs {
+		var avgSize, avgLatency float64
+		if stats.Commits > 0 {
+			avgSize = float64(stats.Entries) / float64(stats.Commits)
+			avgLatency = float64(stats.Latency) / float64(stats.Commits)
+		}
+		fmt.Printf("%s: commits %d, entries %d, bytes %s, avg group size %.2f, avg latency %v, max latency %v\n",
+			stats.Dir, stats.Commits, stats.Entries, utils.HumanReadableSize(stats.Bytes), avgSize,
+			time.Duration(avgLatency), time.Duration(stats.MaxLatency))
+	}
+	fmt.Printf("writes bypassed wal: %d\n", res.Bypassed)
+	return nil
+}
+
+func backupMeta(c *cli.Context) error {
+	etcdUrls := utils.SplitAndTrim(c.String("etcd-urls"), ",")
+	if c.Args().Len() != 1 {
+		return errors.New("meta backup <FILE>")
+	}
+	etcdClient, err := clientv3.New(clientv3.Config{
+		Endpoints:   etcdUrls,
+		DialTimeout: time.Second,
+	})
+	if err != nil {
+		return err
+	}
+	defer etcdClient.Close()
+
+	backup, err := meta_backup.Backup(etcdClient)
+	if err != nil {
+		return err
+	}
+	//write to a temp file, so an existing backup is not broken
+	fileName := c.Args().First()
+	f, err := os.Create(fileName + ".tmp")
+	if err != nil {
+		return err
+	}
+	if err = meta_backup.Write(f, backup); err == nil {
+		err = f.Sync()
+	}
+	f.Close()
+	if err != nil {
+		os.Remove(fileName + ".tmp")
+		return err
+	}
+	if err = os.Rename(fileName+".tmp", fileName); err != nil {
+		return err
+	}
+	fmt.Printf("%d keys at revision %d are saved to %s\n", len(backup.Kvs), backup.Revision, fileName)
+	return nil
+}
+
+func restoreMeta(c *cli.Context) error {
+	etcdUrls := utils.SplitAndTrim(c.String("etcd-urls"), ",")
+	if c.Args().Len() != 1 {
+		return errors.New("meta restore <FILE>")
+	}
+	f, err := os.Open(c.Args().First())
+	if err != nil {
+		return err
+	}
+	backup, err := meta_backup.Read(f)
+	f.Close()
+	if err != nil {
+		return err
+	}
+	if err = meta_backup.Validate(backup); err != nil {
+		return err
+	}
+	fmt.Printf("backup of %d keys at revision %d, created at %s\n", len(backup.Kvs), backup.Revision,
+		time.Unix(backup.CreatedTime, 0).Format(time.RFC3339))
+
+	etcdClient, err := clientv3.New(clientv3.Config{
+		Endpoints:   etcdUrls,
+		DialTimeout: time.Second,
+	})
+	if err != nil {
+		return err
+	}
+	defer etcdClient.Close()
+
+	var diff *meta_backup.Diff
+	if c.Bool("diff") {
+		diff, err = meta_backup.Compare(etcdClient, backup)
+	} else {
+		diff, err = meta_backup.Restore(etcdClient, backup, c.Bool("force"))
+	}
+	if err != nil {
+		return err
+	}
+	for _, key := range diff.Added {
+		fmt.Printf("+ %s\n", key)
+	}
+	for _, key := range diff.Removed {
+		fmt.Printf("- %s\n", key)
+	}
+	for _, key := range diff.Changed {
+		fmt.Printf("~ %s\n", key)
+	}
+	if c.Bool("diff") {
+		fmt.Printf("%d keys to add, %d to remove, %d to change\n", len(diff.Added), len(diff.Removed), len(diff.Changed))
+	} else {
+		fmt.Printf("restored: %d keys added, %d removed, %d changed\n", len(diff.Added), len(diff.Removed), len(diff.Changed))
+	}
+	return nil
+}
+
+func exportKeys(c *cli.Context) error {
+	etcdUrls := utils.SplitAndTrim(c.String("etcd-urls"), ",")
+	if c.Args().Len() != 1 {
+		return errors.New("export <FILE>")
+	}
+	fileName := c.Args().First()
+	prefix := []byte(c.String("prefix"))
+
+	var base *pspb.ArchiveState
+	var err error
+	if len(c.String("base")) > 0 {
+		if base, err = kv_archive.ReadState(c.String("base")); err != nil {
+			return errors.Wrapf(err, "read base archive %s", c.String("base"))
+		}
+		if !bytes.Equal(base.Prefix, prefix) {
+			return errors.Errorf("prefix of base archive is %q", base.Prefix)
+		}
+	}
+
+	var w *kv_archive.Writer
+	var state *pspb.ArchiveState
+	if c.Bool("resume") {
+		if w, state, err = kv_archive.Resume(fileName); err != nil {
+			return err
+		}
+		if state == nil {
+			//interrupted before the first chunk
+			fmt.Printf("no checkpoint in %s, export from the beginning\n", fileName)
+		} else if !bytes.Equal(state.Prefix, prefix) {
+			w.Close()
+			return errors.Errorf("prefix of %s is %q", fileName, state.Prefix)
+		}
+	} else {
+		compression := uint32(kv_archive.CompressionNone)
+		if c.Bool("compress") {
+			compression = kv_archive.CompressionZstd
+		}
+		if w, err = kv_archive.Create(fileName, compression); err != nil {
+			return err
+		}
+	}
+	defer w.Close()
+
+	if state == nil {
+		state = kv_archive.NewState(prefix, base)
+	}
+
+	client := autumn_clientv1.NewAutumnLib(etcdUrls)
+	if err = client.Connect(); err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if err = kv_archive.Export(context.Background(), client, w, state, kv_archive.ExportOptions{}); err != nil {
+		return errors.Wrapf(err, "export is interrupted after %d keys, run export --resume to continue", state.Count)
+	}
+	fmt.Printf("%d keys of %d partitions are exported to %s\n", state.Count, len(state.DonePartitions), fileName)
+	return nil
+}
+
+func importKeys(c *cli.Context) error {
+	etcdUrls := utils.SplitAndTrim(c.String("etcd-urls"), ",")
+	if c.Args().Len() != 1 {
+		return errors.New("import <FILE>")
+	}
+	fileName := c.Args().First()
+	//do not import a part of archive
+	if _, err := kv_archive.ReadState(fileName); err != nil {
+		return err
+	}
+	f, err := os.Open(fileName)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	r, err := kv_archive.NewReader(f)
+	if err != nil {
+		return err
+	}
+
+	client := autumn_clientv1.NewAutumnLib(etcdUrls)
+	if err = client.Connect(); err != nil {
+		return err
+	}
+	defer client.Close()
+
+	start := time.Now()
+	state, err := kv_archive.Import(context.Background(), r, client, kv_archive.ImportOptions{
+		Parallel:    c.Int("parallel"),
+		OpsPerSec:   c.Int("ops-limit"),
+		BytesPerSec: c.Int("bytes-limit"),
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%d keys are imported from %s in %v\n", state.Count, fileName, time.Since(start))
+	return nil
+}
+
+//buildTables builds table files from a key archive for the regions of cluster
+func buildTables(c *cli.Context) error {
+	etcdUrls := utils.SplitAndTrim(c.String("etcd-urls"), ",")
+	if c.Args().Len() != 1 || len(c.String("output")) == 0 {
+		return errors.New("bulk build --output <DIR> <ARCHIVE>")
+	}
+	fileName := c.Args().First()
+	if _, err := kv_archive.ReadState(fileName); err != nil {
+		return err
+	}
 	f, err := os.Open(fileName)
 	if err != nil {
 		return err
 	}
 	defer f.Close()
-	return client.StreamPut(context.Background(), []byte(key), f, uint32(fileSize))
-}
-
-func put(c *cli.Context) error {
-	client, err := connectToAutumn(c)
+	r, err := kv_archive.NewReader(f)
 	if err != nil {
 		return err
 	}
-	defer client.Close()
 
-	key := c.Args().First()
-	if len(key) == 0 {
-		return errors.New("no key")
+	client := autumn_clientv1.NewAutumnLib(etcdUrls)
+	if err = client.Connect(); err != nil {
+		return err
 	}
-	fileName := c.Args().Get(1)
-	if len(fileName) == 0 {
-		return errors.New("no fileName")
+	regions := client.Regions()
+	client.Close()
+
+	compression := table.None
+	if c.Bool("compress") {
+		compression = table.Snappy
 	}
-	value, err := ioutil.ReadFile(fileName)
+	b, err := bulk_load.NewBuilder(c.String("output"), regions, bulk_load.Options{
+		TableSize:   c.Int("table-size"),
+		RunSize:     c.Int("run-size"),
+		Compression: compression,
+	})
 	if err != nil {
-		return errors.Errorf("read file %s: err: %s", fileName, err.Error())
+		return err
 	}
-	if err := client.Put(context.Background(), []byte(key), value); err != nil {
-		return errors.Errorf(("put key:%s failed: reason:%s"), key, err)
+	count := 0
+	for {
+		chunk, err := r.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+		for _, entry := range chunk.Entries {
+			if entry.Deleted {
+				err = b.Delete(entry.Key)
+			} else {
+				err = b.Add(entry.Key, entry.Value)
+			}
+			if err != nil {
+				return err
+			}
+			count++
+		}
 	}
-	fmt.Println("success")
+	if err = b.Finish(); err != nil {
+		return err
+	}
+	fmt.Printf("%d keys are written to %s\n", count, c.String("output"))
 	return nil
 }
 
-func gc(c *cli.Context) error {
-	client, err := connectToAutumn(c)
-	if err != nil {
-		return err
+//connectCluster connects AutumnLib and etcd of a cluster, and returns its cluster id
+func connectCluster(urls string) (*autumn_clientv1.AutumnLib, *clientv3.Client, uint64, error) {
+	etcdUrls := utils.SplitAndTrim(urls, ",")
+	lib := autumn_clientv1.NewAutumnLib(etcdUrls)
+	if err := lib.Connect(); err != nil {
+		return nil, nil, 0, err
 	}
-	defer client.Close()
-	partIDString := c.Args().First()
-
-	if len(partIDString) == 0 {
-		return errors.New("partID is nil")
-
+	clusterID, err := lib.ClusterID()
+	if err != nil {
+		lib.Close()
+		return nil, nil, 0, err
 	}
-	partID, err := strconv.ParseUint(partIDString, 10, 64)
+	etcdClient, err := clientv3.New(clientv3.Config{
+		Endpoints:   etcdUrls,
+		DialTimeout: time.Second,
+	})
 	if err != nil {
-		return errors.Errorf("partID is not int: %s", partIDString)
+		lib.Close()
+		return nil, nil, 0, err
 	}
-	return client.Maintenance(context.Background(), partID, autumn_clientv1.AutoGCTask{})
+	return lib, etcdClient, clusterID, nil
 }
 
-func forcegc(c *cli.Context) error {
-	client, err := connectToAutumn(c)
-	if err != nil {
-		return err
+func printReplicationStatus(status []geo_replication.PartitionStatus) {
+	sort.Slice(status, func(i, j int) bool {
+		return status[i].PartID < status[j].PartID
+	})
+	for _, s := range status {
+		fmt.Printf("partition %d: applied %d, source %d, lag %d, checkpoint saved at %s\n",
+			s.PartID, s.AppliedSeq, s.SourceSeq, s.Lag(), s.UpdatedAt.Format(time.RFC3339))
 	}
-	defer client.Close()
-	partIDString := c.Args().First()
-
-	if len(partIDString) == 0 {
-		return errors.New("partID is nil")
+}
 
+func runReplication(c *cli.Context) error {
+	if c.String("target-etcd-urls") == "" {
+		return errors.New("replicate run --etcd-urls <addrs> --target-etcd-urls <addrs>")
 	}
-	partID, err := strconv.ParseUint(partIDString, 10, 64)
+	src, srcEtcd, srcID, err := connectCluster(c.String("etcd-urls"))
 	if err != nil {
-		return errors.Errorf("partID is not int: %s", partIDString)
-	}
-
-	tail := c.Args().Tail()
-
-	if len(tail) == 0 {
-		return errors.New("must have at least one extent id")
+		return err
 	}
-	intTails := make([]uint64, 0, len(tail))
-	var exID uint64
-	for _, t := range tail {
-		exID, err = strconv.ParseUint(t, 10, 64)
-		if err != nil {
-			return errors.Errorf("exID is not int: %s", t)
-		}
-		intTails = append(intTails, exID)
+	defer src.Close()
+	defer srcEtcd.Close()
+	dst, dstEtcd, dstID, err := connectCluster(c.String("target-etcd-urls"))
+	if err != nil {
+		return err
 	}
+	defer dst.Close()
+	defer dstEtcd.Close()
 
-	return client.Maintenance(context.Background(), partID, autumn_clientv1.ForceGCTask{ExIDs: intTails})
-}
-
-func compact(c *cli.Context) error {
-	client, err := connectToAutumn(c)
+	cps := geo_replication.NewEtcdCheckpoints(srcEtcd, c.String("name"))
+	r, err := geo_replication.New(src, dst, cps, geo_replication.Options{
+		SourceClusterID: srcID,
+		TargetClusterID: dstID,
+	})
 	if err != nil {
 		return err
 	}
-	defer client.Close()
-	partIDString := c.Args().First()
+	fmt.Printf("replicating cluster %d to cluster %d\n", srcID, dstID)
 
-	if len(partIDString) == 0 {
-		return errors.New("partID is nil")
-
-	}
-	partID, err := strconv.ParseUint(partIDString, 10, 64)
-	if err != nil {
-		return errors.Errorf("partID is not int: %s", partIDString)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	signalCh := make(chan os.Signal, 1)
+	signal.Notify(signalCh, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		ticker := time.NewTicker(10 * time.Second)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-signalCh:
+				cancel()
+				return
+			case <-ticker.C:
+				printReplicationStatus(r.Status())
+			}
+		}
+	}()
+	if err = r.Run(ctx); err != context.Canceled {
+		return err
 	}
-	return client.Maintenance(context.Background(), partID, autumn_clientv1.CompactTask{})
+	return nil
 }
 
-func splitPartition(c *cli.Context) error {
-	client, err := connectToAutumn(c)
+func replicationStatus(c *cli.Context) error {
+	src, srcEtcd, _, err := connectCluster(c.String("etcd-urls"))
 	if err != nil {
 		return err
 	}
-	defer client.Close()
-
-	partIDString := c.Args().First()
-
-	if len(partIDString) == 0 {
-		return errors.New("partID is nil")
+	defer src.Close()
+	defer srcEtcd.Close()
 
-	}
-	partID, err := strconv.ParseUint(partIDString, 10, 64)
+	status, err := geo_replication.Lag(context.Background(), src, geo_replication.NewEtcdCheckpoints(srcEtcd, c.String("name")))
 	if err != nil {
-		return errors.Errorf("partID is not int: %s", partIDString)
+		return err
 	}
-	return client.SplitPart(context.Background(), partID)
+	printReplicationStatus(status)
+	return nil
 }
 
-func info(c *cli.Context) error {
-	smUrls := utils.SplitAndTrim(c.String("sm-urls"), ",")
-	client := smclient.NewSMClient(smUrls)
-	if err := client.Connect(); err != nil {
-		return err
+func ingestTables(c *cli.Context) error {
+	etcdUrls := utils.SplitAndTrim(c.String("etcd-urls"), ",")
+	if c.Args().Len() != 1 {
+		return errors.New("bulk ingest <DIR>")
 	}
-	streams, extents, err := client.StreamInfo(context.Background(), nil)
-	if err != nil {
+	client := autumn_clientv1.NewAutumnLib(etcdUrls)
+	if err := client.Connect(); err != nil {
 		return err
 	}
+	defer client.Close()
 
-	nodes, err := client.NodesInfo(context.Background())
-	if err != nil {
-		return err
-	}
-	fmt.Printf("%v\n", streams)
-	fmt.Printf("%v\n", extents)
-	fmt.Printf("%v\n", nodes)
-	return nil
+	return bulk_load.Ingest(context.Background(), c.Args().First(), client, func(g *bulk_load.Group, seq uint64) {
+		fmt.Printf("run %d: %d tables are ingested to partition %d, seq %d\n", g.Run, len(g.Paths), g.PartID, seq)
+	})
 }
 
 func main() {
@@ -620,6 +1442,158 @@ func main() {
 			Action: info,
 		},
 
+		{
+			Name:  "ecpolicy",
+			Usage: "ecpolicy --sm-urls <addrs> [--replication 4+2 --min-age 24h --lrc-groups <N> | --disable] <streamID>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "sm-urls", Value: "127.0.0.1:3401"},
+				&cli.StringFlag{Name: "replication", Value: "4+2"},
+				&cli.DurationFlag{Name: "min-age", Value: 24 * time.Hour},
+				&cli.IntFlag{Name: "lrc-groups", Value: 0, Usage: "use LRC codec with N local groups"},
+				&cli.BoolFlag{Name: "disable", Value: false},
+			},
+			Action: setECPolicy,
+		},
+		{
+			Name:  "ecstatus",
+			Usage: "ecstatus --sm-urls <addrs> [streamID]",
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "sm-urls", Value: "127.0.0.1:3401"},
+			},
+			Action: ecStatus,
+		},
+		{
+			Name:  "verify",
+			Usage: "verify --sm-urls <addrs> <extentID>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "sm-urls", Value: "127.0.0.1:3401"},
+			},
+			Action: verifyExtent,
+		},
+		{
+			Name:  "decommission",
+			Usage: "decommission --sm-urls <addrs> [--cancel] <nodeID>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "sm-urls", Value: "127.0.0.1:3401"},
+				&cli.BoolFlag{Name: "cancel"},
+			},
+			Action: decommissionNode,
+		},
+		{
+			Name:  "reconcile",
+			Usage: "reconcile --sm-urls <addrs> [--dry-run] <nodeID>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "sm-urls", Value: "127.0.0.1:3401"},
+				&cli.BoolFlag{Name: "dry-run"},
+			},
+			Action: reconcileNode,
+		},
+		{
+			Name:  "walstats",
+			Usage: "walstats --sm-urls <addrs> <nodeID>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "sm-urls", Value: "127.0.0.1:3401"},
+			},
+			Action: walStats,
+		},
+		{
+			Name:  "export",
+			Usage: "export --etcd-urls <addrs> [--prefix <PREFIX>] [--base <BASE FILE>] [--compress] [--resume] <FILE>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "etcd-urls", Value: "127.0.0.1:2379"},
+				&cli.StringFlag{Name: "prefix"},
+				&cli.StringFlag{Name: "base", Usage: "export keys changed after the base archive"},
+				&cli.BoolFlag{Name: "compress", Usage: "compress chunks by zstd"},
+				&cli.BoolFlag{Name: "resume", Usage: "resume an interrupted export"},
+			},
+			Action: exportKeys,
+		},
+		{
+			Name:  "import",
+			Usage: "import --etcd-urls <addrs> [--parallel <N>] [--ops-limit <N>] [--bytes-limit <N>] <FILE>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "etcd-urls", Value: "127.0.0.1:2379"},
+				&cli.IntFlag{Name: "parallel", Value: 8},
+				&cli.IntFlag{Name: "ops-limit", Usage: "max writes per second, 0 for no limit"},
+				&cli.IntFlag{Name: "bytes-limit", Usage: "max bytes written per second, 0 for no limit"},
+			},
+			Action: importKeys,
+		},
+		{
+			Name:  "meta",
+			Usage: "backup or restore metadata in etcd",
+			Subcommands: []*cli.Command{
+				{
+					Name:  "backup",
+					Usage: "meta backup --etcd-urls <addrs> <FILE>",
+					Flags: []cli.Flag{
+						&cli.StringFlag{Name: "etcd-urls", Value: "127.0.0.1:2379"},
+					},
+					Action: backupMeta,
+				},
+				{
+					Name:  "restore",
+					Usage: "meta restore --etcd-urls <addrs> [--diff] [--force] <FILE>",
+					Flags: []cli.Flag{
+						&cli.StringFlag{Name: "etcd-urls", Value: "127.0.0.1:2379"},
+						&cli.BoolFlag{Name: "diff", Usage: "only show the difference between backup and cluster"},
+						&cli.BoolFlag{Name: "force", Usage: "restore even if managers or partition servers are running"},
+					},
+					Action: restoreMeta,
+				},
+			},
+		},
+		{
+			Name:  "bulk",
+			Usage: "build table files out of the cluster and ingest them",
+			Subcommands: []*cli.Command{
+				{
+					Name:  "build",
+					Usage: "bulk build --etcd-urls <addrs> --output <DIR> [--table-size N] [--run-size N] [--compress] <ARCHIVE>",
+					Flags: []cli.Flag{
+						&cli.StringFlag{Name: "etcd-urls", Value: "127.0.0.1:2379"},
+						&cli.StringFlag{Name: "output"},
+						&cli.IntFlag{Name: "table-size", Value: 64 << 20, Usage: "bytes of keys and values in a table"},
+						&cli.IntFlag{Name: "run-size", Value: 512 << 20, Usage: "bytes of keys and values sorted in memory"},
+						&cli.BoolFlag{Name: "compress", Usage: "compress blocks by snappy"},
+					},
+					Action: buildTables,
+				},
+				{
+					Name:  "ingest",
+					Usage: "bulk ingest --etcd-urls <addrs> <DIR>",
+					Flags: []cli.Flag{
+						&cli.StringFlag{Name: "etcd-urls", Value: "127.0.0.1:2379"},
+					},
+					Action: ingestTables,
+				},
+			},
+		},
+		{
+			Name:  "replicate",
+			Usage: "replicate keys to another cluster asynchronously, run in both directions for bidirectional replication",
+			Subcommands: []*cli.Command{
+				{
+					Name:  "run",
+					Usage: "replicate run --etcd-urls <addrs> --target-etcd-urls <addrs> [--name NAME]",
+					Flags: []cli.Flag{
+						&cli.StringFlag{Name: "etcd-urls", Value: "127.0.0.1:2379"},
+						&cli.StringFlag{Name: "target-etcd-urls"},
+						&cli.StringFlag{Name: "name", Value: "default", Usage: "name of replication, checkpoints are saved by name"},
+					},
+					Action: runReplication,
+				},
+				{
+					Name:  "status",
+					Usage: "replicate status --etcd-urls <addrs> [--name NAME]",
+					Flags: []cli.Flag{
+						&cli.StringFlag{Name: "etcd-urls", Value: "127.0.0.1:2379"},
+						&cli.StringFlag{Name: "name", Value: "default"},
+					},
+					Action: replicationStatus,
+				},
+			},
+		},
 		{
 			Name:  "bootstrap",
 			Usage: "bootstrap --sm-urls <addrs> --etcd-urls <addrs>",
@@ -678,6 +1652,52 @@ func main() {
 			},
 			Action: splitPartition,
 		},
+		{
+			Name:  "snapshot",
+			Usage: "read-only point-in-time snapshots of partitions",
+			Subcommands: []*cli.Command{
+				{
+					Name:  "create",
+					Usage: "snapshot create --etcd-urls <addrs> <PARTID>",
+					Flags: []cli.Flag{
+						&cli.StringFlag{Name: "etcd-urls", Value: "127.0.0.1:2379"},
+					},
+					Action: createSnapshot,
+				},
+				{
+					Name:  "list",
+					Usage: "snapshot list --etcd-urls <addrs>",
+					Flags: []cli.Flag{
+						&cli.StringFlag{Name: "etcd-urls", Value: "127.0.0.1:2379"},
+					},
+					Action: listSnapshots,
+				},
+				{
+					Name:  "get",
+					Usage: "snapshot get --etcd-urls <addrs> <SNAPSHOTID> <KEY>",
+					Flags: []cli.Flag{
+						&cli.StringFlag{Name: "etcd-urls", Value: "127.0.0.1:2379"},
+					},
+					Action: getSnapshot,
+				},
+				{
+					Name:  "restore",
+					Usage: "snapshot restore --etcd-urls <addrs> <SNAPSHOTID>",
+					Flags: []cli.Flag{
+						&cli.StringFlag{Name: "etcd-urls", Value: "127.0.0.1:2379"},
+					},
+					Action: restoreSnapshot,
+				},
+				{
+					Name:  "delete",
+					Usage: "snapshot delete --sm-urls <addrs> <SNAPSHOTID>",
+					Flags: []cli.Flag{
+						&cli.StringFlag{Name: "sm-urls", Value: "127.0.0.1:3401"},
+					},
+					Action: deleteSnapshot,
+				},
+			},
+		},
 		{
 			Name:  "gc",
 			Usage: "gc --etcd-urls <addrs> <PARTID>",
@@ -739,19 +1759,80 @@ func main() {
 			},
 			Action: autumnRange,
 		},
+		{
+			Name:  "create-index",
+			Usage: "create-index --etcd-urls <addrs> --prefix <keyPrefix> [--json-path <a.b.c> | --offset <N> --length <N>] <name>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "etcd-urls", Value: "127.0.0.1:2379"},
+				&cli.StringFlag{Name: "prefix", Value: ""},
+				&cli.StringFlag{Name: "json-path", Value: ""},
+				&cli.UintFlag{Name: "offset", Value: 0},
+				&cli.UintFlag{Name: "length", Value: 0},
+			},
+			Action: createIndex,
+		},
+		{
+			Name:  "index-range",
+			Usage: "index-range --etcd-urls <addrs> --value <value> [--exact] <name>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "etcd-urls", Value: "127.0.0.1:2379"},
+				&cli.StringFlag{Name: "value", Value: ""},
+				&cli.BoolFlag{Name: "exact", Value: false},
+				&cli.Int64Flag{Name: "limit", Value: math.MaxUint32},
+			},
+			Action: indexRange,
+		},
+		{
+			Name:  "watch",
+			Usage: "watch --etcd-urls <addrs> [--from-seq <seq>] <prefix>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "etcd-urls", Value: "127.0.0.1:2379"},
+				&cli.Uint64Flag{Name: "from-seq", Value: 0, Usage: "0 is to watch from now"},
+			},
+			Action: watch,
+		},
+		{
+			Name:  "tenant",
+			Usage: "tenant --etcd-urls <addrs> --ops <N> --bytes <N> --quota <prefix=maxBytes> <tenant>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "etcd-urls", Value: "127.0.0.1:2379"},
+				&cli.Uint64Flag{Name: "ops", Value: 0, Usage: "ops per second, 0 is unlimited"},
+				&cli.Uint64Flag{Name: "bytes", Value: 0, Usage: "bytes per second, 0 is unlimited"},
+				&cli.StringSliceFlag{Name: "quota"},
+			},
+			Action: setTenant,
+		},
+		{
+			Name:  "format-disk",
+			Usage: "format-disk --sm-urls <URLS> --node-id <ID> [--replace <offline diskID>] <dir>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "sm-urls", Value: "127.0.0.1:3401"},
+				&cli.Uint64Flag{Name: "node-id"},
+				&cli.Uint64Flag{Name: "replace"},
+			},
+			Action: formatDisk,
+		},
 		{
 			Name:  "format",
-			Usage: "format --output file.toml --waldir <dir> --listen-url <URL> --sm-urls <URLS> --etcd-urls <URLS> <dir list> ",
+			Usage: "format --output file.toml --waldir <dir> --listen-url <URL> --sm-urls <URLS> --etcd-urls <URLS> [--zone <zone> --rack <rack> --host <host>] <dir list> ",
 			Flags: []cli.Flag{
 				&cli.StringFlag{Name: "etcd-urls", Value: "127.0.0.1:2379"},
 				&cli.StringFlag{Name: "sm-urls", Value: "127.0.0.1:3401"},
 				&cli.StringFlag{Name: "listen-url"},
 				&cli.StringFlag{Name: "advertise-url"},
-				&cli.StringFlag{Name: "waldir"},
+				&cli.StringFlag{Name: "waldir", Usage: "wal dirs separated by comma"},
 				&cli.StringFlag{Name: "output"},
+				&cli.StringFlag{Name: "zone"},
+				&cli.StringFlag{Name: "rack"},
+				&cli.StringFlag{Name: "host"},
 			},
 			Action: format,
 		},
+		{
+			Name:   "migrate-extents",
+			Usage:  "migrate-extents <dir list>, convert extents to format v2 in place, the node must be stopped",
+			Action: migrateExtents,
+		},
 	}
 	err := app.Run(os.Args)
 	if err != nil {
@@ -762,7 +1843,65 @@ func main() {
 	os.Exit(0)
 }
 
+//formatDisk formats a new disk of a registered node, usually to replace an offline disk,
+//the node loads it after restart
+func formatDisk(c *cli.Context) error {
+	smURLs := utils.SplitAndTrim(c.String("sm-urls"), ",")
+	nodeID := c.Uint64("node-id")
+	replaceDiskID := c.Uint64("replace")
+	if nodeID == 0 {
+		return errors.New("--node-id can not be empty")
+	}
+	if c.Args().Len() != 1 {
+		return errors.New("format-disk needs one dir")
+	}
+	dir := c.Args().First()
+
+	sm := smclient.NewSMClient(smURLs)
+	if err := sm.Connect(); err != nil {
+		return err
+	}
+
+	uuid, err := node.FormatDisk(dir)
+	if err != nil {
+		return err
+	}
+	revert := func() {
+		names, _ := ioutil.ReadDir(dir)
+		for _, info := range names {
+			os.RemoveAll(filepath.Join(dir, info.Name()))
+		}
+	}
+	diskID, err := sm.RegisterDisk(context.Background(), nodeID, uuid, replaceDiskID)
+	if err != nil {
+		revert()
+		return err
+	}
+	if err = ioutil.WriteFile(path.Join(dir, "node_id"), []byte(fmt.Sprintf("%d", nodeID)), 0644); err != nil {
+		return err
+	}
+	if err = ioutil.WriteFile(path.Join(dir, "disk_id"), []byte(fmt.Sprintf("%d", diskID)), 0644); err != nil {
+		return err
+	}
+	fmt.Printf("disk %d is registered on node %d, restart the node with %s in its dirs\n", diskID, nodeID, dir)
+	return nil
+}
+
 //FIXME: detect disk and verify , then register first, then write down uuid, node_id, directory level
+func migrateExtents(c *cli.Context) error {
+	if c.Args().Len() == 0 {
+		return errors.New("migrate-extents <dir list>")
+	}
+	for _, dir := range c.Args().Slice() {
+		n, err := node.MigrateExtents(dir)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s: %d extents are migrated\n", dir, n)
+	}
+	return nil
+}
+
 func format(c *cli.Context) error {
 	//if any error happend, revert.
 	revert := func(dirList []string) {
@@ -785,8 +1924,8 @@ func format(c *cli.Context) error {
 	advertiseURL := c.String("advertise-url")
 	output := c.String("output")
 
-	walDir := c.String("walDir")
-	if len(walDir) > 0 {
+	walDirs := utils.SplitAndTrim(c.String("waldir"), ",")
+	for _, walDir := range walDirs {
 		_, err := os.Stat(walDir)
 		if err != nil {
 			return err
@@ -827,7 +1966,11 @@ func format(c *cli.Context) error {
 	fmt.Printf("format on disks : %+v", dirList)
 
 	fmt.Printf("register node on stream manager ..\n")
-	nodeID, uuidToDiskID, err := sm.RegisterNode(context.Background(), uuids, advertiseURL)
+	var topology *pb.Topology
+	if c.String("zone") != "" || c.String("rack") != "" || c.String("host") != "" {
+		topology = &pb.Topology{Zone: c.String("zone"), Rack: c.String("rack"), Host: c.String("host")}
+	}
+	nodeID, uuidToDiskID, err := sm.RegisterNodeWithTopology(context.Background(), uuids, advertiseURL, topology)
 	if err != nil {
 		revert(dirList)
 		return err
@@ -857,7 +2000,7 @@ func format(c *cli.Context) error {
 	var config node.Config
 	config.Dirs = dirList
 	config.ID = nodeID
-	config.WalDir = walDir
+	config.WalDirs = walDirs
 	config.SmURLs = smURLs
 	config.EtcdURLs = etcdURLs
 	config.ListenURL = listenURL
@@ -209,6 +209,8 @@ func main() {
 		MustSync:             !noSync,
 		CronTimeGC:           "0 0 * * 1",
 		CronTimeMajorCompact: "0 3 * * 2",
+		CronTimeIndexCheck:   "30 * * * *",
+		CronTimeTxnResolve:   "*/5 * * * *",
 		MaxExtentSize:        uint32((maxExtentMB << 20)),
 		MaxMetaExtentSize:    (4 << 20),
 		SkipListSize:         uint32((skiplistSizeMB << 20)),
@@ -238,6 +240,8 @@ func main() {
 	for {
 		select {
 		case <-sc:
+			//hand over partitions before shutdown
+			ps.Drain()
 			ps.Shutdown()
 			return
 		}
@@ -22,7 +22,8 @@ func main() {
 	fmt.Printf("config: %+v\n", config)
 	xlog.InitLog([]string{fmt.Sprintf("node_%d.log", config.ID)}, zap.DebugLevel)
 
-	node := node.NewExtentNode(config.ID, config.Dirs, config.WalDir, config.ListenURL, config.SmURLs, config.EtcdURLs)
+	node := node.NewExtentNode(config.ID, config.Dirs, config.WalOptions(), config.ListenURL, config.SmURLs, config.EtcdURLs)
+	node.SetDiskFailurePolicy(config.DiskFailurePolicy())
 
 	//open all extent files
 
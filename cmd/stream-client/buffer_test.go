@@ -0,0 +1,129 @@
+package main
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/journeymidnight/autumn/erasure_code"
+	"github.com/journeymidnight/autumn/extent"
+	"github.com/journeymidnight/autumn/extent/record"
+	"github.com/journeymidnight/autumn/utils"
+)
+
+//go test -bench . -benchmem ./cmd/stream-client
+//"alloc" is the path without pooled buffers, "pooled" is the path used by node and streamclient
+
+const benchBlockSize = 8192
+
+func benchBlocks(n int) [][]byte {
+	blocks := make([][]byte, n)
+	for i := range blocks {
+		blocks[i] = make([]byte, benchBlockSize)
+		utils.SetRandStringBytes(blocks[i])
+	}
+	return blocks
+}
+
+//ExtentNode.Append receives blocks split in several payloads
+func BenchmarkReceiveBlocks(b *testing.B) {
+	blocks := benchBlocks(64)
+	b.Run("alloc", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			data := make([][]byte, len(blocks))
+			for j := range blocks {
+				data[j] = make([]byte, len(blocks[j]))
+				copy(data[j], blocks[j])
+			}
+		}
+	})
+	b.Run("pooled", func(b *testing.B) {
+		b.ReportAllocs()
+		data := make([][]byte, len(blocks))
+		for i := 0; i < b.N; i++ {
+			buf := utils.GetBuffer(int(utils.SizeOfBlocks(blocks)))
+			n := 0
+			for j := range blocks {
+				data[j] = buf.B[n : n+len(blocks[j])]
+				n += copy(data[j], blocks[j])
+			}
+			buf.DecrRef()
+		}
+	})
+}
+
+//AutumnStreamClient stripes blocks in EC
+func BenchmarkECEncode(b *testing.B) {
+	block := benchBlocks(1)[0]
+	b.Run("alloc", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := (erasure_code.ReedSolomon{}).Encode(block, 3, 2); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("pooled", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, buf, err := erasure_code.ReedSolomon{}.EncodeBuffer(block, 3, 2)
+			if err != nil {
+				b.Fatal(err)
+			}
+			buf.DecrRef()
+		}
+	})
+}
+
+//ExtentNode.ReadBlocks
+func BenchmarkReadBlocks(b *testing.B) {
+	f, err := ioutil.TempFile("", "bench-extent")
+	if err != nil {
+		b.Fatal(err)
+	}
+	f.Close()
+	defer os.Remove(f.Name())
+	ex, err := extent.CreateExtent(f.Name(), 100)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer ex.Close()
+	blocks := benchBlocks(64)
+	ex.Lock()
+	_, _, err = ex.AppendBlocks(blocks, false)
+	ex.Unlock()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.Run("alloc", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			rr := record.NewReader(ex.GetReader())
+			var ret [][]byte
+			for {
+				reader, err := rr.Next()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					b.Fatal(err)
+				}
+				data, _ := ioutil.ReadAll(reader)
+				ret = append(ret, data)
+			}
+		}
+	})
+	b.Run("pooled", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			buf := utils.GetBuffer(int(ex.CommitLength()))
+			if _, _, _, err := ex.ReadBlocksTo(buf.B, 0, 64, 32<<20); err != nil {
+				b.Fatal(err)
+			}
+			buf.DecrRef()
+		}
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"runtime"
 	"sort"
 	"sync"
 	"sync/atomic"
@@ -139,7 +140,7 @@ func doWrites(writeCh chan *writeRequest, done chan struct{}, sc *streamclient.A
 	}
 }
 
-func benchmark(etcdAddr []string, smAddr []string, op BenchType, duration int, size int, threadNum int, dataShards int, parityShards int) error {
+func benchmark(etcdAddr []string, smAddr []string, op BenchType, duration int, size int, threadNum int, dataShards int, parityShards int, mode pb.ReplicationMode, codec *pb.ErasureCodec) error {
 
 	sm := smclient.NewSMClient(smAddr)
 	if err := sm.Connect(); err != nil {
@@ -147,8 +148,15 @@ func benchmark(etcdAddr []string, smAddr []string, op BenchType, duration int, s
 	}
 	defer sm.Close()
 	stopper := utils.NewStopper()
-	fmt.Printf("create stream , replication is %d+%d\n", dataShards, parityShards)
-	s, _, err := sm.CreateStream(context.Background(), uint32(dataShards), uint32(parityShards))
+	fmt.Printf("create stream , replication is %d+%d, mode is %s\n", dataShards, parityShards, mode)
+	var s *pb.StreamInfo
+	var err error
+	if codec != nil {
+		fmt.Printf("codec is %s\n", codec)
+		s, _, err = sm.CreateECStream(context.Background(), uint32(dataShards), uint32(parityShards), codec)
+	} else {
+		s, _, err = sm.CreateStreamWithReplication(context.Background(), uint32(dataShards), uint32(parityShards), mode)
+	}
 	if err != nil {
 		return err
 	}
@@ -186,6 +194,8 @@ func benchmark(etcdAddr []string, smAddr []string, op BenchType, duration int, s
 
 	done := make(chan struct{})
 
+	var memBefore, memAfter runtime.MemStats
+	runtime.ReadMemStats(&memBefore)
 	start := time.Now()
 	livePrint := func() {
 		ticker := time.NewTicker(time.Second)
@@ -270,7 +280,12 @@ func benchmark(etcdAddr []string, smAddr []string, op BenchType, duration int, s
 			fmt.Println("failed to write result.json")
 		}
 	}
+	runtime.ReadMemStats(&memAfter)
 	printSummary(time.Now().Sub(start), atomic.LoadUint64(&count), atomic.LoadUint64(&totalSize), threadNum, size, hist)
+	if n := atomic.LoadUint64(&count); n > 0 {
+		fmt.Printf("Allocations per request :%d allocs, %d bytes, GC cycles :%d\n",
+			(memAfter.Mallocs-memBefore.Mallocs)/n, (memAfter.TotalAlloc-memBefore.TotalAlloc)/n, memAfter.NumGC-memBefore.NumGC)
+	}
 
 	return nil
 }
@@ -343,6 +358,8 @@ func main() {
 				&cli.IntFlag{Name: "duration", Value: 10, Aliases: []string{"d"}},
 				&cli.IntFlag{Name: "size", Value: 8192, Aliases: []string{"s"}},
 				&cli.StringFlag{Name: "replication", Value: "2+1"},
+				&cli.BoolFlag{Name: "chain", Usage: "use chain replication, only for replicates"},
+				&cli.IntFlag{Name: "lrc-groups", Value: 0, Usage: "use LRC codec with N local groups, only for EC"},
 			},
 			Action: wbench,
 		},
@@ -374,7 +391,15 @@ func wbench(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
-	return benchmark(etcdAddrs, clusterAddrs, benchWrite, duration, size, threadNum, r, s)
+	mode := pb.ReplicationMode_Leaderless
+	if c.Bool("chain") {
+		mode = pb.ReplicationMode_Chain
+	}
+	var codec *pb.ErasureCodec
+	if groups := c.Int("lrc-groups"); groups > 0 {
+		codec = &pb.ErasureCodec{Type: pb.CodecType_LRC, LocalGroups: uint32(groups)}
+	}
+	return benchmark(etcdAddrs, clusterAddrs, benchWrite, duration, size, threadNum, r, s, mode, codec)
 }
 
 func printSummary(elapsed time.Duration, totalCount uint64, totalSize uint64, threadNum int, size int, hist *utils.HistogramStatus) {
@@ -2,10 +2,67 @@ package erasure_code
 
 import (
 	"io"
+
+	"github.com/journeymidnight/autumn/extent"
+	"github.com/journeymidnight/autumn/proto/pb"
+	"github.com/journeymidnight/autumn/utils"
+	"github.com/pkg/errors"
 )
 
+//ErasureCodec is implemented by ReedSolomon and LRC, shards are ordered as
+//[data shards, parity shards], parityShards includes all kinds of parity
 type ErasureCodec interface {
-	Encode(input []byte, dataShards int, parityShards int, chunkSize int) ([][]byte, error)
-	Decode(input [][]byte, dataShards uint32, parityShards uint32, chunkSize int) ([]byte, error)
-	Reconstruct(input []io.Reader, dataShards int, parityShards int, output []io.Writer, chunkSize int) error
+	Encode(input []byte, dataShards int, parityShards int) ([][]byte, error)
+	//EncodeBuffer is Encode, but padding and parity shards are in a pooled buffer
+	EncodeBuffer(input []byte, dataShards int, parityShards int) ([][]byte, *utils.Buffer, error)
+	Decode(input [][]byte, dataShards int, parityShards int) ([]byte, error)
+	//CanDecode returns true if data could be decoded from present shards
+	CanDecode(present []bool, dataShards int, parityShards int) bool
+	//RepairSources returns shards needed to rebuild the lost shard, nil means any dataShards shards
+	RepairSources(lost int, dataShards int, parityShards int) []int
+
+	EncodeStream(data []io.Reader, dataShards int, parityShards int, parity []io.Writer) error
+	Reconstruct(input []io.Reader, dataShards int, parityShards int, output []io.Writer) error
+	ReconstructData(shards [][]byte, dataShards int, parityShards int) error
+	RebuildECExtent(dataShards, parityShards int, sourceExtent []*extent.Extent, start uint32, replacingIndex int, targetExtent *extent.Extent) error
+}
+
+//shardCoder encodes and reconstructs shards of the same size, reedsolomon.Encoder implements it
+type shardCoder interface {
+	Encode(shards [][]byte) error
+	Verify(shards [][]byte) (bool, error)
+	Reconstruct(shards [][]byte) error
+	ReconstructData(shards [][]byte) error
+}
+
+//someReconstructor is implemented by coders which could rebuild some shards from fewer shards
+type someReconstructor interface {
+	ReconstructSome(shards [][]byte, required []bool) error
+}
+
+//NewCodec returns the codec of stream or extent, nil is ReedSolomon
+func NewCodec(c *pb.ErasureCodec) ErasureCodec {
+	if c == nil {
+		return ReedSolomon{}
+	}
+	switch c.Type {
+	case pb.CodecType_LRC:
+		return LRC{LocalGroups: int(c.LocalGroups)}
+	default:
+		return ReedSolomon{}
+	}
+}
+
+//ValidateCodec checks if codec could encode dataShards+parityShards
+func ValidateCodec(c *pb.ErasureCodec, dataShards int, parityShards int) error {
+	if c == nil || c.Type == pb.CodecType_ReedSolomon {
+		return nil
+	}
+	switch c.Type {
+	case pb.CodecType_LRC:
+		_, err := newLRCCoder(dataShards, parityShards, int(c.LocalGroups))
+		return err
+	default:
+		return errors.Errorf("unknown codec %v", c.Type)
+	}
 }
@@ -0,0 +1,425 @@
+package erasure_code
+
+import (
+	"bytes"
+	"io"
+	"sync"
+
+	"github.com/journeymidnight/autumn/extent"
+	"github.com/journeymidnight/autumn/utils"
+	"github.com/klauspost/reedsolomon"
+	"github.com/pkg/errors"
+)
+
+/*
+LRC(k, l, r): k data shards are divided into l local groups, the local parity of a group is XOR of
+its data shards, r global parities are encoded by reedsolomon from all data shards.
+shards are ordered as [data shards, local parities, global parities], so parityShards = l + r.
+a lost data shard or local parity is rebuilt from k/l shards of its group instead of k shards.
+*/
+
+type LRC struct {
+	LocalGroups int
+}
+
+//stream functions encode or reconstruct chunks of shards
+const lrcStreamChunkSize = 1 << 20
+
+type lrcCoder struct {
+	dataShards   int
+	localGroups  int
+	globalShards int
+	global       reedsolomon.Encoder
+}
+
+var lrcCoders sync.Map
+
+func newLRCCoder(dataShards int, parityShards int, localGroups int) (*lrcCoder, error) {
+	if localGroups < 1 || dataShards < localGroups || dataShards%localGroups != 0 {
+		return nil, errors.Errorf("LRC: %d data shards can not be divided into %d local groups", dataShards, localGroups)
+	}
+	if parityShards <= localGroups {
+		return nil, errors.Errorf("LRC: %d parity shards, need %d local parities and at least 1 global parity", parityShards, localGroups)
+	}
+	key := dataShards<<20 | parityShards<<10 | localGroups
+	if c, ok := lrcCoders.Load(key); ok {
+		return c.(*lrcCoder), nil
+	}
+	global, err := reedsolomon.New(dataShards, parityShards-localGroups)
+	if err != nil {
+		return nil, err
+	}
+	c := &lrcCoder{
+		dataShards:   dataShards,
+		localGroups:  localGroups,
+		globalShards: parityShards - localGroups,
+		global:       global,
+	}
+	lrcCoders.Store(key, c)
+	return c, nil
+}
+
+func (c *lrcCoder) totalShards() int {
+	return c.dataShards + c.localGroups + c.globalShards
+}
+
+//group returns data shards and local parity of group g
+func (c *lrcCoder) group(g int) []int {
+	size := c.dataShards / c.localGroups
+	ret := make([]int, 0, size+1)
+	for i := g * size; i < (g+1)*size; i++ {
+		ret = append(ret, i)
+	}
+	return append(ret, c.dataShards+g)
+}
+
+//globalView returns data shards and global parities, they share memory with shards
+func (c *lrcCoder) globalView(shards [][]byte) [][]byte {
+	view := make([][]byte, 0, c.dataShards+c.globalShards)
+	view = append(view, shards[:c.dataShards]...)
+	return append(view, shards[c.dataShards+c.localGroups:]...)
+}
+
+func xorInto(dst []byte, src []byte) {
+	for i := range dst {
+		dst[i] ^= src[i]
+	}
+}
+
+//xorGroup writes XOR of members of group g except shard skip to dst
+func (c *lrcCoder) xorGroup(shards [][]byte, g int, skip int, dst []byte) {
+	for i := range dst {
+		dst[i] = 0
+	}
+	for _, i := range c.group(g) {
+		if i != skip {
+			xorInto(dst, shards[i])
+		}
+	}
+}
+
+func allocShard(s []byte, size int) []byte {
+	if cap(s) >= size {
+		return s[:size]
+	}
+	return make([]byte, size)
+}
+
+func (c *lrcCoder) Encode(shards [][]byte) error {
+	if len(shards) != c.totalShards() {
+		return reedsolomon.ErrTooFewShards
+	}
+	if err := c.global.Encode(c.globalView(shards)); err != nil {
+		return err
+	}
+	for g := 0; g < c.localGroups; g++ {
+		c.xorGroup(shards, g, c.dataShards+g, shards[c.dataShards+g])
+	}
+	return nil
+}
+
+func (c *lrcCoder) Verify(shards [][]byte) (bool, error) {
+	if len(shards) != c.totalShards() {
+		return false, reedsolomon.ErrTooFewShards
+	}
+	for _, s := range shards {
+		if len(s) == 0 {
+			return false, reedsolomon.ErrShardNoData
+		}
+	}
+	if ok, err := c.global.Verify(c.globalView(shards)); !ok || err != nil {
+		return ok, err
+	}
+	buf := make([]byte, len(shards[0]))
+	for g := 0; g < c.localGroups; g++ {
+		c.xorGroup(shards, g, c.dataShards+g, buf)
+		if !bytes.Equal(buf, shards[c.dataShards+g]) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (c *lrcCoder) Reconstruct(shards [][]byte) error {
+	return c.reconstruct(shards, false)
+}
+
+func (c *lrcCoder) ReconstructData(shards [][]byte) error {
+	return c.reconstruct(shards, true)
+}
+
+//ReconstructSome rebuilds required shards, they are rebuilt by local groups if possible
+func (c *lrcCoder) ReconstructSome(shards [][]byte, required []bool) error {
+	if len(shards) != c.totalShards() || len(required) != len(shards) {
+		return reedsolomon.ErrTooFewShards
+	}
+	size := shardSize(shards)
+	if size == 0 {
+		return reedsolomon.ErrShardNoData
+	}
+	c.repairLocal(shards, size, false)
+	for i := range required {
+		if required[i] && len(shards[i]) == 0 {
+			return c.reconstruct(shards, false)
+		}
+	}
+	return nil
+}
+
+func shardSize(shards [][]byte) int {
+	for _, s := range shards {
+		if len(s) > 0 {
+			return len(s)
+		}
+	}
+	return 0
+}
+
+//repairLocal repairs groups which lost only one shard
+func (c *lrcCoder) repairLocal(shards [][]byte, size int, dataOnly bool) {
+	for g := 0; g < c.localGroups; g++ {
+		lost, n := -1, 0
+		for _, i := range c.group(g) {
+			if len(shards[i]) == 0 {
+				lost = i
+				n++
+			}
+		}
+		if n != 1 || (dataOnly && lost >= c.dataShards) {
+			continue
+		}
+		shards[lost] = allocShard(shards[lost], size)
+		c.xorGroup(shards, g, lost, shards[lost])
+	}
+}
+
+//reconstruct repairs groups which lost only one shard first, other lost data shards are
+//reconstructed by global parities
+func (c *lrcCoder) reconstruct(shards [][]byte, dataOnly bool) error {
+	if len(shards) != c.totalShards() {
+		return reedsolomon.ErrTooFewShards
+	}
+	size := shardSize(shards)
+	if size == 0 {
+		return reedsolomon.ErrShardNoData
+	}
+	c.repairLocal(shards, size, dataOnly)
+
+	view := c.globalView(shards)
+	var err error
+	if dataOnly {
+		err = c.global.ReconstructData(view)
+	} else {
+		err = c.global.Reconstruct(view)
+	}
+	if err != nil {
+		return err
+	}
+	copy(shards[:c.dataShards], view[:c.dataShards])
+	if dataOnly {
+		return nil
+	}
+	copy(shards[c.dataShards+c.localGroups:], view[c.dataShards:])
+	for g := 0; g < c.localGroups; g++ {
+		if p := c.dataShards + g; len(shards[p]) == 0 {
+			shards[p] = allocShard(shards[p], size)
+			c.xorGroup(shards, g, p, shards[p])
+		}
+	}
+	return nil
+}
+
+func (c *lrcCoder) canDecode(present []bool) bool {
+	p := make([]bool, len(present))
+	copy(p, present)
+	for g := 0; g < c.localGroups; g++ {
+		lost, n := -1, 0
+		for _, i := range c.group(g) {
+			if !p[i] {
+				lost = i
+				n++
+			}
+		}
+		if n == 1 {
+			p[lost] = true
+		}
+	}
+	n := 0
+	for i := range p {
+		if p[i] && (i < c.dataShards || i >= c.dataShards+c.localGroups) {
+			n++
+		}
+	}
+	return n >= c.dataShards
+}
+
+func (l LRC) Encode(input []byte, dataShards int, parityShards int) ([][]byte, error) {
+	c, err := newLRCCoder(dataShards, parityShards, l.LocalGroups)
+	if err != nil {
+		return nil, err
+	}
+	dst, _, err := encodeShards(c, input, dataShards, parityShards, false)
+	return dst, err
+}
+
+func (l LRC) EncodeBuffer(input []byte, dataShards int, parityShards int) ([][]byte, *utils.Buffer, error) {
+	c, err := newLRCCoder(dataShards, parityShards, l.LocalGroups)
+	if err != nil {
+		return nil, nil, err
+	}
+	return encodeShards(c, input, dataShards, parityShards, true)
+}
+
+func (l LRC) Decode(input [][]byte, dataShards int, parityShards int) ([]byte, error) {
+	c, err := newLRCCoder(dataShards, parityShards, l.LocalGroups)
+	if err != nil {
+		return nil, err
+	}
+	return decodeShards(c, input, dataShards)
+}
+
+func (l LRC) CanDecode(present []bool, dataShards int, parityShards int) bool {
+	c, err := newLRCCoder(dataShards, parityShards, l.LocalGroups)
+	if err != nil || len(present) != c.totalShards() {
+		return false
+	}
+	return c.canDecode(present)
+}
+
+func (l LRC) RepairSources(lost int, dataShards int, parityShards int) []int {
+	c, err := newLRCCoder(dataShards, parityShards, l.LocalGroups)
+	if err != nil {
+		return nil
+	}
+	var ret []int
+	if lost < dataShards+c.localGroups {
+		g := lost / (c.dataShards / c.localGroups)
+		if lost >= c.dataShards {
+			g = lost - c.dataShards
+		}
+		for _, i := range c.group(g) {
+			if i != lost {
+				ret = append(ret, i)
+			}
+		}
+		return ret
+	}
+	//global parity is encoded from all data shards
+	for i := 0; i < dataShards; i++ {
+		ret = append(ret, i)
+	}
+	return ret
+}
+
+func (l LRC) ReconstructData(shards [][]byte, dataShards int, parityShards int) error {
+	c, err := newLRCCoder(dataShards, parityShards, l.LocalGroups)
+	if err != nil {
+		return err
+	}
+	return c.ReconstructData(shards)
+}
+
+func (l LRC) RebuildECExtent(dataShards, parityShards int, sourceExtent []*extent.Extent, start uint32, replacingIndex int, targetExtent *extent.Extent) error {
+	c, err := newLRCCoder(dataShards, parityShards, l.LocalGroups)
+	if err != nil {
+		return err
+	}
+	return rebuildExtent(c, dataShards, parityShards, sourceExtent, start, replacingIndex, targetExtent)
+}
+
+//readChunk reads the next chunk of all present readers, they must have the same size
+func readChunk(input []io.Reader, bufs [][]byte) (int, error) {
+	n := -1
+	for i, r := range input {
+		if r == nil {
+			continue
+		}
+		m, err := io.ReadFull(r, bufs[i])
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return 0, err
+		}
+		if n != -1 && m != n {
+			return 0, errors.New("LRC: shards have different size")
+		}
+		n = m
+	}
+	if n == -1 {
+		return 0, reedsolomon.ErrTooFewShards
+	}
+	return n, nil
+}
+
+func (l LRC) EncodeStream(data []io.Reader, dataShards int, parityShards int, parity []io.Writer) error {
+	c, err := newLRCCoder(dataShards, parityShards, l.LocalGroups)
+	if err != nil {
+		return err
+	}
+	bufs := make([][]byte, dataShards+parityShards)
+	for i := range bufs {
+		bufs[i] = make([]byte, lrcStreamChunkSize)
+	}
+	shards := make([][]byte, len(bufs))
+	for {
+		n, err := readChunk(data, bufs)
+		if err != nil || n == 0 {
+			return err
+		}
+		for i := range shards {
+			shards[i] = bufs[i][:n]
+		}
+		if err = c.Encode(shards); err != nil {
+			return err
+		}
+		for i := range parity {
+			if _, err = parity[i].Write(shards[dataShards+i]); err != nil {
+				return err
+			}
+		}
+		if n < lrcStreamChunkSize {
+			return nil
+		}
+	}
+}
+
+//Reconstruct writes lost shards to output, input[i] is nil if shard i is lost
+func (l LRC) Reconstruct(input []io.Reader, dataShards int, parityShards int, output []io.Writer) error {
+	c, err := newLRCCoder(dataShards, parityShards, l.LocalGroups)
+	if err != nil {
+		return err
+	}
+	bufs := make([][]byte, dataShards+parityShards)
+	for i := range bufs {
+		bufs[i] = make([]byte, lrcStreamChunkSize)
+	}
+	shards := make([][]byte, len(bufs))
+	required := make([]bool, len(bufs))
+	for i := range output {
+		required[i] = output[i] != nil
+	}
+	for {
+		n, err := readChunk(input, bufs)
+		if err != nil || n == 0 {
+			return err
+		}
+		for i := range shards {
+			shards[i] = bufs[i][:0]
+			if input[i] != nil {
+				shards[i] = bufs[i][:n]
+			}
+		}
+		if err = c.ReconstructSome(shards, required); err != nil {
+			return err
+		}
+		for i, w := range output {
+			if w == nil {
+				continue
+			}
+			if _, err = w.Write(shards[i]); err != nil {
+				return err
+			}
+		}
+		if n < lrcStreamChunkSize {
+			return nil
+		}
+	}
+}
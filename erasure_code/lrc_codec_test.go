@@ -0,0 +1,100 @@
+package erasure_code
+
+import (
+	"bytes"
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/journeymidnight/autumn/proto/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLRCEncodeDecode(t *testing.T) {
+	//6 data shards in 2 groups, 2 global parities
+	codec := NewCodec(&pb.ErasureCodec{Type: pb.CodecType_LRC, LocalGroups: 2})
+	data := make([]byte, 100000)
+	rand.Read(data)
+
+	shards, err := codec.Encode(data, 6, 4)
+	require.Nil(t, err)
+	require.Equal(t, 10, len(shards))
+
+	//lost one shard of each group and a global parity
+	shards[1], shards[5], shards[9] = nil, nil, nil
+	output, err := codec.Decode(shards, 6, 4)
+	require.Nil(t, err)
+	require.Equal(t, data, output)
+
+	//two data shards of a group and its local parity, recovered by global parities
+	shards, err = codec.Encode(data, 6, 4)
+	require.Nil(t, err)
+	shards[0], shards[1], shards[6] = nil, nil, nil
+	output, err = codec.Decode(shards, 6, 4)
+	require.Nil(t, err)
+	require.Equal(t, data, output)
+
+	//too many shards are lost
+	shards, err = codec.Encode(data, 6, 4)
+	require.Nil(t, err)
+	shards[0], shards[1], shards[2], shards[8] = nil, nil, nil, nil
+	_, err = codec.Decode(shards, 6, 4)
+	require.NotNil(t, err)
+}
+
+func TestLRCRepairSources(t *testing.T) {
+	codec := LRC{LocalGroups: 2}
+	require.Equal(t, []int{0, 2, 6}, codec.RepairSources(1, 6, 4))
+	require.Equal(t, []int{3, 4, 5}, codec.RepairSources(7, 6, 4))
+	require.Equal(t, []int{0, 1, 2, 3, 4, 5}, codec.RepairSources(8, 6, 4))
+	require.Nil(t, ReedSolomon{}.RepairSources(1, 6, 3))
+
+	present := func(lost ...int) []bool {
+		p := make([]bool, 10)
+		for i := range p {
+			p[i] = true
+		}
+		for _, i := range lost {
+			p[i] = false
+		}
+		return p
+	}
+	require.True(t, codec.CanDecode(present(1, 4, 8, 9), 6, 4))
+	require.True(t, codec.CanDecode(present(0, 1, 6), 6, 4))
+	require.False(t, codec.CanDecode(present(0, 1, 2, 8), 6, 4))
+
+	require.NotNil(t, ValidateCodec(&pb.ErasureCodec{Type: pb.CodecType_LRC, LocalGroups: 4}, 6, 5))
+	require.NotNil(t, ValidateCodec(&pb.ErasureCodec{Type: pb.CodecType_LRC, LocalGroups: 2}, 6, 2))
+	require.Nil(t, ValidateCodec(&pb.ErasureCodec{Type: pb.CodecType_LRC, LocalGroups: 2}, 6, 3))
+}
+
+func TestLRCStreamReconstruct(t *testing.T) {
+	codec := LRC{LocalGroups: 2}
+	const size = 3<<20 + 123
+	data := make([][]byte, 4)
+	readers := make([]io.Reader, 4)
+	for i := range data {
+		data[i] = make([]byte, size)
+		rand.Read(data[i])
+		readers[i] = bytes.NewReader(data[i])
+	}
+	parity := make([]*bytes.Buffer, 3)
+	writers := make([]io.Writer, 3)
+	for i := range parity {
+		parity[i] = new(bytes.Buffer)
+		writers[i] = parity[i]
+	}
+	require.Nil(t, codec.EncodeStream(readers, 4, 3, writers))
+
+	shards := append(data, parity[0].Bytes(), parity[1].Bytes(), parity[2].Bytes())
+	//rebuild shard 1 from its group
+	input := make([]io.Reader, 7)
+	for _, i := range codec.RepairSources(1, 4, 3) {
+		input[i] = bytes.NewReader(shards[i])
+	}
+	output := make([]io.Writer, 7)
+	rebuilt := new(bytes.Buffer)
+	output[1] = rebuilt
+	require.Nil(t, codec.Reconstruct(input, 4, 3, output))
+	require.Equal(t, shards[1], rebuilt.Bytes())
+}
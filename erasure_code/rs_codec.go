@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"sync"
 
 	"github.com/journeymidnight/autumn/extent"
 	"github.com/journeymidnight/autumn/utils"
@@ -14,6 +15,22 @@ import (
 
 type ReedSolomon struct{}
 
+//reedsolomon.New builds matrices, encoders are cached by (dataShards, parityShards)
+var encoders sync.Map
+
+func newEncoder(dataShards int, parityShards int) (shardCoder, error) {
+	key := dataShards<<16 | parityShards
+	if enc, ok := encoders.Load(key); ok {
+		return enc.(shardCoder), nil
+	}
+	enc, err := reedsolomon.New(dataShards, parityShards)
+	if err != nil {
+		return nil, err
+	}
+	encoders.Store(key, enc)
+	return enc, nil
+}
+
 func (ReedSolomon) Reconstruct(input []io.Reader, dataShards int, parityShards int, output []io.Writer) error {
 	enc, err := reedsolomon.NewStream(dataShards, parityShards)
 	if err != nil {
@@ -26,12 +43,34 @@ func (ReedSolomon) Reconstruct(input []io.Reader, dataShards int, parityShards i
 	return nil
 }
 
-func (ReedSolomon) Decode(input [][]byte, dataShards int, parityShards int) ([]byte, error) {
+//EncodeStream computes parity of data readers, it is used to convert a whole extent,
+//all data readers must have the same length
+func (ReedSolomon) EncodeStream(data []io.Reader, dataShards int, parityShards int, parity []io.Writer) error {
+	enc, err := reedsolomon.NewStream(dataShards, parityShards)
+	if err != nil {
+		return err
+	}
+	return enc.Encode(data, parity)
+}
+
+//ReconstructData fills nil data shards, shards must have the same length
+func (ReedSolomon) ReconstructData(shards [][]byte, dataShards int, parityShards int) error {
+	enc, err := newEncoder(dataShards, parityShards)
+	if err != nil {
+		return err
+	}
+	return enc.ReconstructData(shards)
+}
 
-	enc, err := reedsolomon.New(int(dataShards), int(parityShards))
+func (ReedSolomon) Decode(input [][]byte, dataShards int, parityShards int) ([]byte, error) {
+	enc, err := newEncoder(dataShards, parityShards)
 	if err != nil {
 		return nil, err
 	}
+	return decodeShards(enc, input, dataShards)
+}
+
+func decodeShards(enc shardCoder, input [][]byte, dataShards int) ([]byte, error) {
 
 	// Verify the shards
 	ok, err := enc.Verify(input)
@@ -58,12 +97,26 @@ func (ReedSolomon) Decode(input [][]byte, dataShards int, parityShards int) ([]b
 }
 
 func (ReedSolomon) Encode(input []byte, dataShards int, parityShards int) ([][]byte, error) {
-
-	enc, err := reedsolomon.New(int(dataShards), int(parityShards))
+	enc, err := newEncoder(dataShards, parityShards)
 	if err != nil {
 		return nil, err
 	}
+	dst, _, err := encodeShards(enc, input, dataShards, parityShards, false)
+	return dst, err
+}
+
+//EncodeBuffer is Encode, but padding and parity shards are in a pooled buffer, caller
+//must call DecrRef of the buffer after shards are not used
+func (ReedSolomon) EncodeBuffer(input []byte, dataShards int, parityShards int) ([][]byte, *utils.Buffer, error) {
+	enc, err := newEncoder(dataShards, parityShards)
+	if err != nil {
+		return nil, nil, err
+	}
+	return encodeShards(enc, input, dataShards, parityShards, true)
+}
 
+func encodeShards(enc shardCoder, input []byte, dataShards int, parityShards int, pooled bool) ([][]byte, *utils.Buffer, error) {
+	var err error
 	size := len(input)
 	/*
 		leftSpace和perShard至少是4, 保证存储长度
@@ -89,18 +142,30 @@ func (ReedSolomon) Encode(input []byte, dataShards int, parityShards int) ([][]b
 	utils.AssertTrue(leftSpace >= 4)
 
 	var padding []byte //include the last shard and all partyShards
+	var buf *utils.Buffer
+	allocPadding := func(size int) []byte {
+		if !pooled {
+			return make([]byte, size)
+		}
+		buf = utils.GetBuffer(size)
+		//pooled buffer is dirty
+		for i := range buf.B {
+			buf.B[i] = 0
+		}
+		return buf.B
+	}
 
 	shards := dataShards + parityShards
 	// calculate maximum number of full shards in `data` slice
 	fullShards := len(input) / perShard
 	if fullShards == 0 {
 		//copy all data
-		padding = make([]byte, shards*perShard)
+		padding = allocPadding(shards * perShard)
 		copy(padding, input)
 		input = input[:0]
 	} else {
 		//copy the last shards
-		padding = make([]byte, shards*perShard-perShard*fullShards)
+		padding = allocPadding(shards*perShard - perShard*fullShards)
 		copy(padding, input[perShard*fullShards:])
 		input = input[0 : perShard*fullShards]
 	}
@@ -119,8 +184,11 @@ func (ReedSolomon) Encode(input []byte, dataShards int, parityShards int) ([][]b
 
 	binary.BigEndian.PutUint32(dst[dataShards-1][perShard-4:], uint32(size))
 
-	err = enc.Encode(dst)
-	return dst, err
+	if err = enc.Encode(dst); err != nil && buf != nil {
+		buf.DecrRef()
+		return nil, nil, err
+	}
+	return dst, buf, err
 
 }
 
@@ -136,10 +204,18 @@ type block = []byte
 
 //FIXME: add a channel to make ReadBlocks and Reconstruct asynchronized
 func (ReedSolomon) RebuildECExtent(dataShards, parityShards int, sourceExtent []*extent.Extent, start uint32, replacingIndex int, targetExtent *extent.Extent) error {
+	enc, err := newEncoder(dataShards, parityShards)
+	if err != nil {
+		return err
+	}
+	return rebuildExtent(enc, dataShards, parityShards, sourceExtent, start, replacingIndex, targetExtent)
+}
+
+func rebuildExtent(enc shardCoder, dataShards, parityShards int, sourceExtent []*extent.Extent, start uint32, replacingIndex int, targetExtent *extent.Extent) error {
 
 	targetExtent.AssertLock()
 
-	enc, err := reedsolomon.New(dataShards, parityShards)
+	var err error
 
 	blocks := make([][]block, dataShards+parityShards)
 	shards := make([][]byte, dataShards+parityShards)
@@ -147,6 +223,14 @@ func (ReedSolomon) RebuildECExtent(dataShards, parityShards int, sourceExtent []
 	if len(sourceExtent) > replacingIndex && sourceExtent[replacingIndex] != nil {
 		return errors.New("sourceExtent[replacingIndex] must be nil")
 	}
+	required := make([]bool, dataShards+parityShards)
+	required[replacingIndex] = true
+	reconstruct := enc.Reconstruct
+	if some, ok := enc.(someReconstructor); ok {
+		reconstruct = func(shards [][]byte) error {
+			return some.ReconstructSome(shards, required)
+		}
+	}
 
 	var done bool
 	end := uint32(0)
@@ -181,7 +265,7 @@ func (ReedSolomon) RebuildECExtent(dataShards, parityShards int, sourceExtent []
 				}
 			}
 
-			if err = enc.Reconstruct(shards); err != nil {
+			if err = reconstruct(shards); err != nil {
 				return err
 			}
 			writeBlocks[k] = shards[replacingIndex]
@@ -197,3 +281,17 @@ func (ReedSolomon) RebuildECExtent(dataShards, parityShards int, sourceExtent []
 
 	return err
 }
+
+func (ReedSolomon) CanDecode(present []bool, dataShards int, parityShards int) bool {
+	n := 0
+	for _, p := range present {
+		if p {
+			n++
+		}
+	}
+	return n >= dataShards
+}
+
+func (ReedSolomon) RepairSources(lost int, dataShards int, parityShards int) []int {
+	return nil
+}
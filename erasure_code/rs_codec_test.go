@@ -119,3 +119,35 @@ func TestReconstruct(t *testing.T) {
 	require.Equal(t, output[3], x)
 
 }
+
+//fragments are encoded by stream, any range of them could be reconstructed alone
+func TestEncodeStreamReconstructRange(t *testing.T) {
+	const fragmentSize = 100 << 10
+	data := make([][]byte, 3)
+	readers := make([]io.Reader, 3)
+	for i := range data {
+		data[i] = make([]byte, fragmentSize)
+		utils.SetRandStringBytes(data[i])
+		readers[i] = bytes.NewReader(data[i])
+	}
+	parity := make([]*bytes.Buffer, 2)
+	writers := make([]io.Writer, 2)
+	for i := range parity {
+		parity[i] = new(bytes.Buffer)
+		writers[i] = parity[i]
+	}
+	require.Nil(t, ReedSolomon{}.EncodeStream(readers, 3, 2, writers))
+	require.Equal(t, fragmentSize, parity[0].Len())
+
+	start, end := 4000, 9000
+	shards := [][]byte{
+		nil,
+		data[1][start:end],
+		nil,
+		parity[0].Bytes()[start:end],
+		parity[1].Bytes()[start:end],
+	}
+	require.Nil(t, ReedSolomon{}.ReconstructData(shards, 3, 2))
+	require.Equal(t, data[0][start:end], shards[0])
+	require.Equal(t, data[2][start:end], shards[2])
+}
@@ -15,6 +15,7 @@
 package extent
 
 import (
+	"context"
 	"io"
 	"io/ioutil"
 	"strconv"
@@ -25,6 +26,7 @@ import (
 	"encoding/json"
 	"math"
 	"os"
+	"sync"
 	"sync/atomic"
 
 	"github.com/journeymidnight/autumn/extent/record"
@@ -52,6 +54,15 @@ type Extent struct {
 	//FIXME: add SSD Chanel
 	writer       *record.LogWriter
 	lastRevision int64
+	format       int         //FormatV1 or FormatV2
+	meta         *extentMeta //metadata of FormatV2, protected by ex.Lock
+	//closed and renewed when commitLength changes, protected by ex.Lock
+	commitChanged chan struct{}
+
+	//rolling checksum of [0, sumLength), updated by writer and Checksum
+	sumLock   sync.Mutex
+	sumLength uint32
+	sum       utils.CRC
 }
 
 //format to JSON
@@ -105,10 +116,8 @@ func CreateCopyExtent(fileName string, ID uint64) (string, error) {
 	}
 	f.Sync()
 	defer f.Close()
-	extentHeader := newExtentHeader(ID)
-	value := extentHeader.Marshal()
 
-	if err := xattr.FSet(f, XATTRMETA, value); err != nil {
+	if err := writeExtentMeta(fileName, &extentMeta{ID: ID}); err != nil {
 		return "", err
 	}
 	return fileName, nil
@@ -120,10 +129,9 @@ func CreateExtent(fileName string, ID uint64) (*Extent, error) {
 	if err != nil {
 		return nil, err
 	}
-	extentHeader := newExtentHeader(ID)
-	value := extentHeader.Marshal()
-
-	if err := xattr.FSet(f, XATTRMETA, value); err != nil {
+	meta := &extentMeta{ID: ID}
+	if err := writeExtentMeta(fileName, meta); err != nil {
+		f.Close()
 		return nil, err
 	}
 
@@ -135,6 +143,8 @@ func CreateExtent(fileName string, ID uint64) (*Extent, error) {
 		commitLength: 0,
 		fileName:     fileName,
 		file:         f,
+		format:       FormatV2,
+		meta:         meta,
 	}
 	ex.resetWriter()
 	return ex, nil
@@ -142,6 +152,12 @@ func CreateExtent(fileName string, ID uint64) (*Extent, error) {
 }
 
 func OpenExtent(fileName string) (*Extent, error) {
+	meta, err := readExtentMeta(fileName)
+	if err == nil {
+		return openExtentV2(fileName, meta)
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
 
 	d, err := xattr.LGet(fileName, XATTRSEAL)
 
@@ -168,6 +184,7 @@ func OpenExtent(fileName string) (*Extent, error) {
 			fileName:     fileName,
 			file:         file,
 			ID:           eh.ID,
+			format:       FormatV1,
 		}
 		return ex, nil
 	}
@@ -203,11 +220,62 @@ func OpenExtent(fileName string) (*Extent, error) {
 		file:         f,
 		ID:           eh.ID,
 		lastRevision: rev,
+		format:       FormatV1,
+	}
+	ex.resetWriter()
+	return ex, nil
+}
+
+func openExtentV2(fileName string, meta *extentMeta) (*Extent, error) {
+	if meta.Sealed {
+		file, err := os.Open(fileName)
+		if err != nil {
+			return nil, err
+		}
+		info, _ := file.Stat()
+		if info.Size() < int64(meta.SealedLength) {
+			file.Close()
+			return nil, errors.Errorf("check extent file, %s is shorter than sealed length %d", fileName, meta.SealedLength)
+		}
+		return &Extent{
+			isSeal:       1,
+			commitLength: meta.SealedLength,
+			fileName:     fileName,
+			file:         file,
+			ID:           meta.ID,
+			lastRevision: meta.Revision,
+			format:       FormatV2,
+			meta:         meta,
+		}, nil
+	}
+
+	f, err := os.OpenFile(fileName, os.O_RDWR, 0644)
+	if err != nil {
+		return nil, err
+	}
+	info, _ := f.Stat()
+	if info.Size() > math.MaxUint32 {
+		f.Close()
+		return nil, errors.Errorf("check extent file, the extent file is too big")
+	}
+	ex := &Extent{
+		commitLength: uint32(info.Size()),
+		fileName:     fileName,
+		file:         f,
+		ID:           meta.ID,
+		lastRevision: meta.Revision,
+		format:       FormatV2,
+		meta:         meta,
 	}
 	ex.resetWriter()
 	return ex, nil
 }
 
+//Format returns FormatV1 or FormatV2
+func (ex *Extent) Format() int {
+	return ex.format
+}
+
 //support multple threads
 //limit max read size
 type extentReader struct {
@@ -265,11 +333,21 @@ func (ex *Extent) Seal(commit uint32) error {
 	if err = ex.file.Truncate(int64(commit)); err != nil {
 		return err
 	}
-	if err = xattr.FSet(ex.file, XATTRSEAL, []byte("true")); err != nil {
+	ex.truncateChecksum(commit)
+	if ex.format == FormatV2 {
+		meta := *ex.meta
+		meta.Sealed = true
+		meta.SealedLength = commit
+		if err = writeExtentMeta(ex.fileName, &meta); err != nil {
+			return err
+		}
+		ex.meta = &meta
+	} else if err = xattr.FSet(ex.file, XATTRSEAL, []byte("true")); err != nil {
 		return err
 	}
 
 	atomic.StoreInt32(&ex.isSeal, 1)
+	ex.notifyCommit()
 	return nil
 
 }
@@ -337,7 +415,40 @@ func (ex *Extent) Truncate(length uint32) error {
 	if err := ex.file.Truncate(int64(length)); err != nil {
 		return err
 	}
+	ex.truncateChecksum(length)
 	ex.resetWriter()
+	ex.notifyCommit()
+	return nil
+}
+
+func (ex *Extent) notifyCommit() {
+	if ex.commitChanged != nil {
+		close(ex.commitChanged)
+		ex.commitChanged = nil
+	}
+}
+
+//WaitCommitLength waits until commitLength >= length, pipelined appends could arrive out of order.
+//ex must be locked, and it is still locked when WaitCommitLength returns
+func (ex *Extent) WaitCommitLength(ctx context.Context, length uint32) error {
+	ex.AssertLock()
+	for ex.CommitLength() < length {
+		if ex.IsSeal() {
+			return errors.Errorf("extent %d is sealed", ex.ID)
+		}
+		if ex.commitChanged == nil {
+			ex.commitChanged = make(chan struct{})
+		}
+		ch := ex.commitChanged
+		ex.Unlock()
+		select {
+		case <-ctx.Done():
+			ex.Lock()
+			return ctx.Err()
+		case <-ch:
+		}
+		ex.Lock()
+	}
 	return nil
 }
 
@@ -346,12 +457,27 @@ func (ex *Extent) HasLock(revision int64) bool {
 		return true
 	} else if ex.lastRevision < revision {
 		ex.lastRevision = revision
-		xattr.FSet(ex.file, XATTRREV, []byte(strconv.FormatInt(revision, 10)))
+		if ex.format == FormatV2 {
+			meta := *ex.meta
+			meta.Revision = revision
+			if err := writeExtentMeta(ex.fileName, &meta); err != nil {
+				xlog.Logger.Errorf("save revision of extent %d: %v", ex.ID, err)
+			} else {
+				ex.meta = &meta
+			}
+		} else {
+			xattr.FSet(ex.file, XATTRREV, []byte(strconv.FormatInt(revision, 10)))
+		}
 		return true
 	}
 	return false
 }
 
+//Revision returns the lock revision of the last writer
+func (ex *Extent) Revision() int64 {
+	return ex.lastRevision
+}
+
 func (ex *Extent) resetWriter() {
 	if ex.writer != nil {
 		ex.writer.Close()
@@ -370,7 +496,7 @@ func (ex *Extent) resetWriter() {
 	ex.file.Seek(int64(currentLength), os.SEEK_SET)
 	bn := (currentLength / record.BlockSize)
 	offset := currentLength % record.BlockSize
-	newWriter := record.NewLogWriter(ex.file, int64(bn), int32(offset))
+	newWriter := record.NewLogWriter(&checksumFile{File: ex.file, extent: ex, pos: currentLength}, int64(bn), int32(offset))
 	ex.writer = newWriter
 	return
 }
@@ -416,6 +542,7 @@ func (ex *Extent) RecoveryData(start uint32, rev int64, blocks []block) error {
 	}
 
 	newWriter.Close() //close will force flush data to underlying file. but doesn't close file
+	ex.truncateChecksum(start)
 
 	info, err := ex.file.Stat()
 	utils.Check(err)
@@ -425,6 +552,10 @@ func (ex *Extent) RecoveryData(start uint32, rev int64, blocks []block) error {
 }
 
 func (ex *Extent) Sync() error {
+	//data written by rawWriter is not in writer
+	if ex.writer == nil {
+		return ex.file.Sync()
+	}
 	return ex.writer.Sync()
 }
 
@@ -443,6 +574,7 @@ func (ex *Extent) AppendBlocks(blocks []block, doSync bool) ([]uint32, uint32, e
 
 		utils.Check(ex.file.Truncate(int64(currentLength)))
 		ex.file.Sync()
+		ex.truncateChecksum(currentLength)
 		atomic.StoreUint32(&ex.commitLength, currentLength)
 		//reset writer
 		ex.resetWriter()
@@ -470,6 +602,7 @@ func (ex *Extent) AppendBlocks(blocks []block, doSync bool) ([]uint32, uint32, e
 	utils.AssertTrue(end <= math.MaxUint32)
 
 	atomic.StoreUint32(&ex.commitLength, uint32(end))
+	ex.notifyCommit()
 	return offsets, uint32(end), nil
 }
 
@@ -504,6 +637,12 @@ func (ex *Extent) ValidAllBlocks(start int64) (uint32, error) {
 type block = []byte
 
 func (ex *Extent) ReadBlocks(offset uint32, maxNumOfBlocks uint32, maxTotalSize uint32) ([]block, []uint32, uint32, error) {
+	return ex.ReadBlocksTo(nil, offset, maxNumOfBlocks, maxTotalSize)
+}
+
+//ReadBlocksTo reads blocks into buf[:0], returned blocks are slices of buf if buf has enough room,
+//so caller could reuse buf after blocks are not used
+func (ex *Extent) ReadBlocksTo(buf []byte, offset uint32, maxNumOfBlocks uint32, maxTotalSize uint32) ([]block, []uint32, uint32, error) {
 
 	var ret []block
 	//TODO: fix block number
@@ -515,7 +654,8 @@ func (ex *Extent) ReadBlocks(offset uint32, maxNumOfBlocks uint32, maxTotalSize
 	}
 
 	wrapReader := ex.GetReader() //thread-safe
-	rr := record.NewReader(wrapReader)
+	rr := record.GetReader(wrapReader)
+	defer record.PutReader(rr)
 	err := rr.SeekRecord(int64(offset))
 	if err != nil {
 		return nil, nil, 0, err
@@ -523,6 +663,7 @@ func (ex *Extent) ReadBlocks(offset uint32, maxNumOfBlocks uint32, maxTotalSize
 
 	var offsets []uint32
 	var end uint32
+	buf = buf[:0]
 	for i := 0; uint32(i) < maxNumOfBlocks; i++ {
 		reader, err := rr.Next()
 		start := rr.Offset()
@@ -536,14 +677,15 @@ func (ex *Extent) ReadBlocks(offset uint32, maxNumOfBlocks uint32, maxTotalSize
 			//return nil, nil, 0, err
 		}
 
-		data, err := ioutil.ReadAll(reader)
+		n := len(buf)
+		buf, err = record.ReadRecordTo(buf, reader)
 
 		if rr.End()-int64(offset) > int64(maxTotalSize) && len(ret) > 0 {
 			end = uint32(start)
 			break
 		}
 
-		ret = append(ret, data)
+		ret = append(ret, buf[n:len(buf):len(buf)])
 		offsets = append(offsets, uint32(start))
 		end = uint32(rr.End())
 	}
@@ -599,6 +741,74 @@ func (ex *Extent) CommitLength() uint32 {
 	return atomic.LoadUint32(&ex.commitLength)
 }
 
+//checksumFile updates rolling checksum of extent when LogWriter flushes data
+type checksumFile struct {
+	*os.File
+	extent *Extent
+	pos    uint32
+}
+
+func (f *checksumFile) Write(p []byte) (int, error) {
+	n, err := f.File.Write(p)
+	f.extent.updateChecksum(f.pos, p[:n])
+	f.pos += uint32(n)
+	return n, err
+}
+
+//updateChecksum adds data at pos to rolling checksum, if there is a gap, the checksum
+//is caught up in Checksum
+func (ex *Extent) updateChecksum(pos uint32, data []byte) {
+	ex.sumLock.Lock()
+	defer ex.sumLock.Unlock()
+	if pos == ex.sumLength {
+		ex.sum = ex.sum.Update(data)
+		ex.sumLength += uint32(len(data))
+	} else if pos < ex.sumLength {
+		ex.sumLength, ex.sum = 0, 0
+	}
+}
+
+//truncateChecksum drops rolling checksum if data after length is changed
+func (ex *Extent) truncateChecksum(length uint32) {
+	ex.sumLock.Lock()
+	defer ex.sumLock.Unlock()
+	if length < ex.sumLength {
+		ex.sumLength, ex.sum = 0, 0
+	}
+}
+
+//Checksum returns crc32c of [0, commitLength), only data after the last checksumed
+//position is read from disk
+func (ex *Extent) Checksum() (uint32, error) {
+	ex.sumLock.Lock()
+	defer ex.sumLock.Unlock()
+
+	length := ex.CommitLength()
+	if length < ex.sumLength {
+		ex.sumLength, ex.sum = 0, 0
+	}
+	if ex.sumLength < length {
+		buf := make([]byte, utils.Min(int(length-ex.sumLength), 1<<20))
+		for ex.sumLength < length {
+			n := utils.Min(len(buf), int(length-ex.sumLength))
+			if _, err := ex.file.ReadAt(buf[:n], int64(ex.sumLength)); err != nil {
+				return 0, err
+			}
+			ex.sum = ex.sum.Update(buf[:n])
+			ex.sumLength += uint32(n)
+		}
+	}
+	return ex.sum.Value(), nil
+}
+
+//ComputeChecksum rereads the whole extent to find corrupted data on disk
+func (ex *Extent) ComputeChecksum() (uint32, error) {
+	ex.sumLock.Lock()
+	ex.sumLength, ex.sum = 0, 0
+	ex.sumLock.Unlock()
+	return ex.Checksum()
+}
+
 //helper function, block could be pb.Entries, support ReadEntries
 //ReadEntries can only be called on replicated extent
 //node_service will never call this function, this function is only for test
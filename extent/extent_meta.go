@@ -0,0 +1,262 @@
+/*
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless  by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package extent
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/journeymidnight/autumn/utils"
+	"github.com/pkg/errors"
+	"github.com/pkg/xattr"
+)
+
+/*
+extent format v1 keeps metadata in xattrs XATTRMETA, XATTRSEAL and XATTRREV of the extent file.
+format v2 keeps metadata in a sidecar file "<extent file>.meta", so it works on filesystems without
+user xattrs:
+
+	magic(8) | ID(8) | sealed(1) | sealedLength(4) | revision(8) | crc32(4)
+
+the sidecar is replaced atomically by writing a temporary file and renaming it.
+new extents are always v2, OpenExtent reads both formats, MigrateExtent converts v1 extents.
+*/
+
+const (
+	extentMetaMagic = "EXTMETA2"
+	extentMetaSize  = 33
+	metaSuffix      = ".meta"
+)
+
+const (
+	FormatV1 = 1
+	FormatV2 = 2
+)
+
+type extentMeta struct {
+	ID           uint64
+	Sealed       bool
+	SealedLength uint32
+	Revision     int64
+}
+
+func (m *extentMeta) Marshal() []byte {
+	buf := make([]byte, extentMetaSize)
+	copy(buf, extentMetaMagic)
+	binary.BigEndian.PutUint64(buf[8:], m.ID)
+	if m.Sealed {
+		buf[16] = 1
+	}
+	binary.BigEndian.PutUint32(buf[17:], m.SealedLength)
+	binary.BigEndian.PutUint64(buf[21:], uint64(m.Revision))
+	binary.BigEndian.PutUint32(buf[29:], utils.NewCRC(buf[:29]).Value())
+	return buf
+}
+
+func (m *extentMeta) Unmarshal(data []byte) error {
+	if len(data) != extentMetaSize || !bytes.Equal(data[:8], []byte(extentMetaMagic)) {
+		return errors.New("meta data is not corret")
+	}
+	if binary.BigEndian.Uint32(data[29:]) != utils.NewCRC(data[:29]).Value() {
+		return errors.New("checksum of meta data mismatch")
+	}
+	m.ID = binary.BigEndian.Uint64(data[8:])
+	m.Sealed = data[16] == 1
+	m.SealedLength = binary.BigEndian.Uint32(data[17:])
+	m.Revision = int64(binary.BigEndian.Uint64(data[21:]))
+	return nil
+}
+
+//MetaFileName returns the sidecar file of extent format v2
+func MetaFileName(fileName string) string {
+	return fileName + metaSuffix
+}
+
+//readExtentMeta returns error of os.IsNotExist if the extent is not v2
+func readExtentMeta(fileName string) (*extentMeta, error) {
+	data, err := ioutil.ReadFile(MetaFileName(fileName))
+	if err != nil {
+		return nil, err
+	}
+	var meta extentMeta
+	if err = meta.Unmarshal(data); err != nil {
+		return nil, errors.Wrapf(err, "read %s", MetaFileName(fileName))
+	}
+	return &meta, nil
+}
+
+//writeExtentMeta replaces the sidecar of fileName atomically
+func writeExtentMeta(fileName string, meta *extentMeta) error {
+	metaName := MetaFileName(fileName)
+	tmpName := metaName + ".tmp"
+	f, err := os.OpenFile(tmpName, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(meta.Marshal()); err == nil {
+		err = f.Sync()
+	}
+	f.Close()
+	if err != nil {
+		os.Remove(tmpName)
+		return err
+	}
+	if err = os.Rename(tmpName, metaName); err != nil {
+		return err
+	}
+	return syncDir(filepath.Dir(fileName))
+}
+
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
+
+//FileFormat returns the format of the extent file
+func FileFormat(fileName string) (int, error) {
+	_, err := os.Stat(MetaFileName(fileName))
+	if err == nil {
+		return FormatV2, nil
+	} else if os.IsNotExist(err) {
+		return FormatV1, nil
+	}
+	return 0, err
+}
+
+//RenameExtentFile renames the extent file and its sidecar, the extent file is renamed first.
+//if it is interrupted between them, FinishRename moves the sidecar left with oldName
+func RenameExtentFile(oldName, newName string) error {
+	if err := os.Rename(oldName, newName); err != nil {
+		return err
+	}
+	err := os.Rename(MetaFileName(oldName), MetaFileName(newName))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+//FinishRename completes RenameExtentFile(oldName, newName) interrupted after the extent file is renamed,
+//the sidecar of oldName is moved to newName, or removed if it belongs to nothing.
+//it returns false if there is nothing to do
+func FinishRename(oldName, newName string) (bool, error) {
+	metaName := MetaFileName(oldName)
+	if exist, err := fileExists(metaName); err != nil || !exist {
+		return false, err
+	}
+	if exist, err := fileExists(oldName); err != nil || exist {
+		return false, err
+	}
+	exist, err := fileExists(newName)
+	if err != nil {
+		return false, err
+	}
+	if exist {
+		newMetaExist, err := fileExists(MetaFileName(newName))
+		if err != nil {
+			return false, err
+		}
+		if !newMetaExist {
+			return true, os.Rename(metaName, MetaFileName(newName))
+		}
+	}
+	return true, os.Remove(metaName)
+}
+
+//ExtentFileName returns the extent file of sidecar metaName, it returns false if metaName is not a sidecar
+func ExtentFileName(metaName string) (string, bool) {
+	if !strings.HasSuffix(metaName, metaSuffix) {
+		return "", false
+	}
+	return strings.TrimSuffix(metaName, metaSuffix), true
+}
+
+//IsTempMetaFile returns true if fileName is a sidecar left by an interrupted writeExtentMeta
+func IsTempMetaFile(fileName string) bool {
+	return strings.HasSuffix(fileName, metaSuffix+".tmp")
+}
+
+func fileExists(fileName string) (bool, error) {
+	_, err := os.Stat(fileName)
+	if err == nil {
+		return true, nil
+	} else if os.IsNotExist(err) {
+		return false, nil
+	}
+	return false, err
+}
+
+//RemoveExtentFile removes the extent file and its sidecar
+func RemoveExtentFile(fileName string) error {
+	if err := os.Remove(fileName); err != nil {
+		return err
+	}
+	if err := os.Remove(MetaFileName(fileName)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+//MigrateExtent converts extent of format v1 to v2 in place, the extent must not be opened.
+//it returns false if the extent is already v2
+func MigrateExtent(fileName string) (bool, error) {
+	format, err := FileFormat(fileName)
+	if err != nil {
+		return false, err
+	}
+	if format == FormatV2 {
+		return false, nil
+	}
+
+	f, err := os.Open(fileName)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+	eh, err := readExtentHeader(f)
+	if err != nil {
+		return false, err
+	}
+	meta := extentMeta{ID: eh.ID}
+	if d, err := xattr.FGet(f, XATTRSEAL); err == nil && bytes.Equal(d, []byte("true")) {
+		info, err := f.Stat()
+		if err != nil {
+			return false, err
+		}
+		meta.Sealed = true
+		meta.SealedLength = uint32(info.Size())
+	}
+	if d, err := xattr.FGet(f, XATTRREV); err == nil {
+		meta.Revision, _ = strconv.ParseInt(string(d), 10, 64)
+	}
+
+	//the extent is v2 once the sidecar is written, xattrs are not used any more
+	if err = writeExtentMeta(fileName, &meta); err != nil {
+		return false, err
+	}
+	for _, name := range []string{XATTRMETA, XATTRSEAL, XATTRREV} {
+		xattr.FRemove(f, name)
+	}
+	return true, nil
+}
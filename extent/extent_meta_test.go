@@ -0,0 +1,161 @@
+package extent
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/xattr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtentMeta(t *testing.T) {
+	meta := extentMeta{ID: 10, Sealed: true, SealedLength: 100, Revision: 7}
+	data := meta.Marshal()
+	var m extentMeta
+	require.NoError(t, m.Unmarshal(data))
+	require.Equal(t, meta, m)
+
+	data[10]++
+	require.Error(t, m.Unmarshal(data))
+	require.Error(t, m.Unmarshal(data[:10]))
+}
+
+func TestExtentV2(t *testing.T) {
+	p, err := ioutil.TempDir(os.TempDir(), "extenttest")
+	require.Nil(t, err)
+	defer os.RemoveAll(p)
+	fileName := filepath.Join(p, "100.ext")
+
+	ex, err := CreateExtent(fileName, 100)
+	require.Nil(t, err)
+	require.Equal(t, FormatV2, ex.Format())
+	ex.Lock()
+	_, _, err = ex.AppendBlocks([][]byte{generateBlock(4096)}, true)
+	require.Nil(t, err)
+	require.True(t, ex.HasLock(5))
+	require.False(t, ex.HasLock(4))
+	ex.Unlock()
+	commit := ex.CommitLength()
+	ex.Close()
+
+	ex, err = OpenExtent(fileName)
+	require.Nil(t, err)
+	require.Equal(t, FormatV2, ex.Format())
+	require.Equal(t, uint64(100), ex.ID)
+	require.Equal(t, int64(5), ex.Revision())
+	require.Equal(t, commit, ex.CommitLength())
+	require.False(t, ex.IsSeal())
+	ex.Lock()
+	require.Nil(t, ex.Seal(commit))
+	ex.Unlock()
+	ex.Close()
+
+	//no xattrs are used
+	for _, name := range []string{XATTRMETA, XATTRSEAL, XATTRREV} {
+		_, err = xattr.Get(fileName, name)
+		require.Error(t, err)
+	}
+
+	newName := filepath.Join(p, "101.ext")
+	require.Nil(t, RenameExtentFile(fileName, newName))
+	ex, err = OpenExtent(newName)
+	require.Nil(t, err)
+	require.True(t, ex.IsSeal())
+	require.Equal(t, commit, ex.CommitLength())
+	require.Equal(t, int64(5), ex.Revision())
+	ex.Close()
+
+	//corrupted sidecar
+	require.Nil(t, ioutil.WriteFile(MetaFileName(newName), []byte("bad"), 0644))
+	_, err = OpenExtent(newName)
+	require.Error(t, err)
+
+	require.Nil(t, RemoveExtentFile(newName))
+	files, err := ioutil.ReadDir(p)
+	require.Nil(t, err)
+	require.Equal(t, 0, len(files))
+}
+
+func TestFinishRename(t *testing.T) {
+	p, err := ioutil.TempDir(os.TempDir(), "extenttest")
+	require.Nil(t, err)
+	defer os.RemoveAll(p)
+	copyName := filepath.Join(p, "100.2.copy")
+	extName := filepath.Join(p, "100.ext")
+
+	_, err = CreateCopyExtent(copyName, 100)
+	require.Nil(t, err)
+	//nothing is interrupted
+	fixed, err := FinishRename(copyName, extName)
+	require.Nil(t, err)
+	require.False(t, fixed)
+
+	//RenameExtentFile is interrupted after the extent file is renamed
+	require.Nil(t, os.Rename(copyName, extName))
+	fixed, err = FinishRename(copyName, extName)
+	require.Nil(t, err)
+	require.True(t, fixed)
+	ex, err := OpenExtent(extName)
+	require.Nil(t, err)
+	require.Equal(t, FormatV2, ex.Format())
+	require.Equal(t, uint64(100), ex.ID)
+	ex.Close()
+
+	//sidecar of nothing
+	require.Nil(t, ioutil.WriteFile(MetaFileName(copyName), []byte("x"), 0644))
+	fixed, err = FinishRename(copyName, copyName)
+	require.Nil(t, err)
+	require.True(t, fixed)
+	_, err = os.Stat(MetaFileName(copyName))
+	require.True(t, os.IsNotExist(err))
+
+	name, ok := ExtentFileName(MetaFileName(extName))
+	require.True(t, ok)
+	require.Equal(t, extName, name)
+	_, ok = ExtentFileName(extName)
+	require.False(t, ok)
+	require.True(t, IsTempMetaFile(MetaFileName(extName)+".tmp"))
+	require.False(t, IsTempMetaFile(MetaFileName(extName)))
+}
+
+func TestMigrateExtent(t *testing.T) {
+	p, err := ioutil.TempDir(os.TempDir(), "extenttest")
+	require.Nil(t, err)
+	defer os.RemoveAll(p)
+	fileName := filepath.Join(p, "100.ext")
+
+	//extent of format v1
+	data := generateBlock(4096)
+	require.Nil(t, ioutil.WriteFile(fileName, data, 0644))
+	require.Nil(t, xattr.Set(fileName, XATTRMETA, newExtentHeader(100).Marshal()))
+	require.Nil(t, xattr.Set(fileName, XATTRREV, []byte("9")))
+	require.Nil(t, xattr.Set(fileName, XATTRSEAL, []byte("true")))
+
+	ex, err := OpenExtent(fileName)
+	require.Nil(t, err)
+	require.Equal(t, FormatV1, ex.Format())
+	ex.Close()
+
+	migrated, err := MigrateExtent(fileName)
+	require.Nil(t, err)
+	require.True(t, migrated)
+	migrated, err = MigrateExtent(fileName)
+	require.Nil(t, err)
+	require.False(t, migrated)
+
+	for _, name := range []string{XATTRMETA, XATTRSEAL, XATTRREV} {
+		_, err = xattr.Get(fileName, name)
+		require.Error(t, err)
+	}
+
+	ex, err = OpenExtent(fileName)
+	require.Nil(t, err)
+	defer ex.Close()
+	require.Equal(t, FormatV2, ex.Format())
+	require.Equal(t, uint64(100), ex.ID)
+	require.True(t, ex.IsSeal())
+	require.Equal(t, uint32(len(data)), ex.CommitLength())
+	require.Equal(t, int64(9), ex.Revision())
+}
@@ -1,12 +1,14 @@
 package extent
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"sync"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/journeymidnight/autumn/extent/record"
 	"github.com/journeymidnight/autumn/extent/wal"
@@ -41,7 +43,7 @@ func TestReadEntries(t *testing.T) {
 	data, err := entry.Marshal()
 	require.Nil(t, err)
 	extent, err := CreateExtent("localtest.ext", 100)
-	defer os.Remove("localtest.ext")
+	defer RemoveExtentFile("localtest.ext")
 	extent.Lock()
 	extent.AppendBlocks([]*pb.Block{{Data: data}}, true)
 	extent.Unlock()
@@ -64,7 +66,7 @@ func TestAppendReadFile(t *testing.T) {
 	}
 
 	extent, err := CreateExtent("localtest.ext", 100)
-	defer os.Remove("localtest.ext")
+	defer RemoveExtentFile("localtest.ext")
 	//extent.ResetWriter()
 	assert.Nil(t, err)
 	extent.Lock()
@@ -112,6 +114,29 @@ func TestAppendReadFile(t *testing.T) {
 
 }
 
+func TestReadBlocksToSmallBuffer(t *testing.T) {
+	cases := [][]byte{
+		generateBlock(4096),
+		generateBlock(64 << 10),
+		generateBlock(8192),
+	}
+
+	extent, err := CreateExtent("localtest.ext", 100)
+	defer RemoveExtentFile("localtest.ext")
+	assert.Nil(t, err)
+	extent.Lock()
+	ret, _, err := extent.AppendBlocks(cases, true)
+	extent.Unlock()
+	assert.Nil(t, err)
+
+	//buf grows if blocks are larger than it
+	buf := make([]byte, 0, 1024)
+	retBlocks, offsets, _, err := extent.ReadBlocksTo(buf, ret[0], 3, (20 << 20))
+	assert.Nil(t, err)
+	assert.Equal(t, ret, offsets)
+	assert.Equal(t, cases, retBlocks)
+}
+
 func TestReplayExtent(t *testing.T) {
 
 	extentName := "localtest.ext"
@@ -124,7 +149,7 @@ func TestReplayExtent(t *testing.T) {
 
 	extent, err := CreateExtent(extentName, 100)
 	//extent.ResetWriter()
-	defer os.Remove(extentName)
+	defer RemoveExtentFile(extentName)
 	assert.Nil(t, err)
 	extent.Lock()
 	_, _, err = extent.AppendBlocks(cases, true)
@@ -168,14 +193,14 @@ func TestReplayExtent(t *testing.T) {
 func TestWalExtent(t *testing.T) {
 	extent, err := CreateExtent("localtest.ext", 100)
 	//extent.ResetWriter()
-	defer os.Remove("localtest.ext")
+	defer RemoveExtentFile("localtest.ext")
 	if err != nil {
 		panic(err.Error())
 	}
 	p, err := ioutil.TempDir(os.TempDir(), "waltest")
 	defer os.RemoveAll(p)
 
-	walLog, err := wal.OpenWal(p, func() {
+	walLog, err := wal.OpenWal(wal.Options{Dirs: []string{p}}, func() {
 		extent.Sync()
 	})
 	require.Nil(t, err)
@@ -231,7 +256,7 @@ func TestWalExtent(t *testing.T) {
 
 	extent, err = OpenExtent("localtest.ext")
 	require.Nil(t, err)
-	walLog, err = wal.OpenWal(p, func() {})
+	walLog, err = wal.OpenWal(wal.Options{Dirs: []string{p}}, func() {})
 	require.Nil(t, err)
 
 	err = walLog.Replay(func(_ uint64, start uint32, rev int64, blocks [][]byte) {
@@ -265,7 +290,7 @@ func TestValidBlock(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, uint32(0), start)
 
-	defer os.Remove("localtest.ext")
+	defer RemoveExtentFile("localtest.ext")
 	extent.Lock()
 	extent.AppendBlocks(cases, true)
 	extent.Unlock()
@@ -291,7 +316,7 @@ func TestReadLastBlock(t *testing.T) {
 
 	extent, err := CreateExtent("localtest.ext", 100)
 	assert.Nil(t, err)
-	defer os.Remove("localtest.ext")
+	defer RemoveExtentFile("localtest.ext")
 
 	extent.Lock()
 	extent.AppendBlocks(cases, true)
@@ -315,7 +340,7 @@ func TestWriteECFriendlyBlock(t *testing.T) {
 	extent, err := CreateExtent("localtest.ext", 100)
 	require.Nil(t, err)
 	//extent.ResetWriter()
-	defer os.Remove("localtest.ext")
+	defer RemoveExtentFile("localtest.ext")
 	b1 := generateBlock(512 << 10)
 	extent.Lock()
 	extent.AppendBlocks([][]byte{b1}, true)
@@ -333,7 +358,7 @@ func TestWriteECFriendlyBlock(t *testing.T) {
 func BenchmarkExtentWithoutSync(b *testing.B) {
 	extent, err := CreateExtent("localtest.ext", 100)
 	//extent.ResetWriter()
-	defer os.Remove("localtest.ext")
+	defer RemoveExtentFile("localtest.ext")
 	if err != nil {
 		panic(err.Error())
 	}
@@ -355,7 +380,7 @@ func BenchmarkExtentWithoutSync(b *testing.B) {
 func BenchmarkExtent(b *testing.B) {
 	extent, err := CreateExtent("localtest.ext", 100)
 	//extent.ResetWriter()
-	defer os.Remove("localtest.ext")
+	defer RemoveExtentFile("localtest.ext")
 	if err != nil {
 		panic(err.Error())
 	}
@@ -374,3 +399,86 @@ func BenchmarkExtent(b *testing.B) {
 	}
 
 }
+
+func TestWaitCommitLength(t *testing.T) {
+	extent, err := CreateExtent("localtest.ext", 100)
+	require.Nil(t, err)
+	defer RemoveExtentFile("localtest.ext")
+
+	first := []block{generateBlock(4096)}
+	end := record.ComputeEnd(0, 4096)
+
+	//the second append arrives before the first one
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		extent.Lock()
+		defer extent.Unlock()
+		require.Nil(t, extent.WaitCommitLength(context.Background(), end))
+		_, _, err := extent.AppendBlocks([]block{generateBlock(4096)}, false)
+		require.Nil(t, err)
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	extent.Lock()
+	offsets, _, err := extent.AppendBlocks(first, false)
+	extent.Unlock()
+	require.Nil(t, err)
+	require.Equal(t, uint32(0), offsets[0])
+	wg.Wait()
+	require.Equal(t, record.ComputeEnd(end, 4096), extent.CommitLength())
+
+	//timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	extent.Lock()
+	require.Equal(t, context.DeadlineExceeded, extent.WaitCommitLength(ctx, extent.CommitLength()+1))
+	extent.Unlock()
+}
+
+func TestExtentChecksum(t *testing.T) {
+	extent, err := CreateExtent("localtest.ext", 100)
+	require.Nil(t, err)
+	defer RemoveExtentFile("localtest.ext")
+
+	fileChecksum := func() uint32 {
+		data, err := ioutil.ReadFile("localtest.ext")
+		require.Nil(t, err)
+		return utils.NewCRC(data).Value()
+	}
+
+	extent.Lock()
+	_, end, err := extent.AppendBlocks([][]byte{generateBlock(4096), generateBlock(50 << 10)}, true)
+	extent.Unlock()
+	require.Nil(t, err)
+	//rolling checksum is updated by writer
+	require.Equal(t, end, extent.sumLength)
+	sum, err := extent.Checksum()
+	require.Nil(t, err)
+	require.Equal(t, fileChecksum(), sum)
+
+	extent.Lock()
+	_, _, err = extent.AppendBlocks([][]byte{generateBlock(8192)}, true)
+	require.Nil(t, err)
+	//truncate and seal drop the checksum of truncated data
+	require.Nil(t, extent.Seal(end))
+	extent.Unlock()
+	sum, err = extent.Checksum()
+	require.Nil(t, err)
+	require.Equal(t, fileChecksum(), sum)
+
+	//corrupt data on disk
+	f, err := os.OpenFile("localtest.ext", os.O_RDWR, 0644)
+	require.Nil(t, err)
+	f.WriteAt([]byte("corrupted"), 100)
+	f.Close()
+	cached, err := extent.Checksum()
+	require.Nil(t, err)
+	require.Equal(t, sum, cached)
+	sum, err = extent.ComputeChecksum()
+	require.Nil(t, err)
+	require.NotEqual(t, cached, sum)
+	require.Equal(t, fileChecksum(), sum)
+	extent.Close()
+}
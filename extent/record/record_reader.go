@@ -85,6 +85,7 @@ import (
 	"errors"
 	"io"
 	"os"
+	"sync"
 
 	"github.com/journeymidnight/autumn/utils"
 )
@@ -109,6 +110,18 @@ var (
 
 	// ErrNoLastRecord is returned if LastRecordOffset is called and there is no previous record.
 	ErrNoLastRecord = errors.New("pebble/record: no last record exists")
+
+	// ErrZeroedBlock is returned if the rest of a block is zero, it is common at the end of a preallocated file.
+	ErrZeroedBlock = errors.New("pebble/record: block appears to be zeroed")
+
+	// ErrInvalidChunk is returned if the header of a chunk is invalid.
+	ErrInvalidChunk = errors.New("pebble/record: invalid chunk")
+
+	// ErrChunkOverflow is returned if the length of a chunk overflows its block.
+	ErrChunkOverflow = errors.New("pebble/record: invalid chunk (length overflows block)")
+
+	// ErrChecksumMismatch is returned if the checksum of a chunk is wrong.
+	ErrChecksumMismatch = errors.New("pebble/record: invalid chunk (checksum mismatch)")
 )
 
 type flusher interface {
@@ -155,6 +168,51 @@ func NewReader(r io.Reader) *Reader {
 	}
 }
 
+//Reader has a 128KB buffer, reuse them on read paths
+var readerPool = sync.Pool{
+	New: func() interface{} {
+		return new(Reader)
+	},
+}
+
+//GetReader returns a Reader from pool, call PutReader after reading
+func GetReader(r io.Reader) *Reader {
+	rr := readerPool.Get().(*Reader)
+	//keep seq increasing, so stale singleReaders are still invalid
+	rr.seq++
+	rr.i, rr.j, rr.n = 0, 0, 0
+	rr.started, rr.recovering, rr.last = false, false, false
+	rr.err = nil
+	rr.offset = 0
+	rr.r = r
+	rr.seeker, _ = r.(io.Seeker)
+	return rr
+}
+
+func PutReader(r *Reader) {
+	r.r = nil
+	r.seeker = nil
+	readerPool.Put(r)
+}
+
+//ReadRecordTo appends the record of reader(returned by Next) to dst, it does not allocate
+//if dst has enough room
+func ReadRecordTo(dst []byte, reader io.Reader) ([]byte, error) {
+	for {
+		if len(dst) == cap(dst) {
+			dst = append(dst, 0)[:len(dst)]
+		}
+		n, err := reader.Read(dst[len(dst):cap(dst)])
+		dst = dst[:len(dst)+n]
+		if err == io.EOF {
+			return dst, nil
+		}
+		if err != nil {
+			return dst, err
+		}
+	}
+}
+
 //called AFTER Next()
 //返回的Offset包括Header, 因为r.i并不包括Header
 func (r *Reader) Offset() int64 {
@@ -186,14 +244,14 @@ func (r *Reader) nextChunk(wantFirst bool) error {
 					// via mmap.
 					//
 					// Set r.err to be an error so r.Recover actually recovers.
-					r.err = errors.New("pebble/record: block appears to be zeroed")
+					r.err = ErrZeroedBlock
 					if !r.recovering {
 						return r.err
 					}
 					r.Recover()
 					continue
 				}
-				return errors.New("pebble/record: invalid chunk")
+				return ErrInvalidChunk
 			}
 
 			r.i = r.j + HeaderSize
@@ -203,7 +261,7 @@ func (r *Reader) nextChunk(wantFirst bool) error {
 					r.Recover()
 					continue
 				}
-				return errors.New("pebble/record: invalid chunk (length overflows block)")
+				return ErrChunkOverflow
 			}
 			if checksum != utils.NewCRC(r.buf[r.i-1:r.j]).Value() {
 				//if checksum != crc.New(r.buf[r.i-1:r.j]).Value() {
@@ -211,7 +269,7 @@ func (r *Reader) nextChunk(wantFirst bool) error {
 					r.Recover()
 					continue
 				}
-				return errors.New("pebble/record: invalid chunk (checksum mismatch)")
+				return ErrChecksumMismatch
 			}
 			if wantFirst {
 				if chunkType != fullChunkType && chunkType != firstChunkType {
@@ -0,0 +1,165 @@
+/*
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless  by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package wal
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"sync/atomic"
+
+	"github.com/journeymidnight/autumn/extent/record"
+	"github.com/journeymidnight/autumn/xlog"
+)
+
+//walReader reads entries of old wal files in a stripe
+type walReader struct {
+	files   []string
+	fname   string
+	f       *os.File
+	records *record.Reader
+	legacy  bool //file is written before seq is added
+	n       int  //records read in current file
+	head    *request
+	skipped int
+}
+
+//next sets r.head to the next entry, r.head is nil if all files are read
+func (r *walReader) next() error {
+	r.head = nil
+	for {
+		if r.records == nil {
+			if len(r.files) == 0 {
+				return nil
+			}
+			f, err := os.Open(r.files[0])
+			if err != nil {
+				return err
+			}
+			r.fname, r.f = r.files[0], f
+			r.files = r.files[1:]
+			r.records = record.NewReader(f)
+			r.legacy = true
+			r.n = 0
+		}
+
+		var data []byte
+		rec, err := r.records.Next()
+		if err == nil {
+			data, err = ioutil.ReadAll(rec)
+		}
+		switch {
+		case err == io.EOF || err == record.ErrZeroedBlock:
+			//the rest of a wal file is preallocated
+			r.f.Close()
+			r.records = nil
+			continue
+		case err != nil:
+			//checksum mismatch or the last write is not finished, skip to the next block
+			xlog.Logger.Warnf("%s: skip corrupted record after %d records: %v", r.fname, r.n, err)
+			r.skipped++
+			r.records.Recover()
+			continue
+		}
+
+		r.n++
+		if r.n == 1 && bytes.Equal(data, walMagic) {
+			r.legacy = false
+			continue
+		}
+		req := new(request)
+		if err = req.decode(data, r.legacy); err != nil {
+			xlog.Logger.Warnf("%s: skip record %d: %v", r.fname, r.n, err)
+			r.skipped++
+			continue
+		}
+		r.head = req
+		return nil
+	}
+}
+
+func (r *walReader) close() {
+	if r.records != nil {
+		r.f.Close()
+	}
+}
+
+//Replay calls callback for every entry in wal files written before OpenWal. entries of all
+//dirs are merged by seq, records with wrong checksums or bad entries are skipped, callback
+//should check whether an entry still applies. wal files are deleted after replay
+func (wal *Wal) Replay(callback func(uint64, uint32, int64, [][]byte)) error {
+	readers := make([]*walReader, len(wal.stripes))
+	defer func() {
+		for _, r := range readers {
+			if r != nil {
+				r.close()
+			}
+		}
+	}()
+
+	nFiles := 0
+	for i, s := range wal.stripes {
+		readers[i] = &walReader{files: s.oldWALs}
+		nFiles += len(s.oldWALs)
+		if err := readers[i].next(); err != nil {
+			return err
+		}
+	}
+
+	n := 0
+	maxSeq := uint64(0)
+	for {
+		var min *walReader
+		for _, r := range readers {
+			if r.head != nil && (min == nil || r.head.seq < min.head.seq) {
+				min = r
+			}
+		}
+		if min == nil {
+			break
+		}
+		req := min.head
+		callback(req.extentID, req.start, req.rev, req.data)
+		n++
+		if req.seq > maxSeq {
+			maxSeq = req.seq
+		}
+		if err := min.next(); err != nil {
+			return err
+		}
+	}
+
+	//new entries must have larger seq than replayed ones
+	for {
+		cur := atomic.LoadUint64(&wal.seq)
+		if maxSeq <= cur || atomic.CompareAndSwapUint64(&wal.seq, cur, maxSeq) {
+			break
+		}
+	}
+
+	skipped := 0
+	for _, r := range readers {
+		skipped += r.skipped
+	}
+	if nFiles > 0 {
+		xlog.Logger.Infof("replayed %d entries from %d wal files, %d records skipped", n, nFiles, skipped)
+	}
+
+	for _, s := range wal.stripes {
+		s.cleanPendingWal()
+	}
+	return nil
+}
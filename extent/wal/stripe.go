@@ -0,0 +1,311 @@
+/*
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless  by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package wal
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/journeymidnight/autumn/extent/record"
+	"github.com/journeymidnight/autumn/extent/storage"
+	"github.com/journeymidnight/autumn/utils"
+	"github.com/journeymidnight/autumn/xlog"
+)
+
+//the first record of wal files which have seq in entries
+var walMagic = []byte("AUTUMN-WAL-V2")
+
+//stripe is wal files in one dir
+type stripe struct {
+	wal        *Wal
+	dir        string
+	oldWALs    []string
+	currentWAL *storage.SyncingFile
+	last       uint64
+	writeCh    chan *request
+	stopper    *utils.Stopper
+	writer     *record.LogWriter
+	walOffset  int64
+	userSync   func()
+	syncing    int32
+	gracefull  bool
+	syncWg     sync.WaitGroup
+
+	//stats, atomic
+	commits    uint64
+	entries    uint64
+	bytes      uint64
+	latency    int64
+	maxLatency int64
+}
+
+//FIXME:extent may use storage.File in the future
+func openStripe(wal *Wal, dir string) (*stripe, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var oldWals []string
+	last := uint64(0)
+	for _, file := range files {
+		//filter "*.wal"
+		if !strings.HasSuffix(file.Name(), ".wal") {
+			continue
+		}
+
+		fsz := len(file.Name())
+		fid, err := strconv.ParseUint(file.Name()[:fsz-4], 16, 64)
+		if err != nil {
+			return nil, err
+		}
+		if fid == last {
+			return nil, errors.New("duplicated wal")
+		}
+		if fid > last {
+			last = fid
+		}
+		oldWals = append(oldWals, filepath.Join(dir, file.Name()))
+	}
+
+	//some filesystem can not guarantee readdir is order
+	sort.Slice(oldWals, func(i, j int) bool {
+		return strings.Compare(oldWals[i], oldWals[j]) < 0
+	})
+
+	s := &stripe{
+		wal:     wal,
+		dir:     dir,
+		oldWALs: oldWals,
+		last:    last,
+		writeCh: make(chan *request, 5),
+		stopper: utils.NewStopper(),
+	}
+	//create new wal
+	if err := s.createWal(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *stripe) createWal() error {
+	s.last++
+	fd, err := os.Create(filepath.Join(s.dir, fmt.Sprintf("%016x.wal", s.last)))
+	if err != nil {
+		return err
+	}
+	s.currentWAL = storage.NewSyncingFile(fd, storage.SyncingFileOptions{
+		BytesPerSync:    10 << 20,
+		PreallocateSize: 64 << 20,
+	})
+	s.writer = record.NewLogWriter(s.currentWAL, 0, 0)
+	_, end, err := s.writer.WriteRecord(walMagic)
+	if err != nil {
+		return err
+	}
+	s.walOffset = end
+	return nil
+}
+
+func (s *stripe) cleanPendingWal() {
+	for _, f := range s.oldWALs {
+		os.Remove(f)
+	}
+	s.oldWALs = s.oldWALs[:0]
+}
+
+//rotate will  non-block
+func (s *stripe) rotate() {
+	atomic.StoreInt32(&s.syncing, 1)
+	s.syncWg.Add(1)
+	go func() {
+
+		s.userSync()
+
+		//wait user sync end
+		s.cleanPendingWal()
+		atomic.StoreInt32(&s.syncing, 0)
+		s.syncWg.Done()
+	}()
+}
+
+//doRequest is a single thread function
+func (s *stripe) doRequest(reqs []*request) error {
+
+	done := func(err error) {
+		for _, r := range reqs {
+			r.err = err
+			r.wg.Done()
+		}
+	}
+
+	//rotate wal
+	if s.walOffset > s.wal.opt.MaxWalSize && atomic.LoadInt32(&s.syncing) == 0 {
+		//non-block
+		s.oldWALs = append(s.oldWALs, pathName(s.dir, s.currentWAL))
+		s.writer.Close()
+
+		s.rotate()
+		if err := s.createWal(); err != nil {
+			done(err)
+			return err
+		}
+	}
+
+	//normal write
+	begin := time.Now()
+	size := 0
+	buf := new(bytes.Buffer)
+	for _, req := range reqs {
+		buf.Reset()
+		//seq is assigned in the order of the file, writes of an extent are serialized,
+		//so a later write of the extent always has a larger seq in any stripe
+		req.seq = s.wal.nextSeq()
+		req.encodeTo(buf)
+		size += buf.Len()
+		_, end, err := s.writer.WriteRecord(buf.Bytes())
+		if err != nil {
+			done(err)
+			return err
+		}
+		s.walOffset = end
+	}
+	if err := s.writer.Sync(); err != nil {
+		done(err)
+		return err
+	}
+	s.record(len(reqs), size, time.Since(begin))
+	done(nil)
+	return nil
+}
+
+func (s *stripe) record(n int, size int, latency time.Duration) {
+	atomic.AddUint64(&s.commits, 1)
+	atomic.AddUint64(&s.entries, uint64(n))
+	atomic.AddUint64(&s.bytes, uint64(size))
+	atomic.AddInt64(&s.latency, int64(latency))
+	for {
+		max := atomic.LoadInt64(&s.maxLatency)
+		if int64(latency) <= max || atomic.CompareAndSwapInt64(&s.maxLatency, max, int64(latency)) {
+			return
+		}
+	}
+}
+
+func (s *stripe) stats() Stats {
+	return Stats{
+		Dir:        s.dir,
+		Commits:    atomic.LoadUint64(&s.commits),
+		Entries:    atomic.LoadUint64(&s.entries),
+		Bytes:      atomic.LoadUint64(&s.bytes),
+		Latency:    time.Duration(atomic.LoadInt64(&s.latency)),
+		MaxLatency: time.Duration(atomic.LoadInt64(&s.maxLatency)),
+	}
+}
+
+func (s *stripe) doWrites() {
+	pendingCh := make(chan struct{}, 1)
+	writeRequests := func(reqs []*request) {
+		if err := s.doRequest(reqs); err != nil {
+			xlog.Logger.Errorf(err.Error())
+		}
+		<-pendingCh
+	}
+
+	for {
+		reqs, ok := s.collect(pendingCh)
+		if ok {
+			go writeRequests(reqs)
+			continue
+		}
+		// All the pending request are drained.
+		// Don't close the writeCh, because it has be used in several places.
+		for {
+			select {
+			case r := <-s.writeCh:
+				reqs = append(reqs, r)
+			default:
+				pendingCh <- struct{}{} // Push to pending before doing a write.
+				writeRequests(reqs)
+				return
+			}
+		}
+	}
+}
+
+//collect waits for a request, and picks more requests until the previous write is done
+//and SyncDelay is passed. pendingCh is pushed if it returns true, it returns false if
+//the stripe is closed
+func (s *stripe) collect(pendingCh chan struct{}) ([]*request, bool) {
+	reqs := make([]*request, 0, 10)
+	select {
+	case r := <-s.writeCh:
+		reqs = append(reqs, r)
+	case <-s.stopper.ShouldStop():
+		return reqs, false
+	}
+
+	readyCh := pendingCh
+	var windowC <-chan time.Time
+	if s.wal.opt.SyncDelay > 0 {
+		timer := time.NewTimer(s.wal.opt.SyncDelay)
+		defer timer.Stop()
+		windowC = timer.C
+		readyCh = nil
+	}
+	for {
+		select {
+		// Either push to pending, or continue to pick from writeCh.
+		case r := <-s.writeCh:
+			reqs = append(reqs, r)
+		case <-windowC:
+			windowC = nil
+			readyCh = pendingCh
+		case readyCh <- struct{}{}:
+			return reqs, true
+		case <-s.stopper.ShouldStop():
+			return reqs, false
+		}
+	}
+}
+
+func pathName(dir string, f *storage.SyncingFile) string {
+	info, _ := f.File.Stat()
+	name := info.Name()
+	return filepath.Join(dir, name)
+}
+
+func (s *stripe) close() {
+	s.stopper.Stop()
+	s.syncWg.Wait()
+	//wait for currrent syncing stop
+	if s.gracefull {
+		s.oldWALs = append(s.oldWALs, pathName(s.dir, s.currentWAL))
+		s.currentWAL.Close()
+		s.userSync()
+		s.cleanPendingWal()
+	} else {
+		s.currentWAL.Close()
+	}
+}
@@ -18,238 +18,135 @@ import (
 	"bytes"
 	"encoding/binary"
 	"errors"
-	"fmt"
-	"io"
-	"io/ioutil"
-	"os"
-	"path/filepath"
-	"sort"
-	"strconv"
-	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
+)
 
-	"github.com/journeymidnight/autumn/extent/record"
-	"github.com/journeymidnight/autumn/extent/storage"
-	"github.com/journeymidnight/autumn/utils"
-	"github.com/journeymidnight/autumn/xlog"
+/*
+wal is striped over Options.Dirs, one stripe per dir, and each stripe has its own
+wal files and writing goroutine. writes of an extent always go to the same stripe.
+every entry has a sequence number which is increasing over all stripes, writes of an
+extent are serialized by the extent, so replay merges stripes by sequence number and
+keeps the order of writes of every extent, even if dirs are changed between restarts.
+*/
+
+const (
+	DefaultMaxWalSize   = int64(250 << 20) //250MB
+	DefaultMaxEntrySize = 2 << 20          //2MB
 )
 
+type Options struct {
+	Dirs []string
+	//rotate a wal file when it is bigger than MaxWalSize
+	MaxWalSize int64
+	//wait SyncDelay after the first write of a group commit for more writes, 0 syncs as soon as possible
+	SyncDelay time.Duration
+	//writes bigger than MaxEntrySize bypass wal, extents sync them directly
+	MaxEntrySize int
+}
+
+func (opt *Options) fillDefault() {
+	if opt.MaxWalSize <= 0 {
+		opt.MaxWalSize = DefaultMaxWalSize
+	}
+	if opt.MaxEntrySize <= 0 {
+		opt.MaxEntrySize = DefaultMaxEntrySize
+	}
+}
+
 type Wal struct {
-	dir        string
-	oldWALs    []string
-	currentWAL *storage.SyncingFile
-	last       uint64
-	writeCh    chan *request
-	stopper    *utils.Stopper
-	writer     *record.LogWriter
-	walOffset  int64
-	userSync   func()
-	syncing    int32
-	gracefull  bool
-	syncWg     sync.WaitGroup
+	opt      Options
+	stripes  []*stripe
+	seq      uint64 //atomic, sequence number of the last entry
+	bypassed uint64 //atomic
+}
+
+//Stats of group commits of a wal dir
+type Stats struct {
+	Dir        string
+	Commits    uint64 //number of syncs
+	Entries    uint64 //number of writes in all commits
+	Bytes      uint64
+	Latency    time.Duration //total time of writing and syncing
+	MaxLatency time.Duration
 }
 
-var maxWalSize = int64(250 << 20) //250MB
 var requestPool = sync.Pool{
 	New: func() interface{} {
 		return new(request)
 	},
 }
 
-//FIXME:extent may use storage.File in the future
-func OpenWal(dir string, userSync func()) (*Wal, error) {
+//OpenWal creates a new wal file in every dir, userSync should sync all extents, wal files
+//written before userSync are deleted after it returns
+func OpenWal(opt Options, userSync func()) (*Wal, error) {
 	if userSync == nil {
 		return nil, errors.New("no userSync functions")
 	}
-	files, err := ioutil.ReadDir(dir)
-	if err != nil {
-		return nil, err
+	if len(opt.Dirs) == 0 {
+		return nil, errors.New("no wal dirs")
 	}
-	var oldWals []string
-	last := uint64(0)
-	for _, file := range files {
-		//filter "*.wal"
-		if !strings.HasSuffix(file.Name(), ".wal") {
-			continue
-		}
+	opt.fillDefault()
 
-		fsz := len(file.Name())
-		fid, err := strconv.ParseUint(file.Name()[:fsz-4], 16, 64)
+	w := &Wal{
+		opt: opt,
+		//sequence numbers are larger than ones written before restart,
+		//Replay moves it forward if the clock goes back
+		seq: uint64(time.Now().UnixNano()),
+	}
+	seen := make(map[string]bool)
+	for _, dir := range opt.Dirs {
+		if seen[dir] {
+			w.closeStripes()
+			return nil, errors.New("duplicated wal dir")
+		}
+		seen[dir] = true
+		s, err := openStripe(w, dir)
 		if err != nil {
+			w.closeStripes()
 			return nil, err
 		}
-		if fid <= last {
-			return nil, errors.New("duplicated wal")
-		}
-		last = fid
-		//fd, err := storage.Default.Open(filepath.Join(dir, file.Name()))
-		//utils.AssertTrue(err == nil)
-
-		oldWals = append(oldWals, filepath.Join(dir, file.Name()))
+		w.stripes = append(w.stripes, s)
 	}
-
-	//some filesystem can not guarantee readdir is order
-	sort.Slice(oldWals, func(i, j int) bool {
-		return strings.Compare(oldWals[i], oldWals[j]) < 0
-	})
-
-	//create new wal
-	last++
-	fd, err := os.Create(filepath.Join(dir, fmt.Sprintf("%016x.wal", last)))
-
-	if err != nil {
-		return nil, err
+	for _, s := range w.stripes {
+		s.userSync = userSync
+		s.stopper.RunWorker(s.doWrites)
 	}
-	currentWal := storage.NewSyncingFile(fd, storage.SyncingFileOptions{
-		BytesPerSync:    10 << 20,
-		PreallocateSize: 64 << 20,
-	})
-
-	w := &Wal{
-		dir:        dir,
-		oldWALs:    oldWals,
-		currentWAL: currentWal,
-		last:       last,
-		writeCh:    make(chan *request, 5),
-		stopper:    utils.NewStopper(),
-		writer:     record.NewLogWriter(currentWal, 0, 0),
-		walOffset:  0,
-		syncing:    0,
-		userSync:   userSync,
-	}
-	w.stopper.RunWorker(w.doWrites)
 	return w, nil
-
 }
 
-func (wal *Wal) cleanPendingWal() {
-	for _, f := range wal.oldWALs {
-		os.Remove(f)
+func (wal *Wal) closeStripes() {
+	for _, s := range wal.stripes {
+		s.currentWAL.Close()
 	}
-	wal.oldWALs = wal.oldWALs[0:]
 }
 
-//rotate will  non-block
-func (wal *Wal) rotate() {
-	atomic.StoreInt32(&wal.syncing, 1)
-	wal.syncWg.Add(1)
-	go func() {
-
-		wal.userSync()
-
-		//wait user sync end
-		wal.cleanPendingWal()
-		atomic.StoreInt32(&wal.syncing, 0)
-		wal.syncWg.Done()
-	}()
+func (wal *Wal) nextSeq() uint64 {
+	return atomic.AddUint64(&wal.seq, 1)
 }
 
-//doRequest is a single thread function
-func (wal *Wal) doRequest(reqs []*request) error {
-
-	done := func(err error) {
-		for _, r := range reqs {
-			r.err = err
-			r.wg.Done()
-		}
-	}
-
-	//rotate wal
-	if wal.walOffset > maxWalSize && atomic.LoadInt32(&wal.syncing) == 0 {
-		//non-block
-		wal.oldWALs = append(wal.oldWALs, pathName(wal.dir, wal.currentWAL))
-		wal.writer.Close()
-
-		wal.rotate()
-		wal.last++
-		fd, err := os.Create(filepath.Join(wal.dir, fmt.Sprintf("%016x.wal", wal.last)))
-		if err != nil {
-			done(err)
-			return err
-		}
-		wal.currentWAL = storage.NewSyncingFile(fd, storage.SyncingFileOptions{
-			BytesPerSync:    10 << 20,
-			PreallocateSize: 64 << 20,
-		})
-		wal.writer = record.NewLogWriter(wal.currentWAL, 0, 0)
-		/*
-			info, _ := fd.Stat()
-			fmt.Printf("new file %s\n", info.Name())
-		*/
-	}
-
-	//normal write
-	buf := new(bytes.Buffer)
-	for _, req := range reqs {
-		buf.Reset()
-		req.encodeTo(buf)
-		_, end, err := wal.writer.WriteRecord(buf.Bytes())
-		if err != nil {
-			done(err)
-			return err
-		}
-		wal.walOffset = end
-	}
-	if err := wal.writer.Sync(); err != nil {
-		done(err)
-		return err
+//ShouldBypass returns true if a write of size should not be written to wal
+func (wal *Wal) ShouldBypass(size int) bool {
+	if size > wal.opt.MaxEntrySize {
+		atomic.AddUint64(&wal.bypassed, 1)
+		return true
 	}
-	done(nil)
-	return nil
+	return false
 }
 
-func (wal *Wal) doWrites() {
-	pendingCh := make(chan struct{}, 1)
-	writeRequests := func(reqs []*request) {
-		if err := wal.doRequest(reqs); err != nil {
-			xlog.Logger.Errorf(err.Error())
-		}
-		<-pendingCh
-	}
-
-	reqs := make([]*request, 0, 10)
-	for {
-		var r *request
-
-		select {
-		case r = <-wal.writeCh:
-		case <-wal.stopper.ShouldStop():
-			goto closedCase
-		}
-
-		for {
-			reqs = append(reqs, r)
-
-			select {
-			// Either push to pending, or continue to pick from writeCh.
-			case r = <-wal.writeCh:
-			case pendingCh <- struct{}{}:
-				goto writeCase
-			case <-wal.stopper.ShouldStop():
-				goto closedCase
-			}
-
-		}
-
-	closedCase:
-		// All the pending request are drained.
-		// Don't close the writeCh, because it has be used in several places.
-		for {
-			select {
-			case r = <-wal.writeCh:
-				reqs = append(reqs, r)
-			default:
-				pendingCh <- struct{}{} // Push to pending before doing a write.
-				writeRequests(reqs)
-				return
-			}
-		}
+//Bypassed returns the number of writes which bypassed wal
+func (wal *Wal) Bypassed() uint64 {
+	return atomic.LoadUint64(&wal.bypassed)
+}
 
-	writeCase:
-		go writeRequests(reqs)
-		reqs = make([]*request, 0, 10)
+//Stats returns stats of every wal dir
+func (wal *Wal) Stats() []Stats {
+	ret := make([]Stats, len(wal.stripes))
+	for i, s := range wal.stripes {
+		ret[i] = s.stats()
 	}
+	return ret
 }
 
 //write will block until write is done
@@ -262,67 +159,28 @@ func (wal *Wal) Write(extentID uint64, start uint32, rev int64, blocks [][]byte)
 	req.start = start
 	req.rev = rev
 	req.wg.Add(1)
-	wal.writeCh <- req
+	wal.stripes[extentID%uint64(len(wal.stripes))].writeCh <- req
 
 	req.wg.Wait()
+	err := req.err
 	requestPool.Put(req)
-	return req.err
-}
-
-func pathName(dir string, f *storage.SyncingFile) string {
-	info, _ := f.File.Stat()
-	name := info.Name()
-	return filepath.Join(dir, name)
+	return err
 }
 
 func (wal *Wal) Close() {
-	wal.stopper.Stop()
-	wal.syncWg.Wait()
-	//wait for currrent syncing stop
-	if wal.gracefull {
-		wal.oldWALs = append(wal.oldWALs, pathName(wal.dir, wal.currentWAL))
-		wal.currentWAL.Close()
-		wal.userSync()
-		wal.cleanPendingWal()
-	} else {
-		wal.currentWAL.Close()
+	var wg sync.WaitGroup
+	for _, s := range wal.stripes {
+		wg.Add(1)
+		go func(s *stripe) {
+			defer wg.Done()
+			s.close()
+		}(s)
 	}
-}
-
-func (wal *Wal) Replay(callback func(uint64, uint32, int64, [][]byte)) error {
-	//delete pendingWals after replay
-	for _, fname := range wal.oldWALs {
-		f, err := os.Open(fname)
-		if err != nil {
-			return err
-		}
-		n := 0
-		records := record.NewReader(f)
-		for {
-			var req request
-			rec, err := records.Next()
-			if err == io.EOF {
-				break
-			}
-			if err != nil {
-				xlog.Logger.Warnf("%s read %d record, meet err : recovering: %v", fname, n, err)
-				break
-			}
-			s, err := ioutil.ReadAll(rec)
-			if err != nil {
-				xlog.Logger.Warnf("%s read %d record, meet err : recovering: %v", fname, n, err)
-				break
-			}
-			req.decode(s)
-			callback(req.extentID, req.start, req.rev, req.data)
-			n++
-		}
-	}
-	wal.cleanPendingWal()
-	return nil
+	wg.Wait()
 }
 
 type request struct {
+	seq      uint64   //encoding
 	extentID uint64   //encoding
 	start    uint32   //encoding
 	data     [][]byte //encoding
@@ -334,6 +192,7 @@ type request struct {
 }
 
 func (req *request) reset() {
+	req.seq = 0
 	req.extentID = 0
 	req.start = 0
 	req.data = nil
@@ -344,7 +203,9 @@ func (req *request) reset() {
 
 func (r *request) encodeTo(buf *bytes.Buffer) {
 	var enc [binary.MaxVarintLen64]byte
-	sz := binary.PutUvarint(enc[:], r.extentID)
+	sz := binary.PutUvarint(enc[:], r.seq)
+	buf.Write(enc[:sz])
+	sz = binary.PutUvarint(enc[:], r.extentID)
 	buf.Write(enc[:sz])
 	sz = binary.PutUvarint(enc[:], uint64(r.start))
 	buf.Write(enc[:sz])
@@ -357,24 +218,46 @@ func (r *request) encodeTo(buf *bytes.Buffer) {
 	}
 }
 
-func (r *request) decode(buf []byte) {
-	var sz int
-	var off int
-	var start uint64
-	r.extentID, sz = binary.Uvarint(buf)
-	off += sz
-	start, sz = binary.Uvarint(buf[off:])
+var errBadEntry = errors.New("bad wal entry")
+
+//decode entries written by encodeTo, entries of legacy wal files do not have seq
+func (r *request) decode(buf []byte, legacy bool) error {
+	uvarint := func() (uint64, error) {
+		v, sz := binary.Uvarint(buf)
+		if sz <= 0 {
+			return 0, errBadEntry
+		}
+		buf = buf[sz:]
+		return v, nil
+	}
+
+	var err error
+	if !legacy {
+		if r.seq, err = uvarint(); err != nil {
+			return err
+		}
+	}
+	if r.extentID, err = uvarint(); err != nil {
+		return err
+	}
+	start, err := uvarint()
+	if err != nil || start > uint64(^uint32(0)) {
+		return errBadEntry
+	}
 	r.start = uint32(start)
-	off += sz
-	r.rev, sz = binary.Varint(buf[off:])
-	off += sz
-	buf = buf[off:]
+	rev, sz := binary.Varint(buf)
+	if sz <= 0 {
+		return errBadEntry
+	}
+	r.rev = rev
+	buf = buf[sz:]
 	for len(buf) > 0 {
-		size, sz := binary.Uvarint(buf)
-		r.data = append(r.data,
-			buf[sz:sz+int(size)],
-		)
-
-		buf = buf[sz+int(size):]
+		size, err := uvarint()
+		if err != nil || size > uint64(len(buf)) {
+			return errBadEntry
+		}
+		r.data = append(r.data, buf[:size])
+		buf = buf[size:]
 	}
+	return nil
 }
@@ -18,9 +18,12 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path/filepath"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/journeymidnight/autumn/extent/record"
 	"github.com/journeymidnight/autumn/xlog"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zapcore"
@@ -30,6 +33,7 @@ type block = []byte
 
 func TestWalEncode(t *testing.T) {
 	x := request{
+		seq:      7,
 		extentID: 10,
 		start:    3,
 		data:     make([]block, 2),
@@ -43,13 +47,18 @@ func TestWalEncode(t *testing.T) {
 
 	out := buf.Bytes()
 	var y request
-	y.decode(out)
+	require.NoError(t, y.decode(out, false))
+	require.Equal(t, uint64(7), y.seq)
 	require.Equal(t, uint64(10), y.extentID)
 	require.Equal(t, uint32(3), y.start)
 	require.Equal(t, 2, len(y.data))
 	require.Equal(t, 99, len(y.data[0]))
 	require.Equal(t, 567, len(y.data[1]))
 	require.Equal(t, int64(1000), y.rev)
+
+	//truncated entry
+	var z request
+	require.Error(t, z.decode(out[:len(out)-1], false))
 }
 
 func init() {
@@ -60,7 +69,7 @@ func TestWalWrite(t *testing.T) {
 	p, err := ioutil.TempDir(os.TempDir(), "waltest")
 	defer os.RemoveAll(p)
 
-	wal, err := OpenWal(p, func() {
+	wal, err := OpenWal(Options{Dirs: []string{p}}, func() {
 		fmt.Printf("syncing...\n")
 		time.Sleep(time.Second)
 	})
@@ -71,23 +80,26 @@ func TestWalWrite(t *testing.T) {
 	}
 	wal.Close()
 
-	wal, err = OpenWal(p, func() {})
+	wal, err = OpenWal(Options{Dirs: []string{p}}, func() {})
 	require.Nil(t, err)
 
+	n := 0
 	wal.Replay(func(id uint64, start uint32, rev int64, data []block) {
 		require.Equal(t, uint32(10), start)
 		require.Equal(t, 2, len(data))
+		n++
 	})
+	require.Equal(t, 10, n)
+	wal.Close()
 }
 
 func TestMultiWal(t *testing.T) {
 	p, err := ioutil.TempDir(os.TempDir(), "waltest")
 	defer os.RemoveAll(p)
-	wal, err := OpenWal(p, func() {
+	wal, err := OpenWal(Options{Dirs: []string{p}, MaxWalSize: 1 << 20}, func() {
 		fmt.Printf("syncing...\n")
 		//time.Sleep(10 * time.Second)
 	})
-	maxWalSize = (1 << 20) //for debug
 	require.Nil(t, err)
 	for i := 0; i < 100; i++ {
 		wal.Write(10, 10, 0, []block{make([]byte, 50240), make([]byte, 9)})
@@ -110,7 +122,7 @@ func BenchmarkRecordWrite(b *testing.B) {
 	}
 	defer os.RemoveAll(p)
 
-	wal, err := OpenWal(p, func() {
+	wal, err := OpenWal(Options{Dirs: []string{p}}, func() {
 		fmt.Printf("syncing...\n")
 		time.Sleep(time.Second)
 	})
@@ -130,3 +142,137 @@ func BenchmarkRecordWrite(b *testing.B) {
 		})
 	}
 }
+
+type walEntry struct {
+	extentID uint64
+	start    uint32
+}
+
+func replayAll(t *testing.T, dirs []string) []walEntry {
+	wal, err := OpenWal(Options{Dirs: dirs}, func() {})
+	require.Nil(t, err)
+	defer wal.Close()
+	var entries []walEntry
+	require.NoError(t, wal.Replay(func(id uint64, start uint32, rev int64, data []block) {
+		entries = append(entries, walEntry{id, start})
+	}))
+	return entries
+}
+
+func TestStripedReplay(t *testing.T) {
+	p, err := ioutil.TempDir(os.TempDir(), "waltest")
+	require.Nil(t, err)
+	defer os.RemoveAll(p)
+	dirs := []string{filepath.Join(p, "a"), filepath.Join(p, "b")}
+	for _, dir := range dirs {
+		require.NoError(t, os.Mkdir(dir, 0755))
+	}
+
+	write := func(dirs []string, from, to uint32) {
+		wal, err := OpenWal(Options{Dirs: dirs}, func() {})
+		require.Nil(t, err)
+		for start := from; start < to; start++ {
+			for extentID := uint64(1); extentID <= 4; extentID++ {
+				require.NoError(t, wal.Write(extentID, start, 0, []block{make([]byte, 10)}))
+			}
+		}
+		wal.Close()
+	}
+	write(dirs, 0, 10)
+	//extents are in other dirs after dirs are changed
+	write([]string{dirs[1], dirs[0]}, 10, 20)
+
+	//every dir has wal files
+	for _, dir := range dirs {
+		files, err := ioutil.ReadDir(dir)
+		require.Nil(t, err)
+		require.True(t, len(files) > 1)
+	}
+
+	entries := replayAll(t, dirs)
+	require.Equal(t, 80, len(entries))
+	next := make(map[uint64]uint32)
+	for _, e := range entries {
+		require.Equal(t, next[e.extentID], e.start)
+		next[e.extentID]++
+	}
+
+	//all replayed
+	require.Equal(t, 0, len(replayAll(t, dirs)))
+}
+
+func TestReplayCorrupted(t *testing.T) {
+	p, err := ioutil.TempDir(os.TempDir(), "waltest")
+	require.Nil(t, err)
+	defer os.RemoveAll(p)
+
+	wal, err := OpenWal(Options{Dirs: []string{p}}, func() {})
+	require.Nil(t, err)
+	for i := 0; i < 3; i++ {
+		require.NoError(t, wal.Write(1, uint32(i), 0, []block{make([]byte, 150<<10)}))
+	}
+	wal.Close()
+
+	//the second entry is in [150KB, 300KB)
+	f, err := os.OpenFile(filepath.Join(p, "0000000000000001.wal"), os.O_RDWR, 0644)
+	require.Nil(t, err)
+	_, err = f.WriteAt([]byte{0xff}, 200<<10)
+	require.Nil(t, err)
+	f.Close()
+
+	entries := replayAll(t, []string{p})
+	require.Equal(t, []walEntry{{1, 0}, {1, 2}}, entries)
+}
+
+func TestReplayLegacyWal(t *testing.T) {
+	p, err := ioutil.TempDir(os.TempDir(), "waltest")
+	require.Nil(t, err)
+	defer os.RemoveAll(p)
+
+	//wal file without seq
+	f, err := os.Create(filepath.Join(p, "0000000000000001.wal"))
+	require.Nil(t, err)
+	writer := record.NewLogWriter(f, 0, 0)
+	for i := 0; i < 3; i++ {
+		buf := new(bytes.Buffer)
+		(&request{extentID: 5, start: uint32(i), data: []block{make([]byte, 10)}}).encodeTo(buf)
+		//seq 0 is one byte
+		_, _, err = writer.WriteRecord(buf.Bytes()[1:])
+		require.Nil(t, err)
+	}
+	require.NoError(t, writer.Close())
+
+	entries := replayAll(t, []string{p})
+	require.Equal(t, []walEntry{{5, 0}, {5, 1}, {5, 2}}, entries)
+}
+
+func TestGroupCommit(t *testing.T) {
+	p, err := ioutil.TempDir(os.TempDir(), "waltest")
+	require.Nil(t, err)
+	defer os.RemoveAll(p)
+
+	wal, err := OpenWal(Options{Dirs: []string{p}, SyncDelay: 50 * time.Millisecond, MaxEntrySize: 1 << 20}, func() {})
+	require.Nil(t, err)
+	defer wal.Close()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			require.NoError(t, wal.Write(uint64(i), 0, 0, []block{make([]byte, 100)}))
+		}(i)
+	}
+	wg.Wait()
+
+	stats := wal.Stats()
+	require.Equal(t, 1, len(stats))
+	require.Equal(t, uint64(10), stats[0].Entries)
+	require.True(t, stats[0].Commits < 10)
+	require.True(t, stats[0].Bytes > 1000)
+	require.True(t, stats[0].MaxLatency > 0)
+
+	require.False(t, wal.ShouldBypass(1<<20))
+	require.True(t, wal.ShouldBypass(1<<20+1))
+	require.Equal(t, uint64(1), wal.Bypassed())
+}
@@ -0,0 +1,100 @@
+package geo_replication
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/journeymidnight/autumn/utils"
+	"github.com/pkg/errors"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+const etcdTimeout = 5 * time.Second
+
+//Checkpoints saves checkpoints of partitions of a replication
+type Checkpoints interface {
+	//Load returns nil if partID has no checkpoint
+	Load(partID uint64) (*pspb.ReplicationCheckpoint, error)
+	Save(partID uint64, cp *pspb.ReplicationCheckpoint) error
+	//SaveIfNotExists returns false if partID has a checkpoint
+	SaveIfNotExists(partID uint64, cp *pspb.ReplicationCheckpoint) (bool, error)
+	List() (map[uint64]*pspb.ReplicationCheckpoint, error)
+}
+
+//EtcdCheckpoints saves checkpoints in etcd of the source cluster as replication/<name>/<partID>
+type EtcdCheckpoints struct {
+	client *clientv3.Client
+	prefix string
+}
+
+func NewEtcdCheckpoints(client *clientv3.Client, name string) *EtcdCheckpoints {
+	return &EtcdCheckpoints{client: client, prefix: fmt.Sprintf("replication/%s/", name)}
+}
+
+func (c *EtcdCheckpoints) key(partID uint64) string {
+	return fmt.Sprintf("%s%d", c.prefix, partID)
+}
+
+func (c *EtcdCheckpoints) Load(partID uint64) (*pspb.ReplicationCheckpoint, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), etcdTimeout)
+	defer cancel()
+	res, err := c.client.Get(ctx, c.key(partID))
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Kvs) == 0 {
+		return nil, nil
+	}
+	var cp pspb.ReplicationCheckpoint
+	if err = cp.Unmarshal(res.Kvs[0].Value); err != nil {
+		return nil, err
+	}
+	return &cp, nil
+}
+
+func (c *EtcdCheckpoints) Save(partID uint64, cp *pspb.ReplicationCheckpoint) error {
+	ctx, cancel := context.WithTimeout(context.Background(), etcdTimeout)
+	defer cancel()
+	_, err := c.client.Put(ctx, c.key(partID), string(utils.MustMarshal(cp)))
+	return err
+}
+
+func (c *EtcdCheckpoints) SaveIfNotExists(partID uint64, cp *pspb.ReplicationCheckpoint) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), etcdTimeout)
+	defer cancel()
+	key := c.key(partID)
+	res, err := c.client.Txn(ctx).
+		If(clientv3.Compare(clientv3.CreateRevision(key), "=", 0)).
+		Then(clientv3.OpPut(key, string(utils.MustMarshal(cp)))).
+		Commit()
+	if err != nil {
+		return false, err
+	}
+	return res.Succeeded, nil
+}
+
+func (c *EtcdCheckpoints) List() (map[uint64]*pspb.ReplicationCheckpoint, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), etcdTimeout)
+	defer cancel()
+	res, err := c.client.Get(ctx, c.prefix, clientv3.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+	ret := make(map[uint64]*pspb.ReplicationCheckpoint, len(res.Kvs))
+	for _, kv := range res.Kvs {
+		partID, err := strconv.ParseUint(strings.TrimPrefix(string(kv.Key), c.prefix), 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "bad checkpoint key %s", kv.Key)
+		}
+		var cp pspb.ReplicationCheckpoint
+		if err = cp.Unmarshal(kv.Value); err != nil {
+			return nil, err
+		}
+		ret[partID] = &cp
+	}
+	return ret, nil
+}
@@ -0,0 +1,538 @@
+package geo_replication
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"time"
+
+	autumn_clientv1 "github.com/journeymidnight/autumn/autumn_clientv1"
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/journeymidnight/autumn/xlog"
+	"github.com/pkg/errors"
+)
+
+/*
+geo replication ships puts and deletes of the source cluster to the target cluster asynchronously.
+
+1. a worker of each source partition watches its logStream from the checkpoint, and applies events to the
+target by AutumnLib.Replicate. checkpoints are saved in etcd of the source cluster every SaveInterval,
+events after a checkpoint are applied again after restart, they are skipped by last-writer-wins
+2. every value has an origin (cluster id, seq), values written locally have the origin of their cluster
+and version. target keeps the value with the newer origin, see range_partition/replication.go
+3. events whose origin is the target are skipped, so replicators in both directions do not loop
+4. watch responses carry the range of partition. if the range shrinks, the partition has been split, the
+worker saves its checkpoint for the new partitions before applying any event after the split, events of
+the new partitions after the split have larger seqs
+5. if events have been GC'd, the worker resyncs keys changed after its checkpoint by Range and Get
+6. keys are routed by regions of target's AutumnLib, writes failed by splits of target are retried
+*/
+
+const (
+	defaultSaveInterval    = time.Second
+	defaultRefreshInterval = 5 * time.Second
+	defaultPageSize        = 1000
+	maxBackoff             = 5 * time.Second
+)
+
+//Source is implemented by autumn_clientv1.AutumnLib of the source cluster
+type Source interface {
+	//Regions returns regions sorted by start key
+	Regions() []*pspb.RegionInfo
+	WatchPartition(ctx context.Context, region *pspb.RegionInfo, fromSeq uint64, f func(*pspb.WatchResponse) error) error
+	RangePartition(ctx context.Context, region *pspb.RegionInfo, prefix []byte, start []byte, limit uint32, sinceSeq uint64) (*pspb.RangeResponse, error)
+	GetWithOrigin(ctx context.Context, key []byte) (*pspb.GetResponse, error)
+}
+
+//Target is implemented by autumn_clientv1.AutumnLib of the target cluster
+type Target interface {
+	Replicate(ctx context.Context, ops []*pspb.ReplicateOp, clusterID uint64) (int, error)
+}
+
+type Options struct {
+	SourceClusterID uint64
+	TargetClusterID uint64
+	SaveInterval    time.Duration //interval of saving checkpoints
+	RefreshInterval time.Duration //interval of finding new partitions of source
+	PageSize        uint32        //keys of a range request when resyncing
+}
+
+//PartitionStatus is the replication status of a source partition
+type PartitionStatus struct {
+	PartID     uint64
+	AppliedSeq uint64    //events whose seq <= AppliedSeq have been applied to target
+	SourceSeq  uint64    //the latest seq of partition
+	UpdatedAt  time.Time //when the checkpoint is saved
+}
+
+//Lag is the number of seqs target is behind source, seqs of partitions are not continuous, because they
+//are raised by replicated writes
+func (s PartitionStatus) Lag() uint64 {
+	if s.SourceSeq > s.AppliedSeq {
+		return s.SourceSeq - s.AppliedSeq
+	}
+	return 0
+}
+
+type Replicator struct {
+	src Source
+	dst Target
+	cps Checkpoints
+	opt Options
+
+	statusLock sync.Mutex
+	status     map[uint64]*PartitionStatus
+}
+
+func New(src Source, dst Target, cps Checkpoints, opt Options) (*Replicator, error) {
+	if opt.SourceClusterID == 0 || opt.TargetClusterID == 0 {
+		return nil, errors.New("cluster id is required")
+	}
+	if opt.SourceClusterID == opt.TargetClusterID {
+		return nil, errors.Errorf("source and target are the same cluster %d", opt.SourceClusterID)
+	}
+	if opt.SaveInterval <= 0 {
+		opt.SaveInterval = defaultSaveInterval
+	}
+	if opt.RefreshInterval <= 0 {
+		opt.RefreshInterval = defaultRefreshInterval
+	}
+	if opt.PageSize == 0 {
+		opt.PageSize = defaultPageSize
+	}
+	return &Replicator{
+		src:    src,
+		dst:    dst,
+		cps:    cps,
+		opt:    opt,
+		status: make(map[uint64]*PartitionStatus),
+	}, nil
+}
+
+//Run replicates all partitions of source until ctx is done
+func (r *Replicator) Run(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	workers := make(map[uint64]context.CancelFunc)
+	var wg sync.WaitGroup
+	ticker := time.NewTicker(r.opt.RefreshInterval)
+	defer ticker.Stop()
+	for {
+		if err := r.refresh(ctx, workers, &wg); err != nil {
+			xlog.Logger.Warnf("replication: refresh partitions: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			cancel()
+			wg.Wait()
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+//Status returns the status of partitions being replicated, SourceSeq is the latest checkpoint from source
+func (r *Replicator) Status() []PartitionStatus {
+	r.statusLock.Lock()
+	defer r.statusLock.Unlock()
+	ret := make([]PartitionStatus, 0, len(r.status))
+	for _, s := range r.status {
+		ret = append(ret, *s)
+	}
+	return ret
+}
+
+func (r *Replicator) setStatus(partID uint64, cp *pspb.ReplicationCheckpoint, sourceSeq uint64) {
+	r.statusLock.Lock()
+	defer r.statusLock.Unlock()
+	s := r.status[partID]
+	if s == nil {
+		s = &PartitionStatus{PartID: partID}
+		r.status[partID] = s
+	}
+	s.AppliedSeq = cp.Seq
+	if sourceSeq > s.SourceSeq {
+		s.SourceSeq = sourceSeq
+	}
+	s.UpdatedAt = time.Unix(0, cp.UpdatedAt)
+}
+
+//Lag reads checkpoints and the latest seqs of source partitions, it does not need a running Replicator
+func Lag(ctx context.Context, src Source, cps Checkpoints) ([]PartitionStatus, error) {
+	saved, err := cps.List()
+	if err != nil {
+		return nil, err
+	}
+	var ret []PartitionStatus
+	for _, region := range src.Regions() {
+		res, err := src.RangePartition(ctx, region, nil, nil, 0, 0)
+		if err != nil {
+			return nil, errors.Wrapf(err, "partition %d", region.PartID)
+		}
+		s := PartitionStatus{PartID: region.PartID, SourceSeq: res.Seq}
+		if cp := saved[region.PartID]; cp != nil {
+			s.AppliedSeq = cp.Seq
+			s.UpdatedAt = time.Unix(0, cp.UpdatedAt)
+		}
+		ret = append(ret, s)
+	}
+	return ret, nil
+}
+
+//refresh starts workers of new partitions, and stops workers of partitions which do not exist
+func (r *Replicator) refresh(ctx context.Context, workers map[uint64]context.CancelFunc, wg *sync.WaitGroup) error {
+	regions := r.src.Regions()
+	saved, err := r.cps.List()
+	if err != nil {
+		return err
+	}
+	current := make(map[uint64]bool, len(regions))
+	for _, region := range regions {
+		current[region.PartID] = true
+	}
+
+	for _, region := range regions {
+		if workers[region.PartID] != nil {
+			continue
+		}
+		if saved[region.PartID] == nil {
+			if parent := splitFrom(region, current, saved); parent != 0 {
+				//wait for the worker of parent to save the checkpoint of region
+				continue
+			}
+			cp := &pspb.ReplicationCheckpoint{Rg: region.Rg, UpdatedAt: time.Now().UnixNano()}
+			if _, err := r.cps.SaveIfNotExists(region.PartID, cp); err != nil {
+				return err
+			}
+		}
+		wctx, wcancel := context.WithCancel(ctx)
+		workers[region.PartID] = wcancel
+		wg.Add(1)
+		go func(partID uint64) {
+			defer wg.Done()
+			r.runWorker(wctx, partID)
+		}(region.PartID)
+	}
+
+	for partID, wcancel := range workers {
+		if !current[partID] {
+			wcancel()
+			delete(workers, partID)
+			r.statusLock.Lock()
+			delete(r.status, partID)
+			r.statusLock.Unlock()
+		}
+	}
+	return nil
+}
+
+//splitFrom returns the partition whose checkpoint covers region, it is the partition region is split from
+func splitFrom(region *pspb.RegionInfo, current map[uint64]bool, saved map[uint64]*pspb.ReplicationCheckpoint) uint64 {
+	for partID, cp := range saved {
+		if partID != region.PartID && current[partID] && cp.Rg != nil && overlap(cp.Rg, region.Rg) {
+			return partID
+		}
+	}
+	return 0
+}
+
+func overlap(a, b *pspb.Range) bool {
+	return (len(b.EndKey) == 0 || bytes.Compare(a.StartKey, b.EndKey) < 0) &&
+		(len(a.EndKey) == 0 || bytes.Compare(b.StartKey, a.EndKey) < 0)
+}
+
+func sameRange(a, b *pspb.Range) bool {
+	return bytes.Equal(a.StartKey, b.StartKey) && bytes.Equal(a.EndKey, b.EndKey)
+}
+
+//lostRanges returns parts of old which are not in new
+func lostRanges(old, new *pspb.Range) []*pspb.Range {
+	var ret []*pspb.Range
+	if bytes.Compare(new.StartKey, old.StartKey) > 0 {
+		ret = append(ret, &pspb.Range{StartKey: old.StartKey, EndKey: new.StartKey})
+	}
+	if len(new.EndKey) > 0 && (len(old.EndKey) == 0 || bytes.Compare(new.EndKey, old.EndKey) < 0) {
+		ret = append(ret, &pspb.Range{StartKey: new.EndKey, EndKey: old.EndKey})
+	}
+	return ret
+}
+
+//covers returns regions other than partID which cover all keys of rg
+func covers(regions []*pspb.RegionInfo, rg *pspb.Range, partID uint64) ([]*pspb.RegionInfo, bool) {
+	var ret []*pspb.RegionInfo
+	cursor := rg.StartKey
+	for _, region := range regions {
+		if !overlap(region.Rg, rg) {
+			continue
+		}
+		if region.PartID == partID || bytes.Compare(region.Rg.StartKey, cursor) > 0 {
+			return nil, false
+		}
+		ret = append(ret, region)
+		if len(region.Rg.EndKey) == 0 {
+			return ret, true
+		}
+		cursor = region.Rg.EndKey
+		if len(rg.EndKey) > 0 && bytes.Compare(cursor, rg.EndKey) >= 0 {
+			return ret, true
+		}
+	}
+	return nil, false
+}
+
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(d):
+		return nil
+	}
+}
+
+//toOps converts events to ops, events from target are skipped
+func (r *Replicator) toOps(events []*pspb.WatchEvent) []*pspb.ReplicateOp {
+	ops := make([]*pspb.ReplicateOp, 0, len(events))
+	for _, e := range events {
+		op := &pspb.ReplicateOp{
+			Key:           e.Key,
+			Value:         e.Value,
+			ExpiresAt:     e.ExpiresAt,
+			Deleted:       e.Type == pspb.WatchEvent_DELETE,
+			OriginCluster: r.opt.SourceClusterID,
+			OriginSeq:     e.Seq,
+		}
+		if e.OriginCluster != 0 {
+			op.OriginCluster = e.OriginCluster
+			op.OriginSeq = e.OriginSeq
+		}
+		if op.OriginCluster == r.opt.TargetClusterID {
+			continue
+		}
+		ops = append(ops, op)
+	}
+	return ops
+}
+
+//replicate retries until ops are applied or ctx is done
+func (r *Replicator) replicate(ctx context.Context, ops []*pspb.ReplicateOp) error {
+	if len(ops) == 0 {
+		return nil
+	}
+	backoff := 100 * time.Millisecond
+	for {
+		_, err := r.dst.Replicate(ctx, ops, r.opt.TargetClusterID)
+		if err == nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		xlog.Logger.Warnf("replication: apply %d ops: %v, retry", len(ops), err)
+		if err = sleepCtx(ctx, backoff); err != nil {
+			return err
+		}
+		if backoff < maxBackoff {
+			backoff *= 2
+		}
+	}
+}
+
+type worker struct {
+	r        *Replicator
+	partID   uint64
+	cp       *pspb.ReplicationCheckpoint
+	savedAt  time.Time
+	resynced bool //keys have been resynced after cp.Rg is confirmed by a watch response
+}
+
+func (r *Replicator) runWorker(ctx context.Context, partID uint64) {
+	w := &worker{r: r, partID: partID}
+	backoff := 100 * time.Millisecond
+	for {
+		seq := uint64(0)
+		if w.cp != nil {
+			seq = w.cp.Seq
+		}
+		err := w.run(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if w.cp != nil && w.cp.Seq > seq {
+			backoff = 100 * time.Millisecond
+		}
+		xlog.Logger.Warnf("replication of partition %d is broken: %v, retry", partID, err)
+		if sleepCtx(ctx, backoff) != nil {
+			return
+		}
+		if backoff < maxBackoff {
+			backoff *= 2
+		}
+	}
+}
+
+func (w *worker) region() *pspb.RegionInfo {
+	for _, region := range w.r.src.Regions() {
+		if region.PartID == w.partID {
+			return region
+		}
+	}
+	return nil
+}
+
+func (w *worker) save() error {
+	w.cp.UpdatedAt = time.Now().UnixNano()
+	if err := w.r.cps.Save(w.partID, w.cp); err != nil {
+		return err
+	}
+	w.savedAt = time.Now()
+	return nil
+}
+
+//run watches the partition until the stream is broken
+func (w *worker) run(ctx context.Context) error {
+	if w.cp == nil {
+		cp, err := w.r.cps.Load(w.partID)
+		if err != nil {
+			return err
+		}
+		if cp == nil {
+			return errors.Errorf("partition %d has no checkpoint", w.partID)
+		}
+		w.cp = cp
+		w.r.setStatus(w.partID, cp, cp.Seq)
+	}
+	region := w.region()
+	if region == nil {
+		return errors.Errorf("partition %d is not found", w.partID)
+	}
+
+	err := w.r.src.WatchPartition(ctx, region, w.cp.Seq, func(res *pspb.WatchResponse) error {
+		return w.apply(ctx, res)
+	})
+	if err == autumn_clientv1.ErrWatchCompacted {
+		xlog.Logger.Infof("replication: events of partition %d after %d have been GC'd, resync", w.partID, w.cp.Seq)
+		err = w.resync(ctx, region)
+	}
+	if saveErr := w.save(); err == nil {
+		err = saveErr
+	}
+	return err
+}
+
+func (w *worker) apply(ctx context.Context, res *pspb.WatchResponse) error {
+	if res.Rg != nil {
+		if w.cp.Rg != nil && !sameRange(w.cp.Rg, res.Rg) {
+			if err := w.handoff(ctx, res.Rg); err != nil {
+				return err
+			}
+		}
+		w.cp.Rg = res.Rg
+		w.resynced = false
+	}
+
+	if err := w.r.replicate(ctx, w.r.toOps(res.Events)); err != nil {
+		return err
+	}
+	if res.Checkpoint > w.cp.Seq {
+		w.cp.Seq = res.Checkpoint
+	}
+	if time.Since(w.savedAt) >= w.r.opt.SaveInterval {
+		if err := w.save(); err != nil {
+			return err
+		}
+	}
+	w.r.setStatus(w.partID, w.cp, res.Checkpoint)
+	return nil
+}
+
+//handoff saves checkpoints of partitions split from w.partID. it is called before any event after the split
+//is applied, so events of the new partitions before the split have been applied
+func (w *worker) handoff(ctx context.Context, rg *pspb.Range) error {
+	seq := w.cp.Seq
+	if w.resynced {
+		//resync could read keys written after the split, new partitions have to resync from the start
+		seq = 0
+	}
+	for _, lost := range lostRanges(w.cp.Rg, rg) {
+		var children []*pspb.RegionInfo
+		for {
+			var ok bool
+			//regions of AutumnLib are updated by watching etcd, they could be behind the partition server
+			if children, ok = covers(w.r.src.Regions(), lost, w.partID); ok {
+				break
+			}
+			if err := sleepCtx(ctx, 100*time.Millisecond); err != nil {
+				return err
+			}
+		}
+		for _, child := range children {
+			cp := &pspb.ReplicationCheckpoint{Seq: seq, Rg: child.Rg, UpdatedAt: time.Now().UnixNano()}
+			created, err := w.r.cps.SaveIfNotExists(child.PartID, cp)
+			if err != nil {
+				return err
+			}
+			if created {
+				xlog.Logger.Infof("replication: partition %d is split from %d, starts from %d", child.PartID, w.partID, seq)
+			}
+		}
+	}
+	w.cp.Rg = rg
+	return w.save()
+}
+
+//resync applies keys changed after w.cp.Seq by Range and Get, it is used if events have been GC'd.
+//deleted keys are found if their tombstones have not been compacted
+func (w *worker) resync(ctx context.Context, region *pspb.RegionInfo) error {
+	var start []byte
+	var seq uint64
+	first := true
+	for {
+		res, err := w.r.src.RangePartition(ctx, region, nil, start, w.r.opt.PageSize, w.cp.Seq)
+		if err != nil {
+			return err
+		}
+		if first {
+			//keys changed after seq will be watched
+			seq = res.Seq
+			first = false
+		}
+		ops := make([]*pspb.ReplicateOp, 0, len(res.Keys))
+		for _, key := range res.Keys {
+			got, err := w.r.src.GetWithOrigin(ctx, key)
+			if err != nil {
+				return err
+			}
+			if got.NotFound && got.Version == 0 {
+				continue
+			}
+			op := &pspb.ReplicateOp{
+				Key:           key,
+				Value:         got.Value,
+				ExpiresAt:     got.ExpiresAt,
+				Deleted:       got.NotFound,
+				OriginCluster: w.r.opt.SourceClusterID,
+				OriginSeq:     got.Version,
+			}
+			if got.OriginCluster != 0 {
+				op.OriginCluster = got.OriginCluster
+				op.OriginSeq = got.OriginSeq
+			}
+			if op.OriginCluster != w.r.opt.TargetClusterID {
+				ops = append(ops, op)
+			}
+		}
+		if err = w.r.replicate(ctx, ops); err != nil {
+			return err
+		}
+		if !res.Truncated || len(res.Keys) == 0 {
+			break
+		}
+		start = append(append([]byte{}, res.Keys[len(res.Keys)-1]...), 0)
+	}
+
+	if seq > w.cp.Seq {
+		w.cp.Seq = seq
+	}
+	w.resynced = true
+	xlog.Logger.Infof("replication: partition %d is resynced to %d", w.partID, w.cp.Seq)
+	return w.save()
+}
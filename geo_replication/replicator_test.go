@@ -0,0 +1,209 @@
+package geo_replication
+
+import (
+	"context"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	autumn_clientv1 "github.com/journeymidnight/autumn/autumn_clientv1"
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/journeymidnight/autumn/xlog"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+const (
+	sourceID = 1
+	targetID = 2
+)
+
+type memCheckpoints struct {
+	sync.Mutex
+	cps map[uint64]pspb.ReplicationCheckpoint
+}
+
+func newMemCheckpoints() *memCheckpoints {
+	return &memCheckpoints{cps: make(map[uint64]pspb.ReplicationCheckpoint)}
+}
+
+func (c *memCheckpoints) Load(partID uint64) (*pspb.ReplicationCheckpoint, error) {
+	c.Lock()
+	defer c.Unlock()
+	if cp, ok := c.cps[partID]; ok {
+		return &cp, nil
+	}
+	return nil, nil
+}
+
+func (c *memCheckpoints) Save(partID uint64, cp *pspb.ReplicationCheckpoint) error {
+	c.Lock()
+	defer c.Unlock()
+	c.cps[partID] = *cp
+	return nil
+}
+
+func (c *memCheckpoints) SaveIfNotExists(partID uint64, cp *pspb.ReplicationCheckpoint) (bool, error) {
+	c.Lock()
+	defer c.Unlock()
+	if _, ok := c.cps[partID]; ok {
+		return false, nil
+	}
+	c.cps[partID] = *cp
+	return true, nil
+}
+
+func (c *memCheckpoints) List() (map[uint64]*pspb.ReplicationCheckpoint, error) {
+	c.Lock()
+	defer c.Unlock()
+	ret := make(map[uint64]*pspb.ReplicationCheckpoint, len(c.cps))
+	for partID, cp := range c.cps {
+		cp := cp
+		ret[partID] = &cp
+	}
+	return ret, nil
+}
+
+//values returns keys of src except "c", which comes from target and is not sent back
+func values(src *autumn_clientv1.MockLib) map[string]string {
+	ret := src.Values()
+	delete(ret, "c")
+	return ret
+}
+
+func TestMain(m *testing.M) {
+	xlog.InitLog([]string{"replication.log"}, zapcore.DebugLevel)
+	code := m.Run()
+	os.Remove("replication.log")
+	os.Exit(code)
+}
+
+func TestToOps(t *testing.T) {
+	r, err := New(autumn_clientv1.NewMockLib(), autumn_clientv1.NewMockLib(), newMemCheckpoints(), Options{SourceClusterID: sourceID, TargetClusterID: targetID})
+	require.Nil(t, err)
+	ops := r.toOps([]*pspb.WatchEvent{
+		{Key: []byte("a"), Value: []byte("1"), Seq: 10},
+		{Key: []byte("b"), Type: pspb.WatchEvent_DELETE, Seq: 11},
+		{Key: []byte("c"), Value: []byte("3"), Seq: 12, OriginCluster: targetID, OriginSeq: 5},
+		{Key: []byte("d"), Value: []byte("4"), Seq: 13, OriginCluster: 3, OriginSeq: 6},
+	})
+	require.Equal(t, []*pspb.ReplicateOp{
+		{Key: []byte("a"), Value: []byte("1"), OriginCluster: sourceID, OriginSeq: 10},
+		{Key: []byte("b"), Deleted: true, OriginCluster: sourceID, OriginSeq: 11},
+		{Key: []byte("d"), Value: []byte("4"), OriginCluster: 3, OriginSeq: 6},
+	}, ops)
+
+	_, err = New(autumn_clientv1.NewMockLib(), autumn_clientv1.NewMockLib(), newMemCheckpoints(), Options{SourceClusterID: sourceID, TargetClusterID: sourceID})
+	require.NotNil(t, err)
+}
+
+func TestLostRanges(t *testing.T) {
+	old := &pspb.Range{StartKey: []byte("b"), EndKey: []byte("")}
+	require.Equal(t, []*pspb.Range{{StartKey: []byte("m"), EndKey: []byte("")}},
+		lostRanges(old, &pspb.Range{StartKey: []byte("b"), EndKey: []byte("m")}))
+	require.Nil(t, lostRanges(old, old))
+
+	regions := []*pspb.RegionInfo{
+		{PartID: 1, Rg: &pspb.Range{StartKey: []byte(""), EndKey: []byte("m")}},
+		{PartID: 2, Rg: &pspb.Range{StartKey: []byte("m"), EndKey: []byte("t")}},
+		{PartID: 3, Rg: &pspb.Range{StartKey: []byte("t"), EndKey: []byte("")}},
+	}
+	children, ok := covers(regions, &pspb.Range{StartKey: []byte("m"), EndKey: []byte("")}, 1)
+	require.True(t, ok)
+	require.Equal(t, regions[1:], children)
+	//regions are not updated
+	_, ok = covers(regions[:1], &pspb.Range{StartKey: []byte("m"), EndKey: []byte("")}, 1)
+	require.False(t, ok)
+	_, ok = covers(regions, &pspb.Range{StartKey: []byte("k"), EndKey: []byte("")}, 1)
+	require.False(t, ok)
+}
+
+func TestReplicator(t *testing.T) {
+	src := autumn_clientv1.NewMockLib()
+	dst := autumn_clientv1.NewMockLib()
+	cps := newMemCheckpoints()
+	r, err := New(src, dst, cps, Options{
+		SourceClusterID: sourceID,
+		TargetClusterID: targetID,
+		SaveInterval:    10 * time.Millisecond,
+		RefreshInterval: 10 * time.Millisecond,
+		PageSize:        3,
+	})
+	require.Nil(t, err)
+
+	for _, key := range []string{"a", "f", "k", "p", "u"} {
+		src.Put(context.Background(), []byte(key), []byte("v1-"+key))
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- r.Run(ctx)
+	}()
+	synced := func() bool {
+		return assertEqual(values(src), dst.Values())
+	}
+	require.Eventually(t, synced, 5*time.Second, 10*time.Millisecond)
+
+	//split, new partition starts from the checkpoint of its parent
+	src.Split(1, 2, "m")
+	src.Put(ctx, []byte("b"), []byte("v2-b"))
+	src.Put(ctx, []byte("q"), []byte("v2-q"))
+	src.Write(&pspb.WatchEvent{Key: []byte("u"), Type: pspb.WatchEvent_DELETE}, true)
+	require.Eventually(t, synced, 5*time.Second, 10*time.Millisecond)
+	cp, err := cps.Load(2)
+	require.Nil(t, err)
+	require.NotNil(t, cp)
+	require.Equal(t, []byte("m"), cp.Rg.StartKey)
+
+	//events from target are not sent back
+	src.Write(&pspb.WatchEvent{Key: []byte("c"), Value: []byte("from target"), OriginCluster: targetID, OriginSeq: 1}, true)
+	src.Put(ctx, []byte("d"), []byte("v3-d"))
+	require.Eventually(t, synced, 5*time.Second, 10*time.Millisecond)
+	_, ok := dst.Values()["c"]
+	require.False(t, ok)
+
+	//events are GC'd, keys are resynced with their TTLs
+	src.Write(&pspb.WatchEvent{Key: []byte("r"), Value: []byte("v4-r"), ExpiresAt: 1 << 40}, false)
+	src.Write(&pspb.WatchEvent{Key: []byte("q"), Type: pspb.WatchEvent_DELETE}, false)
+	src.GCWatchLog(2)
+	require.Eventually(t, synced, 5*time.Second, 10*time.Millisecond)
+	got, err := dst.GetWithOrigin(ctx, []byte("r"))
+	require.Nil(t, err)
+	require.Equal(t, uint64(1<<40), got.ExpiresAt)
+
+	require.Eventually(t, func() bool {
+		status := r.Status()
+		if len(status) != 2 {
+			return false
+		}
+		for _, s := range status {
+			if s.Lag() != 0 {
+				return false
+			}
+		}
+		return true
+	}, 5*time.Second, 10*time.Millisecond)
+
+	cancel()
+	require.Equal(t, context.Canceled, <-done)
+
+	status, err := Lag(context.Background(), src, cps)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(status))
+	for _, s := range status {
+		require.Equal(t, uint64(0), s.Lag())
+	}
+}
+
+func assertEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if b[k] != v {
+			return false
+		}
+	}
+	return true
+}
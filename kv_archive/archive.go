@@ -0,0 +1,212 @@
+package kv_archive
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"os"
+
+	"github.com/DataDog/zstd"
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/journeymidnight/autumn/utils"
+	"github.com/pkg/errors"
+)
+
+/*
+archive file:
+magic(8 bytes) | version(4 bytes) | compression(4 bytes) | chunk | chunk | ...
+chunk: length(4 bytes) | crc32c of data(4 bytes) | data(pspb.ArchiveChunk, compressed if compression is zstd)
+
+every chunk has the export state after its entries, so an interrupted export is resumed from the
+last complete chunk. the last chunk of a complete archive has state.done
+*/
+
+const (
+	Version = 1
+	magic   = "AUTUMNKV"
+
+	CompressionNone = 0
+	CompressionZstd = 1
+
+	headerSize      = 16
+	chunkHeaderSize = 8
+	maxChunkSize    = 1 << 30
+)
+
+var (
+	ErrBrokenChunk = errors.New("broken chunk in archive")
+	ErrIncomplete  = errors.New("archive is incomplete, resume the export")
+)
+
+type Writer struct {
+	f           *os.File
+	compression uint32
+}
+
+//Create creates a new archive, it fails if path exists
+func Create(path string, compression uint32) (*Writer, error) {
+	if compression != CompressionNone && compression != CompressionZstd {
+		return nil, errors.Errorf("unknown compression %d", compression)
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+	header := make([]byte, headerSize)
+	copy(header, magic)
+	binary.BigEndian.PutUint32(header[8:], Version)
+	binary.BigEndian.PutUint32(header[12:], compression)
+	if _, err = f.Write(header); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return &Writer{f: f, compression: compression}, nil
+}
+
+//Resume opens an interrupted archive, a broken chunk at the end is dropped. it returns the state
+//of the last complete chunk, nil if there is no chunk
+func Resume(path string) (*Writer, *pspb.ArchiveState, error) {
+	f, err := os.OpenFile(path, os.O_RDWR, 0644)
+	if err != nil {
+		return nil, nil, err
+	}
+	r, err := NewReader(f)
+	if err != nil {
+		f.Close()
+		return nil, nil, err
+	}
+	var state *pspb.ArchiveState
+	for {
+		chunk, err := r.Next()
+		if err == io.EOF || err == ErrBrokenChunk {
+			break
+		} else if err != nil {
+			f.Close()
+			return nil, nil, err
+		}
+		state = chunk.State
+	}
+	if err = f.Truncate(r.offset); err != nil {
+		f.Close()
+		return nil, nil, err
+	}
+	if _, err = f.Seek(r.offset, io.SeekStart); err != nil {
+		f.Close()
+		return nil, nil, err
+	}
+	return &Writer{f: f, compression: r.compression}, state, nil
+}
+
+//WriteChunk appends chunk to archive and syncs it, so the state in chunk is a checkpoint
+func (w *Writer) WriteChunk(chunk *pspb.ArchiveChunk) error {
+	data := utils.MustMarshal(chunk)
+	if w.compression == CompressionZstd {
+		var err error
+		if data, err = zstd.Compress(nil, data); err != nil {
+			return err
+		}
+	}
+	buf := make([]byte, chunkHeaderSize+len(data))
+	binary.BigEndian.PutUint32(buf, uint32(len(data)))
+	binary.BigEndian.PutUint32(buf[4:], utils.NewCRC(data).Value())
+	copy(buf[chunkHeaderSize:], data)
+	if _, err := w.f.Write(buf); err != nil {
+		return err
+	}
+	return w.f.Sync()
+}
+
+func (w *Writer) Close() error {
+	return w.f.Close()
+}
+
+type Reader struct {
+	r           *bufio.Reader
+	compression uint32
+	offset      int64 //end of the last complete chunk
+}
+
+func NewReader(r io.Reader) (*Reader, error) {
+	br := bufio.NewReaderSize(r, 1<<20)
+	header := make([]byte, headerSize)
+	if _, err := io.ReadFull(br, header); err != nil {
+		return nil, errors.Wrap(err, "read archive header")
+	}
+	if !bytes.Equal(header[:8], []byte(magic)) {
+		return nil, errors.New("not a key archive")
+	}
+	if version := binary.BigEndian.Uint32(header[8:]); version != Version {
+		return nil, errors.Errorf("unsupported archive version %d", version)
+	}
+	return &Reader{
+		r:           br,
+		compression: binary.BigEndian.Uint32(header[12:]),
+		offset:      headerSize,
+	}, nil
+}
+
+//Next returns the next chunk, io.EOF at the end of archive, ErrBrokenChunk if the chunk is
+//truncated or corrupted
+func (r *Reader) Next() (*pspb.ArchiveChunk, error) {
+	header := make([]byte, chunkHeaderSize)
+	n, err := io.ReadFull(r.r, header)
+	if err == io.EOF {
+		return nil, io.EOF
+	} else if err == io.ErrUnexpectedEOF {
+		return nil, ErrBrokenChunk
+	} else if err != nil {
+		return nil, err
+	}
+	size := binary.BigEndian.Uint32(header)
+	if size > maxChunkSize {
+		return nil, ErrBrokenChunk
+	}
+	data := make([]byte, size)
+	if _, err = io.ReadFull(r.r, data); err == io.EOF || err == io.ErrUnexpectedEOF {
+		return nil, ErrBrokenChunk
+	} else if err != nil {
+		return nil, err
+	}
+	if utils.NewCRC(data).Value() != binary.BigEndian.Uint32(header[4:]) {
+		return nil, ErrBrokenChunk
+	}
+	if r.compression == CompressionZstd {
+		if data, err = zstd.Decompress(nil, data); err != nil {
+			return nil, errors.Wrap(ErrBrokenChunk, err.Error())
+		}
+	}
+	var chunk pspb.ArchiveChunk
+	if err = chunk.Unmarshal(data); err != nil || chunk.State == nil {
+		return nil, ErrBrokenChunk
+	}
+	r.offset += int64(n) + int64(size)
+	return &chunk, nil
+}
+
+//ReadState returns the state of a complete archive
+func ReadState(path string) (*pspb.ArchiveState, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	r, err := NewReader(f)
+	if err != nil {
+		return nil, err
+	}
+	var state *pspb.ArchiveState
+	for {
+		chunk, err := r.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		state = chunk.State
+	}
+	if state == nil || !state.Done {
+		return nil, ErrIncomplete
+	}
+	return state, nil
+}
@@ -0,0 +1,263 @@
+package kv_archive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/journeymidnight/autumn/autumn_clientv1"
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/journeymidnight/autumn/xlog"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func init() {
+	xlog.InitLog([]string{"archive.log"}, zapcore.DebugLevel)
+}
+
+//newMemSource is a cluster of two partitions split at "m"
+func newMemSource() *autumn_clientv1.MockLib {
+	return autumn_clientv1.NewMockLib(
+		&pspb.RegionInfo{PartID: 1, Rg: &pspb.Range{StartKey: []byte(""), EndKey: []byte("m")}},
+		&pspb.RegionInfo{PartID: 2, Rg: &pspb.Range{StartKey: []byte("m"), EndKey: []byte("")}},
+	)
+}
+
+func importArchive(t *testing.T, path string, sink Sink) *pspb.ArchiveState {
+	f, err := os.Open(path)
+	require.Nil(t, err)
+	defer f.Close()
+	r, err := NewReader(f)
+	require.Nil(t, err)
+	state, err := Import(context.Background(), r, sink, ImportOptions{Parallel: 4, OpsPerSec: 10000, BytesPerSec: 1 << 20})
+	require.Nil(t, err)
+	return state
+}
+
+func TestExportImport(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "archivetest")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	src := newMemSource()
+	for i := 0; i < 50; i++ {
+		src.Put(context.Background(), []byte(fmt.Sprintf("a%02d", i)), []byte(fmt.Sprintf("val%d", i)))
+		src.Put(context.Background(), []byte(fmt.Sprintf("x%02d", i)), []byte(fmt.Sprintf("val%d", i)))
+	}
+	src.Put(context.Background(), []byte("other"), []byte("val"))
+
+	//full export is interrupted, and resumed
+	full := filepath.Join(dir, "full")
+	w, err := Create(full, CompressionZstd)
+	require.Nil(t, err)
+	src.FailRangeAfter = 3
+	opt := ExportOptions{ChunkSize: 64, PageSize: 7}
+	require.NotNil(t, Export(context.Background(), src, w, NewState(nil, nil), opt))
+	w.Close()
+	_, err = ReadState(full)
+	require.Equal(t, ErrIncomplete, err)
+
+	src.FailRangeAfter = 0
+	w, state, err := Resume(full)
+	require.Nil(t, err)
+	require.NotNil(t, state)
+	require.Nil(t, Export(context.Background(), src, w, state, opt))
+	w.Close()
+	fullState, err := ReadState(full)
+	require.Nil(t, err)
+	require.Equal(t, []uint64{1, 2}, fullState.DonePartitions)
+	require.Equal(t, map[uint64]uint64{1: 101, 2: 101}, fullState.Seqs)
+
+	dst := newMemSource()
+	importArchive(t, full, dst)
+	require.Equal(t, src.Values(), dst.Values())
+
+	//incremental export has changed and deleted keys
+	src.Put(context.Background(), []byte("a01"), []byte("new"))
+	src.Delete(context.Background(), []byte("x02"))
+	src.Put(context.Background(), []byte("x99"), []byte("new"))
+	incr := filepath.Join(dir, "incr")
+	w, err = Create(incr, CompressionNone)
+	require.Nil(t, err)
+	require.Nil(t, Export(context.Background(), src, w, NewState(nil, fullState), opt))
+	w.Close()
+	incrState, err := ReadState(incr)
+	require.Nil(t, err)
+	require.Equal(t, uint64(3), incrState.Count)
+
+	importArchive(t, incr, dst)
+	require.Equal(t, src.Values(), dst.Values())
+
+	//export of prefix
+	prefixed := filepath.Join(dir, "prefix")
+	w, err = Create(prefixed, CompressionNone)
+	require.Nil(t, err)
+	require.Nil(t, Export(context.Background(), src, w, NewState([]byte("x"), nil), opt))
+	w.Close()
+	prefixState, err := ReadState(prefixed)
+	require.Nil(t, err)
+	require.Equal(t, []uint64{2}, prefixState.DonePartitions)
+	require.Equal(t, uint64(50), prefixState.Count)
+}
+
+func TestIncrementalExportAfterCompaction(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "archivetest")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	src := newMemSource()
+	for i := 0; i < 10; i++ {
+		src.Put(context.Background(), []byte(fmt.Sprintf("a%02d", i)), []byte("val"))
+	}
+	full := filepath.Join(dir, "full")
+	w, err := Create(full, CompressionNone)
+	require.Nil(t, err)
+	require.Nil(t, Export(context.Background(), src, w, NewState(nil, nil), ExportOptions{}))
+	w.Close()
+	fullState, err := ReadState(full)
+	require.Nil(t, err)
+
+	//the deletion is dropped before the incremental export
+	src.Delete(context.Background(), []byte("a01"))
+	src.CompactDeletions()
+	incr := filepath.Join(dir, "incr")
+	w, err = Create(incr, CompressionNone)
+	require.Nil(t, err)
+	err = Export(context.Background(), src, w, NewState(nil, fullState), ExportOptions{})
+	w.Close()
+	require.True(t, errors.Is(err, ErrIncrementalExport), "%v", err)
+
+	//partition 2 is not in the base
+	delete(fullState.Seqs, 2)
+	src = newMemSource()
+	src.Put(context.Background(), []byte("a01"), []byte("val"))
+	w, err = Create(filepath.Join(dir, "incr2"), CompressionNone)
+	require.Nil(t, err)
+	err = Export(context.Background(), src, w, NewState(nil, fullState), ExportOptions{})
+	w.Close()
+	require.True(t, errors.Is(err, ErrIncrementalExport), "%v", err)
+}
+
+func TestBrokenChunk(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "archivetest")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "archive")
+	w, err := Create(path, CompressionNone)
+	require.Nil(t, err)
+	for i := 0; i < 3; i++ {
+		require.Nil(t, w.WriteChunk(&pspb.ArchiveChunk{
+			Entries: []*pspb.ArchiveEntry{{Key: []byte(fmt.Sprintf("key%d", i)), Value: []byte("val")}},
+			State:   &pspb.ArchiveState{Count: uint64(i + 1)},
+		}))
+	}
+	w.Close()
+
+	//corrupt the last chunk
+	data, err := ioutil.ReadFile(path)
+	require.Nil(t, err)
+	data[len(data)-1] ^= 0xFF
+	require.Nil(t, ioutil.WriteFile(path, data, 0644))
+
+	w, state, err := Resume(path)
+	require.Nil(t, err)
+	require.Equal(t, uint64(2), state.Count)
+	require.Nil(t, w.WriteChunk(&pspb.ArchiveChunk{State: &pspb.ArchiveState{Count: 2, Done: true}}))
+	w.Close()
+
+	state, err = ReadState(path)
+	require.Nil(t, err)
+	require.Equal(t, uint64(2), state.Count)
+}
+
+func TestExportAfterSplit(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "archivetest")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	src := newMemSource()
+	for i := 0; i < 50; i++ {
+		src.Put(context.Background(), []byte(fmt.Sprintf("a%02d", i)), []byte(fmt.Sprintf("val%d", i)))
+		src.Put(context.Background(), []byte(fmt.Sprintf("x%02d", i)), []byte(fmt.Sprintf("val%d", i)))
+	}
+	path := filepath.Join(dir, "full")
+	opt := ExportOptions{ChunkSize: 64, PageSize: 7}
+	w, err := Create(path, CompressionNone)
+	require.Nil(t, err)
+	src.FailRangeAfter = 4
+	require.NotNil(t, Export(context.Background(), src, w, NewState(nil, nil), opt))
+	w.Close()
+
+	//partition 1 splits after some of its keys are exported
+	src.Split(1, 3, "a10")
+	src.RangeCalls = 0
+	src.FailRangeAfter = 6
+	w, state, err := Resume(path)
+	require.Nil(t, err)
+	require.NotNil(t, Export(context.Background(), src, w, state, opt))
+	w.Close()
+
+	//partition 1 splits after it is done, partition 3 is interrupted
+	src.Split(1, 4, "a05")
+	src.FailRangeAfter = 0
+	w, state, err = Resume(path)
+	require.Nil(t, err)
+	require.Equal(t, uint64(3), state.PartID)
+	require.Nil(t, Export(context.Background(), src, w, state, opt))
+	w.Close()
+
+	f, err := os.Open(path)
+	require.Nil(t, err)
+	defer f.Close()
+	r, err := NewReader(f)
+	require.Nil(t, err)
+	exported := make(map[string]string)
+	for {
+		chunk, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		require.Nil(t, err)
+		for _, entry := range chunk.Entries {
+			_, ok := exported[string(entry.Key)]
+			require.False(t, ok, "%s is exported twice", entry.Key)
+			exported[string(entry.Key)] = string(entry.Value)
+		}
+		state = chunk.State
+	}
+	require.True(t, state.Done)
+	require.Equal(t, uint64(100), state.Count)
+	require.Equal(t, src.Values(), exported)
+}
+
+func TestExportTTL(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "archivetest")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	src := newMemSource()
+	src.PutWithExpiresAt(context.Background(), []byte("a"), []byte("ttl"), 1<<40)
+	src.Put(context.Background(), []byte("x"), []byte("forever"))
+	path := filepath.Join(dir, "full")
+	w, err := Create(path, CompressionNone)
+	require.Nil(t, err)
+	require.Nil(t, Export(context.Background(), src, w, NewState(nil, nil), ExportOptions{}))
+	w.Close()
+
+	dst := newMemSource()
+	importArchive(t, path, dst)
+	got, err := dst.GetIfExists(context.Background(), []byte("a"))
+	require.Nil(t, err)
+	require.Equal(t, "ttl", string(got.Value))
+	require.Equal(t, uint64(1<<40), got.ExpiresAt)
+	got, err = dst.GetIfExists(context.Background(), []byte("x"))
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), got.ExpiresAt)
+}
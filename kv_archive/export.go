@@ -0,0 +1,210 @@
+package kv_archive
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/journeymidnight/autumn/xlog"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultChunkSize = 4 << 20
+	defaultPageSize  = 1000
+)
+
+//ErrIncrementalExport means deleted keys can not be exported incrementally, run a full export instead
+var ErrIncrementalExport = errors.New("incremental export is not possible")
+
+//Source is implemented by autumn_clientv1.AutumnLib
+type Source interface {
+	//Regions returns regions sorted by start key
+	Regions() []*pspb.RegionInfo
+	RangePartition(ctx context.Context, region *pspb.RegionInfo, prefix []byte, start []byte, limit uint32, sinceSeq uint64) (*pspb.RangeResponse, error)
+	GetIfExists(ctx context.Context, key []byte) (*pspb.GetResponse, error)
+}
+
+type ExportOptions struct {
+	ChunkSize int    //bytes of keys and values in a chunk
+	PageSize  uint32 //keys of a range request
+}
+
+//NewState returns the state of a new export, if base is not nil, only keys changed after base are exported
+func NewState(prefix []byte, base *pspb.ArchiveState) *pspb.ArchiveState {
+	state := &pspb.ArchiveState{
+		Prefix: prefix,
+		Seqs:   make(map[uint64]uint64),
+	}
+	if base != nil {
+		state.BaseSeqs = base.Seqs
+	}
+	return state
+}
+
+func overlapPrefix(region *pspb.RegionInfo, prefix []byte) bool {
+	if len(prefix) == 0 {
+		return true
+	}
+	if len(region.Rg.EndKey) > 0 && bytes.Compare(region.Rg.EndKey, prefix) <= 0 {
+		return false
+	}
+	return bytes.Compare(region.Rg.StartKey, prefix) <= 0 || bytes.HasPrefix(region.Rg.StartKey, prefix)
+}
+
+//Export exports keys of state.Prefix to w from state. partitions are exported one by one, regions are
+//read again after each partition, so partitions created by splitting are exported too. keys exported
+//by a partition before it splits are in state.ExportedRanges, they are not exported again
+func Export(ctx context.Context, src Source, w *Writer, state *pspb.ArchiveState, opt ExportOptions) error {
+	if opt.ChunkSize <= 0 {
+		opt.ChunkSize = defaultChunkSize
+	}
+	if opt.PageSize == 0 {
+		opt.PageSize = defaultPageSize
+	}
+	if state.Seqs == nil {
+		state.Seqs = make(map[uint64]uint64)
+	}
+	e := &exporter{src: src, w: w, state: state, opt: opt}
+	for !state.Done {
+		region := e.nextRegion()
+		if region == nil {
+			state.Done = true
+			return e.flush()
+		}
+		if err := e.exportPartition(ctx, region); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type exporter struct {
+	src     Source
+	w       *Writer
+	state   *pspb.ArchiveState
+	opt     ExportOptions
+	entries []*pspb.ArchiveEntry
+	size    int
+}
+
+func (e *exporter) nextRegion() *pspb.RegionInfo {
+	done := make(map[uint64]bool, len(e.state.DonePartitions))
+	for _, partID := range e.state.DonePartitions {
+		done[partID] = true
+	}
+	var next *pspb.RegionInfo
+	for _, region := range e.src.Regions() {
+		if done[region.PartID] || !overlapPrefix(region, e.state.Prefix) {
+			continue
+		}
+		//the interrupted partition is resumed first, its LastKey is lost once another partition starts
+		if region.PartID == e.state.PartID {
+			return region
+		}
+		if next == nil {
+			next = region
+		}
+	}
+	return next
+}
+
+//exported returns true if key has been exported by a done partition
+func (e *exporter) exported(key []byte) bool {
+	for _, rg := range e.state.ExportedRanges {
+		if bytes.Compare(key, rg.StartKey) >= 0 && bytes.Compare(key, rg.EndKey) < 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *exporter) flush() error {
+	err := e.w.WriteChunk(&pspb.ArchiveChunk{
+		Entries: e.entries,
+		State:   e.state,
+	})
+	e.entries = nil
+	e.size = 0
+	return err
+}
+
+func (e *exporter) exportPartition(ctx context.Context, region *pspb.RegionInfo) error {
+	state := e.state
+	partID := region.PartID
+	start := state.Prefix
+	if state.PartID == partID && len(state.LastKey) > 0 {
+		//resume after LastKey
+		start = append(append([]byte{}, state.LastKey...), 0)
+	} else {
+		state.PartID = partID
+		state.LastKey = nil
+		state.StartKey = region.Rg.StartKey
+	}
+	sinceSeq, ok := state.BaseSeqs[partID]
+	if len(state.BaseSeqs) > 0 && !ok {
+		//keys deleted from the partition are unknown
+		return errors.Wrapf(ErrIncrementalExport, "partition %d is created after the base export", partID)
+	}
+	xlog.Logger.Infof("export partition %d since seq %d", partID, sinceSeq)
+
+	for {
+		res, err := e.src.RangePartition(ctx, region, state.Prefix, start, e.opt.PageSize, sinceSeq)
+		if err != nil {
+			return err
+		}
+		if sinceSeq > 0 && res.DeleteGCSeq > sinceSeq {
+			return errors.Wrapf(ErrIncrementalExport, "deletions of partition %d after seq %d are compacted", partID, sinceSeq)
+		}
+		//keys changed after the first range are exported by the next incremental export
+		if _, ok := state.Seqs[partID]; !ok {
+			state.Seqs[partID] = res.Seq
+		}
+		for i, key := range res.Keys {
+			if e.exported(key) {
+				continue
+			}
+			entry := &pspb.ArchiveEntry{Key: key}
+			if sinceSeq > 0 && res.Deleted[i] {
+				entry.Deleted = true
+			} else {
+				got, err := e.src.GetIfExists(ctx, key)
+				if err != nil {
+					return err
+				}
+				if got.NotFound && sinceSeq == 0 {
+					continue
+				}
+				entry.Value = got.Value
+				entry.ExpiresAt = got.ExpiresAt
+				entry.Deleted = got.NotFound
+			}
+			e.entries = append(e.entries, entry)
+			e.size += len(entry.Key) + len(entry.Value)
+			state.LastKey = key
+			state.Count++
+			if e.size >= e.opt.ChunkSize {
+				if err = e.flush(); err != nil {
+					return err
+				}
+			}
+		}
+		if !res.Truncated || len(res.Keys) == 0 {
+			break
+		}
+		start = append(append([]byte{}, res.Keys[len(res.Keys)-1]...), 0)
+	}
+
+	state.DonePartitions = append(state.DonePartitions, partID)
+	//keys of partID are in [StartKey, LastKey], partitions split from partID skip them
+	if len(state.LastKey) > 0 {
+		state.ExportedRanges = append(state.ExportedRanges, &pspb.Range{
+			StartKey: state.StartKey,
+			EndKey:   append(append([]byte{}, state.LastKey...), 0),
+		})
+	}
+	state.PartID = 0
+	state.LastKey = nil
+	state.StartKey = nil
+	return e.flush()
+}
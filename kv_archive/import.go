@@ -0,0 +1,126 @@
+package kv_archive
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"golang.org/x/time/rate"
+)
+
+//Sink is implemented by autumn_clientv1.AutumnLib
+type Sink interface {
+	PutWithExpiresAt(ctx context.Context, key, value []byte, expiresAt uint64) error
+	Delete(ctx context.Context, key []byte) error
+}
+
+type ImportOptions struct {
+	Parallel    int //number of concurrent writes
+	OpsPerSec   int //0 for no limit
+	BytesPerSec int //0 for no limit
+}
+
+//waitBytes waits for n bytes, n could be larger than the burst of limiter
+func waitBytes(ctx context.Context, limiter *rate.Limiter, n int) error {
+	for n > 0 {
+		m := n
+		if m > limiter.Burst() {
+			m = limiter.Burst()
+		}
+		if err := limiter.WaitN(ctx, m); err != nil {
+			return err
+		}
+		n -= m
+	}
+	return nil
+}
+
+//Import writes entries of archive to sink, it returns the state of archive. entries of an archive have
+//different keys, so they are written concurrently. incremental archives should be imported in the order
+//of exporting, after the full archive
+func Import(ctx context.Context, r *Reader, sink Sink, opt ImportOptions) (*pspb.ArchiveState, error) {
+	if opt.Parallel <= 0 {
+		opt.Parallel = 1
+	}
+	var opsLimiter, bytesLimiter *rate.Limiter
+	if opt.OpsPerSec > 0 {
+		opsLimiter = rate.NewLimiter(rate.Limit(opt.OpsPerSec), opt.OpsPerSec)
+	}
+	if opt.BytesPerSec > 0 {
+		bytesLimiter = rate.NewLimiter(rate.Limit(opt.BytesPerSec), opt.BytesPerSec)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var errOnce sync.Once
+	var firstErr error
+	fail := func(err error) {
+		errOnce.Do(func() {
+			firstErr = err
+			cancel()
+		})
+	}
+
+	entryCh := make(chan *pspb.ArchiveEntry, opt.Parallel*4)
+	var wg sync.WaitGroup
+	for i := 0; i < opt.Parallel; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for entry := range entryCh {
+				if ctx.Err() != nil {
+					continue
+				}
+				var err error
+				if opsLimiter != nil {
+					err = opsLimiter.Wait(ctx)
+				}
+				if err == nil && bytesLimiter != nil {
+					err = waitBytes(ctx, bytesLimiter, len(entry.Key)+len(entry.Value))
+				}
+				if err == nil {
+					if entry.Deleted {
+						err = sink.Delete(ctx, entry.Key)
+					} else {
+						err = sink.PutWithExpiresAt(ctx, entry.Key, entry.Value, entry.ExpiresAt)
+					}
+				}
+				if err != nil {
+					fail(err)
+				}
+			}
+		}()
+	}
+
+	var state *pspb.ArchiveState
+	for ctx.Err() == nil {
+		chunk, err := r.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			fail(err)
+			break
+		}
+		for _, entry := range chunk.Entries {
+			select {
+			case entryCh <- entry:
+			case <-ctx.Done():
+			}
+		}
+		state = chunk.State
+	}
+	close(entryCh)
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if state == nil || !state.Done {
+		return state, ErrIncomplete
+	}
+	return state, nil
+}
@@ -23,6 +23,9 @@ type Config struct {
 	MaxTxnOps           uint   // --max-txn-ops
 
 	GrpcUrl string // --listen-stream-manager-grpc
+
+	FailureDomain      string // --failure-domain, host, rack or zone, empty to disable
+	MaxCopiesPerDomain uint   // --max-copies-per-domain, 0 for no limit
 	//GrpcUrlPM string
 }
 
@@ -145,6 +148,16 @@ func NewConfig() *Config {
 				Required:    false,
 				Value:       3000,
 			},
+			&cli.StringFlag{
+				Name:        "failure-domain",
+				Usage:       "spread copies of extents across host, rack or zone",
+				Destination: &config.FailureDomain,
+			},
+			&cli.UintFlag{
+				Name:        "max-copies-per-domain",
+				Usage:       "max copies of an extent in a failure domain, 0 for no limit",
+				Destination: &config.MaxCopiesPerDomain,
+			},
 			/*
 				&cli.StringFlag{
 					Name:        "listen-grpc-pm",
@@ -35,6 +35,8 @@ type ExtentManager struct {
 	//condition lock to notify WaitVersion
 	cond                  *sync.Cond
 	extentUpdatedCallback extentInfoUpdatedFunc
+
+	latency *latencyTracker //read latency of nodes
 }
 
 type extentInfoUpdatedFunc func(eventType string, cur *pb.ExtentInfo, prev *pb.ExtentInfo)
@@ -68,6 +70,7 @@ func NewExtentManager(smclient *SMClient, etcdAddr []string, extentsUpdate exten
 		smClient:              smclient,
 		cond:                  sync.NewCond(new(sync.Mutex)),
 		extentUpdatedCallback: extentsUpdate,
+		latency:               newLatencyTracker(),
 	}
 
 	//load latest data
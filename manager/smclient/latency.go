@@ -0,0 +1,134 @@
+package smclient
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/journeymidnight/autumn/conn"
+	"github.com/journeymidnight/autumn/proto/pb"
+)
+
+/*
+ExtentManager keeps an EWMA of read latency for each node, readers prefer the fastest replicas.
+recent samples of all nodes are kept to compute the delay of hedged reads: if a read does not
+return in HedgeDelay(), it is sent to the next replica.
+*/
+
+const (
+	latencyAlpha      = 0.3
+	latencySamples    = 512
+	hedgePercentile   = 0.95
+	minHedgeDelay     = 2 * time.Millisecond
+	maxHedgeDelay     = 500 * time.Millisecond
+	defaultHedgeDelay = 20 * time.Millisecond
+	errorLatency      = time.Second //a failed read counts as a slow read
+)
+
+type latencyTracker struct {
+	sync.Mutex
+	ewma    map[uint64]float64 //nodeID => nanoseconds
+	samples []time.Duration    //ring buffer of recent samples
+	next    int
+}
+
+func newLatencyTracker() *latencyTracker {
+	return &latencyTracker{
+		ewma:    make(map[uint64]float64),
+		samples: make([]time.Duration, 0, latencySamples),
+	}
+}
+
+func (lt *latencyTracker) record(nodeID uint64, d time.Duration) {
+	lt.Lock()
+	defer lt.Unlock()
+	if v, ok := lt.ewma[nodeID]; ok {
+		lt.ewma[nodeID] = latencyAlpha*float64(d) + (1-latencyAlpha)*v
+	} else {
+		lt.ewma[nodeID] = float64(d)
+	}
+	if len(lt.samples) < latencySamples {
+		lt.samples = append(lt.samples, d)
+	} else {
+		lt.samples[lt.next] = d
+		lt.next = (lt.next + 1) % latencySamples
+	}
+}
+
+//get returns 0 if nodeID has never been read, so new nodes are tried first
+func (lt *latencyTracker) get(nodeID uint64) time.Duration {
+	lt.Lock()
+	defer lt.Unlock()
+	return time.Duration(lt.ewma[nodeID])
+}
+
+func (lt *latencyTracker) percentile(p float64) time.Duration {
+	lt.Lock()
+	if len(lt.samples) < 16 {
+		lt.Unlock()
+		return defaultHedgeDelay
+	}
+	sorted := make([]time.Duration, len(lt.samples))
+	copy(sorted, lt.samples)
+	lt.Unlock()
+
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	d := sorted[int(float64(len(sorted)-1)*p)]
+	if d < minHedgeDelay {
+		return minHedgeDelay
+	}
+	if d > maxHedgeDelay {
+		return maxHedgeDelay
+	}
+	return d
+}
+
+//RecordLatency is called after each read on nodeID
+func (em *ExtentManager) RecordLatency(nodeID uint64, d time.Duration, err error) {
+	if err != nil && d < errorLatency {
+		d = errorLatency
+	}
+	em.latency.record(nodeID, d)
+}
+
+func (em *ExtentManager) NodeLatency(nodeID uint64) time.Duration {
+	return em.latency.get(nodeID)
+}
+
+//HedgeDelay is the p95 of recent reads
+func (em *ExtentManager) HedgeDelay() time.Duration {
+	return em.latency.percentile(hedgePercentile)
+}
+
+//ShardNodes returns nodeIDs of replicates and parity, the index is the position of shard
+func ShardNodes(exInfo *pb.ExtentInfo) []uint64 {
+	nodes := make([]uint64, 0, len(exInfo.Replicates)+len(exInfo.Parity))
+	nodes = append(nodes, exInfo.Replicates...)
+	return append(nodes, exInfo.Parity...)
+}
+
+//SortedShards returns positions of available shards, sorted by read latency.
+//nodes whose connection is unhealthy are at the end
+func (em *ExtentManager) SortedShards(exInfo *pb.ExtentInfo) []int {
+	nodes := ShardNodes(exInfo)
+	positions := make([]int, 0, len(nodes))
+	cost := make(map[int]time.Duration, len(nodes))
+	for i, nodeID := range nodes {
+		if exInfo.Avali > 0 && (exInfo.Avali&(1<<i)) == 0 {
+			continue
+		}
+		nodeInfo := em.GetNodeInfo(nodeID)
+		if nodeInfo == nil {
+			continue
+		}
+		positions = append(positions, i)
+		cost[i] = em.NodeLatency(nodeID)
+		if pool := conn.GetPools().Connect(nodeInfo.Address); pool == nil || !pool.IsHealthy() {
+			cost[i] += time.Hour
+		}
+	}
+	sort.SliceStable(positions, func(i, j int) bool {
+		return cost[positions[i]] < cost[positions[j]]
+	})
+	return positions
+}
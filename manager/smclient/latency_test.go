@@ -0,0 +1,31 @@
+package smclient
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLatencyTracker(t *testing.T) {
+	lt := newLatencyTracker()
+	require.Equal(t, time.Duration(0), lt.get(1))
+	require.Equal(t, defaultHedgeDelay, lt.percentile(hedgePercentile))
+
+	lt.record(1, 10*time.Millisecond)
+	require.Equal(t, 10*time.Millisecond, lt.get(1))
+	lt.record(1, 20*time.Millisecond)
+	require.Equal(t, 13*time.Millisecond, lt.get(1))
+
+	for i := 1; i <= 100; i++ {
+		lt.record(2, time.Duration(i)*time.Millisecond)
+	}
+	d := lt.percentile(hedgePercentile)
+	require.True(t, d >= 90*time.Millisecond && d <= 100*time.Millisecond, "%v", d)
+
+	//clamped
+	for i := 0; i < latencySamples; i++ {
+		lt.record(3, 10*time.Second)
+	}
+	require.Equal(t, maxHedgeDelay, lt.percentile(hedgePercentile))
+}
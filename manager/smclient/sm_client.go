@@ -12,6 +12,7 @@ import (
 	"github.com/journeymidnight/autumn/xlog"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
 )
 
 //FIXME, 统一error的形式,
@@ -25,8 +26,8 @@ var (
 type SMClient struct {
 	conns []*grpc.ClientConn //protected by RWMUTEX
 	sync.RWMutex
-	lastLeader int32 //protected by atomic
-	addrs      []string
+	lastLeader int32    //protected by atomic
+	addrs      []string //protected by RWMUTEX
 }
 
 func NewSMClient(addrs []string) *SMClient {
@@ -77,6 +78,8 @@ func (client *SMClient) Connect() error {
 
 func (client *SMClient) CurrentLeader() string {
 	current := atomic.LoadInt32(&client.lastLeader)
+	client.RLock()
+	defer client.RUnlock()
 	return client.addrs[current]
 }
 
@@ -95,6 +98,30 @@ func (client *SMClient) Alive() bool {
 	return len(client.conns) > 0
 }
 
+//findLeader asks the stream manager behind conn for the address of the leader campaigned in etcd,
+//it returns the index of the leader in addrs. conn is skipped if it is not connected,
+//so a dead stream manager does not cost a timeout on every retry
+func (client *SMClient) findLeader(conn *grpc.ClientConn) (int32, bool) {
+	if conn == nil || conn.GetState() != connectivity.Ready {
+		return 0, false
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	res, err := pb.NewStreamManagerServiceClient(conn).Status(ctx, &pb.StatusRequest{})
+	cancel()
+	if err != nil || res.Leader == nil {
+		return 0, false
+	}
+	client.RLock()
+	defer client.RUnlock()
+	for i, addr := range client.addrs {
+		if addr == res.Leader.GrpcURL {
+			return int32(i), true
+		}
+	}
+	//leader is not in addrs
+	return 0, false
+}
+
 func (client *SMClient) try(f func(conn *grpc.ClientConn) bool, x time.Duration) {
 	client.RLock()
 	connLen := len(client.conns)
@@ -107,9 +134,16 @@ func (client *SMClient) try(f func(conn *grpc.ClientConn) bool, x time.Duration)
 		if client.conns != nil && client.conns[current] != nil {
 			//if f() return true, sleep and continue
 			//if f() return false, return
-			if f(client.conns[current]) {
-				current = (current + 1) % int32(connLen)
+			conn := client.conns[current]
+			if f(conn) {
 				client.RUnlock()
+				//go to the leader directly, cycle through addrs if it is unknown
+				if leader, ok := client.findLeader(conn); !ok {
+					current = (current + 1) % int32(connLen)
+				} else if leader != current {
+					current = leader
+					continue
+				}
 				time.Sleep(x)
 				continue
 			} else {
@@ -123,6 +157,11 @@ func (client *SMClient) try(f func(conn *grpc.ClientConn) bool, x time.Duration)
 }
 
 func (client *SMClient) RegisterNode(ctx context.Context, uuids []string, addr string) (uint64, map[string]uint64, error) {
+	return client.RegisterNodeWithTopology(ctx, uuids, addr, nil)
+}
+
+//RegisterNodeWithTopology registers a node with its failure domains
+func (client *SMClient) RegisterNodeWithTopology(ctx context.Context, uuids []string, addr string, topology *pb.Topology) (uint64, map[string]uint64, error) {
 	err := ErrTimeOut
 	var res *pb.RegisterNodeResponse
 	nodeID := uint64(0)
@@ -132,6 +171,7 @@ func (client *SMClient) RegisterNode(ctx context.Context, uuids []string, addr s
 		res, err = c.RegisterNode(ctx, &pb.RegisterNodeRequest{
 			Addr:      addr,
 			DiskUUIDs: uuids,
+			Topology:  topology,
 		})
 		if err == context.Canceled || err == context.DeadlineExceeded {
 			return false
@@ -154,8 +194,61 @@ func (client *SMClient) RegisterNode(ctx context.Context, uuids []string, addr s
 	return nodeID, uuidToDiskID, err
 }
 
+//RegisterDisk adds disk to node, if replaceDiskID is not 0, the offline disk is removed from node
+func (client *SMClient) RegisterDisk(ctx context.Context, nodeID uint64, uuid string, replaceDiskID uint64) (uint64, error) {
+	err := ErrTimeOut
+	var res *pb.RegisterDiskResponse
+	diskID := uint64(0)
+	client.try(func(conn *grpc.ClientConn) bool {
+		c := pb.NewStreamManagerServiceClient(conn)
+		res, err = c.RegisterDisk(ctx, &pb.RegisterDiskRequest{
+			NodeID:        nodeID,
+			DiskUUID:      uuid,
+			ReplaceDiskID: replaceDiskID,
+		})
+		if err == context.Canceled || err == context.DeadlineExceeded {
+			return false
+		}
+		if err != nil {
+			xlog.Logger.Warnf(err.Error())
+			return true
+		}
+		if res.Code != pb.Code_OK {
+			err = wire_errors.FromPBCode(res.Code, res.CodeDes)
+			//if remote is not a leader, retry
+			return err == wire_errors.NotLeader
+		}
+		diskID = res.DiskID
+		return false
+	}, 500*time.Millisecond)
+
+	return diskID, err
+}
+
 //FIXME: stream layer need Code to tell logic error or network error
 func (client *SMClient) CreateStream(ctx context.Context, dataShard uint32, parityShard uint32) (*pb.StreamInfo, *pb.ExtentInfo, error) {
+	return client.CreateStreamWithReplication(ctx, dataShard, parityShard, pb.ReplicationMode_Leaderless)
+}
+
+//CreateStreamWithReplication creates a stream whose appends are sent in mode
+func (client *SMClient) CreateStreamWithReplication(ctx context.Context, dataShard uint32, parityShard uint32, mode pb.ReplicationMode) (*pb.StreamInfo, *pb.ExtentInfo, error) {
+	return client.createStream(ctx, &pb.CreateStreamRequest{
+		DataShard:   dataShard,
+		ParityShard: parityShard,
+		Replication: mode,
+	})
+}
+
+//CreateECStream creates a EC stream encoded by codec, nil codec is ReedSolomon
+func (client *SMClient) CreateECStream(ctx context.Context, dataShard uint32, parityShard uint32, codec *pb.ErasureCodec) (*pb.StreamInfo, *pb.ExtentInfo, error) {
+	return client.createStream(ctx, &pb.CreateStreamRequest{
+		DataShard:   dataShard,
+		ParityShard: parityShard,
+		Codec:       codec,
+	})
+}
+
+func (client *SMClient) createStream(ctx context.Context, req *pb.CreateStreamRequest) (*pb.StreamInfo, *pb.ExtentInfo, error) {
 	err := ErrTimeOut
 
 	var res *pb.CreateStreamResponse
@@ -163,10 +256,7 @@ func (client *SMClient) CreateStream(ctx context.Context, dataShard uint32, pari
 	var si *pb.StreamInfo
 	client.try(func(conn *grpc.ClientConn) bool {
 		c := pb.NewStreamManagerServiceClient(conn)
-		res, err = c.CreateStream(ctx, &pb.CreateStreamRequest{
-			DataShard:   dataShard,
-			ParityShard: parityShard,
-		})
+		res, err = c.CreateStream(ctx, req)
 
 		//user cancel or timeout
 		if err == context.Canceled || err == context.DeadlineExceeded {
@@ -262,10 +352,26 @@ func (client *SMClient) StreamAllocExtent(ctx context.Context, streamID uint64,
 }
 
 func (client *SMClient) NodesInfo(ctx context.Context) (map[uint64]*pb.NodeInfo, error) {
+	res, err := client.nodesInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return res.Nodes, nil
+}
+
+//DecommissionProgress returns the progress of decommissioning nodes
+func (client *SMClient) DecommissionProgress(ctx context.Context) (map[uint64]*pb.DecommissionProgress, error) {
+	res, err := client.nodesInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return res.Decommission, nil
+}
+
+func (client *SMClient) nodesInfo(ctx context.Context) (*pb.NodesInfoResponse, error) {
 
 	err := ErrTimeOut
 	var res *pb.NodesInfoResponse
-	var nodeInfos map[uint64]*pb.NodeInfo
 	client.try(func(conn *grpc.ClientConn) bool {
 		c := pb.NewStreamManagerServiceClient(conn)
 		res, err = c.NodesInfo(ctx, &pb.NodesInfoRequest{})
@@ -281,11 +387,41 @@ func (client *SMClient) NodesInfo(ctx context.Context) (map[uint64]*pb.NodeInfo,
 			//if remote is not a leader, retry
 			return err == wire_errors.NotLeader
 		}
-		nodeInfos = res.Nodes
 		return false
 	}, 500*time.Millisecond)
 
-	return nodeInfos, err
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+//DecommissionNode starts moving extents away from nodeID, or stops it if cancel is true
+func (client *SMClient) DecommissionNode(ctx context.Context, nodeID uint64, cancel bool) error {
+	err := ErrTimeOut
+	client.try(func(conn *grpc.ClientConn) bool {
+		c := pb.NewStreamManagerServiceClient(conn)
+		var res *pb.DecommissionNodeResponse
+		res, err = c.DecommissionNode(ctx, &pb.DecommissionNodeRequest{
+			NodeID: nodeID,
+			Cancel: cancel,
+		})
+		if err == context.Canceled || err == context.DeadlineExceeded {
+			return false
+		}
+		if err != nil {
+			xlog.Logger.Warnf(err.Error())
+			return true
+		}
+		if res.Code != pb.Code_OK {
+			err = wire_errors.FromPBCode(res.Code, res.CodeDes)
+			//if remote is not a leader, retry
+			return err == wire_errors.NotLeader
+		}
+		return false
+	}, 500*time.Millisecond)
+
+	return err
 }
 
 func (client *SMClient) ExtentInfo(ctx context.Context, extentID uint64) (*pb.ExtentInfo, error) {
@@ -410,6 +546,66 @@ func (client *SMClient) TruncateStream(ctx context.Context, streamID uint64, ext
 	return
 }
 
+//DeleteStream deletes a stream, its extents are reclaimed if no other stream has them.
+//ownerKey and revision are not checked if ownerKey is empty
+func (client *SMClient) DeleteStream(ctx context.Context, streamID uint64, ownerKey string, revision int64) error {
+	err := ErrTimeOut
+	var res *pb.DeleteStreamResponse
+	client.try(func(conn *grpc.ClientConn) bool {
+		c := pb.NewStreamManagerServiceClient(conn)
+		res, err = c.DeleteStream(ctx, &pb.DeleteStreamRequest{
+			StreamID: streamID,
+			OwnerKey: ownerKey,
+			Revision: revision,
+		})
+
+		if err == context.Canceled || err == context.DeadlineExceeded {
+			return false
+		}
+		if err != nil {
+			xlog.Logger.Warnf(err.Error())
+			return true
+		}
+		if res.Code != pb.Code_OK {
+			err = wire_errors.FromPBCode(res.Code, res.CodeDes)
+			//if remote is not a leader, retry
+			return err == wire_errors.NotLeader
+		}
+		return false
+	}, 500*time.Millisecond)
+
+	return err
+}
+
+//ReportInventory sends extents and copy files of a node, sm answers what should be reconciled
+func (client *SMClient) ReportInventory(ctx context.Context, req *pb.InventoryRequest) (*pb.InventoryResponse, error) {
+	err := ErrTimeOut
+	var res *pb.InventoryResponse
+	client.try(func(conn *grpc.ClientConn) bool {
+		c := pb.NewStreamManagerServiceClient(conn)
+		res, err = c.ReportInventory(ctx, req)
+
+		if err == context.Canceled || err == context.DeadlineExceeded {
+			return false
+		}
+		if err != nil {
+			xlog.Logger.Warnf(err.Error())
+			return true
+		}
+		if res.Code != pb.Code_OK {
+			err = wire_errors.FromPBCode(res.Code, res.CodeDes)
+			//if remote is not a leader, retry
+			return err == wire_errors.NotLeader
+		}
+		return false
+	}, 500*time.Millisecond)
+
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 func (client *SMClient) MultiModifySplit(ctx context.Context, partID uint64, midKey []byte,
 	ownerKey string, revision int64, logEnd, rowEnd, metaEnd uint32) error {
 	err := ErrTimeOut
@@ -443,3 +639,175 @@ func (client *SMClient) MultiModifySplit(ctx context.Context, partID uint64, mid
 
 	return err
 }
+
+//SnapshotPartition seals and duplicates streams of partID into a snapshot, it returns snapshotID
+func (client *SMClient) SnapshotPartition(ctx context.Context, partID uint64,
+	ownerKey string, revision int64, logEnd, rowEnd, metaEnd uint32) (uint64, error) {
+	err := ErrTimeOut
+	var res *pb.SnapshotPartitionResponse
+	snapshotID := uint64(0)
+	client.try(func(conn *grpc.ClientConn) bool {
+		c := pb.NewStreamManagerServiceClient(conn)
+		res, err = c.SnapshotPartition(ctx, &pb.SnapshotPartitionRequest{
+			PartID:                 partID,
+			OwnerKey:               ownerKey,
+			Revision:               revision,
+			LogStreamSealedLength:  logEnd,
+			RowStreamSealedLength:  rowEnd,
+			MetaStreamSealedLength: metaEnd,
+		})
+		if err == context.Canceled || err == context.DeadlineExceeded {
+			return false
+		}
+		if err != nil {
+			xlog.Logger.Warnf(err.Error())
+			return true
+		}
+		if res.Code != pb.Code_OK {
+			err = wire_errors.FromPBCode(res.Code, res.CodeDes)
+			//if remote is not a leader, retry
+			return err == wire_errors.NotLeader
+		}
+		snapshotID = res.SnapshotID
+		return false
+	}, 500*time.Millisecond)
+
+	return snapshotID, err
+}
+
+//RestoreSnapshot replaces streams of the partition of snapshotID with copies of the snapshot
+func (client *SMClient) RestoreSnapshot(ctx context.Context, snapshotID uint64, ownerKey string, revision int64) error {
+	err := ErrTimeOut
+	var res *pb.RestoreSnapshotResponse
+	client.try(func(conn *grpc.ClientConn) bool {
+		c := pb.NewStreamManagerServiceClient(conn)
+		res, err = c.RestoreSnapshot(ctx, &pb.RestoreSnapshotRequest{
+			SnapshotID: snapshotID,
+			OwnerKey:   ownerKey,
+			Revision:   revision,
+		})
+		if err == context.Canceled || err == context.DeadlineExceeded {
+			return false
+		}
+		if err != nil {
+			xlog.Logger.Warnf(err.Error())
+			return true
+		}
+		if res.Code != pb.Code_OK {
+			err = wire_errors.FromPBCode(res.Code, res.CodeDes)
+			//if remote is not a leader, retry
+			return err == wire_errors.NotLeader
+		}
+		return false
+	}, 500*time.Millisecond)
+
+	return err
+}
+
+//DeleteSnapshot deletes streams of snapshotID, extents only in the snapshot are reclaimed later
+func (client *SMClient) DeleteSnapshot(ctx context.Context, snapshotID uint64) error {
+	err := ErrTimeOut
+	var res *pb.DeleteSnapshotResponse
+	client.try(func(conn *grpc.ClientConn) bool {
+		c := pb.NewStreamManagerServiceClient(conn)
+		res, err = c.DeleteSnapshot(ctx, &pb.DeleteSnapshotRequest{SnapshotID: snapshotID})
+		if err == context.Canceled || err == context.DeadlineExceeded {
+			return false
+		}
+		if err != nil {
+			xlog.Logger.Warnf(err.Error())
+			return true
+		}
+		if res.Code != pb.Code_OK {
+			err = wire_errors.FromPBCode(res.Code, res.CodeDes)
+			//if remote is not a leader, retry
+			return err == wire_errors.NotLeader
+		}
+		return false
+	}, 500*time.Millisecond)
+
+	return err
+}
+
+//SetECPolicy sets the policy of background EC conversion, nil policy disables conversion
+func (client *SMClient) SetECPolicy(ctx context.Context, streamID uint64, policy *pb.ECPolicy) error {
+	err := ErrTimeOut
+	var res *pb.SetECPolicyResponse
+	client.try(func(conn *grpc.ClientConn) bool {
+		c := pb.NewStreamManagerServiceClient(conn)
+		res, err = c.SetECPolicy(ctx, &pb.SetECPolicyRequest{
+			StreamID: streamID,
+			Policy:   policy,
+		})
+		if err == context.Canceled || err == context.DeadlineExceeded {
+			return false
+		}
+		if err != nil {
+			xlog.Logger.Warnf(err.Error())
+			return true
+		}
+		if res.Code != pb.Code_OK {
+			err = wire_errors.FromPBCode(res.Code, res.CodeDes)
+			//if remote is not a leader, retry
+			return err == wire_errors.NotLeader
+		}
+		return false
+	}, 500*time.Millisecond)
+
+	return err
+}
+
+//ECConversionStatus returns progress of all streams which have ECPolicy if streamID is 0
+func (client *SMClient) ECConversionStatus(ctx context.Context, streamID uint64) ([]*pb.ECConversionProgress, error) {
+	err := ErrTimeOut
+	var res *pb.ECConversionStatusResponse
+	var progress []*pb.ECConversionProgress
+	client.try(func(conn *grpc.ClientConn) bool {
+		c := pb.NewStreamManagerServiceClient(conn)
+		res, err = c.ECConversionStatus(ctx, &pb.ECConversionStatusRequest{StreamID: streamID})
+		if err == context.Canceled || err == context.DeadlineExceeded {
+			return false
+		}
+		if err != nil {
+			xlog.Logger.Warnf(err.Error())
+			return true
+		}
+		if res.Code != pb.Code_OK {
+			err = wire_errors.FromPBCode(res.Code, res.CodeDes)
+			//if remote is not a leader, retry
+			return err == wire_errors.NotLeader
+		}
+		progress = res.Progress
+		return false
+	}, 500*time.Millisecond)
+
+	return progress, err
+}
+
+//VerifyExtent asks sm to verify checksums of all copies of extentID now
+func (client *SMClient) VerifyExtent(ctx context.Context, extentID uint64) (*pb.VerifyExtentResponse, error) {
+	err := ErrTimeOut
+	var res *pb.VerifyExtentResponse
+	client.try(func(conn *grpc.ClientConn) bool {
+		c := pb.NewStreamManagerServiceClient(conn)
+		res, err = c.VerifyExtent(ctx, &pb.VerifyExtentRequest{ExtentID: extentID})
+		if err == context.Canceled || err == context.DeadlineExceeded {
+			return false
+		}
+		if err != nil {
+			xlog.Logger.Warnf(err.Error())
+			return true
+		}
+		if res.Code != pb.Code_OK {
+			err = wire_errors.FromPBCode(res.Code, res.CodeDes)
+			//if remote is not a leader, retry
+			return err == wire_errors.NotLeader
+		}
+		return false
+	}, 500*time.Millisecond)
+
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
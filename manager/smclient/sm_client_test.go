@@ -0,0 +1,77 @@
+package smclient
+
+import (
+	"context"
+	"net"
+	"os"
+	"sync/atomic"
+	"testing"
+
+	"github.com/journeymidnight/autumn/proto/pb"
+	"github.com/journeymidnight/autumn/xlog"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc"
+)
+
+type fakeStreamManager struct {
+	pb.UnimplementedStreamManagerServiceServer
+	leader      string
+	isLeader    bool
+	nodesInfo   int32
+	statusCalls int32
+}
+
+func (sm *fakeStreamManager) Status(ctx context.Context, req *pb.StatusRequest) (*pb.StatusResponse, error) {
+	atomic.AddInt32(&sm.statusCalls, 1)
+	return &pb.StatusResponse{Code: pb.Code_OK, Leader: &pb.MemberValue{GrpcURL: sm.leader}}, nil
+}
+
+func (sm *fakeStreamManager) NodesInfo(ctx context.Context, req *pb.NodesInfoRequest) (*pb.NodesInfoResponse, error) {
+	atomic.AddInt32(&sm.nodesInfo, 1)
+	if !sm.isLeader {
+		return &pb.NodesInfoResponse{Code: pb.Code_NotLEADER}, nil
+	}
+	return &pb.NodesInfoResponse{Code: pb.Code_OK}, nil
+}
+
+func serveFakeStreamManager(t *testing.T, addr string, sm *fakeStreamManager) *grpc.Server {
+	listener, err := net.Listen("tcp", addr)
+	require.Nil(t, err)
+	server := grpc.NewServer()
+	pb.RegisterStreamManagerServiceServer(server, sm)
+	go server.Serve(listener)
+	return server
+}
+
+func TestTryGoesToLeader(t *testing.T) {
+	xlog.InitLog([]string{"smclient.log"}, zapcore.DebugLevel)
+	defer os.Remove("smclient.log")
+
+	//nothing listens on the first address
+	addrs := []string{"127.0.0.1:23420", "127.0.0.1:23421", "127.0.0.1:23422"}
+	follower := &fakeStreamManager{leader: addrs[2]}
+	leader := &fakeStreamManager{leader: addrs[2], isLeader: true}
+	s1 := serveFakeStreamManager(t, addrs[1], follower)
+	defer s1.Stop()
+	s2 := serveFakeStreamManager(t, addrs[2], leader)
+	defer s2.Stop()
+
+	client := NewSMClient(addrs)
+	require.Nil(t, client.Connect())
+	defer client.Close()
+
+	_, err := client.NodesInfo(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, addrs[2], client.CurrentLeader())
+	//the follower is asked once, then the client jumps to the leader
+	require.Equal(t, int32(1), atomic.LoadInt32(&follower.nodesInfo))
+	require.Equal(t, int32(1), atomic.LoadInt32(&follower.statusCalls))
+	require.Equal(t, int32(0), atomic.LoadInt32(&leader.statusCalls))
+
+	//the leader is remembered
+	_, err = client.NodesInfo(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, int32(1), atomic.LoadInt32(&follower.nodesInfo))
+	require.Equal(t, int32(2), atomic.LoadInt32(&leader.nodesInfo))
+}
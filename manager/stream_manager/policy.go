@@ -1,6 +1,8 @@
 package stream_manager
 
 import (
+	"fmt"
+	"net"
 	"sort"
 
 	"github.com/journeymidnight/autumn/xlog"
@@ -8,33 +10,53 @@ import (
 )
 
 type AllocExtentPolicy interface {
-	AllocExtent([]*NodeStatus, int, []uint64) ([]*NodeStatus, error)
+	//AllocExtent chooses count nodes from ns, keepNodes have other copies of the extent
+	AllocExtent(ns []*NodeStatus, count int, keepNodes []*NodeStatus) ([]*NodeStatus, error)
 }
 
-type SimplePolicy struct{}
-
-func (sp *SimplePolicy) AllocExtent(ns []*NodeStatus, count int, keepNodes []uint64) ([]*NodeStatus, error) {
+//NewAllocExtentPolicy returns SimplePolicy if failureDomain is empty
+func NewAllocExtentPolicy(failureDomain string, maxPerDomain int) (AllocExtentPolicy, error) {
+	if failureDomain == "" {
+		return new(SimplePolicy), nil
+	}
+	level, err := ParseFailureDomain(failureDomain)
+	if err != nil {
+		return nil, err
+	}
+	if maxPerDomain < 0 {
+		return nil, errors.Errorf("invalid max copies per failure domain %d", maxPerDomain)
+	}
+	return &FailureDomainPolicy{Level: level, MaxPerDomain: maxPerDomain}, nil
+}
 
-	xlog.Logger.Debugf("alloc extents %d from %d", count, len(ns))
+func sortNodes(ns []*NodeStatus) {
 	sort.Slice(ns, func(a, b int) bool {
 		if ns[a].LastEcho().After(ns[b].LastEcho()) {
 			return true
 		} else if ns[a].LastEcho().Before(ns[b].LastEcho()) {
 			return false
 		}
-		return ns[a].free >  ns[b].free
+		return ns[a].Free() > ns[b].Free()
 	})
+}
+
+type SimplePolicy struct{}
+
+func (sp *SimplePolicy) AllocExtent(ns []*NodeStatus, count int, keepNodes []*NodeStatus) ([]*NodeStatus, error) {
+
+	xlog.Logger.Debugf("alloc extents %d from %d", count, len(ns))
+	sortNodes(ns)
 
 	set := make(map[uint64]bool)
-	for _, id := range keepNodes {
-		set[id] = true
+	for _, n := range keepNodes {
+		set[n.NodeID] = true
 	}
 	if len(ns) < count {
 		return nil, errors.New("not enough nodes")
 	}
 
 	var ret []*NodeStatus
-	for i := 0; i < count; i++ {
+	for i := 0; i < len(ns) && len(ret) < count; i++ {
 		if _, ok := set[ns[i].NodeID]; !ok {
 			ret = append(ret, ns[i])
 		}
@@ -44,3 +66,101 @@ func (sp *SimplePolicy) AllocExtent(ns []*NodeStatus, count int, keepNodes []uin
 	}
 	return ret, nil
 }
+
+type FailureDomain int
+
+const (
+	DomainHost FailureDomain = iota
+	DomainRack
+	DomainZone
+)
+
+func ParseFailureDomain(s string) (FailureDomain, error) {
+	switch s {
+	case "host":
+		return DomainHost, nil
+	case "rack":
+		return DomainRack, nil
+	case "zone":
+		return DomainZone, nil
+	}
+	return 0, errors.Errorf("unknown failure domain %s, should be host, rack or zone", s)
+}
+
+//FailureDomainPolicy spreads copies of an extent across failure domains of Level, a domain has
+//at most MaxPerDomain copies, 0 for no limit. a node without the label of Level is a domain itself,
+//except that host defaults to the host of node address
+type FailureDomainPolicy struct {
+	Level        FailureDomain
+	MaxPerDomain int
+}
+
+func (fp *FailureDomainPolicy) domainOf(n *NodeStatus) string {
+	t := n.Topology
+	var zone, rack, host string
+	if t != nil {
+		zone, rack, host = t.Zone, t.Rack, t.Host
+	}
+	if host == "" {
+		if h, _, err := net.SplitHostPort(n.Address); err == nil {
+			host = h
+		}
+	}
+	switch fp.Level {
+	case DomainZone:
+		if zone != "" {
+			return zone
+		}
+	case DomainRack:
+		if rack != "" {
+			return zone + "/" + rack
+		}
+	default:
+		if host != "" {
+			return zone + "/" + rack + "/" + host
+		}
+	}
+	return fmt.Sprintf("node-%d", n.NodeID)
+}
+
+func (fp *FailureDomainPolicy) AllocExtent(ns []*NodeStatus, count int, keepNodes []*NodeStatus) ([]*NodeStatus, error) {
+
+	xlog.Logger.Debugf("alloc extents %d from %d", count, len(ns))
+	sortNodes(ns)
+	return fp.spread(ns, count, keepNodes)
+}
+
+//spread chooses count nodes in the order of ns, every one is from the domain having least copies
+func (fp *FailureDomainPolicy) spread(ns []*NodeStatus, count int, keepNodes []*NodeStatus) ([]*NodeStatus, error) {
+	used := make(map[uint64]bool)
+	copies := make(map[string]int) //domain => number of copies
+	for _, n := range keepNodes {
+		used[n.NodeID] = true
+		copies[fp.domainOf(n)]++
+	}
+
+	var ret []*NodeStatus
+	for len(ret) < count {
+		var chosen *NodeStatus
+		least := 0
+		for _, n := range ns {
+			if used[n.NodeID] {
+				continue
+			}
+			c := copies[fp.domainOf(n)]
+			if fp.MaxPerDomain > 0 && c >= fp.MaxPerDomain {
+				continue
+			}
+			if chosen == nil || c < least {
+				chosen, least = n, c
+			}
+		}
+		if chosen == nil {
+			return nil, errors.Errorf("cannot find enough nodes in different failure domains, %d copies, at most %d in a domain", count, fp.MaxPerDomain)
+		}
+		used[chosen.NodeID] = true
+		copies[fp.domainOf(chosen)]++
+		ret = append(ret, chosen)
+	}
+	return ret, nil
+}
@@ -0,0 +1,63 @@
+package stream_manager
+
+import (
+	"testing"
+
+	"github.com/journeymidnight/autumn/proto/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func testNode(nodeID uint64, zone, rack, host string) *NodeStatus {
+	return &NodeStatus{NodeInfo: pb.NodeInfo{
+		NodeID:   nodeID,
+		Address:  "10.0.0.1:4000",
+		Topology: &pb.Topology{Zone: zone, Rack: rack, Host: host},
+	}}
+}
+
+func nodeIDs(ns []*NodeStatus) []uint64 {
+	var ret []uint64
+	for _, n := range ns {
+		ret = append(ret, n.NodeID)
+	}
+	return ret
+}
+
+func TestFailureDomainPolicy(t *testing.T) {
+	nodes := []*NodeStatus{
+		testNode(1, "z1", "r1", "h1"),
+		testNode(2, "z1", "r1", "h2"),
+		testNode(3, "z1", "r2", "h3"),
+		testNode(4, "z2", "r3", "h4"),
+		testNode(5, "z2", "r3", "h5"),
+	}
+
+	fp := &FailureDomainPolicy{Level: DomainRack, MaxPerDomain: 1}
+	ret, err := fp.spread(nodes, 3, nil)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 3, 4}, nodeIDs(ret))
+
+	//only 3 racks
+	_, err = fp.spread(nodes, 4, nil)
+	require.Error(t, err)
+
+	//replace a copy on node 3, nodes 1 and 4 keep their copies
+	ret, err = fp.spread(nodes[1:], 1, []*NodeStatus{nodes[0], nodes[3]})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{3}, nodeIDs(ret))
+
+	//no limit, copies are spread evenly
+	fp = &FailureDomainPolicy{Level: DomainZone}
+	ret, err = fp.spread(nodes, 4, nil)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 4, 2, 5}, nodeIDs(ret))
+
+	//nodes without labels are different domains, host defaults to address
+	fp = &FailureDomainPolicy{Level: DomainRack}
+	require.Equal(t, "node-6", fp.domainOf(&NodeStatus{NodeInfo: pb.NodeInfo{NodeID: 6}}))
+	fp = &FailureDomainPolicy{Level: DomainHost}
+	require.Equal(t, "//10.0.0.1", fp.domainOf(&NodeStatus{NodeInfo: pb.NodeInfo{NodeID: 6, Address: "10.0.0.1:4000"}}))
+
+	_, err = NewAllocExtentPolicy("building", 0)
+	require.Error(t, err)
+}
@@ -2,7 +2,6 @@ package stream_manager
 
 import (
 	"context"
-	"fmt"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -124,17 +123,26 @@ type StreamManager struct {
 
 	taskPoolLock *utils.SafeMutex
 	taskPool     *TaskPool
+
+	converting    *sync.Map //extentID => streamID, extents being converted to EC
+	convertErrors *sync.Map //streamID => last error of conversion
+
+	cacheRev      int64          //atomic, etcd revision of the maps when following
+	followStopper *utils.Stopper //follower watches etcd, nil when leader
 }
 
 func NewStreamManager(etcd *embed.Etcd, client *clientv3.Client, config *manager.Config) *StreamManager {
+	policy, err := NewAllocExtentPolicy(config.FailureDomain, int(config.MaxCopiesPerDomain))
+	utils.Check(err)
 	sm := &StreamManager{
 		etcd:    etcd,
 		client:  client,
 		config:  config,
 		ID:      uint64(etcd.Server.ID()),
-		policy:  new(SimplePolicy),
+		policy:  policy,
 		stopper: utils.NewStopper(),
 	}
+	sm.initCache()
 
 	v := pb.MemberValue{
 		ID:      sm.ID,
@@ -159,143 +167,48 @@ func (sm *StreamManager) AmLeader() bool {
 	return atomic.LoadInt32(&sm.isLeader) == 1
 }
 
-func (sm *StreamManager) runAsLeader() {
-	//load system
-
-	startLoading := time.Now()
-
-	//load streams
-	kvs, _, err := etcd_utils.EtcdRange(sm.client, "streams/")
-	if err != nil {
-		xlog.Logger.Warnf(err.Error())
-		return
-	}
-
-	sm.streams = &hashmap.HashMap{}
-	//sm.streamsLocks = new(sync.Map)
-
-	for _, kv := range kvs {
-		streamID, err := parseKey(string(kv.Key), "streams")
-		if err != nil {
-			xlog.Logger.Warnf(err.Error())
-			return
-		}
-		var streamInfo pb.StreamInfo
-		if err = streamInfo.Unmarshal(kv.Value); err != nil {
-			xlog.Logger.Warnf(err.Error())
-			return
-		}
-
-		sm.streams.Set(streamID, &streamInfo)
-		//sm.streamsLocks.Store(streamID, new(sync.Mutex))
-	}
-
-	//load extents
-	kvs, _, err = etcd_utils.EtcdRange(sm.client, "extents/")
-	if err != nil {
-		xlog.Logger.Warnf(err.Error())
-		return
-	}
-
-	sm.extents = &hashmap.HashMap{}
-	sm.extentsLocks = new(sync.Map)
-	for _, kv := range kvs {
-		extentID, err := parseKey(string(kv.Key), "extents")
-		if err != nil {
-			xlog.Logger.Errorf(err.Error())
-			return
-		}
-		var extentInfo pb.ExtentInfo
-		if err = extentInfo.Unmarshal(kv.Value); err != nil {
-			xlog.Logger.Errorf(err.Error())
-			return
-		}
-
-		/*
-			if kv.Version != int64(extentInfo.Eversion) {
-				panic(fmt.Sprintf("%s 's Version %d is not equal to %d", kv.Key, kv.Version, extentInfo.Eversion))
-			}
-		*/
-
-		sm.extents.Set(extentID, &extentInfo)
-		sm.extentsLocks.Store(extentID, new(sync.Mutex))
-	}
-
-	//sm.nodes = make(map[uint64]*NodeStatus)
-
-	sm.nodes = &hashmap.HashMap{}
-
-	kvs, _, err = etcd_utils.EtcdRange(sm.client, "nodes/")
+//currentLeader returns the MemberValue campaigned by the leader, the earliest key of the election.
+//it returns nil if there is no leader
+func (sm *StreamManager) currentLeader(ctx context.Context) (*pb.MemberValue, error) {
+	res, err := sm.client.Get(ctx, electionKeyPrefix+"/", clientv3.WithFirstCreate()...)
 	if err != nil {
-		xlog.Logger.Errorf(err.Error())
-		return
-	}
-	for _, kv := range kvs {
-		nodeID, err := parseKey(string(kv.Key), "nodes")
-		if err != nil {
-			xlog.Logger.Errorf(err.Error())
-			return
-		}
-		var nodeInfo pb.NodeInfo
-		if err = nodeInfo.Unmarshal(kv.Value); err != nil {
-			xlog.Logger.Errorf(err.Error())
-			return
-		}
-		sm.nodes.Set(nodeID, &NodeStatus{
-			NodeInfo: nodeInfo,
-		})
+		return nil, err
 	}
-
-	//load recovery tasks
-	sm.taskPool = NewTaskPool()
-	kvs, _, err = etcd_utils.EtcdRange(sm.client, "recoveryTasks/")
-	if err != nil {
-		xlog.Logger.Errorf(err.Error())
-		return
+	if len(res.Kvs) == 0 {
+		return nil, nil
 	}
-	for _, kv := range kvs {
-		var task pb.RecoveryTask
-		if err = task.Unmarshal(kv.Value); err != nil {
-			xlog.Logger.Errorf(err.Error())
-			continue
-		}
-		fmt.Printf("%+v\n", task)
-
-		sm.taskPool.Insert(&task)
-		fmt.Printf("????%v\n", sm.taskPool.GetFromNode(task.NodeID))
+	var leader pb.MemberValue
+	if err = leader.Unmarshal(res.Kvs[0].Value); err != nil {
+		return nil, err
 	}
+	return &leader, nil
+}
 
-	sm.disks = &hashmap.HashMap{}
-	kvs, _, err = etcd_utils.EtcdRange(sm.client, "disks")
-	if err != nil {
+func (sm *StreamManager) runAsLeader() {
+	startTakeover := time.Now()
+	if err := sm.catchUp(); err != nil {
 		xlog.Logger.Errorf(err.Error())
 		return
 	}
-	for _, kv := range kvs {
-		diskID, err := parseKey(string(kv.Key), "disks")
-		if err != nil {
-			xlog.Logger.Errorf(err.Error())
-			return
-		}
-		var diskInfo pb.DiskInfo
-		if err = diskInfo.Unmarshal(kv.Value); err != nil {
-			xlog.Logger.Errorf(err.Error())
-			return
-		}
-		sm.disks.Set(diskID, &DiskStatus{
-			DiskInfo: diskInfo,
-		})
-	}
+	sm.converting = new(sync.Map)
+	sm.convertErrors = new(sync.Map)
+	sm.stopper = utils.NewStopper()
 
 	//start leader tasks
 	sm.stopper.RunWorker(sm.routineUpdateDF)
 	sm.stopper.RunWorker(sm.routineDispatchTask)
+	sm.stopper.RunWorker(sm.routineConvertEC)
+	sm.stopper.RunWorker(sm.routineAuditExtents)
+	sm.stopper.RunWorker(sm.routineDecommission)
+	sm.stopper.RunWorker(sm.routineReclaimExtents)
 
 	atomic.StoreInt32(&sm.isLeader, 1)
-	fmt.Printf("Start loading data from etcd, cost %v\n", time.Since(startLoading))
+	xlog.Logger.Infof("take over as leader, cost %v", time.Since(startTakeover))
 }
 
 func (sm *StreamManager) LeaderLoop() {
+	//keep a warm copy of etcd before being elected
+	sm.startFollowing()
 	for {
 		if sm.ID != sm.etcdLeader() {
 			time.Sleep(100 * time.Millisecond)
@@ -325,6 +238,7 @@ func (sm *StreamManager) LeaderLoop() {
 			atomic.StoreInt32(&sm.isLeader, 0)
 			sm.stopper.Stop()
 			xlog.Logger.Info("%d's leadershipt expire", sm.ID)
+			sm.startFollowing()
 		}
 	}
 }
@@ -0,0 +1,304 @@
+package stream_manager
+
+import (
+	"context"
+	"math/bits"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/journeymidnight/autumn/etcd_utils"
+	"github.com/journeymidnight/autumn/proto/pb"
+	"github.com/journeymidnight/autumn/utils"
+	"github.com/journeymidnight/autumn/wire_errors"
+	"github.com/journeymidnight/autumn/xlog"
+	"github.com/pkg/errors"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"google.golang.org/grpc"
+)
+
+/*
+extent checksums:
+nodes keep a rolling crc32c of every extent. after an extent is sealed, sm collects the checksums of
+all copies and records them in ExtentInfo.Checksums. sealed extents are audited periodically, nodes
+reread the whole extent from disk, if a copy does not match the recorded checksum, a recovery task
+is dispatched to replace it. nodes also verify copied data by recorded checksums when recovering
+*/
+
+const (
+	auditExtentsPerRound = 4
+	checksumTimeout      = 10 * time.Minute
+)
+
+//RecordedChecksum returns the checksum of copy i recorded in exInfo
+func RecordedChecksum(exInfo *pb.ExtentInfo, i int) (uint32, bool) {
+	if i < 0 || i >= len(exInfo.Checksums) || exInfo.ChecksumMask&(1<<i) == 0 {
+		return 0, false
+	}
+	return exInfo.Checksums[i], true
+}
+
+func (sm *StreamManager) VerifyExtent(ctx context.Context, req *pb.VerifyExtentRequest) (*pb.VerifyExtentResponse, error) {
+	errDone := func(err error) (*pb.VerifyExtentResponse, error) {
+		code, desCode := wire_errors.ConvertToPBCode(err)
+		return &pb.VerifyExtentResponse{
+			Code:    code,
+			CodeDes: desCode,
+		}, nil
+	}
+
+	if !sm.AmLeader() {
+		return errDone(wire_errors.NotLeader)
+	}
+
+	exInfo, ok := sm.cloneExtentInfo(req.ExtentID)
+	if !ok {
+		return errDone(errors.Errorf("no such extent %d", req.ExtentID))
+	}
+	if exInfo.Avali == 0 {
+		return errDone(errors.Errorf("extent %d is not sealed", req.ExtentID))
+	}
+
+	sums, corrupted, err := sm.auditExtent(ctx, exInfo, false)
+	if err != nil {
+		return errDone(err)
+	}
+	exInfo, _ = sm.cloneExtentInfo(req.ExtentID)
+	return &pb.VerifyExtentResponse{
+		Code:      pb.Code_OK,
+		Checksums: sums,
+		Corrupted: corrupted,
+		Extent:    exInfo,
+	}, nil
+}
+
+//collectChecksums asks available copies of exInfo for their checksums, -1 if no response
+func (sm *StreamManager) collectChecksums(ctx context.Context, exInfo *pb.ExtentInfo, cached bool) []int64 {
+	nodes := sm.getNodes(exInfo)
+	result := make([]int64, len(exInfo.Replicates)+len(exInfo.Parity))
+
+	var wg sync.WaitGroup
+	for i := range result {
+		result[i] = -1
+		if nodes == nil || exInfo.Avali&(1<<i) == 0 {
+			continue
+		}
+		conn := nodes[i].GetConn()
+		if conn == nil {
+			continue
+		}
+		wg.Add(1)
+		go func(i int, conn *grpc.ClientConn) {
+			defer wg.Done()
+			res, err := pb.NewExtentServiceClient(conn).ExtentChecksum(ctx, &pb.ExtentChecksumRequest{
+				ExtentID: exInfo.ExtentID,
+				Eversion: exInfo.Eversion,
+				Cached:   cached,
+			})
+			if err != nil {
+				xlog.Logger.Warnf("get checksum of extent %d from node %d: %v", exInfo.ExtentID, nodes[i].NodeID, err)
+				return
+			}
+			if res.Code != pb.Code_OK {
+				xlog.Logger.Warnf("get checksum of extent %d from node %d: %s", exInfo.ExtentID, nodes[i].NodeID, res.CodeDes)
+				return
+			}
+			result[i] = int64(res.Checksum)
+		}(i, conn)
+	}
+	wg.Wait()
+	return result
+}
+
+//checkChecksums compares checksums of copies with recorded ones, returns corrupted copies and checksums
+//to be recorded. all copies of a replicated extent are the same, its first checksum is recorded only if
+//a majority of copies agree. every shard of EC extent is different, its first checksum is trusted
+func checkChecksums(exInfo *pb.ExtentInfo, sums []int64) (uint32, map[int]uint32) {
+	var corrupted uint32
+	record := make(map[int]uint32)
+
+	if len(exInfo.Parity) > 0 {
+		for i, sum := range sums {
+			if sum < 0 {
+				continue
+			}
+			if expected, ok := RecordedChecksum(exInfo, i); !ok {
+				record[i] = uint32(sum)
+			} else if expected != uint32(sum) {
+				corrupted |= 1 << i
+			}
+		}
+		return corrupted, record
+	}
+
+	var expected int64 = -1
+	for i := range sums {
+		if sum, ok := RecordedChecksum(exInfo, i); ok {
+			expected = int64(sum)
+			break
+		}
+	}
+	if expected == -1 {
+		votes := make(map[int64]int)
+		for _, sum := range sums {
+			if sum < 0 {
+				continue
+			}
+			votes[sum]++
+			if votes[sum]*2 > len(sums) {
+				expected = sum
+			}
+		}
+		if expected == -1 {
+			return 0, record
+		}
+	}
+	for i, sum := range sums {
+		if sum < 0 {
+			continue
+		}
+		if sum != expected {
+			corrupted |= 1 << i
+		} else if _, ok := RecordedChecksum(exInfo, i); !ok {
+			record[i] = uint32(sum)
+		}
+	}
+	return corrupted, record
+}
+
+//auditExtent records missing checksums of exInfo and dispatches recovery for a corrupted copy,
+//if cached is true, nodes return their rolling checksums instead of rereading extent
+func (sm *StreamManager) auditExtent(ctx context.Context, exInfo *pb.ExtentInfo, cached bool) ([]int64, uint32, error) {
+	cctx, cancel := context.WithTimeout(ctx, checksumTimeout)
+	sums := sm.collectChecksums(cctx, exInfo, cached)
+	cancel()
+
+	corrupted, record := checkChecksums(exInfo, sums)
+	if corrupted == 0 && len(record) == 0 {
+		return sums, 0, nil
+	}
+
+	if err := sm.lockExtent(exInfo.ExtentID); err != nil {
+		return sums, corrupted, err
+	}
+	defer sm.unlockExtent(exInfo.ExtentID)
+	cur, ok := sm.cloneExtentInfo(exInfo.ExtentID)
+	if !ok || cur.Eversion != exInfo.Eversion {
+		return sums, corrupted, errors.Errorf("extent %d is updated during verification", exInfo.ExtentID)
+	}
+
+	if len(record) > 0 {
+		n := len(cur.Replicates) + len(cur.Parity)
+		if len(cur.Checksums) != n {
+			checksums := make([]uint32, n)
+			copy(checksums, cur.Checksums)
+			cur.Checksums = checksums
+		}
+		for i, sum := range record {
+			cur.Checksums[i] = sum
+			cur.ChecksumMask |= 1 << i
+		}
+		cur.Eversion++
+
+		data := utils.MustMarshal(cur)
+		err := etcd_utils.EtcdSetKVS(sm.client, []clientv3.Cmp{
+			clientv3.Compare(clientv3.Value(sm.leaderKey), "=", sm.memberValue),
+		}, []clientv3.Op{
+			clientv3.OpPut(formatExtentKey(cur.ExtentID), string(data)),
+		})
+		if err != nil {
+			return sums, corrupted, err
+		}
+		sm.extents.Set(cur.ExtentID, cur)
+	}
+
+	if corrupted == 0 {
+		return sums, 0, nil
+	}
+	if corrupted == cur.Avali {
+		xlog.Logger.Errorf("all copies of extent %d are corrupted", cur.ExtentID)
+		return sums, corrupted, errors.Errorf("all copies of extent %d are corrupted", cur.ExtentID)
+	}
+	//an extent has only one recovery task, other corrupted copies are replaced in later audits
+	slot := bits.TrailingZeros32(corrupted)
+	var nodeID uint64
+	if slot < len(cur.Replicates) {
+		nodeID = cur.Replicates[slot]
+	} else {
+		nodeID = cur.Parity[slot-len(cur.Replicates)]
+	}
+	xlog.Logger.Errorf("copy %d of extent %d on node %d is corrupted", slot, cur.ExtentID, nodeID)
+	if err := sm.dispatchRecoveryTask(cur, nodeID); err != nil {
+		return sums, corrupted, err
+	}
+	return sums, corrupted, nil
+}
+
+//pickExtents returns at most n extentIDs after cursor in ascending order, it wraps around to the
+//smallest one, the last picked extentID is the next cursor
+func pickExtents(extentIDs []uint64, cursor uint64, n int) ([]uint64, uint64) {
+	if len(extentIDs) == 0 {
+		return nil, 0
+	}
+	sort.Slice(extentIDs, func(i, j int) bool { return extentIDs[i] < extentIDs[j] })
+	start := sort.Search(len(extentIDs), func(i int) bool { return extentIDs[i] > cursor })
+	var ret []uint64
+	for i := 0; i < n && i < len(extentIDs); i++ {
+		ret = append(ret, extentIDs[(start+i)%len(extentIDs)])
+	}
+	return ret, ret[len(ret)-1]
+}
+
+func (sm *StreamManager) routineAuditExtents() {
+	ticker := utils.NewRandomTicker(time.Minute, 2*time.Minute)
+	defer func() {
+		ticker.Stop()
+		xlog.Logger.Infof("routineAuditExtents quit")
+	}()
+
+	xlog.Logger.Infof("routineAuditExtents started")
+	var recordCursor, auditCursor uint64
+	for {
+		select {
+		case <-sm.stopper.ShouldStop():
+			return
+		case <-ticker.C:
+			var unrecorded, recorded []uint64
+			for kv := range sm.extents.Iter() {
+				exInfo := kv.Value.(*pb.ExtentInfo)
+				if exInfo.Avali == 0 || exInfo.Refs == 0 || sm.taskPool.HasTask(exInfo.ExtentID) {
+					continue
+				}
+				if _, running := sm.converting.Load(exInfo.ExtentID); running {
+					continue
+				}
+				if exInfo.Avali&^exInfo.ChecksumMask != 0 {
+					unrecorded = append(unrecorded, exInfo.ExtentID)
+				} else {
+					recorded = append(recorded, exInfo.ExtentID)
+				}
+			}
+
+			//checksums of new sealed extents are recorded from rolling checksums on nodes
+			var picked []uint64
+			picked, recordCursor = pickExtents(unrecorded, recordCursor, auditExtentsPerRound)
+			for _, extentID := range picked {
+				sm.auditExtentByID(extentID, true)
+			}
+			picked, auditCursor = pickExtents(recorded, auditCursor, auditExtentsPerRound)
+			for _, extentID := range picked {
+				sm.auditExtentByID(extentID, false)
+			}
+		}
+	}
+}
+
+func (sm *StreamManager) auditExtentByID(extentID uint64, cached bool) {
+	exInfo, ok := sm.cloneExtentInfo(extentID)
+	if !ok || exInfo.Avali == 0 {
+		return
+	}
+	if _, _, err := sm.auditExtent(sm.stopper.Ctx(), exInfo, cached); err != nil {
+		xlog.Logger.Warnf("audit extent %d: %v", extentID, err)
+	}
+}
@@ -0,0 +1,47 @@
+package stream_manager
+
+import (
+	"testing"
+
+	"github.com/journeymidnight/autumn/proto/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckChecksums(t *testing.T) {
+	//replicated, no checksum is recorded, majority agree
+	exInfo := &pb.ExtentInfo{Replicates: []uint64{1, 2, 3}, Avali: 7}
+	corrupted, record := checkChecksums(exInfo, []int64{10, 11, 10})
+	require.Equal(t, uint32(2), corrupted)
+	require.Equal(t, map[int]uint32{0: 10, 2: 10}, record)
+
+	//no majority
+	corrupted, record = checkChecksums(exInfo, []int64{10, 11, -1})
+	require.Equal(t, uint32(0), corrupted)
+	require.Equal(t, 0, len(record))
+
+	//recorded checksum wins
+	exInfo.Checksums, exInfo.ChecksumMask = []uint32{0, 11, 0}, 2
+	corrupted, record = checkChecksums(exInfo, []int64{10, 11, 10})
+	require.Equal(t, uint32(5), corrupted)
+	require.Equal(t, 0, len(record))
+
+	//EC, every shard has its own checksum
+	exInfo = &pb.ExtentInfo{Replicates: []uint64{1, 2}, Parity: []uint64{3}, Avali: 7,
+		Checksums: []uint32{10, 0, 30}, ChecksumMask: 5}
+	corrupted, record = checkChecksums(exInfo, []int64{10, 20, 31})
+	require.Equal(t, uint32(4), corrupted)
+	require.Equal(t, map[int]uint32{1: 20}, record)
+}
+
+func TestPickExtents(t *testing.T) {
+	ids := []uint64{7, 3, 9, 1}
+	picked, cursor := pickExtents(ids, 0, 2)
+	require.Equal(t, []uint64{1, 3}, picked)
+	picked, cursor = pickExtents(ids, cursor, 3)
+	require.Equal(t, []uint64{7, 9, 1}, picked)
+	require.Equal(t, uint64(1), cursor)
+	picked, _ = pickExtents(ids, 100, 10)
+	require.Equal(t, []uint64{1, 3, 7, 9}, picked)
+	picked, _ = pickExtents(nil, 5, 10)
+	require.Nil(t, picked)
+}
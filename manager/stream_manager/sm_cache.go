@@ -0,0 +1,303 @@
+package stream_manager
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cornelk/hashmap"
+	"github.com/journeymidnight/autumn/proto/pb"
+	"github.com/journeymidnight/autumn/utils"
+	"github.com/journeymidnight/autumn/xlog"
+	"github.com/pkg/errors"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+/*
+followers keep a warm copy of streams, extents, nodes, disks and recovery tasks by watching etcd,
+so a new leader only waits the copy to catch up instead of loading everything from etcd.
+the leader changes the maps by itself after writing etcd, so the watch stops when it becomes leader
+*/
+
+//all prefixes of the cache are in [cacheStart, cacheEnd), other keys in the range are ignored
+const (
+	cacheStart = "disks/"
+	cacheEnd   = "streams0"
+)
+
+var (
+	cachePrefixes = []string{"streams/", "extents/", "nodes/", "recoveryTasks/", "disks/"}
+
+	catchUpTimeout = 10 * time.Second
+)
+
+//initCache allocates the maps once, they are read by grpc handlers without lock,
+//so they are never replaced afterwards
+func (sm *StreamManager) initCache() {
+	sm.streams = &hashmap.HashMap{}
+	sm.extents = &hashmap.HashMap{}
+	sm.extentsLocks = new(sync.Map)
+	sm.nodes = &hashmap.HashMap{}
+	sm.disks = &hashmap.HashMap{}
+	sm.taskPool = NewTaskPool()
+}
+
+//resetCache empties all maps in place
+func (sm *StreamManager) resetCache() {
+	for _, m := range []*hashmap.HashMap{sm.streams, sm.extents, sm.nodes, sm.disks} {
+		clearHashMap(m)
+	}
+	sm.extentsLocks.Range(func(k, _ interface{}) bool {
+		sm.extentsLocks.Delete(k)
+		return true
+	})
+	sm.taskPool.Reset()
+}
+
+func clearHashMap(m *hashmap.HashMap) {
+	for kv := range m.Iter() {
+		m.Del(kv.Key)
+	}
+}
+
+//loadCache loads everything from etcd into emptied maps, all prefixes are read at the same revision.
+//it returns the revision
+func (sm *StreamManager) loadCache(ctx context.Context) (int64, error) {
+	sm.resetCache()
+	atomic.StoreInt64(&sm.cacheRev, 0)
+	var rev int64
+	for _, prefix := range cachePrefixes {
+		opts := []clientv3.OpOption{clientv3.WithPrefix()}
+		if rev > 0 {
+			opts = append(opts, clientv3.WithRev(rev))
+		}
+		res, err := sm.client.Get(ctx, prefix, opts...)
+		if err != nil {
+			return 0, err
+		}
+		rev = res.Header.Revision
+		for _, kv := range res.Kvs {
+			if err = sm.putCache(string(kv.Key), kv.Value); err != nil {
+				return 0, err
+			}
+		}
+	}
+	atomic.StoreInt64(&sm.cacheRev, rev)
+	return rev, nil
+}
+
+func cachePrefix(key string) string {
+	for _, prefix := range cachePrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return prefix
+		}
+	}
+	return ""
+}
+
+//putCache applies a put of key, status of nodes and disks is kept
+func (sm *StreamManager) putCache(key string, value []byte) error {
+	prefix := cachePrefix(key)
+	if prefix == "" {
+		return nil
+	}
+	id, err := parseKey(key, strings.TrimSuffix(prefix, "/"))
+	if err != nil {
+		return err
+	}
+	switch prefix {
+	case "streams/":
+		var streamInfo pb.StreamInfo
+		if err = streamInfo.Unmarshal(value); err != nil {
+			return errors.Wrapf(err, "bad value of %s", key)
+		}
+		sm.streams.Set(id, &streamInfo)
+	case "extents/":
+		var extentInfo pb.ExtentInfo
+		if err = extentInfo.Unmarshal(value); err != nil {
+			return errors.Wrapf(err, "bad value of %s", key)
+		}
+		sm.extents.Set(id, &extentInfo)
+		sm.extentsLocks.LoadOrStore(id, new(sync.Mutex))
+	case "nodes/":
+		var nodeInfo pb.NodeInfo
+		if err = nodeInfo.Unmarshal(value); err != nil {
+			return errors.Wrapf(err, "bad value of %s", key)
+		}
+		ns := &NodeStatus{NodeInfo: nodeInfo}
+		if d, ok := sm.nodes.Get(id); ok {
+			old := d.(*NodeStatus)
+			ns.SetTotal(old.Total())
+			ns.SetFree(old.Free())
+			if old.Dead() {
+				ns.SetDead()
+			}
+		}
+		sm.nodes.Set(id, ns)
+	case "disks/":
+		var diskInfo pb.DiskInfo
+		if err = diskInfo.Unmarshal(value); err != nil {
+			return errors.Wrapf(err, "bad value of %s", key)
+		}
+		ds := &DiskStatus{DiskInfo: diskInfo}
+		if d, ok := sm.disks.Get(id); ok {
+			old := d.(*DiskStatus)
+			atomic.StoreUint64(&ds.total, old.Total())
+			atomic.StoreUint64(&ds.free, old.Free())
+		}
+		sm.disks.Set(id, ds)
+	case "recoveryTasks/":
+		var task pb.RecoveryTask
+		if err = task.Unmarshal(value); err != nil {
+			return errors.Wrapf(err, "bad value of %s", key)
+		}
+		//task could be moved to another node
+		sm.taskPool.Remove(id)
+		sm.taskPool.Insert(&task)
+	}
+	return nil
+}
+
+//deleteCache applies a delete of key
+func (sm *StreamManager) deleteCache(key string) error {
+	prefix := cachePrefix(key)
+	if prefix == "" {
+		return nil
+	}
+	id, err := parseKey(key, strings.TrimSuffix(prefix, "/"))
+	if err != nil {
+		return err
+	}
+	switch prefix {
+	case "streams/":
+		sm.streams.Del(id)
+	case "extents/":
+		sm.extents.Del(id)
+		sm.extentsLocks.Delete(id)
+	case "nodes/":
+		sm.nodes.Del(id)
+	case "disks/":
+		sm.disks.Del(id)
+	case "recoveryTasks/":
+		sm.taskPool.Remove(id)
+	}
+	return nil
+}
+
+func (sm *StreamManager) applyEvent(ev *clientv3.Event) error {
+	switch ev.Type {
+	case mvccpb.PUT:
+		return sm.putCache(string(ev.Kv.Key), ev.Kv.Value)
+	case mvccpb.DELETE:
+		return sm.deleteCache(string(ev.Kv.Key))
+	}
+	return nil
+}
+
+//startFollowing loads the cache and keeps it updated until sm.followStopper stops
+func (sm *StreamManager) startFollowing() {
+	stopper := utils.NewStopper()
+	sm.followStopper = stopper
+	stopper.RunWorker(func() {
+		var rev int64
+		var err error
+		for {
+			if rev, err = sm.loadCache(stopper.Ctx()); err == nil {
+				break
+			}
+			xlog.Logger.Warnf("load cache of stream manager: %v", err)
+			select {
+			case <-stopper.ShouldStop():
+				return
+			case <-time.After(time.Second):
+			}
+		}
+		sm.followEtcd(stopper, rev)
+	})
+}
+
+//followEtcd applies changes after rev to the cache, progress is saved in sm.cacheRev
+func (sm *StreamManager) followEtcd(stopper *utils.Stopper, rev int64) {
+	for {
+		compacted := false
+		rch := sm.client.Watch(stopper.Ctx(), cacheStart, clientv3.WithRange(cacheEnd),
+			clientv3.WithRev(rev+1), clientv3.WithProgressNotify())
+		for res := range rch {
+			if res.CompactRevision > 0 || res.Err() == rpctypes.ErrCompacted {
+				xlog.Logger.Warnf("cache of stream manager is compacted at %d, reload", res.CompactRevision)
+				compacted = true
+				break
+			}
+			if err := res.Err(); err != nil {
+				xlog.Logger.Warnf("watch cache of stream manager: %v", err)
+				break
+			}
+			for _, ev := range res.Events {
+				if err := sm.applyEvent(ev); err != nil {
+					xlog.Logger.Errorf(err.Error())
+				}
+				rev = ev.Kv.ModRevision
+			}
+			if res.IsProgressNotify() && res.Header.Revision > rev {
+				rev = res.Header.Revision
+			}
+			atomic.StoreInt64(&sm.cacheRev, rev)
+		}
+
+		select {
+		case <-stopper.ShouldStop():
+			return
+		case <-time.After(time.Second):
+		}
+		if !compacted {
+			continue
+		}
+		//changes are lost, load everything again
+		newRev, err := sm.loadCache(stopper.Ctx())
+		if err != nil {
+			xlog.Logger.Warnf("load cache of stream manager: %v", err)
+			continue
+		}
+		rev = newRev
+	}
+}
+
+//catchUp stops following etcd after the cache has every change before takeover.
+//if the cache can not catch up in time, everything is loaded from etcd
+func (sm *StreamManager) catchUp() error {
+	ctx, cancel := context.WithTimeout(context.Background(), catchUpTimeout)
+	defer cancel()
+
+	res, err := sm.client.Get(ctx, IdKey)
+	if err == nil {
+		target := res.Header.Revision
+		for atomic.LoadInt64(&sm.cacheRev) < target {
+			//no event after target, ask etcd for the progress of the watch
+			sm.client.RequestProgress(ctx)
+			select {
+			case <-ctx.Done():
+				err = ctx.Err()
+			case <-time.After(20 * time.Millisecond):
+				continue
+			}
+			break
+		}
+	}
+
+	if sm.followStopper != nil {
+		sm.followStopper.Stop()
+		sm.followStopper = nil
+	}
+	if err == nil {
+		return nil
+	}
+	xlog.Logger.Warnf("cache of stream manager is behind: %v, load from etcd", err)
+	loadCtx, loadCancel := context.WithTimeout(context.Background(), 5*catchUpTimeout)
+	defer loadCancel()
+	_, err = sm.loadCache(loadCtx)
+	return err
+}
@@ -0,0 +1,146 @@
+package stream_manager
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/journeymidnight/autumn/proto/pb"
+	"github.com/journeymidnight/autumn/utils"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+func putEvent(key string, value []byte) *clientv3.Event {
+	return &clientv3.Event{Type: mvccpb.PUT, Kv: &mvccpb.KeyValue{Key: []byte(key), Value: value}}
+}
+
+func deleteEvent(key string) *clientv3.Event {
+	return &clientv3.Event{Type: mvccpb.DELETE, Kv: &mvccpb.KeyValue{Key: []byte(key)}}
+}
+
+func TestApplyEvent(t *testing.T) {
+	sm := &StreamManager{}
+	sm.initCache()
+
+	events := []*clientv3.Event{
+		putEvent("streams/1", utils.MustMarshal(&pb.StreamInfo{StreamID: 1, ExtentIDs: []uint64{10}})),
+		putEvent("extents/10", utils.MustMarshal(&pb.ExtentInfo{ExtentID: 10, Replicates: []uint64{1, 2}})),
+		putEvent("nodes/1", utils.MustMarshal(&pb.NodeInfo{NodeID: 1, Address: "a"})),
+		putEvent("disks/11", utils.MustMarshal(&pb.DiskInfo{DiskID: 11, Online: true})),
+		putEvent("recoveryTasks/10", utils.MustMarshal(&pb.RecoveryTask{ExtentID: 10, ReplaceID: 2, NodeID: 1})),
+		//not in the cache
+		putEvent("replication/a/1", []byte("x")),
+	}
+	for _, ev := range events {
+		require.NoError(t, sm.applyEvent(ev))
+	}
+	require.Equal(t, 1, sm.streams.Len())
+	_, ok := sm.extentsLocks.Load(uint64(10))
+	require.True(t, ok)
+	require.Equal(t, 1, len(sm.taskPool.GetFromNode(1)))
+
+	//status of node is kept after its info changes
+	d, _ := sm.nodes.Get(uint64(1))
+	d.(*NodeStatus).SetFree(100)
+	require.NoError(t, sm.applyEvent(putEvent("nodes/1", utils.MustMarshal(&pb.NodeInfo{NodeID: 1, Address: "b"}))))
+	d, _ = sm.nodes.Get(uint64(1))
+	require.Equal(t, "b", d.(*NodeStatus).Address)
+	require.Equal(t, uint64(100), d.(*NodeStatus).Free())
+
+	//task moved to another node
+	require.NoError(t, sm.applyEvent(putEvent("recoveryTasks/10", utils.MustMarshal(&pb.RecoveryTask{ExtentID: 10, ReplaceID: 2, NodeID: 3}))))
+	require.Equal(t, 0, len(sm.taskPool.GetFromNode(1)))
+	require.Equal(t, 1, len(sm.taskPool.GetFromNode(3)))
+
+	for _, key := range []string{"streams/1", "extents/10", "nodes/1", "disks/11", "recoveryTasks/10"} {
+		require.NoError(t, sm.applyEvent(deleteEvent(key)))
+	}
+	require.Equal(t, 0, sm.streams.Len())
+	require.Equal(t, 0, sm.extents.Len())
+	require.Equal(t, 0, sm.nodes.Len())
+	require.Equal(t, 0, sm.disks.Len())
+	require.False(t, sm.taskPool.HasTask(10))
+	_, ok = sm.extentsLocks.Load(uint64(10))
+	require.False(t, ok)
+
+	require.Error(t, sm.applyEvent(putEvent("extents/x", nil)))
+}
+
+func TestFollowEtcd(t *testing.T) {
+	config, etcd, client, stop := startTestEtcd(t, "sm-cache", 22381, "127.0.0.1:23403")
+	defer stop()
+
+	put := func(key string, value []byte) {
+		_, err := client.Put(context.Background(), key, string(value))
+		require.Nil(t, err)
+	}
+	put(formatStreamKey(1), utils.MustMarshal(&pb.StreamInfo{StreamID: 1, ExtentIDs: []uint64{10}}))
+	put(formatExtentKey(10), utils.MustMarshal(&pb.ExtentInfo{ExtentID: 10, Replicates: []uint64{1}}))
+	put(formatNodeKey(1), utils.MustMarshal(&pb.NodeInfo{NodeID: 1, Address: "a"}))
+
+	sm := NewStreamManager(etcd, client, config)
+	//handlers read the maps while the cache is loaded
+	stopReader := make(chan struct{})
+	readerDone := make(chan struct{})
+	go func() {
+		defer close(readerDone)
+		for {
+			select {
+			case <-stopReader:
+				return
+			default:
+			}
+			sm.streams.Get(uint64(1))
+			sm.extentsLocks.Load(uint64(10))
+			sm.taskPool.HasTask(10)
+			time.Sleep(100 * time.Microsecond)
+		}
+	}()
+	defer func() {
+		close(stopReader)
+		<-readerDone
+	}()
+
+	sm.startFollowing()
+	require.Eventually(t, func() bool {
+		return sm.streams.Len() == 1 && sm.extents.Len() == 1 && sm.nodes.Len() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	//changes after loading come from the watch
+	d, _ := sm.nodes.Get(uint64(1))
+	d.(*NodeStatus).SetFree(100)
+	put(formatStreamKey(2), utils.MustMarshal(&pb.StreamInfo{StreamID: 2}))
+	put(formatNodeKey(1), utils.MustMarshal(&pb.NodeInfo{NodeID: 1, Address: "b"}))
+	_, err := client.Delete(context.Background(), formatExtentKey(10))
+	require.Nil(t, err)
+	require.Eventually(t, func() bool {
+		return sm.streams.Len() == 2 && sm.extents.Len() == 0
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Eventually(t, func() bool {
+		d, _ := sm.nodes.Get(uint64(1))
+		return d.(*NodeStatus).Address == "b"
+	}, 5*time.Second, 10*time.Millisecond)
+	d, _ = sm.nodes.Get(uint64(1))
+	require.Equal(t, uint64(100), d.(*NodeStatus).Free())
+
+	//everything before takeover is in the cache after catchUp, then the watch stops
+	put(formatStreamKey(3), utils.MustMarshal(&pb.StreamInfo{StreamID: 3}))
+	require.Nil(t, sm.catchUp())
+	require.Nil(t, sm.followStopper)
+	_, ok := sm.streams.Get(uint64(3))
+	require.True(t, ok)
+	put(formatStreamKey(4), utils.MustMarshal(&pb.StreamInfo{StreamID: 4}))
+	time.Sleep(100 * time.Millisecond)
+	_, ok = sm.streams.Get(uint64(4))
+	require.False(t, ok)
+
+	//reload keeps the same maps
+	streams := sm.streams
+	_, err = sm.loadCache(context.Background())
+	require.Nil(t, err)
+	require.True(t, streams == sm.streams)
+	require.Equal(t, 4, sm.streams.Len())
+	require.Equal(t, 0, sm.extents.Len())
+}
@@ -0,0 +1,246 @@
+package stream_manager
+
+import (
+	"context"
+	"time"
+
+	"github.com/journeymidnight/autumn/etcd_utils"
+	"github.com/journeymidnight/autumn/proto/pb"
+	"github.com/journeymidnight/autumn/utils"
+	"github.com/journeymidnight/autumn/wire_errors"
+	"github.com/journeymidnight/autumn/xlog"
+	"github.com/pkg/errors"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+/*
+node decommissioning:
+a decommissioning node gets no new extents. sealed extents on it are copied to other nodes by recovery
+tasks, the node itself is still a source of the copies. unsealed extents on it are sealed at the
+length of replicas first, a stream writing the extent fails its append and moves on to a new extent.
+when no extent is on the node, the node and its disks are removed from etcd
+*/
+
+const maxDecommissionTasks = 4 //recovery tasks per decommissioning node
+
+func (sm *StreamManager) DecommissionNode(ctx context.Context, req *pb.DecommissionNodeRequest) (*pb.DecommissionNodeResponse, error) {
+	errDone := func(err error) (*pb.DecommissionNodeResponse, error) {
+		code, desCode := wire_errors.ConvertToPBCode(err)
+		return &pb.DecommissionNodeResponse{
+			Code:    code,
+			CodeDes: desCode,
+		}, nil
+	}
+
+	if !sm.AmLeader() {
+		return errDone(wire_errors.NotLeader)
+	}
+
+	ns := sm.getNodeStatus(req.NodeID)
+	if ns == nil {
+		return errDone(errors.Errorf("no such node %d", req.NodeID))
+	}
+	nodeInfo := ns.NodeInfo //copy nodeInfo
+	if nodeInfo.Decommissioning == !req.Cancel {
+		return &pb.DecommissionNodeResponse{Code: pb.Code_OK}, nil
+	}
+	nodeInfo.Decommissioning = !req.Cancel
+
+	err := etcd_utils.EtcdSetKVS(sm.client, []clientv3.Cmp{
+		clientv3.Compare(clientv3.Value(sm.leaderKey), "=", sm.memberValue),
+	}, []clientv3.Op{
+		clientv3.OpPut(formatNodeKey(nodeInfo.NodeID), string(utils.MustMarshal(&nodeInfo))),
+	})
+	if err != nil {
+		return errDone(err)
+	}
+	sm.addNode(nodeInfo)
+	xlog.Logger.Infof("node %d decommissioning is %v", req.NodeID, nodeInfo.Decommissioning)
+
+	return &pb.DecommissionNodeResponse{
+		Code: pb.Code_OK,
+	}, nil
+}
+
+//decommissionState returns the progress of moving extents away from nodeID, sealed extents
+//on nodeID which have no recovery task, and unsealed extents on nodeID
+func decommissionState(nodeID uint64, extents []*pb.ExtentInfo, getTask func(uint64) *pb.RecoveryTask) (*pb.DecommissionProgress, []uint64, []uint64) {
+	progress := &pb.DecommissionProgress{NodeID: nodeID}
+	var candidates, unsealed []uint64
+	for _, exInfo := range extents {
+		if FindNodeIndex(exInfo, nodeID) == -1 {
+			continue
+		}
+		progress.Remaining++
+		task := getTask(exInfo.ExtentID)
+		switch {
+		case task != nil && task.ReplaceID == nodeID:
+			progress.Running++
+		case task != nil:
+			//another copy is being recovered
+		case exInfo.Refs == 0:
+			//removed by routineReclaimExtents
+		case exInfo.Avali == 0:
+			progress.Unsealed++
+			unsealed = append(unsealed, exInfo.ExtentID)
+		default:
+			candidates = append(candidates, exInfo.ExtentID)
+		}
+	}
+	return progress, candidates, unsealed
+}
+
+func (sm *StreamManager) allExtents() []*pb.ExtentInfo {
+	var ret []*pb.ExtentInfo
+	for kv := range sm.extents.Iter() {
+		ret = append(ret, kv.Value.(*pb.ExtentInfo))
+	}
+	return ret
+}
+
+func (sm *StreamManager) decommissionProgress() map[uint64]*pb.DecommissionProgress {
+	ret := make(map[uint64]*pb.DecommissionProgress)
+	var extents []*pb.ExtentInfo
+	for _, ns := range sm.getAllNodeStatus(false) {
+		if !ns.Decommissioning {
+			continue
+		}
+		if extents == nil {
+			extents = sm.allExtents()
+		}
+		ret[ns.NodeID], _, _ = decommissionState(ns.NodeID, extents, sm.taskPool.GetFromExtent)
+	}
+	return ret
+}
+
+func (sm *StreamManager) routineDecommission() {
+	ticker := utils.NewRandomTicker(10*time.Second, 20*time.Second)
+	defer func() {
+		ticker.Stop()
+		xlog.Logger.Infof("routineDecommission quit")
+	}()
+
+	xlog.Logger.Infof("routineDecommission started")
+	for {
+		select {
+		case <-sm.stopper.ShouldStop():
+			return
+		case <-ticker.C:
+			for _, ns := range sm.getAllNodeStatus(false) {
+				if ns.Decommissioning {
+					sm.decommission(ns)
+				}
+			}
+		}
+	}
+}
+
+func (sm *StreamManager) decommission(ns *NodeStatus) {
+	progress, candidates, unsealed := decommissionState(ns.NodeID, sm.allExtents(), sm.taskPool.GetFromExtent)
+	//copies to the node started before decommissioning
+	if progress.Remaining == 0 && len(sm.taskPool.GetFromNode(ns.NodeID)) == 0 {
+		if err := sm.removeNode(ns); err != nil {
+			xlog.Logger.Warnf("remove decommissioned node %d: %v", ns.NodeID, err)
+		}
+		return
+	}
+
+	//an idle stream never seals its tail extent
+	for _, extentID := range unsealed {
+		if err := sm.sealExtent(extentID); err != nil {
+			xlog.Logger.Warnf("seal extent %d on decommissioning node %d: %v", extentID, ns.NodeID, err)
+		}
+	}
+
+	running := int(progress.Running)
+	for _, extentID := range candidates {
+		if running >= maxDecommissionTasks {
+			return
+		}
+		if _, converting := sm.converting.Load(extentID); converting {
+			continue
+		}
+		if err := sm.lockExtent(extentID); err != nil {
+			continue
+		}
+		exInfo, ok := sm.cloneExtentInfo(extentID)
+		if ok && exInfo.Avali > 0 && FindNodeIndex(exInfo, ns.NodeID) >= 0 && !sm.taskPool.HasTask(extentID) {
+			if err := sm.dispatchRecoveryTask(exInfo, ns.NodeID); err != nil {
+				xlog.Logger.Warnf("move extent %d from decommissioning node %d: %v", extentID, ns.NodeID, err)
+			} else {
+				running++
+			}
+		}
+		sm.unlockExtent(extentID)
+	}
+}
+
+//sealExtent seals an unsealed extent at the commit length of its replicas. replicas are sealed
+//before the length is read, so no later append could succeed. if the lengths differ, an append
+//is in flight, the extent is left to the stream which fails the append and seals it by StreamAllocExtent
+func (sm *StreamManager) sealExtent(extentID uint64) error {
+	if err := sm.lockExtent(extentID); err != nil {
+		return err
+	}
+	defer sm.unlockExtent(extentID)
+
+	exInfo, ok := sm.cloneExtentInfo(extentID)
+	if !ok {
+		return errors.Errorf("no such extent %d", extentID)
+	}
+	if exInfo.Avali > 0 {
+		return nil
+	}
+	nodes := sm.getNodes(exInfo)
+	if nodes == nil {
+		return errors.Errorf("can not get nodes of extent %d", extentID)
+	}
+
+	sizes := sm.receiveCommitlength(context.Background(), nodes, extentID, 0, true)
+	for i := range sizes {
+		if sizes[i] == -1 || sizes[i] != sizes[0] {
+			return errors.Errorf("commit lengths of extent %d are %v", extentID, sizes)
+		}
+	}
+
+	exInfo.SealedLength = uint64(sizes[0])
+	exInfo.SealedTime = time.Now().Unix()
+	exInfo.Eversion++
+	exInfo.Avali = (1 << len(sizes)) - 1
+
+	err := etcd_utils.EtcdSetKVS(sm.client, []clientv3.Cmp{
+		clientv3.Compare(clientv3.Value(sm.leaderKey), "=", sm.memberValue),
+	}, []clientv3.Op{
+		clientv3.OpPut(formatExtentKey(extentID), string(utils.MustMarshal(exInfo))),
+	})
+	if err != nil {
+		return err
+	}
+	sm.extents.Set(extentID, exInfo)
+	xlog.Logger.Infof("extent %d is sealed at %d", extentID, exInfo.SealedLength)
+	return nil
+}
+
+//removeNode removes a decommissioned node and its disks, no extent is on it
+func (sm *StreamManager) removeNode(ns *NodeStatus) error {
+	nodeInfo := ns.NodeInfo //copy nodeInfo
+	nodeKey := formatNodeKey(nodeInfo.NodeID)
+	ops := []clientv3.Op{clientv3.OpDelete(nodeKey)}
+	for _, diskID := range nodeInfo.Disks {
+		ops = append(ops, clientv3.OpDelete(formatDiskKey(diskID)))
+	}
+	//node is not updated by others, such as cancelling decommission
+	err := etcd_utils.EtcdSetKVS(sm.client, []clientv3.Cmp{
+		clientv3.Compare(clientv3.Value(sm.leaderKey), "=", sm.memberValue),
+		clientv3.Compare(clientv3.Value(nodeKey), "=", string(utils.MustMarshal(&nodeInfo))),
+	}, ops)
+	if err != nil {
+		return err
+	}
+	sm.nodes.Del(nodeInfo.NodeID)
+	for _, diskID := range nodeInfo.Disks {
+		sm.disks.Del(diskID)
+	}
+	xlog.Logger.Infof("node %d is decommissioned and removed", nodeInfo.NodeID)
+	return nil
+}
@@ -0,0 +1,38 @@
+package stream_manager
+
+import (
+	"testing"
+
+	"github.com/journeymidnight/autumn/proto/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecommissionState(t *testing.T) {
+	extents := []*pb.ExtentInfo{
+		{ExtentID: 1, Replicates: []uint64{1, 2, 3}, Avali: 7, Refs: 1},
+		{ExtentID: 2, Replicates: []uint64{2, 3, 4}, Avali: 7, Refs: 1},
+		{ExtentID: 3, Replicates: []uint64{1, 2, 3}, Refs: 1},
+		{ExtentID: 4, Replicates: []uint64{2, 3}, Parity: []uint64{1}, Avali: 7, Refs: 1},
+		{ExtentID: 5, Replicates: []uint64{1, 2, 3}, Avali: 7, Refs: 1},
+		{ExtentID: 6, Replicates: []uint64{1, 2, 3}, Avali: 5, Refs: 1},
+		//to be reclaimed
+		{ExtentID: 7, Replicates: []uint64{1, 2, 3}, Avali: 7},
+	}
+	tasks := map[uint64]*pb.RecoveryTask{
+		5: {ExtentID: 5, ReplaceID: 1, NodeID: 4},
+		6: {ExtentID: 6, ReplaceID: 2, NodeID: 4},
+	}
+	getTask := func(extentID uint64) *pb.RecoveryTask {
+		return tasks[extentID]
+	}
+
+	progress, candidates, unsealed := decommissionState(1, extents, getTask)
+	require.Equal(t, &pb.DecommissionProgress{NodeID: 1, Remaining: 6, Running: 1, Unsealed: 1}, progress)
+	require.Equal(t, []uint64{1, 4}, candidates)
+	require.Equal(t, []uint64{3}, unsealed)
+
+	progress, candidates, unsealed = decommissionState(5, extents, getTask)
+	require.Equal(t, uint32(0), progress.Remaining)
+	require.Nil(t, candidates)
+	require.Nil(t, unsealed)
+}
@@ -0,0 +1,313 @@
+package stream_manager
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/journeymidnight/autumn/erasure_code"
+	"github.com/journeymidnight/autumn/etcd_utils"
+	"github.com/journeymidnight/autumn/proto/pb"
+	"github.com/journeymidnight/autumn/utils"
+	"github.com/journeymidnight/autumn/wire_errors"
+	"github.com/journeymidnight/autumn/xlog"
+	"github.com/pkg/errors"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+/*
+background EC conversion:
+if a stream has ECPolicy, its sealed replicated extents older than policy.MinAge are converted to
+policy.DataShard+policy.ParityShard. one node of replicates encodes the extent and writes fragments
+to new nodes, then ExtentInfo is updated, nodes of old replicates remove the extent when they
+receive the new ExtentInfo
+*/
+
+const (
+	maxConcurrentConversions = 2
+	convertTimeout           = 30 * time.Minute
+)
+
+func (sm *StreamManager) SetECPolicy(ctx context.Context, req *pb.SetECPolicyRequest) (*pb.SetECPolicyResponse, error) {
+	errDone := func(err error) (*pb.SetECPolicyResponse, error) {
+		code, desCode := wire_errors.ConvertToPBCode(err)
+		return &pb.SetECPolicyResponse{
+			Code:    code,
+			CodeDes: desCode,
+		}, nil
+	}
+
+	if !sm.AmLeader() {
+		return errDone(wire_errors.NotLeader)
+	}
+
+	if p := req.Policy; p != nil {
+		if p.DataShard < 2 || p.ParityShard < 1 || p.DataShard+p.ParityShard > 32 {
+			return errDone(errors.Errorf("invalid EC policy %d+%d", p.DataShard, p.ParityShard))
+		}
+		if p.MinAge < 0 {
+			return errDone(errors.New("minAge can not be negative"))
+		}
+		if err := erasure_code.ValidateCodec(p.Codec, int(p.DataShard), int(p.ParityShard)); err != nil {
+			return errDone(err)
+		}
+	}
+
+	streamInfo, ok := sm.cloneStreamInfo(req.StreamID)
+	if !ok {
+		return errDone(errors.Errorf("no such stream %d", req.StreamID))
+	}
+	streamInfo.EcPolicy = req.Policy
+
+	data := utils.MustMarshal(streamInfo)
+	err := etcd_utils.EtcdSetKVS(sm.client, []clientv3.Cmp{
+		clientv3.Compare(clientv3.Value(sm.leaderKey), "=", sm.memberValue),
+	}, []clientv3.Op{
+		clientv3.OpPut(formatStreamKey(req.StreamID), string(data)),
+	})
+	if err != nil {
+		return errDone(err)
+	}
+	sm.streams.Set(req.StreamID, streamInfo)
+
+	return &pb.SetECPolicyResponse{
+		Code: pb.Code_OK,
+	}, nil
+}
+
+func (sm *StreamManager) ECConversionStatus(ctx context.Context, req *pb.ECConversionStatusRequest) (*pb.ECConversionStatusResponse, error) {
+	errDone := func(err error) (*pb.ECConversionStatusResponse, error) {
+		code, desCode := wire_errors.ConvertToPBCode(err)
+		return &pb.ECConversionStatusResponse{
+			Code:    code,
+			CodeDes: desCode,
+		}, nil
+	}
+
+	if !sm.AmLeader() {
+		return errDone(wire_errors.NotLeader)
+	}
+
+	var streams []*pb.StreamInfo
+	if req.StreamID > 0 {
+		s, ok := sm.cloneStreamInfo(req.StreamID)
+		if !ok {
+			return errDone(errors.Errorf("no such stream %d", req.StreamID))
+		}
+		streams = append(streams, s)
+	} else {
+		for kv := range sm.streams.Iter() {
+			if s := kv.Value.(*pb.StreamInfo); s.EcPolicy != nil {
+				streams = append(streams, s)
+			}
+		}
+	}
+
+	var ret []*pb.ECConversionProgress
+	for _, s := range streams {
+		progress := &pb.ECConversionProgress{
+			StreamID: s.StreamID,
+			Policy:   s.EcPolicy,
+		}
+		for i, extentID := range s.ExtentIDs {
+			exInfo, ok := sm.cloneExtentInfo(extentID)
+			if !ok {
+				continue
+			}
+			_, running := sm.converting.Load(extentID)
+			switch {
+			case exInfo.FragmentSize > 0:
+				progress.Converted++
+				progress.ConvertedBytes += exInfo.SealedLength
+			case running:
+				progress.Running++
+			case i < len(s.ExtentIDs)-1 && exInfo.Avali > 0 && len(exInfo.Parity) == 0:
+				progress.Pending++
+				progress.PendingBytes += exInfo.SealedLength
+			}
+		}
+		if v, ok := sm.convertErrors.Load(s.StreamID); ok {
+			progress.LastError = v.(string)
+		}
+		ret = append(ret, progress)
+	}
+
+	return &pb.ECConversionStatusResponse{
+		Code:     pb.Code_OK,
+		Progress: ret,
+	}, nil
+}
+
+//conversionCandidates returns extents of s which could be converted at now, the last extent
+//is being written, so it is never converted
+func conversionCandidates(s *pb.StreamInfo, getExtent func(uint64) (*pb.ExtentInfo, bool), now int64) []*pb.ExtentInfo {
+	policy := s.EcPolicy
+	if policy == nil || len(s.ExtentIDs) == 0 {
+		return nil
+	}
+	var ret []*pb.ExtentInfo
+	for _, extentID := range s.ExtentIDs[:len(s.ExtentIDs)-1] {
+		exInfo, ok := getExtent(extentID)
+		if !ok || len(exInfo.Parity) > 0 || exInfo.FragmentSize > 0 || exInfo.SealedLength == 0 {
+			continue
+		}
+		//all replicates must be available
+		if exInfo.Avali != (1<<len(exInfo.Replicates))-1 {
+			continue
+		}
+		if now-exInfo.SealedTime < policy.MinAge {
+			continue
+		}
+		//no space is saved
+		if uint32(len(exInfo.Replicates))*policy.DataShard <= policy.DataShard+policy.ParityShard {
+			continue
+		}
+		ret = append(ret, exInfo)
+	}
+	return ret
+}
+
+func (sm *StreamManager) routineConvertEC() {
+	ticker := utils.NewRandomTicker(30*time.Second, 60*time.Second)
+	defer func() {
+		ticker.Stop()
+		xlog.Logger.Infof("routineConvertEC quit")
+	}()
+
+	xlog.Logger.Infof("routineConvertEC started")
+	for {
+		select {
+		case <-sm.stopper.ShouldStop():
+			return
+		case <-ticker.C:
+			sm.dispatchConversions(sm.stopper)
+		}
+	}
+}
+
+//dispatchConversions runs conversions as workers of stopper, they are canceled when sm loses
+//leadership
+func (sm *StreamManager) dispatchConversions(stopper *utils.Stopper) {
+	running := 0
+	sm.converting.Range(func(k, v interface{}) bool {
+		running++
+		return true
+	})
+
+	now := time.Now().Unix()
+	for kv := range sm.streams.Iter() {
+		s := kv.Value.(*pb.StreamInfo)
+		for _, exInfo := range conversionCandidates(s, sm.cloneExtentInfo, now) {
+			if running >= maxConcurrentConversions {
+				return
+			}
+			//extent is being recovered
+			if sm.taskPool.HasTask(exInfo.ExtentID) {
+				continue
+			}
+			if _, loaded := sm.converting.LoadOrStore(exInfo.ExtentID, s.StreamID); loaded {
+				continue
+			}
+			running++
+			streamID, exInfo, policy := s.StreamID, exInfo, s.EcPolicy
+			stopper.RunWorker(func() {
+				defer sm.converting.Delete(exInfo.ExtentID)
+				if err := sm.convertExtent(stopper.Ctx(), exInfo, policy); err != nil {
+					xlog.Logger.Warnf("convert extent %d to EC: %v", exInfo.ExtentID, err)
+					sm.convertErrors.Store(streamID, fmt.Sprintf("extent %d: %v", exInfo.ExtentID, err))
+				}
+			})
+		}
+	}
+}
+
+//convertExtent does not hold the lock of extent when converting, if extent is updated during
+//conversion, the result is dropped
+func (sm *StreamManager) convertExtent(ctx context.Context, exInfo *pb.ExtentInfo, policy *pb.ECPolicy) error {
+	dataShard := int(policy.DataShard)
+	parityShard := int(policy.ParityShard)
+
+	var converter *NodeStatus
+	for _, nodeID := range exInfo.Replicates {
+		if ns := sm.getNodeStatus(nodeID); ns != nil && ns.IsHealthy() {
+			converter = ns
+			break
+		}
+	}
+	if converter == nil {
+		return errors.New("no replicate is available")
+	}
+
+	//fragments are written to new nodes
+	var nodes []*NodeStatus
+	for _, ns := range sm.getAllNodeStatus(true) {
+		if FindNodeIndex(exInfo, ns.NodeID) == -1 {
+			nodes = append(nodes, ns)
+		}
+	}
+	targets, err := sm.policy.AllocExtent(nodes, dataShard+parityShard, nil)
+	if err != nil {
+		return err
+	}
+	addrs := make([]string, len(targets))
+	for i := range targets {
+		addrs[i] = targets[i].Address
+	}
+
+	cctx, cancel := context.WithTimeout(ctx, convertTimeout)
+	res, err := pb.NewExtentServiceClient(converter.GetConn()).ConvertExtent(cctx, &pb.ConvertExtentRequest{
+		ExtentID:    exInfo.ExtentID,
+		Eversion:    exInfo.Eversion,
+		DataShard:   uint32(dataShard),
+		ParityShard: uint32(parityShard),
+		Targets:     addrs,
+		Codec:       policy.Codec,
+	})
+	cancel()
+	if err != nil {
+		return err
+	}
+	if res.Code != pb.Code_OK {
+		return wire_errors.FromPBCode(res.Code, res.CodeDes)
+	}
+	if len(res.DiskIDs) != len(targets) {
+		return errors.Errorf("converter returns %d disks, expected %d", len(res.DiskIDs), len(targets))
+	}
+
+	if err = sm.lockExtent(exInfo.ExtentID); err != nil {
+		return err
+	}
+	defer sm.unlockExtent(exInfo.ExtentID)
+	cur, ok := sm.cloneExtentInfo(exInfo.ExtentID)
+	if !ok || cur.Eversion != exInfo.Eversion {
+		return errors.Errorf("extent %d is updated during conversion", exInfo.ExtentID)
+	}
+
+	nodeIDs := extractNodeId(targets)
+	cur.Replicates = nodeIDs[:dataShard]
+	cur.Parity = nodeIDs[dataShard:]
+	cur.ReplicateDisks = res.DiskIDs[:dataShard]
+	cur.ParityDisk = res.DiskIDs[dataShard:]
+	cur.FragmentSize = res.FragmentSize
+	cur.Codec = policy.Codec
+	cur.Checksums, cur.ChecksumMask = nil, 0
+	if len(res.Checksums) == len(nodeIDs) {
+		cur.Checksums = res.Checksums
+		cur.ChecksumMask = (1 << len(nodeIDs)) - 1
+	}
+	cur.Avali = (1 << len(nodeIDs)) - 1
+	cur.Eversion++
+
+	data := utils.MustMarshal(cur)
+	err = etcd_utils.EtcdSetKVS(sm.client, []clientv3.Cmp{
+		clientv3.Compare(clientv3.Value(sm.leaderKey), "=", sm.memberValue),
+	}, []clientv3.Op{
+		clientv3.OpPut(formatExtentKey(cur.ExtentID), string(data)),
+	})
+	if err != nil {
+		return err
+	}
+	sm.extents.Set(cur.ExtentID, cur)
+	xlog.Logger.Infof("extent %d is converted to %d+%d on nodes %v", cur.ExtentID, dataShard, parityShard, nodeIDs)
+	return nil
+}
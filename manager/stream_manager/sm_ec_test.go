@@ -0,0 +1,45 @@
+package stream_manager
+
+import (
+	"testing"
+
+	"github.com/journeymidnight/autumn/proto/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConversionCandidates(t *testing.T) {
+	extents := map[uint64]*pb.ExtentInfo{
+		//ok
+		1: {ExtentID: 1, Replicates: []uint64{1, 2, 3}, Avali: 7, SealedLength: 100, SealedTime: 100},
+		//too young
+		2: {ExtentID: 2, Replicates: []uint64{1, 2, 3}, Avali: 7, SealedLength: 100, SealedTime: 950},
+		//one replicate is not available
+		3: {ExtentID: 3, Replicates: []uint64{1, 2, 3}, Avali: 5, SealedLength: 100, SealedTime: 100},
+		//already EC
+		4: {ExtentID: 4, Replicates: []uint64{1, 2}, Parity: []uint64{3}, Avali: 7, SealedLength: 100, SealedTime: 100},
+		//already converted
+		5: {ExtentID: 5, Replicates: []uint64{1, 2, 3, 4}, Parity: []uint64{5, 6}, Avali: 63, SealedLength: 100, FragmentSize: 25, SealedTime: 100},
+		//last extent
+		6: {ExtentID: 6, Replicates: []uint64{1, 2, 3}, Avali: 7, SealedLength: 100, SealedTime: 100},
+	}
+	getExtent := func(id uint64) (*pb.ExtentInfo, bool) {
+		ex, ok := extents[id]
+		return ex, ok
+	}
+	s := &pb.StreamInfo{
+		StreamID:  10,
+		ExtentIDs: []uint64{1, 2, 3, 4, 5, 6},
+		EcPolicy:  &pb.ECPolicy{DataShard: 4, ParityShard: 2, MinAge: 100},
+	}
+
+	ret := conversionCandidates(s, getExtent, 1000)
+	require.Equal(t, 1, len(ret))
+	require.Equal(t, uint64(1), ret[0].ExtentID)
+
+	//1*4 <= 4+2, no space is saved for single replicate
+	extents[1].Replicates, extents[1].Avali = []uint64{1}, 1
+	require.Equal(t, 0, len(conversionCandidates(s, getExtent, 1000)))
+
+	s.EcPolicy = nil
+	require.Equal(t, 0, len(conversionCandidates(s, getExtent, 1000)))
+}
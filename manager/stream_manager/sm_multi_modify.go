@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/journeymidnight/autumn/etcd_utils"
 	"github.com/journeymidnight/autumn/proto/pb"
@@ -22,7 +23,9 @@ func (sm *StreamManager) duplicateStream(ops *[]clientv3.Op, srcStreamID uint64,
 		return nil, nil, errors.Errorf("stream %d no exist", srcStreamID)
 	}
 	newStreamInfo := pb.StreamInfo{
-		StreamID: destStreamID,
+		StreamID:    destStreamID,
+		Replication: streamInfo.Replication,
+		Codec:       streamInfo.Codec,
 	}
 
 	unlockExtents := func () {
@@ -58,6 +61,7 @@ func (sm *StreamManager) duplicateStream(ops *[]clientv3.Op, srcStreamID uint64,
 			exInfo.Eversion++
 			exInfo.Refs++
 			exInfo.SealedLength = uint64(sealedLength)
+			exInfo.SealedTime = time.Now().Unix()
 			n := len(exInfo.Replicates) + len(exInfo.Parity)
 			exInfo.Avali = (1 << n) - 1
 		} else {
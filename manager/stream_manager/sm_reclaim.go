@@ -0,0 +1,213 @@
+package stream_manager
+
+import (
+	"context"
+	"time"
+
+	"github.com/journeymidnight/autumn/etcd_utils"
+	"github.com/journeymidnight/autumn/proto/pb"
+	"github.com/journeymidnight/autumn/utils"
+	"github.com/journeymidnight/autumn/wire_errors"
+	"github.com/journeymidnight/autumn/xlog"
+	"github.com/pkg/errors"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+/*
+extent reclamation:
+ExtentInfo.Refs is the number of streams having the extent. CreateStream and StreamAllocExtent set it
+to 1, MultiModifySplit and snapshots increase it, PunchHoles, Truncate, DeleteStream and DeleteSnapshot
+decrease it. when refs reaches 0, DeletedTime is set, readers could still read the extent in a grace
+period. after that, routineReclaimExtents removes the extent from etcd, then from all nodes
+*/
+
+const (
+	extentGracePeriod = 30 * time.Minute
+	reclaimTimeout    = 10 * time.Second
+)
+
+//releaseExtent decreases refs of exInfo, it is called with the lock of extent
+func releaseExtent(exInfo *pb.ExtentInfo, now int64) {
+	if exInfo.Refs > 0 {
+		exInfo.Refs--
+	}
+	if exInfo.Refs == 0 {
+		exInfo.DeletedTime = now
+	}
+	exInfo.Eversion++
+}
+
+func (sm *StreamManager) DeleteStream(ctx context.Context, req *pb.DeleteStreamRequest) (*pb.DeleteStreamResponse, error) {
+	errDone := func(err error) (*pb.DeleteStreamResponse, error) {
+		code, desCode := wire_errors.ConvertToPBCode(err)
+		return &pb.DeleteStreamResponse{
+			Code:    code,
+			CodeDes: desCode,
+		}, nil
+	}
+
+	if !sm.AmLeader() {
+		return errDone(wire_errors.NotLeader)
+	}
+
+	streamInfo, ok := sm.cloneStreamInfo(req.StreamID)
+	if !ok {
+		return errDone(errors.Errorf("stream %d do not exist", req.StreamID))
+	}
+
+	var locked []uint64
+	defer func() {
+		for _, extentID := range locked {
+			sm.unlockExtent(extentID)
+		}
+	}()
+
+	now := time.Now().Unix()
+	ops := []clientv3.Op{clientv3.OpDelete(formatStreamKey(req.StreamID))}
+	var updated []*pb.ExtentInfo
+	for _, extentID := range streamInfo.ExtentIDs {
+		if err := sm.lockExtent(extentID); err != nil {
+			xlog.Logger.Warnf("delete stream %d: extent %d do not exist", req.StreamID, extentID)
+			continue
+		}
+		locked = append(locked, extentID)
+		exInfo, ok := sm.cloneExtentInfo(extentID)
+		if !ok {
+			continue
+		}
+		releaseExtent(exInfo, now)
+		ops = append(ops, clientv3.OpPut(formatExtentKey(extentID), string(utils.MustMarshal(exInfo))))
+		updated = append(updated, exInfo)
+	}
+
+	cmps := []clientv3.Cmp{
+		clientv3.Compare(clientv3.Value(sm.leaderKey), "=", sm.memberValue),
+	}
+	if len(req.OwnerKey) > 0 {
+		cmps = append(cmps, clientv3.Compare(clientv3.CreateRevision(req.OwnerKey), "=", req.Revision))
+	}
+	if err := etcd_utils.EtcdSetKVS(sm.client, cmps, ops); err != nil {
+		return errDone(err)
+	}
+
+	for _, exInfo := range updated {
+		sm.extents.Set(exInfo.ExtentID, exInfo)
+	}
+	sm.streams.Del(req.StreamID)
+	xlog.Logger.Infof("stream %d is deleted", req.StreamID)
+
+	return &pb.DeleteStreamResponse{
+		Code: pb.Code_OK,
+	}, nil
+}
+
+//reclaimable returns true if exInfo is not referenced and its grace period is over
+func reclaimable(exInfo *pb.ExtentInfo, referenced map[uint64]bool, now int64) bool {
+	return exInfo.Refs == 0 && !referenced[exInfo.ExtentID] &&
+		now-exInfo.DeletedTime >= int64(extentGracePeriod/time.Second)
+}
+
+func (sm *StreamManager) routineReclaimExtents() {
+	ticker := utils.NewRandomTicker(time.Minute, 2*time.Minute)
+	defer func() {
+		ticker.Stop()
+		xlog.Logger.Infof("routineReclaimExtents quit")
+	}()
+
+	xlog.Logger.Infof("routineReclaimExtents started")
+	for {
+		select {
+		case <-sm.stopper.ShouldStop():
+			return
+		case <-ticker.C:
+			//refs is not trusted if a stream still has the extent
+			referenced := make(map[uint64]bool)
+			for kv := range sm.streams.Iter() {
+				for _, extentID := range kv.Value.(*pb.StreamInfo).ExtentIDs {
+					referenced[extentID] = true
+				}
+			}
+			now := time.Now().Unix()
+			for kv := range sm.extents.Iter() {
+				exInfo := kv.Value.(*pb.ExtentInfo)
+				if !reclaimable(exInfo, referenced, now) {
+					if exInfo.Refs == 0 && referenced[exInfo.ExtentID] {
+						xlog.Logger.Errorf("extent %d has no refs, but it is in a stream", exInfo.ExtentID)
+					}
+					continue
+				}
+				if sm.taskPool.HasTask(exInfo.ExtentID) {
+					continue
+				}
+				if _, running := sm.converting.Load(exInfo.ExtentID); running {
+					continue
+				}
+				if err := sm.reclaimExtent(exInfo); err != nil {
+					xlog.Logger.Warnf("reclaim extent %d: %v", exInfo.ExtentID, err)
+				}
+			}
+		}
+	}
+}
+
+//reclaimExtent removes exInfo from etcd, then from nodes. copies on nodes which can not be reached
+//are left to be removed as orphans by reconciliation
+func (sm *StreamManager) reclaimExtent(exInfo *pb.ExtentInfo) error {
+	if err := sm.lockExtent(exInfo.ExtentID); err != nil {
+		return nil
+	}
+	cur, ok := sm.cloneExtentInfo(exInfo.ExtentID)
+	if !ok {
+		sm.unlockExtent(exInfo.ExtentID)
+		return nil
+	}
+	if cur.Refs > 0 {
+		sm.unlockExtent(exInfo.ExtentID)
+		return errors.Errorf("extent %d is referenced again", exInfo.ExtentID)
+	}
+	err := etcd_utils.EtcdSetKVS(sm.client, []clientv3.Cmp{
+		clientv3.Compare(clientv3.Value(sm.leaderKey), "=", sm.memberValue),
+	}, []clientv3.Op{
+		clientv3.OpDelete(formatExtentKey(exInfo.ExtentID)),
+	})
+	if err != nil {
+		sm.unlockExtent(exInfo.ExtentID)
+		return err
+	}
+	sm.extents.Del(exInfo.ExtentID)
+	//waiters find the extent is deleted
+	sm.unlockExtent(exInfo.ExtentID)
+	sm.extentsLocks.Delete(exInfo.ExtentID)
+	xlog.Logger.Infof("extent %d is reclaimed", exInfo.ExtentID)
+
+	//nodes having been removed are skipped
+	for _, nodeID := range append(append([]uint64{}, cur.Replicates...), cur.Parity...) {
+		ns := sm.getNodeStatus(nodeID)
+		if ns == nil {
+			continue
+		}
+		if err := sm.deleteExtentOnNode(ns, exInfo.ExtentID); err != nil {
+			xlog.Logger.Warnf("delete extent %d on node %d: %v, left to reconciliation", exInfo.ExtentID, nodeID, err)
+		}
+	}
+	return nil
+}
+
+func (sm *StreamManager) deleteExtentOnNode(ns *NodeStatus, extentID uint64) error {
+	conn := ns.GetConn()
+	if conn == nil {
+		return errors.Errorf("can not connect node %d", ns.NodeID)
+	}
+	ctx, cancel := context.WithTimeout(sm.stopper.Ctx(), reclaimTimeout)
+	defer cancel()
+	res, err := pb.NewExtentServiceClient(conn).DeleteExtent(ctx, &pb.DeleteExtentRequest{
+		ExtentID: extentID,
+	})
+	if err != nil {
+		return err
+	}
+	if res.Code != pb.Code_OK {
+		return wire_errors.FromPBCode(res.Code, res.CodeDes)
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package stream_manager
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/journeymidnight/autumn/etcd_utils"
+	"github.com/journeymidnight/autumn/manager"
+	"github.com/journeymidnight/autumn/proto/pb"
+	"github.com/journeymidnight/autumn/utils"
+	"github.com/journeymidnight/autumn/xlog"
+	"github.com/stretchr/testify/require"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/server/v3/embed"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc"
+)
+
+func TestReclaimable(t *testing.T) {
+	now := time.Now().Unix()
+	exInfo := &pb.ExtentInfo{ExtentID: 1, Refs: 2, Eversion: 1}
+
+	releaseExtent(exInfo, now)
+	require.Equal(t, uint64(1), exInfo.Refs)
+	require.Equal(t, int64(0), exInfo.DeletedTime)
+	require.False(t, reclaimable(exInfo, nil, now))
+
+	releaseExtent(exInfo, now)
+	require.Equal(t, uint64(0), exInfo.Refs)
+	require.Equal(t, now, exInfo.DeletedTime)
+	require.Equal(t, uint64(3), exInfo.Eversion)
+
+	//in grace period
+	require.False(t, reclaimable(exInfo, nil, now+1))
+	later := now + int64(extentGracePeriod/time.Second)
+	require.True(t, reclaimable(exInfo, nil, later))
+	//still in a stream
+	require.False(t, reclaimable(exInfo, map[uint64]bool{1: true}, later))
+}
+
+type fakeExtentNode struct {
+	pb.UnimplementedExtentServiceServer
+	deleted chan uint64
+}
+
+func (n *fakeExtentNode) DeleteExtent(ctx context.Context, req *pb.DeleteExtentRequest) (*pb.DeleteExtentResponse, error) {
+	n.deleted <- req.ExtentID
+	return &pb.DeleteExtentResponse{Code: pb.Code_OK}, nil
+}
+
+func init() {
+	xlog.InitLog([]string{"sm.log"}, zapcore.DebugLevel)
+}
+
+//startTestEtcd serves an embedded etcd for a stream manager on 127.0.0.1:port and port+1,
+//ports must not conflict with node_test.go and other tests in this package
+func startTestEtcd(t *testing.T, name string, port int, grpcUrl string) (*manager.Config, *embed.Etcd, *clientv3.Client, func()) {
+	dir, err := ioutil.TempDir(os.TempDir(), name)
+	require.Nil(t, err)
+
+	clientUrl := fmt.Sprintf("http://127.0.0.1:%d", port)
+	peerUrl := fmt.Sprintf("http://127.0.0.1:%d", port+1)
+	config := &manager.Config{
+		Name:                name,
+		Dir:                 dir,
+		ClientUrls:          clientUrl,
+		PeerUrls:            peerUrl,
+		AdvertisePeerUrls:   peerUrl,
+		AdvertiseClientUrls: clientUrl,
+		InitialCluster:      name + "=" + peerUrl,
+		InitialClusterState: "new",
+		ClusterToken:        name,
+		GrpcUrl:             grpcUrl,
+		MaxTxnOps:           3000,
+	}
+	cfg, err := config.GetEmbedConfig()
+	require.Nil(t, err)
+	etcd, client, err := etcd_utils.ServeETCD(cfg)
+	require.Nil(t, err)
+	return config, etcd, client, func() {
+		client.Close()
+		etcd.Close()
+		os.RemoveAll(dir)
+		os.Remove("sm.log")
+	}
+}
+
+func TestDeleteStreamReclaim(t *testing.T) {
+	config, etcd, client, stop := startTestEtcd(t, "sm-reclaim", 22379, "127.0.0.1:23400")
+	defer stop()
+
+	//node 1 is alive, nothing listens on the address of node 2
+	node := &fakeExtentNode{deleted: make(chan uint64, 1)}
+	listener, err := net.Listen("tcp", "127.0.0.1:23401")
+	require.Nil(t, err)
+	server := grpc.NewServer()
+	pb.RegisterExtentServiceServer(server, node)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	kvs := map[string][]byte{
+		formatNodeKey(1):   utils.MustMarshal(&pb.NodeInfo{NodeID: 1, Address: "127.0.0.1:23401"}),
+		formatNodeKey(2):   utils.MustMarshal(&pb.NodeInfo{NodeID: 2, Address: "127.0.0.1:23402"}),
+		formatExtentKey(3): utils.MustMarshal(&pb.ExtentInfo{ExtentID: 3, Replicates: []uint64{1, 2}, Refs: 1, Eversion: 1}),
+		formatStreamKey(4): utils.MustMarshal(&pb.StreamInfo{StreamID: 4, ExtentIDs: []uint64{3}}),
+	}
+	sm := NewStreamManager(etcd, client, config)
+	kvs["leader"] = []byte(sm.memberValue)
+	for k, v := range kvs {
+		_, err = client.Put(context.Background(), k, string(v))
+		require.Nil(t, err)
+	}
+	_, err = sm.loadCache(context.Background())
+	require.Nil(t, err)
+	sm.leaderKey = "leader"
+	atomic.StoreInt32(&sm.isLeader, 1)
+
+	res, err := sm.DeleteStream(context.Background(), &pb.DeleteStreamRequest{StreamID: 4})
+	require.Nil(t, err)
+	require.Equal(t, pb.Code_OK, res.Code, res.CodeDes)
+	exInfo, ok := sm.cloneExtentInfo(3)
+	require.True(t, ok)
+	require.Equal(t, uint64(0), exInfo.Refs)
+
+	//the copy on node 2 is left to reconciliation
+	require.Nil(t, sm.reclaimExtent(exInfo))
+	require.Equal(t, uint64(3), <-node.deleted)
+	_, ok = sm.cloneExtentInfo(3)
+	require.False(t, ok)
+	kv, err := client.Get(context.Background(), formatExtentKey(3))
+	require.Nil(t, err)
+	require.Equal(t, 0, len(kv.Kvs))
+}
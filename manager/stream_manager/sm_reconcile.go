@@ -0,0 +1,162 @@
+package stream_manager
+
+import (
+	"context"
+	"time"
+
+	"github.com/journeymidnight/autumn/proto/pb"
+	"github.com/journeymidnight/autumn/wire_errors"
+	"github.com/journeymidnight/autumn/xlog"
+	"github.com/pkg/errors"
+)
+
+/*
+orphan-extent reconciliation:
+nodes report extents and copy files they hold periodically. sm answers orphans (extents not known
+or not placed on the node), missing extents (placed on the node but not reported, recovery is
+dispatched), mismatched extents (seal state or length is different) and stale copies (no recovery
+task). files modified in orphanGracePeriod are never orphans, they could be extents being allocated
+or copies being written. nodes move orphans to quarantine before unlinking them
+*/
+
+const (
+	orphanGracePeriod      = time.Hour
+	maxReconcileRecoveries = 8 //recovery tasks dispatched for one report
+)
+
+//SealedFileLength returns the length of the sealed extent file on nodes, a fragment of an EC
+//extent is shorter than the extent
+func SealedFileLength(exInfo *pb.ExtentInfo) uint64 {
+	if exInfo.FragmentSize > 0 {
+		return exInfo.FragmentSize
+	}
+	return exInfo.SealedLength
+}
+
+//checkInventory compares the inventory of a node with extents, it returns the answer to node
+//and extents whose copies on node should be recovered
+func checkInventory(req *pb.InventoryRequest, extents []*pb.ExtentInfo, getTask func(uint64) *pb.RecoveryTask,
+	diskOnline func(uint64) bool, now int64) (*pb.InventoryResponse, []uint64) {
+
+	res := &pb.InventoryResponse{Code: pb.Code_OK}
+	var recover []uint64
+	grace := int64(orphanGracePeriod / time.Second)
+
+	known := make(map[uint64]*pb.ExtentInfo, len(extents))
+	for _, exInfo := range extents {
+		known[exInfo.ExtentID] = exInfo
+	}
+	reported := make(map[uint64]bool, len(req.Extents))
+	for _, r := range req.Extents {
+		reported[r.ExtentID] = true
+		exInfo, ok := known[r.ExtentID]
+		slot := -1
+		if ok {
+			slot = FindNodeIndex(exInfo, req.NodeID)
+		}
+		if slot == -1 {
+			//copy is done, but ExtentInfo is not updated
+			if task := getTask(r.ExtentID); task != nil && task.NodeID == req.NodeID {
+				continue
+			}
+			if now-r.ModTime >= grace {
+				res.Orphans = append(res.Orphans, r.ExtentID)
+			}
+			continue
+		}
+		//unavailable copy is checked by routineDispatchTask
+		if exInfo.Avali&(1<<slot) == 0 {
+			continue
+		}
+		if !r.Sealed {
+			res.Mismatched = append(res.Mismatched, r.ExtentID)
+		} else if uint64(r.Length) != SealedFileLength(exInfo) {
+			res.Mismatched = append(res.Mismatched, r.ExtentID)
+			recover = append(recover, r.ExtentID)
+		}
+	}
+
+	copying := make(map[uint64]bool)
+	for _, c := range req.Copies {
+		copying[c.ExtentID] = true
+		if task := getTask(c.ExtentID); task != nil && task.NodeID == req.NodeID && task.ReplaceID == c.ReplaceID {
+			continue
+		}
+		if now-c.ModTime >= grace {
+			res.StaleCopies = append(res.StaleCopies, c)
+		}
+	}
+
+	for _, exInfo := range extents {
+		slot := FindNodeIndex(exInfo, req.NodeID)
+		if slot == -1 || reported[exInfo.ExtentID] || copying[exInfo.ExtentID] || exInfo.Refs == 0 {
+			continue
+		}
+		if getTask(exInfo.ExtentID) != nil {
+			continue
+		}
+		//offline disk is checked by routineDispatchTask
+		var diskID uint64
+		if slot < len(exInfo.Replicates) {
+			if slot < len(exInfo.ReplicateDisks) {
+				diskID = exInfo.ReplicateDisks[slot]
+			}
+		} else if slot-len(exInfo.Replicates) < len(exInfo.ParityDisk) {
+			diskID = exInfo.ParityDisk[slot-len(exInfo.Replicates)]
+		}
+		if !diskOnline(diskID) {
+			continue
+		}
+		res.Missing = append(res.Missing, exInfo.ExtentID)
+		if exInfo.Avali > 0 {
+			recover = append(recover, exInfo.ExtentID)
+		}
+	}
+	return res, recover
+}
+
+func (sm *StreamManager) ReportInventory(ctx context.Context, req *pb.InventoryRequest) (*pb.InventoryResponse, error) {
+	errDone := func(err error) (*pb.InventoryResponse, error) {
+		code, desCode := wire_errors.ConvertToPBCode(err)
+		return &pb.InventoryResponse{
+			Code:    code,
+			CodeDes: desCode,
+		}, nil
+	}
+
+	if !sm.AmLeader() {
+		return errDone(wire_errors.NotLeader)
+	}
+	if sm.getNodeStatus(req.NodeID) == nil {
+		return errDone(errors.Errorf("no such node %d", req.NodeID))
+	}
+
+	diskOnline := func(diskID uint64) bool {
+		ds := sm.getDiskStatus(diskID)
+		return ds != nil && ds.Online
+	}
+	res, recover := checkInventory(req, sm.allExtents(), sm.taskPool.GetFromExtent, diskOnline, time.Now().Unix())
+	xlog.Logger.Infof("inventory of node %d: %d extents, %d copies, %d orphans, %d missing, %d mismatched, %d stale copies",
+		req.NodeID, len(req.Extents), len(req.Copies), len(res.Orphans), len(res.Missing), len(res.Mismatched), len(res.StaleCopies))
+	if req.DryRun {
+		return res, nil
+	}
+
+	//other copies are recovered in later reports
+	if len(recover) > maxReconcileRecoveries {
+		recover = recover[:maxReconcileRecoveries]
+	}
+	for _, extentID := range recover {
+		if err := sm.lockExtent(extentID); err != nil {
+			continue
+		}
+		exInfo, ok := sm.cloneExtentInfo(extentID)
+		if ok && exInfo.Avali > 0 && FindNodeIndex(exInfo, req.NodeID) >= 0 && !sm.taskPool.HasTask(extentID) {
+			if err := sm.dispatchRecoveryTask(exInfo, req.NodeID); err != nil {
+				xlog.Logger.Warnf("recover extent %d on node %d: %v", extentID, req.NodeID, err)
+			}
+		}
+		sm.unlockExtent(extentID)
+	}
+	return res, nil
+}
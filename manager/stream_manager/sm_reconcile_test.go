@@ -0,0 +1,64 @@
+package stream_manager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/journeymidnight/autumn/proto/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckInventory(t *testing.T) {
+	now := time.Now().Unix()
+	old := now - int64(2*orphanGracePeriod/time.Second)
+	extents := []*pb.ExtentInfo{
+		{ExtentID: 1, Replicates: []uint64{1, 2, 3}, ReplicateDisks: []uint64{11, 21, 31}, Avali: 7, Refs: 1, SealedLength: 100},
+		{ExtentID: 2, Replicates: []uint64{1, 2, 3}, ReplicateDisks: []uint64{11, 21, 31}, Avali: 7, Refs: 1, SealedLength: 100},
+		{ExtentID: 3, Replicates: []uint64{1, 2, 3}, ReplicateDisks: []uint64{12, 21, 31}, Avali: 7, Refs: 1, SealedLength: 100},
+		{ExtentID: 4, Replicates: []uint64{2, 3}, ReplicateDisks: []uint64{21, 31}, Parity: []uint64{1}, ParityDisk: []uint64{11}, Avali: 7, Refs: 1, FragmentSize: 50},
+		{ExtentID: 5, Replicates: []uint64{1, 2, 3}, ReplicateDisks: []uint64{11, 21, 31}, Refs: 1},
+		{ExtentID: 6, Replicates: []uint64{2, 3, 4}, ReplicateDisks: []uint64{21, 31, 41}, Avali: 7, Refs: 1},
+		{ExtentID: 7, Replicates: []uint64{1, 2, 3}, ReplicateDisks: []uint64{11, 21, 31}, Avali: 7, Refs: 1, SealedLength: 100},
+	}
+	tasks := map[uint64]*pb.RecoveryTask{
+		6: {ExtentID: 6, ReplaceID: 4, NodeID: 1},
+		7: {ExtentID: 7, ReplaceID: 2, NodeID: 1},
+	}
+	getTask := func(extentID uint64) *pb.RecoveryTask {
+		return tasks[extentID]
+	}
+	diskOnline := func(diskID uint64) bool {
+		return diskID != 12
+	}
+
+	req := &pb.InventoryRequest{
+		NodeID: 1,
+		Extents: []*pb.ExtentReport{
+			{ExtentID: 1, DiskID: 11, Sealed: true, Length: 100, ModTime: old},
+			//wrong length
+			{ExtentID: 4, DiskID: 11, Sealed: true, Length: 40, ModTime: old},
+			//not sealed
+			{ExtentID: 5, DiskID: 11, ModTime: old},
+			//copied by a task
+			{ExtentID: 6, DiskID: 11, Sealed: true, ModTime: old},
+			//unknown
+			{ExtentID: 8, DiskID: 11, ModTime: old},
+			//just created
+			{ExtentID: 9, DiskID: 11, ModTime: now},
+		},
+		Copies: []*pb.CopyReport{
+			{ExtentID: 7, ReplaceID: 2, DiskID: 11, ModTime: old},
+			{ExtentID: 10, ReplaceID: 3, DiskID: 11, ModTime: old},
+			{ExtentID: 11, ReplaceID: 3, DiskID: 11, ModTime: now},
+		},
+	}
+
+	res, recover := checkInventory(req, extents, getTask, diskOnline, now)
+	require.Equal(t, []uint64{8}, res.Orphans)
+	//extent 3 is on an offline disk
+	require.Equal(t, []uint64{2}, res.Missing)
+	require.Equal(t, []uint64{4}, res.Mismatched)
+	require.Equal(t, 1, len(res.StaleCopies))
+	require.Equal(t, uint64(10), res.StaleCopies[0].ExtentID)
+	require.Equal(t, []uint64{4, 2}, recover)
+}
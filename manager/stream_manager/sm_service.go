@@ -13,6 +13,7 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/journeymidnight/autumn/conn"
+	"github.com/journeymidnight/autumn/erasure_code"
 	"github.com/journeymidnight/autumn/etcd_utils"
 	"github.com/journeymidnight/autumn/proto/pb"
 	"github.com/journeymidnight/autumn/utils"
@@ -54,6 +55,18 @@ func (sm *StreamManager) CreateStream(ctx context.Context, req *pb.CreateStreamR
 		return errDone(errors.New("DataShard can not be less than 2 when EC is used"))
 	}
 
+	//in EC, each node has different shards, they can not be forwarded
+	if req.Replication == pb.ReplicationMode_Chain && req.ParityShard > 0 {
+		return errDone(errors.New("chain replication can not be used with EC"))
+	}
+
+	if req.Codec != nil && req.ParityShard == 0 {
+		return errDone(errors.New("codec can only be used with EC"))
+	}
+	if err = erasure_code.ValidateCodec(req.Codec, int(req.DataShard), int(req.ParityShard)); err != nil {
+		return errDone(err)
+	}
+
 	nodes := sm.getAllNodeStatus(true)
 
 	nodes, err = sm.policy.AllocExtent(nodes, int(req.DataShard+req.ParityShard), nil)
@@ -71,8 +84,10 @@ func (sm *StreamManager) CreateStream(ctx context.Context, req *pb.CreateStreamR
 	//new  stream
 	streamKey := formatStreamKey(streamID)
 	streamInfo := pb.StreamInfo{
-		StreamID:  streamID,
-		ExtentIDs: []uint64{extentID},
+		StreamID:    streamID,
+		ExtentIDs:   []uint64{extentID},
+		Replication: req.Replication,
+		Codec:       req.Codec,
 	}
 
 	sdata, err := streamInfo.Marshal()
@@ -91,6 +106,7 @@ func (sm *StreamManager) CreateStream(ctx context.Context, req *pb.CreateStreamR
 		ReplicateDisks: diskIDs[:req.DataShard],
 		ParityDisk:     diskIDs[req.DataShard:],
 		Refs:           1,
+		Codec:          req.Codec,
 	}
 
 	edata, err := extentInfo.Marshal()
@@ -110,7 +126,11 @@ func (sm *StreamManager) CreateStream(ctx context.Context, req *pb.CreateStreamR
 	}
 
 	//update memory, create stream and extent.
-
+	sm.streams.Set(streamID, &pb.StreamInfo{
+		StreamID:    streamID,
+		Replication: req.Replication,
+		Codec:       req.Codec,
+	})
 	sm.addExtent(streamID, &extentInfo)
 
 	return &pb.CreateStreamResponse{
@@ -233,7 +253,7 @@ func (sm *StreamManager) CheckCommitLength(ctx context.Context, req *pb.CheckCom
 	haveToSealLastExtent := false
 	//PS invoke 'CheckCommit' which will invoke receiveCommitlength,
 	//update req.Revision to the current PS's revision.
-	sizes := sm.receiveCommitlength(ctx, nodes, tailExtentID, req.Revision)
+	sizes := sm.receiveCommitlength(ctx, nodes, tailExtentID, req.Revision, false)
 
 	//examples to haveToSealLastExtent
 	//[-1, 10, 10]
@@ -279,6 +299,7 @@ func (sm *StreamManager) CheckCommitLength(ctx context.Context, req *pb.CheckCom
 	}
 
 	lastExtentInfo.SealedLength = uint64(minimalLength)
+	lastExtentInfo.SealedTime = time.Now().Unix()
 	lastExtentInfo.Eversion++
 	lastExtentInfo.Avali = avali
 	ops := []clientv3.Op{clientv3.OpPut(formatExtentKey(lastExtentInfo.ExtentID), string(utils.MustMarshal(lastExtentInfo)))}
@@ -351,8 +372,8 @@ func (sm *StreamManager) doPunchHoles(streamID uint64, extentIDs []uint64, owner
 		}
 	}()
 
-	//delete extentIDs if ref == 1
-	exToBeDeleted := make([]*pb.ExtentInfo, 0)
+	//extents whose refs reach 0 are removed by routineReclaimExtents
+	now := time.Now().Unix()
 	exToBeUpdated := make([]*pb.ExtentInfo, 0)
 	for i := range punchedExtents {
 		punchExInfo, ok := sm.cloneExtentInfo(punchedExtents[i])
@@ -362,19 +383,10 @@ func (sm *StreamManager) doPunchHoles(streamID uint64, extentIDs []uint64, owner
 		if punchExInfo.Avali == 0 {
 			return nil, errors.Errorf("punch holes: extent %d should be sealed", punchExInfo.ExtentID)
 		}
-		if punchExInfo.Refs == 1 {
-			exToBeDeleted = append(exToBeDeleted, punchExInfo)
-		} else {
-			punchExInfo.Refs--
-			punchExInfo.Eversion++
-			exToBeUpdated = append(exToBeUpdated, punchExInfo)
-		}
+		releaseExtent(punchExInfo, now)
+		exToBeUpdated = append(exToBeUpdated, punchExInfo)
 	}
 
-	//delete extentIDs
-	for _, exInfo := range exToBeDeleted {
-		ops = append(ops, clientv3.OpDelete(formatExtentKey(exInfo.ExtentID)))
-	}
 	for _, exInfo := range exToBeUpdated {
 		ops = append(ops, clientv3.OpPut(formatExtentKey(exInfo.ExtentID), string(utils.MustMarshal(exInfo))))
 	}
@@ -392,10 +404,6 @@ func (sm *StreamManager) doPunchHoles(streamID uint64, extentIDs []uint64, owner
 	for _, exInfo := range exToBeUpdated {
 		sm.extents.Set(exInfo.ExtentID, exInfo)
 	}
-	for _, exInfo := range exToBeDeleted {
-		sm.extents.Del(exInfo.ExtentID)
-		sm.extentsLocks.Delete(exInfo.ExtentID)
-	}
 	sm.streams.Set(streamID, streamInfo)
 	return streamInfo, nil
 
@@ -489,7 +497,7 @@ func (sm *StreamManager) StreamAllocExtent(ctx context.Context, req *pb.StreamAl
 			minSize = 1
 		}
 
-		sizes = sm.receiveCommitlength(ctx, nodes, lastExInfo.ExtentID, req.Revision)
+		sizes = sm.receiveCommitlength(ctx, nodes, lastExInfo.ExtentID, req.Revision, false)
 
 		for i := range sizes {
 			if sizes[i] != -1 {
@@ -511,6 +519,7 @@ func (sm *StreamManager) StreamAllocExtent(ctx context.Context, req *pb.StreamAl
 
 	//set extent
 	lastExInfo.SealedLength = uint64(minimalLength)
+	lastExInfo.SealedTime = time.Now().Unix()
 	lastExInfo.Eversion++
 	lastExInfo.Avali = avali
 
@@ -560,6 +569,7 @@ func (sm *StreamManager) StreamAllocExtent(ctx context.Context, req *pb.StreamAl
 		ReplicateDisks: diskIDs[:dataShards],
 		ParityDisk:     diskIDs[dataShards:],
 		Refs:           1,
+		Codec:          stream.Codec,
 	}
 
 	//set old
@@ -587,6 +597,11 @@ func (sm *StreamManager) StreamAllocExtent(ctx context.Context, req *pb.StreamAl
 	//update streams and new extents
 	sm.addExtent(req.StreamID, &newExInfo)
 
+	//record checksums of the sealed extent
+	sm.stopper.RunWorker(func() {
+		sm.auditExtentByID(lastExInfo.ExtentID, true)
+	})
+
 	return &pb.StreamAllocExtentResponse{
 		Code:       pb.Code_OK,
 		StreamInfo: stream,
@@ -625,8 +640,8 @@ func (sm *StreamManager) sealExtents(ctx context.Context, nodes []*NodeStatus, e
 */
 
 //receiveCommitlength returns minimal commitlength and all node who give us response and who did not
-//-1 if no response
-func (sm *StreamManager) receiveCommitlength(ctx context.Context, nodes []*NodeStatus, extentID uint64, revision int64) []int64 {
+//-1 if no response. if seal is true, nodes seal the extent at their lengths
+func (sm *StreamManager) receiveCommitlength(ctx context.Context, nodes []*NodeStatus, extentID uint64, revision int64, seal bool) []int64 {
 
 	pctx, cancel := context.WithTimeout(ctx, time.Second)
 	defer cancel()
@@ -655,6 +670,7 @@ func (sm *StreamManager) receiveCommitlength(ctx context.Context, nodes []*NodeS
 			res, err := c.CommitLength(pctx, &pb.CommitLengthRequest{
 				ExtentID: extentID,
 				Revision: revision,
+				Seal:     seal,
 			})
 
 			if err != nil { //timeout or other error
@@ -662,6 +678,11 @@ func (sm *StreamManager) receiveCommitlength(ctx context.Context, nodes []*NodeS
 				result[i] = -1
 				return
 			}
+			if res.Code != pb.Code_OK {
+				xlog.Logger.Warnf("commit length of extent %d on node %d: %s", extentID, nodes[i].NodeID, res.CodeDes)
+				result[i] = -1
+				return
+			}
 			result[i] = int64(res.Length)
 		}(i)
 	}
@@ -762,9 +783,10 @@ func (sm *StreamManager) RegisterNode(ctx context.Context, req *pb.RegisterNodeR
 	}
 
 	nodeInfo := &pb.NodeInfo{
-		NodeID:  id,
-		Address: req.Addr,
-		Disks:   seq(id+1, len(req.DiskUUIDs)),
+		NodeID:   id,
+		Address:  req.Addr,
+		Disks:    seq(id+1, len(req.DiskUUIDs)),
+		Topology: req.Topology,
 	}
 
 	data, err := nodeInfo.Marshal()
@@ -808,19 +830,93 @@ func (sm *StreamManager) RegisterNode(ctx context.Context, req *pb.RegisterNodeR
 	}, nil
 }
 
+func (sm *StreamManager) RegisterDisk(ctx context.Context, req *pb.RegisterDiskRequest) (*pb.RegisterDiskResponse, error) {
+	errDone := func(err error) (*pb.RegisterDiskResponse, error) {
+		code, desCode := wire_errors.ConvertToPBCode(err)
+		return &pb.RegisterDiskResponse{
+			Code:    code,
+			CodeDes: desCode,
+		}, nil
+	}
+
+	if !sm.AmLeader() {
+		return errDone(wire_errors.NotLeader)
+	}
+
+	ns := sm.getNodeStatus(req.NodeID)
+	if ns == nil {
+		return errDone(errors.Errorf("no such node %d", req.NodeID))
+	}
+	nodeInfo := ns.NodeInfo //copy nodeInfo
+	nodeInfo.Disks = nil
+	for _, diskID := range ns.Disks {
+		info, ok := sm.cloneDiskInfo(diskID)
+		if ok && info.Uuid == req.DiskUUID {
+			return errDone(errors.Errorf("disk %s is already registered as %d", req.DiskUUID, diskID))
+		}
+		if diskID == req.ReplaceDiskID {
+			if ok && info.Online {
+				return errDone(errors.Errorf("disk %d to be replaced is online", diskID))
+			}
+			continue
+		}
+		nodeInfo.Disks = append(nodeInfo.Disks, diskID)
+	}
+	if req.ReplaceDiskID > 0 && len(nodeInfo.Disks) == len(ns.Disks) {
+		return errDone(errors.Errorf("disk %d is not on node %d", req.ReplaceDiskID, req.NodeID))
+	}
+
+	diskID, _, err := sm.allocUniqID(1)
+	if err != nil {
+		return errDone(errors.New("failed to alloc uniq id"))
+	}
+	disk := pb.DiskInfo{
+		DiskID: diskID,
+		Online: true,
+		Uuid:   req.DiskUUID,
+	}
+	nodeInfo.Disks = append(nodeInfo.Disks, diskID)
+
+	err = etcd_utils.EtcdSetKVS(sm.client, []clientv3.Cmp{
+		clientv3.Compare(clientv3.Value(sm.leaderKey), "=", sm.memberValue),
+	}, []clientv3.Op{
+		clientv3.OpPut(formatNodeKey(nodeInfo.NodeID), string(utils.MustMarshal(&nodeInfo))),
+		clientv3.OpPut(formatDiskKey(diskID), string(utils.MustMarshal(&disk))),
+	})
+	if err != nil {
+		return errDone(err)
+	}
+
+	//extents on replaced disk keep the old diskID, so the old DiskInfo is not removed
+	sm.addDisk(disk)
+	sm.addNode(nodeInfo)
+	xlog.Logger.Infof("disk %d is registered on node %d, replaced disk %d", diskID, req.NodeID, req.ReplaceDiskID)
+
+	return &pb.RegisterDiskResponse{
+		Code:   pb.Code_OK,
+		DiskID: diskID,
+	}, nil
+}
+
 func (sm *StreamManager) NodesInfo(ctx context.Context, req *pb.NodesInfoRequest) (*pb.NodesInfoResponse, error) {
 	if !sm.AmLeader() {
 		return nil, errors.Errorf("not a leader")
 	}
 	return &pb.NodesInfoResponse{
-		Code:  pb.Code_OK,
-		Nodes: sm.cloneNodesInfo(),
+		Code:         pb.Code_OK,
+		Nodes:        sm.cloneNodesInfo(),
+		Decommission: sm.decommissionProgress(),
 	}, nil
 }
 
 func (sm *StreamManager) Status(ctx context.Context, req *pb.StatusRequest) (*pb.StatusResponse, error) {
+	leader, err := sm.currentLeader(ctx)
+	if err != nil {
+		xlog.Logger.Warnf("get leader of stream managers: %v", err)
+	}
 	return &pb.StatusResponse{
-		Code: pb.Code_OK,
+		Code:   pb.Code_OK,
+		Leader: leader,
 	}, nil
 }
 
@@ -988,11 +1084,12 @@ func (sm *StreamManager) getNodeStatus(nodeID uint64) *NodeStatus {
 	return v.(*NodeStatus)
 }
 
+//getAllNodeStatus returns nodes for new extents if onlyAlive is true, decommissioning nodes are excluded
 func (sm *StreamManager) getAllNodeStatus(onlyAlive bool) (ret []*NodeStatus) {
 	for kv := range sm.nodes.Iter() {
 		ns := kv.Value.(*NodeStatus)
 		if onlyAlive {
-			if ns.IsHealthy() {
+			if ns.IsHealthy() && !ns.Decommissioning && sm.hasOnlineDisk(ns) {
 				ret = append(ret, ns)
 			}
 		} else {
@@ -1003,6 +1100,15 @@ func (sm *StreamManager) getAllNodeStatus(onlyAlive bool) (ret []*NodeStatus) {
 	return ret
 }
 
+func (sm *StreamManager) hasOnlineDisk(ns *NodeStatus) bool {
+	for _, diskID := range ns.Disks {
+		if ds := sm.getDiskStatus(diskID); ds != nil && ds.Online {
+			return true
+		}
+	}
+	return false
+}
+
 func (sm *StreamManager) cloneNodesInfo() map[uint64]*pb.NodeInfo {
 	ret := make(map[uint64]*pb.NodeInfo)
 	for kv := range sm.nodes.Iter() {
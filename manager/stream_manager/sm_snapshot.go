@@ -0,0 +1,347 @@
+package stream_manager
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/journeymidnight/autumn/etcd_utils"
+	"github.com/journeymidnight/autumn/proto/pb"
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/journeymidnight/autumn/utils"
+	"github.com/journeymidnight/autumn/wire_errors"
+	"github.com/journeymidnight/autumn/xlog"
+	"github.com/pkg/errors"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+/*
+partition snapshots:
+a snapshot has its own log, row and meta streams, duplicated from the partition like split does,
+so extents of the snapshot are sealed and shared with the partition, refs of extents count both.
+restoring copies streams of the snapshot again and replaces streams of the partition, the snapshot
+is kept. deleting a snapshot releases its streams, extents only referenced by it are reclaimed later
+*/
+
+func formatSnapshotKey(ID uint64) string {
+	return fmt.Sprintf("snapshots/%d", ID)
+}
+
+func formatPartKey(ID uint64) string {
+	return fmt.Sprintf("PART/%d", ID)
+}
+
+func (sm *StreamManager) getPartitionMeta(partID uint64) (*pspb.PartitionMeta, error) {
+	data, _, err := etcd_utils.EtcdGetKV(sm.client, formatPartKey(partID))
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, errors.Errorf("partition %d do not exist", partID)
+	}
+	var meta pspb.PartitionMeta
+	if err = meta.Unmarshal(data); err != nil {
+		return nil, err
+	}
+	return &meta, nil
+}
+
+func (sm *StreamManager) getSnapshot(snapshotID uint64) (*pspb.PartitionSnapshot, error) {
+	data, _, err := etcd_utils.EtcdGetKV(sm.client, formatSnapshotKey(snapshotID))
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, wire_errors.NotFound
+	}
+	var snapshot pspb.PartitionSnapshot
+	if err = snapshot.Unmarshal(data); err != nil {
+		return nil, err
+	}
+	return &snapshot, nil
+}
+
+//changeRefs adds deltas to refs of extents, changed extents are locked until one of returned funcs is called.
+//the first one unlocks extents, the second one also updates extents in memory
+func (sm *StreamManager) changeRefs(ops *[]clientv3.Op, deltas map[uint64]int) (func(), func(), error) {
+	extentIDs := make([]uint64, 0, len(deltas))
+	for extentID, delta := range deltas {
+		if delta != 0 {
+			extentIDs = append(extentIDs, extentID)
+		}
+	}
+	//lock extents in the same order
+	sort.Slice(extentIDs, func(i, j int) bool {
+		return extentIDs[i] < extentIDs[j]
+	})
+
+	var locked []uint64
+	unlockExtents := func() {
+		for _, extentID := range locked {
+			sm.unlockExtent(extentID)
+		}
+	}
+
+	now := time.Now().Unix()
+	var updated []*pb.ExtentInfo
+	for _, extentID := range extentIDs {
+		if err := sm.lockExtent(extentID); err != nil {
+			unlockExtents()
+			return nil, nil, errors.Errorf("extent %d has been deleted", extentID)
+		}
+		locked = append(locked, extentID)
+		exInfo, ok := sm.cloneExtentInfo(extentID)
+		if !ok {
+			unlockExtents()
+			return nil, nil, errors.Errorf("extent %d has been deleted", extentID)
+		}
+		if delta := deltas[extentID]; delta > 0 {
+			exInfo.Refs += uint64(delta)
+			exInfo.DeletedTime = 0
+			exInfo.Eversion++
+		} else {
+			for i := 0; i < -delta; i++ {
+				releaseExtent(exInfo, now)
+			}
+		}
+		*ops = append(*ops, clientv3.OpPut(formatExtentKey(extentID), string(utils.MustMarshal(exInfo))))
+		updated = append(updated, exInfo)
+	}
+
+	return unlockExtents, func() {
+		for _, exInfo := range updated {
+			sm.extents.Set(exInfo.ExtentID, exInfo)
+		}
+		unlockExtents()
+	}, nil
+}
+
+func (sm *StreamManager) SnapshotPartition(ctx context.Context, req *pb.SnapshotPartitionRequest) (*pb.SnapshotPartitionResponse, error) {
+	errDone := func(err error) (*pb.SnapshotPartitionResponse, error) {
+		code, desCode := wire_errors.ConvertToPBCode(err)
+		return &pb.SnapshotPartitionResponse{
+			Code:    code,
+			CodeDes: desCode,
+		}, nil
+	}
+
+	if !sm.AmLeader() {
+		return errDone(wire_errors.NotLeader)
+	}
+
+	meta, err := sm.getPartitionMeta(req.PartID)
+	if err != nil {
+		return errDone(err)
+	}
+
+	//3 streams and snapshotID
+	start, end, err := sm.allocUniqID(4)
+	if err != nil {
+		return errDone(err)
+	}
+
+	var ops []clientv3.Op
+	var successOps []func()
+	var failedOps []func()
+	failed := func(err error) (*pb.SnapshotPartitionResponse, error) {
+		for _, f := range failedOps {
+			f()
+		}
+		return errDone(err)
+	}
+
+	//same order as split: log, row, meta
+	srcs := []struct {
+		streamID     uint64
+		sealedLength uint32
+	}{
+		{meta.LogStream, req.LogStreamSealedLength},
+		{meta.RowStream, req.RowStreamSealedLength},
+		{meta.MetaStream, req.MetaStreamSealedLength},
+	}
+	for i, src := range srcs {
+		f, s, err := sm.duplicateStream(&ops, src.streamID, start+uint64(i), src.sealedLength)
+		if err != nil {
+			return failed(err)
+		}
+		successOps = append(successOps, s)
+		failedOps = append(failedOps, f)
+	}
+
+	snapshot := pspb.PartitionSnapshot{
+		SnapshotID: end - 1,
+		PartID:     req.PartID,
+		LogStream:  start,
+		RowStream:  start + 1,
+		MetaStream: start + 2,
+		Rg:         meta.Rg,
+		CreatedAt:  time.Now().Unix(),
+	}
+	ops = append(ops, clientv3.OpPut(formatSnapshotKey(snapshot.SnapshotID), string(utils.MustMarshal(&snapshot))))
+
+	err = etcd_utils.EtcdSetKVS(sm.client, []clientv3.Cmp{
+		clientv3.Compare(clientv3.Value(sm.leaderKey), "=", sm.memberValue),
+		clientv3.Compare(clientv3.CreateRevision(req.OwnerKey), "=", req.Revision),
+	}, ops)
+	if err != nil {
+		return failed(err)
+	}
+	for _, s := range successOps {
+		s()
+	}
+	xlog.Logger.Infof("partition %d snapshot %d is created", req.PartID, snapshot.SnapshotID)
+
+	return &pb.SnapshotPartitionResponse{
+		Code:       pb.Code_OK,
+		SnapshotID: snapshot.SnapshotID,
+	}, nil
+}
+
+func (sm *StreamManager) RestoreSnapshot(ctx context.Context, req *pb.RestoreSnapshotRequest) (*pb.RestoreSnapshotResponse, error) {
+	errDone := func(err error) (*pb.RestoreSnapshotResponse, error) {
+		code, desCode := wire_errors.ConvertToPBCode(err)
+		return &pb.RestoreSnapshotResponse{
+			Code:    code,
+			CodeDes: desCode,
+		}, nil
+	}
+
+	if !sm.AmLeader() {
+		return errDone(wire_errors.NotLeader)
+	}
+
+	snapshot, err := sm.getSnapshot(req.SnapshotID)
+	if err != nil {
+		return errDone(err)
+	}
+	meta, err := sm.getPartitionMeta(snapshot.PartID)
+	if err != nil {
+		return errDone(err)
+	}
+
+	start, _, err := sm.allocUniqID(3)
+	if err != nil {
+		return errDone(err)
+	}
+
+	var ops []clientv3.Op
+	deltas := make(map[uint64]int)
+	var newStreams []*pb.StreamInfo
+	for i, streamID := range []uint64{snapshot.LogStream, snapshot.RowStream, snapshot.MetaStream} {
+		streamInfo, ok := sm.cloneStreamInfo(streamID)
+		if !ok {
+			return errDone(errors.Errorf("stream %d of snapshot %d do not exist", streamID, req.SnapshotID))
+		}
+		newStreamInfo := &pb.StreamInfo{
+			StreamID:    start + uint64(i),
+			Replication: streamInfo.Replication,
+			Codec:       streamInfo.Codec,
+			ExtentIDs:   streamInfo.ExtentIDs,
+		}
+		for _, extentID := range newStreamInfo.ExtentIDs {
+			deltas[extentID]++
+		}
+		ops = append(ops, clientv3.OpPut(formatStreamKey(newStreamInfo.StreamID), string(utils.MustMarshal(newStreamInfo))))
+		newStreams = append(newStreams, newStreamInfo)
+	}
+
+	oldStreams := []uint64{meta.LogStream, meta.RowStream, meta.MetaStream}
+	for _, streamID := range oldStreams {
+		streamInfo, ok := sm.cloneStreamInfo(streamID)
+		if !ok {
+			xlog.Logger.Warnf("restore snapshot %d: stream %d do not exist", req.SnapshotID, streamID)
+			continue
+		}
+		for _, extentID := range streamInfo.ExtentIDs {
+			deltas[extentID]--
+		}
+		ops = append(ops, clientv3.OpDelete(formatStreamKey(streamID)))
+	}
+
+	unlockExtents, updateExtents, err := sm.changeRefs(&ops, deltas)
+	if err != nil {
+		return errDone(err)
+	}
+
+	meta.LogStream = newStreams[0].StreamID
+	meta.RowStream = newStreams[1].StreamID
+	meta.MetaStream = newStreams[2].StreamID
+	ops = append(ops, clientv3.OpPut(formatPartKey(meta.PartID), string(utils.MustMarshal(meta))))
+
+	err = etcd_utils.EtcdSetKVS(sm.client, []clientv3.Cmp{
+		clientv3.Compare(clientv3.Value(sm.leaderKey), "=", sm.memberValue),
+		clientv3.Compare(clientv3.CreateRevision(req.OwnerKey), "=", req.Revision),
+		clientv3.Compare(clientv3.CreateRevision(formatSnapshotKey(req.SnapshotID)), ">", 0),
+	}, ops)
+	if err != nil {
+		unlockExtents()
+		return errDone(err)
+	}
+	updateExtents()
+	for _, streamInfo := range newStreams {
+		sm.streams.Set(streamInfo.StreamID, streamInfo)
+	}
+	for _, streamID := range oldStreams {
+		sm.streams.Del(streamID)
+	}
+	xlog.Logger.Infof("partition %d is restored from snapshot %d", meta.PartID, req.SnapshotID)
+
+	return &pb.RestoreSnapshotResponse{
+		Code: pb.Code_OK,
+	}, nil
+}
+
+func (sm *StreamManager) DeleteSnapshot(ctx context.Context, req *pb.DeleteSnapshotRequest) (*pb.DeleteSnapshotResponse, error) {
+	errDone := func(err error) (*pb.DeleteSnapshotResponse, error) {
+		code, desCode := wire_errors.ConvertToPBCode(err)
+		return &pb.DeleteSnapshotResponse{
+			Code:    code,
+			CodeDes: desCode,
+		}, nil
+	}
+
+	if !sm.AmLeader() {
+		return errDone(wire_errors.NotLeader)
+	}
+
+	snapshot, err := sm.getSnapshot(req.SnapshotID)
+	if err != nil {
+		return errDone(err)
+	}
+
+	ops := []clientv3.Op{clientv3.OpDelete(formatSnapshotKey(req.SnapshotID))}
+	deltas := make(map[uint64]int)
+	streams := []uint64{snapshot.LogStream, snapshot.RowStream, snapshot.MetaStream}
+	for _, streamID := range streams {
+		streamInfo, ok := sm.cloneStreamInfo(streamID)
+		if !ok {
+			continue
+		}
+		for _, extentID := range streamInfo.ExtentIDs {
+			deltas[extentID]--
+		}
+		ops = append(ops, clientv3.OpDelete(formatStreamKey(streamID)))
+	}
+
+	unlockExtents, updateExtents, err := sm.changeRefs(&ops, deltas)
+	if err != nil {
+		return errDone(err)
+	}
+	err = etcd_utils.EtcdSetKVS(sm.client, []clientv3.Cmp{
+		clientv3.Compare(clientv3.Value(sm.leaderKey), "=", sm.memberValue),
+	}, ops)
+	if err != nil {
+		unlockExtents()
+		return errDone(err)
+	}
+	updateExtents()
+	for _, streamID := range streams {
+		sm.streams.Del(streamID)
+	}
+	xlog.Logger.Infof("snapshot %d of partition %d is deleted", req.SnapshotID, snapshot.PartID)
+
+	return &pb.DeleteSnapshotResponse{
+		Code: pb.Code_OK,
+	}, nil
+}
@@ -0,0 +1,53 @@
+package stream_manager
+
+import (
+	"testing"
+
+	"github.com/journeymidnight/autumn/proto/pb"
+	"github.com/journeymidnight/autumn/utils"
+	"github.com/stretchr/testify/require"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+func TestChangeRefs(t *testing.T) {
+	sm := &StreamManager{}
+	sm.initCache()
+	for _, exInfo := range []*pb.ExtentInfo{
+		{ExtentID: 1, Refs: 1, Eversion: 1},
+		{ExtentID: 2, Refs: 1, Eversion: 1},
+		{ExtentID: 3, Refs: 2, Eversion: 1},
+	} {
+		require.NoError(t, sm.putCache(formatExtentKey(exInfo.ExtentID), utils.MustMarshal(exInfo)))
+	}
+
+	var ops []clientv3.Op
+	//extent 3 is in both old and new streams
+	_, updateExtents, err := sm.changeRefs(&ops, map[uint64]int{1: 1, 2: -1, 3: 0})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(ops))
+
+	//not changed before etcd is updated
+	exInfo, _ := sm.cloneExtentInfo(1)
+	require.Equal(t, uint64(1), exInfo.Refs)
+
+	updateExtents()
+	exInfo, _ = sm.cloneExtentInfo(1)
+	require.Equal(t, uint64(2), exInfo.Refs)
+	require.Equal(t, uint64(2), exInfo.Eversion)
+	exInfo, _ = sm.cloneExtentInfo(2)
+	require.Equal(t, uint64(0), exInfo.Refs)
+	require.NotZero(t, exInfo.DeletedTime)
+	exInfo, _ = sm.cloneExtentInfo(3)
+	require.Equal(t, uint64(1), exInfo.Eversion)
+
+	//all locks are released
+	for _, extentID := range []uint64{1, 2, 3} {
+		require.NoError(t, sm.lockExtent(extentID))
+		sm.unlockExtent(extentID)
+	}
+
+	_, _, err = sm.changeRefs(&ops, map[uint64]int{1: 1, 4: 1})
+	require.Error(t, err)
+	require.NoError(t, sm.lockExtent(1))
+	sm.unlockExtent(1)
+}
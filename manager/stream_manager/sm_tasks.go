@@ -102,7 +102,8 @@ func (sm *StreamManager) routineUpdateDF() {
 		pctx, pCancel := context.WithTimeout(sm.stopper.Ctx(), 5 * time.Second)
 		client := pb.NewExtentServiceClient(conn)
 		res, err := client.Df(pctx, &pb.DfRequest{
-			Tasks: sm.taskPool.GetFromNode(node.NodeID),
+			Tasks:   sm.taskPool.GetFromNode(node.NodeID),
+			DiskIDs: node.Disks,
 		})
 		pCancel()
 		if err != nil {
@@ -116,8 +117,25 @@ func (sm *StreamManager) routineUpdateDF() {
 		sumTotal := uint64(0)
 		for diskID, df := range res.DiskStatus {
 			disk := sm.getDiskStatus(diskID)
-			atomic.StoreUint64(&disk.total, df.Total)
-			atomic.StoreUint64(&disk.free, df.Free)
+			if disk == nil {
+				continue
+			}
+			//node found disk failure, extents on it are recovered by routineDispatchTask
+			if !df.Online {
+				if disk.Online {
+					if err := sm.setDiskOffline(diskID); err != nil {
+						xlog.Logger.Warnf("set disk %d offline: %v", diskID, err)
+					}
+				}
+				continue
+			}
+			//Df failed on node, use the last space
+			if df.Total > 0 {
+				atomic.StoreUint64(&disk.total, df.Total)
+				atomic.StoreUint64(&disk.free, df.Free)
+			}
+			sumTotal += atomic.LoadUint64(&disk.total)
+			sumFree += atomic.LoadUint64(&disk.free)
 		}
 
 		node.SetFree(sumFree)
@@ -144,6 +162,27 @@ func (sm *StreamManager) routineUpdateDF() {
 	}
 }
 
+//setDiskOffline persists that disk is broken, an offline disk never comes back,
+//a replaced disk is registered as a new disk
+func (sm *StreamManager) setDiskOffline(diskID uint64) error {
+	info, ok := sm.cloneDiskInfo(diskID)
+	if !ok || !info.Online {
+		return nil
+	}
+	info.Online = false
+	err := etcd_utils.EtcdSetKVS(sm.client, []clientv3.Cmp{
+		clientv3.Compare(clientv3.Value(sm.leaderKey), "=", sm.memberValue),
+	}, []clientv3.Op{
+		clientv3.OpPut(formatDiskKey(diskID), string(utils.MustMarshal(info))),
+	})
+	if err != nil {
+		return err
+	}
+	sm.addDisk(*info)
+	xlog.Logger.Warnf("disk %d is offline", diskID)
+	return nil
+}
+
 type RecoveryTask struct {
 	task        *pb.RecoveryTask //immutable
 	runningNode uint64
@@ -292,55 +331,33 @@ func (sm *StreamManager) dispatchRecoveryTask(exInfo *pb.ExtentInfo, replaceID u
 	}
 
 	//find a remote node
-	nodes := sm.getAllNodeStatus(true)
+	var nodes []*NodeStatus
+	for _, ns := range sm.getAllNodeStatus(true) {
+		//to be replaced or already in node
+		if ns.NodeID == replaceID || FindNodeIndex(exInfo, ns.NodeID) >= 0 {
+			continue
+		}
+		nodes = append(nodes, ns)
+	}
 	if len(nodes) == 0 {
 		return errors.Errorf("can not find remote node to copy")
 	}
 
-	/*
-		if exInfo.ExtentID == 17 {
-			chosenNode = &NodeStatus{NodeInfo:pb.NodeInfo{
-				NodeID: 4,
-				Address:"127.0.0.1:4002",
-			}}
-		} else {
-			fmt.Printf("ignore")
-			return nil
-		}*/
-
-	//simple policy
-	var chosenNode *NodeStatus
-	for i := range nodes {
-		//to be replaced
-		if nodes[i].NodeID == replaceID {
-			continue
-		}
-
-		//already in node
-		if FindNodeIndex(exInfo, nodes[i].NodeID) >= 0 {
+	//other copies constrain the failure domain of new copy
+	var keepNodes []*NodeStatus
+	for _, nodeID := range append(append([]uint64{}, exInfo.Replicates...), exInfo.Parity...) {
+		if nodeID == replaceID {
 			continue
 		}
-
-		//no disk avali
-		noDiskAvali := true
-		for _, diskID := range nodes[i].Disks {
-			ds := sm.getDiskStatus(diskID)
-			if ds.Online {
-				noDiskAvali = false
-				break
-			}
-		}
-		if noDiskAvali {
-			continue
+		if ns := sm.getNodeStatus(nodeID); ns != nil {
+			keepNodes = append(keepNodes, ns)
 		}
-
-		chosenNode = nodes[i]
-		break
 	}
-
-	if chosenNode == nil {
-		return errors.New("can not find remote node to copy")
+	chosen, err := sm.policy.AllocExtent(nodes, 1, keepNodes)
+	if err != nil {
+		return errors.Wrap(err, "can not find remote node to copy")
 	}
+	chosenNode := chosen[0]
 
 	pool := conn.GetPools().Connect(chosenNode.Address)
 	if pool == nil || !pool.IsHealthy() {
@@ -401,8 +418,8 @@ func (sm *StreamManager) routineDispatchTask() {
 			for kv := range sm.extents.Iter() {
 				exInfo := kv.Value.(*pb.ExtentInfo) //extent is read only
 
-				//only check sealed extent
-				if exInfo.Avali == 0 {
+				//only check sealed extent, extents without refs are reclaimed
+				if exInfo.Avali == 0 || exInfo.Refs == 0 {
 					continue
 				}
 
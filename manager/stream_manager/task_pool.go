@@ -23,6 +23,14 @@ func NewTaskPool() *TaskPool {
 }
 
 
+//Reset removes all tasks
+func (tp *TaskPool) Reset() {
+	tp.Lock()
+	defer tp.Unlock()
+	clearHashMap(tp.extentMap)
+	clearHashMap(tp.nodeMap)
+}
+
 func (tp *TaskPool) Remove(extentID uint64) {
 	tp.Lock()
 	defer tp.Unlock()
@@ -40,7 +48,7 @@ func (tp *TaskPool) Remove(extentID uint64) {
 		return
 	}
 	tl := d.(*hashmap.HashMap)
-	tl.Del(t.NodeID)
+	tl.Del(extentID)
 	
 }
 
@@ -57,7 +65,7 @@ func (tp *TaskPool) Insert(t *pb.RecoveryTask) {
 		tl.Set(extentID, t)
 	} else {
 		tl := &hashmap.HashMap{}
-		tl.Set(t.NodeID, t)
+		tl.Set(extentID, t)
 		tp.nodeMap.Set(t.NodeID, tl)
 	}
 }
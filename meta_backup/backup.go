@@ -0,0 +1,354 @@
+package meta_backup
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/journeymidnight/autumn/manager/stream_manager"
+	"github.com/journeymidnight/autumn/proto/pb"
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/journeymidnight/autumn/utils"
+	"github.com/pkg/errors"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+/*
+backup file:
+magic(8 bytes) | version(4 bytes) | crc32c of data(4 bytes) | length of data(8 bytes) | data(pb.MetaBackup)
+
+all keys without lease are read at one etcd revision, keys with lease(leaders of managers, partition
+servers) are recreated by running processes. restore checks that streams, extents, partitions and
+regions refer to each other before writing. managers must be stopped during restore, because they
+cache metadata. id allocators never go back and clusterID is kept
+*/
+
+const (
+	Version = 1
+	magic   = "AUTUMNMB"
+
+	headerSize  = 24
+	pageSize    = 1000
+	maxTxnOps   = 128 //default max ops of an etcd txn
+	etcdTimeout = 10 * time.Second
+	maxProblems = 10 //problems shown in error of Validate
+)
+
+var ErrChecksum = errors.New("backup checksum mismatch")
+
+//keys of id allocators of managers
+var idKeys = map[string]bool{
+	stream_manager.IdKey: true,
+	"AutumnPMIDKey":      true,
+}
+
+const clusterIDKey = "clusterID"
+
+//load reads all keys at one revision, keys with lease are returned separately
+func load(c *clientv3.Client) ([]*pb.MetaKV, []string, int64, error) {
+	var kvs []*pb.MetaKV
+	var leased []string
+	var rev int64
+	key := "\x00"
+	for {
+		opts := []clientv3.OpOption{
+			clientv3.WithFromKey(),
+			clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend),
+			clientv3.WithLimit(pageSize),
+		}
+		if rev > 0 {
+			opts = append(opts, clientv3.WithRev(rev))
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), etcdTimeout)
+		resp, err := c.Get(ctx, key, opts...)
+		cancel()
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		if rev == 0 {
+			rev = resp.Header.Revision
+		}
+		for _, kv := range resp.Kvs {
+			if kv.Lease != 0 {
+				leased = append(leased, string(kv.Key))
+				continue
+			}
+			kvs = append(kvs, &pb.MetaKV{Key: string(kv.Key), Value: kv.Value})
+		}
+		if !resp.More || len(resp.Kvs) == 0 {
+			return kvs, leased, rev, nil
+		}
+		key = string(resp.Kvs[len(resp.Kvs)-1].Key) + "\x00"
+	}
+}
+
+//Backup dumps metadata of cluster at the current revision
+func Backup(c *clientv3.Client) (*pb.MetaBackup, error) {
+	kvs, _, rev, err := load(c)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.MetaBackup{
+		Version:     Version,
+		Revision:    rev,
+		CreatedTime: time.Now().Unix(),
+		Kvs:         kvs,
+	}, nil
+}
+
+func Write(w io.Writer, backup *pb.MetaBackup) error {
+	data := utils.MustMarshal(backup)
+	header := make([]byte, headerSize)
+	copy(header, magic)
+	binary.BigEndian.PutUint32(header[8:], backup.Version)
+	binary.BigEndian.PutUint32(header[12:], utils.NewCRC(data).Value())
+	binary.BigEndian.PutUint64(header[16:], uint64(len(data)))
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+	_, err := w.Write(data)
+	return err
+}
+
+func Read(r io.Reader) (*pb.MetaBackup, error) {
+	header := make([]byte, headerSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, errors.Wrap(err, "read backup header")
+	}
+	if !bytes.Equal(header[:8], []byte(magic)) {
+		return nil, errors.New("not a metadata backup")
+	}
+	if version := binary.BigEndian.Uint32(header[8:]); version != Version {
+		return nil, errors.Errorf("unsupported backup version %d", version)
+	}
+	data, err := ioutil.ReadAll(io.LimitReader(r, int64(binary.BigEndian.Uint64(header[16:]))))
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(data)) != binary.BigEndian.Uint64(header[16:]) {
+		return nil, errors.New("backup is truncated")
+	}
+	if utils.NewCRC(data).Value() != binary.BigEndian.Uint32(header[12:]) {
+		return nil, ErrChecksum
+	}
+	var backup pb.MetaBackup
+	if err = backup.Unmarshal(data); err != nil {
+		return nil, err
+	}
+	return &backup, nil
+}
+
+func parseID(key, prefix string) (uint64, bool) {
+	if !strings.HasPrefix(key, prefix) {
+		return 0, false
+	}
+	id, err := strconv.ParseUint(key[len(prefix):], 10, 64)
+	return id, err == nil
+}
+
+//Validate checks that extents of streams, streams of partitions and snapshots, and partitions of regions exist
+func Validate(backup *pb.MetaBackup) error {
+	var problems []string
+	streams := make(map[uint64]*pb.StreamInfo)
+	extents := make(map[uint64]bool)
+	parts := make(map[uint64]*pspb.PartitionMeta)
+	snapshots := make(map[uint64]*pspb.PartitionSnapshot)
+	var regions *pspb.Regions
+	for _, kv := range backup.Kvs {
+		var err error
+		if id, ok := parseID(kv.Key, "streams/"); ok {
+			var s pb.StreamInfo
+			err = s.Unmarshal(kv.Value)
+			streams[id] = &s
+		} else if id, ok := parseID(kv.Key, "extents/"); ok {
+			var e pb.ExtentInfo
+			err = e.Unmarshal(kv.Value)
+			extents[id] = true
+		} else if id, ok := parseID(kv.Key, "PART/"); ok {
+			var p pspb.PartitionMeta
+			err = p.Unmarshal(kv.Value)
+			parts[id] = &p
+		} else if id, ok := parseID(kv.Key, "snapshots/"); ok {
+			var p pspb.PartitionSnapshot
+			err = p.Unmarshal(kv.Value)
+			snapshots[id] = &p
+		} else if kv.Key == "regions/config" {
+			regions = new(pspb.Regions)
+			err = regions.Unmarshal(kv.Value)
+		}
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("can not parse %s: %v", kv.Key, err))
+		}
+	}
+
+	for id, s := range streams {
+		for _, extentID := range s.ExtentIDs {
+			if !extents[extentID] {
+				problems = append(problems, fmt.Sprintf("extent %d of stream %d does not exist", extentID, id))
+			}
+		}
+	}
+	for id, p := range parts {
+		for _, streamID := range []uint64{p.LogStream, p.RowStream, p.MetaStream} {
+			if _, ok := streams[streamID]; streamID != 0 && !ok {
+				problems = append(problems, fmt.Sprintf("stream %d of partition %d does not exist", streamID, id))
+			}
+		}
+	}
+	for id, p := range snapshots {
+		for _, streamID := range []uint64{p.LogStream, p.RowStream, p.MetaStream} {
+			if _, ok := streams[streamID]; !ok {
+				problems = append(problems, fmt.Sprintf("stream %d of snapshot %d does not exist", streamID, id))
+			}
+		}
+	}
+	if regions != nil {
+		for _, region := range regions.Regions {
+			if _, ok := parts[region.PartID]; !ok {
+				problems = append(problems, fmt.Sprintf("partition %d of regions does not exist", region.PartID))
+			}
+		}
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+	sort.Strings(problems)
+	n := len(problems)
+	if n > maxProblems {
+		problems = append(problems[:maxProblems], "...")
+	}
+	return errors.Errorf("%d problems in backup: %s", n, strings.Join(problems, "; "))
+}
+
+//Diff is the difference between a backup and a live cluster
+type Diff struct {
+	Added   []string //in backup, not in cluster
+	Removed []string //in cluster, not in backup
+	Changed []string //values are different
+}
+
+func (d *Diff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+//compare returns what restoring backup changes on live, both are sorted by key
+func compare(backup []*pb.MetaKV, live []*pb.MetaKV) *Diff {
+	d := new(Diff)
+	i, j := 0, 0
+	for i < len(backup) || j < len(live) {
+		switch {
+		case j == len(live) || (i < len(backup) && backup[i].Key < live[j].Key):
+			d.Added = append(d.Added, backup[i].Key)
+			i++
+		case i == len(backup) || live[j].Key < backup[i].Key:
+			d.Removed = append(d.Removed, live[j].Key)
+			j++
+		default:
+			if !bytes.Equal(backup[i].Value, live[j].Value) {
+				d.Changed = append(d.Changed, backup[i].Key)
+			}
+			i++
+			j++
+		}
+	}
+	return d
+}
+
+//restored returns keys of cluster after restoring backup on live, both are sorted by key. id allocators
+//keep the larger value, ids allocated after the backup are not reused. clusterID is never changed,
+//replication peers know the cluster by it
+func restored(backup []*pb.MetaKV, live []*pb.MetaKV) []*pb.MetaKV {
+	liveValues := make(map[string][]byte, len(live))
+	for _, kv := range live {
+		liveValues[kv.Key] = kv.Value
+	}
+	var ret []*pb.MetaKV
+	for _, kv := range backup {
+		switch {
+		case kv.Key == clusterIDKey:
+			continue
+		case idKeys[kv.Key] && len(kv.Value) == 8 && len(liveValues[kv.Key]) == 8 &&
+			binary.BigEndian.Uint64(liveValues[kv.Key]) > binary.BigEndian.Uint64(kv.Value):
+			ret = append(ret, &pb.MetaKV{Key: kv.Key, Value: liveValues[kv.Key]})
+		default:
+			ret = append(ret, kv)
+		}
+	}
+	for _, kv := range live {
+		if kv.Key == clusterIDKey || (idKeys[kv.Key] && !contains(backup, kv.Key)) {
+			ret = append(ret, kv)
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Key < ret[j].Key
+	})
+	return ret
+}
+
+func contains(kvs []*pb.MetaKV, key string) bool {
+	i := sort.Search(len(kvs), func(i int) bool {
+		return kvs[i].Key >= key
+	})
+	return i < len(kvs) && kvs[i].Key == key
+}
+
+//Compare returns the difference between backup and cluster
+func Compare(c *clientv3.Client, backup *pb.MetaBackup) (*Diff, error) {
+	live, _, _, err := load(c)
+	if err != nil {
+		return nil, err
+	}
+	return compare(restored(backup.Kvs, live), live), nil
+}
+
+//Restore makes keys without lease in cluster the same as backup, except id allocators and clusterID. it fails if keys with lease exist,
+//which means managers or partition servers are running, unless force is true. restore is not atomic,
+//run it again if it fails
+func Restore(c *clientv3.Client, backup *pb.MetaBackup, force bool) (*Diff, error) {
+	if err := Validate(backup); err != nil {
+		return nil, err
+	}
+	live, leased, _, err := load(c)
+	if err != nil {
+		return nil, err
+	}
+	if len(leased) > 0 && !force {
+		return nil, errors.Errorf("stop managers and partition servers before restore, %s is alive", leased[0])
+	}
+
+	target := restored(backup.Kvs, live)
+	d := compare(target, live)
+	var ops []clientv3.Op
+	for _, key := range d.Removed {
+		ops = append(ops, clientv3.OpDelete(key))
+	}
+	values := make(map[string][]byte, len(target))
+	for _, kv := range target {
+		values[kv.Key] = kv.Value
+	}
+	for _, key := range append(append([]string{}, d.Added...), d.Changed...) {
+		ops = append(ops, clientv3.OpPut(key, string(values[key])))
+	}
+	for len(ops) > 0 {
+		n := maxTxnOps
+		if n > len(ops) {
+			n = len(ops)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), etcdTimeout)
+		_, err = c.Txn(ctx).Then(ops[:n]...).Commit()
+		cancel()
+		if err != nil {
+			return nil, err
+		}
+		ops = ops[n:]
+	}
+	return d, nil
+}
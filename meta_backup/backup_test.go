@@ -0,0 +1,132 @@
+package meta_backup
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/journeymidnight/autumn/proto/pb"
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/journeymidnight/autumn/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func testBackup() *pb.MetaBackup {
+	regions := &pspb.Regions{Regions: map[uint64]*pspb.RegionInfo{
+		5: {PartID: 5, PSID: 1, Rg: &pspb.Range{}},
+	}}
+	return &pb.MetaBackup{
+		Version:  Version,
+		Revision: 100,
+		Kvs: []*pb.MetaKV{
+			{Key: "AutumnSMIDKey", Value: []byte{0, 0, 0, 0, 0, 0, 0, 10}},
+			{Key: "PART/5", Value: utils.MustMarshal(&pspb.PartitionMeta{PartID: 5, LogStream: 1, RowStream: 2, MetaStream: 3})},
+			{Key: "extents/4", Value: utils.MustMarshal(&pb.ExtentInfo{ExtentID: 4})},
+			{Key: "regions/config", Value: utils.MustMarshal(regions)},
+			{Key: "streams/1", Value: utils.MustMarshal(&pb.StreamInfo{StreamID: 1, ExtentIDs: []uint64{4}})},
+			{Key: "streams/2", Value: utils.MustMarshal(&pb.StreamInfo{StreamID: 2})},
+			{Key: "streams/3", Value: utils.MustMarshal(&pb.StreamInfo{StreamID: 3})},
+		},
+	}
+}
+
+func TestWriteRead(t *testing.T) {
+	backup := testBackup()
+	var buf bytes.Buffer
+	require.Nil(t, Write(&buf, backup))
+	data := buf.Bytes()
+
+	got, err := Read(bytes.NewReader(data))
+	require.Nil(t, err)
+	require.Equal(t, backup, got)
+
+	corrupted := append([]byte{}, data...)
+	corrupted[len(corrupted)-1] ^= 0xFF
+	_, err = Read(bytes.NewReader(corrupted))
+	require.Equal(t, ErrChecksum, err)
+
+	_, err = Read(bytes.NewReader(data[:len(data)-1]))
+	require.NotNil(t, err)
+}
+
+func TestValidate(t *testing.T) {
+	backup := testBackup()
+	require.Nil(t, Validate(backup))
+
+	//remove extents/4 and PART/5
+	backup.Kvs = append(backup.Kvs[:1], backup.Kvs[3:]...)
+	err := Validate(backup)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "2 problems")
+	require.Contains(t, err.Error(), "extent 4 of stream 1 does not exist")
+	require.Contains(t, err.Error(), "partition 5 of regions does not exist")
+
+	backup = testBackup()
+	backup.Kvs = append(backup.Kvs, &pb.MetaKV{Key: "snapshots/6", Value: utils.MustMarshal(&pspb.PartitionSnapshot{
+		SnapshotID: 6, PartID: 5, LogStream: 1, RowStream: 2, MetaStream: 7,
+	})})
+	err = Validate(backup)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "stream 7 of snapshot 6 does not exist")
+}
+
+func TestCompare(t *testing.T) {
+	backup := []*pb.MetaKV{
+		{Key: "a", Value: []byte("1")},
+		{Key: "b", Value: []byte("2")},
+		{Key: "d", Value: []byte("4")},
+	}
+	live := []*pb.MetaKV{
+		{Key: "b", Value: []byte("3")},
+		{Key: "c", Value: []byte("3")},
+		{Key: "d", Value: []byte("4")},
+		{Key: "e", Value: []byte("5")},
+	}
+	d := compare(backup, live)
+	require.Equal(t, []string{"a"}, d.Added)
+	require.Equal(t, []string{"c", "e"}, d.Removed)
+	require.Equal(t, []string{"b"}, d.Changed)
+	require.True(t, compare(backup, backup).Empty())
+}
+
+func TestRestoreOverNewerAllocations(t *testing.T) {
+	id := func(n uint64) []byte {
+		var buf [8]byte
+		binary.BigEndian.PutUint64(buf[:], n)
+		return buf[:]
+	}
+	backup := testBackup()
+	backup.Kvs = append([]*pb.MetaKV{
+		{Key: "AutumnPMIDKey", Value: id(8)},
+	}, backup.Kvs...)
+	backup.Kvs = append(backup.Kvs, &pb.MetaKV{Key: "clusterID", Value: id(1)})
+
+	//ids and streams are allocated after the backup
+	live := []*pb.MetaKV{
+		{Key: "AutumnPMIDKey", Value: id(3)},
+		{Key: "AutumnSMIDKey", Value: id(20)},
+		{Key: "clusterID", Value: id(2)},
+		{Key: "streams/1", Value: utils.MustMarshal(&pb.StreamInfo{StreamID: 1, ExtentIDs: []uint64{4}})},
+		{Key: "streams/15", Value: utils.MustMarshal(&pb.StreamInfo{StreamID: 15})},
+	}
+
+	target := restored(backup.Kvs, live)
+	values := make(map[string][]byte)
+	for _, kv := range target {
+		values[kv.Key] = kv.Value
+	}
+	require.Equal(t, id(8), values["AutumnPMIDKey"])
+	require.Equal(t, id(20), values["AutumnSMIDKey"])
+	require.Equal(t, id(2), values["clusterID"])
+	require.Equal(t, len(backup.Kvs), len(target))
+
+	d := compare(target, live)
+	require.Equal(t, []string{"PART/5", "extents/4", "regions/config", "streams/2", "streams/3"}, d.Added)
+	require.Equal(t, []string{"streams/15"}, d.Removed)
+	require.Equal(t, []string{"AutumnPMIDKey"}, d.Changed)
+
+	//clusterID of backup is not written to a cluster without it
+	target = restored(backup.Kvs, nil)
+	require.Equal(t, len(backup.Kvs)-1, len(target))
+	require.False(t, contains(target, "clusterID"))
+}
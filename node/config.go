@@ -3,8 +3,10 @@ package node
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/BurntSushi/toml"
+	"github.com/journeymidnight/autumn/extent/wal"
 	"github.com/journeymidnight/autumn/utils"
 	"github.com/pkg/errors"
 	"github.com/urfave/cli/v2"
@@ -14,10 +16,48 @@ type Config struct {
 	ID           uint64
 	ListenURL    string
 	Dirs         []string
-	WalDir       string
+	WalDirs      []string
+	WalDir       string //same as WalDirs with one dir, for old config files
 	SmURLs       []string
 	EtcdURLs     []string
 	TraceSampler float64
+	//0 is default, negative value disables auto-offline or probing
+	DiskErrorThreshold int
+	DiskErrorWindow    int //seconds
+	DiskProbeInterval  int //seconds
+	//0 is default
+	WalSize         int64 //bytes, rotate a wal file when it is bigger
+	WalSyncDelay    int   //microseconds, wait so long for more writes in one sync
+	WalMaxEntrySize int   //bytes, bigger writes bypass wal
+}
+
+//WalOptions returns options of wal, wal is disabled if it has no dirs
+func (c *Config) WalOptions() wal.Options {
+	opt := wal.Options{
+		Dirs:         c.WalDirs,
+		MaxWalSize:   c.WalSize,
+		SyncDelay:    time.Duration(c.WalSyncDelay) * time.Microsecond,
+		MaxEntrySize: c.WalMaxEntrySize,
+	}
+	if len(opt.Dirs) == 0 && len(c.WalDir) > 0 {
+		opt.Dirs = []string{c.WalDir}
+	}
+	return opt
+}
+
+//DiskFailurePolicy returns DefaultDiskFailurePolicy overridden by config
+func (c *Config) DiskFailurePolicy() DiskFailurePolicy {
+	policy := DefaultDiskFailurePolicy
+	if c.DiskErrorThreshold != 0 {
+		policy.ErrorThreshold = utils.Max(c.DiskErrorThreshold, 0)
+	}
+	if c.DiskErrorWindow > 0 {
+		policy.ErrorWindow = time.Duration(c.DiskErrorWindow) * time.Second
+	}
+	if c.DiskProbeInterval != 0 {
+		policy.ProbeInterval = time.Duration(utils.Max(c.DiskProbeInterval, 0)) * time.Second
+	}
+	return policy
 }
 
 func NewConfig() (*Config, error) {
@@ -29,6 +69,7 @@ func NewConfig() (*Config, error) {
 	var config Config
 	var configFile string
 	var dirSlice string
+	var walDirSlice string
 	var smURLsSlice string
 	var etcdURLsSlice string
 
@@ -55,7 +96,23 @@ func NewConfig() (*Config, error) {
 		},
 		&cli.StringFlag{
 			Name:        "waldir",
-			Destination: &config.WalDir,
+			Usage:       "wal dirs, wal is striped over them",
+			Destination: &walDirSlice,
+		},
+		&cli.Int64Flag{
+			Name:        "wal-size",
+			Usage:       "bytes, rotate a wal file when it is bigger",
+			Destination: &config.WalSize,
+		},
+		&cli.IntFlag{
+			Name:        "wal-sync-delay",
+			Usage:       "microseconds, wait so long for more writes in one sync",
+			Destination: &config.WalSyncDelay,
+		},
+		&cli.IntFlag{
+			Name:        "wal-max-entry-size",
+			Usage:       "bytes, bigger writes bypass wal",
+			Destination: &config.WalMaxEntrySize,
 		},
 		&cli.StringFlag{
 			Name:        "etcd-urls",
@@ -69,6 +126,21 @@ func NewConfig() (*Config, error) {
 			Name:        "trace-sampler",
 			Destination: &config.TraceSampler,
 		},
+		&cli.IntFlag{
+			Name:        "disk-error-threshold",
+			Usage:       "set disk offline if it has so many errors in disk-error-window",
+			Destination: &config.DiskErrorThreshold,
+		},
+		&cli.IntFlag{
+			Name:        "disk-error-window",
+			Usage:       "seconds",
+			Destination: &config.DiskErrorWindow,
+		},
+		&cli.IntFlag{
+			Name:        "disk-probe-interval",
+			Usage:       "seconds",
+			Destination: &config.DiskProbeInterval,
+		},
 	}
 
 	app := &cli.App{
@@ -92,6 +164,7 @@ func NewConfig() (*Config, error) {
 		fmt.Printf("node %d reading config file %s\n", config.ID, configFile)
 	} else {
 		config.Dirs = utils.SplitAndTrim(dirSlice, ",")
+		config.WalDirs = utils.SplitAndTrim(walDirSlice, ",")
 		config.SmURLs = utils.SplitAndTrim(smURLsSlice, ",")
 		config.EtcdURLs = utils.SplitAndTrim(etcdURLsSlice, ",")
 	}
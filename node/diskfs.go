@@ -1,15 +1,19 @@
 package node
 
 import (
+	"bytes"
 	"encoding/binary"
 	"fmt"
 	"io/ioutil"
+	"math/rand"
 	"os"
 	"path"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/dgryski/go-farm"
 	"github.com/journeymidnight/autumn/extent"
@@ -21,6 +25,11 @@ import (
 
 const (
 	diskLevel = 1
+	//disk is not loaded as online if this file exists
+	offlineFlag = "offline"
+	probeFile   = "probe"
+	//orphan files are moved here before they are unlinked
+	quarantineDir = "quarantine"
 )
 
 type diskFS struct {
@@ -28,8 +37,14 @@ type diskFS struct {
 	baseFd  *os.File
 	diskID  uint64
 	online  uint32 //atomic value
+	full    uint32 //atomic value, no extent is allocated on a full disk
 	total   uint64
 	free    uint64
+
+	errLock   sync.Mutex
+	errTimes  []time.Time //time of recent errors
+	lastError error
+	probing   int32 //atomic, a probe is running
 }
 
 func OpenDiskFS(dir string, nodeID uint64) (*diskFS, error) {
@@ -66,6 +81,10 @@ func OpenDiskFS(dir string, nodeID uint64) (*diskFS, error) {
 	}
 	s.diskID = diskID
 	s.online = 1
+	if _, err = os.Stat(filepath.Join(dir, offlineFlag)); err == nil {
+		xlog.Logger.Warnf("disk %d on %s was set offline", diskID, dir)
+		s.online = 0
+	}
 	return s, nil
 }
 
@@ -82,13 +101,80 @@ func (s *diskFS) Df() (uint64, uint64, error) {
 
 func (s *diskFS) SetOffline() {
 	atomic.StoreUint32(&s.online, 0)
-	//FIXME: write flag on disk. bad sector or bad file?
+	//disk may be broken, writing flag is best-effort
+	ioutil.WriteFile(filepath.Join(s.baseDir, offlineFlag), []byte(time.Now().String()), 0644)
+}
+
+//RecordError records an error of disk at now, returns the number of errors in the last window
+func (s *diskFS) RecordError(err error, now time.Time, window time.Duration) int {
+	s.errLock.Lock()
+	defer s.errLock.Unlock()
+	i := 0
+	for i < len(s.errTimes) && now.Sub(s.errTimes[i]) > window {
+		i++
+	}
+	s.errTimes = append(s.errTimes[i:], now)
+	s.lastError = err
+	return len(s.errTimes)
+}
+
+//Errors returns the number of errors in the last window and the last error
+func (s *diskFS) Errors(now time.Time, window time.Duration) (int, error) {
+	s.errLock.Lock()
+	defer s.errLock.Unlock()
+	n := 0
+	for _, t := range s.errTimes {
+		if now.Sub(t) <= window {
+			n++
+		}
+	}
+	return n, s.lastError
+}
+
+//Probe writes, syncs and reads back a small file to check if disk works
+func (s *diskFS) Probe() error {
+	fpath := filepath.Join(s.baseDir, probeFile)
+	data := make([]byte, 4096)
+	rand.Read(data)
+	f, err := os.OpenFile(fpath, os.O_CREATE|os.O_TRUNC|os.O_RDWR, 0644)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(fpath)
+	defer f.Close()
+	if _, err = f.Write(data); err != nil {
+		return err
+	}
+	if err = f.Sync(); err != nil {
+		return err
+	}
+	buf := make([]byte, len(data))
+	if _, err = f.ReadAt(buf, 0); err != nil {
+		return err
+	}
+	if !bytes.Equal(data, buf) {
+		return errors.Errorf("probe data on disk %d is corrupted", s.diskID)
+	}
+	return nil
 }
 
 func (s *diskFS) Online() bool {
 	return atomic.LoadUint32(&s.online) == 1
 }
 
+//SetFull returns true if full changes the state of disk
+func (s *diskFS) SetFull(full bool) bool {
+	var v uint32
+	if full {
+		v = 1
+	}
+	return atomic.SwapUint32(&s.full, v) != v
+}
+
+func (s *diskFS) Full() bool {
+	return atomic.LoadUint32(&s.full) == 1
+}
+
 func (s *diskFS) pathName(extentID uint64, suffix string) string {
 	var buf [8]byte
 	binary.BigEndian.PutUint64(buf[:], extentID)
@@ -107,7 +193,7 @@ func (s *diskFS) pathName(extentID uint64, suffix string) string {
 
 func (s *diskFS) RemoveExtent(extentID uint64) error {
 	fileName := s.pathName(extentID, "ext")
-	return os.Remove(fileName)
+	return extent.RemoveExtentFile(fileName)
 }
 
 func (s *diskFS) AllocCopyExtent(extentID uint64, ReplaceNodeID uint64) (string, error) {
@@ -116,6 +202,19 @@ func (s *diskFS) AllocCopyExtent(extentID uint64, ReplaceNodeID uint64) (string,
 	return extent.CreateCopyExtent(fpath, extentID)
 }
 
+//AllocFragment creates a fragment file of EC conversion, it is not loaded on startup
+//until CommitFragment renames it to an extent
+func (s *diskFS) AllocFragment(ID uint64) (*extent.Extent, error) {
+	fpath := s.pathName(ID, "frag")
+	extent.RemoveExtentFile(fpath) //remove fragment of a failed conversion
+	return extent.CreateExtent(fpath, ID)
+}
+
+func (s *diskFS) CommitFragment(ID uint64) (string, error) {
+	fpath := s.pathName(ID, "ext")
+	return fpath, extent.RenameExtentFile(s.pathName(ID, "frag"), fpath)
+}
+
 func (s *diskFS) AllocExtent(ID uint64) (*extent.Extent, error) {
 	fpath := s.pathName(ID, "ext")
 	ex, err := extent.CreateExtent(fpath, ID)
@@ -125,13 +224,82 @@ func (s *diskFS) AllocExtent(ID uint64) (*extent.Extent, error) {
 	return ex, nil
 }
 
+//renamedName returns the extent file which copy or fragment fileName is renamed to
+func renamedName(fileName string) (string, bool) {
+	base := filepath.Base(fileName)
+	var extentID uint64
+	var err error
+	if strings.HasSuffix(base, ".copy") {
+		extentID, _, err = parseCopyName(base)
+	} else if strings.HasSuffix(base, ".frag") {
+		extentID, err = strconv.ParseUint(strings.TrimSuffix(base, ".frag"), 10, 64)
+	} else {
+		return "", false
+	}
+	if err != nil {
+		return "", false
+	}
+	return filepath.Join(filepath.Dir(fileName), fmt.Sprintf("%d.ext", extentID)), true
+}
+
+//cleanSidecars removes temporary sidecars and sidecars left by interrupted RenameExtentFile
+//or RemoveExtentFile, it runs before extents are opened
+func (s *diskFS) cleanSidecars() error {
+	var sidecars []string
+	err := filepath.Walk(s.baseDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if path == filepath.Join(s.baseDir, quarantineDir) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if extent.IsTempMetaFile(path) {
+			xlog.Logger.Infof("remove temporary sidecar %s", path)
+			return os.Remove(path)
+		}
+		if _, ok := extent.ExtentFileName(path); ok {
+			sidecars = append(sidecars, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, path := range sidecars {
+		fileName, _ := extent.ExtentFileName(path)
+		newName, ok := renamedName(fileName)
+		if !ok {
+			//RemoveExtentFile is interrupted, nothing is renamed to an extent
+			newName = fileName
+		}
+		fixed, err := extent.FinishRename(fileName, newName)
+		if err != nil {
+			return err
+		}
+		if fixed {
+			xlog.Logger.Infof("sidecar %s is left by an interrupted rename or remove, fixed", path)
+		}
+	}
+	return nil
+}
+
 func (s *diskFS) LoadExtents(normalExt func(string, uint64), copyExt func(string, uint64)) {
+	if err := s.cleanSidecars(); err != nil {
+		xlog.Logger.Errorf("clean sidecars on disk %d: %v", s.diskID, err)
+	}
+	var fragments []string
 	//walk all exts files
 	err := filepath.Walk(s.baseDir, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			xlog.Logger.Fatal(err)
 		}
 		if info.IsDir() {
+			if path == filepath.Join(s.baseDir, quarantineDir) {
+				return filepath.SkipDir
+			}
 			return nil
 		}
 		if strings.HasSuffix(info.Name(), ".ext") {
@@ -140,19 +308,143 @@ func (s *diskFS) LoadExtents(normalExt func(string, uint64), copyExt func(string
 		} else if strings.HasSuffix(info.Name(), ".copy") {
 			copyExt(path, s.diskID)
 			return nil
+		} else if strings.HasSuffix(info.Name(), ".frag") {
+			fragments = append(fragments, path)
+			return nil
 		}
 		return nil
 	})
 	if err != nil {
 		xlog.Logger.Errorf(err.Error())
 	}
+	//WriteFragment was interrupted, the conversion is retried by stream manager
+	for _, path := range fragments {
+		xlog.Logger.Infof("remove unfinished fragment %s", path)
+		if err := extent.RemoveExtentFile(path); err != nil {
+			xlog.Logger.Warnf("remove fragment %s: %v", path, err)
+		}
+	}
+}
+
+//MigrateExtents converts extents and copies on disk dir from format v1 to v2 in place, the node
+//must be stopped. it returns the number of migrated files
+func MigrateExtents(dir string) (int, error) {
+	n := 0
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if path == filepath.Join(dir, quarantineDir) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(info.Name(), ".ext") && !strings.HasSuffix(info.Name(), ".copy") {
+			return nil
+		}
+		migrated, err := extent.MigrateExtent(path)
+		if err != nil {
+			return errors.Wrapf(err, "migrate %s", path)
+		}
+		if migrated {
+			n++
+		}
+		return nil
+	})
+	return n, err
+}
+
+//parseCopyName parses the name of copy file: extentID.replaceID.copy
+func parseCopyName(name string) (uint64, uint64, error) {
+	parts := strings.Split(name, ".")
+	if len(parts) != 3 || parts[2] != "copy" {
+		return 0, 0, errors.Errorf("found extent %s: can not parse replaceID", name)
+	}
+	extentID, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	replaceID, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	return extentID, replaceID, nil
+}
+
+//ListCopies calls fn on every copy file of recovery tasks
+func (s *diskFS) ListCopies(fn func(path string, extentID uint64, replaceID uint64, info os.FileInfo)) error {
+	return filepath.Walk(s.baseDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if path == filepath.Join(s.baseDir, quarantineDir) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(info.Name(), ".copy") {
+			return nil
+		}
+		extentID, replaceID, err := parseCopyName(info.Name())
+		if err != nil {
+			xlog.Logger.Warnf(err.Error())
+			return nil
+		}
+		fn(path, extentID, replaceID, info)
+		return nil
+	})
+}
+
+//Quarantine moves the file to quarantine dir, the time of quarantine is appended to its name
+func (s *diskFS) Quarantine(fpath string, now time.Time) error {
+	dir := filepath.Join(s.baseDir, quarantineDir)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	//sidecar of extent format v2 is quarantined as "<name>.meta.<time>"
+	metaPath := extent.MetaFileName(fpath)
+	err := os.Rename(metaPath, filepath.Join(dir, fmt.Sprintf("%s.%d", filepath.Base(metaPath), now.Unix())))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return os.Rename(fpath, filepath.Join(dir, fmt.Sprintf("%s.%d", filepath.Base(fpath), now.Unix())))
+}
+
+//PurgeQuarantine unlinks files quarantined before now-period, it returns the number of them
+func (s *diskFS) PurgeQuarantine(now time.Time, period time.Duration) (int, error) {
+	dir := filepath.Join(s.baseDir, quarantineDir)
+	files, err := ioutil.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+	n := 0
+	for _, f := range files {
+		name := f.Name()
+		t, err := strconv.ParseInt(name[strings.LastIndex(name, ".")+1:], 10, 64)
+		if err != nil {
+			xlog.Logger.Warnf("unknown file %s in quarantine", name)
+			continue
+		}
+		if now.Sub(time.Unix(t, 0)) < period {
+			continue
+		}
+		if err = os.Remove(filepath.Join(dir, name)); err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, nil
 }
 
 func (s *diskFS) Syncfs() {
 	syncfs(s.baseFd.Fd())
 }
 
-func (s diskFS) Close() {
+func (s *diskFS) Close() {
 	s.baseFd.Close()
 }
 
@@ -191,5 +483,6 @@ func FormatDisk(dir string) (string, error) {
 		return "", err
 	}
 	f.Close()
+	os.Remove(path.Join(dir, offlineFlag))
 	return us, nil
 }
@@ -1,9 +1,19 @@
 package node
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"syscall"
 	"testing"
+	"time"
 
+	"github.com/journeymidnight/autumn/extent"
+	"github.com/journeymidnight/autumn/proto/pb"
+	pkgerrors "github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 )
 
@@ -29,4 +39,252 @@ func TestPathName(t *testing.T) {
 	x := disk.pathName(28, "ext")
 	fmt.Printf(x)
 	require.Equal(t, "/68/28.ext", x)
-}
\ No newline at end of file
+}
+func TestDiskErrors(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "disktest")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dir, "node_id"), []byte("100"), 0644))
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dir, "disk_id"), []byte("5"), 0644))
+
+	disk, err := OpenDiskFS(dir, 100)
+	require.Nil(t, err)
+	require.True(t, disk.Online())
+	require.Nil(t, disk.Probe())
+
+	//errors out of window are dropped
+	now := time.Now()
+	require.Equal(t, 1, disk.RecordError(errors.New("e1"), now, time.Minute))
+	require.Equal(t, 2, disk.RecordError(errors.New("e2"), now.Add(30*time.Second), time.Minute))
+	require.Equal(t, 2, disk.RecordError(errors.New("e3"), now.Add(80*time.Second), time.Minute))
+	n, lastErr := disk.Errors(now.Add(100*time.Second), time.Minute)
+	require.Equal(t, 1, n)
+	require.Equal(t, "e3", lastErr.Error())
+
+	//offline disk is still offline after reopen
+	en := &ExtentNode{diskFSs: map[uint64]*diskFS{5: disk}, diskPolicy: DiskFailurePolicy{ErrorThreshold: 2, ErrorWindow: time.Minute}}
+	en.diskError(5, errors.New("not an I/O error"))
+	require.True(t, disk.Online())
+	_, err = os.Open(filepath.Join(dir, "no_such_file"))
+	en.diskError(5, err)
+	require.True(t, disk.Online())
+	err = &os.PathError{Op: "write", Path: filepath.Join(dir, "extent"), Err: syscall.EIO}
+	en.diskError(5, err)
+	en.diskError(5, err)
+	require.False(t, disk.Online())
+	disk.Close()
+
+	disk, err = OpenDiskFS(dir, 100)
+	require.Nil(t, err)
+	require.False(t, disk.Online())
+	disk.Close()
+}
+
+func TestDiskErrorClass(t *testing.T) {
+	for _, err := range []error{
+		syscall.EIO,
+		&os.PathError{Op: "write", Path: "f", Err: syscall.EIO},
+		&os.SyscallError{Syscall: "fsync", Err: syscall.EROFS},
+		pkgerrors.Wrap(&os.PathError{Op: "open", Path: "f", Err: syscall.ENXIO}, "alloc"),
+	} {
+		require.True(t, isDiskError(err), "%v", err)
+		require.False(t, isDiskFull(err), "%v", err)
+	}
+	for _, err := range []error{
+		&os.PathError{Op: "write", Path: "f", Err: syscall.ENOSPC},
+		pkgerrors.Wrap(&os.PathError{Op: "write", Path: "f", Err: syscall.EDQUOT}, "append"),
+	} {
+		require.False(t, isDiskError(err), "%v", err)
+		require.True(t, isDiskFull(err), "%v", err)
+	}
+	for _, err := range []error{
+		nil,
+		errors.New("not an I/O error"),
+		&os.PathError{Op: "open", Path: "f", Err: syscall.ENOENT},
+		&os.PathError{Op: "open", Path: "f", Err: syscall.EEXIST},
+	} {
+		require.False(t, isDiskError(err), "%v", err)
+		require.False(t, isDiskFull(err), "%v", err)
+	}
+}
+
+func TestDiskFull(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "disktest")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dir, "node_id"), []byte("100"), 0644))
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dir, "disk_id"), []byte("5"), 0644))
+
+	disk, err := OpenDiskFS(dir, 100)
+	require.Nil(t, err)
+	defer disk.Close()
+	en := &ExtentNode{diskFSs: map[uint64]*diskFS{5: disk}, diskPolicy: DiskFailurePolicy{ErrorThreshold: 2, ErrorWindow: time.Minute, FullReserve: 1 << 62}}
+
+	//a full disk is not offline, but no extent is allocated on it
+	for i := 0; i < 5; i++ {
+		en.diskError(5, &os.PathError{Op: "write", Path: "f", Err: syscall.ENOSPC})
+	}
+	require.True(t, disk.Online())
+	require.True(t, disk.Full())
+	n, _ := disk.Errors(time.Now(), time.Minute)
+	require.Equal(t, 0, n)
+	require.Equal(t, uint64(0), en.chooseDisktoAlloc())
+	res, err := en.Df(context.Background(), &pb.DfRequest{DiskIDs: []uint64{5}})
+	require.Nil(t, err)
+	require.True(t, res.DiskStatus[5].Online)
+	require.Equal(t, uint64(0), res.DiskStatus[5].Free)
+
+	//disk has FullReserve free bytes again
+	en.diskPolicy.FullReserve = 0
+	res, err = en.Df(context.Background(), &pb.DfRequest{DiskIDs: []uint64{5}})
+	require.Nil(t, err)
+	require.True(t, res.DiskStatus[5].Free > 0)
+	require.False(t, disk.Full())
+	require.Equal(t, uint64(5), en.chooseDisktoAlloc())
+}
+
+func TestQuarantine(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "disktest")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dir, "node_id"), []byte("100"), 0644))
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dir, "disk_id"), []byte("5"), 0644))
+	require.Nil(t, mkHashDir(dir, diskLevel))
+
+	disk, err := OpenDiskFS(dir, 100)
+	require.Nil(t, err)
+	defer disk.Close()
+
+	copyPath, err := disk.AllocCopyExtent(10, 3)
+	require.Nil(t, err)
+	var copies []uint64
+	require.Nil(t, disk.ListCopies(func(path string, extentID uint64, replaceID uint64, info os.FileInfo) {
+		require.Equal(t, copyPath, path)
+		copies = append(copies, extentID, replaceID)
+	}))
+	require.Equal(t, []uint64{10, 3}, copies)
+
+	//quarantined files are not loaded
+	now := time.Now()
+	require.Nil(t, disk.Quarantine(copyPath, now))
+	copies = nil
+	require.Nil(t, disk.ListCopies(func(path string, extentID uint64, replaceID uint64, info os.FileInfo) {
+		copies = append(copies, extentID)
+	}))
+	require.Nil(t, copies)
+
+	n, err := disk.PurgeQuarantine(now.Add(time.Hour), 2*time.Hour)
+	require.Nil(t, err)
+	require.Equal(t, 0, n)
+	//the copy and its sidecar
+	n, err = disk.PurgeQuarantine(now.Add(3*time.Hour), 2*time.Hour)
+	require.Nil(t, err)
+	require.Equal(t, 2, n)
+}
+
+func TestLoadExtentsRemovesFragments(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "disktest")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	_, err = FormatDisk(dir)
+	require.Nil(t, err)
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dir, "node_id"), []byte("100"), 0644))
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dir, "disk_id"), []byte("5"), 0644))
+
+	disk, err := OpenDiskFS(dir, 100)
+	require.Nil(t, err)
+	defer disk.Close()
+
+	ex, err := disk.AllocExtent(10)
+	require.Nil(t, err)
+	ex.Close()
+	//WriteFragment is interrupted
+	frag, err := disk.AllocFragment(11)
+	require.Nil(t, err)
+	frag.Close()
+
+	var loaded []string
+	disk.LoadExtents(func(path string, diskID uint64) {
+		loaded = append(loaded, path)
+	}, func(string, uint64) {})
+	require.Equal(t, []string{disk.pathName(10, "ext")}, loaded)
+	_, err = os.Stat(disk.pathName(11, "frag"))
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestLoadExtentsCleansSidecars(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "disktest")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	_, err = FormatDisk(dir)
+	require.Nil(t, err)
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dir, "node_id"), []byte("100"), 0644))
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dir, "disk_id"), []byte("5"), 0644))
+
+	disk, err := OpenDiskFS(dir, 100)
+	require.Nil(t, err)
+	defer disk.Close()
+
+	ex, err := disk.AllocExtent(10)
+	require.Nil(t, err)
+	ex.Close()
+	//writing the sidecar is interrupted
+	tmpName := extent.MetaFileName(disk.pathName(10, "ext")) + ".tmp"
+	require.Nil(t, ioutil.WriteFile(tmpName, []byte("x"), 0644))
+
+	//renaming the copy is interrupted after the extent file is renamed
+	copyName, err := disk.AllocCopyExtent(12, 3)
+	require.Nil(t, err)
+	require.Nil(t, os.Rename(copyName, disk.pathName(12, "ext")))
+
+	//removing the extent is interrupted after the extent file is removed
+	orphan := extent.MetaFileName(disk.pathName(13, "ext"))
+	require.Nil(t, os.MkdirAll(filepath.Dir(orphan), 0755))
+	require.Nil(t, ioutil.WriteFile(orphan, []byte("x"), 0644))
+
+	var loaded []string
+	disk.LoadExtents(func(path string, diskID uint64) {
+		loaded = append(loaded, path)
+	}, func(string, uint64) {})
+	require.ElementsMatch(t, []string{disk.pathName(10, "ext"), disk.pathName(12, "ext")}, loaded)
+
+	ex, err = extent.OpenExtent(disk.pathName(12, "ext"))
+	require.Nil(t, err)
+	require.Equal(t, extent.FormatV2, ex.Format())
+	ex.Close()
+	for _, name := range []string{tmpName, extent.MetaFileName(copyName), orphan} {
+		_, err = os.Stat(name)
+		require.True(t, os.IsNotExist(err), name)
+	}
+}
+
+func TestDfReport(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "disktest")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dir, "node_id"), []byte("100"), 0644))
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dir, "disk_id"), []byte("5"), 0644))
+
+	disk, err := OpenDiskFS(dir, 100)
+	require.Nil(t, err)
+	defer disk.Close()
+	en := &ExtentNode{diskFSs: map[uint64]*diskFS{5: disk}, diskPolicy: DiskFailurePolicy{ErrorThreshold: 2, ErrorWindow: time.Minute}}
+
+	//unknown disks are not reported
+	res, err := en.Df(context.Background(), &pb.DfRequest{DiskIDs: []uint64{5, 6}})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(res.DiskStatus))
+	require.True(t, res.DiskStatus[5].Online)
+	require.True(t, res.DiskStatus[5].Total > 0)
+
+	//a failed Df is an error of disk, the disk is still online
+	disk.baseDir = filepath.Join(dir, "no_such_dir")
+	res, err = en.Df(context.Background(), &pb.DfRequest{DiskIDs: []uint64{5}})
+	require.Nil(t, err)
+	require.True(t, res.DiskStatus[5].Online)
+	require.Equal(t, uint64(0), res.DiskStatus[5].Total)
+
+	res, err = en.Df(context.Background(), &pb.DfRequest{DiskIDs: []uint64{5}})
+	require.Nil(t, err)
+	require.False(t, res.DiskStatus[5].Online)
+}
@@ -19,8 +19,6 @@ import (
 	"fmt"
 	"net"
 	"path/filepath"
-	"strconv"
-	"strings"
 	"sync"
 
 	"github.com/grpc-ecosystem/grpc-opentracing/go/otgrpc"
@@ -28,6 +26,7 @@ import (
 	"github.com/journeymidnight/autumn/extent"
 	"github.com/journeymidnight/autumn/extent/wal"
 	smclient "github.com/journeymidnight/autumn/manager/smclient"
+	"github.com/journeymidnight/autumn/manager/stream_manager"
 	"github.com/journeymidnight/autumn/proto/pb"
 	"github.com/journeymidnight/autumn/utils"
 	"github.com/journeymidnight/autumn/xlog"
@@ -57,17 +56,24 @@ type ExtentNode struct {
 	smClient        *smclient.SMClient
 	em              *smclient.ExtentManager
 	recoveryTaskNum int32
+	convertTaskNum  int32
+	diskPolicy      DiskFailurePolicy
+	stopper         *utils.Stopper
+	copyFiles       sync.Map //copy file path of running recovery tasks
+	reconciling     int32    //atomic, a reconciliation is running
 }
 
-func NewExtentNode(nodeID uint64, diskDirs []string, walDir string, listenUrl string, smURLs []string, etcdURLs []string) *ExtentNode {
+func NewExtentNode(nodeID uint64, diskDirs []string, walOpt wal.Options, listenUrl string, smURLs []string, etcdURLs []string) *ExtentNode {
 	utils.AssertTrue(xlog.Logger != nil)
 
 	en := &ExtentNode{
-		extentMap: new(sync.Map),
-		listenUrl: listenUrl,
-		smClient:  smclient.NewSMClient(smURLs),
-		nodeID:    nodeID,
-		diskFSs:   make(map[uint64]*diskFS),
+		extentMap:  new(sync.Map),
+		listenUrl:  listenUrl,
+		smClient:   smclient.NewSMClient(smURLs),
+		nodeID:     nodeID,
+		diskFSs:    make(map[uint64]*diskFS),
+		diskPolicy: DefaultDiskFailurePolicy,
+		stopper:    utils.NewStopper(),
 	}
 	if err := en.smClient.Connect(); err != nil {
 		xlog.Logger.Fatal(err)
@@ -96,10 +102,10 @@ func NewExtentNode(nodeID uint64, diskDirs []string, walDir string, listenUrl st
 	}
 
 	//load wal
-	if len(walDir) > 0 {
-		wal, err := wal.OpenWal(walDir, en.SyncFs)
+	if len(walOpt.Dirs) > 0 {
+		wal, err := wal.OpenWal(walOpt, en.SyncFs)
 		if err != nil {
-			xlog.Logger.Warnf("can not open waldir :%s", walDir)
+			xlog.Logger.Warnf("can not open waldir %v: %v", walOpt.Dirs, err)
 		} else {
 			en.wal = wal
 		}
@@ -116,7 +122,7 @@ func (en *ExtentNode) extentInfoUpdatedfunc(eventType string, cur *pb.ExtentInfo
 			if ex != nil && ex.IsSeal() == false {
 				xlog.Logger.Infof("SEAL extent %d", cur.ExtentID)
 				ex.Lock()
-				if err := ex.Seal(uint32(cur.SealedLength)); err != nil {
+				if err := ex.Seal(uint32(stream_manager.SealedFileLength(cur))); err != nil {
 					//if error happend, wait for manager send a ReAvali msg to recovery data
 					xlog.Logger.Errorf(err.Error())
 				}
@@ -124,6 +130,7 @@ func (en *ExtentNode) extentInfoUpdatedfunc(eventType string, cur *pb.ExtentInfo
 			}
 
 		}
+		en.removeConvertedReplicate(cur)
 	case "DELETE":
 		//FIXME, TODO
 		ex := en.getExtent(prev.ExtentID)
@@ -184,19 +191,8 @@ func (en *ExtentNode) LoadExtents() error {
 		//extentID.replaceID.copy
 		//get basename of path
 		fmt.Println("register copy")
-		parts := strings.Split(filepath.Base(path), ".")
-		if len(parts) != 3 {
-			xlog.Logger.Errorf("found extent %s: can not parse replaceID", path)
-			return
-		}
 		//restore task from filename
-		extentID, err := strconv.ParseUint(parts[0], 10, 64)
-		if err != nil {
-			xlog.Logger.Error(err)
-			return
-		}
-
-		replaceID, err := strconv.ParseUint(parts[1], 10, 64)
+		extentID, replaceID, err := parseCopyName(filepath.Base(path))
 		if err != nil {
 			xlog.Logger.Error(err)
 			return
@@ -225,35 +221,64 @@ func (en *ExtentNode) LoadExtents() error {
 	}
 	wg.Wait()
 
-	//read the wal, and replay writes to extents
-	replay := func(ID uint64, start uint32, rev int64, data [][]byte) {
-		ex := en.getExtent(ID)
-		if ex == nil {
-			xlog.Logger.Warnf("extentID %d not exist", ID)
-			return
-		}
-		err := ex.RecoveryData(start, rev, data)
-		if err != nil {
-			xlog.Logger.Errorf("replay extent %d failed: %v, disk failure?", ID, err)
-		}
-		//ex.CommitLength()
-	}
-
 	//replay en.wal to recovery
 	if en.wal != nil {
-		en.wal.Replay(replay)
+		if err := en.wal.Replay(en.walReplayer()); err != nil {
+			xlog.Logger.Errorf("replay wal failed: %v", err)
+		}
 	}
 
 	en.extentMap.Range(func(k, v interface{}) bool {
 		ex := v.(*ExtentOnDisk)
 		ex.ResetWriter()
+		//the event of conversion could be missed when node is down
+		if exInfo := en.em.GetExtentInfo(ex.ID); exInfo != nil {
+			en.removeConvertedReplicate(exInfo)
+		}
 		return true
 	})
 
 	return nil
 }
 
+//walReplayer returns the callback to replay writes of wal to extents, an entry is applied
+//only if its extent still exists on sm, the entry is written by the latest owner of the extent,
+//and it does not leave a hole in the extent
+func (en *ExtentNode) walReplayer() func(uint64, uint32, int64, [][]byte) {
+	deleted := make(map[uint64]bool)
+	return func(ID uint64, start uint32, rev int64, data [][]byte) {
+		ex := en.getExtent(ID)
+		if ex == nil {
+			xlog.Logger.Warnf("extentID %d not exist", ID)
+			return
+		}
+		if _, ok := deleted[ID]; !ok {
+			deleted[ID] = en.em.Latest(ID) == nil
+		}
+		if deleted[ID] {
+			xlog.Logger.Warnf("extent %d is deleted, skip its wal", ID)
+			return
+		}
+		if ex.IsSeal() {
+			return
+		}
+		if rev < ex.Revision() {
+			xlog.Logger.Warnf("extent %d is written by revision %d, skip wal of revision %d", ID, ex.Revision(), rev)
+			return
+		}
+		if start > ex.CommitLength() {
+			xlog.Logger.Warnf("extent %d: wal from %d is after its end %d, skip", ID, start, ex.CommitLength())
+			return
+		}
+		err := ex.RecoveryData(start, rev, data)
+		if err != nil {
+			xlog.Logger.Errorf("replay extent %d failed: %v, disk failure?", ID, err)
+		}
+	}
+}
+
 func (en *ExtentNode) Shutdown() {
+	en.stopper.Stop()
 	en.grcpServer.Stop()
 	//loop over all extent to close
 
@@ -302,6 +327,8 @@ func (en *ExtentNode) ServeGRPC(traceSampler float64) error {
 		utils.Check(grpcServer.Serve(listener))
 	}()
 	en.grcpServer = grpcServer
+	en.stopper.RunWorker(en.routineProbeDisks)
+	en.stopper.RunWorker(en.routineReconcile)
 
 	return nil
 }
@@ -315,7 +342,7 @@ func (en *ExtentNode) AppendWithWal(ex *extent.Extent, rev int64, blocks [][]byt
 
 	if mustSync == false {
 		return ex.AppendBlocks(blocks, false)
-	} else if en.wal == nil || utils.SizeOfBlocks(blocks) > (2<<20) {
+	} else if en.wal == nil || en.wal.ShouldBypass(int(utils.SizeOfBlocks(blocks))) {
 		//force sync write
 		return ex.AppendBlocks(blocks, true)
 	}
@@ -346,10 +373,15 @@ func (en *ExtentNode) AppendWithWal(ex *extent.Extent, rev int64, blocks [][]byt
 		close(errC)
 	}()
 
+	//wait for both tasks, blocks could be reused after return
+	var firstErr error
 	for err := range errC {
-		if err != nil {
-			return nil, 0, err
+		if err != nil && firstErr == nil {
+			firstErr = err
 		}
 	}
+	if firstErr != nil {
+		return nil, 0, firstErr
+	}
 	return offsets, end, nil
 }
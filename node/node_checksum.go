@@ -0,0 +1,86 @@
+package node
+
+import (
+	"context"
+	"io"
+
+	"github.com/journeymidnight/autumn/manager/stream_manager"
+	"github.com/journeymidnight/autumn/proto/pb"
+	"github.com/journeymidnight/autumn/utils"
+	"github.com/journeymidnight/autumn/wire_errors"
+	"github.com/pkg/errors"
+)
+
+var errChecksumMismatch = errors.New("checksum mismatch")
+
+//verifyChecksum returns errChecksumMismatch if checksum is different from the recorded one,
+//copies without recorded checksum are not verified
+func verifyChecksum(exInfo *pb.ExtentInfo, i int, checksum uint32) error {
+	expected, ok := stream_manager.RecordedChecksum(exInfo, i)
+	if ok && expected != checksum {
+		return errors.Wrapf(errChecksumMismatch, "copy %d of extent %d, expected %x, got %x", i, exInfo.ExtentID, expected, checksum)
+	}
+	return nil
+}
+
+//checksumWriter computes the checksum of data written to w
+type checksumWriter struct {
+	w   io.Writer
+	crc utils.CRC
+}
+
+func (cw *checksumWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.crc = cw.crc.Update(p[:n])
+	return n, err
+}
+
+func (cw *checksumWriter) Checksum() uint32 {
+	return cw.crc.Value()
+}
+
+func (en *ExtentNode) ExtentChecksum(ctx context.Context, req *pb.ExtentChecksumRequest) (*pb.ExtentChecksumResponse, error) {
+	errDone := func(err error) (*pb.ExtentChecksumResponse, error) {
+		code, desCode := wire_errors.ConvertToPBCode(err)
+		return &pb.ExtentChecksumResponse{
+			Code:    code,
+			CodeDes: desCode,
+		}, nil
+	}
+
+	exInfo := en.em.WaitVersion(req.ExtentID, req.Eversion)
+	if exInfo == nil || exInfo.Avali == 0 {
+		return errDone(errors.Errorf("extent %d is not sealed", req.ExtentID))
+	}
+	ex := en.getExtent(req.ExtentID)
+	if ex == nil {
+		return errDone(errors.Errorf("node %d do not have extent %d", en.nodeID, req.ExtentID))
+	}
+	if !en.diskOnline(ex.diskID) {
+		return errDone(errors.Errorf("disk %d of extent %d is offline", ex.diskID, req.ExtentID))
+	}
+
+	//if Seal message delayed, Seal it now.
+	if !ex.IsSeal() {
+		ex.Lock()
+		ex.Seal(uint32(stream_manager.SealedFileLength(exInfo)))
+		ex.Unlock()
+	}
+
+	var checksum uint32
+	var err error
+	if req.Cached {
+		checksum, err = ex.Checksum()
+	} else {
+		checksum, err = ex.ComputeChecksum()
+	}
+	if err != nil {
+		en.diskError(ex.diskID, err)
+		return errDone(err)
+	}
+	return &pb.ExtentChecksumResponse{
+		Code:     pb.Code_OK,
+		Checksum: checksum,
+		Length:   ex.CommitLength(),
+	}, nil
+}
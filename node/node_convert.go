@@ -0,0 +1,348 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"sync/atomic"
+
+	"github.com/journeymidnight/autumn/conn"
+	"github.com/journeymidnight/autumn/erasure_code"
+	"github.com/journeymidnight/autumn/extent"
+	"github.com/journeymidnight/autumn/manager/stream_manager"
+	"github.com/journeymidnight/autumn/proto/pb"
+	"github.com/journeymidnight/autumn/utils"
+	"github.com/journeymidnight/autumn/wire_errors"
+	"github.com/journeymidnight/autumn/xlog"
+	"github.com/pkg/errors"
+)
+
+/*
+EC conversion of sealed replicated extents:
+sm asks a node which has the replicated extent to convert it, the extent is split into dataShard
+fragments, fragment i is [i*fragmentSize, (i+1)*fragmentSize) of the extent, the last one is padded
+by zero. parity fragments are encoded from data fragments, all fragments are sent to new nodes by
+WriteFragment. because fragments keep the offsets of blocks, readers could still read blocks at
+the same offsets after conversion
+*/
+
+const fragmentPayloadSize = 512 << 10
+
+//removeConvertedReplicate removes the old replicate of exInfo if the extent is converted to EC
+//and this node has no fragment of it
+func (en *ExtentNode) removeConvertedReplicate(exInfo *pb.ExtentInfo) {
+	if exInfo.FragmentSize == 0 || stream_manager.FindNodeIndex(exInfo, en.nodeID) != -1 {
+		return
+	}
+	if ex := en.getExtent(exInfo.ExtentID); ex != nil {
+		xlog.Logger.Infof("remove replicate of extent %d after conversion", exInfo.ExtentID)
+		en.RemoveExtent(ex)
+	}
+}
+
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 0
+	}
+	return len(p), nil
+}
+
+//fragmentWriter sends data to WriteFragment
+type fragmentWriter struct {
+	addr   string
+	stream pb.ExtentService_WriteFragmentClient
+}
+
+func openFragmentWriter(ctx context.Context, addr string, extentID uint64, size uint64) (*fragmentWriter, error) {
+	pool := conn.GetPools().Connect(addr)
+	if pool == nil {
+		return nil, errors.Errorf("can not connect to %s", addr)
+	}
+	stream, err := pb.NewExtentServiceClient(pool.Get()).WriteFragment(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = stream.Send(&pb.WriteFragmentRequest{
+		Data: &pb.WriteFragmentRequest_Header{
+			Header: &pb.WriteFragmentHeader{
+				ExtentID: extentID,
+				Size_:    size,
+			},
+		},
+	}); err != nil {
+		return nil, err
+	}
+	return &fragmentWriter{addr: addr, stream: stream}, nil
+}
+
+func (w *fragmentWriter) Write(p []byte) (int, error) {
+	n := 0
+	for n < len(p) {
+		size := utils.Min(len(p)-n, fragmentPayloadSize)
+		if err := w.stream.Send(&pb.WriteFragmentRequest{
+			Data: &pb.WriteFragmentRequest_Payload{
+				Payload: p[n : n+size],
+			},
+		}); err != nil {
+			return n, err
+		}
+		n += size
+	}
+	return n, nil
+}
+
+//Close returns the disk of fragment
+func (w *fragmentWriter) Close() (uint64, error) {
+	res, err := w.stream.CloseAndRecv()
+	if err != nil {
+		return 0, err
+	}
+	if res.Code != pb.Code_OK {
+		return 0, wire_errors.FromPBCode(res.Code, res.CodeDes)
+	}
+	return res.DiskID, nil
+}
+
+func (en *ExtentNode) ConvertExtent(ctx context.Context, req *pb.ConvertExtentRequest) (*pb.ConvertExtentResponse, error) {
+	errDone := func(err error) (*pb.ConvertExtentResponse, error) {
+		code, desCode := wire_errors.ConvertToPBCode(err)
+		return &pb.ConvertExtentResponse{
+			Code:    code,
+			CodeDes: desCode,
+		}, nil
+	}
+
+	if atomic.AddInt32(&en.convertTaskNum, 1) > MaxConcurrentTask {
+		atomic.AddInt32(&en.convertTaskNum, -1)
+		return errDone(errors.New("exceed MaxConcurrentTask, please wait..."))
+	}
+	defer atomic.AddInt32(&en.convertTaskNum, -1)
+
+	exInfo := en.em.WaitVersion(req.ExtentID, req.Eversion)
+	if exInfo.Avali == 0 || len(exInfo.Parity) > 0 || exInfo.FragmentSize > 0 || exInfo.SealedLength == 0 {
+		return errDone(errors.Errorf("extent %d is not a sealed replicated extent", req.ExtentID))
+	}
+	dataShard, parityShard := int(req.DataShard), int(req.ParityShard)
+	if dataShard < 2 || parityShard < 1 || len(req.Targets) != dataShard+parityShard {
+		return errDone(errors.Errorf("invalid conversion %d+%d to %v", dataShard, parityShard, req.Targets))
+	}
+	if err := erasure_code.ValidateCodec(req.Codec, dataShard, parityShard); err != nil {
+		return errDone(err)
+	}
+	ex := en.getExtent(req.ExtentID)
+	if ex == nil || !ex.IsSeal() || uint64(ex.CommitLength()) != exInfo.SealedLength {
+		return errDone(errors.Errorf("extent %d is not sealed on node %d", req.ExtentID, en.nodeID))
+	}
+
+	//do not spread corrupted data to fragments
+	checksum, err := ex.ComputeChecksum()
+	if err != nil {
+		return errDone(err)
+	}
+	if err = verifyChecksum(exInfo, stream_manager.FindNodeIndex(exInfo, en.nodeID), checksum); err != nil {
+		return errDone(err)
+	}
+
+	length := exInfo.SealedLength
+	fragmentSize := (length + uint64(dataShard) - 1) / uint64(dataShard)
+
+	cctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	writers := make([]*fragmentWriter, len(req.Targets))
+	checksums := make([]*checksumWriter, len(req.Targets))
+	for i, addr := range req.Targets {
+		if writers[i], err = openFragmentWriter(cctx, addr, req.ExtentID, fragmentSize); err != nil {
+			return errDone(errors.Wrapf(err, "write fragment to %s", addr))
+		}
+		checksums[i] = &checksumWriter{w: writers[i]}
+	}
+
+	//data fragments are sent when encoder reads them
+	data := make([]io.Reader, dataShard)
+	for i := range data {
+		start := uint64(i) * fragmentSize
+		n := uint64(0)
+		if start < length {
+			n = length - start
+		}
+		if n > fragmentSize {
+			n = fragmentSize
+		}
+		reader := ex.GetReader()
+		reader.Seek(int64(start), io.SeekStart)
+		fragment := io.MultiReader(io.LimitReader(reader, int64(n)), io.LimitReader(zeroReader{}, int64(fragmentSize-n)))
+		data[i] = io.TeeReader(fragment, checksums[i])
+	}
+	parity := make([]io.Writer, parityShard)
+	for i := range parity {
+		parity[i] = checksums[dataShard+i]
+	}
+	if err = erasure_code.NewCodec(req.Codec).EncodeStream(data, dataShard, parityShard, parity); err != nil {
+		return errDone(err)
+	}
+
+	diskIDs := make([]uint64, len(writers))
+	sums := make([]uint32, len(writers))
+	for i, w := range writers {
+		if diskIDs[i], err = w.Close(); err != nil {
+			return errDone(errors.Wrapf(err, "write fragment to %s", w.addr))
+		}
+		sums[i] = checksums[i].Checksum()
+	}
+	xlog.Logger.Infof("extent %d is converted to %d+%d, fragment size is %d", req.ExtentID, dataShard, parityShard, fragmentSize)
+	return &pb.ConvertExtentResponse{
+		Code:         pb.Code_OK,
+		FragmentSize: fragmentSize,
+		DiskIDs:      diskIDs,
+		Checksums:    sums,
+	}, nil
+}
+
+//WriteFragment receives a fragment of ConvertExtent, the fragment is saved as a sealed extent
+func (en *ExtentNode) WriteFragment(stream pb.ExtentService_WriteFragmentServer) error {
+	errDone := func(err error) error {
+		code, desCode := wire_errors.ConvertToPBCode(err)
+		stream.SendAndClose(&pb.WriteFragmentResponse{
+			Code:    code,
+			CodeDes: desCode,
+		})
+		return nil
+	}
+
+	req, err := stream.Recv()
+	if err != nil {
+		return errDone(err)
+	}
+	header := req.GetHeader()
+	if header == nil {
+		return errDone(errors.New("the first message of WriteFragment must be header"))
+	}
+	if header.Size_ > math.MaxUint32 {
+		return errDone(errors.Errorf("fragment size %d is too large", header.Size_))
+	}
+
+	//fragment of a failed conversion could be overwritten
+	if old := en.getExtent(header.ExtentID); old != nil {
+		exInfo := en.em.GetExtentInfo(header.ExtentID)
+		if exInfo == nil || stream_manager.FindNodeIndex(exInfo, en.nodeID) != -1 {
+			return errDone(errors.Errorf("extent %d is in use on node %d", header.ExtentID, en.nodeID))
+		}
+		en.RemoveExtent(old)
+	}
+
+	diskID := en.chooseDisktoAlloc()
+	if diskID == 0 {
+		return errDone(errors.New("no available disk"))
+	}
+	disk := en.diskFSs[diskID]
+	ex, err := disk.AllocFragment(header.ExtentID)
+	if err != nil {
+		en.diskError(diskID, err)
+		return errDone(err)
+	}
+
+	write := func() error {
+		ex.Lock()
+		defer ex.Unlock()
+		writer, err := ex.GetRawWriter()
+		if err != nil {
+			return err
+		}
+		for n := uint64(0); n < header.Size_; {
+			req, err := stream.Recv()
+			if err != nil {
+				return err
+			}
+			payload := req.GetPayload()
+			if uint64(len(payload)) > header.Size_-n {
+				return errors.Errorf("payload size is larger than fragment size %d", header.Size_)
+			}
+			if _, err = writer.Write(payload); err != nil {
+				return err
+			}
+			n += uint64(len(payload))
+		}
+		if err = ex.Sync(); err != nil {
+			return err
+		}
+		return ex.Seal(uint32(header.Size_))
+	}
+	err = write()
+	ex.Close()
+	if err != nil {
+		en.diskError(diskID, err)
+		return errDone(err)
+	}
+
+	fpath, err := disk.CommitFragment(header.ExtentID)
+	if err != nil {
+		return errDone(err)
+	}
+	if ex, err = extent.OpenExtent(fpath); err != nil {
+		return errDone(err)
+	}
+	en.setExtent(header.ExtentID, &ExtentOnDisk{
+		Extent: ex,
+		diskID: diskID,
+	})
+	return stream.SendAndClose(&pb.WriteFragmentResponse{
+		Code:   pb.Code_OK,
+		DiskID: diskID,
+	})
+}
+
+//recoveryFragment rebuilds the fragment of exceptID from other fragments
+func (en *ExtentNode) recoveryFragment(exInfo *pb.ExtentInfo, exceptID uint64, target io.Writer) error {
+	conns, missingIndex, err := en.chooseECAliveNode(exInfo, exceptID)
+	if err != nil {
+		return err
+	}
+	if missingIndex == -1 {
+		return errors.Errorf("task.ReplaceID is %d, not find in extentInfo", exceptID)
+	}
+
+	tmpDir, err := ioutil.TempDir(os.TempDir(), "recoveryFragment")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	dataShard := len(exInfo.Replicates)
+	parityShard := len(exInfo.Parity)
+	codec := erasure_code.NewCodec(exInfo.Codec)
+	conns = repairConns(codec, conns, missingIndex, dataShard, parityShard)
+	input := make([]io.Reader, dataShard+parityShard)
+	present := make([]bool, dataShard+parityShard)
+	for i := range conns {
+		if conns[i] == nil || codec.CanDecode(present, dataShard, parityShard) {
+			continue
+		}
+		f, err := os.Create(fmt.Sprintf("%s/%d", tmpDir, i))
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		if err = en.copyRemoteExtent(conns[i], exInfo, i, f, 0, exInfo.FragmentSize); err != nil {
+			xlog.Logger.Warnf("recoveryFragment can not copyRemoteExtent %v", err)
+			continue
+		}
+		input[i] = f
+		present[i] = true
+	}
+	if !codec.CanDecode(present, dataShard, parityShard) {
+		return errors.Errorf("can not call enought fragments")
+	}
+
+	output := make([]io.Writer, dataShard+parityShard)
+	cw := &checksumWriter{w: target}
+	output[missingIndex] = cw
+	if err = codec.Reconstruct(input, dataShard, parityShard, output); err != nil {
+		return err
+	}
+	return verifyChecksum(exInfo, missingIndex, cw.Checksum())
+}
@@ -0,0 +1,158 @@
+package node
+
+import (
+	"os"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/journeymidnight/autumn/utils"
+	"github.com/journeymidnight/autumn/xlog"
+	"github.com/pkg/errors"
+)
+
+/*
+disk failure handling:
+I/O errors of appends, reads, allocations and periodic probing writes are counted per disk, if a disk
+has ErrorThreshold errors in ErrorWindow, it is set offline. an offline disk is not used for new extents,
+extents on it are not served any more, and Df reports it to stream manager, which recovers its extents
+on other disks. a replaced disk is formatted and registered as a new disk by "autumn-client format-disk".
+
+only errors of broken disks are counted, a disk out of space is set full instead: no extent is allocated
+on it and Df reports no free space until it has FullReserve free bytes again, extents on it are still served
+*/
+
+//DiskFailurePolicy decides when a disk is set offline
+type DiskFailurePolicy struct {
+	ErrorThreshold int //0 to never set disk offline
+	ErrorWindow    time.Duration
+	ProbeInterval  time.Duration //0 to disable probing
+	ProbeTimeout   time.Duration //a probe not done in ProbeTimeout is an error
+	FullReserve    uint64        //a full disk is used again if it has FullReserve free bytes
+}
+
+var DefaultDiskFailurePolicy = DiskFailurePolicy{
+	ErrorThreshold: 5,
+	ErrorWindow:    5 * time.Minute,
+	ProbeInterval:  30 * time.Second,
+	ProbeTimeout:   10 * time.Second,
+	FullReserve:    1 << 30,
+}
+
+//SetDiskFailurePolicy should be called before ServeGRPC
+func (en *ExtentNode) SetDiskFailurePolicy(policy DiskFailurePolicy) {
+	en.diskPolicy = policy
+}
+
+//errno returns the errno of err returned by file system, or 0
+func errno(err error) syscall.Errno {
+	switch e := errors.Cause(err).(type) {
+	case *os.PathError:
+		err = e.Err
+	case *os.SyscallError:
+		err = e.Err
+	case *os.LinkError:
+		err = e.Err
+	}
+	if e, ok := err.(syscall.Errno); ok {
+		return e
+	}
+	return 0
+}
+
+//isDiskError returns true if err means the disk is broken
+func isDiskError(err error) bool {
+	switch errno(err) {
+	case syscall.EIO, syscall.EROFS, syscall.ENXIO, syscall.ENODEV:
+		return true
+	}
+	return false
+}
+
+//isDiskFull returns true if err means the disk is out of space
+func isDiskFull(err error) bool {
+	switch errno(err) {
+	case syscall.ENOSPC, syscall.EDQUOT:
+		return true
+	}
+	return false
+}
+
+func (en *ExtentNode) diskOnline(diskID uint64) bool {
+	disk, ok := en.diskFSs[diskID]
+	return ok && disk.Online()
+}
+
+//diskError counts err if it is an I/O error of disk, or sets disk full if it is out of space
+func (en *ExtentNode) diskError(diskID uint64, err error) {
+	if err == nil {
+		return
+	}
+	disk, ok := en.diskFSs[diskID]
+	if !ok {
+		return
+	}
+	if isDiskFull(err) {
+		en.setDiskFull(disk, err)
+	} else if isDiskError(err) {
+		en.recordDiskError(disk, err)
+	}
+}
+
+func (en *ExtentNode) setDiskFull(disk *diskFS, err error) {
+	if disk.SetFull(true) {
+		xlog.Logger.Warnf("disk %d on %s is full: %v, stop allocating extents on it", disk.diskID, disk.baseDir, err)
+	}
+}
+
+func (en *ExtentNode) recordDiskError(disk *diskFS, err error) {
+	n := disk.RecordError(err, time.Now(), en.diskPolicy.ErrorWindow)
+	xlog.Logger.Warnf("disk %d on %s: %v, %d errors in %v", disk.diskID, disk.baseDir, err, n, en.diskPolicy.ErrorWindow)
+	if en.diskPolicy.ErrorThreshold > 0 && n >= en.diskPolicy.ErrorThreshold && disk.Online() {
+		xlog.Logger.Errorf("disk %d on %s has too many errors, set it offline", disk.diskID, disk.baseDir)
+		disk.SetOffline()
+	}
+}
+
+func (en *ExtentNode) probeDisk(disk *diskFS) {
+	//the last probe hangs
+	if !atomic.CompareAndSwapInt32(&disk.probing, 0, 1) {
+		en.recordDiskError(disk, errors.New("the last probe is not done"))
+		return
+	}
+	done := make(chan error, 1)
+	go func() {
+		defer atomic.StoreInt32(&disk.probing, 0)
+		done <- disk.Probe()
+	}()
+	select {
+	case err := <-done:
+		if isDiskFull(err) {
+			en.setDiskFull(disk, err)
+		} else if err != nil {
+			en.recordDiskError(disk, err)
+		}
+	case <-time.After(en.diskPolicy.ProbeTimeout):
+		en.recordDiskError(disk, errors.Errorf("probe is not done in %v", en.diskPolicy.ProbeTimeout))
+	}
+}
+
+func (en *ExtentNode) routineProbeDisks() {
+	if en.diskPolicy.ProbeInterval == 0 {
+		return
+	}
+	ticker := utils.NewRandomTicker(en.diskPolicy.ProbeInterval, en.diskPolicy.ProbeInterval*3/2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-en.stopper.ShouldStop():
+			return
+		case <-ticker.C:
+			for _, disk := range en.diskFSs {
+				if disk.Online() {
+					go en.probeDisk(disk)
+				}
+			}
+		}
+	}
+}
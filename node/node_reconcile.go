@@ -0,0 +1,197 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/journeymidnight/autumn/manager/stream_manager"
+	"github.com/journeymidnight/autumn/proto/pb"
+	"github.com/journeymidnight/autumn/utils"
+	"github.com/journeymidnight/autumn/wire_errors"
+	"github.com/journeymidnight/autumn/xlog"
+	"github.com/pkg/errors"
+)
+
+/*
+orphan-extent reconciliation:
+node reports extents and copy files on online disks to stream manager periodically. orphan extents and
+stale copy files in the answer are moved to quarantine dir of their disks, and unlinked after
+quarantinePeriod, so an orphan found by mistake could be moved back by hand. unsealed extents which
+are sealed on stream manager are sealed. missing extents are recovered by stream manager
+*/
+
+const (
+	quarantinePeriod = 24 * time.Hour
+	reconcileTimeout = time.Minute
+)
+
+var errReconciling = errors.New("reconciliation is running")
+
+//inventory returns extents and copy files on online disks
+func (en *ExtentNode) inventory() *pb.InventoryRequest {
+	req := &pb.InventoryRequest{NodeID: en.nodeID}
+	en.extentMap.Range(func(k, v interface{}) bool {
+		ex := v.(*ExtentOnDisk)
+		disk := en.diskFSs[ex.diskID]
+		if disk == nil || !disk.Online() {
+			return true
+		}
+		info, err := os.Stat(disk.pathName(ex.ID, "ext"))
+		if err != nil {
+			//removed after Range
+			if !os.IsNotExist(err) {
+				en.diskError(ex.diskID, err)
+			}
+			return true
+		}
+		req.Extents = append(req.Extents, &pb.ExtentReport{
+			ExtentID: ex.ID,
+			DiskID:   ex.diskID,
+			Sealed:   ex.IsSeal(),
+			Length:   ex.CommitLength(),
+			ModTime:  info.ModTime().Unix(),
+		})
+		return true
+	})
+
+	for diskID, disk := range en.diskFSs {
+		if !disk.Online() {
+			continue
+		}
+		err := disk.ListCopies(func(path string, extentID uint64, replaceID uint64, info os.FileInfo) {
+			req.Copies = append(req.Copies, &pb.CopyReport{
+				ExtentID:  extentID,
+				ReplaceID: replaceID,
+				DiskID:    diskID,
+				ModTime:   info.ModTime().Unix(),
+			})
+		})
+		if err != nil {
+			xlog.Logger.Warnf("list copies on disk %d: %v", diskID, err)
+		}
+	}
+	return req
+}
+
+//reconcile reports inventory to stream manager, and applies the answer if dryRun is false
+func (en *ExtentNode) reconcile(ctx context.Context, dryRun bool) (*pb.InventoryResponse, error) {
+	if !atomic.CompareAndSwapInt32(&en.reconciling, 0, 1) {
+		return nil, errReconciling
+	}
+	defer atomic.StoreInt32(&en.reconciling, 0)
+
+	req := en.inventory()
+	req.DryRun = dryRun
+	res, err := en.smClient.ReportInventory(ctx, req)
+	if err != nil || dryRun {
+		return res, err
+	}
+
+	now := time.Now()
+	for _, extentID := range res.Orphans {
+		en.quarantineExtent(extentID, now)
+	}
+	for _, c := range res.StaleCopies {
+		disk := en.diskFSs[c.DiskID]
+		if disk == nil || !disk.Online() {
+			continue
+		}
+		path := disk.pathName(c.ExtentID, fmt.Sprintf("%d.copy", c.ReplaceID))
+		if _, running := en.copyFiles.Load(path); running {
+			continue
+		}
+		if err := disk.Quarantine(path, now); err != nil && !os.IsNotExist(err) {
+			en.diskError(c.DiskID, err)
+			continue
+		}
+		xlog.Logger.Infof("copy file %s is quarantined", path)
+	}
+	for _, extentID := range res.Mismatched {
+		//sealed extent with a different length is recovered by stream manager
+		ex := en.getExtent(extentID)
+		exInfo := en.em.GetExtentInfo(extentID)
+		if ex == nil || exInfo == nil || exInfo.Avali == 0 || ex.IsSeal() {
+			continue
+		}
+		xlog.Logger.Infof("SEAL extent %d by reconciliation", extentID)
+		ex.Lock()
+		if err := ex.Seal(uint32(stream_manager.SealedFileLength(exInfo))); err != nil {
+			xlog.Logger.Errorf(err.Error())
+		}
+		ex.Unlock()
+	}
+	return res, nil
+}
+
+func (en *ExtentNode) quarantineExtent(extentID uint64, now time.Time) {
+	ex := en.getExtent(extentID)
+	if ex == nil {
+		return
+	}
+	//stream manager and local cache do not agree, wait for the next reconciliation
+	if exInfo := en.em.GetExtentInfo(extentID); exInfo != nil && stream_manager.FindNodeIndex(exInfo, en.nodeID) >= 0 {
+		xlog.Logger.Warnf("orphan extent %d is on node %d in local cache", extentID, en.nodeID)
+		return
+	}
+	disk := en.diskFSs[ex.diskID]
+	if !disk.Online() {
+		return
+	}
+	en.removeExtent(extentID)
+	ex.Close()
+	if err := disk.Quarantine(disk.pathName(extentID, "ext"), now); err != nil && !os.IsNotExist(err) {
+		en.diskError(ex.diskID, err)
+		return
+	}
+	xlog.Logger.Infof("orphan extent %d is quarantined", extentID)
+}
+
+func (en *ExtentNode) Reconcile(ctx context.Context, req *pb.ReconcileRequest) (*pb.InventoryResponse, error) {
+	errDone := func(err error) (*pb.InventoryResponse, error) {
+		code, desCode := wire_errors.ConvertToPBCode(err)
+		return &pb.InventoryResponse{
+			Code:    code,
+			CodeDes: desCode,
+		}, nil
+	}
+
+	res, err := en.reconcile(ctx, req.DryRun)
+	if err != nil {
+		return errDone(err)
+	}
+	return res, nil
+}
+
+func (en *ExtentNode) routineReconcile() {
+	ticker := utils.NewRandomTicker(10*time.Minute, 20*time.Minute)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-en.stopper.ShouldStop():
+			return
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(en.stopper.Ctx(), reconcileTimeout)
+			res, err := en.reconcile(ctx, false)
+			cancel()
+			if err != nil {
+				xlog.Logger.Warnf("reconcile node %d: %v", en.nodeID, err)
+			} else {
+				xlog.Logger.Infof("reconcile node %d: %d orphans, %d missing, %d mismatched, %d stale copies",
+					en.nodeID, len(res.Orphans), len(res.Missing), len(res.Mismatched), len(res.StaleCopies))
+			}
+			for diskID, disk := range en.diskFSs {
+				if !disk.Online() {
+					continue
+				}
+				if n, err := disk.PurgeQuarantine(time.Now(), quarantinePeriod); err != nil {
+					en.diskError(diskID, err)
+				} else if n > 0 {
+					xlog.Logger.Infof("%d quarantined files on disk %d are removed", n, diskID)
+				}
+			}
+		}
+	}
+}
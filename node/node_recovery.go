@@ -24,7 +24,9 @@ import (
 	"google.golang.org/grpc"
 )
 
-func (en *ExtentNode) copyRemoteExtent(conn *grpc.ClientConn, exInfo *pb.ExtentInfo, targetWriter io.WriteSeeker, offset uint64, size uint64) error {
+//copyRemoteExtent copies [offset, offset+size) of copy slot, if the whole copy is transferred, data is
+//verified by the checksum in exInfo
+func (en *ExtentNode) copyRemoteExtent(conn *grpc.ClientConn, exInfo *pb.ExtentInfo, slot int, targetWriter io.WriteSeeker, offset uint64, size uint64) error {
 	c := pb.NewExtentServiceClient(conn)
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -54,6 +56,7 @@ func (en *ExtentNode) copyRemoteExtent(conn *grpc.ClientConn, exInfo *pb.ExtentI
 	header = proto.Clone(res.GetHeader()).(*pb.CopyResponseHeader)
 
 	n := 0
+	var crc utils.CRC
 	for {
 		res, err := copyStream.Recv()
 		if err != nil && err != io.EOF {
@@ -61,6 +64,7 @@ func (en *ExtentNode) copyRemoteExtent(conn *grpc.ClientConn, exInfo *pb.ExtentI
 		}
 		payload := res.GetPayload()
 		if len(payload) > 0 {
+			crc = crc.Update(payload)
 			if _, err = targetWriter.Write(payload); err != nil {
 				return err
 			}
@@ -76,6 +80,11 @@ func (en *ExtentNode) copyRemoteExtent(conn *grpc.ClientConn, exInfo *pb.ExtentI
 	if n != int(header.PayloadLen) {
 		return errors.Errorf("header is %v, got data length %d", header, n)
 	}
+	if offset == 0 && size == stream_manager.SealedFileLength(exInfo) {
+		if err := verifyChecksum(exInfo, slot, crc.Value()); err != nil {
+			return err
+		}
+	}
 
 	//rewind to start for reading
 	targetWriter.Seek(0, os.SEEK_SET)
@@ -85,18 +94,28 @@ func (en *ExtentNode) copyRemoteExtent(conn *grpc.ClientConn, exInfo *pb.ExtentI
 //func (en *ExtentNode) recoveryReplicateExtent(extentInfo *pb.ExtentInfo, task *pb.RecoveryTask, targetWriter *os.File) error {
 func (en *ExtentNode) recoveryReplicateExtent(exInfo *pb.ExtentInfo, exceptID, offset, size uint64, targetWriter io.WriteSeeker) error {
 
-	conn := en.chooseAliveNode(exInfo, exceptID)
-
-	if conn == nil {
-		xlog.Logger.Warnf("runRecoveryTask: can not find remote connect")
-		return errors.Errorf("runRecoveryTask: can not find remote connect")
-	}
+	//try next replicate if data is corrupted
+	tried := make(map[int]bool)
+	for {
+		conn, slot := en.chooseAliveNode(exInfo, exceptID, tried)
+		if conn == nil {
+			xlog.Logger.Warnf("runRecoveryTask: can not find remote connect")
+			return errors.Errorf("runRecoveryTask: can not find remote connect")
+		}
 
-	if err := en.copyRemoteExtent(conn, exInfo, targetWriter, 0, exInfo.SealedLength); err != nil {
+		err := en.copyRemoteExtent(conn, exInfo, slot, targetWriter, 0, exInfo.SealedLength)
+		if err == nil {
+			return nil
+		}
 		xlog.Logger.Warnf("recoveryReplicateExtent: [%s]", err.Error())
-		return err
+		if errors.Cause(err) != errChecksumMismatch {
+			return err
+		}
+		if _, err = targetWriter.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		tried[slot] = true
 	}
-	return nil
 }
 
 func (en *ExtentNode) recoveryErasureExtent(exInfo *pb.ExtentInfo, exceptID, offset, size uint64, start uint32, targetExtent *extent.Extent) error {
@@ -109,6 +128,9 @@ func (en *ExtentNode) recoveryErasureExtent(exInfo *pb.ExtentInfo, exceptID, off
 	if replacingIndex == -1 {
 		return errors.Errorf("task.ReplaceID is %d, not find in extentInfo", exceptID)
 	}
+	dataShards, parityShards := len(exInfo.Replicates), len(exInfo.Parity)
+	codec := erasure_code.NewCodec(exInfo.Codec)
+	conns = repairConns(codec, conns, replacingIndex, dataShards, parityShards)
 
 	//FIXME: do not use tmp dirs
 	tmpDir, err := ioutil.TempDir(os.TempDir(), "recoveryEC")
@@ -121,7 +143,7 @@ func (en *ExtentNode) recoveryErasureExtent(exInfo *pb.ExtentInfo, exceptID, off
 	sourceExtent := make([]*extent.Extent, len(conns))
 
 	for i := range sourceExtent {
-		if i != replacingIndex {
+		if i != replacingIndex && conns[i] != nil {
 			//ID 0 indicate the extent is tmp extent
 			ex, err := extent.CreateExtent(fmt.Sprintf("%s/%d", tmpDir, i), 0)
 			if err != nil {
@@ -136,7 +158,7 @@ func (en *ExtentNode) recoveryErasureExtent(exInfo *pb.ExtentInfo, exceptID, off
 	var wg sync.WaitGroup
 	var completes int32
 	for i := range sourceExtent {
-		if i == replacingIndex {
+		if sourceExtent[i] == nil {
 			continue
 		}
 		wg.Add(1)
@@ -150,7 +172,7 @@ func (en *ExtentNode) recoveryErasureExtent(exInfo *pb.ExtentInfo, exceptID, off
 				xlog.Logger.Errorf("GetRawWrier failed, disk failure?")
 				return
 			}
-			if err := en.copyRemoteExtent(conns[i], exInfo, rawWriter, offset, size); err != nil {
+			if err := en.copyRemoteExtent(conns[i], exInfo, i, rawWriter, offset, size); err != nil {
 				xlog.Logger.Warnf("ErasureExtent can not copyRemoteExtent %v", err)
 				sourceExtent[i] = nil
 				return
@@ -162,35 +184,59 @@ func (en *ExtentNode) recoveryErasureExtent(exInfo *pb.ExtentInfo, exceptID, off
 	wg.Wait()
 
 	fmt.Printf("completes is %d\n", completes)
-	if completes < int32(len(exInfo.Replicates)) {
+	present := make([]bool, len(sourceExtent))
+	for i := range sourceExtent {
+		present[i] = sourceExtent[i] != nil
+	}
+	if !codec.CanDecode(present, dataShards, parityShards) {
 		return errors.Errorf("can not call enought shards")
 	}
 
-	return erasure_code.ReedSolomon{}.RebuildECExtent(len(exInfo.Replicates), len(exInfo.Parity), sourceExtent, start, replacingIndex, targetExtent)
-
+	if err = codec.RebuildECExtent(dataShards, parityShards, sourceExtent, start, replacingIndex, targetExtent); err != nil {
+		return err
+	}
+	//only a whole rebuilt shard could be verified
+	if start == 0 && offset == 0 && size == exInfo.SealedLength {
+		checksum, err := targetExtent.Checksum()
+		if err != nil {
+			return err
+		}
+		return verifyChecksum(exInfo, replacingIndex, checksum)
+	}
+	return nil
 }
 
-//choose at least n alive node from extentInfo
-//replics: return one connection
-//EC: return (datashards+parity) connections
-func (en *ExtentNode) chooseAliveNode(extentInfo *pb.ExtentInfo, except uint64) *grpc.ClientConn {
+//chooseAliveNode returns the connection and slot of an alive copy not in tried, the copy on except
+//is the last choice, it is still alive if its node is being decommissioned
+func (en *ExtentNode) chooseAliveNode(extentInfo *pb.ExtentInfo, except uint64, tried map[int]bool) (*grpc.ClientConn, int) {
 	addrs := en.em.GetPeers(extentInfo.ExtentID)
 	if addrs == nil {
-		return nil
+		return nil, -1
 	}
 	utils.AssertTrue(len(addrs) == len(extentInfo.Replicates))
-	for i := range extentInfo.Replicates {
-		if extentInfo.Replicates[i] == except || (1<<i)&extentInfo.Avali == 0 {
+	exceptSlot := -1
+	for i := 0; i < len(extentInfo.Replicates); i++ {
+		if tried[i] || (1<<i)&extentInfo.Avali == 0 {
+			continue
+		}
+		if extentInfo.Replicates[i] == except {
+			exceptSlot = i
 			continue
 		}
 		pool := conn.GetPools().Connect(addrs[i])
 		if pool == nil || !pool.IsHealthy() {
 			continue
 		}
-		return pool.Get()
+		return pool.Get(), i
 	}
 
-	return nil
+	if exceptSlot >= 0 {
+		pool := conn.GetPools().Connect(addrs[exceptSlot])
+		if pool != nil && pool.IsHealthy() {
+			return pool.Get(), exceptSlot
+		}
+	}
+	return nil, -1
 }
 
 func (en *ExtentNode) chooseECAliveNode(exInfo *pb.ExtentInfo, except uint64) ([]*grpc.ClientConn, int, error) {
@@ -230,13 +276,30 @@ func (en *ExtentNode) chooseECAliveNode(exInfo *pb.ExtentInfo, except uint64) ([
 	}
 
 	fmt.Printf("activeConns is %v\n", activeConns)
-	if activeConns == len(exInfo.Replicates) {
+	if activeConns >= len(exInfo.Replicates) {
 		return conns, missingIndex, nil
 	}
 
 	return nil, missingIndex, errors.New("can not find enough nodes to recover")
 }
 
+//repairConns keeps connections of shards which are needed to rebuild the lost shard by codec,
+//if some of them are not alive, all connections are kept
+func repairConns(codec erasure_code.ErasureCodec, conns []*grpc.ClientConn, lost int, dataShards, parityShards int) []*grpc.ClientConn {
+	sources := codec.RepairSources(lost, dataShards, parityShards)
+	if sources == nil {
+		return conns
+	}
+	ret := make([]*grpc.ClientConn, len(conns))
+	for _, i := range sources {
+		if conns[i] == nil {
+			return conns
+		}
+		ret[i] = conns[i]
+	}
+	return ret
+}
+
 func truncateFileToZero(path string) error {
 	info, err := os.Stat(path)
 	if err != nil {
@@ -251,7 +314,9 @@ func truncateFileToZero(path string) error {
 func (en *ExtentNode) runRecoveryTask(task *pb.RecoveryTask, exInfo *pb.ExtentInfo, copyFilePath string, diskID uint64) {
 
 	atomic.AddInt32(&en.recoveryTaskNum, 1)
+	en.copyFiles.Store(copyFilePath, task)
 	defer func() {
+		en.copyFiles.Delete(copyFilePath)
 		atomic.AddInt32(&en.recoveryTaskNum, ^int32(0))
 	}()
 
@@ -263,7 +328,7 @@ func (en *ExtentNode) runRecoveryTask(task *pb.RecoveryTask, exInfo *pb.ExtentIn
 		//有可能manager等的太久了,或者网络parttion, 找另外一个
 		//node做完了recovery任务, 这个任务自动取消
 		if stream_manager.FindNodeIndex(exInfo, task.ReplaceID) == -1 {
-			os.Remove(copyFilePath)
+			extent.RemoveExtentFile(copyFilePath)
 			return
 		}
 
@@ -276,7 +341,16 @@ func (en *ExtentNode) runRecoveryTask(task *pb.RecoveryTask, exInfo *pb.ExtentIn
 		}
 
 		//write to ".copy" file, and
-		if isEC == false {
+		if exInfo.FragmentSize > 0 {
+			var copyFile *os.File
+			copyFile, err = os.OpenFile(copyFilePath, os.O_RDWR, 0666)
+			if err != nil {
+				xlog.Logger.Errorf(err.Error())
+				return
+			}
+			err = en.recoveryFragment(exInfo, task.ReplaceID, copyFile)
+			copyFile.Close()
+		} else if isEC == false {
 			var copyFile *os.File
 			copyFile, err = os.OpenFile(copyFilePath, os.O_RDWR, 0666)
 			if err != nil {
@@ -314,9 +388,18 @@ func (en *ExtentNode) runRecoveryTask(task *pb.RecoveryTask, exInfo *pb.ExtentIn
 
 	//rename file from XX.XX.copy to XX.ext
 	extentFileName := fmt.Sprintf("%s/%d.ext", path.Dir(copyFilePath), task.ExtentID)
-	utils.Check(os.Rename(copyFilePath, extentFileName))
+	utils.Check(extent.RenameExtentFile(copyFilePath, extentFileName))
 	ex, err := extent.OpenExtent(extentFileName)
 	utils.Check(err)
+	//fragment is never appended, seal it now
+	if exInfo.FragmentSize > 0 && !ex.IsSeal() {
+		ex.Lock()
+		err = ex.Seal(uint32(exInfo.FragmentSize))
+		ex.Unlock()
+		if err != nil {
+			xlog.Logger.Errorf(err.Error())
+		}
+	}
 	en.setExtent(ex.ID, &ExtentOnDisk{
 		Extent: ex,
 		diskID: diskID,
@@ -358,11 +441,14 @@ func (en *ExtentNode) CopyExtent(req *pb.CopyExtentRequest, stream pb.ExtentServ
 	if ex == nil {
 		return errDone(errors.Errorf("node %d do not have extent %d", en.nodeID, req.ExtentID), stream)
 	}
+	if !en.diskOnline(ex.diskID) {
+		return errDone(errors.Errorf("disk %d of extent %d is offline", ex.diskID, req.ExtentID), stream)
+	}
 
 	//if Seal message delayed, Seal it now.
 	if !ex.IsSeal() {
 		ex.Lock()
-		ex.Seal(uint32(exInfo.SealedLength))
+		ex.Seal(uint32(stream_manager.SealedFileLength(exInfo)))
 		ex.Unlock()
 	}
 
@@ -449,6 +535,7 @@ func (en *ExtentNode) RequireRecovery(ctx context.Context, req *pb.RequireRecove
 		fmt.Printf("target path is %s\n", targetFilePath)
 		if err != nil {
 			xlog.Logger.Warnf("can not create CopyExtent copy target [%s]", err.Error())
+			en.diskError(diskID, err)
 			return errDone(err)
 		}
 		go en.runRecoveryTask(req.Task, exInfo, targetFilePath, diskID)
@@ -17,10 +17,10 @@ package node
 import (
 	"context"
 	"fmt"
+	"os"
 	"time"
 
 	"github.com/journeymidnight/autumn/conn"
-	"github.com/journeymidnight/autumn/extent"
 	"github.com/journeymidnight/autumn/proto/pb"
 	"github.com/journeymidnight/autumn/utils"
 	"github.com/journeymidnight/autumn/wire_errors"
@@ -34,6 +34,12 @@ var (
 	MaxConcurrentTask = int32(2)
 )
 
+//max time an append waits for previous pipelined appends
+const commitWaitTimeout = 5 * time.Second
+
+//readBufferPerBlock is the size of pooled buffer for each block read by ReadBlocks
+const readBufferPerBlock = 64 << 10
+
 //internal services
 func (en *ExtentNode) Heartbeat(in *pb.Payload, stream pb.ExtentService_HeartbeatServer) error {
 	ticker := time.NewTicker(conn.EchoDuration)
@@ -112,12 +118,15 @@ func (en *ExtentNode) connPool(peers []string) ([]*conn.Pool, error) {
 	return ret, err
 }
 
-func (en *ExtentNode) validReq(extentID uint64, version uint64) (*extent.Extent, *pb.ExtentInfo, error) {
+func (en *ExtentNode) validReq(extentID uint64, version uint64) (*ExtentOnDisk, *pb.ExtentInfo, error) {
 
 	ex := en.getExtent(extentID)
 	if ex == nil {
 		return nil, nil, errors.Errorf("no such extent %d on node %d", extentID, en.nodeID)
 	}
+	if !en.diskOnline(ex.diskID) {
+		return nil, nil, errors.Errorf("disk %d of extent %d is offline", ex.diskID, extentID)
+	}
 
 	extentInfo := en.em.WaitVersion(extentID, version)
 	if extentInfo == nil {
@@ -128,9 +137,46 @@ func (en *ExtentNode) validReq(extentID uint64, version uint64) (*extent.Extent,
 		return nil, nil, wire_errors.VersionLow
 	}
 
-	return ex.Extent, extentInfo, nil
+	return ex, extentInfo, nil
 }
+//appendServer is the server stream of Append and ChainAppend
+type appendServer interface {
+	Recv() (*pb.AppendRequest, error)
+	SendAndClose(*pb.AppendResponse) error
+	Context() context.Context
+}
+
 func (en *ExtentNode) Append(stream pb.ExtentService_AppendServer) error {
+	return en.appendBlocks(stream, false)
+}
+
+//ChainAppend appends blocks and forwards the request to header.Chain[0] at the same time,
+//it returns after all nodes in the chain are done
+func (en *ExtentNode) ChainAppend(stream pb.ExtentService_ChainAppendServer) error {
+	return en.appendBlocks(stream, true)
+}
+
+//forward opens ChainAppend on the next node of chain and sends header to it
+func (en *ExtentNode) forward(ctx context.Context, header *pb.AppendRequestHeader) (pb.ExtentService_ChainAppendClient, error) {
+	pool := conn.GetPools().Connect(header.Chain[0])
+	if pool == nil {
+		return nil, errors.Errorf("can not connect to %s", header.Chain[0])
+	}
+	next, err := pb.NewExtentServiceClient(pool.Get()).ChainAppend(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nextHeader := *header
+	nextHeader.Chain = header.Chain[1:]
+	if err = next.Send(&pb.AppendRequest{
+		Data: &pb.AppendRequest_Header{Header: &nextHeader},
+	}); err != nil {
+		return nil, err
+	}
+	return next, nil
+}
+
+func (en *ExtentNode) appendBlocks(stream appendServer, chain bool) error {
 	/*
 		startTime := time.Now()
 		defer func() {
@@ -170,8 +216,14 @@ func (en *ExtentNode) Append(stream pb.ExtentService_AppendServer) error {
 		return errDone(wire_errors.LockedByOther)
 	}
 
+	//previous pipelined appends may not be done
 	if ex.CommitLength() < header.Commit {
-		return errDone(errors.Errorf("primary commitlength is different with replicates %d vs %d", header.Commit, ex.CommitLength()))
+		wctx, cancel := context.WithTimeout(stream.Context(), commitWaitTimeout)
+		err = ex.WaitCommitLength(wctx, header.Commit)
+		cancel()
+		if err != nil {
+			return errDone(errors.Errorf("primary commitlength is different with replicates %d vs %d", header.Commit, ex.CommitLength()))
+		}
 	}
 
 	if ex.CommitLength() > header.Commit {
@@ -179,34 +231,102 @@ func (en *ExtentNode) Append(stream pb.ExtentService_AppendServer) error {
 	}
 	utils.AssertTrue(ex.CommitLength() == header.Commit)
 
+	var next pb.ExtentService_ChainAppendClient
+	if chain && len(header.Chain) > 0 {
+		ctx, cancel := context.WithCancel(stream.Context())
+		defer cancel()
+		if next, err = en.forward(ctx, header); err != nil {
+			return errDone(errors.Wrapf(err, "forward to %s", header.Chain[0]))
+		}
+	}
+
 	data := make([][]byte, len(header.Blocks))
+	//a block sent in one payload is used directly, others are copied into buf
+	var buf *utils.Buffer
+	defer func() {
+		if buf != nil {
+			buf.DecrRef()
+		}
+	}()
+	bufOffset := 0
 	//received header.blocks
 	for i := 0; i < len(header.Blocks); i++ {
 		blockSize := int(header.Blocks[i])
 
 		n := 0
-		data[i] = make([]byte, blockSize, blockSize)
 		for n < blockSize {
 			req, err := stream.Recv()
 			if err != nil {
 				xlog.Logger.Errorf("node %d recv error %+v", en.nodeID, err)
 				return errDone(err)
 			}
+			if next != nil {
+				if err = next.Send(req); err != nil {
+					return errDone(errors.Wrapf(err, "forward to %s", header.Chain[0]))
+				}
+			}
 			payload := req.GetPayload()
 			if len(payload) > blockSize-n {
 				return errDone(errors.Errorf("payload size is larger than block size %d vs %d", len(payload), blockSize-n))
 			}
+			if n == 0 && len(payload) == blockSize {
+				data[i] = payload
+				n = blockSize
+				break
+			}
+			if data[i] == nil {
+				if buf == nil {
+					total := 0
+					for _, size := range header.Blocks {
+						total += int(size)
+					}
+					buf = utils.GetBuffer(total)
+				}
+				data[i] = buf.B[bufOffset : bufOffset+blockSize : bufOffset+blockSize]
+				bufOffset += blockSize
+			}
 			copy(data[i][n:], payload)
 			n += len(payload)
 		}
+		if data[i] == nil {
+			data[i] = []byte{}
+		}
 	}
 
-	ret, end, err := en.AppendWithWal(ex, header.Revision, data, header.MustSync)
+	//the rest of chain appends at the same time
+	var nextRes *pb.AppendResponse
+	var nextErr error
+	nextDone := make(chan struct{})
+	if next != nil {
+		go func() {
+			defer close(nextDone)
+			nextRes, nextErr = next.CloseAndRecv()
+		}()
+	} else {
+		close(nextDone)
+	}
+
+	ret, end, err := en.AppendWithWal(ex.Extent, header.Revision, data, header.MustSync)
+	<-nextDone
 
 	if err != nil {
+		en.diskError(ex.diskID, err)
 		return errDone(err)
 	}
 
+	if next != nil {
+		if nextErr == nil && nextRes.Code != pb.Code_OK {
+			nextErr = wire_errors.FromPBCode(nextRes.Code, nextRes.CodeDes)
+		}
+		if nextErr != nil {
+			return errDone(nextErr)
+		}
+		if !utils.EqualUint32(ret, nextRes.Offsets) || end != nextRes.End {
+			return errDone(errors.Errorf("block is not appended at the same offset on %s [%v] vs [%v], end [%v] vs [%v]",
+				header.Chain[0], ret, nextRes.Offsets, end, nextRes.End))
+		}
+	}
+
 	stream.SendAndClose(&pb.AppendResponse{
 		Code:    pb.Code_OK,
 		Offsets: ret,
@@ -234,6 +354,9 @@ func (en *ExtentNode) ReadBlocks(req *pb.ReadBlocksRequest, stream pb.ExtentServ
 	if ex == nil {
 		return errDone(errors.Errorf("no such extent %d on node %d", req.ExtentID, en.nodeID))
 	}
+	if !en.diskOnline(ex.diskID) {
+		return errDone(errors.Errorf("disk %d of extent %d is offline", ex.diskID, req.ExtentID))
+	}
 
 	var blocks [][]byte
 	var end uint32
@@ -243,10 +366,21 @@ func (en *ExtentNode) ReadBlocks(req *pb.ReadBlocksRequest, stream pb.ExtentServ
 	if req.OnlyLastBlock {
 		blocks, offsets, end, err = ex.ReadLastBlock()
 	} else {
-		blocks, offsets, end, err = ex.ReadBlocks(req.Offset, req.NumOfBlocks, (32 << 20))
+		//blocks are sent before buf is released. buf is sized by the number of blocks,
+		//ReadBlocksTo grows it out of the pool if blocks are larger
+		maxSize := uint32(32 << 20)
+		size := 0
+		if length := ex.CommitLength(); length > req.Offset {
+			size = utils.Min(int(length-req.Offset), int(maxSize))
+			size = utils.Min(size, int(req.NumOfBlocks)*readBufferPerBlock)
+		}
+		buf := utils.GetBuffer(size)
+		defer buf.DecrRef()
+		blocks, offsets, end, err = ex.ReadBlocksTo(buf.B, req.Offset, req.NumOfBlocks, maxSize)
 	}
 
 	if err != nil && err != wire_errors.EndOfExtent {
+		en.diskError(ex.diskID, err)
 		return errDone(err)
 	}
 
@@ -282,7 +416,7 @@ func (en *ExtentNode) ReadBlocks(req *pb.ReadBlocksRequest, stream pb.ExtentServ
 func (en *ExtentNode) chooseDisktoAlloc() uint64 {
 	//only choose the first disk
 	for _, disk := range en.diskFSs {
-		if disk.Online() {
+		if disk.Online() && !disk.Full() {
 			return disk.diskID
 		}
 	}
@@ -307,6 +441,7 @@ func (en *ExtentNode) AllocExtent(ctx context.Context, req *pb.AllocExtentReques
 
 	ex, err := en.diskFSs[i].AllocExtent(req.ExtentID)
 	if err != nil {
+		en.diskError(i, err)
 		xlog.Logger.Warnf("can not alloc extent %d, [%s]", req.ExtentID, err.Error())
 		return errDone(err)
 	}
@@ -322,6 +457,41 @@ func (en *ExtentNode) AllocExtent(ctx context.Context, req *pb.AllocExtentReques
 	}, nil
 }
 
+//DeleteExtent removes an extent which is not referenced by any stream, it is idempotent
+func (en *ExtentNode) DeleteExtent(ctx context.Context, req *pb.DeleteExtentRequest) (*pb.DeleteExtentResponse, error) {
+
+	errDone := func(err error) (*pb.DeleteExtentResponse, error) {
+		code, desCode := wire_errors.ConvertToPBCode(err)
+		return &pb.DeleteExtentResponse{
+			Code:    code,
+			CodeDes: desCode,
+		}, nil
+	}
+
+	if exInfo := en.em.GetExtentInfo(req.ExtentID); exInfo != nil && exInfo.Refs > 0 {
+		return errDone(errors.Errorf("extent %d is still referenced", req.ExtentID))
+	}
+
+	ex := en.getExtent(req.ExtentID)
+	if ex == nil {
+		return &pb.DeleteExtentResponse{Code: pb.Code_OK}, nil
+	}
+	//offline disk is formatted when it is replaced
+	if !en.diskOnline(ex.diskID) {
+		en.removeExtent(req.ExtentID)
+		return &pb.DeleteExtentResponse{Code: pb.Code_OK}, nil
+	}
+	if err := en.RemoveExtent(ex); err != nil && !os.IsNotExist(err) {
+		en.diskError(ex.diskID, err)
+		return errDone(err)
+	}
+	xlog.Logger.Infof("extent %d is deleted", req.ExtentID)
+
+	return &pb.DeleteExtentResponse{
+		Code: pb.Code_OK,
+	}, nil
+}
+
 func (en *ExtentNode) CommitLength(ctx context.Context, req *pb.CommitLengthRequest) (*pb.CommitLengthResponse, error) {
 
 	errDone := func(err error) (*pb.CommitLengthResponse, error) {
@@ -343,6 +513,20 @@ func (en *ExtentNode) CommitLength(ctx context.Context, req *pb.CommitLengthRequ
 		}
 	}
 
+	//appends hold the lock of extent, so an append is either done or rejected
+	if req.Seal {
+		ex.Lock()
+		var err error
+		if !ex.IsSeal() {
+			err = ex.Seal(ex.CommitLength())
+		}
+		ex.Unlock()
+		if err != nil {
+			en.diskError(ex.diskID, err)
+			return errDone(err)
+		}
+	}
+
 	l := ex.CommitLength()
 	return &pb.CommitLengthResponse{
 		Code:   pb.Code_OK,
@@ -351,24 +535,30 @@ func (en *ExtentNode) CommitLength(ctx context.Context, req *pb.CommitLengthRequ
 }
 
 func (en *ExtentNode) Df(ctx context.Context, req *pb.DfRequest) (*pb.DfResponse, error) {
+	//unknown disks are not reported, only disks set offline by node are reported as offline.
+	//if Df fails, Total is 0, stream manager keeps the last space of the disk
 	dfStatus := make(map[uint64]*pb.DF)
 	for _, diskID := range req.DiskIDs {
 		disk, ok := en.diskFSs[diskID]
-		if ok {
-			total, free, err := disk.Df()
-			if err != nil {
-				dfStatus[diskID] = &pb.DF{
-					0, 0, false,
-				}
-				continue
-			}
-			dfStatus[diskID] = &pb.DF{
-				Total:  total,
-				Free:   free,
-				Online: disk.Online(),
-			}
-		} else { //no such disk
-			dfStatus[diskID] = &pb.DF{0, 0, false}
+		if !ok {
+			continue
+		}
+		total, free, err := disk.Df()
+		if err != nil {
+			en.recordDiskError(disk, err)
+			dfStatus[diskID] = &pb.DF{Online: disk.Online()}
+			continue
+		}
+		if disk.Full() && free >= en.diskPolicy.FullReserve && disk.SetFull(false) {
+			xlog.Logger.Infof("disk %d on %s has %d free bytes, allocate extents on it again", diskID, disk.baseDir, free)
+		}
+		if disk.Full() {
+			free = 0
+		}
+		dfStatus[diskID] = &pb.DF{
+			Total:  total,
+			Free:   free,
+			Online: disk.Online(),
 		}
 	}
 
@@ -394,3 +584,25 @@ func (en *ExtentNode) Df(ctx context.Context, req *pb.DfRequest) (*pb.DfResponse
 		DoneTask:   doneTasks,
 	}, nil
 }
+
+func (en *ExtentNode) WalStats(ctx context.Context, req *pb.WalStatsRequest) (*pb.WalStatsResponse, error) {
+	if en.wal == nil {
+		return &pb.WalStatsResponse{Code: pb.Code_OK}, nil
+	}
+	var dirs []*pb.WalStats
+	for _, stats := range en.wal.Stats() {
+		dirs = append(dirs, &pb.WalStats{
+			Dir:        stats.Dir,
+			Commits:    stats.Commits,
+			Entries:    stats.Entries,
+			Bytes:      stats.Bytes,
+			Latency:    int64(stats.Latency),
+			MaxLatency: int64(stats.MaxLatency),
+		})
+	}
+	return &pb.WalStatsResponse{
+		Code:     pb.Code_OK,
+		Dirs:     dirs,
+		Bypassed: en.wal.Bypassed(),
+	}, nil
+}
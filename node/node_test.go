@@ -3,13 +3,16 @@ package node
 import (
 	"context"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net"
 	"os"
 	"testing"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/journeymidnight/autumn/etcd_utils"
+	"github.com/journeymidnight/autumn/extent/wal"
 	"github.com/journeymidnight/autumn/manager"
 	smclient "github.com/journeymidnight/autumn/manager/smclient"
 	"github.com/journeymidnight/autumn/manager/stream_manager"
@@ -59,6 +62,7 @@ func setupStreamManager(suite *ExtentNodeTestSuite, dir string) {
 		InitialClusterState: "new",
 		ClusterToken:        "sm-cluster-1",
 		GrpcUrl:             "127.0.0.1:3401",
+		MaxTxnOps:           3000,
 	}
 
 	cfg, err := config.GetEmbedConfig()
@@ -153,7 +157,7 @@ func (suite *ExtentNodeTestSuite) SetupSuite() {
 		url := fmt.Sprintf("127.0.0.1:400%d", i)
 		//register to stream manager
 
-		suite.ens[i] = NewExtentNode(nodeIDs[i], []string{dir}, "", url, []string{"127.0.0.1:3401"}, []string{"127.0.0.1:2379"})
+		suite.ens[i] = NewExtentNode(nodeIDs[i], []string{dir}, wal.Options{}, url, []string{"127.0.0.1:3401"}, []string{"127.0.0.1:2379"})
 		err := suite.ens[i].LoadExtents()
 		if err != nil {
 			panic(err)
@@ -220,6 +224,132 @@ func (suite *ExtentNodeTestSuite) TestAppendReadValue() {
 
 }
 
+func (suite *ExtentNodeTestSuite) TestChainAppend() {
+	sm := smclient.NewSMClient([]string{"127.0.0.1:3401"})
+	err := sm.Connect()
+	suite.Require().Nil(err)
+
+	_, _, err = sm.CreateStreamWithReplication(context.Background(), 2, 1, pb.ReplicationMode_Chain)
+	suite.Require().NotNil(err)
+
+	si, _, err := sm.CreateStreamWithReplication(context.Background(), 3, 0, pb.ReplicationMode_Chain)
+	suite.Require().Nil(err)
+	suite.Require().Equal(pb.ReplicationMode_Chain, si.Replication)
+
+	em := smclient.NewExtentManager(sm, []string{"127.0.0.1:2379"}, func(eventType string, cur *pb.ExtentInfo, prev *pb.ExtentInfo) {})
+
+	suite.mutex.Lock(context.Background())
+	defer suite.mutex.Unlock(context.Background())
+	sc := streamclient.NewStreamClient(sm, em, testExtentSize, si.StreamID, streamclient.MutexToLock(suite.mutex))
+	err = sc.Connect()
+	suite.Require().Nil(err)
+	extentID, offsets, end, err := sc.Append(context.Background(),
+		[][]byte{
+			[]byte("hello"),
+			[]byte("world"),
+		}, false)
+	suite.Require().Nil(err)
+
+	//all replicates have blocks
+	for _, nodeID := range em.GetExtentInfo(extentID).Replicates {
+		for _, en := range suite.ens {
+			if en.nodeID == nodeID {
+				suite.Require().Equal(end, en.getExtent(extentID).CommitLength())
+			}
+		}
+	}
+
+	ret, _, err := sc.Read(context.Background(), extentID, offsets[0], 2)
+	suite.Require().Nil(err)
+	suite.Require().Equal([]byte("hello"), ret[0])
+	suite.Require().Equal([]byte("world"), ret[1])
+}
+
+//if a node in the chain fails, the extent is sealed and blocks are appended on a new extent
+func (suite *ExtentNodeTestSuite) TestChainAppendFailure() {
+	sm := smclient.NewSMClient([]string{"127.0.0.1:3401"})
+	err := sm.Connect()
+	suite.Require().Nil(err)
+
+	si, _, err := sm.CreateStreamWithReplication(context.Background(), 3, 0, pb.ReplicationMode_Chain)
+	suite.Require().Nil(err)
+
+	em := smclient.NewExtentManager(sm, []string{"127.0.0.1:2379"}, func(eventType string, cur *pb.ExtentInfo, prev *pb.ExtentInfo) {})
+
+	suite.mutex.Lock(context.Background())
+	defer suite.mutex.Unlock(context.Background())
+	sc := streamclient.NewStreamClient(sm, em, testExtentSize, si.StreamID, streamclient.MutexToLock(suite.mutex))
+	err = sc.Connect()
+	suite.Require().Nil(err)
+	extentID, _, end, err := sc.Append(context.Background(), [][]byte{[]byte("hello")}, false)
+	suite.Require().Nil(err)
+
+	//seal the extent on the tail of chain
+	replicates := em.GetExtentInfo(extentID).Replicates
+	for _, en := range suite.ens {
+		if en.nodeID == replicates[len(replicates)-1] {
+			ex := en.getExtent(extentID)
+			ex.Lock()
+			suite.Require().Nil(ex.Seal(end))
+			ex.Unlock()
+		}
+	}
+
+	newExtentID, offsets, _, err := sc.Append(context.Background(), [][]byte{[]byte("world")}, false)
+	suite.Require().Nil(err)
+	suite.Require().NotEqual(extentID, newExtentID)
+	suite.Require().Equal([]uint64{extentID, newExtentID}, sc.StreamInfo().ExtentIDs)
+
+	sealed := em.Latest(extentID)
+	suite.Require().True(sealed.Avali > 0)
+	suite.Require().Equal(uint64(end), sealed.SealedLength)
+
+	ret, _, err := sc.Read(context.Background(), newExtentID, offsets[0], 1)
+	suite.Require().Nil(err)
+	suite.Require().Equal([]byte("world"), ret[0])
+}
+
+func (suite *ExtentNodeTestSuite) TestDecommissionSealTail() {
+	sm := smclient.NewSMClient([]string{"127.0.0.1:3401"})
+	err := sm.Connect()
+	suite.Require().Nil(err)
+
+	si, _, err := sm.CreateStream(context.Background(), 3, 0)
+	suite.Require().Nil(err)
+
+	em := smclient.NewExtentManager(sm, []string{"127.0.0.1:2379"}, func(eventType string, cur *pb.ExtentInfo, prev *pb.ExtentInfo) {})
+
+	suite.mutex.Lock(context.Background())
+	defer suite.mutex.Unlock(context.Background())
+	sc := streamclient.NewStreamClient(sm, em, testExtentSize, si.StreamID, streamclient.MutexToLock(suite.mutex))
+	err = sc.Connect()
+	suite.Require().Nil(err)
+	extentID, offsets, end, err := sc.Append(context.Background(), [][]byte{[]byte("hello")}, false)
+	suite.Require().Nil(err)
+
+	//the stream is idle, its tail extent is sealed by decommissioning
+	nodeID := em.GetExtentInfo(extentID).Replicates[0]
+	suite.Require().Nil(sm.DecommissionNode(context.Background(), nodeID, false))
+	defer sm.DecommissionNode(context.Background(), nodeID, true)
+
+	var sealed *pb.ExtentInfo
+	for i := 0; i < 30 && (sealed == nil || sealed.Avali == 0); i++ {
+		time.Sleep(time.Second)
+		sealed = em.Latest(extentID)
+	}
+	suite.Require().True(sealed.Avali > 0)
+	suite.Require().Equal(uint64(end), sealed.SealedLength)
+	suite.Require().Nil(sm.DecommissionNode(context.Background(), nodeID, true))
+
+	newExtentID, _, _, err := sc.Append(context.Background(), [][]byte{[]byte("world")}, false)
+	suite.Require().Nil(err)
+	suite.Require().NotEqual(extentID, newExtentID)
+
+	ret, _, err := sc.Read(context.Background(), extentID, offsets[0], 1)
+	suite.Require().Nil(err)
+	suite.Require().Equal([]byte("hello"), ret[0])
+}
+
 func (suite *ExtentNodeTestSuite) TestNodeRecoveryDataFromOtherNode() {
 	sm := smclient.NewSMClient([]string{"127.0.0.1:3401"})
 	err := sm.Connect()
@@ -408,6 +538,165 @@ func (suite *ExtentNodeTestSuite) TestReadLastBlock() {
 	suite.Require().Equal([]byte("DATA2"), b)
 }
 
+//a peer fails in the middle of pipelined appends, the extent is sealed at the last acknowledged
+//offset and windows after it are appended on a new extent
+func (suite *ExtentNodeTestSuite) TestPipelineFailure() {
+	sm := smclient.NewSMClient([]string{"127.0.0.1:3401"})
+	err := sm.Connect()
+	suite.Require().Nil(err)
+
+	si, _, err := sm.CreateStream(context.Background(), 3, 0)
+	suite.Require().Nil(err)
+
+	em := smclient.NewExtentManager(sm, []string{"127.0.0.1:2379"}, func(eventType string, cur *pb.ExtentInfo, prev *pb.ExtentInfo) {})
+
+	suite.mutex.Lock(context.Background())
+	defer suite.mutex.Unlock(context.Background())
+
+	sc := streamclient.NewStreamClient(sm, em, testExtentSize, si.StreamID, streamclient.MutexToLock(suite.mutex))
+	err = sc.Connect()
+	suite.Require().Nil(err)
+	defer sc.Close()
+
+	block := func(i int) []byte {
+		return []byte(fmt.Sprintf("block%d", i))
+	}
+	var futures []*streamclient.AppendFuture
+	for i := 0; i < 3; i++ {
+		futures = append(futures, sc.AppendAsync(context.Background(), [][]byte{block(i)}, false))
+	}
+	//commit end is read while ackLoop acknowledges windows
+	suite.True(sc.CommitEnd() <= testExtentSize)
+	var extentID uint64
+	var acked uint32
+	for _, f := range futures {
+		exID, _, end, err := f.Wait()
+		suite.Require().Nil(err)
+		extentID, acked = exID, end
+	}
+	suite.Require().Equal(acked, sc.CommitEnd())
+
+	//seal the extent on one replica, appends on it fail from now on
+	exInfo := em.GetExtentInfo(extentID)
+	var failed *ExtentOnDisk
+	for _, en := range suite.ens {
+		if en.nodeID == exInfo.Replicates[0] {
+			failed = en.getExtent(extentID)
+		}
+	}
+	suite.Require().NotNil(failed)
+	failed.Lock()
+	suite.Require().Nil(failed.Seal(acked))
+	failed.Unlock()
+
+	for i := 3; i < 7; i++ {
+		futures = append(futures, sc.AppendAsync(context.Background(), [][]byte{block(i)}, false))
+	}
+	for i, f := range futures[3:] {
+		exID, offsets, _, err := f.Wait()
+		suite.Require().Nil(err)
+		suite.Require().NotEqual(extentID, exID)
+
+		ret, _, err := sc.Read(context.Background(), exID, offsets[0], 1)
+		suite.Require().Nil(err)
+		suite.Require().Equal(block(i+3), ret[0])
+	}
+
+	//the old extent is truncated to the last acknowledged offset
+	sealed := em.Latest(extentID)
+	suite.Require().True(sealed.Avali > 0)
+	suite.Require().Equal(uint64(acked), sealed.SealedLength)
+	for i, f := range futures[:3] {
+		exID, offsets, _, _ := f.Wait()
+		ret, _, err := sc.Read(context.Background(), exID, offsets[0], 1)
+		suite.Require().Nil(err)
+		suite.Require().Equal(block(i), ret[0])
+	}
+}
+
+//convert a sealed extent to 2+1 fragments on other nodes by ConvertExtent and WriteFragment
+func (suite *ExtentNodeTestSuite) TestConvertExtent() {
+	sm := smclient.NewSMClient([]string{"127.0.0.1:3401"})
+	err := sm.Connect()
+	suite.Require().Nil(err)
+
+	si, _, err := sm.CreateStream(context.Background(), 1, 0)
+	suite.Require().Nil(err)
+
+	em := smclient.NewExtentManager(sm, []string{"127.0.0.1:2379"}, func(eventType string, cur *pb.ExtentInfo, prev *pb.ExtentInfo) {})
+
+	suite.mutex.Lock(context.Background())
+	defer suite.mutex.Unlock(context.Background())
+	sc := streamclient.NewStreamClient(sm, em, testExtentSize, si.StreamID, streamclient.MutexToLock(suite.mutex))
+	err = sc.Connect()
+	suite.Require().Nil(err)
+	for i := 0; i < 10; i++ {
+		_, _, _, err = sc.Append(context.Background(), [][]byte{[]byte(fmt.Sprintf("block%d", i))}, false)
+		suite.Require().Nil(err)
+	}
+	extentID := sc.StreamInfo().ExtentIDs[0]
+	suite.Require().Nil(sc.MustAllocNewExtent())
+	exInfo := em.WaitVersion(extentID, 2)
+
+	var converter *ExtentNode
+	var targets []*ExtentNode
+	for _, en := range suite.ens {
+		if en.nodeID == exInfo.Replicates[0] {
+			converter = en
+		} else {
+			targets = append(targets, en)
+		}
+	}
+	suite.Require().NotNil(converter)
+	converter.em.WaitVersion(extentID, exInfo.Eversion)
+	var addrs []string
+	for _, en := range targets {
+		addrs = append(addrs, en.listenUrl)
+	}
+
+	res, err := converter.ConvertExtent(context.Background(), &pb.ConvertExtentRequest{
+		ExtentID:    extentID,
+		Eversion:    exInfo.Eversion,
+		DataShard:   2,
+		ParityShard: 1,
+		Targets:     addrs,
+	})
+	suite.Require().Nil(err)
+	suite.Require().Equal(pb.Code_OK, res.Code, res.CodeDes)
+	suite.Require().Equal((exInfo.SealedLength+1)/2, res.FragmentSize)
+	suite.Require().Equal(3, len(res.DiskIDs))
+
+	//data fragments are the original extent
+	read := func(ex *ExtentOnDisk, size uint64) []byte {
+		data, err := ioutil.ReadAll(io.LimitReader(ex.GetReader(), int64(size)))
+		suite.Require().Nil(err)
+		return data
+	}
+	var fragments []byte
+	for i, en := range targets {
+		ex := en.getExtent(extentID)
+		suite.Require().NotNil(ex)
+		suite.Require().True(ex.IsSeal())
+		suite.Require().Equal(uint32(res.FragmentSize), ex.CommitLength())
+		suite.Require().Equal(res.DiskIDs[i], ex.diskID)
+		_, err := os.Stat(en.diskFSs[ex.diskID].pathName(extentID, "frag"))
+		suite.Require().True(os.IsNotExist(err))
+		if i < 2 {
+			fragments = append(fragments, read(ex, res.FragmentSize)...)
+		}
+	}
+	original := read(converter.getExtent(extentID), exInfo.SealedLength)
+	suite.Require().Equal(original, fragments[:exInfo.SealedLength])
+
+	//the old replicate is removed even if the event of conversion is missed
+	converted := proto.Clone(exInfo).(*pb.ExtentInfo)
+	converted.Replicates = []uint64{targets[0].nodeID, targets[1].nodeID}
+	converted.Parity = []uint64{targets[2].nodeID}
+	converted.FragmentSize = res.FragmentSize
+	converter.removeConvertedReplicate(converted)
+	suite.Require().Nil(converter.getExtent(extentID))
+}
+
 func TestNode(t *testing.T) {
 	suite.Run(t, new(ExtentNodeTestSuite))
 }
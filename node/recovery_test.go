@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/journeymidnight/autumn/etcd_utils"
+	"github.com/journeymidnight/autumn/extent/wal"
 	"github.com/journeymidnight/autumn/manager"
 	smclient "github.com/journeymidnight/autumn/manager/smclient"
 	"github.com/journeymidnight/autumn/manager/stream_manager"
@@ -53,6 +54,7 @@ func setupStreamManager1(suite *RcoveryTestSuite, dir string) {
 		InitialClusterState: "new",
 		ClusterToken:        "sm-cluster-1",
 		GrpcUrl:             "127.0.0.1:3401",
+		MaxTxnOps:           3000,
 	}
 
 	cfg, err := config.GetEmbedConfig()
@@ -147,7 +149,7 @@ func (suite *RcoveryTestSuite) SetupSuite() {
 		url := fmt.Sprintf("127.0.0.1:410%d", i)
 		//register to stream manager
 
-		suite.ens[i] = NewExtentNode(nodeIDs[i], []string{dir}, "", url, []string{"127.0.0.1:3401"}, []string{"127.0.0.1:2379"})
+		suite.ens[i] = NewExtentNode(nodeIDs[i], []string{dir}, wal.Options{}, url, []string{"127.0.0.1:3401"}, []string{"127.0.0.1:2379"})
 		err := suite.ens[i].LoadExtents()
 		if err != nil {
 			panic(err)
@@ -29,6 +29,15 @@ func (ps *PartitionServer) checkVersion(partID uint64, key []byte) *range_partit
 	return nil
 }
 
+//storedSize returns how many bytes key takes in rp, used by quota
+func storedSize(rp *range_partition.RangePartition, key []byte) int64 {
+	info, err := rp.Head(key)
+	if err != nil {
+		return 0
+	}
+	return int64(len(key)) + int64(info.Len)
+}
+
 func (ps *PartitionServer) Batch(ctx context.Context, req *pspb.BatchRequest) (*pspb.BatchResponse, error) {
 	return nil, errors.New("not implemented")
 }
@@ -50,11 +59,24 @@ func (ps *PartitionServer) StreamPut(stream pspb.PartitionKV_StreamPutServer) er
 
 	header := req.GetHeader()
 
+	_, err = ps.quota.admit(stream.Context(), int(header.LenOfValue))
+	if err != nil {
+		return err
+	}
+
 	rp := ps.checkVersion(header.Partid, header.GetKey())
 	if rp == nil {
 		return errDone(errors.New("no such partid"))
 	}
 
+	var delta int64
+	if ps.quota.hasQuota(header.Key) {
+		delta = int64(len(header.Key)) + int64(header.LenOfValue) - storedSize(rp, header.Key)
+		if err = ps.quota.checkQuota(header.Key, delta); err != nil {
+			return err
+		}
+	}
+
 	entry := range_partition.NewPutEntry(header.Key, header.ExpiresAt, header.LenOfValue)
 	//received at most header.LenOfValue bytes
 	for {
@@ -77,7 +99,7 @@ func (ps *PartitionServer) StreamPut(stream pspb.PartitionKV_StreamPutServer) er
 		return errDone(errors.Errorf("payload is %d, header.LenOfValue is %d", len(entry.Value), header.LenOfValue))
 	}
 
-	if err = rp.WriteEntries([]*range_partition.Entry{entry}); err != nil {
+	if err = ps.writeEntry(rp, entry); err != nil {
 		if err == wire_errors.LockedByOther {
 			partID := header.Partid
 			defer func() {
@@ -92,6 +114,7 @@ func (ps *PartitionServer) StreamPut(stream pspb.PartitionKV_StreamPutServer) er
 
 		return errDone(err)
 	}
+	ps.quota.chargeQuota(header.Key, delta)
 
 	return stream.SendAndClose(&pspb.PutResponse{
 		Key: []byte(header.Key),
@@ -99,11 +122,22 @@ func (ps *PartitionServer) StreamPut(stream pspb.PartitionKV_StreamPutServer) er
 }
 
 func (ps *PartitionServer) Put(ctx context.Context, req *pspb.PutRequest) (*pspb.PutResponse, error) {
+	_, err := ps.quota.admit(ctx, len(req.Key)+len(req.Value))
+	if err != nil {
+		return nil, err
+	}
 	rp := ps.checkVersion(req.Partid, req.Key)
 	if rp == nil {
 		return nil, errors.New("no such partid")
 	}
-	if err := rp.Write(req.Key, req.Value); err != nil {
+	var delta int64
+	if ps.quota.hasQuota(req.Key) {
+		delta = int64(len(req.Key)+len(req.Value)) - storedSize(rp, req.Key)
+		if err = ps.quota.checkQuota(req.Key, delta); err != nil {
+			return nil, err
+		}
+	}
+	if err := ps.writeEntry(rp, range_partition.NewPutKVEntry(req.Key, req.Value, req.ExpiresAt)); err != nil {
 		if err == wire_errors.LockedByOther {
 			partID := req.Partid
 			defer func() {
@@ -118,14 +152,23 @@ func (ps *PartitionServer) Put(ctx context.Context, req *pspb.PutRequest) (*pspb
 
 		return nil, err
 	}
+	ps.quota.chargeQuota(req.Key, delta)
 	return &pspb.PutResponse{Key: req.Key}, nil
 
 }
 
 func (ps *PartitionServer) Head(ctx context.Context, req *pspb.HeadRequest) (*pspb.HeadResponse, error) {
-	rp := ps.checkVersion(req.Partid, req.Key)
-	if rp == nil {
-		return nil, errors.New("no such partid")
+	if _, err := ps.quota.admit(ctx, 0); err != nil {
+		return nil, err
+	}
+	rp, err := ps.readPartition(req.Partid, req.SnapshotID, req.Key)
+	if err != nil {
+		return nil, err
+	}
+	if req.SnapshotID == 0 {
+		if err = ps.resolveForRead(rp, req.Key); err != nil {
+			return nil, err
+		}
 	}
 	info, err := rp.Head(req.Key)
 	if err != nil {
@@ -139,32 +182,73 @@ func (ps *PartitionServer) Head(ctx context.Context, req *pspb.HeadRequest) (*ps
 
 func (ps *PartitionServer) Get(ctx context.Context, req *pspb.GetRequest) (*pspb.GetResponse, error) {
 
-	rp := ps.checkVersion(req.Partid, req.Key)
-	if rp == nil {
-		return nil, errors.New("no such partid")
+	ts, err := ps.quota.admit(ctx, 0)
+	if err != nil {
+		return nil, err
 	}
 
-	v, err := rp.Get(req.Key)
+	rp, err := ps.readPartition(req.Partid, req.SnapshotID, req.Key)
 	if err != nil {
 		return nil, err
 	}
-	return &pspb.GetResponse{
-		Key:   req.Key,
-		Value: v,
-	}, nil
+
+	v, expiresAt, version, err := ps.getWithIntent(rp, req.Key)
+	var res *pspb.GetResponse
+	if req.AllowNotFound && isNotFound(err) {
+		res = &pspb.GetResponse{
+			Key:      req.Key,
+			Version:  version,
+			NotFound: true,
+		}
+	} else if err != nil {
+		return nil, err
+	} else {
+		//size of value is unknown before reading
+		if err = ts.allowBytes(len(v)); err != nil {
+			return nil, err
+		}
+		res = &pspb.GetResponse{
+			Key:       req.Key,
+			Value:     v,
+			Version:   version,
+			ExpiresAt: expiresAt,
+		}
+	}
+
+	if req.WithOrigin {
+		origin, ok, err := rp.GetOrigin(req.Key)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			res.OriginCluster = origin.ClusterID
+			res.OriginSeq = origin.Seq
+		}
+	}
+	return res, nil
 
 }
 
 func (ps *PartitionServer) Delete(ctx context.Context, req *pspb.DeleteRequest) (*pspb.DeleteResponse, error) {
+	_, err := ps.quota.admit(ctx, len(req.Key))
+	if err != nil {
+		return nil, err
+	}
 	rp := ps.checkVersion(req.Partid, req.Key)
 	if rp == nil {
 		return nil, errors.New("no such partid")
 	}
 
-	err := rp.Delete(req.Key)
+	var delta int64
+	if ps.quota.hasQuota(req.Key) {
+		delta = -storedSize(rp, req.Key)
+	}
+
+	err = ps.writeEntry(rp, range_partition.NewDeleteEntry(req.Key))
 	if err != nil {
 		return nil, err
 	}
+	ps.quota.chargeQuota(req.Key, delta)
 
 	return &pspb.DeleteResponse{
 		Key: req.Key,
@@ -172,14 +256,34 @@ func (ps *PartitionServer) Delete(ctx context.Context, req *pspb.DeleteRequest)
 }
 
 func (ps *PartitionServer) Range(ctx context.Context, req *pspb.RangeRequest) (*pspb.RangeResponse, error) {
-	ps.RLock()
-	rp := ps.rangePartitions[req.Partid]
-	ps.RUnlock()
+	if _, err := ps.quota.admit(ctx, 0); err != nil {
+		return nil, err
+	}
+	var rp *range_partition.RangePartition
+	if req.SnapshotID > 0 {
+		var err error
+		if rp, err = ps.getSnapshotPartition(req.Partid, req.SnapshotID); err != nil {
+			return nil, err
+		}
+	} else {
+		ps.RLock()
+		rp = ps.rangePartitions[req.Partid]
+		ps.RUnlock()
+	}
 	if rp == nil {
 		return nil, errors.New("no such partid")
 	}
 
-	out := rp.Range(req.Prefix, req.Start, req.Limit)
+	out, deleted, seq, intents := rp.RangeWithIntents(req.Prefix, req.Start, req.Limit, req.SinceSeq)
+	if len(intents) > 0 && req.SnapshotID == 0 {
+		//values of decided txns are visible after resolving, range again
+		for _, key := range intents {
+			if err := ps.resolveForRead(rp, key); err != nil {
+				return nil, err
+			}
+		}
+		out, deleted, seq = rp.RangeSince(req.Prefix, req.Start, req.Limit, req.SinceSeq)
+	}
 
 	var truncated bool
 	if len(out) == int(req.Limit) {
@@ -188,8 +292,11 @@ func (ps *PartitionServer) Range(ctx context.Context, req *pspb.RangeRequest) (*
 		truncated = false
 	}
 	return &pspb.RangeResponse{
-		Truncated: truncated,
-		Keys:      out,
+		Truncated:   truncated,
+		Keys:        out,
+		Deleted:     deleted,
+		Seq:         seq,
+		DeleteGCSeq: rp.DeleteGCSeq(),
 	}, nil
 }
 
@@ -0,0 +1,463 @@
+package partition_server
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dgryski/go-farm"
+	"github.com/journeymidnight/autumn/etcd_utils"
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/journeymidnight/autumn/range_partition"
+	"github.com/journeymidnight/autumn/range_partition/y"
+	"github.com/journeymidnight/autumn/secondary_index"
+	"github.com/journeymidnight/autumn/utils"
+	"github.com/journeymidnight/autumn/xlog"
+	"github.com/pkg/errors"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"google.golang.org/grpc/status"
+)
+
+/*
+index entries whose key is in the same range partition as the primary key are
+written in the same request of sendToWriteCh, so they are atomic.
+the old value is read before writing, the write is conditional(WriteEntriesIf) on the
+version of the old value, and retried if the key is updated by others in between.
+index entries on other partitions are queued after the primary key is written, writes of
+the same primary key are serialized by keyLocks, and each of keyLocks has its own queue, so
+remote index entries are written by autumn client in the order of primary key's writes,
+without holding keyLocks. they are eventually consistent, CronTaskCheckIndex repairs them
+if remote writes fail or queues are full
+*/
+
+const (
+	indexCheckBatch    = 1000
+	indexKeyLocks      = 64
+	indexWriteRetries  = 16              //retries of a write whose key is updated by others
+	remoteIndexQueue   = 1024            //max pending remote writes of each keyLocks
+	remoteIndexTimeout = 5 * time.Second //timeout of each remote index entry
+)
+
+type indexManager struct {
+	utils.SafeMutex //protect indexes
+	indexes         map[string]*pspb.IndexInfo
+	etcdClient      *clientv3.Client
+	closeWatch      func()
+	keyLocks        [indexKeyLocks]sync.Mutex
+	remoteChs       [indexKeyLocks]chan []*range_partition.Entry //remote index entries of each keyLocks
+	stopper         *utils.Stopper
+}
+
+func newIndexManager(etcdClient *clientv3.Client) *indexManager {
+	im := &indexManager{
+		indexes:    make(map[string]*pspb.IndexInfo),
+		etcdClient: etcdClient,
+		stopper:    utils.NewStopper(),
+	}
+	for i := range im.remoteChs {
+		im.remoteChs[i] = make(chan []*range_partition.Entry, remoteIndexQueue)
+	}
+	return im
+}
+
+func (im *indexManager) start() error {
+	kvs, rev, err := etcd_utils.EtcdRange(im.etcdClient, secondary_index.EtcdKeyPrefix)
+	if err != nil {
+		return err
+	}
+	im.Lock()
+	for _, kv := range kvs {
+		var info pspb.IndexInfo
+		if err = info.Unmarshal(kv.Value); err != nil {
+			xlog.Logger.Warnf("parse %s: %v", kv.Key, err)
+			continue
+		}
+		im.indexes[info.Name] = &info
+	}
+	im.Unlock()
+
+	watchCh, closeWatch := etcd_utils.EtcdWatchEvents(im.etcdClient, secondary_index.EtcdKeyPrefix, "INDEX0", rev)
+	im.closeWatch = closeWatch
+	go func() {
+		for res := range watchCh {
+			for _, e := range res.Events {
+				switch e.Type.String() {
+				case "PUT":
+					var info pspb.IndexInfo
+					if err := info.Unmarshal(e.Kv.Value); err != nil {
+						xlog.Logger.Warnf("parse %s: %v", e.Kv.Key, err)
+						break
+					}
+					xlog.Logger.Infof("index %s is updated: %+v", info.Name, info)
+					im.Lock()
+					im.indexes[info.Name] = &info
+					im.Unlock()
+				case "DELETE":
+					name := strings.TrimPrefix(string(e.Kv.Key), secondary_index.EtcdKeyPrefix)
+					im.Lock()
+					delete(im.indexes, name)
+					im.Unlock()
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+//startRemoteWriters starts a writer of each queue of remote index entries,
+//ctx of write is canceled when im is closed
+func (im *indexManager) startRemoteWriters(write func(ctx context.Context, entries []*range_partition.Entry)) {
+	for i := range im.remoteChs {
+		ch := im.remoteChs[i]
+		im.stopper.RunWorker(func() {
+			for {
+				select {
+				case entries := <-ch:
+					write(im.stopper.Ctx(), entries)
+				case <-im.stopper.ShouldStop():
+					return
+				}
+			}
+		})
+	}
+}
+
+func (im *indexManager) close() {
+	if im.closeWatch != nil {
+		im.closeWatch()
+	}
+	im.stopper.Stop()
+}
+
+func (im *indexManager) get(name string) *pspb.IndexInfo {
+	im.RLock()
+	defer im.RUnlock()
+	return im.indexes[name]
+}
+
+func (im *indexManager) list() []*pspb.IndexInfo {
+	im.RLock()
+	defer im.RUnlock()
+	ret := make([]*pspb.IndexInfo, 0, len(im.indexes))
+	for _, info := range im.indexes {
+		ret = append(ret, info)
+	}
+	return ret
+}
+
+//match returns all indexes on userKey
+func (im *indexManager) match(userKey []byte) []*pspb.IndexInfo {
+	if secondary_index.IsIndexKey(userKey) {
+		return nil
+	}
+	im.RLock()
+	defer im.RUnlock()
+	var ret []*pspb.IndexInfo
+	for _, info := range im.indexes {
+		if bytes.HasPrefix(userKey, info.KeyPrefix) {
+			ret = append(ret, info)
+		}
+	}
+	return ret
+}
+
+//lockKey serializes index updates of userKey, returns the unlock function
+func (im *indexManager) lockKey(userKey []byte) func() {
+	mu := &im.keyLocks[farm.Fingerprint64(userKey)%indexKeyLocks]
+	mu.Lock()
+	return mu.Unlock
+}
+
+//queueRemote queues remote index entries of userKey, it is called with the lock of userKey held.
+//entries are dropped if the queue is full
+func (im *indexManager) queueRemote(userKey []byte, entries []*range_partition.Entry) {
+	if len(entries) == 0 {
+		return
+	}
+	select {
+	case im.remoteChs[farm.Fingerprint64(userKey)%indexKeyLocks] <- entries:
+	default:
+		//CronTaskCheckIndex will repair them
+		xlog.Logger.Warnf("remote index queue of %q is full, drop %d index entries", userKey, len(entries))
+	}
+}
+
+func isNotFound(err error) bool {
+	//error from remote ps is a grpc status
+	return err != nil && status.Convert(err).Message() == "not found"
+}
+
+//writeEntry writes e into rp and updates secondary indexes of e's key,
+//if e is a delete entry and key does not exist, returns not found.
+//if the key is under a txn intent, the intent is resolved first if its txn is decided,
+//otherwise returns codes.Aborted
+func (ps *PartitionServer) writeEntry(rp *range_partition.RangePartition, e *range_partition.Entry) error {
+	for {
+		err := ps.doWriteEntry(rp, e)
+		if err != range_partition.ErrTxnLocked {
+			return err
+		}
+		if err = ps.resolveLock(rp, y.ParseKey(e.Key)); err != nil {
+			return err
+		}
+	}
+}
+
+func (ps *PartitionServer) doWriteEntry(rp *range_partition.RangePartition, e *range_partition.Entry) error {
+	userKey := y.ParseKey(e.Key)
+	isDelete := e.Meta&uint32(range_partition.BitDelete) > 0
+	indexes := ps.indexes.match(userKey)
+	if len(indexes) == 0 {
+		if isDelete {
+			return rp.Delete(userKey)
+		}
+		return rp.WriteEntries([]*range_partition.Entry{e})
+	}
+
+	unlock := ps.indexes.lockKey(userKey)
+	defer unlock()
+	for retry := 0; ; retry++ {
+		remote, err := ps.writeEntryWithIndex(rp, e, indexes)
+		if err == range_partition.ErrTxnConflict && retry < indexWriteRetries {
+			//the key is updated by others after reading the old value
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		ps.indexes.queueRemote(userKey, remote)
+		return nil
+	}
+}
+
+//writeEntryWithIndex writes e and local index entries if e's key is not updated after reading
+//its old value, returns index entries on other partitions
+func (ps *PartitionServer) writeEntryWithIndex(rp *range_partition.RangePartition, e *range_partition.Entry,
+	indexes []*pspb.IndexInfo) ([]*range_partition.Entry, error) {
+	userKey := y.ParseKey(e.Key)
+	isDelete := e.Meta&uint32(range_partition.BitDelete) > 0
+
+	//read version before value, if the key is updated between them, WriteEntriesIf fails.
+	//WriteEntriesIf is allowed to overwrite intents, so check it here
+	_, version, err := rp.GetIntent(userKey)
+	if err == nil {
+		return nil, range_partition.ErrTxnLocked
+	} else if !isNotFound(err) {
+		return nil, err
+	}
+	oldValue, err := rp.Get(userKey)
+	if err != nil {
+		if isDelete || !isNotFound(err) {
+			return nil, err
+		}
+		oldValue = nil
+	}
+	var newValue []byte
+	if !isDelete {
+		newValue = e.Value
+	}
+
+	entries := []*range_partition.Entry{e}
+	versions := []uint64{version}
+	var remote []*range_partition.Entry
+	add := func(ie *range_partition.Entry) {
+		if rp.IsUserKeyInRange(y.ParseKey(ie.Key)) {
+			entries = append(entries, ie)
+			//index keys are not checked
+			versions = append(versions, math.MaxUint64)
+		} else {
+			remote = append(remote, ie)
+		}
+	}
+	for _, info := range indexes {
+		oldField, hasOld := secondary_index.Extract(info, userKey, oldValue)
+		newField, hasNew := secondary_index.Extract(info, userKey, newValue)
+		if hasOld && hasNew && bytes.Equal(oldField, newField) {
+			continue
+		}
+		if hasOld {
+			add(range_partition.NewDeleteEntry(secondary_index.IndexKey(info.Name, oldField, userKey)))
+		}
+		if hasNew {
+			add(range_partition.NewPutKVEntry(secondary_index.IndexKey(info.Name, newField, userKey), userKey, 0))
+		}
+	}
+
+	if err = rp.WriteEntriesIf(entries, versions); err != nil {
+		return nil, err
+	}
+	return remote, nil
+}
+
+func (ps *PartitionServer) writeRemoteIndex(ctx context.Context, entries []*range_partition.Entry) {
+	for _, e := range entries {
+		var err error
+		indexKey := y.ParseKey(e.Key)
+		writeCtx, cancel := context.WithTimeout(ctx, remoteIndexTimeout)
+		if e.Meta&uint32(range_partition.BitDelete) > 0 {
+			err = ps.autumnClient.Delete(writeCtx, indexKey)
+			if isNotFound(err) {
+				err = nil
+			}
+		} else {
+			err = ps.autumnClient.Put(writeCtx, indexKey, e.Value)
+		}
+		cancel()
+		if err != nil {
+			//CronTaskCheckIndex will repair it
+			xlog.Logger.Warnf("write index entry %q failed: %v", indexKey, err)
+		}
+	}
+}
+
+func (ps *PartitionServer) getKey(rp *range_partition.RangePartition, key []byte) ([]byte, error) {
+	if rp.IsUserKeyInRange(key) {
+		return rp.Get(key)
+	}
+	if ps.autumnClient == nil {
+		return nil, errors.New("no autumn client")
+	}
+	return ps.autumnClient.Get(context.Background(), key)
+}
+
+//IndexRange returns index entries on partition req.Partid,
+//entries whose primary key is in the same partition are verified
+func (ps *PartitionServer) IndexRange(ctx context.Context, req *pspb.IndexRangeRequest) (*pspb.IndexRangeResponse, error) {
+	if _, err := ps.quota.admit(ctx, 0); err != nil {
+		return nil, err
+	}
+	ps.RLock()
+	rp := ps.rangePartitions[req.Partid]
+	ps.RUnlock()
+	if rp == nil {
+		return nil, errors.New("no such partid")
+	}
+	info := ps.indexes.get(req.Index)
+	if info == nil {
+		return nil, errors.Errorf("no such index %s", req.Index)
+	}
+
+	prefix := secondary_index.ValuePrefix(req.Index, req.Value, req.Exact)
+	keys := rp.Range(prefix, prefix, req.Limit)
+	entries := make([]*pspb.IndexEntry, 0, len(keys))
+	for _, key := range keys {
+		field, primaryKey, err := secondary_index.ParseIndexKey(req.Index, key)
+		if err != nil {
+			continue
+		}
+		if rp.IsUserKeyInRange(primaryKey) {
+			value, err := rp.Get(primaryKey)
+			if err != nil {
+				continue
+			}
+			if f, ok := secondary_index.Extract(info, primaryKey, value); !ok || !bytes.Equal(f, field) {
+				continue
+			}
+		}
+		entries = append(entries, &pspb.IndexEntry{
+			Value: field,
+			Key:   primaryKey,
+		})
+	}
+
+	return &pspb.IndexRangeResponse{
+		Truncated: len(keys) == int(req.Limit),
+		Entries:   entries,
+	}, nil
+}
+
+func (ps *PartitionServer) CronTaskCheckIndex() {
+	indexes := ps.indexes.list()
+	if len(indexes) == 0 {
+		return
+	}
+	//copy range partitions
+	ps.RLock()
+	rangePartitions := make([]*range_partition.RangePartition, 0, len(ps.rangePartitions))
+	for _, rp := range ps.rangePartitions {
+		rangePartitions = append(rangePartitions, rp)
+	}
+	ps.RUnlock()
+	for _, rp := range rangePartitions {
+		for _, info := range indexes {
+			ps.checkIndex(rp, info)
+		}
+	}
+}
+
+//rangeAll calls f on all keys with prefix in rp
+func rangeAll(rp *range_partition.RangePartition, prefix []byte, f func(key []byte)) {
+	start := prefix
+	for {
+		keys := rp.Range(prefix, start, indexCheckBatch)
+		for _, key := range keys {
+			f(key)
+		}
+		if len(keys) < indexCheckBatch {
+			return
+		}
+		start = append(y.Copy(keys[len(keys)-1]), 0x00)
+	}
+}
+
+func (ps *PartitionServer) checkIndex(rp *range_partition.RangePartition, info *pspb.IndexInfo) {
+	var added, removed int
+
+	//1. every primary key in rp should have an index entry
+	rangeAll(rp, info.KeyPrefix, func(key []byte) {
+		if secondary_index.IsIndexKey(key) {
+			return
+		}
+		value, err := rp.Get(key)
+		if err != nil {
+			return
+		}
+		field, ok := secondary_index.Extract(info, key, value)
+		if !ok {
+			return
+		}
+		indexKey := secondary_index.IndexKey(info.Name, field, key)
+		if _, err = ps.getKey(rp, indexKey); !isNotFound(err) {
+			return
+		}
+		if rp.IsUserKeyInRange(indexKey) {
+			err = rp.Write(indexKey, key)
+		} else {
+			err = ps.autumnClient.Put(context.Background(), indexKey, key)
+		}
+		if err != nil {
+			xlog.Logger.Warnf("repair index entry %q failed: %v", indexKey, err)
+			return
+		}
+		added++
+	})
+
+	//2. every index entry in rp should point to a primary key which has the same field
+	rangeAll(rp, secondary_index.IndexPrefix(info.Name), func(indexKey []byte) {
+		field, primaryKey, err := secondary_index.ParseIndexKey(info.Name, indexKey)
+		if err == nil {
+			value, err := ps.getKey(rp, primaryKey)
+			if err == nil {
+				if f, ok := secondary_index.Extract(info, primaryKey, value); ok && bytes.Equal(f, field) {
+					return
+				}
+			} else if !isNotFound(err) {
+				//can not tell if index entry is stale
+				return
+			}
+		}
+		if err = rp.Delete(indexKey); err != nil && !isNotFound(err) {
+			xlog.Logger.Warnf("remove index entry %q failed: %v", indexKey, err)
+			return
+		}
+		removed++
+	})
+
+	if added > 0 || removed > 0 {
+		xlog.Logger.Infof("check index %s on range partition %d: %d entries added, %d entries removed",
+			info.Name, rp.PartID, added, removed)
+	}
+}
@@ -0,0 +1,96 @@
+package partition_server
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/journeymidnight/autumn/range_partition"
+	"github.com/journeymidnight/autumn/range_partition/y"
+	"github.com/journeymidnight/autumn/secondary_index"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteEntryConcurrently(t *testing.T) {
+	runPSTest(t, func(t *testing.T, ps *PartitionServer, rp *range_partition.RangePartition) {
+		ps.indexes.indexes["idx"] = &pspb.IndexInfo{
+			Name:      "idx",
+			KeyPrefix: []byte("user/"),
+			Field:     &pspb.IndexInfo_ByteRange{ByteRange: &pspb.ByteRange{Offset: 0, Length: 3}},
+		}
+
+		key := []byte("user/1")
+		var wg sync.WaitGroup
+		for i := 0; i < 50; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				value := []byte(fmt.Sprintf("%03d", i))
+				require.Nil(t, ps.writeEntry(rp, range_partition.NewPutKVEntry(key, value, 0)))
+			}(i)
+		}
+		wg.Wait()
+
+		//only the index entry of the last value is left
+		value, err := rp.Get(key)
+		require.Nil(t, err)
+		indexKeys := rp.Range(secondary_index.IndexPrefix("idx"), secondary_index.IndexPrefix("idx"), 100)
+		require.Equal(t, [][]byte{secondary_index.IndexKey("idx", value, key)}, indexKeys)
+
+		require.Nil(t, ps.writeEntry(rp, range_partition.NewDeleteEntry(key)))
+		require.Equal(t, 0, len(rp.Range(secondary_index.IndexPrefix("idx"), secondary_index.IndexPrefix("idx"), 100)))
+	})
+}
+
+func TestRemoteIndexQueued(t *testing.T) {
+	rp, closeRP := openTestPartition(t, 1, "a", "")
+	defer closeRP()
+	ps := newTestPS(rp)
+	ps.indexes.indexes["idx"] = &pspb.IndexInfo{
+		Name:      "idx",
+		KeyPrefix: []byte("user/"),
+		Field:     &pspb.IndexInfo_ByteRange{ByteRange: &pspb.ByteRange{Offset: 0, Length: 3}},
+	}
+
+	//a hung remote ps does not block writes of the primary key
+	unblock := make(chan struct{})
+	var mu sync.Mutex
+	var written []string
+	ps.indexes.startRemoteWriters(func(ctx context.Context, entries []*range_partition.Entry) {
+		<-unblock
+		mu.Lock()
+		defer mu.Unlock()
+		for _, e := range entries {
+			op := "put"
+			if e.Meta&uint32(range_partition.BitDelete) > 0 {
+				op = "delete"
+			}
+			written = append(written, fmt.Sprintf("%s %q", op, y.ParseKey(e.Key)))
+		}
+	})
+	defer ps.indexes.close()
+
+	key := []byte("user/1")
+	for i := 0; i < 3; i++ {
+		require.Nil(t, ps.writeEntry(rp, range_partition.NewPutKVEntry(key, []byte(fmt.Sprintf("%03d", i)), 0)))
+	}
+	close(unblock)
+
+	//remote index entries are written in the order of writes
+	var expected []string
+	for i := 0; i < 3; i++ {
+		if i > 0 {
+			expected = append(expected, fmt.Sprintf("delete %q", secondary_index.IndexKey("idx", []byte(fmt.Sprintf("%03d", i-1)), key)))
+		}
+		expected = append(expected, fmt.Sprintf("put %q", secondary_index.IndexKey("idx", []byte(fmt.Sprintf("%03d", i)), key)))
+	}
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(written) == len(expected)
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, expected, written)
+}
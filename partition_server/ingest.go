@@ -0,0 +1,131 @@
+package partition_server
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"github.com/journeymidnight/autumn/etcd_utils"
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/journeymidnight/autumn/range_partition"
+	"github.com/journeymidnight/autumn/range_partition/table"
+	"github.com/journeymidnight/autumn/xlog"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxIngestSize        = 256 << 20      //bytes of tables of one IngestTables, they are in memory until ingested
+	replicationKeyPrefix = "replication/" //checkpoints of geo_replication are replication/<name>/<partID>
+)
+
+//ingestReader reads payloads of IngestTablesRequest as a stream of table files
+type ingestReader struct {
+	stream pspb.PartitionKV_IngestTablesServer
+	buf    []byte
+	total  int
+}
+
+func (r *ingestReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		req, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		if req.GetPayload() == nil {
+			return 0, errors.New("expect payload")
+		}
+		r.buf = req.GetPayload()
+		r.total += len(r.buf)
+		if r.total > maxIngestSize {
+			return 0, errors.Errorf("tables are larger than %d bytes", maxIngestSize)
+		}
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+func overlapPrefix(rp *range_partition.RangePartition, prefix []byte) bool {
+	if len(rp.EndKey) > 0 && bytes.Compare(rp.EndKey, prefix) <= 0 {
+		return false
+	}
+	return bytes.Compare(rp.StartKey, prefix) <= 0 || bytes.HasPrefix(rp.StartKey, prefix)
+}
+
+//checkIngest rejects partitions having indexed or replicated keys. ingested keys are not written to
+//logStream, so watchers of replication never see them, and no index entry is written for them
+func (ps *PartitionServer) checkIngest(rp *range_partition.RangePartition) error {
+	for _, info := range ps.indexes.list() {
+		if overlapPrefix(rp, info.KeyPrefix) {
+			return errors.Errorf("partition %d has keys of index %s", rp.PartID, info.Name)
+		}
+	}
+	kvs, _, err := etcd_utils.EtcdRange(ps.etcdClient, replicationKeyPrefix)
+	if err != nil {
+		return err
+	}
+	for _, kv := range kvs {
+		if strings.HasSuffix(string(kv.Key), fmt.Sprintf("/%d", rp.PartID)) {
+			return errors.Errorf("partition %d is replicated, checkpoint %s", rp.PartID, kv.Key)
+		}
+	}
+	return nil
+}
+
+//IngestTables reads all tables into memory before adding them to partition, one IngestTables runs at
+//a time. quota of tenants is not charged, ingestion is an operation of administrators
+func (ps *PartitionServer) IngestTables(stream pspb.PartitionKV_IngestTablesServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	header := req.GetHeader()
+	if header == nil {
+		return status.Error(codes.InvalidArgument, "first message should be header")
+	}
+	ps.RLock()
+	rp := ps.rangePartitions[header.Partid]
+	ps.RUnlock()
+	if rp == nil {
+		return errors.New("no such partid")
+	}
+	if err = ps.checkIngest(rp); err != nil {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if !atomic.CompareAndSwapInt32(&ps.ingesting, 0, 1) {
+		return status.Error(codes.ResourceExhausted, "another ingestion is running")
+	}
+	defer atomic.StoreInt32(&ps.ingesting, 0)
+
+	r := &ingestReader{stream: stream}
+	srcs := make([]*table.Table, 0, header.NumOfTables)
+	closeAll := func() {
+		for _, src := range srcs {
+			src.Close()
+		}
+	}
+	for i := uint32(0); i < header.NumOfTables; i++ {
+		blocks, err := table.ReadTableFile(r)
+		if err != nil {
+			closeAll()
+			return status.Errorf(codes.InvalidArgument, "table %d: %v", i, err)
+		}
+		src, err := blocks.Open()
+		if err != nil {
+			closeAll()
+			return status.Errorf(codes.InvalidArgument, "table %d: %v", i, err)
+		}
+		srcs = append(srcs, src)
+	}
+
+	seq, err := rp.IngestTables(srcs)
+	if err != nil {
+		closeAll()
+		return err
+	}
+	xlog.Logger.Infof("partition %d ingested %d tables of %d bytes", header.Partid, len(srcs), r.total)
+	return stream.SendAndClose(&pspb.IngestTablesResponse{Seq: seq})
+}
@@ -0,0 +1,22 @@
+package partition_server
+
+import (
+	"testing"
+
+	"github.com/journeymidnight/autumn/range_partition"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOverlapPrefix(t *testing.T) {
+	rp := &range_partition.RangePartition{StartKey: []byte("b"), EndKey: []byte("d")}
+	require.True(t, overlapPrefix(rp, nil))
+	require.False(t, overlapPrefix(rp, []byte("a")))
+	require.True(t, overlapPrefix(rp, []byte("bb")))
+	require.True(t, overlapPrefix(rp, []byte("c")))
+	require.False(t, overlapPrefix(rp, []byte("d")))
+	require.True(t, overlapPrefix(rp, []byte("b")))
+
+	rp = &range_partition.RangePartition{StartKey: []byte("b")}
+	require.True(t, overlapPrefix(rp, []byte("z")))
+	require.False(t, overlapPrefix(rp, []byte("a")))
+}
@@ -5,11 +5,14 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.etcd.io/etcd/client/v3/concurrency"
 
+	"github.com/journeymidnight/autumn/autumn_clientv1"
 	"github.com/journeymidnight/autumn/etcd_utils"
 	"github.com/journeymidnight/autumn/manager/smclient"
 	"github.com/journeymidnight/autumn/proto/pspb"
@@ -18,6 +21,7 @@ import (
 	"github.com/journeymidnight/autumn/utils"
 	"github.com/journeymidnight/autumn/xlog"
 	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
 	"github.com/robfig/cron/v3"
 	"github.com/uber/jaeger-client-go/config"
 	"google.golang.org/grpc"
@@ -40,6 +44,8 @@ type Config struct {
 	AssertKeys           bool //Check if all tables' keys are valid
 	GatewayListenURL     string
 	MaxUnCommitedLogSize uint64 //in the unit of Bytes
+	CronTimeIndexCheck   string
+	CronTimeTxnResolve   string
 }
 
 type PartitionServer struct {
@@ -56,12 +62,21 @@ type PartitionServer struct {
 	watchCh             *clientv3.WatchChan
 	closeWatchCh        func()
 	cron                *cron.Cron
+	draining            int32 //atomic, if 1, do not start any new range partition
+	ingesting           int32 //atomic, if 1, an IngestTables is reading tables into memory
+	quota               *quotaManager
+	indexes             *indexManager
+	autumnClient        *autumn_clientv1.AutumnLib //write index entries on other partitions
+	snapshots           map[uint64]*snapshotPart   //snapshotID => read-only range partition
+	snapshotLock        utils.SafeMutex            //protect snapshots
+	closeSnapshotWatch  func()
 }
 
 func NewPartitionServer(config Config) *PartitionServer {
 	return &PartitionServer{
 		rangePartitions:     make(map[uint64]*range_partition.RangePartition),
 		rangePartitionLocks: make(map[uint64]*concurrency.Mutex),
+		snapshots:           make(map[uint64]*snapshotPart),
 		smClient:            smclient.NewSMClient(config.SmURLs),
 		PSID:                config.PSID,
 		config:              config,
@@ -73,6 +88,10 @@ func formatPartLock(partID uint64) string {
 	return fmt.Sprintf("partLock/%d", partID)
 }
 
+func formatPSServerKey(psID uint64) string {
+	return fmt.Sprintf("PSSERVER/%d", psID)
+}
+
 func (ps *PartitionServer) getPartitionMeta(partID uint64) (int64, *pspb.PartitionMeta, error) {
 	/*
 		PART/{PartID} => {id, id <startKey, endKEY>} //immutable
@@ -111,11 +130,7 @@ func (ps *PartitionServer) parseRegionAndStart(regions *pspb.Regions) int64 {
 			if ok {
 				//如果merge或者split存在, 会先close range_partion, 然后再修改regions.
 				//但是允许close range_partition超时或者失败
-				ps.Lock()
-				ps.rangePartitions[region.PartID].Close()
-				delete(ps.rangePartitionLocks, region.PartID)
-				delete(ps.rangePartitions, region.PartID)
-				ps.Unlock()
+				ps.closeRangePartition(region.PartID)
 			}
 			continue
 		}
@@ -125,6 +140,11 @@ func (ps *PartitionServer) parseRegionAndStart(regions *pspb.Regions) int64 {
 			continue
 		}
 
+		//ps is draining, PM will move this PART to other ps
+		if ps.IsDraining() {
+			continue
+		}
+
 		//if PART did not activate, lock and activate
 		fmt.Printf("locking part %d\n", region.PartID)
 		var mutex *concurrency.Mutex
@@ -157,6 +177,13 @@ func (ps *PartitionServer) parseRegionAndStart(regions *pspb.Regions) int64 {
 		}
 		utils.AssertTrue(meta.PartID == region.PartID)
 		ps.Lock()
+		//Drain started after startRangePartition, it has not seen this partition
+		if ps.IsDraining() {
+			ps.Unlock()
+			rp.Close()
+			mutex.Unlock(context.Background())
+			continue
+		}
 		ps.rangePartitionLocks[meta.PartID] = mutex
 		ps.rangePartitions[meta.PartID] = rp
 		ps.Unlock()
@@ -186,6 +213,22 @@ func (ps *PartitionServer) Init() {
 
 	ps.session = session
 
+	//load tenant limits before serving
+	ps.quota = newQuotaManager(ps.etcdClient)
+	if err = ps.quota.start(); err != nil {
+		xlog.Logger.Fatalf(err.Error())
+	}
+
+	ps.indexes = newIndexManager(ps.etcdClient)
+	if err = ps.indexes.start(); err != nil {
+		xlog.Logger.Fatalf(err.Error())
+	}
+	ps.autumnClient = autumn_clientv1.NewAutumnLib(ps.config.EtcdURLs)
+	if err = ps.autumnClient.Connect(); err != nil {
+		xlog.Logger.Fatalf(err.Error())
+	}
+	ps.indexes.startRemoteWriters(ps.writeRemoteIndex)
+
 	//if session is Done, quit
 	go func() {
 		for {
@@ -204,7 +247,7 @@ func (ps *PartitionServer) Init() {
 		PSID:    ps.PSID,
 	}
 
-	keyName := fmt.Sprintf("PSSERVER/%d", ps.PSID)
+	keyName := formatPSServerKey(ps.PSID)
 	cmp := []clientv3.Cmp{clientv3.Compare(clientv3.CreateRevision(keyName), "=", 0)}
 	ops := []clientv3.Op{
 		clientv3.OpPut(keyName, string(utils.MustMarshal(&detail)), clientv3.WithLease(session.Lease())),
@@ -249,12 +292,21 @@ func (ps *PartitionServer) Init() {
 	}()
 	ps.watchCh = &watchConfigCh
 	ps.closeWatchCh = closeWatchCh
+	ps.watchSnapshots(rev)
 
 	//start cron task:
 
 	ps.cron.AddFunc(ps.config.CronTimeGC, ps.CronTaskGC)
 
 	ps.cron.AddFunc(ps.config.CronTimeMajorCompact, ps.CronTaskCompact)
+
+	if len(ps.config.CronTimeIndexCheck) > 0 {
+		ps.cron.AddFunc(ps.config.CronTimeIndexCheck, ps.CronTaskCheckIndex)
+	}
+	if len(ps.config.CronTimeTxnResolve) > 0 {
+		ps.cron.AddFunc(ps.config.CronTimeTxnResolve, ps.CronTaskResolveTxn)
+	}
+	ps.cron.AddFunc("@every 1m", ps.CronTaskCloseSnapshots)
 }
 
 func (ps *PartitionServer) CronTaskGC() {
@@ -285,7 +337,16 @@ func (ps *PartitionServer) CronTaskCompact() {
 	}
 }
 
+//startRangePartition refuses new partitions while draining
 func (ps *PartitionServer) startRangePartition(meta *pspb.PartitionMeta, mutex *concurrency.Mutex) (*range_partition.RangePartition, error) {
+	if ps.IsDraining() {
+		return nil, errors.Errorf("ps %d is draining, do not start range partition %d", ps.PSID, meta.PartID)
+	}
+	return ps.openRangePartition(meta, streamclient.MutexToLock(mutex))
+}
+
+//openRangePartition opens streams of meta with lock, extra options are appended to options of ps
+func (ps *PartitionServer) openRangePartition(meta *pspb.PartitionMeta, lock streamclient.StreamLock, extra ...range_partition.OptionFunc) (*range_partition.RangePartition, error) {
 	//1. pmclient get info
 	//2. streamclient connect
 	//3. open RangePartition
@@ -303,18 +364,18 @@ func (ps *PartitionServer) startRangePartition(meta *pspb.PartitionMeta, mutex *
 		}
 	}
 
-	row = streamclient.NewStreamClient(ps.smClient, ps.extentManager, ps.config.MaxExtentSize, meta.RowStream, streamclient.MutexToLock(mutex))
+	row = streamclient.NewStreamClient(ps.smClient, ps.extentManager, ps.config.MaxExtentSize, meta.RowStream, lock)
 	if err := row.Connect(); err != nil {
 		return nil, err
 	}
 
-	log = streamclient.NewStreamClient(ps.smClient, ps.extentManager, ps.config.MaxExtentSize, meta.LogStream, streamclient.MutexToLock(mutex))
+	log = streamclient.NewStreamClient(ps.smClient, ps.extentManager, ps.config.MaxExtentSize, meta.LogStream, lock)
 	if err := log.Connect(); err != nil {
 		cleanup()
 		return nil, err
 	}
 
-	metaLog = streamclient.NewStreamClient(ps.smClient, ps.extentManager, ps.config.MaxMetaExtentSize, meta.MetaStream, streamclient.MutexToLock(mutex))
+	metaLog = streamclient.NewStreamClient(ps.smClient, ps.extentManager, ps.config.MaxMetaExtentSize, meta.MetaStream, lock)
 	if err := metaLog.Connect(); err != nil {
 		cleanup()
 		return nil, err
@@ -329,11 +390,13 @@ func (ps *PartitionServer) startRangePartition(meta *pspb.PartitionMeta, mutex *
 		range_partition.WithSync(ps.config.MustSync),
 		range_partition.WithCompression(ps.config.Compression),
 		range_partition.WithMaxUnCommitedLogSize(ps.config.MaxUnCommitedLogSize),
+		range_partition.WithOnExpired(ps.quota.creditExpired),
 	}
 
 	if ps.config.AssertKeys {
 		options = append(options, range_partition.WithAssertKeys())
 	}
+	options = append(options, extra...)
 	rp, err := range_partition.OpenRangePartition(meta.PartID, metaLog, row, log, meta.Rg.StartKey, meta.Rg.EndKey, options...)
 
 	xlog.Logger.Infof("open range partition %d, StartKey:[%s], EndKey:[%s]: err is %v", meta.PartID, meta.Rg.StartKey, meta.Rg.EndKey, err)
@@ -376,6 +439,75 @@ func (ps *PartitionServer) ServeGRPC(traceSampler float64) error {
 	return nil
 }
 
+//closeRangePartition stops writes on partID, flushes its memtable and releases
+//partLock, so the next ps does not have to wait for the session lease to expire
+func (ps *PartitionServer) closeRangePartition(partID uint64) {
+	ps.Lock()
+	rp, ok := ps.rangePartitions[partID]
+	mutex := ps.rangePartitionLocks[partID]
+	delete(ps.rangePartitions, partID)
+	delete(ps.rangePartitionLocks, partID)
+	ps.Unlock()
+	if !ok {
+		return
+	}
+
+	if err := rp.Close(); err != nil {
+		xlog.Logger.Errorf("close range partition %d: %v", partID, err)
+	}
+	if mutex != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		if err := mutex.Unlock(ctx); err != nil {
+			xlog.Logger.Warnf("unlock range partition %d: %v", partID, err)
+		}
+		cancel()
+	}
+	xlog.Logger.Infof("range partition %d closed", partID)
+}
+
+func (ps *PartitionServer) IsDraining() bool {
+	return atomic.LoadInt32(&ps.draining) == 1
+}
+
+//Drain hands all range partitions over to other partition servers:
+//1. stop accepting new partitions
+//2. remove PSSERVER/{PSID}, PM will reassign its partitions to other ps
+//3. for each partition, block writes, flush memtable and release partLock
+//grpc server is still running, after Drain, call Shutdown
+func (ps *PartitionServer) Drain() {
+	if !atomic.CompareAndSwapInt32(&ps.draining, 0, 1) {
+		return
+	}
+	xlog.Logger.Infof("ps %d is draining", ps.PSID)
+
+	keyName := formatPSServerKey(ps.PSID)
+	if err := etcd_utils.EtcdSetKVS(ps.etcdClient, nil, []clientv3.Op{
+		clientv3.OpDelete(keyName),
+	}); err != nil {
+		//PM will reassign partitions after session expires
+		xlog.Logger.Warnf("delete %s failed: %v", keyName, err)
+	}
+
+	ps.RLock()
+	partIDs := make([]uint64, 0, len(ps.rangePartitions))
+	for partID := range ps.rangePartitions {
+		partIDs = append(partIDs, partID)
+	}
+	ps.RUnlock()
+
+	//flush memtables in parallel
+	var wg sync.WaitGroup
+	for _, partID := range partIDs {
+		wg.Add(1)
+		go func(partID uint64) {
+			defer wg.Done()
+			ps.closeRangePartition(partID)
+		}(partID)
+	}
+	wg.Wait()
+	xlog.Logger.Infof("ps %d drained %d partitions", ps.PSID, len(partIDs))
+}
+
 func (ps *PartitionServer) Shutdown() {
 
 	//1. close grpc server
@@ -386,12 +518,26 @@ func (ps *PartitionServer) Shutdown() {
 	//1.5 close all crontab tasks
 	ps.cron.Stop()
 
+	//save quota usage
+	ps.quota.close()
+	ps.indexes.close()
+
 	//2. close all range partition
 	ps.Lock()
 	for _, rp := range ps.rangePartitions {
 		rp.Close()
 	}
 	ps.Unlock()
+	if ps.closeSnapshotWatch != nil {
+		ps.closeSnapshotWatch()
+	}
+	ps.snapshotLock.Lock()
+	for _, sp := range ps.snapshots {
+		if sp.opened() {
+			sp.rp.Close()
+		}
+	}
+	ps.snapshotLock.Unlock()
 	time.Sleep(300 * time.Millisecond)
 	//FIXME, will release all mutex as well
 	ps.session.Close()
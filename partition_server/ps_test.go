@@ -0,0 +1,155 @@
+package partition_server
+
+import (
+	"context"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/journeymidnight/autumn/etcd_utils"
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/journeymidnight/autumn/range_partition"
+	"github.com/journeymidnight/autumn/streamclient"
+	"github.com/journeymidnight/autumn/xlog"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/etcd/client/v3/concurrency"
+	"go.etcd.io/etcd/server/v3/embed"
+	"go.uber.org/zap/zapcore"
+)
+
+func init() {
+	xlog.InitLog([]string{"ps.log"}, zapcore.DebugLevel)
+}
+
+//openTestPartition opens range partition [start, end) on mock streams, close removes the streams
+func openTestPartition(t *testing.T, id uint64, start, end string) (*range_partition.RangePartition, func()) {
+	logStream := streamclient.NewMockStreamClient("log")
+	rowStream := streamclient.NewMockStreamClient("sst")
+	metaStream := streamclient.NewMockStreamClient("meta")
+
+	rp, err := range_partition.OpenRangePartition(id, metaStream, rowStream, logStream,
+		[]byte(start), []byte(end), range_partition.TestOption())
+	require.Nil(t, err)
+	return rp, func() {
+		require.NoError(t, rp.Close())
+		logStream.Close()
+		rowStream.Close()
+		metaStream.Close()
+	}
+}
+
+func newTestPS(rps ...*range_partition.RangePartition) *PartitionServer {
+	ps := &PartitionServer{
+		rangePartitions: make(map[uint64]*range_partition.RangePartition),
+		quota:           newQuotaManager(nil),
+		indexes:         newIndexManager(nil),
+	}
+	for _, rp := range rps {
+		ps.rangePartitions[rp.PartID] = rp
+	}
+	return ps
+}
+
+//runPSTest runs test on a partition server with one partition of id 1 on mock streams
+func runPSTest(t *testing.T, test func(t *testing.T, ps *PartitionServer, rp *range_partition.RangePartition)) {
+	rp, close := openTestPartition(t, 1, "", "")
+	defer close()
+	test(t, newTestPS(rp), rp)
+}
+
+func TestDrain(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "ps_drain")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	//ports must not conflict with snapshot_test.go and txn_test.go
+	config := embed.NewConfig()
+	config.Name = "etcd"
+	config.Dir = dir + "/etcd.db"
+	clientURL, _ := url.Parse("http://127.0.0.1:32399")
+	peerURL, _ := url.Parse("http://127.0.0.1:32400")
+	config.LCUrls, config.ACUrls = []url.URL{*clientURL}, []url.URL{*clientURL}
+	config.LPUrls, config.APUrls = []url.URL{*peerURL}, []url.URL{*peerURL}
+	config.InitialCluster = "etcd=http://127.0.0.1:32400"
+	config.ClusterState = "new"
+	config.LogLevel = "fatal"
+	etcd, client, err := etcd_utils.ServeETCD(config)
+	require.Nil(t, err)
+	defer etcd.Close()
+	defer client.Close()
+
+	session, err := concurrency.NewSession(client, concurrency.WithTTL(60))
+	require.Nil(t, err)
+	defer session.Close()
+	mutex := concurrency.NewMutex(session, formatPartLock(1))
+	require.Nil(t, mutex.Lock(context.Background()))
+	require.Nil(t, etcd_utils.EtcdSetKV(client, formatPSServerKey(1), []byte("127.0.0.1:9955")))
+
+	logStream := streamclient.NewMockStreamClient("log")
+	rowStream := streamclient.NewMockStreamClient("sst")
+	metaStream := streamclient.NewMockStreamClient("meta")
+	defer logStream.Close()
+	defer rowStream.Close()
+	defer metaStream.Close()
+	rp, err := range_partition.OpenRangePartition(1, metaStream, rowStream, logStream,
+		[]byte(""), []byte(""), range_partition.TestOption())
+	require.Nil(t, err)
+
+	ps := newTestPS(rp)
+	ps.PSID = 1
+	ps.etcdClient = client
+	ps.session = session
+	ps.rangePartitionLocks = map[uint64]*concurrency.Mutex{1: mutex}
+	ps.Drain()
+	require.True(t, ps.IsDraining())
+	require.Equal(t, 0, len(ps.rangePartitions))
+	require.Equal(t, 0, len(ps.rangePartitionLocks))
+
+	//PSSERVER/{PSID} is removed
+	value, _, err := etcd_utils.EtcdGetKV(client, formatPSServerKey(1))
+	require.Nil(t, err)
+	require.Nil(t, value)
+
+	//partLock is released, the next ps could lock it at once
+	other, err := concurrency.NewSession(client, concurrency.WithTTL(60))
+	require.Nil(t, err)
+	defer other.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	otherMutex := concurrency.NewMutex(other, formatPartLock(1))
+	require.Nil(t, otherMutex.Lock(ctx))
+	require.Nil(t, otherMutex.Unlock(ctx))
+
+	//no partition is started while draining
+	_, err = ps.startRangePartition(&pspb.PartitionMeta{PartID: 1}, mutex)
+	require.NotNil(t, err)
+	ps.parseRegionAndStart(&pspb.Regions{Regions: map[uint64]*pspb.RegionInfo{
+		1: {Rg: &pspb.Range{StartKey: []byte(""), EndKey: []byte("")}, PartID: 1, PSID: 1},
+	}})
+	require.Equal(t, 0, len(ps.rangePartitions))
+}
+
+func TestGetExpiresAt(t *testing.T) {
+	runPSTest(t, func(t *testing.T, ps *PartitionServer, rp *range_partition.RangePartition) {
+		expiresAt := uint64(time.Now().Add(time.Hour).Unix())
+		require.Nil(t, rp.WriteEntries([]*range_partition.Entry{
+			range_partition.NewPutKVEntry([]byte("ttl"), []byte("value"), expiresAt),
+			range_partition.NewPutKVEntry([]byte("forever"), []byte("value"), 0),
+		}))
+		res, err := ps.Get(context.Background(), &pspb.GetRequest{Key: []byte("ttl"), Partid: 1, WithOrigin: true})
+		require.Nil(t, err)
+		require.Equal(t, expiresAt, res.ExpiresAt)
+		res, err = ps.Get(context.Background(), &pspb.GetRequest{Key: []byte("forever"), Partid: 1})
+		require.Nil(t, err)
+		require.Equal(t, uint64(0), res.ExpiresAt)
+
+		//Put keeps the TTL of request
+		_, err = ps.Put(context.Background(), &pspb.PutRequest{Key: []byte("put"), Value: []byte("value"), ExpiresAt: expiresAt, Partid: 1})
+		require.Nil(t, err)
+		res, err = ps.Get(context.Background(), &pspb.GetRequest{Key: []byte("put"), Partid: 1})
+		require.Nil(t, err)
+		require.Equal(t, expiresAt, res.ExpiresAt)
+	})
+}
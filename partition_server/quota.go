@@ -0,0 +1,342 @@
+package partition_server
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/journeymidnight/autumn/etcd_utils"
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/journeymidnight/autumn/utils"
+	"github.com/journeymidnight/autumn/xlog"
+	"github.com/pkg/errors"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+/*
+TENANT/{tenant} => {TenantLimit}
+QUOTAUSAGE/{tenant}/{hex(prefix)} => used bytes
+
+tenant id is read from grpc metadata "tenant", requests without tenant are not rate limited.
+prefix quotas of a tenant limit the size of all keys under the prefixes, whoever writes them,
+so usage is accounted and quotas are enforced by keys, not by tenants of requests. usage of a key
+is credited when it is deleted, overwritten, or dropped by major compaction after it expires.
+every ps keeps local delta of quota usage and merges it into etcd periodically,
+so quota is soft, all ps could exceed quota a little bit at the same time
+*/
+
+const (
+	TenantMetadataKey   = "tenant"
+	tenantKeyPrefix     = "TENANT/"
+	quotaUsageKeyPrefix = "QUOTAUSAGE/"
+	quotaSyncInterval   = time.Second
+)
+
+func FormatTenantKey(tenant string) string {
+	return tenantKeyPrefix + tenant
+}
+
+func formatQuotaUsageKey(tenant string, prefix []byte) string {
+	return fmt.Sprintf("%s%s/%s", quotaUsageKeyPrefix, tenant, hex.EncodeToString(prefix))
+}
+
+type prefixUsage struct {
+	used    int64 //last value read from etcd
+	pending int64 //local delta which is not saved into etcd
+}
+
+type tenantState struct {
+	limit      *pspb.TenantLimit
+	opsLimiter *rate.Limiter //nil if unlimited
+	bwLimiter  *rate.Limiter //nil if unlimited
+}
+
+type quotaManager struct {
+	utils.SafeMutex //protect tenants and usage
+	tenants         map[string]*tenantState
+	usage           map[string]*prefixUsage //formatQuotaUsageKey => usage
+	etcdClient      *clientv3.Client
+	stopper         *utils.Stopper
+	closeWatch      func()
+}
+
+func newQuotaManager(etcdClient *clientv3.Client) *quotaManager {
+	return &quotaManager{
+		tenants:    make(map[string]*tenantState),
+		usage:      make(map[string]*prefixUsage),
+		etcdClient: etcdClient,
+		stopper:    utils.NewStopper(),
+	}
+}
+
+func newTenantState(limit *pspb.TenantLimit) *tenantState {
+	ts := &tenantState{
+		limit: limit,
+	}
+	if limit.OpsPerSec > 0 {
+		ts.opsLimiter = rate.NewLimiter(rate.Limit(limit.OpsPerSec), int(limit.OpsPerSec))
+	}
+	if limit.BytesPerSec > 0 {
+		ts.bwLimiter = rate.NewLimiter(rate.Limit(limit.BytesPerSec), int(limit.BytesPerSec))
+	}
+	return ts
+}
+
+func (qm *quotaManager) setLimit(limit *pspb.TenantLimit) {
+	qm.Lock()
+	defer qm.Unlock()
+	qm.tenants[limit.Tenant] = newTenantState(limit)
+	for _, q := range limit.Quotas {
+		k := formatQuotaUsageKey(limit.Tenant, q.Prefix)
+		if _, ok := qm.usage[k]; !ok {
+			qm.usage[k] = &prefixUsage{}
+		}
+	}
+}
+
+func (qm *quotaManager) start() error {
+	kvs, rev, err := etcd_utils.EtcdRange(qm.etcdClient, tenantKeyPrefix)
+	if err != nil {
+		return err
+	}
+	for _, kv := range kvs {
+		var limit pspb.TenantLimit
+		if err = limit.Unmarshal(kv.Value); err != nil {
+			xlog.Logger.Warnf("parse %s: %v", kv.Key, err)
+			continue
+		}
+		qm.setLimit(&limit)
+	}
+	qm.syncUsage()
+
+	watchCh, closeWatch := etcd_utils.EtcdWatchEvents(qm.etcdClient, tenantKeyPrefix, "TENANT0", rev)
+	qm.closeWatch = closeWatch
+	go func() {
+		for res := range watchCh {
+			for _, e := range res.Events {
+				switch e.Type.String() {
+				case "PUT":
+					var limit pspb.TenantLimit
+					if err := limit.Unmarshal(e.Kv.Value); err != nil {
+						xlog.Logger.Warnf("parse %s: %v", e.Kv.Key, err)
+						break
+					}
+					xlog.Logger.Infof("tenant %s limit changed: %+v", limit.Tenant, limit)
+					qm.setLimit(&limit)
+				case "DELETE":
+					tenant := strings.TrimPrefix(string(e.Kv.Key), tenantKeyPrefix)
+					qm.Lock()
+					delete(qm.tenants, tenant)
+					qm.Unlock()
+				}
+			}
+		}
+	}()
+
+	qm.stopper.RunWorker(func() {
+		ticker := time.NewTicker(quotaSyncInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-qm.stopper.ShouldStop():
+				qm.syncUsage()
+				return
+			case <-ticker.C:
+				qm.syncUsage()
+			}
+		}
+	})
+	return nil
+}
+
+func (qm *quotaManager) close() {
+	if qm.closeWatch != nil {
+		qm.closeWatch()
+	}
+	qm.stopper.Stop()
+}
+
+//syncUsage merges local pending delta into etcd and reloads the usage of other ps
+func (qm *quotaManager) syncUsage() {
+	qm.RLock()
+	keys := make([]string, 0, len(qm.usage))
+	for k := range qm.usage {
+		keys = append(keys, k)
+	}
+	qm.RUnlock()
+
+	for _, k := range keys {
+		qm.Lock()
+		u := qm.usage[k]
+		pending := u.pending
+		u.pending = 0
+		qm.Unlock()
+
+		used, err := qm.addUsage(k, pending)
+
+		qm.Lock()
+		if err != nil {
+			xlog.Logger.Warnf("sync quota usage %s: %v", k, err)
+			//put it back, try next time
+			u.pending += pending
+		} else {
+			u.used = used
+		}
+		qm.Unlock()
+	}
+}
+
+//addUsage add delta to etcd's value of key and returns the new value
+func (qm *quotaManager) addUsage(key string, delta int64) (int64, error) {
+	for i := 0; i < 3; i++ {
+		data, _, err := etcd_utils.EtcdGetKV(qm.etcdClient, key)
+		if err != nil {
+			return 0, err
+		}
+		var used int64
+		if len(data) > 0 {
+			if used, err = strconv.ParseInt(string(data), 10, 64); err != nil {
+				return 0, err
+			}
+		}
+		if delta == 0 {
+			return used, nil
+		}
+		used += delta
+		if used < 0 {
+			used = 0
+		}
+		var cmp clientv3.Cmp
+		if len(data) == 0 {
+			cmp = clientv3.Compare(clientv3.CreateRevision(key), "=", 0)
+		} else {
+			cmp = clientv3.Compare(clientv3.Value(key), "=", string(data))
+		}
+		err = etcd_utils.EtcdSetKVS(qm.etcdClient, []clientv3.Cmp{cmp}, []clientv3.Op{
+			clientv3.OpPut(key, strconv.FormatInt(used, 10)),
+		})
+		if err == nil {
+			return used, nil
+		}
+	}
+	return 0, errors.Errorf("update %s conflicts", key)
+}
+
+func tenantFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	vals := md.Get(TenantMetadataKey)
+	if len(vals) == 0 {
+		return ""
+	}
+	return vals[0]
+}
+
+//admit checks ops/sec and bytes/sec of ctx's tenant, size is the number of bytes of the request.
+//returns nil tenantState if tenant has no limit
+func (qm *quotaManager) admit(ctx context.Context, size int) (*tenantState, error) {
+	tenant := tenantFromContext(ctx)
+	if len(tenant) == 0 {
+		return nil, nil
+	}
+	qm.RLock()
+	ts := qm.tenants[tenant]
+	qm.RUnlock()
+	if ts == nil {
+		return nil, nil
+	}
+	if ts.opsLimiter != nil && !ts.opsLimiter.Allow() {
+		return nil, status.Errorf(codes.ResourceExhausted, "tenant %s exceeds %d ops/sec", tenant, ts.limit.OpsPerSec)
+	}
+	if err := ts.allowBytes(size); err != nil {
+		return nil, err
+	}
+	return ts, nil
+}
+
+func (ts *tenantState) allowBytes(size int) error {
+	if ts == nil || ts.bwLimiter == nil || size == 0 {
+		return nil
+	}
+	//requests larger than burst can never be allowed, take the whole bucket
+	if size > ts.bwLimiter.Burst() {
+		size = ts.bwLimiter.Burst()
+	}
+	if !ts.bwLimiter.AllowN(time.Now(), size) {
+		return status.Errorf(codes.ResourceExhausted, "tenant %s exceeds %d bytes/sec", ts.limit.Tenant, ts.limit.BytesPerSec)
+	}
+	return nil
+}
+
+//hasQuota returns true if key is under any prefix quota
+func (qm *quotaManager) hasQuota(key []byte) bool {
+	qm.RLock()
+	defer qm.RUnlock()
+	for _, ts := range qm.tenants {
+		for _, q := range ts.limit.Quotas {
+			if bytes.HasPrefix(key, q.Prefix) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+//checkQuota returns ResourceExhausted if writing delta bytes to key exceeds any prefix quota
+func (qm *quotaManager) checkQuota(key []byte, delta int64) error {
+	if delta <= 0 {
+		return nil
+	}
+	qm.RLock()
+	defer qm.RUnlock()
+	for _, ts := range qm.tenants {
+		for _, q := range ts.limit.Quotas {
+			if q.MaxBytes == 0 || !bytes.HasPrefix(key, q.Prefix) {
+				continue
+			}
+			u := qm.usage[formatQuotaUsageKey(ts.limit.Tenant, q.Prefix)]
+			if u == nil {
+				continue
+			}
+			if u.used+u.pending+delta > int64(q.MaxBytes) {
+				return status.Errorf(codes.ResourceExhausted, "tenant %s exceeds quota %d bytes on prefix [%s]",
+					ts.limit.Tenant, q.MaxBytes, q.Prefix)
+			}
+		}
+	}
+	return nil
+}
+
+//chargeQuota adds delta to usage of all prefix quotas on key, it is called after write/delete succeeded
+func (qm *quotaManager) chargeQuota(key []byte, delta int64) {
+	if delta == 0 {
+		return
+	}
+	qm.Lock()
+	defer qm.Unlock()
+	for _, ts := range qm.tenants {
+		for _, q := range ts.limit.Quotas {
+			if !bytes.HasPrefix(key, q.Prefix) {
+				continue
+			}
+			if u := qm.usage[formatQuotaUsageKey(ts.limit.Tenant, q.Prefix)]; u != nil {
+				u.pending += delta
+			}
+		}
+	}
+}
+
+//creditExpired credits usage of an expired key which is dropped by major compaction
+func (qm *quotaManager) creditExpired(userKey []byte, size int64) {
+	qm.chargeQuota(userKey, -size)
+}
@@ -0,0 +1,107 @@
+package partition_server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/journeymidnight/autumn/range_partition"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func tenantContext(tenant string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(TenantMetadataKey, tenant))
+}
+
+func TestAdmit(t *testing.T) {
+	qm := newQuotaManager(nil)
+	qm.setLimit(&pspb.TenantLimit{
+		Tenant:      "t1",
+		OpsPerSec:   2,
+		BytesPerSec: 100,
+	})
+
+	//no tenant, no limit
+	ts, err := qm.admit(context.Background(), 1000)
+	require.Nil(t, err)
+	require.Nil(t, ts)
+
+	//unknown tenant
+	ts, err = qm.admit(tenantContext("t2"), 1000)
+	require.Nil(t, err)
+	require.Nil(t, ts)
+
+	ts, err = qm.admit(tenantContext("t1"), 10)
+	require.Nil(t, err)
+	require.NotNil(t, ts)
+
+	//bytes/sec
+	_, err = qm.admit(tenantContext("t1"), 100)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	//ops/sec
+	_, err = qm.admit(tenantContext("t1"), 0)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestPrefixQuota(t *testing.T) {
+	qm := newQuotaManager(nil)
+	qm.setLimit(&pspb.TenantLimit{
+		Tenant: "t1",
+		Quotas: []*pspb.PrefixQuota{
+			{Prefix: []byte("a/"), MaxBytes: 100},
+		},
+	})
+	require.True(t, qm.hasQuota([]byte("a/1")))
+	require.False(t, qm.hasQuota([]byte("b/1")))
+
+	require.Nil(t, qm.checkQuota([]byte("a/1"), 80))
+	qm.chargeQuota([]byte("a/1"), 80)
+
+	err := qm.checkQuota([]byte("a/2"), 30)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	//other prefix is not limited
+	require.Nil(t, qm.checkQuota([]byte("b/2"), 30))
+
+	//delete frees quota
+	qm.chargeQuota([]byte("a/1"), -80)
+	require.Nil(t, qm.checkQuota([]byte("a/2"), 30))
+}
+
+func TestPrefixQuotaOfAllWriters(t *testing.T) {
+	runPSTest(t, func(t *testing.T, ps *PartitionServer, rp *range_partition.RangePartition) {
+		ps.quota.setLimit(&pspb.TenantLimit{
+			Tenant: "t1",
+			Quotas: []*pspb.PrefixQuota{
+				{Prefix: []byte("a/"), MaxBytes: 100},
+			},
+		})
+		usage := func() int64 {
+			u := ps.quota.usage[formatQuotaUsageKey("t1", []byte("a/"))]
+			return u.used + u.pending
+		}
+		value := make([]byte, 37)
+
+		//writes without tenant and of other tenants are accounted and limited
+		_, err := ps.Put(context.Background(), &pspb.PutRequest{Key: []byte("a/1"), Value: value, Partid: 1})
+		require.Nil(t, err)
+		_, err = ps.Put(tenantContext("t2"), &pspb.PutRequest{Key: []byte("a/2"), Value: value, Partid: 1})
+		require.Nil(t, err)
+		require.Equal(t, int64(80), usage())
+		_, err = ps.Put(context.Background(), &pspb.PutRequest{Key: []byte("a/3"), Value: value, Partid: 1})
+		require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+		//deletes of others credit the owner
+		_, err = ps.Delete(tenantContext("t2"), &pspb.DeleteRequest{Key: []byte("a/1"), Partid: 1})
+		require.Nil(t, err)
+		require.Equal(t, int64(40), usage())
+
+		//so do expired keys dropped by major compaction
+		ps.quota.creditExpired([]byte("a/2"), 40)
+		require.Equal(t, int64(0), usage())
+	})
+}
@@ -0,0 +1,53 @@
+package partition_server
+
+import (
+	"context"
+
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/journeymidnight/autumn/range_partition"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//Replicate applies ops from another cluster with last-writer-wins. like IngestTables, quota of tenants
+//is not charged, and secondary indexes are not updated, index keys are replicated as other keys
+func (ps *PartitionServer) Replicate(ctx context.Context, req *pspb.ReplicateRequest) (*pspb.ReplicateResponse, error) {
+	if req.ClusterID == 0 {
+		return nil, status.Error(codes.InvalidArgument, "clusterID is required")
+	}
+	ps.RLock()
+	rp := ps.rangePartitions[req.Partid]
+	ps.RUnlock()
+	if rp == nil {
+		return nil, errors.New("no such partid")
+	}
+
+	ops := make([]range_partition.ReplicatedOp, len(req.Ops))
+	for i, op := range req.Ops {
+		if len(op.Key) == 0 || op.OriginCluster == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "op %d has no key or origin", i)
+		}
+		if !rp.IsUserKeyInRange(op.Key) {
+			//partition has been split, client should refresh regions
+			return nil, status.Errorf(codes.FailedPrecondition, "key %q is not in partition %d", op.Key, req.Partid)
+		}
+		ops[i] = range_partition.ReplicatedOp{
+			Key:       op.Key,
+			Value:     op.Value,
+			ExpiresAt: op.ExpiresAt,
+			Deleted:   op.Deleted,
+			Origin:    range_partition.Origin{ClusterID: op.OriginCluster, Seq: op.OriginSeq},
+		}
+	}
+
+	applied, err := rp.ApplyReplicated(ops, req.ClusterID)
+	switch err {
+	case nil:
+	case range_partition.ErrReplicateTxnIntent, range_partition.ErrTxnConflict:
+		return nil, status.Error(codes.Aborted, err.Error())
+	default:
+		return nil, err
+	}
+	return &pspb.ReplicateResponse{Applied: uint32(applied)}, nil
+}
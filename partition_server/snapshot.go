@@ -0,0 +1,286 @@
+package partition_server
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/journeymidnight/autumn/etcd_utils"
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/journeymidnight/autumn/range_partition"
+	"github.com/journeymidnight/autumn/streamclient"
+	"github.com/journeymidnight/autumn/xlog"
+	"github.com/pkg/errors"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/client/v3/concurrency"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+snapshots of partitions:
+SnapshotPart closes the partition like SplitPart, sm seals and duplicates its streams into a snapshot,
+then the partition is opened again with the same lock. RestorePart replaces streams of the partition
+with copies of the snapshot in the same way, the range of the partition is kept, keys out of the range
+are skipped. quota usage and secondary indexes are not restored.
+any ps opens a snapshot read-only at the first read of it, and closes it after snapshotIdleTime
+or when the snapshot is deleted
+*/
+
+const snapshotIdleTime = 5 * time.Minute
+
+type snapshotPart struct {
+	rp       *range_partition.RangePartition
+	err      error         //error of opening, rp and err are set before ready is closed
+	ready    chan struct{} //closed when the partition is opened
+	deleted  bool          //protected by snapshotLock, snapshot is deleted while opening
+	lastUsed int64         //atomic, unix time
+}
+
+func (sp *snapshotPart) opened() bool {
+	select {
+	case <-sp.ready:
+		return sp.err == nil
+	default:
+		return false
+	}
+}
+
+func formatSnapshotKey(snapshotID uint64) string {
+	return fmt.Sprintf("snapshots/%d", snapshotID)
+}
+
+func (ps *PartitionServer) getSnapshot(snapshotID uint64) (*pspb.PartitionSnapshot, error) {
+	data, _, err := etcd_utils.EtcdGetKV(ps.etcdClient, formatSnapshotKey(snapshotID))
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, status.Errorf(codes.NotFound, "snapshot %d do not exist", snapshotID)
+	}
+	var snapshot pspb.PartitionSnapshot
+	if err = snapshot.Unmarshal(data); err != nil {
+		return nil, err
+	}
+	return &snapshot, nil
+}
+
+//suspendPartition stops serving partID and closes it, the lock of partID is kept for resumePartition
+func (ps *PartitionServer) suspendPartition(partID uint64) (*concurrency.Mutex, range_partition.CommitEnds, error) {
+	ps.Lock()
+	rp := ps.rangePartitions[partID]
+	mutex := ps.rangePartitionLocks[partID]
+	if rp == nil || mutex == nil {
+		ps.Unlock()
+		return nil, range_partition.CommitEnds{}, errors.New("no such partid")
+	}
+	delete(ps.rangePartitions, partID)
+	ps.Unlock()
+
+	rp.Close()
+	//LogRowMetaStreamEnd MUST be called after rp.Close()
+	return mutex, rp.LogRowMetaStreamEnd(), nil
+}
+
+//resumePartition opens partID with its latest meta, if it fails, the lock is released
+func (ps *PartitionServer) resumePartition(partID uint64, mutex *concurrency.Mutex) error {
+	_, meta, err := ps.getPartitionMeta(partID)
+	if err == nil {
+		var rp *range_partition.RangePartition
+		if rp, err = ps.startRangePartition(meta, mutex); err == nil {
+			ps.Lock()
+			ps.rangePartitions[partID] = rp
+			ps.Unlock()
+			xlog.Logger.Infof("range partition %d resumed", partID)
+			return nil
+		}
+	}
+	ps.Lock()
+	delete(ps.rangePartitionLocks, partID)
+	ps.Unlock()
+	mutex.Unlock(context.Background())
+	return err
+}
+
+func (ps *PartitionServer) SnapshotPart(ctx context.Context, req *pspb.SnapshotPartRequest) (*pspb.SnapshotPartResponse, error) {
+	mutex, ends, err := ps.suspendPartition(req.Partid)
+	if err != nil {
+		return nil, err
+	}
+	snapshotID, smErr := ps.smClient.SnapshotPartition(ctx, req.Partid, mutex.Key(), mutex.Header().Revision,
+		ends.LogEnd, ends.RowEnd, ends.MetaEnd)
+	if err = ps.resumePartition(req.Partid, mutex); err != nil {
+		xlog.Logger.Errorf("resume range partition %d after snapshot: %v", req.Partid, err)
+	}
+	if smErr != nil {
+		return nil, smErr
+	}
+
+	snapshot, err := ps.getSnapshot(snapshotID)
+	if err != nil {
+		return nil, err
+	}
+	xlog.Logger.Infof("range partition %d snapshot %d is created", req.Partid, snapshotID)
+	return &pspb.SnapshotPartResponse{
+		Snapshot: snapshot,
+	}, nil
+}
+
+func (ps *PartitionServer) RestorePart(ctx context.Context, req *pspb.RestorePartRequest) (*pspb.RestorePartResponse, error) {
+	snapshot, err := ps.getSnapshot(req.SnapshotID)
+	if err != nil {
+		return nil, err
+	}
+	if snapshot.PartID != req.Partid {
+		return nil, status.Errorf(codes.InvalidArgument, "snapshot %d is not of partition %d", req.SnapshotID, req.Partid)
+	}
+
+	mutex, _, err := ps.suspendPartition(req.Partid)
+	if err != nil {
+		return nil, err
+	}
+	smErr := ps.smClient.RestoreSnapshot(ctx, req.SnapshotID, mutex.Key(), mutex.Header().Revision)
+	if err = ps.resumePartition(req.Partid, mutex); err != nil {
+		xlog.Logger.Errorf("resume range partition %d after restore: %v", req.Partid, err)
+	}
+	if smErr != nil {
+		return nil, smErr
+	}
+	xlog.Logger.Infof("range partition %d is restored from snapshot %d", req.Partid, req.SnapshotID)
+	return &pspb.RestorePartResponse{}, nil
+}
+
+//getSnapshotPartition returns the read-only range partition of snapshotID, it is opened at the first call.
+//replaying the log could be slow, so it is opened out of snapshotLock, other calls of the same snapshot wait for it
+func (ps *PartitionServer) getSnapshotPartition(partID, snapshotID uint64) (*range_partition.RangePartition, error) {
+	ps.snapshotLock.Lock()
+	sp, ok := ps.snapshots[snapshotID]
+	if !ok {
+		sp = &snapshotPart{ready: make(chan struct{})}
+		ps.snapshots[snapshotID] = sp
+	}
+	ps.snapshotLock.Unlock()
+
+	if !ok {
+		ps.openSnapshotPartition(partID, snapshotID, sp)
+	}
+	<-sp.ready
+	if sp.err != nil {
+		return nil, sp.err
+	}
+	if sp.rp.PartID != partID {
+		return nil, status.Errorf(codes.InvalidArgument, "snapshot %d is not of partition %d", snapshotID, partID)
+	}
+	atomic.StoreInt64(&sp.lastUsed, time.Now().Unix())
+	return sp.rp, nil
+}
+
+//openSnapshotPartition opens snapshotID into sp, sp is removed from ps.snapshots if it fails
+func (ps *PartitionServer) openSnapshotPartition(partID, snapshotID uint64, sp *snapshotPart) {
+	defer close(sp.ready)
+
+	sp.err = func() error {
+		snapshot, err := ps.getSnapshot(snapshotID)
+		if err != nil {
+			return err
+		}
+		if snapshot.PartID != partID {
+			return status.Errorf(codes.InvalidArgument, "snapshot %d is not of partition %d", snapshotID, partID)
+		}
+		meta := &pspb.PartitionMeta{
+			PartID:     snapshot.PartID,
+			LogStream:  snapshot.LogStream,
+			RowStream:  snapshot.RowStream,
+			MetaStream: snapshot.MetaStream,
+			Rg:         snapshot.Rg,
+		}
+		sp.rp, err = ps.openRangePartition(meta, streamclient.StreamLock{}, range_partition.WithReadOnly())
+		return err
+	}()
+
+	ps.snapshotLock.Lock()
+	defer ps.snapshotLock.Unlock()
+	if sp.err == nil && sp.deleted {
+		sp.rp.Close()
+		sp.rp = nil
+		sp.err = status.Errorf(codes.NotFound, "snapshot %d do not exist", snapshotID)
+	}
+	if sp.err != nil {
+		delete(ps.snapshots, snapshotID)
+		return
+	}
+	atomic.StoreInt64(&sp.lastUsed, time.Now().Unix())
+}
+
+//closeSnapshot is called after snapshotID is deleted, its streams will be reclaimed by sm
+func (ps *PartitionServer) closeSnapshot(snapshotID uint64) {
+	ps.snapshotLock.Lock()
+	defer ps.snapshotLock.Unlock()
+	sp, ok := ps.snapshots[snapshotID]
+	if !ok {
+		return
+	}
+	if !sp.opened() {
+		//closed by openSnapshotPartition
+		sp.deleted = true
+		return
+	}
+	delete(ps.snapshots, snapshotID)
+	sp.rp.Close()
+	xlog.Logger.Infof("snapshot %d is deleted, closed", snapshotID)
+}
+
+//watchSnapshots closes snapshots deleted after rev
+func (ps *PartitionServer) watchSnapshots(rev int64) {
+	watchCh, closeWatch := etcd_utils.EtcdWatchEvents(ps.etcdClient, "snapshots/", "snapshots0", rev)
+	go func() {
+		for res := range watchCh {
+			for _, e := range res.Events {
+				if e.Type != mvccpb.DELETE {
+					continue
+				}
+				var snapshotID uint64
+				if _, err := fmt.Sscanf(string(e.Kv.Key), "snapshots/%d", &snapshotID); err != nil {
+					xlog.Logger.Errorf("bad key of snapshot %s", e.Kv.Key)
+					continue
+				}
+				ps.closeSnapshot(snapshotID)
+			}
+		}
+	}()
+	ps.closeSnapshotWatch = closeWatch
+}
+
+//readPartition returns the range partition to read key, or the snapshot of partition if snapshotID > 0
+func (ps *PartitionServer) readPartition(partID, snapshotID uint64, key []byte) (*range_partition.RangePartition, error) {
+	if snapshotID == 0 {
+		if rp := ps.checkVersion(partID, key); rp != nil {
+			return rp, nil
+		}
+		return nil, errors.New("no such partid")
+	}
+	rp, err := ps.getSnapshotPartition(partID, snapshotID)
+	if err != nil {
+		return nil, err
+	}
+	if !rp.IsUserKeyInRange(key) {
+		return nil, errors.New("key is not in range of snapshot")
+	}
+	return rp, nil
+}
+
+//CronTaskCloseSnapshots closes snapshots not read in snapshotIdleTime
+func (ps *PartitionServer) CronTaskCloseSnapshots() {
+	ps.snapshotLock.Lock()
+	defer ps.snapshotLock.Unlock()
+	deadline := time.Now().Add(-snapshotIdleTime).Unix()
+	for snapshotID, sp := range ps.snapshots {
+		if !sp.opened() || atomic.LoadInt64(&sp.lastUsed) > deadline {
+			continue
+		}
+		delete(ps.snapshots, snapshotID)
+		sp.rp.Close()
+		xlog.Logger.Infof("snapshot %d is closed", snapshotID)
+	}
+}
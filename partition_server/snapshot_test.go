@@ -0,0 +1,295 @@
+package partition_server
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/journeymidnight/autumn/etcd_utils"
+	"github.com/journeymidnight/autumn/extent/wal"
+	"github.com/journeymidnight/autumn/manager"
+	"github.com/journeymidnight/autumn/manager/smclient"
+	"github.com/journeymidnight/autumn/manager/stream_manager"
+	"github.com/journeymidnight/autumn/node"
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/journeymidnight/autumn/range_partition"
+	"github.com/journeymidnight/autumn/utils"
+	"github.com/stretchr/testify/suite"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/client/v3/concurrency"
+	"go.etcd.io/etcd/server/v3/embed"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	snapshotEtcdURL = "http://127.0.0.1:32389"
+	snapshotSmURL   = "127.0.0.1:33401"
+)
+
+//SnapshotTestSuite runs a ps of one partition on a stream manager and 3 extent nodes,
+//ports must not conflict with node_test.go and txn_test.go
+type SnapshotTestSuite struct {
+	suite.Suite
+	tmpdir   string
+	etcd     *embed.Etcd
+	client   *clientv3.Client
+	sm       *stream_manager.StreamManager
+	smServer *grpc.Server
+	ens      []*node.ExtentNode
+	ps       *PartitionServer
+	partID   uint64
+}
+
+func (suite *SnapshotTestSuite) SetupSuite() {
+	tmpdir, err := ioutil.TempDir(os.TempDir(), "ps_snapshot")
+	suite.Require().Nil(err)
+	suite.tmpdir = tmpdir
+
+	config := &manager.Config{
+		Name:                "sm1",
+		Dir:                 tmpdir + "/sm1.db",
+		ClientUrls:          snapshotEtcdURL,
+		PeerUrls:            "http://127.0.0.1:32390",
+		AdvertisePeerUrls:   "http://127.0.0.1:32390",
+		AdvertiseClientUrls: snapshotEtcdURL,
+		InitialCluster:      "sm1=http://127.0.0.1:32390",
+		InitialClusterState: "new",
+		ClusterToken:        "ps-snapshot",
+		GrpcUrl:             snapshotSmURL,
+		MaxTxnOps:           3000,
+	}
+	cfg, err := config.GetEmbedConfig()
+	suite.Require().Nil(err)
+	suite.etcd, suite.client, err = etcd_utils.ServeETCD(cfg)
+	suite.Require().Nil(err)
+
+	suite.sm = stream_manager.NewStreamManager(suite.etcd, suite.client, config)
+	go suite.sm.LeaderLoop()
+	suite.smServer = grpc.NewServer()
+	suite.sm.RegisterGRPC(suite.smServer)
+	listener, err := net.Listen("tcp", snapshotSmURL)
+	suite.Require().Nil(err)
+	go suite.smServer.Serve(listener)
+	suite.Require().Eventually(suite.sm.AmLeader, 10*time.Second, 100*time.Millisecond)
+
+	smc := smclient.NewSMClient([]string{snapshotSmURL})
+	suite.Require().Nil(smc.Connect())
+	for i := 0; i < 3; i++ {
+		dir := fmt.Sprintf("%s/store%d", tmpdir, i)
+		suite.Require().Nil(os.Mkdir(dir, 0777))
+		_, err = node.FormatDisk(dir)
+		suite.Require().Nil(err)
+		url := fmt.Sprintf("127.0.0.1:3341%d", i)
+		uuid := fmt.Sprintf("uuid%d", i)
+		nodeID, uuidToDiskID, err := smc.RegisterNode(context.Background(), []string{uuid}, url)
+		suite.Require().Nil(err)
+		suite.Require().Nil(ioutil.WriteFile(dir+"/node_id", []byte(fmt.Sprintf("%d", nodeID)), 0644))
+		suite.Require().Nil(ioutil.WriteFile(dir+"/disk_id", []byte(fmt.Sprintf("%d", uuidToDiskID[uuid])), 0644))
+
+		en := node.NewExtentNode(nodeID, []string{dir}, wal.Options{}, url, []string{snapshotSmURL}, []string{snapshotEtcdURL})
+		suite.Require().Nil(en.LoadExtents())
+		suite.Require().Nil(en.ServeGRPC(0))
+		suite.ens = append(suite.ens, en)
+	}
+
+	//bootstrap a partition like autumn-client does
+	var streams []uint64
+	for i := 0; i < 3; i++ {
+		stream, _, err := smc.CreateStream(context.Background(), 3, 0)
+		suite.Require().Nil(err)
+		streams = append(streams, stream.StreamID)
+	}
+	smc.Close()
+	suite.partID, _, err = etcd_utils.EtcdAllocUniqID(suite.client, stream_manager.IdKey, 1)
+	suite.Require().Nil(err)
+	suite.Require().Nil(etcd_utils.EtcdSetKV(suite.client, fmt.Sprintf("PART/%d", suite.partID), utils.MustMarshal(&pspb.PartitionMeta{
+		LogStream:  streams[0],
+		RowStream:  streams[1],
+		MetaStream: streams[2],
+		Rg:         &pspb.Range{StartKey: []byte(""), EndKey: []byte("")},
+		PartID:     suite.partID,
+	})))
+
+	ps := NewPartitionServer(Config{
+		PSID:                 1,
+		SmURLs:               []string{snapshotSmURL},
+		EtcdURLs:             []string{snapshotEtcdURL},
+		MaxExtentSize:        8 << 20,
+		MaxMetaExtentSize:    4 << 20,
+		SkipListSize:         1 << 20,
+		Compression:          "none",
+		MaxUnCommitedLogSize: 64 << 20,
+	})
+	ps.quota = newQuotaManager(nil)
+	ps.indexes = newIndexManager(nil)
+	ps.extentManager = smclient.NewExtentManager(ps.smClient, ps.config.EtcdURLs, nil)
+	suite.Require().Nil(ps.smClient.Connect())
+	ps.etcdClient = ps.extentManager.EtcdClient()
+	ps.session, err = concurrency.NewSession(ps.etcdClient, concurrency.WithTTL(60))
+	suite.Require().Nil(err)
+	rev := ps.parseRegionAndStart(&pspb.Regions{Regions: map[uint64]*pspb.RegionInfo{
+		suite.partID: {Rg: &pspb.Range{StartKey: []byte(""), EndKey: []byte("")}, PartID: suite.partID, PSID: 1},
+	}})
+	suite.Require().NotNil(ps.rangePartitions[suite.partID])
+	ps.watchSnapshots(rev)
+	suite.ps = ps
+}
+
+func (suite *SnapshotTestSuite) TearDownSuite() {
+	ps := suite.ps
+	ps.closeSnapshotWatch()
+	for _, rp := range ps.rangePartitions {
+		rp.Close()
+	}
+	for _, sp := range ps.snapshots {
+		if sp.opened() {
+			sp.rp.Close()
+		}
+	}
+	ps.session.Close()
+	ps.smClient.Close()
+	for _, en := range suite.ens {
+		en.Shutdown()
+	}
+	suite.smServer.Stop()
+	suite.sm.Close()
+	suite.client.Close()
+	suite.etcd.Close()
+	os.RemoveAll(suite.tmpdir)
+}
+
+func (suite *SnapshotTestSuite) put(key, value string) {
+	_, err := suite.ps.Put(context.Background(), &pspb.PutRequest{Key: []byte(key), Value: []byte(value), Partid: suite.partID})
+	suite.Require().Nil(err)
+}
+
+//get returns the value of key in the partition, or in its snapshot if snapshotID > 0
+func (suite *SnapshotTestSuite) get(key string, snapshotID uint64) (string, error) {
+	res, err := suite.ps.Get(context.Background(), &pspb.GetRequest{Key: []byte(key), Partid: suite.partID, SnapshotID: snapshotID})
+	if err != nil {
+		return "", err
+	}
+	return string(res.Value), nil
+}
+
+func (suite *SnapshotTestSuite) snapshot() uint64 {
+	res, err := suite.ps.SnapshotPart(context.Background(), &pspb.SnapshotPartRequest{Partid: suite.partID})
+	suite.Require().Nil(err)
+	suite.Require().Equal(suite.partID, res.Snapshot.PartID)
+	suite.Require().NotNil(suite.ps.rangePartitions[suite.partID])
+	return res.Snapshot.SnapshotID
+}
+
+func (suite *SnapshotTestSuite) TestSnapshotAndRestore() {
+	suite.put("a", "1")
+	suite.put("b", "1")
+	snapshotID := suite.snapshot()
+
+	//the partition is writable after snapshot
+	suite.put("a", "2")
+	suite.put("c", "2")
+	_, err := suite.ps.Delete(context.Background(), &pspb.DeleteRequest{Key: []byte("b"), Partid: suite.partID})
+	suite.Require().Nil(err)
+
+	v, err := suite.get("a", snapshotID)
+	suite.Require().Nil(err)
+	suite.Require().Equal("1", v)
+	v, err = suite.get("b", snapshotID)
+	suite.Require().Nil(err)
+	suite.Require().Equal("1", v)
+	_, err = suite.get("c", snapshotID)
+	suite.Require().True(isNotFound(err))
+	v, err = suite.get("a", 0)
+	suite.Require().Nil(err)
+	suite.Require().Equal("2", v)
+
+	//snapshot of another partition
+	_, err = suite.ps.Get(context.Background(), &pspb.GetRequest{Key: []byte("a"), Partid: suite.partID + 100, SnapshotID: snapshotID})
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = suite.ps.RestorePart(context.Background(), &pspb.RestorePartRequest{Partid: suite.partID, SnapshotID: snapshotID})
+	suite.Require().Nil(err)
+	v, err = suite.get("a", 0)
+	suite.Require().Nil(err)
+	suite.Require().Equal("1", v)
+	v, err = suite.get("b", 0)
+	suite.Require().Nil(err)
+	suite.Require().Equal("1", v)
+	_, err = suite.get("c", 0)
+	suite.Require().True(isNotFound(err))
+	suite.put("c", "3")
+}
+
+func (suite *SnapshotTestSuite) TestOpenSnapshotOnce() {
+	suite.put("d", "1")
+	snapshotID := suite.snapshot()
+
+	var wg sync.WaitGroup
+	rps := make([]*range_partition.RangePartition, 8)
+	for i := range rps {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			rp, err := suite.ps.getSnapshotPartition(suite.partID, snapshotID)
+			suite.Require().Nil(err)
+			rps[i] = rp
+		}(i)
+	}
+	wg.Wait()
+	for _, rp := range rps {
+		suite.Require().True(rp == rps[0])
+	}
+
+	//unknown snapshot is not cached
+	_, err := suite.get("d", snapshotID+1000)
+	suite.Require().Equal(codes.NotFound, status.Code(err))
+	suite.ps.snapshotLock.Lock()
+	_, ok := suite.ps.snapshots[snapshotID+1000]
+	suite.ps.snapshotLock.Unlock()
+	suite.Require().False(ok)
+}
+
+func (suite *SnapshotTestSuite) TestDeleteSnapshot() {
+	suite.put("e", "1")
+	snapshotID := suite.snapshot()
+	v, err := suite.get("e", snapshotID)
+	suite.Require().Nil(err)
+	suite.Require().Equal("1", v)
+
+	//deleted by another ps or client, the opened snapshot is closed
+	suite.Require().Nil(suite.ps.smClient.DeleteSnapshot(context.Background(), snapshotID))
+	suite.Require().Eventually(func() bool {
+		suite.ps.snapshotLock.Lock()
+		defer suite.ps.snapshotLock.Unlock()
+		_, ok := suite.ps.snapshots[snapshotID]
+		return !ok
+	}, 5*time.Second, 10*time.Millisecond)
+	_, err = suite.get("e", snapshotID)
+	suite.Require().Equal(codes.NotFound, status.Code(err))
+
+	//deleted while it is opening
+	snapshotID = suite.snapshot()
+	sp := &snapshotPart{ready: make(chan struct{})}
+	suite.ps.snapshotLock.Lock()
+	suite.ps.snapshots[snapshotID] = sp
+	suite.ps.snapshotLock.Unlock()
+	suite.ps.closeSnapshot(snapshotID)
+	suite.ps.openSnapshotPartition(suite.partID, snapshotID, sp)
+	suite.Require().Equal(codes.NotFound, status.Code(sp.err))
+	suite.Require().Nil(sp.rp)
+	suite.ps.snapshotLock.Lock()
+	_, ok := suite.ps.snapshots[snapshotID]
+	suite.ps.snapshotLock.Unlock()
+	suite.Require().False(ok)
+}
+
+func TestSnapshot(t *testing.T) {
+	suite.Run(t, new(SnapshotTestSuite))
+}
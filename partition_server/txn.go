@@ -0,0 +1,282 @@
+package partition_server
+
+import (
+	"context"
+	"time"
+
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/journeymidnight/autumn/range_partition"
+	"github.com/journeymidnight/autumn/transaction"
+	"github.com/journeymidnight/autumn/utils"
+	"github.com/journeymidnight/autumn/xlog"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+intent of txn is written on the user key with BitTxnIntent, range partition skips intents
+when reading, so Get here checks the intent first:
+1. COMMITTED: returns value of intent and resolves it
+2. ABORTED: resolves it and returns the last committed value
+3. PENDING: returns the last committed value
+Head and Range resolve intents of decided txns before reading, reads of snapshots skip intents.
+
+all writes of prepare and resolve are conditional(WriteEntriesIf), so they never overwrite
+a newer version written by others. plain writes of a key under intent fail with ErrTxnLocked
+in range partition, writeEntry resolves the intent if its txn is decided, or returns codes.Aborted
+if the txn is pending.
+secondary indexes of txn keys are not updated when resolving, CronTaskCheckIndex repairs them
+*/
+
+//records whose deadline passed txnResolveGrace ago are resolved by CronTaskResolveTxn
+const txnResolveGrace = time.Minute
+
+var errKeyNotFound = errors.New("not found")
+
+func decodeIntent(data []byte) (*pspb.TxnIntent, error) {
+	var intent pspb.TxnIntent
+	if err := intent.Unmarshal(data); err != nil {
+		return nil, err
+	}
+	return &intent, nil
+}
+
+//getWithIntent returns value of key, when the value expires and the latest version of key.
+//values written by txns never expire
+func (ps *PartitionServer) getWithIntent(rp *range_partition.RangePartition, key []byte) ([]byte, uint64, uint64, error) {
+	data, version, err := rp.GetIntent(key)
+	if err == nil {
+		intent, err := decodeIntent(data)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		txnStatus, err := transaction.GetStatus(ps.etcdClient, intent.TxnID)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		switch txnStatus {
+		case pspb.TxnStatus_COMMITTED:
+			go ps.resolveIntent(rp, key, intent.TxnID, true)
+			if intent.Delete {
+				return nil, 0, version, errKeyNotFound
+			}
+			return intent.Value, 0, version, nil
+		case pspb.TxnStatus_ABORTED:
+			go ps.resolveIntent(rp, key, intent.TxnID, false)
+		}
+	} else if !isNotFound(err) {
+		return nil, 0, 0, err
+	}
+	value, expiresAt, err := rp.GetWithExpiresAt(key)
+	return value, expiresAt, version, err
+}
+
+//resolveIntent replaces txnID's intent on key with the value of intent if commit, or clears it
+func (ps *PartitionServer) resolveIntent(rp *range_partition.RangePartition, key []byte, txnID string, commit bool) error {
+	data, version, err := rp.GetIntent(key)
+	if isNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	intent, err := decodeIntent(data)
+	if err != nil {
+		return err
+	}
+	if intent.TxnID != txnID {
+		return nil
+	}
+
+	var e *range_partition.Entry
+	switch {
+	case !commit:
+		e = range_partition.NewTxnIntentEntry(key, nil)
+	case intent.Delete:
+		e = range_partition.NewDeleteEntry(key)
+	default:
+		e = range_partition.NewPutKVEntry(key, intent.Value, 0)
+	}
+	var delta int64
+	if commit && ps.quota.hasQuota(key) {
+		delta = -storedSize(rp, key)
+		if !intent.Delete {
+			delta += int64(len(key) + len(intent.Value))
+		}
+	}
+	err = rp.WriteEntriesIf([]*range_partition.Entry{e}, []uint64{version})
+	if err == range_partition.ErrTxnConflict {
+		//resolved by others
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	//quota is checked when preparing
+	ps.quota.chargeQuota(key, delta)
+	return nil
+}
+
+//resolveLock resolves the intent on key if its txn is decided, returns codes.Aborted
+//if the txn is pending
+func (ps *PartitionServer) resolveLock(rp *range_partition.RangePartition, key []byte) error {
+	data, _, err := rp.GetIntent(key)
+	if isNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	intent, err := decodeIntent(data)
+	if err != nil {
+		return err
+	}
+	txnStatus, err := transaction.GetStatus(ps.etcdClient, intent.TxnID)
+	if err != nil {
+		return err
+	}
+	if txnStatus == pspb.TxnStatus_PENDING {
+		return status.Errorf(codes.Aborted, "key %q is locked by txn %s", key, intent.TxnID)
+	}
+	return ps.resolveIntent(rp, key, intent.TxnID, txnStatus == pspb.TxnStatus_COMMITTED)
+}
+
+//resolveForRead resolves the intent on key if its txn is decided, reads of keys under
+//pending txns return the last committed values
+func (ps *PartitionServer) resolveForRead(rp *range_partition.RangePartition, key []byte) error {
+	err := ps.resolveLock(rp, key)
+	if status.Code(err) == codes.Aborted {
+		return nil
+	}
+	return err
+}
+
+func (ps *PartitionServer) TxnPrepare(ctx context.Context, req *pspb.TxnPrepareRequest) (*pspb.TxnPrepareResponse, error) {
+	size := 0
+	for _, m := range req.Mutations {
+		size += len(m.Key) + len(m.Value)
+	}
+	if _, err := ps.quota.admit(ctx, size); err != nil {
+		return nil, err
+	}
+	ps.RLock()
+	rp := ps.rangePartitions[req.Partid]
+	ps.RUnlock()
+	if rp == nil {
+		return nil, errors.New("no such partid")
+	}
+
+	entries := make([]*range_partition.Entry, 0, len(req.Mutations))
+	versions := make([]uint64, 0, len(req.Mutations))
+	for _, m := range req.Mutations {
+		if !rp.IsUserKeyInRange(m.Key) {
+			return nil, errors.Errorf("key %q is not in partition %d", m.Key, req.Partid)
+		}
+		data, version, err := rp.GetIntent(m.Key)
+		if err == nil {
+			intent, err := decodeIntent(data)
+			if err != nil {
+				return nil, err
+			}
+			//intent of the same txn could be written by a retried prepare
+			if intent.TxnID != req.TxnID {
+				txnStatus, err := transaction.GetStatus(ps.etcdClient, intent.TxnID)
+				if err != nil {
+					return nil, err
+				}
+				if txnStatus == pspb.TxnStatus_PENDING {
+					return nil, status.Errorf(codes.Aborted, "key %q is locked by txn %s", m.Key, intent.TxnID)
+				}
+				if err = ps.resolveIntent(rp, m.Key, intent.TxnID, txnStatus == pspb.TxnStatus_COMMITTED); err != nil {
+					return nil, err
+				}
+				if _, version, err = rp.GetIntent(m.Key); !isNotFound(err) {
+					return nil, status.Errorf(codes.Aborted, "key %q is locked", m.Key)
+				}
+			}
+		} else if !isNotFound(err) {
+			return nil, err
+		}
+
+		if m.CheckVersion && version > m.Version {
+			return nil, status.Errorf(codes.Aborted, "key %q has been updated", m.Key)
+		}
+		if m.CheckOnly {
+			continue
+		}
+		if !m.Delete && ps.quota.hasQuota(m.Key) {
+			if err = ps.quota.checkQuota(m.Key, int64(len(m.Key)+len(m.Value))-storedSize(rp, m.Key)); err != nil {
+				return nil, err
+			}
+		}
+		intent := pspb.TxnIntent{
+			TxnID:  req.TxnID,
+			Delete: m.Delete,
+			Value:  m.Value,
+		}
+		entries = append(entries, range_partition.NewTxnIntentEntry(m.Key, utils.MustMarshal(&intent)))
+		versions = append(versions, version)
+	}
+
+	if len(entries) == 0 {
+		return &pspb.TxnPrepareResponse{}, nil
+	}
+	err := rp.WriteEntriesIf(entries, versions)
+	if err == range_partition.ErrTxnConflict {
+		return nil, status.Errorf(codes.Aborted, "%v", err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pspb.TxnPrepareResponse{}, nil
+}
+
+func (ps *PartitionServer) TxnResolve(ctx context.Context, req *pspb.TxnResolveRequest) (*pspb.TxnResolveResponse, error) {
+	ps.RLock()
+	rp := ps.rangePartitions[req.Partid]
+	ps.RUnlock()
+	if rp == nil {
+		return nil, errors.New("no such partid")
+	}
+	//only the coordinator or anyone who saw the decided status calls TxnResolve
+	for _, key := range req.Keys {
+		if err := ps.resolveIntent(rp, key, req.TxnID, req.Commit); err != nil {
+			return nil, err
+		}
+	}
+	return &pspb.TxnResolveResponse{}, nil
+}
+
+//CronTaskResolveTxn resolves txns whose coordinator crashed
+func (ps *PartitionServer) CronTaskResolveTxn() {
+	if ps.autumnClient == nil {
+		return
+	}
+	records, err := transaction.List(ps.etcdClient)
+	if err != nil {
+		xlog.Logger.Warnf("list txn records: %v", err)
+		return
+	}
+	for _, record := range records {
+		if time.Now().UnixNano() < record.Deadline+int64(txnResolveGrace) {
+			continue
+		}
+		txnStatus, err := transaction.GetStatus(ps.etcdClient, record.TxnID)
+		if err != nil || txnStatus == pspb.TxnStatus_PENDING {
+			continue
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		err = ps.autumnClient.ResolveTxn(ctx, record.TxnID, record.Keys, txnStatus == pspb.TxnStatus_COMMITTED)
+		cancel()
+		if err != nil {
+			xlog.Logger.Warnf("resolve txn %s: %v", record.TxnID, err)
+			continue
+		}
+		if err = transaction.Delete(ps.etcdClient, record.TxnID); err != nil {
+			xlog.Logger.Warnf("remove txn record %s: %v", record.TxnID, err)
+			continue
+		}
+		xlog.Logger.Infof("txn %s is resolved as %s", record.TxnID, txnStatus)
+	}
+}
@@ -0,0 +1,285 @@
+package partition_server
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/journeymidnight/autumn/autumn_clientv1"
+	"github.com/journeymidnight/autumn/etcd_utils"
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/journeymidnight/autumn/range_partition"
+	"github.com/journeymidnight/autumn/transaction"
+	"github.com/journeymidnight/autumn/utils"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/suite"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/server/v3/embed"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//TxnTestSuite runs a ps of partitions ["", "m") and ["m", "") with embedded etcd,
+//autumn client is the txn coordinator
+type TxnTestSuite struct {
+	suite.Suite
+	etcd       *embed.Etcd
+	etcdClient *clientv3.Client
+	grpcServer *grpc.Server
+	closeParts []func()
+	ps         *PartitionServer
+	lib        *autumn_clientv1.AutumnLib
+}
+
+func mustParseURL(s string) []url.URL {
+	u, _ := url.Parse(s)
+	return []url.URL{*u}
+}
+
+func (suite *TxnTestSuite) SetupSuite() {
+	config := embed.NewConfig()
+	config.Name = "etcd"
+	config.Dir = "txn_etcd.db"
+	config.LCUrls = mustParseURL("http://127.0.0.1:32379")
+	config.LPUrls = mustParseURL("http://127.0.0.1:32380")
+	config.ACUrls = mustParseURL("http://127.0.0.1:32379")
+	config.APUrls = mustParseURL("http://127.0.0.1:32380")
+	config.InitialCluster = "etcd=http://127.0.0.1:32380"
+	config.ClusterState = "new"
+	config.InitialClusterToken = "cluster"
+	config.LogLevel = "fatal"
+	etcd, client, err := etcd_utils.ServeETCD(config)
+	suite.Require().Nil(err)
+	suite.etcd = etcd
+	suite.etcdClient = client
+
+	t := suite.T()
+	rp1, close1 := openTestPartition(t, 1, "", "m")
+	rp2, close2 := openTestPartition(t, 2, "m", "")
+	suite.closeParts = []func(){close1, close2}
+	suite.ps = newTestPS(rp1, rp2)
+	suite.ps.etcdClient = client
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	suite.Require().Nil(err)
+	suite.grpcServer = grpc.NewServer()
+	pspb.RegisterPartitionKVServer(suite.grpcServer, suite.ps)
+	go suite.grpcServer.Serve(listener)
+
+	regions := &pspb.Regions{Regions: map[uint64]*pspb.RegionInfo{
+		1: {Rg: &pspb.Range{StartKey: []byte(""), EndKey: []byte("m")}, PartID: 1, PSID: 1},
+		2: {Rg: &pspb.Range{StartKey: []byte("m"), EndKey: []byte("")}, PartID: 2, PSID: 1},
+	}}
+	suite.Require().Nil(etcd_utils.EtcdSetKV(client, "regions/config", utils.MustMarshal(regions)))
+	suite.Require().Nil(etcd_utils.EtcdSetKV(client, "PSSERVER/1",
+		utils.MustMarshal(&pspb.PSDetail{PSID: 1, Address: listener.Addr().String()})))
+
+	suite.lib = autumn_clientv1.NewAutumnLib([]string{"127.0.0.1:32379"})
+	suite.Require().Nil(suite.lib.Connect())
+	suite.ps.autumnClient = suite.lib
+}
+
+func (suite *TxnTestSuite) TearDownSuite() {
+	suite.lib.Close()
+	suite.grpcServer.Stop()
+	for _, close := range suite.closeParts {
+		close()
+	}
+	suite.etcdClient.Close()
+	suite.etcd.Close()
+	os.RemoveAll("txn_etcd.db")
+}
+
+func (suite *TxnTestSuite) rp(partID uint64) *range_partition.RangePartition {
+	return suite.ps.rangePartitions[partID]
+}
+
+func (suite *TxnTestSuite) requireValue(key string, value string) {
+	v, err := suite.lib.Get(context.Background(), []byte(key))
+	suite.Require().Nil(err)
+	suite.Require().Equal(value, string(v))
+}
+
+func (suite *TxnTestSuite) requireNoIntent(partID uint64, key string) {
+	_, _, err := suite.rp(partID).GetIntent([]byte(key))
+	suite.Require().True(isNotFound(err))
+}
+
+//prepare writes intents of a txn whose coordinator stops after prepare
+func (suite *TxnTestSuite) prepare(txnID string, deadline time.Time, mutations map[string]string) {
+	record := &pspb.TxnRecord{
+		TxnID:    txnID,
+		Status:   pspb.TxnStatus_PENDING,
+		Deadline: deadline.UnixNano(),
+	}
+	for key := range mutations {
+		record.Keys = append(record.Keys, []byte(key))
+	}
+	suite.Require().Nil(transaction.Create(suite.etcdClient, record))
+	for key, value := range mutations {
+		partID := uint64(1)
+		if key >= "m" {
+			partID = 2
+		}
+		_, err := suite.ps.TxnPrepare(context.Background(), &pspb.TxnPrepareRequest{
+			TxnID:     txnID,
+			Partid:    partID,
+			Mutations: []*pspb.TxnMutation{{Key: []byte(key), Value: []byte(value)}},
+		})
+		suite.Require().Nil(err)
+	}
+}
+
+func (suite *TxnTestSuite) TestCommit() {
+	ctx := context.Background()
+	suite.Require().Nil(suite.lib.Put(ctx, []byte("commit/a"), []byte("0")))
+
+	txn := suite.lib.NewTxn()
+	v, err := txn.Get(ctx, []byte("commit/a"))
+	suite.Require().Nil(err)
+	suite.Require().Equal("0", string(v))
+	txn.Put([]byte("commit/a"), []byte("1"))
+	txn.Put([]byte("x/commit"), []byte("1"))
+	suite.Require().Nil(txn.Commit(ctx))
+
+	suite.requireValue("commit/a", "1")
+	suite.requireValue("x/commit", "1")
+	suite.requireNoIntent(1, "commit/a")
+	suite.requireNoIntent(2, "x/commit")
+	_, err = transaction.GetStatus(suite.etcdClient, txn.ID())
+	suite.Require().Nil(err)
+	records, err := transaction.List(suite.etcdClient)
+	suite.Require().Nil(err)
+	suite.Require().Equal(0, len(records))
+}
+
+func (suite *TxnTestSuite) TestAbortOnConflict() {
+	ctx := context.Background()
+	suite.Require().Nil(suite.lib.Put(ctx, []byte("abort/a"), []byte("0")))
+
+	txn := suite.lib.NewTxn()
+	_, err := txn.Get(ctx, []byte("abort/a"))
+	suite.Require().Nil(err)
+	txn.Put([]byte("abort/a"), []byte("1"))
+	txn.Put([]byte("x/abort"), []byte("1"))
+
+	//key read by txn is updated before commit
+	suite.Require().Nil(suite.lib.Put(ctx, []byte("abort/a"), []byte("2")))
+	err = txn.Commit(ctx)
+	suite.Require().Equal(autumn_clientv1.ErrTxnConflict, errors.Cause(err))
+
+	suite.requireValue("abort/a", "2")
+	_, err = suite.lib.Get(ctx, []byte("x/abort"))
+	suite.Require().NotNil(err)
+	suite.requireNoIntent(2, "x/abort")
+	txnStatus, err := transaction.GetStatus(suite.etcdClient, txn.ID())
+	suite.Require().Nil(err)
+	suite.Require().Equal(pspb.TxnStatus_ABORTED, txnStatus)
+}
+
+func (suite *TxnTestSuite) TestWriteSkew() {
+	ctx := context.Background()
+	suite.Require().Nil(suite.lib.Put(ctx, []byte("skew/a"), []byte("0")))
+
+	//key only read by txn is updated before commit
+	txn := suite.lib.NewTxn()
+	_, err := txn.Get(ctx, []byte("skew/a"))
+	suite.Require().Nil(err)
+	txn.Put([]byte("x/skew"), []byte("1"))
+	suite.Require().Nil(suite.lib.Put(ctx, []byte("skew/a"), []byte("2")))
+	err = txn.Commit(ctx)
+	suite.Require().Equal(autumn_clientv1.ErrTxnConflict, errors.Cause(err))
+	_, err = suite.lib.Get(ctx, []byte("x/skew"))
+	suite.Require().NotNil(err)
+	suite.requireNoIntent(2, "x/skew")
+
+	//key only read by txn is being written by another txn
+	txn = suite.lib.NewTxn()
+	_, err = txn.Get(ctx, []byte("skew/a"))
+	suite.Require().Nil(err)
+	_, err = txn.Get(ctx, []byte("skew/b"))
+	suite.Require().NotNil(err)
+	txn.Put([]byte("x/skew"), []byte("1"))
+	suite.prepare("txn-skew", time.Now().Add(time.Minute), map[string]string{"skew/b": "1"})
+	err = txn.Commit(ctx)
+	suite.Require().Equal(autumn_clientv1.ErrTxnConflict, errors.Cause(err))
+	suite.requireNoIntent(2, "x/skew")
+
+	//keys read are not changed
+	_, err = transaction.Decide(suite.etcdClient, "txn-skew", pspb.TxnStatus_ABORTED)
+	suite.Require().Nil(err)
+	suite.Require().Nil(suite.lib.ResolveTxn(ctx, "txn-skew", [][]byte{[]byte("skew/b")}, false))
+	suite.Require().Nil(transaction.Delete(suite.etcdClient, "txn-skew"))
+	txn = suite.lib.NewTxn()
+	_, err = txn.Get(ctx, []byte("skew/a"))
+	suite.Require().Nil(err)
+	txn.Put([]byte("x/skew"), []byte("1"))
+	suite.Require().Nil(txn.Commit(ctx))
+	suite.requireValue("x/skew", "1")
+	suite.requireValue("skew/a", "2")
+}
+
+func (suite *TxnTestSuite) TestPlainWriteOnIntent() {
+	ctx := context.Background()
+	suite.Require().Nil(suite.lib.Put(ctx, []byte("lock/a"), []byte("0")))
+	suite.prepare("txn-lock", time.Now().Add(time.Minute), map[string]string{"lock/a": "1", "lock/b": "1"})
+
+	//pending txn: writes conflict, reads see the last committed values
+	err := suite.lib.Put(ctx, []byte("lock/a"), []byte("2"))
+	suite.Require().Equal(codes.Aborted, status.Code(err))
+	err = suite.lib.Delete(ctx, []byte("lock/a"))
+	suite.Require().Equal(codes.Aborted, status.Code(err))
+	suite.requireValue("lock/a", "0")
+	keys, _, err := suite.lib.Range(ctx, []byte("lock/"), []byte("lock/"), 100)
+	suite.Require().Nil(err)
+	suite.Require().Equal([][]byte{[]byte("lock/a")}, keys)
+
+	//committed txn: range resolves intents and sees both keys
+	txnStatus, err := transaction.Decide(suite.etcdClient, "txn-lock", pspb.TxnStatus_COMMITTED)
+	suite.Require().Nil(err)
+	suite.Require().Equal(pspb.TxnStatus_COMMITTED, txnStatus)
+	keys, _, err = suite.lib.Range(ctx, []byte("lock/"), []byte("lock/"), 100)
+	suite.Require().Nil(err)
+	suite.Require().Equal([][]byte{[]byte("lock/a"), []byte("lock/b")}, keys)
+	suite.requireNoIntent(1, "lock/b")
+
+	//plain write resolves the intent first, the txn is fully applied before it
+	suite.Require().Nil(suite.lib.Put(ctx, []byte("lock/a"), []byte("2")))
+	suite.requireNoIntent(1, "lock/a")
+	suite.requireValue("lock/a", "2")
+	suite.requireValue("lock/b", "1")
+}
+
+func (suite *TxnTestSuite) TestCrashResolve() {
+	ctx := context.Background()
+	//coordinator crashed after the commit point
+	past := time.Now().Add(-2 * txnResolveGrace)
+	suite.prepare("txn-committed", past, map[string]string{"crash/a": "1", "y/crash": "1"})
+	_, err := transaction.Decide(suite.etcdClient, "txn-committed", pspb.TxnStatus_COMMITTED)
+	suite.Require().Nil(err)
+	//coordinator crashed before the commit point
+	suite.prepare("txn-pending", past, map[string]string{"crash/b": "1", "z/crash": "1"})
+
+	suite.ps.CronTaskResolveTxn()
+
+	suite.requireNoIntent(1, "crash/a")
+	suite.requireNoIntent(2, "y/crash")
+	suite.requireNoIntent(1, "crash/b")
+	suite.requireNoIntent(2, "z/crash")
+	suite.requireValue("crash/a", "1")
+	suite.requireValue("y/crash", "1")
+	_, err = suite.lib.Get(ctx, []byte("crash/b"))
+	suite.Require().NotNil(err)
+	records, err := transaction.List(suite.etcdClient)
+	suite.Require().Nil(err)
+	suite.Require().Equal(0, len(records), fmt.Sprintf("%+v", records))
+}
+
+func TestTxn(t *testing.T) {
+	suite.Run(t, new(TxnTestSuite))
+}
@@ -0,0 +1,66 @@
+package partition_server
+
+import (
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/journeymidnight/autumn/range_partition"
+	"github.com/journeymidnight/autumn/range_partition/y"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func toWatchEvent(e *range_partition.Entry) *pspb.WatchEvent {
+	event := &pspb.WatchEvent{
+		Key:       y.ParseKey(e.Key),
+		ExpiresAt: e.ExpiresAt,
+		Seq:       y.ParseTs(e.Key),
+	}
+	origin, value, replicated := range_partition.ParseOrigin(e)
+	if replicated {
+		event.OriginCluster = origin.ClusterID
+		event.OriginSeq = origin.Seq
+	}
+	if e.Meta&uint32(range_partition.BitDelete) > 0 {
+		event.Type = pspb.WatchEvent_DELETE
+	} else {
+		event.Type = pspb.WatchEvent_PUT
+		event.Value = value
+	}
+	return event
+}
+
+//Watch sends puts and deletes on partition req.Partid, client resumes from the last checkpoint
+//after ps fails over or partition moves.
+func (ps *PartitionServer) Watch(req *pspb.WatchRequest, stream pspb.PartitionKV_WatchServer) error {
+	if _, err := ps.quota.admit(stream.Context(), 0); err != nil {
+		return err
+	}
+	ps.RLock()
+	rp := ps.rangePartitions[req.Partid]
+	ps.RUnlock()
+	if rp == nil {
+		return errors.New("no such partid")
+	}
+
+	err := rp.Watch(stream.Context(), req.Prefix, req.FromSeq, req.FromNow, func(entries []*range_partition.Entry, checkpoint uint64) error {
+		res := &pspb.WatchResponse{
+			Events:     make([]*pspb.WatchEvent, 0, len(entries)),
+			Checkpoint: checkpoint,
+			Rg:         &pspb.Range{StartKey: rp.StartKey, EndKey: rp.EndKey},
+		}
+		for _, e := range entries {
+			res.Events = append(res.Events, toWatchEvent(e))
+		}
+		return stream.Send(res)
+	})
+
+	switch err {
+	case range_partition.ErrWatchCompacted:
+		return status.Errorf(codes.OutOfRange, "events to watch on partition %d have been GC'd", req.Partid)
+	case range_partition.ErrWatchTooSlow:
+		return status.Errorf(codes.Aborted, "watcher on partition %d can not keep up with writes", req.Partid)
+	case range_partition.ErrWatchClosed:
+		return status.Errorf(codes.Unavailable, "partition %d is closed", req.Partid)
+	}
+	return err
+}
@@ -69,6 +69,111 @@ func (Code) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_f80abaa17e25ccc8, []int{0}
 }
 
+//how stream client sends blocks to replicates
+type ReplicationMode int32
+
+const (
+	ReplicationMode_Leaderless ReplicationMode = 0
+	ReplicationMode_Chain      ReplicationMode = 1
+)
+
+var ReplicationMode_name = map[int32]string{
+	0: "Leaderless",
+	1: "Chain",
+}
+
+var ReplicationMode_value = map[string]int32{
+	"Leaderless": 0,
+	"Chain":      1,
+}
+
+func (x ReplicationMode) String() string {
+	return proto.EnumName(ReplicationMode_name, int32(x))
+}
+
+func (ReplicationMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{1}
+}
+
+type CodecType int32
+
+const (
+	CodecType_ReedSolomon CodecType = 0
+	CodecType_LRC         CodecType = 1
+)
+
+var CodecType_name = map[int32]string{
+	0: "ReedSolomon",
+	1: "LRC",
+}
+
+var CodecType_value = map[string]int32{
+	"ReedSolomon": 0,
+	"LRC":         1,
+}
+
+func (x CodecType) String() string {
+	return proto.EnumName(CodecType_name, int32(x))
+}
+
+func (CodecType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{2}
+}
+
+//erasure codec of EC streams, nil is ReedSolomon
+type ErasureCodec struct {
+	Type CodecType `protobuf:"varint,1,opt,name=type,proto3,enum=pb.CodecType" json:"type,omitempty"`
+	//LRC: parityShard = localGroups + global parities, dataShard must be divisible by localGroups
+	LocalGroups uint32 `protobuf:"varint,2,opt,name=localGroups,proto3" json:"localGroups,omitempty"`
+}
+
+func (m *ErasureCodec) Reset()         { *m = ErasureCodec{} }
+func (m *ErasureCodec) String() string { return proto.CompactTextString(m) }
+func (*ErasureCodec) ProtoMessage()    {}
+func (*ErasureCodec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{0}
+}
+func (m *ErasureCodec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ErasureCodec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ErasureCodec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ErasureCodec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ErasureCodec.Merge(m, src)
+}
+func (m *ErasureCodec) XXX_Size() int {
+	return m.Size()
+}
+func (m *ErasureCodec) XXX_DiscardUnknown() {
+	xxx_messageInfo_ErasureCodec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ErasureCodec proto.InternalMessageInfo
+
+func (m *ErasureCodec) GetType() CodecType {
+	if m != nil {
+		return m.Type
+	}
+	return CodecType_ReedSolomon
+}
+
+func (m *ErasureCodec) GetLocalGroups() uint32 {
+	if m != nil {
+		return m.LocalGroups
+	}
+	return 0
+}
+
 type AppendRequestHeader struct {
 	ExtentID uint64   `protobuf:"varint,1,opt,name=extentID,proto3" json:"extentID,omitempty"`
 	Eversion uint64   `protobuf:"varint,2,opt,name=eversion,proto3" json:"eversion,omitempty"`
@@ -76,13 +181,14 @@ type AppendRequestHeader struct {
 	Revision int64    `protobuf:"varint,4,opt,name=revision,proto3" json:"revision,omitempty"`
 	MustSync bool     `protobuf:"varint,5,opt,name=mustSync,proto3" json:"mustSync,omitempty"`
 	Blocks   []uint32 `protobuf:"varint,6,rep,packed,name=blocks,proto3" json:"blocks,omitempty"`
+	Chain    []string `protobuf:"bytes,7,rep,name=chain,proto3" json:"chain,omitempty"`
 }
 
 func (m *AppendRequestHeader) Reset()         { *m = AppendRequestHeader{} }
 func (m *AppendRequestHeader) String() string { return proto.CompactTextString(m) }
 func (*AppendRequestHeader) ProtoMessage()    {}
 func (*AppendRequestHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{0}
+	return fileDescriptor_f80abaa17e25ccc8, []int{1}
 }
 func (m *AppendRequestHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -153,6 +259,13 @@ func (m *AppendRequestHeader) GetBlocks() []uint32 {
 	return nil
 }
 
+func (m *AppendRequestHeader) GetChain() []string {
+	if m != nil {
+		return m.Chain
+	}
+	return nil
+}
+
 type AppendRequest struct {
 	// Types that are valid to be assigned to Data:
 	//	*AppendRequest_Header
@@ -164,7 +277,7 @@ func (m *AppendRequest) Reset()         { *m = AppendRequest{} }
 func (m *AppendRequest) String() string { return proto.CompactTextString(m) }
 func (*AppendRequest) ProtoMessage()    {}
 func (*AppendRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{1}
+	return fileDescriptor_f80abaa17e25ccc8, []int{2}
 }
 func (m *AppendRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -249,7 +362,7 @@ func (m *AppendResponse) Reset()         { *m = AppendResponse{} }
 func (m *AppendResponse) String() string { return proto.CompactTextString(m) }
 func (*AppendResponse) ProtoMessage()    {}
 func (*AppendResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{2}
+	return fileDescriptor_f80abaa17e25ccc8, []int{3}
 }
 func (m *AppendResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -314,7 +427,7 @@ func (m *CreateExtentRequest) Reset()         { *m = CreateExtentRequest{} }
 func (m *CreateExtentRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateExtentRequest) ProtoMessage()    {}
 func (*CreateExtentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{3}
+	return fileDescriptor_f80abaa17e25ccc8, []int{4}
 }
 func (m *CreateExtentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -360,7 +473,7 @@ func (m *CreateExtentResponse) Reset()         { *m = CreateExtentResponse{} }
 func (m *CreateExtentResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateExtentResponse) ProtoMessage()    {}
 func (*CreateExtentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{4}
+	return fileDescriptor_f80abaa17e25ccc8, []int{5}
 }
 func (m *CreateExtentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -422,7 +535,7 @@ func (m *ReadBlocksRequest) Reset()         { *m = ReadBlocksRequest{} }
 func (m *ReadBlocksRequest) String() string { return proto.CompactTextString(m) }
 func (*ReadBlocksRequest) ProtoMessage()    {}
 func (*ReadBlocksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{5}
+	return fileDescriptor_f80abaa17e25ccc8, []int{6}
 }
 func (m *ReadBlocksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -498,7 +611,7 @@ func (m *ReadBlockResponseHeader) Reset()         { *m = ReadBlockResponseHeader
 func (m *ReadBlockResponseHeader) String() string { return proto.CompactTextString(m) }
 func (*ReadBlockResponseHeader) ProtoMessage()    {}
 func (*ReadBlockResponseHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{6}
+	return fileDescriptor_f80abaa17e25ccc8, []int{7}
 }
 func (m *ReadBlockResponseHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -573,7 +686,7 @@ func (m *ReadBlocksResponse) Reset()         { *m = ReadBlocksResponse{} }
 func (m *ReadBlocksResponse) String() string { return proto.CompactTextString(m) }
 func (*ReadBlocksResponse) ProtoMessage()    {}
 func (*ReadBlocksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{7}
+	return fileDescriptor_f80abaa17e25ccc8, []int{8}
 }
 func (m *ReadBlocksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -655,7 +768,7 @@ func (m *Payload) Reset()         { *m = Payload{} }
 func (m *Payload) String() string { return proto.CompactTextString(m) }
 func (*Payload) ProtoMessage()    {}
 func (*Payload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{8}
+	return fileDescriptor_f80abaa17e25ccc8, []int{9}
 }
 func (m *Payload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -694,13 +807,14 @@ func (m *Payload) GetData() []byte {
 type CommitLengthRequest struct {
 	ExtentID uint64 `protobuf:"varint,1,opt,name=extentID,proto3" json:"extentID,omitempty"`
 	Revision int64  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	Seal     bool   `protobuf:"varint,3,opt,name=seal,proto3" json:"seal,omitempty"`
 }
 
 func (m *CommitLengthRequest) Reset()         { *m = CommitLengthRequest{} }
 func (m *CommitLengthRequest) String() string { return proto.CompactTextString(m) }
 func (*CommitLengthRequest) ProtoMessage()    {}
 func (*CommitLengthRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{9}
+	return fileDescriptor_f80abaa17e25ccc8, []int{10}
 }
 func (m *CommitLengthRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -743,6 +857,13 @@ func (m *CommitLengthRequest) GetRevision() int64 {
 	return 0
 }
 
+func (m *CommitLengthRequest) GetSeal() bool {
+	if m != nil {
+		return m.Seal
+	}
+	return false
+}
+
 type CommitLengthResponse struct {
 	Code    Code   `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
 	CodeDes string `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
@@ -753,7 +874,7 @@ func (m *CommitLengthResponse) Reset()         { *m = CommitLengthResponse{} }
 func (m *CommitLengthResponse) String() string { return proto.CompactTextString(m) }
 func (*CommitLengthResponse) ProtoMessage()    {}
 func (*CommitLengthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{10}
+	return fileDescriptor_f80abaa17e25ccc8, []int{11}
 }
 func (m *CommitLengthResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -813,7 +934,7 @@ func (m *DF) Reset()         { *m = DF{} }
 func (m *DF) String() string { return proto.CompactTextString(m) }
 func (*DF) ProtoMessage()    {}
 func (*DF) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{11}
+	return fileDescriptor_f80abaa17e25ccc8, []int{12}
 }
 func (m *DF) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -872,7 +993,7 @@ func (m *DfRequest) Reset()         { *m = DfRequest{} }
 func (m *DfRequest) String() string { return proto.CompactTextString(m) }
 func (*DfRequest) ProtoMessage()    {}
 func (*DfRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{12}
+	return fileDescriptor_f80abaa17e25ccc8, []int{13}
 }
 func (m *DfRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -925,7 +1046,7 @@ func (m *DfResponse) Reset()         { *m = DfResponse{} }
 func (m *DfResponse) String() string { return proto.CompactTextString(m) }
 func (*DfResponse) ProtoMessage()    {}
 func (*DfResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{13}
+	return fileDescriptor_f80abaa17e25ccc8, []int{14}
 }
 func (m *DfResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -977,7 +1098,7 @@ func (m *RecoveryTaskStatus) Reset()         { *m = RecoveryTaskStatus{} }
 func (m *RecoveryTaskStatus) String() string { return proto.CompactTextString(m) }
 func (*RecoveryTaskStatus) ProtoMessage()    {}
 func (*RecoveryTaskStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{14}
+	return fileDescriptor_f80abaa17e25ccc8, []int{15}
 }
 func (m *RecoveryTaskStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1031,7 +1152,7 @@ func (m *RecoveryTask) Reset()         { *m = RecoveryTask{} }
 func (m *RecoveryTask) String() string { return proto.CompactTextString(m) }
 func (*RecoveryTask) ProtoMessage()    {}
 func (*RecoveryTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{15}
+	return fileDescriptor_f80abaa17e25ccc8, []int{16}
 }
 func (m *RecoveryTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1096,7 +1217,7 @@ func (m *RequireRecoveryRequest) Reset()         { *m = RequireRecoveryRequest{}
 func (m *RequireRecoveryRequest) String() string { return proto.CompactTextString(m) }
 func (*RequireRecoveryRequest) ProtoMessage()    {}
 func (*RequireRecoveryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{16}
+	return fileDescriptor_f80abaa17e25ccc8, []int{17}
 }
 func (m *RequireRecoveryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1141,7 +1262,7 @@ func (m *RequireRecoveryResponse) Reset()         { *m = RequireRecoveryResponse
 func (m *RequireRecoveryResponse) String() string { return proto.CompactTextString(m) }
 func (*RequireRecoveryResponse) ProtoMessage()    {}
 func (*RequireRecoveryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{17}
+	return fileDescriptor_f80abaa17e25ccc8, []int{18}
 }
 func (m *RequireRecoveryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1195,7 +1316,7 @@ func (m *CopyResponseHeader) Reset()         { *m = CopyResponseHeader{} }
 func (m *CopyResponseHeader) String() string { return proto.CompactTextString(m) }
 func (*CopyResponseHeader) ProtoMessage()    {}
 func (*CopyResponseHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{18}
+	return fileDescriptor_f80abaa17e25ccc8, []int{19}
 }
 func (m *CopyResponseHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1256,7 +1377,7 @@ func (m *CopyExtentRequest) Reset()         { *m = CopyExtentRequest{} }
 func (m *CopyExtentRequest) String() string { return proto.CompactTextString(m) }
 func (*CopyExtentRequest) ProtoMessage()    {}
 func (*CopyExtentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{19}
+	return fileDescriptor_f80abaa17e25ccc8, []int{20}
 }
 func (m *CopyExtentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1324,7 +1445,7 @@ func (m *CopyExtentResponse) Reset()         { *m = CopyExtentResponse{} }
 func (m *CopyExtentResponse) String() string { return proto.CompactTextString(m) }
 func (*CopyExtentResponse) ProtoMessage()    {}
 func (*CopyExtentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{20}
+	return fileDescriptor_f80abaa17e25ccc8, []int{21}
 }
 func (m *CopyExtentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1398,23 +1519,29 @@ func (*CopyExtentResponse) XXX_OneofWrappers() []interface{} {
 	}
 }
 
-type ReAvaliRequest struct {
-	ExtentID uint64 `protobuf:"varint,1,opt,name=extentID,proto3" json:"extentID,omitempty"`
-	Eversion uint64 `protobuf:"varint,2,opt,name=eversion,proto3" json:"eversion,omitempty"`
+//ConvertExtent encodes a sealed replicated extent to dataShard+parityShard fragments,
+//fragments are written to targets by WriteFragment
+type ConvertExtentRequest struct {
+	ExtentID    uint64        `protobuf:"varint,1,opt,name=extentID,proto3" json:"extentID,omitempty"`
+	Eversion    uint64        `protobuf:"varint,2,opt,name=eversion,proto3" json:"eversion,omitempty"`
+	DataShard   uint32        `protobuf:"varint,3,opt,name=dataShard,proto3" json:"dataShard,omitempty"`
+	ParityShard uint32        `protobuf:"varint,4,opt,name=parityShard,proto3" json:"parityShard,omitempty"`
+	Targets     []string      `protobuf:"bytes,5,rep,name=targets,proto3" json:"targets,omitempty"`
+	Codec       *ErasureCodec `protobuf:"bytes,6,opt,name=codec,proto3" json:"codec,omitempty"`
 }
 
-func (m *ReAvaliRequest) Reset()         { *m = ReAvaliRequest{} }
-func (m *ReAvaliRequest) String() string { return proto.CompactTextString(m) }
-func (*ReAvaliRequest) ProtoMessage()    {}
-func (*ReAvaliRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{21}
+func (m *ConvertExtentRequest) Reset()         { *m = ConvertExtentRequest{} }
+func (m *ConvertExtentRequest) String() string { return proto.CompactTextString(m) }
+func (*ConvertExtentRequest) ProtoMessage()    {}
+func (*ConvertExtentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{22}
 }
-func (m *ReAvaliRequest) XXX_Unmarshal(b []byte) error {
+func (m *ConvertExtentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ReAvaliRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ConvertExtentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ReAvaliRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ConvertExtentRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1424,49 +1551,80 @@ func (m *ReAvaliRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *ReAvaliRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReAvaliRequest.Merge(m, src)
+func (m *ConvertExtentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConvertExtentRequest.Merge(m, src)
 }
-func (m *ReAvaliRequest) XXX_Size() int {
+func (m *ConvertExtentRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ReAvaliRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReAvaliRequest.DiscardUnknown(m)
+func (m *ConvertExtentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConvertExtentRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReAvaliRequest proto.InternalMessageInfo
+var xxx_messageInfo_ConvertExtentRequest proto.InternalMessageInfo
 
-func (m *ReAvaliRequest) GetExtentID() uint64 {
+func (m *ConvertExtentRequest) GetExtentID() uint64 {
 	if m != nil {
 		return m.ExtentID
 	}
 	return 0
 }
 
-func (m *ReAvaliRequest) GetEversion() uint64 {
+func (m *ConvertExtentRequest) GetEversion() uint64 {
 	if m != nil {
 		return m.Eversion
 	}
 	return 0
 }
 
-type ReAvaliResponse struct {
-	Code    Code   `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
-	CodeDes string `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
+func (m *ConvertExtentRequest) GetDataShard() uint32 {
+	if m != nil {
+		return m.DataShard
+	}
+	return 0
 }
 
-func (m *ReAvaliResponse) Reset()         { *m = ReAvaliResponse{} }
-func (m *ReAvaliResponse) String() string { return proto.CompactTextString(m) }
-func (*ReAvaliResponse) ProtoMessage()    {}
-func (*ReAvaliResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{22}
+func (m *ConvertExtentRequest) GetParityShard() uint32 {
+	if m != nil {
+		return m.ParityShard
+	}
+	return 0
 }
-func (m *ReAvaliResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *ConvertExtentRequest) GetTargets() []string {
+	if m != nil {
+		return m.Targets
+	}
+	return nil
+}
+
+func (m *ConvertExtentRequest) GetCodec() *ErasureCodec {
+	if m != nil {
+		return m.Codec
+	}
+	return nil
+}
+
+type ConvertExtentResponse struct {
+	Code         Code     `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	CodeDes      string   `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
+	FragmentSize uint64   `protobuf:"varint,3,opt,name=fragmentSize,proto3" json:"fragmentSize,omitempty"`
+	DiskIDs      []uint64 `protobuf:"varint,4,rep,packed,name=diskIDs,proto3" json:"diskIDs,omitempty"`
+	Checksums    []uint32 `protobuf:"varint,5,rep,packed,name=checksums,proto3" json:"checksums,omitempty"`
+}
+
+func (m *ConvertExtentResponse) Reset()         { *m = ConvertExtentResponse{} }
+func (m *ConvertExtentResponse) String() string { return proto.CompactTextString(m) }
+func (*ConvertExtentResponse) ProtoMessage()    {}
+func (*ConvertExtentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{23}
+}
+func (m *ConvertExtentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ReAvaliResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ConvertExtentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ReAvaliResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ConvertExtentResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1476,48 +1634,71 @@ func (m *ReAvaliResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *ReAvaliResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReAvaliResponse.Merge(m, src)
+func (m *ConvertExtentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConvertExtentResponse.Merge(m, src)
 }
-func (m *ReAvaliResponse) XXX_Size() int {
+func (m *ConvertExtentResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ReAvaliResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReAvaliResponse.DiscardUnknown(m)
+func (m *ConvertExtentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConvertExtentResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReAvaliResponse proto.InternalMessageInfo
+var xxx_messageInfo_ConvertExtentResponse proto.InternalMessageInfo
 
-func (m *ReAvaliResponse) GetCode() Code {
+func (m *ConvertExtentResponse) GetCode() Code {
 	if m != nil {
 		return m.Code
 	}
 	return Code_OK
 }
 
-func (m *ReAvaliResponse) GetCodeDes() string {
+func (m *ConvertExtentResponse) GetCodeDes() string {
 	if m != nil {
 		return m.CodeDes
 	}
 	return ""
 }
 
-type AllocExtentRequest struct {
+func (m *ConvertExtentResponse) GetFragmentSize() uint64 {
+	if m != nil {
+		return m.FragmentSize
+	}
+	return 0
+}
+
+func (m *ConvertExtentResponse) GetDiskIDs() []uint64 {
+	if m != nil {
+		return m.DiskIDs
+	}
+	return nil
+}
+
+func (m *ConvertExtentResponse) GetChecksums() []uint32 {
+	if m != nil {
+		return m.Checksums
+	}
+	return nil
+}
+
+type ExtentChecksumRequest struct {
 	ExtentID uint64 `protobuf:"varint,1,opt,name=extentID,proto3" json:"extentID,omitempty"`
+	Eversion uint64 `protobuf:"varint,2,opt,name=eversion,proto3" json:"eversion,omitempty"`
+	Cached   bool   `protobuf:"varint,3,opt,name=cached,proto3" json:"cached,omitempty"`
 }
 
-func (m *AllocExtentRequest) Reset()         { *m = AllocExtentRequest{} }
-func (m *AllocExtentRequest) String() string { return proto.CompactTextString(m) }
-func (*AllocExtentRequest) ProtoMessage()    {}
-func (*AllocExtentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{23}
+func (m *ExtentChecksumRequest) Reset()         { *m = ExtentChecksumRequest{} }
+func (m *ExtentChecksumRequest) String() string { return proto.CompactTextString(m) }
+func (*ExtentChecksumRequest) ProtoMessage()    {}
+func (*ExtentChecksumRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{24}
 }
-func (m *AllocExtentRequest) XXX_Unmarshal(b []byte) error {
+func (m *ExtentChecksumRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AllocExtentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ExtentChecksumRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AllocExtentRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ExtentChecksumRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1527,43 +1708,58 @@ func (m *AllocExtentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *AllocExtentRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AllocExtentRequest.Merge(m, src)
+func (m *ExtentChecksumRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtentChecksumRequest.Merge(m, src)
 }
-func (m *AllocExtentRequest) XXX_Size() int {
+func (m *ExtentChecksumRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *AllocExtentRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AllocExtentRequest.DiscardUnknown(m)
+func (m *ExtentChecksumRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtentChecksumRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AllocExtentRequest proto.InternalMessageInfo
+var xxx_messageInfo_ExtentChecksumRequest proto.InternalMessageInfo
 
-func (m *AllocExtentRequest) GetExtentID() uint64 {
+func (m *ExtentChecksumRequest) GetExtentID() uint64 {
 	if m != nil {
 		return m.ExtentID
 	}
 	return 0
 }
 
-type AllocExtentResponse struct {
-	Code    Code   `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
-	CodeDes string `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
-	DiskID  uint64 `protobuf:"varint,3,opt,name=diskID,proto3" json:"diskID,omitempty"`
+func (m *ExtentChecksumRequest) GetEversion() uint64 {
+	if m != nil {
+		return m.Eversion
+	}
+	return 0
 }
 
-func (m *AllocExtentResponse) Reset()         { *m = AllocExtentResponse{} }
-func (m *AllocExtentResponse) String() string { return proto.CompactTextString(m) }
-func (*AllocExtentResponse) ProtoMessage()    {}
-func (*AllocExtentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{24}
+func (m *ExtentChecksumRequest) GetCached() bool {
+	if m != nil {
+		return m.Cached
+	}
+	return false
 }
-func (m *AllocExtentResponse) XXX_Unmarshal(b []byte) error {
+
+type ExtentChecksumResponse struct {
+	Code     Code   `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	CodeDes  string `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
+	Checksum uint32 `protobuf:"varint,3,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	Length   uint32 `protobuf:"varint,4,opt,name=length,proto3" json:"length,omitempty"`
+}
+
+func (m *ExtentChecksumResponse) Reset()         { *m = ExtentChecksumResponse{} }
+func (m *ExtentChecksumResponse) String() string { return proto.CompactTextString(m) }
+func (*ExtentChecksumResponse) ProtoMessage()    {}
+func (*ExtentChecksumResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{25}
+}
+func (m *ExtentChecksumResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AllocExtentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ExtentChecksumResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AllocExtentResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ExtentChecksumResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1573,57 +1769,63 @@ func (m *AllocExtentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *AllocExtentResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AllocExtentResponse.Merge(m, src)
+func (m *ExtentChecksumResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtentChecksumResponse.Merge(m, src)
 }
-func (m *AllocExtentResponse) XXX_Size() int {
+func (m *ExtentChecksumResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *AllocExtentResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AllocExtentResponse.DiscardUnknown(m)
+func (m *ExtentChecksumResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtentChecksumResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AllocExtentResponse proto.InternalMessageInfo
+var xxx_messageInfo_ExtentChecksumResponse proto.InternalMessageInfo
 
-func (m *AllocExtentResponse) GetCode() Code {
+func (m *ExtentChecksumResponse) GetCode() Code {
 	if m != nil {
 		return m.Code
 	}
 	return Code_OK
 }
 
-func (m *AllocExtentResponse) GetCodeDes() string {
+func (m *ExtentChecksumResponse) GetCodeDes() string {
 	if m != nil {
 		return m.CodeDes
 	}
 	return ""
 }
 
-func (m *AllocExtentResponse) GetDiskID() uint64 {
+func (m *ExtentChecksumResponse) GetChecksum() uint32 {
 	if m != nil {
-		return m.DiskID
+		return m.Checksum
 	}
 	return 0
 }
 
-type CheckCommitLengthRequest struct {
-	StreamID uint64 `protobuf:"varint,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
-	OwnerKey string `protobuf:"bytes,2,opt,name=ownerKey,proto3" json:"ownerKey,omitempty"`
-	Revision int64  `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
+func (m *ExtentChecksumResponse) GetLength() uint32 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
 }
 
-func (m *CheckCommitLengthRequest) Reset()         { *m = CheckCommitLengthRequest{} }
-func (m *CheckCommitLengthRequest) String() string { return proto.CompactTextString(m) }
-func (*CheckCommitLengthRequest) ProtoMessage()    {}
-func (*CheckCommitLengthRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{25}
+type WriteFragmentHeader struct {
+	ExtentID uint64 `protobuf:"varint,1,opt,name=extentID,proto3" json:"extentID,omitempty"`
+	Size_    uint64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
 }
-func (m *CheckCommitLengthRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *WriteFragmentHeader) Reset()         { *m = WriteFragmentHeader{} }
+func (m *WriteFragmentHeader) String() string { return proto.CompactTextString(m) }
+func (*WriteFragmentHeader) ProtoMessage()    {}
+func (*WriteFragmentHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{26}
+}
+func (m *WriteFragmentHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CheckCommitLengthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *WriteFragmentHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CheckCommitLengthRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_WriteFragmentHeader.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1633,59 +1835,51 @@ func (m *CheckCommitLengthRequest) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *CheckCommitLengthRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CheckCommitLengthRequest.Merge(m, src)
+func (m *WriteFragmentHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WriteFragmentHeader.Merge(m, src)
 }
-func (m *CheckCommitLengthRequest) XXX_Size() int {
+func (m *WriteFragmentHeader) XXX_Size() int {
 	return m.Size()
 }
-func (m *CheckCommitLengthRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CheckCommitLengthRequest.DiscardUnknown(m)
+func (m *WriteFragmentHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_WriteFragmentHeader.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CheckCommitLengthRequest proto.InternalMessageInfo
+var xxx_messageInfo_WriteFragmentHeader proto.InternalMessageInfo
 
-func (m *CheckCommitLengthRequest) GetStreamID() uint64 {
+func (m *WriteFragmentHeader) GetExtentID() uint64 {
 	if m != nil {
-		return m.StreamID
+		return m.ExtentID
 	}
 	return 0
 }
 
-func (m *CheckCommitLengthRequest) GetOwnerKey() string {
-	if m != nil {
-		return m.OwnerKey
-	}
-	return ""
-}
-
-func (m *CheckCommitLengthRequest) GetRevision() int64 {
+func (m *WriteFragmentHeader) GetSize_() uint64 {
 	if m != nil {
-		return m.Revision
+		return m.Size_
 	}
 	return 0
 }
 
-type CheckCommitLengthResponse struct {
-	Code       Code        `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
-	CodeDes    string      `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
-	StreamInfo *StreamInfo `protobuf:"bytes,3,opt,name=streamInfo,proto3" json:"streamInfo,omitempty"`
-	End        uint32      `protobuf:"varint,4,opt,name=end,proto3" json:"end,omitempty"`
-	LastExInfo *ExtentInfo `protobuf:"bytes,5,opt,name=lastExInfo,proto3" json:"lastExInfo,omitempty"`
+type WriteFragmentRequest struct {
+	// Types that are valid to be assigned to Data:
+	//	*WriteFragmentRequest_Header
+	//	*WriteFragmentRequest_Payload
+	Data isWriteFragmentRequest_Data `protobuf_oneof:"data"`
 }
 
-func (m *CheckCommitLengthResponse) Reset()         { *m = CheckCommitLengthResponse{} }
-func (m *CheckCommitLengthResponse) String() string { return proto.CompactTextString(m) }
-func (*CheckCommitLengthResponse) ProtoMessage()    {}
-func (*CheckCommitLengthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{26}
+func (m *WriteFragmentRequest) Reset()         { *m = WriteFragmentRequest{} }
+func (m *WriteFragmentRequest) String() string { return proto.CompactTextString(m) }
+func (*WriteFragmentRequest) ProtoMessage()    {}
+func (*WriteFragmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{27}
 }
-func (m *CheckCommitLengthResponse) XXX_Unmarshal(b []byte) error {
+func (m *WriteFragmentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CheckCommitLengthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *WriteFragmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CheckCommitLengthResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_WriteFragmentRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1695,72 +1889,81 @@ func (m *CheckCommitLengthResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *CheckCommitLengthResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CheckCommitLengthResponse.Merge(m, src)
+func (m *WriteFragmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WriteFragmentRequest.Merge(m, src)
 }
-func (m *CheckCommitLengthResponse) XXX_Size() int {
+func (m *WriteFragmentRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *CheckCommitLengthResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CheckCommitLengthResponse.DiscardUnknown(m)
+func (m *WriteFragmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WriteFragmentRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CheckCommitLengthResponse proto.InternalMessageInfo
+var xxx_messageInfo_WriteFragmentRequest proto.InternalMessageInfo
 
-func (m *CheckCommitLengthResponse) GetCode() Code {
-	if m != nil {
-		return m.Code
-	}
-	return Code_OK
+type isWriteFragmentRequest_Data interface {
+	isWriteFragmentRequest_Data()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
 
-func (m *CheckCommitLengthResponse) GetCodeDes() string {
-	if m != nil {
-		return m.CodeDes
-	}
-	return ""
+type WriteFragmentRequest_Header struct {
+	Header *WriteFragmentHeader `protobuf:"bytes,1,opt,name=header,proto3,oneof" json:"header,omitempty"`
+}
+type WriteFragmentRequest_Payload struct {
+	Payload []byte `protobuf:"bytes,2,opt,name=payload,proto3,oneof" json:"payload,omitempty"`
 }
 
-func (m *CheckCommitLengthResponse) GetStreamInfo() *StreamInfo {
+func (*WriteFragmentRequest_Header) isWriteFragmentRequest_Data()  {}
+func (*WriteFragmentRequest_Payload) isWriteFragmentRequest_Data() {}
+
+func (m *WriteFragmentRequest) GetData() isWriteFragmentRequest_Data {
 	if m != nil {
-		return m.StreamInfo
+		return m.Data
 	}
 	return nil
 }
 
-func (m *CheckCommitLengthResponse) GetEnd() uint32 {
-	if m != nil {
-		return m.End
+func (m *WriteFragmentRequest) GetHeader() *WriteFragmentHeader {
+	if x, ok := m.GetData().(*WriteFragmentRequest_Header); ok {
+		return x.Header
 	}
-	return 0
+	return nil
 }
 
-func (m *CheckCommitLengthResponse) GetLastExInfo() *ExtentInfo {
-	if m != nil {
-		return m.LastExInfo
+func (m *WriteFragmentRequest) GetPayload() []byte {
+	if x, ok := m.GetData().(*WriteFragmentRequest_Payload); ok {
+		return x.Payload
 	}
 	return nil
 }
 
-type StreamAllocExtentRequest struct {
-	StreamID uint64 `protobuf:"varint,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
-	OwnerKey string `protobuf:"bytes,2,opt,name=ownerKey,proto3" json:"ownerKey,omitempty"`
-	Revision int64  `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
-	End      uint32 `protobuf:"varint,4,opt,name=end,proto3" json:"end,omitempty"`
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*WriteFragmentRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*WriteFragmentRequest_Header)(nil),
+		(*WriteFragmentRequest_Payload)(nil),
+	}
 }
 
-func (m *StreamAllocExtentRequest) Reset()         { *m = StreamAllocExtentRequest{} }
-func (m *StreamAllocExtentRequest) String() string { return proto.CompactTextString(m) }
-func (*StreamAllocExtentRequest) ProtoMessage()    {}
-func (*StreamAllocExtentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{27}
+type WriteFragmentResponse struct {
+	Code    Code   `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	CodeDes string `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
+	DiskID  uint64 `protobuf:"varint,3,opt,name=diskID,proto3" json:"diskID,omitempty"`
 }
-func (m *StreamAllocExtentRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *WriteFragmentResponse) Reset()         { *m = WriteFragmentResponse{} }
+func (m *WriteFragmentResponse) String() string { return proto.CompactTextString(m) }
+func (*WriteFragmentResponse) ProtoMessage()    {}
+func (*WriteFragmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{28}
+}
+func (m *WriteFragmentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StreamAllocExtentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *WriteFragmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_StreamAllocExtentRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_WriteFragmentResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1770,65 +1973,108 @@ func (m *StreamAllocExtentRequest) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *StreamAllocExtentRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StreamAllocExtentRequest.Merge(m, src)
+func (m *WriteFragmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WriteFragmentResponse.Merge(m, src)
 }
-func (m *StreamAllocExtentRequest) XXX_Size() int {
+func (m *WriteFragmentResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *StreamAllocExtentRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_StreamAllocExtentRequest.DiscardUnknown(m)
+func (m *WriteFragmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WriteFragmentResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StreamAllocExtentRequest proto.InternalMessageInfo
+var xxx_messageInfo_WriteFragmentResponse proto.InternalMessageInfo
 
-func (m *StreamAllocExtentRequest) GetStreamID() uint64 {
+func (m *WriteFragmentResponse) GetCode() Code {
 	if m != nil {
-		return m.StreamID
+		return m.Code
 	}
-	return 0
+	return Code_OK
 }
 
-func (m *StreamAllocExtentRequest) GetOwnerKey() string {
+func (m *WriteFragmentResponse) GetCodeDes() string {
 	if m != nil {
-		return m.OwnerKey
+		return m.CodeDes
 	}
 	return ""
 }
 
-func (m *StreamAllocExtentRequest) GetRevision() int64 {
+func (m *WriteFragmentResponse) GetDiskID() uint64 {
 	if m != nil {
-		return m.Revision
+		return m.DiskID
 	}
 	return 0
 }
 
-func (m *StreamAllocExtentRequest) GetEnd() uint32 {
+type ReAvaliRequest struct {
+	ExtentID uint64 `protobuf:"varint,1,opt,name=extentID,proto3" json:"extentID,omitempty"`
+	Eversion uint64 `protobuf:"varint,2,opt,name=eversion,proto3" json:"eversion,omitempty"`
+}
+
+func (m *ReAvaliRequest) Reset()         { *m = ReAvaliRequest{} }
+func (m *ReAvaliRequest) String() string { return proto.CompactTextString(m) }
+func (*ReAvaliRequest) ProtoMessage()    {}
+func (*ReAvaliRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{29}
+}
+func (m *ReAvaliRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReAvaliRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReAvaliRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReAvaliRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReAvaliRequest.Merge(m, src)
+}
+func (m *ReAvaliRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReAvaliRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReAvaliRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReAvaliRequest proto.InternalMessageInfo
+
+func (m *ReAvaliRequest) GetExtentID() uint64 {
 	if m != nil {
-		return m.End
+		return m.ExtentID
 	}
 	return 0
 }
 
-type StreamAllocExtentResponse struct {
-	Code       Code        `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
-	CodeDes    string      `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
-	StreamInfo *StreamInfo `protobuf:"bytes,3,opt,name=streamInfo,proto3" json:"streamInfo,omitempty"`
-	LastExInfo *ExtentInfo `protobuf:"bytes,4,opt,name=lastExInfo,proto3" json:"lastExInfo,omitempty"`
+func (m *ReAvaliRequest) GetEversion() uint64 {
+	if m != nil {
+		return m.Eversion
+	}
+	return 0
 }
 
-func (m *StreamAllocExtentResponse) Reset()         { *m = StreamAllocExtentResponse{} }
-func (m *StreamAllocExtentResponse) String() string { return proto.CompactTextString(m) }
-func (*StreamAllocExtentResponse) ProtoMessage()    {}
-func (*StreamAllocExtentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{28}
+type ReAvaliResponse struct {
+	Code    Code   `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	CodeDes string `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
 }
-func (m *StreamAllocExtentResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *ReAvaliResponse) Reset()         { *m = ReAvaliResponse{} }
+func (m *ReAvaliResponse) String() string { return proto.CompactTextString(m) }
+func (*ReAvaliResponse) ProtoMessage()    {}
+func (*ReAvaliResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{30}
+}
+func (m *ReAvaliResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StreamAllocExtentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ReAvaliResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_StreamAllocExtentResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ReAvaliResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1838,62 +2084,48 @@ func (m *StreamAllocExtentResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *StreamAllocExtentResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StreamAllocExtentResponse.Merge(m, src)
+func (m *ReAvaliResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReAvaliResponse.Merge(m, src)
 }
-func (m *StreamAllocExtentResponse) XXX_Size() int {
+func (m *ReAvaliResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *StreamAllocExtentResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_StreamAllocExtentResponse.DiscardUnknown(m)
+func (m *ReAvaliResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReAvaliResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StreamAllocExtentResponse proto.InternalMessageInfo
+var xxx_messageInfo_ReAvaliResponse proto.InternalMessageInfo
 
-func (m *StreamAllocExtentResponse) GetCode() Code {
+func (m *ReAvaliResponse) GetCode() Code {
 	if m != nil {
 		return m.Code
 	}
 	return Code_OK
 }
 
-func (m *StreamAllocExtentResponse) GetCodeDes() string {
+func (m *ReAvaliResponse) GetCodeDes() string {
 	if m != nil {
 		return m.CodeDes
 	}
 	return ""
 }
 
-func (m *StreamAllocExtentResponse) GetStreamInfo() *StreamInfo {
-	if m != nil {
-		return m.StreamInfo
-	}
-	return nil
-}
-
-func (m *StreamAllocExtentResponse) GetLastExInfo() *ExtentInfo {
-	if m != nil {
-		return m.LastExInfo
-	}
-	return nil
-}
-
-type StreamInfoRequest struct {
-	StreamIDs []uint64 `protobuf:"varint,1,rep,packed,name=streamIDs,proto3" json:"streamIDs,omitempty"`
+type ReconcileRequest struct {
+	DryRun bool `protobuf:"varint,1,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
 }
 
-func (m *StreamInfoRequest) Reset()         { *m = StreamInfoRequest{} }
-func (m *StreamInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*StreamInfoRequest) ProtoMessage()    {}
-func (*StreamInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{29}
+func (m *ReconcileRequest) Reset()         { *m = ReconcileRequest{} }
+func (m *ReconcileRequest) String() string { return proto.CompactTextString(m) }
+func (*ReconcileRequest) ProtoMessage()    {}
+func (*ReconcileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{31}
 }
-func (m *StreamInfoRequest) XXX_Unmarshal(b []byte) error {
+func (m *ReconcileRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StreamInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ReconcileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_StreamInfoRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ReconcileRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1903,44 +2135,40 @@ func (m *StreamInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *StreamInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StreamInfoRequest.Merge(m, src)
+func (m *ReconcileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReconcileRequest.Merge(m, src)
 }
-func (m *StreamInfoRequest) XXX_Size() int {
+func (m *ReconcileRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *StreamInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_StreamInfoRequest.DiscardUnknown(m)
+func (m *ReconcileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReconcileRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StreamInfoRequest proto.InternalMessageInfo
+var xxx_messageInfo_ReconcileRequest proto.InternalMessageInfo
 
-func (m *StreamInfoRequest) GetStreamIDs() []uint64 {
+func (m *ReconcileRequest) GetDryRun() bool {
 	if m != nil {
-		return m.StreamIDs
+		return m.DryRun
 	}
-	return nil
+	return false
 }
 
-type StreamInfoResponse struct {
-	Code    Code                   `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
-	CodeDes string                 `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
-	Streams map[uint64]*StreamInfo `protobuf:"bytes,3,rep,name=streams,proto3" json:"streams,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Extents map[uint64]*ExtentInfo `protobuf:"bytes,4,rep,name=extents,proto3" json:"extents,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+type WalStatsRequest struct {
 }
 
-func (m *StreamInfoResponse) Reset()         { *m = StreamInfoResponse{} }
-func (m *StreamInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*StreamInfoResponse) ProtoMessage()    {}
-func (*StreamInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{30}
+func (m *WalStatsRequest) Reset()         { *m = WalStatsRequest{} }
+func (m *WalStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*WalStatsRequest) ProtoMessage()    {}
+func (*WalStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{32}
 }
-func (m *StreamInfoResponse) XXX_Unmarshal(b []byte) error {
+func (m *WalStatsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StreamInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *WalStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_StreamInfoResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_WalStatsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1950,62 +2178,40 @@ func (m *StreamInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *StreamInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StreamInfoResponse.Merge(m, src)
+func (m *WalStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalStatsRequest.Merge(m, src)
 }
-func (m *StreamInfoResponse) XXX_Size() int {
+func (m *WalStatsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *StreamInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_StreamInfoResponse.DiscardUnknown(m)
+func (m *WalStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalStatsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StreamInfoResponse proto.InternalMessageInfo
-
-func (m *StreamInfoResponse) GetCode() Code {
-	if m != nil {
-		return m.Code
-	}
-	return Code_OK
-}
-
-func (m *StreamInfoResponse) GetCodeDes() string {
-	if m != nil {
-		return m.CodeDes
-	}
-	return ""
-}
-
-func (m *StreamInfoResponse) GetStreams() map[uint64]*StreamInfo {
-	if m != nil {
-		return m.Streams
-	}
-	return nil
-}
-
-func (m *StreamInfoResponse) GetExtents() map[uint64]*ExtentInfo {
-	if m != nil {
-		return m.Extents
-	}
-	return nil
-}
+var xxx_messageInfo_WalStatsRequest proto.InternalMessageInfo
 
-type ExtentInfoRequest struct {
-	ExtentID uint64 `protobuf:"varint,1,opt,name=extentID,proto3" json:"extentID,omitempty"`
+//stats of group commits of a wal dir
+type WalStats struct {
+	Dir        string `protobuf:"bytes,1,opt,name=dir,proto3" json:"dir,omitempty"`
+	Commits    uint64 `protobuf:"varint,2,opt,name=commits,proto3" json:"commits,omitempty"`
+	Entries    uint64 `protobuf:"varint,3,opt,name=entries,proto3" json:"entries,omitempty"`
+	Bytes      uint64 `protobuf:"varint,4,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Latency    int64  `protobuf:"varint,5,opt,name=latency,proto3" json:"latency,omitempty"`
+	MaxLatency int64  `protobuf:"varint,6,opt,name=maxLatency,proto3" json:"maxLatency,omitempty"`
 }
 
-func (m *ExtentInfoRequest) Reset()         { *m = ExtentInfoRequest{} }
-func (m *ExtentInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*ExtentInfoRequest) ProtoMessage()    {}
-func (*ExtentInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{31}
+func (m *WalStats) Reset()         { *m = WalStats{} }
+func (m *WalStats) String() string { return proto.CompactTextString(m) }
+func (*WalStats) ProtoMessage()    {}
+func (*WalStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{33}
 }
-func (m *ExtentInfoRequest) XXX_Unmarshal(b []byte) error {
+func (m *WalStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ExtentInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *WalStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ExtentInfoRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_WalStats.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2015,43 +2221,79 @@ func (m *ExtentInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *ExtentInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ExtentInfoRequest.Merge(m, src)
+func (m *WalStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalStats.Merge(m, src)
 }
-func (m *ExtentInfoRequest) XXX_Size() int {
+func (m *WalStats) XXX_Size() int {
 	return m.Size()
 }
-func (m *ExtentInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ExtentInfoRequest.DiscardUnknown(m)
+func (m *WalStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalStats.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ExtentInfoRequest proto.InternalMessageInfo
+var xxx_messageInfo_WalStats proto.InternalMessageInfo
 
-func (m *ExtentInfoRequest) GetExtentID() uint64 {
+func (m *WalStats) GetDir() string {
 	if m != nil {
-		return m.ExtentID
+		return m.Dir
+	}
+	return ""
+}
+
+func (m *WalStats) GetCommits() uint64 {
+	if m != nil {
+		return m.Commits
 	}
 	return 0
 }
 
-type ExtentInfoResponse struct {
-	Code    Code        `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
-	CodeDes string      `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
-	ExInfo  *ExtentInfo `protobuf:"bytes,3,opt,name=exInfo,proto3" json:"exInfo,omitempty"`
+func (m *WalStats) GetEntries() uint64 {
+	if m != nil {
+		return m.Entries
+	}
+	return 0
 }
 
-func (m *ExtentInfoResponse) Reset()         { *m = ExtentInfoResponse{} }
-func (m *ExtentInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*ExtentInfoResponse) ProtoMessage()    {}
-func (*ExtentInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{32}
+func (m *WalStats) GetBytes() uint64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
 }
-func (m *ExtentInfoResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *WalStats) GetLatency() int64 {
+	if m != nil {
+		return m.Latency
+	}
+	return 0
+}
+
+func (m *WalStats) GetMaxLatency() int64 {
+	if m != nil {
+		return m.MaxLatency
+	}
+	return 0
+}
+
+type WalStatsResponse struct {
+	Code     Code        `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	CodeDes  string      `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
+	Dirs     []*WalStats `protobuf:"bytes,3,rep,name=dirs,proto3" json:"dirs,omitempty"`
+	Bypassed uint64      `protobuf:"varint,4,opt,name=bypassed,proto3" json:"bypassed,omitempty"`
+}
+
+func (m *WalStatsResponse) Reset()         { *m = WalStatsResponse{} }
+func (m *WalStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*WalStatsResponse) ProtoMessage()    {}
+func (*WalStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{34}
+}
+func (m *WalStatsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ExtentInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *WalStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ExtentInfoResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_WalStatsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2061,54 +2303,62 @@ func (m *ExtentInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *ExtentInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ExtentInfoResponse.Merge(m, src)
+func (m *WalStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalStatsResponse.Merge(m, src)
 }
-func (m *ExtentInfoResponse) XXX_Size() int {
+func (m *WalStatsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ExtentInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ExtentInfoResponse.DiscardUnknown(m)
+func (m *WalStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalStatsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ExtentInfoResponse proto.InternalMessageInfo
+var xxx_messageInfo_WalStatsResponse proto.InternalMessageInfo
 
-func (m *ExtentInfoResponse) GetCode() Code {
+func (m *WalStatsResponse) GetCode() Code {
 	if m != nil {
 		return m.Code
 	}
 	return Code_OK
 }
 
-func (m *ExtentInfoResponse) GetCodeDes() string {
+func (m *WalStatsResponse) GetCodeDes() string {
 	if m != nil {
 		return m.CodeDes
 	}
 	return ""
 }
 
-func (m *ExtentInfoResponse) GetExInfo() *ExtentInfo {
+func (m *WalStatsResponse) GetDirs() []*WalStats {
 	if m != nil {
-		return m.ExInfo
+		return m.Dirs
 	}
 	return nil
 }
 
-type NodesInfoRequest struct {
+func (m *WalStatsResponse) GetBypassed() uint64 {
+	if m != nil {
+		return m.Bypassed
+	}
+	return 0
 }
 
-func (m *NodesInfoRequest) Reset()         { *m = NodesInfoRequest{} }
-func (m *NodesInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*NodesInfoRequest) ProtoMessage()    {}
-func (*NodesInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{33}
+type DeleteExtentRequest struct {
+	ExtentID uint64 `protobuf:"varint,1,opt,name=extentID,proto3" json:"extentID,omitempty"`
 }
-func (m *NodesInfoRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *DeleteExtentRequest) Reset()         { *m = DeleteExtentRequest{} }
+func (m *DeleteExtentRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteExtentRequest) ProtoMessage()    {}
+func (*DeleteExtentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{35}
+}
+func (m *DeleteExtentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *NodesInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DeleteExtentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_NodesInfoRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DeleteExtentRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2118,36 +2368,42 @@ func (m *NodesInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *NodesInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NodesInfoRequest.Merge(m, src)
+func (m *DeleteExtentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteExtentRequest.Merge(m, src)
 }
-func (m *NodesInfoRequest) XXX_Size() int {
+func (m *DeleteExtentRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *NodesInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_NodesInfoRequest.DiscardUnknown(m)
+func (m *DeleteExtentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteExtentRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NodesInfoRequest proto.InternalMessageInfo
+var xxx_messageInfo_DeleteExtentRequest proto.InternalMessageInfo
 
-type NodesInfoResponse struct {
-	Code    Code                 `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
-	CodeDes string               `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
-	Nodes   map[uint64]*NodeInfo `protobuf:"bytes,3,rep,name=nodes,proto3" json:"nodes,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+func (m *DeleteExtentRequest) GetExtentID() uint64 {
+	if m != nil {
+		return m.ExtentID
+	}
+	return 0
 }
 
-func (m *NodesInfoResponse) Reset()         { *m = NodesInfoResponse{} }
-func (m *NodesInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*NodesInfoResponse) ProtoMessage()    {}
-func (*NodesInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{34}
+type DeleteExtentResponse struct {
+	Code    Code   `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	CodeDes string `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
 }
-func (m *NodesInfoResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *DeleteExtentResponse) Reset()         { *m = DeleteExtentResponse{} }
+func (m *DeleteExtentResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteExtentResponse) ProtoMessage()    {}
+func (*DeleteExtentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{36}
+}
+func (m *DeleteExtentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *NodesInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DeleteExtentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_NodesInfoResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DeleteExtentResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2157,56 +2413,48 @@ func (m *NodesInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *NodesInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NodesInfoResponse.Merge(m, src)
+func (m *DeleteExtentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteExtentResponse.Merge(m, src)
 }
-func (m *NodesInfoResponse) XXX_Size() int {
+func (m *DeleteExtentResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *NodesInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_NodesInfoResponse.DiscardUnknown(m)
+func (m *DeleteExtentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteExtentResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NodesInfoResponse proto.InternalMessageInfo
+var xxx_messageInfo_DeleteExtentResponse proto.InternalMessageInfo
 
-func (m *NodesInfoResponse) GetCode() Code {
+func (m *DeleteExtentResponse) GetCode() Code {
 	if m != nil {
 		return m.Code
 	}
 	return Code_OK
 }
 
-func (m *NodesInfoResponse) GetCodeDes() string {
+func (m *DeleteExtentResponse) GetCodeDes() string {
 	if m != nil {
 		return m.CodeDes
 	}
 	return ""
 }
 
-func (m *NodesInfoResponse) GetNodes() map[uint64]*NodeInfo {
-	if m != nil {
-		return m.Nodes
-	}
-	return nil
-}
-
-type RegisterNodeRequest struct {
-	Addr      string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
-	DiskUUIDs []string `protobuf:"bytes,2,rep,name=diskUUIDs,proto3" json:"diskUUIDs,omitempty"`
+type AllocExtentRequest struct {
+	ExtentID uint64 `protobuf:"varint,1,opt,name=extentID,proto3" json:"extentID,omitempty"`
 }
 
-func (m *RegisterNodeRequest) Reset()         { *m = RegisterNodeRequest{} }
-func (m *RegisterNodeRequest) String() string { return proto.CompactTextString(m) }
-func (*RegisterNodeRequest) ProtoMessage()    {}
-func (*RegisterNodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{35}
+func (m *AllocExtentRequest) Reset()         { *m = AllocExtentRequest{} }
+func (m *AllocExtentRequest) String() string { return proto.CompactTextString(m) }
+func (*AllocExtentRequest) ProtoMessage()    {}
+func (*AllocExtentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{37}
 }
-func (m *RegisterNodeRequest) XXX_Unmarshal(b []byte) error {
+func (m *AllocExtentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RegisterNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AllocExtentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RegisterNodeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AllocExtentRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2216,51 +2464,43 @@ func (m *RegisterNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *RegisterNodeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RegisterNodeRequest.Merge(m, src)
+func (m *AllocExtentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllocExtentRequest.Merge(m, src)
 }
-func (m *RegisterNodeRequest) XXX_Size() int {
+func (m *AllocExtentRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RegisterNodeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RegisterNodeRequest.DiscardUnknown(m)
+func (m *AllocExtentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllocExtentRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RegisterNodeRequest proto.InternalMessageInfo
-
-func (m *RegisterNodeRequest) GetAddr() string {
-	if m != nil {
-		return m.Addr
-	}
-	return ""
-}
+var xxx_messageInfo_AllocExtentRequest proto.InternalMessageInfo
 
-func (m *RegisterNodeRequest) GetDiskUUIDs() []string {
+func (m *AllocExtentRequest) GetExtentID() uint64 {
 	if m != nil {
-		return m.DiskUUIDs
+		return m.ExtentID
 	}
-	return nil
+	return 0
 }
 
-type RegisterNodeResponse struct {
-	Code      Code              `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
-	CodeDes   string            `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
-	NodeId    uint64            `protobuf:"varint,3,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
-	DiskUUIDs map[string]uint64 `protobuf:"bytes,4,rep,name=diskUUIDs,proto3" json:"diskUUIDs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+type AllocExtentResponse struct {
+	Code    Code   `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	CodeDes string `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
+	DiskID  uint64 `protobuf:"varint,3,opt,name=diskID,proto3" json:"diskID,omitempty"`
 }
 
-func (m *RegisterNodeResponse) Reset()         { *m = RegisterNodeResponse{} }
-func (m *RegisterNodeResponse) String() string { return proto.CompactTextString(m) }
-func (*RegisterNodeResponse) ProtoMessage()    {}
-func (*RegisterNodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{36}
+func (m *AllocExtentResponse) Reset()         { *m = AllocExtentResponse{} }
+func (m *AllocExtentResponse) String() string { return proto.CompactTextString(m) }
+func (*AllocExtentResponse) ProtoMessage()    {}
+func (*AllocExtentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{38}
 }
-func (m *RegisterNodeResponse) XXX_Unmarshal(b []byte) error {
+func (m *AllocExtentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RegisterNodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AllocExtentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RegisterNodeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AllocExtentResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2270,63 +2510,57 @@ func (m *RegisterNodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *RegisterNodeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RegisterNodeResponse.Merge(m, src)
+func (m *AllocExtentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllocExtentResponse.Merge(m, src)
 }
-func (m *RegisterNodeResponse) XXX_Size() int {
+func (m *AllocExtentResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RegisterNodeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RegisterNodeResponse.DiscardUnknown(m)
+func (m *AllocExtentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllocExtentResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RegisterNodeResponse proto.InternalMessageInfo
+var xxx_messageInfo_AllocExtentResponse proto.InternalMessageInfo
 
-func (m *RegisterNodeResponse) GetCode() Code {
+func (m *AllocExtentResponse) GetCode() Code {
 	if m != nil {
 		return m.Code
 	}
 	return Code_OK
 }
 
-func (m *RegisterNodeResponse) GetCodeDes() string {
+func (m *AllocExtentResponse) GetCodeDes() string {
 	if m != nil {
 		return m.CodeDes
 	}
 	return ""
 }
 
-func (m *RegisterNodeResponse) GetNodeId() uint64 {
+func (m *AllocExtentResponse) GetDiskID() uint64 {
 	if m != nil {
-		return m.NodeId
+		return m.DiskID
 	}
 	return 0
 }
 
-func (m *RegisterNodeResponse) GetDiskUUIDs() map[string]uint64 {
-	if m != nil {
-		return m.DiskUUIDs
-	}
-	return nil
+type CheckCommitLengthRequest struct {
+	StreamID uint64 `protobuf:"varint,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
+	OwnerKey string `protobuf:"bytes,2,opt,name=ownerKey,proto3" json:"ownerKey,omitempty"`
+	Revision int64  `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
 }
 
-type CreateStreamRequest struct {
-	DataShard   uint32 `protobuf:"varint,1,opt,name=dataShard,proto3" json:"dataShard,omitempty"`
-	ParityShard uint32 `protobuf:"varint,2,opt,name=parityShard,proto3" json:"parityShard,omitempty"`
+func (m *CheckCommitLengthRequest) Reset()         { *m = CheckCommitLengthRequest{} }
+func (m *CheckCommitLengthRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckCommitLengthRequest) ProtoMessage()    {}
+func (*CheckCommitLengthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{39}
 }
-
-func (m *CreateStreamRequest) Reset()         { *m = CreateStreamRequest{} }
-func (m *CreateStreamRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateStreamRequest) ProtoMessage()    {}
-func (*CreateStreamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{37}
-}
-func (m *CreateStreamRequest) XXX_Unmarshal(b []byte) error {
+func (m *CheckCommitLengthRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CreateStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CheckCommitLengthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CreateStreamRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CheckCommitLengthRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2336,51 +2570,59 @@ func (m *CreateStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *CreateStreamRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateStreamRequest.Merge(m, src)
+func (m *CheckCommitLengthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckCommitLengthRequest.Merge(m, src)
 }
-func (m *CreateStreamRequest) XXX_Size() int {
+func (m *CheckCommitLengthRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *CreateStreamRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateStreamRequest.DiscardUnknown(m)
+func (m *CheckCommitLengthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckCommitLengthRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateStreamRequest proto.InternalMessageInfo
+var xxx_messageInfo_CheckCommitLengthRequest proto.InternalMessageInfo
 
-func (m *CreateStreamRequest) GetDataShard() uint32 {
+func (m *CheckCommitLengthRequest) GetStreamID() uint64 {
 	if m != nil {
-		return m.DataShard
+		return m.StreamID
 	}
 	return 0
 }
 
-func (m *CreateStreamRequest) GetParityShard() uint32 {
+func (m *CheckCommitLengthRequest) GetOwnerKey() string {
 	if m != nil {
-		return m.ParityShard
+		return m.OwnerKey
+	}
+	return ""
+}
+
+func (m *CheckCommitLengthRequest) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
 	}
 	return 0
 }
 
-type CreateStreamResponse struct {
-	Code    Code        `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
-	CodeDes string      `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
-	Stream  *StreamInfo `protobuf:"bytes,3,opt,name=stream,proto3" json:"stream,omitempty"`
-	Extent  *ExtentInfo `protobuf:"bytes,4,opt,name=extent,proto3" json:"extent,omitempty"`
+type CheckCommitLengthResponse struct {
+	Code       Code        `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	CodeDes    string      `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
+	StreamInfo *StreamInfo `protobuf:"bytes,3,opt,name=streamInfo,proto3" json:"streamInfo,omitempty"`
+	End        uint32      `protobuf:"varint,4,opt,name=end,proto3" json:"end,omitempty"`
+	LastExInfo *ExtentInfo `protobuf:"bytes,5,opt,name=lastExInfo,proto3" json:"lastExInfo,omitempty"`
 }
 
-func (m *CreateStreamResponse) Reset()         { *m = CreateStreamResponse{} }
-func (m *CreateStreamResponse) String() string { return proto.CompactTextString(m) }
-func (*CreateStreamResponse) ProtoMessage()    {}
-func (*CreateStreamResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{38}
+func (m *CheckCommitLengthResponse) Reset()         { *m = CheckCommitLengthResponse{} }
+func (m *CheckCommitLengthResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckCommitLengthResponse) ProtoMessage()    {}
+func (*CheckCommitLengthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{40}
 }
-func (m *CreateStreamResponse) XXX_Unmarshal(b []byte) error {
+func (m *CheckCommitLengthResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CreateStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CheckCommitLengthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CreateStreamResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CheckCommitLengthResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2390,65 +2632,72 @@ func (m *CreateStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *CreateStreamResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateStreamResponse.Merge(m, src)
+func (m *CheckCommitLengthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckCommitLengthResponse.Merge(m, src)
 }
-func (m *CreateStreamResponse) XXX_Size() int {
+func (m *CheckCommitLengthResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *CreateStreamResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateStreamResponse.DiscardUnknown(m)
+func (m *CheckCommitLengthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckCommitLengthResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateStreamResponse proto.InternalMessageInfo
+var xxx_messageInfo_CheckCommitLengthResponse proto.InternalMessageInfo
 
-func (m *CreateStreamResponse) GetCode() Code {
+func (m *CheckCommitLengthResponse) GetCode() Code {
 	if m != nil {
 		return m.Code
 	}
 	return Code_OK
 }
 
-func (m *CreateStreamResponse) GetCodeDes() string {
+func (m *CheckCommitLengthResponse) GetCodeDes() string {
 	if m != nil {
 		return m.CodeDes
 	}
 	return ""
 }
 
-func (m *CreateStreamResponse) GetStream() *StreamInfo {
+func (m *CheckCommitLengthResponse) GetStreamInfo() *StreamInfo {
 	if m != nil {
-		return m.Stream
+		return m.StreamInfo
 	}
 	return nil
 }
 
-func (m *CreateStreamResponse) GetExtent() *ExtentInfo {
+func (m *CheckCommitLengthResponse) GetEnd() uint32 {
 	if m != nil {
-		return m.Extent
+		return m.End
+	}
+	return 0
+}
+
+func (m *CheckCommitLengthResponse) GetLastExInfo() *ExtentInfo {
+	if m != nil {
+		return m.LastExInfo
 	}
 	return nil
 }
 
-type TruncateRequest struct {
+type StreamAllocExtentRequest struct {
 	StreamID uint64 `protobuf:"varint,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
-	ExtentID uint64 `protobuf:"varint,2,opt,name=extentID,proto3" json:"extentID,omitempty"`
-	OwnerKey string `protobuf:"bytes,3,opt,name=ownerKey,proto3" json:"ownerKey,omitempty"`
-	Revision int64  `protobuf:"varint,4,opt,name=revision,proto3" json:"revision,omitempty"`
+	OwnerKey string `protobuf:"bytes,2,opt,name=ownerKey,proto3" json:"ownerKey,omitempty"`
+	Revision int64  `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	End      uint32 `protobuf:"varint,4,opt,name=end,proto3" json:"end,omitempty"`
 }
 
-func (m *TruncateRequest) Reset()         { *m = TruncateRequest{} }
-func (m *TruncateRequest) String() string { return proto.CompactTextString(m) }
-func (*TruncateRequest) ProtoMessage()    {}
-func (*TruncateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{39}
+func (m *StreamAllocExtentRequest) Reset()         { *m = StreamAllocExtentRequest{} }
+func (m *StreamAllocExtentRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamAllocExtentRequest) ProtoMessage()    {}
+func (*StreamAllocExtentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{41}
 }
-func (m *TruncateRequest) XXX_Unmarshal(b []byte) error {
+func (m *StreamAllocExtentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TruncateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *StreamAllocExtentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TruncateRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_StreamAllocExtentRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2458,64 +2707,65 @@ func (m *TruncateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *TruncateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TruncateRequest.Merge(m, src)
+func (m *StreamAllocExtentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamAllocExtentRequest.Merge(m, src)
 }
-func (m *TruncateRequest) XXX_Size() int {
+func (m *StreamAllocExtentRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *TruncateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_TruncateRequest.DiscardUnknown(m)
+func (m *StreamAllocExtentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamAllocExtentRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TruncateRequest proto.InternalMessageInfo
+var xxx_messageInfo_StreamAllocExtentRequest proto.InternalMessageInfo
 
-func (m *TruncateRequest) GetStreamID() uint64 {
+func (m *StreamAllocExtentRequest) GetStreamID() uint64 {
 	if m != nil {
 		return m.StreamID
 	}
 	return 0
 }
 
-func (m *TruncateRequest) GetExtentID() uint64 {
+func (m *StreamAllocExtentRequest) GetOwnerKey() string {
 	if m != nil {
-		return m.ExtentID
+		return m.OwnerKey
 	}
-	return 0
+	return ""
 }
 
-func (m *TruncateRequest) GetOwnerKey() string {
+func (m *StreamAllocExtentRequest) GetRevision() int64 {
 	if m != nil {
-		return m.OwnerKey
+		return m.Revision
 	}
-	return ""
+	return 0
 }
 
-func (m *TruncateRequest) GetRevision() int64 {
+func (m *StreamAllocExtentRequest) GetEnd() uint32 {
 	if m != nil {
-		return m.Revision
+		return m.End
 	}
 	return 0
 }
 
-type TruncateResponse struct {
-	Code              Code        `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
-	CodeDes           string      `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
-	UpdatedStreamInfo *StreamInfo `protobuf:"bytes,3,opt,name=updatedStreamInfo,proto3" json:"updatedStreamInfo,omitempty"`
+type StreamAllocExtentResponse struct {
+	Code       Code        `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	CodeDes    string      `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
+	StreamInfo *StreamInfo `protobuf:"bytes,3,opt,name=streamInfo,proto3" json:"streamInfo,omitempty"`
+	LastExInfo *ExtentInfo `protobuf:"bytes,4,opt,name=lastExInfo,proto3" json:"lastExInfo,omitempty"`
 }
 
-func (m *TruncateResponse) Reset()         { *m = TruncateResponse{} }
-func (m *TruncateResponse) String() string { return proto.CompactTextString(m) }
-func (*TruncateResponse) ProtoMessage()    {}
-func (*TruncateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{40}
+func (m *StreamAllocExtentResponse) Reset()         { *m = StreamAllocExtentResponse{} }
+func (m *StreamAllocExtentResponse) String() string { return proto.CompactTextString(m) }
+func (*StreamAllocExtentResponse) ProtoMessage()    {}
+func (*StreamAllocExtentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{42}
 }
-func (m *TruncateResponse) XXX_Unmarshal(b []byte) error {
+func (m *StreamAllocExtentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TruncateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *StreamAllocExtentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TruncateResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_StreamAllocExtentResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2525,61 +2775,62 @@ func (m *TruncateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *TruncateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TruncateResponse.Merge(m, src)
+func (m *StreamAllocExtentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamAllocExtentResponse.Merge(m, src)
 }
-func (m *TruncateResponse) XXX_Size() int {
+func (m *StreamAllocExtentResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *TruncateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_TruncateResponse.DiscardUnknown(m)
+func (m *StreamAllocExtentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamAllocExtentResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TruncateResponse proto.InternalMessageInfo
+var xxx_messageInfo_StreamAllocExtentResponse proto.InternalMessageInfo
 
-func (m *TruncateResponse) GetCode() Code {
+func (m *StreamAllocExtentResponse) GetCode() Code {
 	if m != nil {
 		return m.Code
 	}
 	return Code_OK
 }
 
-func (m *TruncateResponse) GetCodeDes() string {
+func (m *StreamAllocExtentResponse) GetCodeDes() string {
 	if m != nil {
 		return m.CodeDes
 	}
 	return ""
 }
 
-func (m *TruncateResponse) GetUpdatedStreamInfo() *StreamInfo {
+func (m *StreamAllocExtentResponse) GetStreamInfo() *StreamInfo {
 	if m != nil {
-		return m.UpdatedStreamInfo
+		return m.StreamInfo
 	}
 	return nil
 }
 
-type MultiModifySplitRequest struct {
-	PartID                 uint64 `protobuf:"varint,1,opt,name=partID,proto3" json:"partID,omitempty"`
-	MidKey                 []byte `protobuf:"bytes,2,opt,name=midKey,proto3" json:"midKey,omitempty"`
-	OwnerKey               string `protobuf:"bytes,3,opt,name=ownerKey,proto3" json:"ownerKey,omitempty"`
-	Revision               int64  `protobuf:"varint,4,opt,name=revision,proto3" json:"revision,omitempty"`
-	LogStreamSealedLength  uint32 `protobuf:"varint,5,opt,name=logStreamSealedLength,proto3" json:"logStreamSealedLength,omitempty"`
-	RowStreamSealedLength  uint32 `protobuf:"varint,6,opt,name=rowStreamSealedLength,proto3" json:"rowStreamSealedLength,omitempty"`
-	MetaStreamSealedLength uint32 `protobuf:"varint,7,opt,name=metaStreamSealedLength,proto3" json:"metaStreamSealedLength,omitempty"`
+func (m *StreamAllocExtentResponse) GetLastExInfo() *ExtentInfo {
+	if m != nil {
+		return m.LastExInfo
+	}
+	return nil
 }
 
-func (m *MultiModifySplitRequest) Reset()         { *m = MultiModifySplitRequest{} }
-func (m *MultiModifySplitRequest) String() string { return proto.CompactTextString(m) }
-func (*MultiModifySplitRequest) ProtoMessage()    {}
-func (*MultiModifySplitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{41}
+type StreamInfoRequest struct {
+	StreamIDs []uint64 `protobuf:"varint,1,rep,packed,name=streamIDs,proto3" json:"streamIDs,omitempty"`
 }
-func (m *MultiModifySplitRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *StreamInfoRequest) Reset()         { *m = StreamInfoRequest{} }
+func (m *StreamInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamInfoRequest) ProtoMessage()    {}
+func (*StreamInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{43}
+}
+func (m *StreamInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MultiModifySplitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *StreamInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MultiModifySplitRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_StreamInfoRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2589,84 +2840,155 @@ func (m *MultiModifySplitRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MultiModifySplitRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MultiModifySplitRequest.Merge(m, src)
+func (m *StreamInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamInfoRequest.Merge(m, src)
 }
-func (m *MultiModifySplitRequest) XXX_Size() int {
+func (m *StreamInfoRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MultiModifySplitRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MultiModifySplitRequest.DiscardUnknown(m)
+func (m *StreamInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamInfoRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MultiModifySplitRequest proto.InternalMessageInfo
-
-func (m *MultiModifySplitRequest) GetPartID() uint64 {
-	if m != nil {
-		return m.PartID
-	}
-	return 0
-}
+var xxx_messageInfo_StreamInfoRequest proto.InternalMessageInfo
 
-func (m *MultiModifySplitRequest) GetMidKey() []byte {
+func (m *StreamInfoRequest) GetStreamIDs() []uint64 {
 	if m != nil {
-		return m.MidKey
+		return m.StreamIDs
 	}
 	return nil
 }
 
-func (m *MultiModifySplitRequest) GetOwnerKey() string {
-	if m != nil {
-		return m.OwnerKey
-	}
-	return ""
+type StreamInfoResponse struct {
+	Code    Code                   `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	CodeDes string                 `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
+	Streams map[uint64]*StreamInfo `protobuf:"bytes,3,rep,name=streams,proto3" json:"streams,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Extents map[uint64]*ExtentInfo `protobuf:"bytes,4,rep,name=extents,proto3" json:"extents,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (m *MultiModifySplitRequest) GetRevision() int64 {
+func (m *StreamInfoResponse) Reset()         { *m = StreamInfoResponse{} }
+func (m *StreamInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*StreamInfoResponse) ProtoMessage()    {}
+func (*StreamInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{44}
+}
+func (m *StreamInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamInfoResponse.Merge(m, src)
+}
+func (m *StreamInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamInfoResponse proto.InternalMessageInfo
+
+func (m *StreamInfoResponse) GetCode() Code {
 	if m != nil {
-		return m.Revision
+		return m.Code
 	}
-	return 0
+	return Code_OK
 }
 
-func (m *MultiModifySplitRequest) GetLogStreamSealedLength() uint32 {
+func (m *StreamInfoResponse) GetCodeDes() string {
 	if m != nil {
-		return m.LogStreamSealedLength
+		return m.CodeDes
 	}
-	return 0
+	return ""
 }
 
-func (m *MultiModifySplitRequest) GetRowStreamSealedLength() uint32 {
+func (m *StreamInfoResponse) GetStreams() map[uint64]*StreamInfo {
 	if m != nil {
-		return m.RowStreamSealedLength
+		return m.Streams
 	}
-	return 0
+	return nil
 }
 
-func (m *MultiModifySplitRequest) GetMetaStreamSealedLength() uint32 {
+func (m *StreamInfoResponse) GetExtents() map[uint64]*ExtentInfo {
 	if m != nil {
-		return m.MetaStreamSealedLength
+		return m.Extents
+	}
+	return nil
+}
+
+type ExtentInfoRequest struct {
+	ExtentID uint64 `protobuf:"varint,1,opt,name=extentID,proto3" json:"extentID,omitempty"`
+}
+
+func (m *ExtentInfoRequest) Reset()         { *m = ExtentInfoRequest{} }
+func (m *ExtentInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*ExtentInfoRequest) ProtoMessage()    {}
+func (*ExtentInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{45}
+}
+func (m *ExtentInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtentInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtentInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtentInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtentInfoRequest.Merge(m, src)
+}
+func (m *ExtentInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtentInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtentInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtentInfoRequest proto.InternalMessageInfo
+
+func (m *ExtentInfoRequest) GetExtentID() uint64 {
+	if m != nil {
+		return m.ExtentID
 	}
 	return 0
 }
 
-type MultiModifySplitResponse struct {
-	Code    Code   `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
-	CodeDes string `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
+type ExtentInfoResponse struct {
+	Code    Code        `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	CodeDes string      `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
+	ExInfo  *ExtentInfo `protobuf:"bytes,3,opt,name=exInfo,proto3" json:"exInfo,omitempty"`
 }
 
-func (m *MultiModifySplitResponse) Reset()         { *m = MultiModifySplitResponse{} }
-func (m *MultiModifySplitResponse) String() string { return proto.CompactTextString(m) }
-func (*MultiModifySplitResponse) ProtoMessage()    {}
-func (*MultiModifySplitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{42}
+func (m *ExtentInfoResponse) Reset()         { *m = ExtentInfoResponse{} }
+func (m *ExtentInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*ExtentInfoResponse) ProtoMessage()    {}
+func (*ExtentInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{46}
 }
-func (m *MultiModifySplitResponse) XXX_Unmarshal(b []byte) error {
+func (m *ExtentInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MultiModifySplitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ExtentInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MultiModifySplitResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ExtentInfoResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2676,47 +2998,54 @@ func (m *MultiModifySplitResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MultiModifySplitResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MultiModifySplitResponse.Merge(m, src)
+func (m *ExtentInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtentInfoResponse.Merge(m, src)
 }
-func (m *MultiModifySplitResponse) XXX_Size() int {
+func (m *ExtentInfoResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MultiModifySplitResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MultiModifySplitResponse.DiscardUnknown(m)
+func (m *ExtentInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtentInfoResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MultiModifySplitResponse proto.InternalMessageInfo
+var xxx_messageInfo_ExtentInfoResponse proto.InternalMessageInfo
 
-func (m *MultiModifySplitResponse) GetCode() Code {
+func (m *ExtentInfoResponse) GetCode() Code {
 	if m != nil {
 		return m.Code
 	}
 	return Code_OK
 }
 
-func (m *MultiModifySplitResponse) GetCodeDes() string {
+func (m *ExtentInfoResponse) GetCodeDes() string {
 	if m != nil {
 		return m.CodeDes
 	}
 	return ""
 }
 
-type StatusRequest struct {
+func (m *ExtentInfoResponse) GetExInfo() *ExtentInfo {
+	if m != nil {
+		return m.ExInfo
+	}
+	return nil
 }
 
-func (m *StatusRequest) Reset()         { *m = StatusRequest{} }
-func (m *StatusRequest) String() string { return proto.CompactTextString(m) }
-func (*StatusRequest) ProtoMessage()    {}
-func (*StatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{43}
+type NodesInfoRequest struct {
 }
-func (m *StatusRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *NodesInfoRequest) Reset()         { *m = NodesInfoRequest{} }
+func (m *NodesInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*NodesInfoRequest) ProtoMessage()    {}
+func (*NodesInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{47}
+}
+func (m *NodesInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *NodesInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_StatusRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_NodesInfoRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2726,35 +3055,37 @@ func (m *StatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *StatusRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StatusRequest.Merge(m, src)
+func (m *NodesInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodesInfoRequest.Merge(m, src)
 }
-func (m *StatusRequest) XXX_Size() int {
+func (m *NodesInfoRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *StatusRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_StatusRequest.DiscardUnknown(m)
+func (m *NodesInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodesInfoRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StatusRequest proto.InternalMessageInfo
+var xxx_messageInfo_NodesInfoRequest proto.InternalMessageInfo
 
-type StatusResponse struct {
-	Code    Code   `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
-	CodeDes string `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
+type NodesInfoResponse struct {
+	Code         Code                             `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	CodeDes      string                           `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
+	Nodes        map[uint64]*NodeInfo             `protobuf:"bytes,3,rep,name=nodes,proto3" json:"nodes,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Decommission map[uint64]*DecommissionProgress `protobuf:"bytes,4,rep,name=decommission,proto3" json:"decommission,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (m *StatusResponse) Reset()         { *m = StatusResponse{} }
-func (m *StatusResponse) String() string { return proto.CompactTextString(m) }
-func (*StatusResponse) ProtoMessage()    {}
-func (*StatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{44}
+func (m *NodesInfoResponse) Reset()         { *m = NodesInfoResponse{} }
+func (m *NodesInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*NodesInfoResponse) ProtoMessage()    {}
+func (*NodesInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{48}
 }
-func (m *StatusResponse) XXX_Unmarshal(b []byte) error {
+func (m *NodesInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *NodesInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_StatusResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_NodesInfoResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2764,51 +3095,65 @@ func (m *StatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *StatusResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StatusResponse.Merge(m, src)
+func (m *NodesInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodesInfoResponse.Merge(m, src)
 }
-func (m *StatusResponse) XXX_Size() int {
+func (m *NodesInfoResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *StatusResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_StatusResponse.DiscardUnknown(m)
+func (m *NodesInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodesInfoResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StatusResponse proto.InternalMessageInfo
+var xxx_messageInfo_NodesInfoResponse proto.InternalMessageInfo
 
-func (m *StatusResponse) GetCode() Code {
+func (m *NodesInfoResponse) GetCode() Code {
 	if m != nil {
 		return m.Code
 	}
 	return Code_OK
 }
 
-func (m *StatusResponse) GetCodeDes() string {
+func (m *NodesInfoResponse) GetCodeDes() string {
 	if m != nil {
 		return m.CodeDes
 	}
 	return ""
 }
 
-type PunchHolesRequest struct {
-	StreamID  uint64   `protobuf:"varint,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
-	ExtentIDs []uint64 `protobuf:"varint,2,rep,packed,name=extentIDs,proto3" json:"extentIDs,omitempty"`
-	OwnerKey  string   `protobuf:"bytes,4,opt,name=ownerKey,proto3" json:"ownerKey,omitempty"`
-	Revision  int64    `protobuf:"varint,5,opt,name=revision,proto3" json:"revision,omitempty"`
+func (m *NodesInfoResponse) GetNodes() map[uint64]*NodeInfo {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
 }
 
-func (m *PunchHolesRequest) Reset()         { *m = PunchHolesRequest{} }
-func (m *PunchHolesRequest) String() string { return proto.CompactTextString(m) }
-func (*PunchHolesRequest) ProtoMessage()    {}
-func (*PunchHolesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{45}
+func (m *NodesInfoResponse) GetDecommission() map[uint64]*DecommissionProgress {
+	if m != nil {
+		return m.Decommission
+	}
+	return nil
 }
-func (m *PunchHolesRequest) XXX_Unmarshal(b []byte) error {
+
+type DecommissionProgress struct {
+	NodeID    uint64 `protobuf:"varint,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	Remaining uint32 `protobuf:"varint,2,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	Running   uint32 `protobuf:"varint,3,opt,name=running,proto3" json:"running,omitempty"`
+	Unsealed  uint32 `protobuf:"varint,4,opt,name=unsealed,proto3" json:"unsealed,omitempty"`
+}
+
+func (m *DecommissionProgress) Reset()         { *m = DecommissionProgress{} }
+func (m *DecommissionProgress) String() string { return proto.CompactTextString(m) }
+func (*DecommissionProgress) ProtoMessage()    {}
+func (*DecommissionProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{49}
+}
+func (m *DecommissionProgress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PunchHolesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DecommissionProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PunchHolesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DecommissionProgress.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2818,64 +3163,63 @@ func (m *PunchHolesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *PunchHolesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PunchHolesRequest.Merge(m, src)
+func (m *DecommissionProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecommissionProgress.Merge(m, src)
 }
-func (m *PunchHolesRequest) XXX_Size() int {
+func (m *DecommissionProgress) XXX_Size() int {
 	return m.Size()
 }
-func (m *PunchHolesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PunchHolesRequest.DiscardUnknown(m)
+func (m *DecommissionProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecommissionProgress.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PunchHolesRequest proto.InternalMessageInfo
+var xxx_messageInfo_DecommissionProgress proto.InternalMessageInfo
 
-func (m *PunchHolesRequest) GetStreamID() uint64 {
+func (m *DecommissionProgress) GetNodeID() uint64 {
 	if m != nil {
-		return m.StreamID
+		return m.NodeID
 	}
 	return 0
 }
 
-func (m *PunchHolesRequest) GetExtentIDs() []uint64 {
+func (m *DecommissionProgress) GetRemaining() uint32 {
 	if m != nil {
-		return m.ExtentIDs
+		return m.Remaining
 	}
-	return nil
+	return 0
 }
 
-func (m *PunchHolesRequest) GetOwnerKey() string {
+func (m *DecommissionProgress) GetRunning() uint32 {
 	if m != nil {
-		return m.OwnerKey
+		return m.Running
 	}
-	return ""
+	return 0
 }
 
-func (m *PunchHolesRequest) GetRevision() int64 {
+func (m *DecommissionProgress) GetUnsealed() uint32 {
 	if m != nil {
-		return m.Revision
+		return m.Unsealed
 	}
 	return 0
 }
 
-type PunchHolesResponse struct {
-	Code    Code        `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
-	CodeDes string      `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
-	Stream  *StreamInfo `protobuf:"bytes,3,opt,name=stream,proto3" json:"stream,omitempty"`
+type DecommissionNodeRequest struct {
+	NodeID uint64 `protobuf:"varint,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	Cancel bool   `protobuf:"varint,2,opt,name=cancel,proto3" json:"cancel,omitempty"`
 }
 
-func (m *PunchHolesResponse) Reset()         { *m = PunchHolesResponse{} }
-func (m *PunchHolesResponse) String() string { return proto.CompactTextString(m) }
-func (*PunchHolesResponse) ProtoMessage()    {}
-func (*PunchHolesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{46}
+func (m *DecommissionNodeRequest) Reset()         { *m = DecommissionNodeRequest{} }
+func (m *DecommissionNodeRequest) String() string { return proto.CompactTextString(m) }
+func (*DecommissionNodeRequest) ProtoMessage()    {}
+func (*DecommissionNodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{50}
 }
-func (m *PunchHolesResponse) XXX_Unmarshal(b []byte) error {
+func (m *DecommissionNodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PunchHolesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DecommissionNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PunchHolesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DecommissionNodeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2885,58 +3229,49 @@ func (m *PunchHolesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *PunchHolesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PunchHolesResponse.Merge(m, src)
+func (m *DecommissionNodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecommissionNodeRequest.Merge(m, src)
 }
-func (m *PunchHolesResponse) XXX_Size() int {
+func (m *DecommissionNodeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *PunchHolesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PunchHolesResponse.DiscardUnknown(m)
+func (m *DecommissionNodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecommissionNodeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PunchHolesResponse proto.InternalMessageInfo
-
-func (m *PunchHolesResponse) GetCode() Code {
-	if m != nil {
-		return m.Code
-	}
-	return Code_OK
-}
+var xxx_messageInfo_DecommissionNodeRequest proto.InternalMessageInfo
 
-func (m *PunchHolesResponse) GetCodeDes() string {
+func (m *DecommissionNodeRequest) GetNodeID() uint64 {
 	if m != nil {
-		return m.CodeDes
+		return m.NodeID
 	}
-	return ""
+	return 0
 }
 
-func (m *PunchHolesResponse) GetStream() *StreamInfo {
+func (m *DecommissionNodeRequest) GetCancel() bool {
 	if m != nil {
-		return m.Stream
+		return m.Cancel
 	}
-	return nil
+	return false
 }
 
-//used in Etcd Campaign
-type MemberValue struct {
-	ID      uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Name    string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
-	GrpcURL string `protobuf:"bytes,3,opt,name=GrpcURL,proto3" json:"GrpcURL,omitempty"`
+type DecommissionNodeResponse struct {
+	Code    Code   `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	CodeDes string `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
 }
 
-func (m *MemberValue) Reset()         { *m = MemberValue{} }
-func (m *MemberValue) String() string { return proto.CompactTextString(m) }
-func (*MemberValue) ProtoMessage()    {}
-func (*MemberValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{47}
+func (m *DecommissionNodeResponse) Reset()         { *m = DecommissionNodeResponse{} }
+func (m *DecommissionNodeResponse) String() string { return proto.CompactTextString(m) }
+func (*DecommissionNodeResponse) ProtoMessage()    {}
+func (*DecommissionNodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{51}
 }
-func (m *MemberValue) XXX_Unmarshal(b []byte) error {
+func (m *DecommissionNodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MemberValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DecommissionNodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MemberValue.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DecommissionNodeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2946,63 +3281,50 @@ func (m *MemberValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *MemberValue) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MemberValue.Merge(m, src)
+func (m *DecommissionNodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecommissionNodeResponse.Merge(m, src)
 }
-func (m *MemberValue) XXX_Size() int {
+func (m *DecommissionNodeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MemberValue) XXX_DiscardUnknown() {
-	xxx_messageInfo_MemberValue.DiscardUnknown(m)
+func (m *DecommissionNodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecommissionNodeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MemberValue proto.InternalMessageInfo
-
-func (m *MemberValue) GetID() uint64 {
-	if m != nil {
-		return m.ID
-	}
-	return 0
-}
+var xxx_messageInfo_DecommissionNodeResponse proto.InternalMessageInfo
 
-func (m *MemberValue) GetName() string {
+func (m *DecommissionNodeResponse) GetCode() Code {
 	if m != nil {
-		return m.Name
+		return m.Code
 	}
-	return ""
+	return Code_OK
 }
 
-func (m *MemberValue) GetGrpcURL() string {
+func (m *DecommissionNodeResponse) GetCodeDes() string {
 	if m != nil {
-		return m.GrpcURL
+		return m.CodeDes
 	}
 	return ""
 }
 
-type ExtentInfo struct {
-	ExtentID       uint64   `protobuf:"varint,1,opt,name=extentID,proto3" json:"extentID,omitempty"`
-	Replicates     []uint64 `protobuf:"varint,2,rep,packed,name=replicates,proto3" json:"replicates,omitempty"`
-	Parity         []uint64 `protobuf:"varint,3,rep,packed,name=parity,proto3" json:"parity,omitempty"`
-	Eversion       uint64   `protobuf:"varint,4,opt,name=eversion,proto3" json:"eversion,omitempty"`
-	Refs           uint64   `protobuf:"varint,5,opt,name=refs,proto3" json:"refs,omitempty"`
-	SealedLength   uint64   `protobuf:"varint,6,opt,name=SealedLength,proto3" json:"SealedLength,omitempty"`
-	Avali          uint32   `protobuf:"varint,7,opt,name=avali,proto3" json:"avali,omitempty"`
-	ReplicateDisks []uint64 `protobuf:"varint,8,rep,packed,name=replicateDisks,proto3" json:"replicateDisks,omitempty"`
-	ParityDisk     []uint64 `protobuf:"varint,9,rep,packed,name=parityDisk,proto3" json:"parityDisk,omitempty"`
+type RegisterNodeRequest struct {
+	Addr      string    `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	DiskUUIDs []string  `protobuf:"bytes,2,rep,name=diskUUIDs,proto3" json:"diskUUIDs,omitempty"`
+	Topology  *Topology `protobuf:"bytes,3,opt,name=topology,proto3" json:"topology,omitempty"`
 }
 
-func (m *ExtentInfo) Reset()         { *m = ExtentInfo{} }
-func (m *ExtentInfo) String() string { return proto.CompactTextString(m) }
-func (*ExtentInfo) ProtoMessage()    {}
-func (*ExtentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{48}
+func (m *RegisterNodeRequest) Reset()         { *m = RegisterNodeRequest{} }
+func (m *RegisterNodeRequest) String() string { return proto.CompactTextString(m) }
+func (*RegisterNodeRequest) ProtoMessage()    {}
+func (*RegisterNodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{52}
 }
-func (m *ExtentInfo) XXX_Unmarshal(b []byte) error {
+func (m *RegisterNodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ExtentInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RegisterNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ExtentInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RegisterNodeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3012,98 +3334,119 @@ func (m *ExtentInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *ExtentInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ExtentInfo.Merge(m, src)
+func (m *RegisterNodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterNodeRequest.Merge(m, src)
 }
-func (m *ExtentInfo) XXX_Size() int {
+func (m *RegisterNodeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ExtentInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_ExtentInfo.DiscardUnknown(m)
+func (m *RegisterNodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterNodeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ExtentInfo proto.InternalMessageInfo
+var xxx_messageInfo_RegisterNodeRequest proto.InternalMessageInfo
 
-func (m *ExtentInfo) GetExtentID() uint64 {
+func (m *RegisterNodeRequest) GetAddr() string {
 	if m != nil {
-		return m.ExtentID
+		return m.Addr
 	}
-	return 0
+	return ""
 }
 
-func (m *ExtentInfo) GetReplicates() []uint64 {
+func (m *RegisterNodeRequest) GetDiskUUIDs() []string {
 	if m != nil {
-		return m.Replicates
+		return m.DiskUUIDs
 	}
 	return nil
 }
 
-func (m *ExtentInfo) GetParity() []uint64 {
+func (m *RegisterNodeRequest) GetTopology() *Topology {
 	if m != nil {
-		return m.Parity
+		return m.Topology
 	}
 	return nil
 }
 
-func (m *ExtentInfo) GetEversion() uint64 {
-	if m != nil {
-		return m.Eversion
-	}
-	return 0
+//failure domains of a node, copies of an extent are spread across them
+type Topology struct {
+	Zone string `protobuf:"bytes,1,opt,name=zone,proto3" json:"zone,omitempty"`
+	Rack string `protobuf:"bytes,2,opt,name=rack,proto3" json:"rack,omitempty"`
+	Host string `protobuf:"bytes,3,opt,name=host,proto3" json:"host,omitempty"`
 }
 
-func (m *ExtentInfo) GetRefs() uint64 {
-	if m != nil {
-		return m.Refs
-	}
-	return 0
+func (m *Topology) Reset()         { *m = Topology{} }
+func (m *Topology) String() string { return proto.CompactTextString(m) }
+func (*Topology) ProtoMessage()    {}
+func (*Topology) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{53}
 }
-
-func (m *ExtentInfo) GetSealedLength() uint64 {
-	if m != nil {
-		return m.SealedLength
+func (m *Topology) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Topology) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Topology.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *Topology) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Topology.Merge(m, src)
+}
+func (m *Topology) XXX_Size() int {
+	return m.Size()
+}
+func (m *Topology) XXX_DiscardUnknown() {
+	xxx_messageInfo_Topology.DiscardUnknown(m)
 }
 
-func (m *ExtentInfo) GetAvali() uint32 {
+var xxx_messageInfo_Topology proto.InternalMessageInfo
+
+func (m *Topology) GetZone() string {
 	if m != nil {
-		return m.Avali
+		return m.Zone
 	}
-	return 0
+	return ""
 }
 
-func (m *ExtentInfo) GetReplicateDisks() []uint64 {
+func (m *Topology) GetRack() string {
 	if m != nil {
-		return m.ReplicateDisks
+		return m.Rack
 	}
-	return nil
+	return ""
 }
 
-func (m *ExtentInfo) GetParityDisk() []uint64 {
+func (m *Topology) GetHost() string {
 	if m != nil {
-		return m.ParityDisk
+		return m.Host
 	}
-	return nil
+	return ""
 }
 
-type StreamInfo struct {
-	StreamID  uint64   `protobuf:"varint,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
-	ExtentIDs []uint64 `protobuf:"varint,2,rep,packed,name=extentIDs,proto3" json:"extentIDs,omitempty"`
+type RegisterNodeResponse struct {
+	Code      Code              `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	CodeDes   string            `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
+	NodeId    uint64            `protobuf:"varint,3,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	DiskUUIDs map[string]uint64 `protobuf:"bytes,4,rep,name=diskUUIDs,proto3" json:"diskUUIDs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 }
 
-func (m *StreamInfo) Reset()         { *m = StreamInfo{} }
-func (m *StreamInfo) String() string { return proto.CompactTextString(m) }
-func (*StreamInfo) ProtoMessage()    {}
-func (*StreamInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{49}
+func (m *RegisterNodeResponse) Reset()         { *m = RegisterNodeResponse{} }
+func (m *RegisterNodeResponse) String() string { return proto.CompactTextString(m) }
+func (*RegisterNodeResponse) ProtoMessage()    {}
+func (*RegisterNodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{54}
 }
-func (m *StreamInfo) XXX_Unmarshal(b []byte) error {
+func (m *RegisterNodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StreamInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RegisterNodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_StreamInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RegisterNodeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3113,50 +3456,64 @@ func (m *StreamInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *StreamInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StreamInfo.Merge(m, src)
+func (m *RegisterNodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterNodeResponse.Merge(m, src)
 }
-func (m *StreamInfo) XXX_Size() int {
+func (m *RegisterNodeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *StreamInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_StreamInfo.DiscardUnknown(m)
+func (m *RegisterNodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterNodeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StreamInfo proto.InternalMessageInfo
+var xxx_messageInfo_RegisterNodeResponse proto.InternalMessageInfo
 
-func (m *StreamInfo) GetStreamID() uint64 {
+func (m *RegisterNodeResponse) GetCode() Code {
 	if m != nil {
-		return m.StreamID
+		return m.Code
+	}
+	return Code_OK
+}
+
+func (m *RegisterNodeResponse) GetCodeDes() string {
+	if m != nil {
+		return m.CodeDes
+	}
+	return ""
+}
+
+func (m *RegisterNodeResponse) GetNodeId() uint64 {
+	if m != nil {
+		return m.NodeId
 	}
 	return 0
 }
 
-func (m *StreamInfo) GetExtentIDs() []uint64 {
+func (m *RegisterNodeResponse) GetDiskUUIDs() map[string]uint64 {
 	if m != nil {
-		return m.ExtentIDs
+		return m.DiskUUIDs
 	}
 	return nil
 }
 
-type NodeInfo struct {
-	NodeID  uint64   `protobuf:"varint,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
-	Address string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Disks   []uint64 `protobuf:"varint,3,rep,packed,name=disks,proto3" json:"disks,omitempty"`
+type RegisterDiskRequest struct {
+	NodeID        uint64 `protobuf:"varint,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	DiskUUID      string `protobuf:"bytes,2,opt,name=diskUUID,proto3" json:"diskUUID,omitempty"`
+	ReplaceDiskID uint64 `protobuf:"varint,3,opt,name=replaceDiskID,proto3" json:"replaceDiskID,omitempty"`
 }
 
-func (m *NodeInfo) Reset()         { *m = NodeInfo{} }
-func (m *NodeInfo) String() string { return proto.CompactTextString(m) }
-func (*NodeInfo) ProtoMessage()    {}
-func (*NodeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{50}
+func (m *RegisterDiskRequest) Reset()         { *m = RegisterDiskRequest{} }
+func (m *RegisterDiskRequest) String() string { return proto.CompactTextString(m) }
+func (*RegisterDiskRequest) ProtoMessage()    {}
+func (*RegisterDiskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{55}
 }
-func (m *NodeInfo) XXX_Unmarshal(b []byte) error {
+func (m *RegisterDiskRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *NodeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RegisterDiskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_NodeInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RegisterDiskRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3166,57 +3523,57 @@ func (m *NodeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *NodeInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NodeInfo.Merge(m, src)
+func (m *RegisterDiskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterDiskRequest.Merge(m, src)
 }
-func (m *NodeInfo) XXX_Size() int {
+func (m *RegisterDiskRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *NodeInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_NodeInfo.DiscardUnknown(m)
+func (m *RegisterDiskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterDiskRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NodeInfo proto.InternalMessageInfo
+var xxx_messageInfo_RegisterDiskRequest proto.InternalMessageInfo
 
-func (m *NodeInfo) GetNodeID() uint64 {
+func (m *RegisterDiskRequest) GetNodeID() uint64 {
 	if m != nil {
 		return m.NodeID
 	}
 	return 0
 }
 
-func (m *NodeInfo) GetAddress() string {
+func (m *RegisterDiskRequest) GetDiskUUID() string {
 	if m != nil {
-		return m.Address
+		return m.DiskUUID
 	}
 	return ""
 }
 
-func (m *NodeInfo) GetDisks() []uint64 {
+func (m *RegisterDiskRequest) GetReplaceDiskID() uint64 {
 	if m != nil {
-		return m.Disks
+		return m.ReplaceDiskID
 	}
-	return nil
+	return 0
 }
 
-type DiskInfo struct {
-	DiskID uint64 `protobuf:"varint,1,opt,name=diskID,proto3" json:"diskID,omitempty"`
-	Online bool   `protobuf:"varint,2,opt,name=online,proto3" json:"online,omitempty"`
-	Uuid   string `protobuf:"bytes,3,opt,name=uuid,proto3" json:"uuid,omitempty"`
+type RegisterDiskResponse struct {
+	Code    Code   `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	CodeDes string `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
+	DiskID  uint64 `protobuf:"varint,3,opt,name=diskID,proto3" json:"diskID,omitempty"`
 }
 
-func (m *DiskInfo) Reset()         { *m = DiskInfo{} }
-func (m *DiskInfo) String() string { return proto.CompactTextString(m) }
-func (*DiskInfo) ProtoMessage()    {}
-func (*DiskInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{51}
+func (m *RegisterDiskResponse) Reset()         { *m = RegisterDiskResponse{} }
+func (m *RegisterDiskResponse) String() string { return proto.CompactTextString(m) }
+func (*RegisterDiskResponse) ProtoMessage()    {}
+func (*RegisterDiskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{56}
 }
-func (m *DiskInfo) XXX_Unmarshal(b []byte) error {
+func (m *RegisterDiskResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DiskInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RegisterDiskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DiskInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RegisterDiskResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3226,4827 +3583,16441 @@ func (m *DiskInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *DiskInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DiskInfo.Merge(m, src)
+func (m *RegisterDiskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterDiskResponse.Merge(m, src)
 }
-func (m *DiskInfo) XXX_Size() int {
+func (m *RegisterDiskResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *DiskInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_DiskInfo.DiscardUnknown(m)
+func (m *RegisterDiskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterDiskResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DiskInfo proto.InternalMessageInfo
+var xxx_messageInfo_RegisterDiskResponse proto.InternalMessageInfo
 
-func (m *DiskInfo) GetDiskID() uint64 {
+func (m *RegisterDiskResponse) GetCode() Code {
 	if m != nil {
-		return m.DiskID
+		return m.Code
 	}
-	return 0
+	return Code_OK
 }
 
-func (m *DiskInfo) GetOnline() bool {
+func (m *RegisterDiskResponse) GetCodeDes() string {
 	if m != nil {
-		return m.Online
+		return m.CodeDes
 	}
-	return false
+	return ""
 }
 
-func (m *DiskInfo) GetUuid() string {
+func (m *RegisterDiskResponse) GetDiskID() uint64 {
 	if m != nil {
-		return m.Uuid
+		return m.DiskID
 	}
-	return ""
+	return 0
 }
 
-func init() {
-	proto.RegisterEnum("pb.Code", Code_name, Code_value)
-	proto.RegisterType((*AppendRequestHeader)(nil), "pb.AppendRequestHeader")
-	proto.RegisterType((*AppendRequest)(nil), "pb.AppendRequest")
-	proto.RegisterType((*AppendResponse)(nil), "pb.AppendResponse")
-	proto.RegisterType((*CreateExtentRequest)(nil), "pb.CreateExtentRequest")
-	proto.RegisterType((*CreateExtentResponse)(nil), "pb.CreateExtentResponse")
-	proto.RegisterType((*ReadBlocksRequest)(nil), "pb.ReadBlocksRequest")
-	proto.RegisterType((*ReadBlockResponseHeader)(nil), "pb.ReadBlockResponseHeader")
-	proto.RegisterType((*ReadBlocksResponse)(nil), "pb.ReadBlocksResponse")
-	proto.RegisterType((*Payload)(nil), "pb.Payload")
-	proto.RegisterType((*CommitLengthRequest)(nil), "pb.CommitLengthRequest")
-	proto.RegisterType((*CommitLengthResponse)(nil), "pb.CommitLengthResponse")
-	proto.RegisterType((*DF)(nil), "pb.DF")
-	proto.RegisterType((*DfRequest)(nil), "pb.DfRequest")
-	proto.RegisterType((*DfResponse)(nil), "pb.DfResponse")
-	proto.RegisterMapType((map[uint64]*DF)(nil), "pb.DfResponse.DiskStatusEntry")
-	proto.RegisterType((*RecoveryTaskStatus)(nil), "pb.RecoveryTaskStatus")
-	proto.RegisterType((*RecoveryTask)(nil), "pb.RecoveryTask")
-	proto.RegisterType((*RequireRecoveryRequest)(nil), "pb.RequireRecoveryRequest")
-	proto.RegisterType((*RequireRecoveryResponse)(nil), "pb.RequireRecoveryResponse")
-	proto.RegisterType((*CopyResponseHeader)(nil), "pb.CopyResponseHeader")
-	proto.RegisterType((*CopyExtentRequest)(nil), "pb.CopyExtentRequest")
-	proto.RegisterType((*CopyExtentResponse)(nil), "pb.CopyExtentResponse")
-	proto.RegisterType((*ReAvaliRequest)(nil), "pb.ReAvaliRequest")
-	proto.RegisterType((*ReAvaliResponse)(nil), "pb.ReAvaliResponse")
-	proto.RegisterType((*AllocExtentRequest)(nil), "pb.AllocExtentRequest")
-	proto.RegisterType((*AllocExtentResponse)(nil), "pb.AllocExtentResponse")
-	proto.RegisterType((*CheckCommitLengthRequest)(nil), "pb.CheckCommitLengthRequest")
-	proto.RegisterType((*CheckCommitLengthResponse)(nil), "pb.CheckCommitLengthResponse")
-	proto.RegisterType((*StreamAllocExtentRequest)(nil), "pb.StreamAllocExtentRequest")
-	proto.RegisterType((*StreamAllocExtentResponse)(nil), "pb.StreamAllocExtentResponse")
-	proto.RegisterType((*StreamInfoRequest)(nil), "pb.StreamInfoRequest")
-	proto.RegisterType((*StreamInfoResponse)(nil), "pb.StreamInfoResponse")
-	proto.RegisterMapType((map[uint64]*ExtentInfo)(nil), "pb.StreamInfoResponse.ExtentsEntry")
-	proto.RegisterMapType((map[uint64]*StreamInfo)(nil), "pb.StreamInfoResponse.StreamsEntry")
-	proto.RegisterType((*ExtentInfoRequest)(nil), "pb.ExtentInfoRequest")
-	proto.RegisterType((*ExtentInfoResponse)(nil), "pb.ExtentInfoResponse")
-	proto.RegisterType((*NodesInfoRequest)(nil), "pb.NodesInfoRequest")
-	proto.RegisterType((*NodesInfoResponse)(nil), "pb.NodesInfoResponse")
-	proto.RegisterMapType((map[uint64]*NodeInfo)(nil), "pb.NodesInfoResponse.NodesEntry")
-	proto.RegisterType((*RegisterNodeRequest)(nil), "pb.RegisterNodeRequest")
-	proto.RegisterType((*RegisterNodeResponse)(nil), "pb.RegisterNodeResponse")
-	proto.RegisterMapType((map[string]uint64)(nil), "pb.RegisterNodeResponse.DiskUUIDsEntry")
-	proto.RegisterType((*CreateStreamRequest)(nil), "pb.CreateStreamRequest")
-	proto.RegisterType((*CreateStreamResponse)(nil), "pb.CreateStreamResponse")
-	proto.RegisterType((*TruncateRequest)(nil), "pb.TruncateRequest")
-	proto.RegisterType((*TruncateResponse)(nil), "pb.TruncateResponse")
-	proto.RegisterType((*MultiModifySplitRequest)(nil), "pb.MultiModifySplitRequest")
-	proto.RegisterType((*MultiModifySplitResponse)(nil), "pb.MultiModifySplitResponse")
-	proto.RegisterType((*StatusRequest)(nil), "pb.StatusRequest")
-	proto.RegisterType((*StatusResponse)(nil), "pb.StatusResponse")
-	proto.RegisterType((*PunchHolesRequest)(nil), "pb.PunchHolesRequest")
-	proto.RegisterType((*PunchHolesResponse)(nil), "pb.PunchHolesResponse")
-	proto.RegisterType((*MemberValue)(nil), "pb.MemberValue")
-	proto.RegisterType((*ExtentInfo)(nil), "pb.ExtentInfo")
-	proto.RegisterType((*StreamInfo)(nil), "pb.StreamInfo")
-	proto.RegisterType((*NodeInfo)(nil), "pb.NodeInfo")
-	proto.RegisterType((*DiskInfo)(nil), "pb.DiskInfo")
+type CreateStreamRequest struct {
+	DataShard   uint32          `protobuf:"varint,1,opt,name=dataShard,proto3" json:"dataShard,omitempty"`
+	ParityShard uint32          `protobuf:"varint,2,opt,name=parityShard,proto3" json:"parityShard,omitempty"`
+	Replication ReplicationMode `protobuf:"varint,3,opt,name=replication,proto3,enum=pb.ReplicationMode" json:"replication,omitempty"`
+	Codec       *ErasureCodec   `protobuf:"bytes,4,opt,name=codec,proto3" json:"codec,omitempty"`
 }
 
-func init() { proto.RegisterFile("pb.proto", fileDescriptor_f80abaa17e25ccc8) }
-
-var fileDescriptor_f80abaa17e25ccc8 = []byte{
-	// 2230 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x19, 0x4d, 0x73, 0x23, 0x47,
-	0x55, 0x23, 0x8d, 0x64, 0xe9, 0xc9, 0xb2, 0xa5, 0xb6, 0x2d, 0x2b, 0x13, 0x47, 0xe5, 0x6a, 0xc2,
-	0x62, 0x72, 0xb0, 0x77, 0x95, 0x10, 0xa8, 0x54, 0x12, 0xd8, 0xb5, 0xec, 0x78, 0x59, 0x7b, 0x1d,
-	0xc6, 0xbb, 0x39, 0x50, 0x5c, 0xc6, 0x9a, 0x96, 0x3d, 0x6b, 0x69, 0x46, 0xcc, 0xb4, 0xbc, 0xab,
-	0x1c, 0x28, 0xa8, 0x82, 0x2a, 0xaa, 0xb8, 0x50, 0xdc, 0xe1, 0x42, 0x71, 0xe2, 0x48, 0xf1, 0x13,
-	0x28, 0xa8, 0xe2, 0x92, 0x23, 0x47, 0x6a, 0xf7, 0xc8, 0x9f, 0xa0, 0xfa, 0x63, 0x66, 0x7a, 0x3e,
-	0xe4, 0x38, 0x51, 0x6a, 0x4f, 0x9a, 0xf7, 0xba, 0xdf, 0xeb, 0xd7, 0xef, 0xbd, 0x7e, 0x5f, 0x82,
-	0xea, 0xe4, 0x7c, 0x77, 0xe2, 0x7b, 0xd4, 0x43, 0xc5, 0xc9, 0x39, 0xfe, 0xbb, 0x06, 0x6b, 0xf7,
-	0x27, 0x13, 0xe2, 0xda, 0x26, 0xf9, 0xf9, 0x94, 0x04, 0xf4, 0x88, 0x58, 0x36, 0xf1, 0x91, 0x01,
-	0x55, 0xf2, 0x82, 0x12, 0x97, 0x3e, 0xec, 0x77, 0xb4, 0x6d, 0x6d, 0x47, 0x37, 0x23, 0x98, 0xaf,
-	0x5d, 0x13, 0x3f, 0x70, 0x3c, 0xb7, 0x53, 0x94, 0x6b, 0x12, 0x46, 0x6d, 0xa8, 0x0c, 0xbc, 0xf1,
-	0xd8, 0xa1, 0x9d, 0xd2, 0xb6, 0xb6, 0xd3, 0x30, 0x25, 0xc4, 0x68, 0x7c, 0x72, 0xed, 0x70, 0x1a,
-	0x7d, 0x5b, 0xdb, 0x29, 0x99, 0x11, 0xcc, 0xd6, 0xc6, 0xd3, 0x80, 0x9e, 0xcd, 0xdc, 0x41, 0xa7,
-	0xbc, 0xad, 0xed, 0x54, 0xcd, 0x08, 0x66, 0xfc, 0xce, 0x47, 0xde, 0xe0, 0x2a, 0xe8, 0x54, 0xb6,
-	0x4b, 0x8c, 0x9f, 0x80, 0xf0, 0x10, 0x1a, 0x09, 0xb1, 0xd1, 0x3d, 0xa8, 0x5c, 0x72, 0xd1, 0xb9,
-	0xb8, 0xf5, 0xde, 0xe6, 0xee, 0xe4, 0x7c, 0x37, 0xe7, 0x66, 0x47, 0x05, 0x53, 0x6e, 0x44, 0x06,
-	0x2c, 0x4d, 0xac, 0xd9, 0xc8, 0xb3, 0x6c, 0x7e, 0x8d, 0xe5, 0xa3, 0x82, 0x19, 0x22, 0x1e, 0x54,
-	0x40, 0xb7, 0x2d, 0x6a, 0x61, 0x0a, 0x2b, 0x21, 0x93, 0x60, 0xe2, 0xb9, 0x01, 0x41, 0x5b, 0xa0,
-	0x0f, 0x3c, 0x9b, 0xf0, 0x63, 0x56, 0x7a, 0x55, 0x76, 0xcc, 0xbe, 0x67, 0x13, 0x93, 0x63, 0x51,
-	0x07, 0x96, 0xd8, 0x6f, 0x9f, 0x04, 0x9c, 0x67, 0xcd, 0x0c, 0x41, 0xb6, 0xe2, 0x0d, 0x87, 0x01,
-	0xa1, 0x41, 0xa7, 0xc4, 0xaf, 0x12, 0x82, 0xa8, 0x09, 0x25, 0xe2, 0xda, 0x5c, 0x2d, 0x0d, 0x93,
-	0x7d, 0xe2, 0x7b, 0xb0, 0xb6, 0xef, 0x13, 0x8b, 0x92, 0x03, 0xae, 0xf3, 0xf0, 0x8e, 0x06, 0x54,
-	0x03, 0xea, 0x13, 0x6b, 0x1c, 0x1b, 0x25, 0x84, 0xf1, 0x33, 0x58, 0x4f, 0x92, 0x2c, 0x28, 0xae,
-	0xea, 0x00, 0xa5, 0xa4, 0x03, 0xe0, 0xbf, 0x6a, 0xd0, 0x32, 0x89, 0x65, 0x3f, 0xe0, 0xb6, 0x50,
-	0xa4, 0x9b, 0xeb, 0x32, 0x6d, 0xa8, 0x88, 0xdb, 0xf2, 0x63, 0x1a, 0xa6, 0x84, 0xd0, 0x36, 0xd4,
-	0xdd, 0xe9, 0xf8, 0x74, 0x28, 0x38, 0x49, 0x9f, 0x51, 0x51, 0x09, 0x67, 0xd3, 0x53, 0xce, 0xf6,
-	0x36, 0x34, 0x3c, 0x77, 0x34, 0x3b, 0xb6, 0x02, 0xca, 0x77, 0x4b, 0xef, 0x49, 0x22, 0xf1, 0x1f,
-	0x35, 0xd8, 0x8c, 0xa4, 0x0d, 0xf5, 0x22, 0xdd, 0xfc, 0x35, 0x18, 0x13, 0x75, 0x01, 0xb8, 0xd3,
-	0x9e, 0x39, 0x9f, 0x93, 0xa0, 0x53, 0xe6, 0xdb, 0x15, 0x0c, 0xf6, 0x00, 0xa9, 0xca, 0x94, 0x76,
-	0xfb, 0x5e, 0xca, 0x9f, 0xdf, 0x64, 0xb2, 0xcd, 0xb9, 0xc6, 0x57, 0xf4, 0xe9, 0xb7, 0x60, 0xe9,
-	0x53, 0x81, 0x42, 0x08, 0xf4, 0xbe, 0x45, 0x2d, 0x7e, 0xc6, 0xb2, 0xc9, 0xbf, 0xf1, 0x09, 0xac,
-	0xed, 0xf3, 0x47, 0x7b, 0x4c, 0xdc, 0x0b, 0x7a, 0x79, 0x1b, 0xf3, 0xaa, 0xaf, 0xbb, 0x98, 0x7c,
-	0xdd, 0x78, 0x08, 0xeb, 0x49, 0x76, 0x0b, 0x3a, 0x66, 0x1b, 0x2a, 0x23, 0xce, 0x29, 0x8c, 0x30,
-	0x02, 0xc2, 0x87, 0x50, 0xec, 0x1f, 0xa2, 0x75, 0x28, 0x53, 0x8f, 0x5a, 0x23, 0x29, 0xa2, 0x00,
-	0xd8, 0x35, 0x87, 0x3e, 0x21, 0x32, 0x5a, 0xf1, 0x6f, 0xee, 0x92, 0xee, 0xc8, 0x71, 0x09, 0xe7,
-	0x53, 0x35, 0x25, 0x84, 0x4f, 0xa0, 0xd6, 0x1f, 0x86, 0x97, 0xbe, 0x03, 0x65, 0x6a, 0x05, 0x57,
-	0x41, 0x47, 0xdb, 0x2e, 0xed, 0xd4, 0x7b, 0x4d, 0x61, 0x84, 0x81, 0x77, 0x4d, 0xfc, 0xd9, 0x13,
-	0x2b, 0xb8, 0x32, 0xc5, 0x32, 0x13, 0xd7, 0x76, 0x82, 0xab, 0x87, 0x7d, 0x26, 0x6e, 0x69, 0x47,
-	0x37, 0x43, 0x10, 0xff, 0x53, 0x03, 0x60, 0xfc, 0xe4, 0xad, 0x7b, 0x50, 0xb5, 0x3d, 0x97, 0x30,
-	0xda, 0x8e, 0xce, 0x79, 0xb6, 0xd3, 0x3c, 0xcf, 0xa8, 0x45, 0xa7, 0x81, 0x19, 0xed, 0x43, 0x1f,
-	0x03, 0x30, 0x6e, 0x02, 0xcf, 0x1d, 0xa8, 0xde, 0xeb, 0x32, 0xaa, 0x98, 0xef, 0x6e, 0x3f, 0xda,
-	0x70, 0xe0, 0x52, 0x7f, 0x66, 0x2a, 0x14, 0xc6, 0x01, 0xac, 0xa6, 0x96, 0x99, 0x97, 0x5e, 0x91,
-	0x99, 0x54, 0x12, 0xfb, 0x44, 0x5b, 0x50, 0xbe, 0xb6, 0x46, 0x53, 0xa1, 0xa3, 0x7a, 0xaf, 0xc2,
-	0xf9, 0x1f, 0x9a, 0x02, 0xf9, 0x41, 0xf1, 0x07, 0x1a, 0xfe, 0x19, 0xf3, 0xd3, 0xb4, 0x98, 0xe8,
-	0x6d, 0xd0, 0x99, 0x0a, 0xa4, 0x97, 0x66, 0x15, 0xc4, 0x57, 0xd9, 0x3b, 0xf7, 0x89, 0x65, 0xcf,
-	0xfa, 0x5c, 0x2b, 0xd2, 0x0e, 0x2a, 0x0a, 0xff, 0x02, 0x96, 0x55, 0xba, 0x1b, 0xdd, 0x6d, 0x0b,
-	0x6a, 0x3e, 0x99, 0x8c, 0xac, 0x01, 0x89, 0x78, 0xc5, 0x08, 0x66, 0x58, 0xd7, 0xb3, 0x49, 0x14,
-	0xb7, 0x24, 0xc4, 0xa8, 0x02, 0x6a, 0xf9, 0xf4, 0x89, 0x33, 0x26, 0x32, 0x07, 0xc5, 0x08, 0xfc,
-	0x31, 0xb4, 0x99, 0xd1, 0x1d, 0x9f, 0x84, 0x62, 0x84, 0x3e, 0x70, 0xab, 0x1b, 0xe2, 0x9f, 0xb0,
-	0x20, 0x93, 0xa2, 0x5f, 0xcc, 0xd3, 0xf1, 0x08, 0xd0, 0xbe, 0x37, 0x99, 0x7d, 0x43, 0x21, 0xab,
-	0x0b, 0x20, 0x03, 0xc1, 0x31, 0x71, 0xa5, 0x6a, 0x14, 0x0c, 0x7e, 0x0e, 0x2d, 0x76, 0x5a, 0x26,
-	0xe3, 0xdc, 0x32, 0xa6, 0xeb, 0x51, 0x4c, 0x47, 0xa0, 0x07, 0xce, 0xe7, 0x44, 0x1e, 0xc1, 0xbf,
-	0x6f, 0x8a, 0xe2, 0xf8, 0x99, 0xb8, 0x66, 0x2a, 0x6f, 0xdd, 0x4d, 0xc5, 0xbf, 0xb6, 0xb8, 0x68,
-	0x5a, 0x1d, 0x5f, 0x31, 0xf4, 0x1d, 0xc1, 0x8a, 0x49, 0xee, 0x5f, 0x5b, 0x23, 0xe7, 0x96, 0x61,
-	0x6d, 0x5e, 0xa1, 0x83, 0x1f, 0xc2, 0x6a, 0xc4, 0x69, 0x41, 0x3b, 0xdf, 0x05, 0x74, 0x7f, 0x34,
-	0xf2, 0x06, 0xb7, 0x56, 0x3d, 0x26, 0xb0, 0x96, 0xa0, 0x58, 0x3c, 0xa4, 0x8a, 0x70, 0x15, 0xbe,
-	0x18, 0x01, 0x61, 0x17, 0x3a, 0xfb, 0x97, 0x64, 0x70, 0x35, 0x27, 0x1d, 0xcc, 0xab, 0x45, 0xd8,
-	0x9a, 0xf7, 0xdc, 0x25, 0xfe, 0x23, 0x32, 0x93, 0x47, 0x45, 0x70, 0x22, 0x55, 0x94, 0x52, 0xa9,
-	0xe2, 0x1f, 0x1a, 0xbc, 0x91, 0x73, 0xe0, 0x82, 0xb7, 0xdb, 0x05, 0x90, 0x92, 0xb9, 0x43, 0x8f,
-	0x9f, 0x59, 0xef, 0xad, 0x30, 0xea, 0xb3, 0x08, 0x6b, 0x2a, 0x3b, 0x72, 0x32, 0xf8, 0x2e, 0xc0,
-	0xc8, 0x0a, 0xe8, 0xc1, 0x0b, 0xce, 0xa1, 0x1c, 0x73, 0x10, 0xfa, 0x17, 0x1c, 0xe2, 0x1d, 0xf8,
-	0x97, 0x1a, 0x74, 0x04, 0xf3, 0x7c, 0xbb, 0x7e, 0xd3, 0x8a, 0xcb, 0xa9, 0x20, 0xff, 0xa6, 0xc1,
-	0x1b, 0x39, 0x22, 0xbc, 0x66, 0x55, 0x26, 0x15, 0xa7, 0x7f, 0xa9, 0xe2, 0xee, 0x41, 0x4b, 0xe1,
-	0x24, 0x15, 0xc6, 0xe3, 0xb6, 0x50, 0x90, 0xc8, 0xc3, 0xba, 0x19, 0x23, 0xf0, 0xcb, 0x22, 0x20,
-	0x95, 0x66, 0xc1, 0x1b, 0x7e, 0x04, 0x4b, 0x82, 0xb7, 0x28, 0xec, 0xea, 0xbd, 0x6f, 0xa5, 0xae,
-	0x17, 0x26, 0x5c, 0x81, 0x92, 0xd9, 0x36, 0xa4, 0x61, 0xe4, 0xe2, 0x91, 0x06, 0x32, 0xbb, 0xcf,
-	0x23, 0x17, 0x0a, 0x08, 0xc9, 0x25, 0x8d, 0xf1, 0x63, 0x58, 0x56, 0xf9, 0xe6, 0xa4, 0xe9, 0xb7,
-	0x93, 0x69, 0x3a, 0xad, 0xfc, 0x38, 0x5d, 0x33, 0x5e, 0xea, 0x21, 0xb7, 0xe4, 0xa5, 0x18, 0x46,
-	0x49, 0xfd, 0x7b, 0xd0, 0x52, 0x16, 0x6e, 0x11, 0xa0, 0x28, 0x20, 0x95, 0x60, 0x41, 0xa3, 0xdc,
-	0x81, 0x0a, 0x79, 0x91, 0x76, 0x39, 0x85, 0xbf, 0x5c, 0xc5, 0x08, 0x9a, 0x8f, 0x3d, 0x9b, 0x04,
-	0x8a, 0x94, 0xf8, 0xdf, 0x1a, 0xb4, 0x14, 0xe4, 0x82, 0x92, 0xbc, 0x0f, 0x65, 0x56, 0x4d, 0x84,
-	0xce, 0xb1, 0xcd, 0x08, 0x33, 0xdc, 0x05, 0x46, 0x98, 0x56, 0x6c, 0x37, 0x0e, 0x01, 0x62, 0x64,
-	0x8e, 0x29, 0x70, 0xd2, 0x14, 0xcb, 0x21, 0xdf, 0xb4, 0x21, 0x3e, 0x81, 0x35, 0x93, 0x5c, 0x38,
-	0x01, 0x25, 0x3e, 0x5b, 0x0e, 0x4d, 0x81, 0x40, 0xb7, 0x6c, 0x5b, 0xa4, 0xca, 0x9a, 0xc9, 0xbf,
-	0xd9, 0xb3, 0x61, 0x61, 0xfc, 0xe9, 0xd3, 0xb0, 0x28, 0xad, 0x99, 0x31, 0x02, 0xff, 0x4f, 0x83,
-	0xf5, 0x24, 0xa7, 0xc5, 0x73, 0x08, 0xaf, 0xb3, 0xec, 0x44, 0xd5, 0x65, 0xa3, 0x03, 0x55, 0x0c,
-	0xf1, 0x26, 0xbe, 0x23, 0x4a, 0xa8, 0xec, 0xe1, 0xbc, 0x8a, 0xe5, 0x3b, 0x85, 0xf2, 0x62, 0x4a,
-	0xe3, 0x43, 0x58, 0x49, 0x2e, 0xaa, 0x4a, 0xac, 0x09, 0x25, 0xae, 0xab, 0x4a, 0xd4, 0x55, 0xb5,
-	0x3d, 0x0d, 0xfb, 0x69, 0xf1, 0x54, 0x94, 0xc8, 0xc2, 0xaa, 0x82, 0xb3, 0x4b, 0xcb, 0xb7, 0x39,
-	0xa3, 0x86, 0x19, 0x23, 0x58, 0xcd, 0x3a, 0xb1, 0x7c, 0x87, 0xce, 0xc4, 0xba, 0x68, 0x5c, 0x55,
-	0x14, 0xfe, 0x93, 0x16, 0x36, 0xdd, 0x21, 0xdf, 0xc5, 0x1d, 0x5d, 0x44, 0x92, 0x39, 0xb1, 0x55,
-	0xae, 0x8a, 0x07, 0xc1, 0xdc, 0x7f, 0x4e, 0x4c, 0x95, 0xab, 0xf8, 0x57, 0x1a, 0xac, 0x3e, 0xf1,
-	0xa7, 0xee, 0xc0, 0xa2, 0xe4, 0x96, 0xf9, 0x27, 0x7a, 0xd2, 0xc5, 0x6c, 0x31, 0x14, 0xe5, 0xa6,
-	0xd2, 0x0d, 0xb9, 0x29, 0x35, 0xdd, 0xc1, 0xbf, 0xd5, 0xa0, 0x19, 0xcb, 0xb0, 0xa0, 0x82, 0x3e,
-	0x84, 0xd6, 0x74, 0x62, 0x5b, 0x94, 0xd8, 0x67, 0x5f, 0x96, 0x87, 0xb2, 0x1b, 0xf1, 0x5f, 0x8a,
-	0xb0, 0x79, 0x32, 0x1d, 0x51, 0xe7, 0xc4, 0xb3, 0x9d, 0xe1, 0xec, 0x6c, 0x32, 0x72, 0xa2, 0xb4,
-	0xdc, 0x86, 0xca, 0xc4, 0xf2, 0xe3, 0x58, 0x26, 0x21, 0x86, 0x1f, 0x3b, 0x76, 0x98, 0x90, 0x97,
-	0x4d, 0x09, 0x7d, 0x5d, 0x75, 0xa0, 0xf7, 0x60, 0x63, 0xe4, 0x5d, 0x08, 0xa1, 0xce, 0x88, 0x35,
-	0x22, 0xb6, 0x28, 0x73, 0x78, 0x59, 0xd1, 0x30, 0xf3, 0x17, 0x19, 0x95, 0xef, 0x3d, 0xcf, 0xa1,
-	0xaa, 0x08, 0xaa, 0xdc, 0x45, 0xf4, 0x3e, 0xb4, 0xc7, 0x84, 0x5a, 0x39, 0x64, 0x4b, 0x9c, 0x6c,
-	0xce, 0x2a, 0x36, 0xa1, 0x93, 0x55, 0xd3, 0x82, 0x45, 0xee, 0x2a, 0x34, 0x64, 0x63, 0x2b, 0x03,
-	0xf3, 0x11, 0xac, 0x84, 0x88, 0x05, 0x59, 0xff, 0x46, 0x83, 0xd6, 0xa7, 0x53, 0x77, 0x70, 0x79,
-	0xe4, 0x8d, 0x48, 0x70, 0x1b, 0x3f, 0xdf, 0x82, 0x5a, 0xe8, 0xd7, 0x61, 0xc3, 0x1e, 0x23, 0x12,
-	0xa6, 0xd5, 0x6f, 0x30, 0x6d, 0x39, 0xe5, 0xe9, 0x14, 0x90, 0x2a, 0xc6, 0xeb, 0x89, 0x05, 0xf8,
-	0x11, 0xd4, 0x4f, 0xc8, 0xf8, 0x9c, 0xf8, 0x9f, 0xb1, 0x70, 0x87, 0x56, 0xa0, 0x18, 0x5d, 0xb8,
-	0xf8, 0xb0, 0xcf, 0x52, 0xc3, 0x63, 0x6b, 0x4c, 0x24, 0x77, 0xfe, 0xcd, 0x0e, 0xfd, 0xc4, 0x9f,
-	0x0c, 0x9e, 0x9a, 0xc7, 0xd2, 0x75, 0x43, 0x10, 0xff, 0xa1, 0x08, 0x10, 0xc7, 0x91, 0x1b, 0x9b,
-	0xa3, 0x2e, 0x00, 0xeb, 0xb9, 0x1d, 0xf6, 0xae, 0x43, 0x25, 0x2a, 0x18, 0xf9, 0xa0, 0x1c, 0x3a,
-	0xe3, 0xb9, 0x52, 0x3c, 0x28, 0x87, 0xce, 0x6e, 0x1c, 0xe8, 0x21, 0xd0, 0x7d, 0x32, 0x0c, 0xb8,
-	0x66, 0x75, 0x93, 0x7f, 0x23, 0x0c, 0xcb, 0x19, 0x8f, 0xd7, 0xcd, 0x04, 0x8e, 0x45, 0x7e, 0x8b,
-	0xb5, 0x62, 0xd2, 0xaf, 0x05, 0x80, 0xee, 0xc0, 0x4a, 0x24, 0x0f, 0x4b, 0x1e, 0x41, 0xa7, 0xca,
-	0x25, 0x49, 0x61, 0x45, 0x67, 0xcc, 0x64, 0x63, 0x60, 0xa7, 0x26, 0x6e, 0x12, 0x63, 0xf0, 0x21,
-	0x40, 0xac, 0xf7, 0xaf, 0xef, 0x57, 0xd8, 0x84, 0x6a, 0x98, 0xd3, 0x95, 0x21, 0x85, 0x96, 0x18,
-	0x52, 0x74, 0x60, 0x89, 0x65, 0x6f, 0x12, 0x44, 0xfe, 0x20, 0x41, 0x76, 0x47, 0x9b, 0x5f, 0x42,
-	0xa8, 0x53, 0x00, 0xf8, 0x31, 0x54, 0xf9, 0x00, 0x45, 0xf2, 0x94, 0x6d, 0x9c, 0xa6, 0xb6, 0x71,
-	0xca, 0xa4, 0xab, 0xa8, 0x4e, 0xba, 0x98, 0xb6, 0xa7, 0x53, 0xc7, 0x96, 0x3e, 0xc0, 0xbf, 0xdf,
-	0xf9, 0x9d, 0x06, 0x3a, 0x73, 0x4f, 0x54, 0x81, 0xe2, 0xe9, 0xa3, 0x66, 0x01, 0xd5, 0xa0, 0x7c,
-	0x60, 0x9a, 0xa7, 0x66, 0x53, 0x43, 0xab, 0x50, 0x3f, 0x70, 0xed, 0xd3, 0xa1, 0x70, 0x90, 0x66,
-	0x91, 0x23, 0x3e, 0x13, 0xa6, 0x3b, 0xf6, 0x9e, 0x37, 0x75, 0xd4, 0x80, 0xda, 0x63, 0x8f, 0x1e,
-	0x1f, 0xdc, 0xef, 0x1f, 0x98, 0xcd, 0x32, 0x6a, 0x41, 0xe3, 0xd8, 0x1b, 0x5c, 0x11, 0xfb, 0xc1,
-	0xec, 0x94, 0x5e, 0x12, 0xbf, 0x59, 0x41, 0x5d, 0x30, 0xf6, 0x47, 0x0e, 0x71, 0xa9, 0xd0, 0xa8,
-	0xa4, 0x7e, 0xe2, 0x79, 0x47, 0xce, 0xc5, 0x65, 0x73, 0x09, 0x2d, 0x33, 0x1d, 0xd1, 0x43, 0x6f,
-	0xea, 0xda, 0xcd, 0x6a, 0xef, 0xcf, 0x3a, 0x34, 0xc4, 0x69, 0x67, 0xc4, 0xbf, 0x76, 0x06, 0x04,
-	0xbd, 0x0b, 0x15, 0x31, 0x8f, 0x47, 0xad, 0xcc, 0x80, 0xdf, 0x40, 0x2a, 0x4a, 0x3c, 0x3f, 0x5c,
-	0xd8, 0xd1, 0xd0, 0x0f, 0x01, 0xe2, 0x09, 0x2b, 0xda, 0x48, 0x4c, 0x52, 0xc3, 0x78, 0x61, 0xb4,
-	0xd3, 0xe8, 0x90, 0xc1, 0x5d, 0x0d, 0xbd, 0x07, 0x4b, 0xb2, 0xd9, 0x47, 0x48, 0x6c, 0x53, 0x67,
-	0x08, 0xc6, 0x5a, 0x02, 0x17, 0xd2, 0xb1, 0x63, 0xe3, 0xc1, 0x86, 0x38, 0x36, 0x33, 0x61, 0x31,
-	0xda, 0x69, 0xb4, 0x72, 0xec, 0xb7, 0xa1, 0xd8, 0x1f, 0xa2, 0x46, 0x38, 0xea, 0x13, 0x04, 0x2b,
-	0xc9, 0xc9, 0x1f, 0x2e, 0xa0, 0x63, 0x58, 0x4d, 0x8d, 0x9e, 0x90, 0x21, 0x24, 0xca, 0x9b, 0x67,
-	0x19, 0x6f, 0xe6, 0xae, 0x45, 0xdc, 0xf6, 0x61, 0x59, 0x6d, 0xbf, 0xd1, 0xa6, 0x10, 0x30, 0x33,
-	0x01, 0x30, 0x3a, 0xd9, 0x85, 0x88, 0xc9, 0x77, 0xa1, 0x76, 0x44, 0x2c, 0x9f, 0x9e, 0x13, 0x8b,
-	0xa2, 0x3a, 0xdb, 0x28, 0x27, 0xce, 0x86, 0x0a, 0xf0, 0x4b, 0xfe, 0x08, 0xea, 0x4a, 0x8b, 0x8a,
-	0xb8, 0x3e, 0xb2, 0x6d, 0xb3, 0xb1, 0x99, 0xc1, 0x87, 0x87, 0xf5, 0x7e, 0x5d, 0x81, 0x75, 0xe1,
-	0x4e, 0x27, 0x96, 0x6b, 0x5d, 0x10, 0x3f, 0x74, 0x96, 0x8f, 0x12, 0x0f, 0x77, 0x23, 0xdd, 0x8a,
-	0x29, 0x06, 0xc8, 0x76, 0x68, 0xb8, 0xc0, 0xc8, 0x95, 0x58, 0xb8, 0x91, 0xaa, 0xb1, 0x54, 0xf2,
-	0x6c, 0xaf, 0x83, 0x0b, 0xe8, 0x03, 0xf6, 0x18, 0x64, 0x6b, 0x80, 0xd6, 0x53, 0x9d, 0x82, 0x20,
-	0xde, 0xc8, 0xed, 0x1f, 0x70, 0x01, 0xdd, 0x83, 0x8a, 0x9c, 0xaf, 0xb6, 0x84, 0x78, 0x4a, 0xe6,
-	0x14, 0x6e, 0x9e, 0xcc, 0x9d, 0xb8, 0x80, 0x4c, 0x68, 0x65, 0x66, 0x27, 0x68, 0x8b, 0xdb, 0x68,
-	0xce, 0x0c, 0xc7, 0x78, 0x6b, 0xce, 0xaa, 0xca, 0x33, 0x33, 0x44, 0x10, 0x3c, 0xe7, 0x8d, 0x37,
-	0x04, 0xcf, 0xb9, 0x93, 0x07, 0xee, 0x5f, 0x4d, 0xb1, 0x1c, 0xe7, 0x4a, 0xa1, 0xdb, 0x4c, 0x0a,
-	0x17, 0xba, 0xcd, 0xa6, 0x54, 0xe9, 0xa4, 0x4a, 0xe1, 0x2d, 0x9d, 0x34, 0x5b, 0xe2, 0x4b, 0x27,
-	0xcd, 0xa9, 0xd1, 0x05, 0x13, 0xb5, 0x0b, 0x11, 0x4c, 0x72, 0xda, 0x2b, 0xc1, 0x24, 0xaf, 0x61,
-	0xc1, 0x05, 0xf4, 0x7d, 0xa8, 0x86, 0xd5, 0x2d, 0xe2, 0x71, 0x20, 0x55, 0x6f, 0x1b, 0xeb, 0x49,
-	0x64, 0x44, 0x78, 0x0a, 0xcd, 0x74, 0x91, 0x85, 0xf8, 0xd3, 0x9c, 0x53, 0xa1, 0x1a, 0x5b, 0xf9,
-	0x8b, 0x21, 0xc3, 0x07, 0xfd, 0x7f, 0xbd, 0xec, 0x6a, 0x5f, 0xbc, 0xec, 0x6a, 0xff, 0x7d, 0xd9,
-	0xd5, 0x7e, 0xff, 0xaa, 0x5b, 0xf8, 0xe2, 0x55, 0xb7, 0xf0, 0x9f, 0x57, 0xdd, 0xc2, 0x4f, 0xdf,
-	0xb9, 0x70, 0xe8, 0xe5, 0xf4, 0x7c, 0x77, 0xe0, 0x8d, 0xf7, 0x9e, 0x79, 0x53, 0xdf, 0x25, 0xb3,
-	0xb1, 0x63, 0xbb, 0xce, 0xc5, 0x25, 0xdd, 0xb3, 0xa6, 0x74, 0x3a, 0x76, 0xf7, 0xf8, 0x3f, 0xc2,
-	0x7b, 0x93, 0xf3, 0xf3, 0x0a, 0xff, 0x7a, 0xf7, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x42, 0x00,
-	0x9d, 0x26, 0x27, 0x1e, 0x00, 0x00,
+func (m *CreateStreamRequest) Reset()         { *m = CreateStreamRequest{} }
+func (m *CreateStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateStreamRequest) ProtoMessage()    {}
+func (*CreateStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{57}
+}
+func (m *CreateStreamRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateStreamRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateStreamRequest.Merge(m, src)
+}
+func (m *CreateStreamRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateStreamRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_CreateStreamRequest proto.InternalMessageInfo
 
-// ExtentServiceClient is the client API for ExtentService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ExtentServiceClient interface {
-	//from stream client
-	Append(ctx context.Context, opts ...grpc.CallOption) (ExtentService_AppendClient, error)
-	//rpc ReadEntries(ReadEntriesRequest) returns (ReadEntriesResponse){}
-	//rpc SmartReadBlocks(ReadBlocksRequest) returns (ReadBlocksResponse){}
-	ReadBlocks(ctx context.Context, in *ReadBlocksRequest, opts ...grpc.CallOption) (ExtentService_ReadBlocksClient, error)
-	//internal rpc
-	ReAvali(ctx context.Context, in *ReAvaliRequest, opts ...grpc.CallOption) (*ReAvaliResponse, error)
-	CopyExtent(ctx context.Context, in *CopyExtentRequest, opts ...grpc.CallOption) (ExtentService_CopyExtentClient, error)
-	Df(ctx context.Context, in *DfRequest, opts ...grpc.CallOption) (*DfResponse, error)
-	RequireRecovery(ctx context.Context, in *RequireRecoveryRequest, opts ...grpc.CallOption) (*RequireRecoveryResponse, error)
-	CommitLength(ctx context.Context, in *CommitLengthRequest, opts ...grpc.CallOption) (*CommitLengthResponse, error)
-	Heartbeat(ctx context.Context, in *Payload, opts ...grpc.CallOption) (ExtentService_HeartbeatClient, error)
-	//rpc ReplicateBlocks(ReplicateBlocksRequest) returns (ReplicateBlocksResponse) {}
-	AllocExtent(ctx context.Context, in *AllocExtentRequest, opts ...grpc.CallOption) (*AllocExtentResponse, error)
+func (m *CreateStreamRequest) GetDataShard() uint32 {
+	if m != nil {
+		return m.DataShard
+	}
+	return 0
 }
 
-type extentServiceClient struct {
-	cc *grpc.ClientConn
+func (m *CreateStreamRequest) GetParityShard() uint32 {
+	if m != nil {
+		return m.ParityShard
+	}
+	return 0
 }
 
-func NewExtentServiceClient(cc *grpc.ClientConn) ExtentServiceClient {
-	return &extentServiceClient{cc}
+func (m *CreateStreamRequest) GetReplication() ReplicationMode {
+	if m != nil {
+		return m.Replication
+	}
+	return ReplicationMode_Leaderless
 }
 
-func (c *extentServiceClient) Append(ctx context.Context, opts ...grpc.CallOption) (ExtentService_AppendClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ExtentService_serviceDesc.Streams[0], "/pb.ExtentService/Append", opts...)
-	if err != nil {
-		return nil, err
+func (m *CreateStreamRequest) GetCodec() *ErasureCodec {
+	if m != nil {
+		return m.Codec
 	}
-	x := &extentServiceAppendClient{stream}
-	return x, nil
+	return nil
 }
 
-type ExtentService_AppendClient interface {
-	Send(*AppendRequest) error
-	CloseAndRecv() (*AppendResponse, error)
-	grpc.ClientStream
+type CreateStreamResponse struct {
+	Code    Code        `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	CodeDes string      `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
+	Stream  *StreamInfo `protobuf:"bytes,3,opt,name=stream,proto3" json:"stream,omitempty"`
+	Extent  *ExtentInfo `protobuf:"bytes,4,opt,name=extent,proto3" json:"extent,omitempty"`
 }
 
-type extentServiceAppendClient struct {
-	grpc.ClientStream
+func (m *CreateStreamResponse) Reset()         { *m = CreateStreamResponse{} }
+func (m *CreateStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateStreamResponse) ProtoMessage()    {}
+func (*CreateStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{58}
 }
-
-func (x *extentServiceAppendClient) Send(m *AppendRequest) error {
-	return x.ClientStream.SendMsg(m)
+func (m *CreateStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (x *extentServiceAppendClient) CloseAndRecv() (*AppendResponse, error) {
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	m := new(AppendResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *CreateStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
 }
-
-func (c *extentServiceClient) ReadBlocks(ctx context.Context, in *ReadBlocksRequest, opts ...grpc.CallOption) (ExtentService_ReadBlocksClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ExtentService_serviceDesc.Streams[1], "/pb.ExtentService/ReadBlocks", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &extentServiceReadBlocksClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
+func (m *CreateStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateStreamResponse.Merge(m, src)
 }
-
-type ExtentService_ReadBlocksClient interface {
-	Recv() (*ReadBlocksResponse, error)
-	grpc.ClientStream
+func (m *CreateStreamResponse) XXX_Size() int {
+	return m.Size()
 }
-
-type extentServiceReadBlocksClient struct {
-	grpc.ClientStream
+func (m *CreateStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateStreamResponse.DiscardUnknown(m)
 }
 
-func (x *extentServiceReadBlocksClient) Recv() (*ReadBlocksResponse, error) {
-	m := new(ReadBlocksResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+var xxx_messageInfo_CreateStreamResponse proto.InternalMessageInfo
+
+func (m *CreateStreamResponse) GetCode() Code {
+	if m != nil {
+		return m.Code
 	}
-	return m, nil
+	return Code_OK
 }
 
-func (c *extentServiceClient) ReAvali(ctx context.Context, in *ReAvaliRequest, opts ...grpc.CallOption) (*ReAvaliResponse, error) {
-	out := new(ReAvaliResponse)
-	err := c.cc.Invoke(ctx, "/pb.ExtentService/ReAvali", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CreateStreamResponse) GetCodeDes() string {
+	if m != nil {
+		return m.CodeDes
 	}
-	return out, nil
+	return ""
 }
 
-func (c *extentServiceClient) CopyExtent(ctx context.Context, in *CopyExtentRequest, opts ...grpc.CallOption) (ExtentService_CopyExtentClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ExtentService_serviceDesc.Streams[2], "/pb.ExtentService/CopyExtent", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &extentServiceCopyExtentClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *CreateStreamResponse) GetStream() *StreamInfo {
+	if m != nil {
+		return m.Stream
 	}
-	return x, nil
+	return nil
 }
 
-type ExtentService_CopyExtentClient interface {
-	Recv() (*CopyExtentResponse, error)
-	grpc.ClientStream
+func (m *CreateStreamResponse) GetExtent() *ExtentInfo {
+	if m != nil {
+		return m.Extent
+	}
+	return nil
 }
 
-type extentServiceCopyExtentClient struct {
-	grpc.ClientStream
+type TruncateRequest struct {
+	StreamID uint64 `protobuf:"varint,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
+	ExtentID uint64 `protobuf:"varint,2,opt,name=extentID,proto3" json:"extentID,omitempty"`
+	OwnerKey string `protobuf:"bytes,3,opt,name=ownerKey,proto3" json:"ownerKey,omitempty"`
+	Revision int64  `protobuf:"varint,4,opt,name=revision,proto3" json:"revision,omitempty"`
 }
 
-func (x *extentServiceCopyExtentClient) Recv() (*CopyExtentResponse, error) {
-	m := new(CopyExtentResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *TruncateRequest) Reset()         { *m = TruncateRequest{} }
+func (m *TruncateRequest) String() string { return proto.CompactTextString(m) }
+func (*TruncateRequest) ProtoMessage()    {}
+func (*TruncateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{59}
+}
+func (m *TruncateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TruncateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TruncateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
+}
+func (m *TruncateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TruncateRequest.Merge(m, src)
+}
+func (m *TruncateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TruncateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TruncateRequest.DiscardUnknown(m)
 }
 
-func (c *extentServiceClient) Df(ctx context.Context, in *DfRequest, opts ...grpc.CallOption) (*DfResponse, error) {
-	out := new(DfResponse)
-	err := c.cc.Invoke(ctx, "/pb.ExtentService/Df", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TruncateRequest proto.InternalMessageInfo
+
+func (m *TruncateRequest) GetStreamID() uint64 {
+	if m != nil {
+		return m.StreamID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *extentServiceClient) RequireRecovery(ctx context.Context, in *RequireRecoveryRequest, opts ...grpc.CallOption) (*RequireRecoveryResponse, error) {
-	out := new(RequireRecoveryResponse)
-	err := c.cc.Invoke(ctx, "/pb.ExtentService/RequireRecovery", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TruncateRequest) GetExtentID() uint64 {
+	if m != nil {
+		return m.ExtentID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *extentServiceClient) CommitLength(ctx context.Context, in *CommitLengthRequest, opts ...grpc.CallOption) (*CommitLengthResponse, error) {
-	out := new(CommitLengthResponse)
-	err := c.cc.Invoke(ctx, "/pb.ExtentService/CommitLength", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TruncateRequest) GetOwnerKey() string {
+	if m != nil {
+		return m.OwnerKey
 	}
-	return out, nil
+	return ""
 }
 
-func (c *extentServiceClient) Heartbeat(ctx context.Context, in *Payload, opts ...grpc.CallOption) (ExtentService_HeartbeatClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ExtentService_serviceDesc.Streams[3], "/pb.ExtentService/Heartbeat", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &extentServiceHeartbeatClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *TruncateRequest) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
 	}
-	return x, nil
+	return 0
 }
 
-type ExtentService_HeartbeatClient interface {
-	Recv() (*Payload, error)
-	grpc.ClientStream
+type TruncateResponse struct {
+	Code              Code        `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	CodeDes           string      `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
+	UpdatedStreamInfo *StreamInfo `protobuf:"bytes,3,opt,name=updatedStreamInfo,proto3" json:"updatedStreamInfo,omitempty"`
 }
 
-type extentServiceHeartbeatClient struct {
-	grpc.ClientStream
+func (m *TruncateResponse) Reset()         { *m = TruncateResponse{} }
+func (m *TruncateResponse) String() string { return proto.CompactTextString(m) }
+func (*TruncateResponse) ProtoMessage()    {}
+func (*TruncateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{60}
 }
-
-func (x *extentServiceHeartbeatClient) Recv() (*Payload, error) {
-	m := new(Payload)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *TruncateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TruncateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TruncateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
+}
+func (m *TruncateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TruncateResponse.Merge(m, src)
+}
+func (m *TruncateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TruncateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TruncateResponse.DiscardUnknown(m)
 }
 
-func (c *extentServiceClient) AllocExtent(ctx context.Context, in *AllocExtentRequest, opts ...grpc.CallOption) (*AllocExtentResponse, error) {
-	out := new(AllocExtentResponse)
-	err := c.cc.Invoke(ctx, "/pb.ExtentService/AllocExtent", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TruncateResponse proto.InternalMessageInfo
+
+func (m *TruncateResponse) GetCode() Code {
+	if m != nil {
+		return m.Code
 	}
-	return out, nil
+	return Code_OK
 }
 
-// ExtentServiceServer is the server API for ExtentService service.
-type ExtentServiceServer interface {
-	//from stream client
-	Append(ExtentService_AppendServer) error
-	//rpc ReadEntries(ReadEntriesRequest) returns (ReadEntriesResponse){}
-	//rpc SmartReadBlocks(ReadBlocksRequest) returns (ReadBlocksResponse){}
-	ReadBlocks(*ReadBlocksRequest, ExtentService_ReadBlocksServer) error
-	//internal rpc
-	ReAvali(context.Context, *ReAvaliRequest) (*ReAvaliResponse, error)
-	CopyExtent(*CopyExtentRequest, ExtentService_CopyExtentServer) error
-	Df(context.Context, *DfRequest) (*DfResponse, error)
-	RequireRecovery(context.Context, *RequireRecoveryRequest) (*RequireRecoveryResponse, error)
-	CommitLength(context.Context, *CommitLengthRequest) (*CommitLengthResponse, error)
-	Heartbeat(*Payload, ExtentService_HeartbeatServer) error
-	//rpc ReplicateBlocks(ReplicateBlocksRequest) returns (ReplicateBlocksResponse) {}
-	AllocExtent(context.Context, *AllocExtentRequest) (*AllocExtentResponse, error)
+func (m *TruncateResponse) GetCodeDes() string {
+	if m != nil {
+		return m.CodeDes
+	}
+	return ""
 }
 
-// UnimplementedExtentServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedExtentServiceServer struct {
+func (m *TruncateResponse) GetUpdatedStreamInfo() *StreamInfo {
+	if m != nil {
+		return m.UpdatedStreamInfo
+	}
+	return nil
 }
 
-func (*UnimplementedExtentServiceServer) Append(srv ExtentService_AppendServer) error {
-	return status.Errorf(codes.Unimplemented, "method Append not implemented")
-}
-func (*UnimplementedExtentServiceServer) ReadBlocks(req *ReadBlocksRequest, srv ExtentService_ReadBlocksServer) error {
-	return status.Errorf(codes.Unimplemented, "method ReadBlocks not implemented")
+//extent file on a node
+type ExtentReport struct {
+	ExtentID uint64 `protobuf:"varint,1,opt,name=extentID,proto3" json:"extentID,omitempty"`
+	DiskID   uint64 `protobuf:"varint,2,opt,name=diskID,proto3" json:"diskID,omitempty"`
+	Sealed   bool   `protobuf:"varint,3,opt,name=sealed,proto3" json:"sealed,omitempty"`
+	Length   uint32 `protobuf:"varint,4,opt,name=length,proto3" json:"length,omitempty"`
+	ModTime  int64  `protobuf:"varint,5,opt,name=modTime,proto3" json:"modTime,omitempty"`
 }
-func (*UnimplementedExtentServiceServer) ReAvali(ctx context.Context, req *ReAvaliRequest) (*ReAvaliResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReAvali not implemented")
+
+func (m *ExtentReport) Reset()         { *m = ExtentReport{} }
+func (m *ExtentReport) String() string { return proto.CompactTextString(m) }
+func (*ExtentReport) ProtoMessage()    {}
+func (*ExtentReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{61}
 }
-func (*UnimplementedExtentServiceServer) CopyExtent(req *CopyExtentRequest, srv ExtentService_CopyExtentServer) error {
-	return status.Errorf(codes.Unimplemented, "method CopyExtent not implemented")
+func (m *ExtentReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedExtentServiceServer) Df(ctx context.Context, req *DfRequest) (*DfResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Df not implemented")
+func (m *ExtentReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtentReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedExtentServiceServer) RequireRecovery(ctx context.Context, req *RequireRecoveryRequest) (*RequireRecoveryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RequireRecovery not implemented")
+func (m *ExtentReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtentReport.Merge(m, src)
 }
-func (*UnimplementedExtentServiceServer) CommitLength(ctx context.Context, req *CommitLengthRequest) (*CommitLengthResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CommitLength not implemented")
+func (m *ExtentReport) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedExtentServiceServer) Heartbeat(req *Payload, srv ExtentService_HeartbeatServer) error {
-	return status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
+func (m *ExtentReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtentReport.DiscardUnknown(m)
 }
-func (*UnimplementedExtentServiceServer) AllocExtent(ctx context.Context, req *AllocExtentRequest) (*AllocExtentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllocExtent not implemented")
+
+var xxx_messageInfo_ExtentReport proto.InternalMessageInfo
+
+func (m *ExtentReport) GetExtentID() uint64 {
+	if m != nil {
+		return m.ExtentID
+	}
+	return 0
 }
 
-func RegisterExtentServiceServer(s *grpc.Server, srv ExtentServiceServer) {
-	s.RegisterService(&_ExtentService_serviceDesc, srv)
+func (m *ExtentReport) GetDiskID() uint64 {
+	if m != nil {
+		return m.DiskID
+	}
+	return 0
 }
 
-func _ExtentService_Append_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(ExtentServiceServer).Append(&extentServiceAppendServer{stream})
+func (m *ExtentReport) GetSealed() bool {
+	if m != nil {
+		return m.Sealed
+	}
+	return false
 }
 
-type ExtentService_AppendServer interface {
-	SendAndClose(*AppendResponse) error
-	Recv() (*AppendRequest, error)
-	grpc.ServerStream
+func (m *ExtentReport) GetLength() uint32 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
 }
 
-type extentServiceAppendServer struct {
-	grpc.ServerStream
+func (m *ExtentReport) GetModTime() int64 {
+	if m != nil {
+		return m.ModTime
+	}
+	return 0
 }
 
-func (x *extentServiceAppendServer) SendAndClose(m *AppendResponse) error {
-	return x.ServerStream.SendMsg(m)
+//copy file of a recovery task on a node
+type CopyReport struct {
+	ExtentID  uint64 `protobuf:"varint,1,opt,name=extentID,proto3" json:"extentID,omitempty"`
+	ReplaceID uint64 `protobuf:"varint,2,opt,name=replaceID,proto3" json:"replaceID,omitempty"`
+	DiskID    uint64 `protobuf:"varint,3,opt,name=diskID,proto3" json:"diskID,omitempty"`
+	ModTime   int64  `protobuf:"varint,4,opt,name=modTime,proto3" json:"modTime,omitempty"`
 }
 
-func (x *extentServiceAppendServer) Recv() (*AppendRequest, error) {
-	m := new(AppendRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *CopyReport) Reset()         { *m = CopyReport{} }
+func (m *CopyReport) String() string { return proto.CompactTextString(m) }
+func (*CopyReport) ProtoMessage()    {}
+func (*CopyReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{62}
+}
+func (m *CopyReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CopyReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CopyReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
+}
+func (m *CopyReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CopyReport.Merge(m, src)
+}
+func (m *CopyReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *CopyReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_CopyReport.DiscardUnknown(m)
 }
 
-func _ExtentService_ReadBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ReadBlocksRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+var xxx_messageInfo_CopyReport proto.InternalMessageInfo
+
+func (m *CopyReport) GetExtentID() uint64 {
+	if m != nil {
+		return m.ExtentID
 	}
-	return srv.(ExtentServiceServer).ReadBlocks(m, &extentServiceReadBlocksServer{stream})
+	return 0
 }
 
-type ExtentService_ReadBlocksServer interface {
-	Send(*ReadBlocksResponse) error
-	grpc.ServerStream
+func (m *CopyReport) GetReplaceID() uint64 {
+	if m != nil {
+		return m.ReplaceID
+	}
+	return 0
 }
 
-type extentServiceReadBlocksServer struct {
-	grpc.ServerStream
+func (m *CopyReport) GetDiskID() uint64 {
+	if m != nil {
+		return m.DiskID
+	}
+	return 0
 }
 
-func (x *extentServiceReadBlocksServer) Send(m *ReadBlocksResponse) error {
-	return x.ServerStream.SendMsg(m)
+func (m *CopyReport) GetModTime() int64 {
+	if m != nil {
+		return m.ModTime
+	}
+	return 0
 }
 
-func _ExtentService_ReAvali_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReAvaliRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ExtentServiceServer).ReAvali(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.ExtentService/ReAvali",
+type InventoryRequest struct {
+	NodeID  uint64          `protobuf:"varint,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	Extents []*ExtentReport `protobuf:"bytes,2,rep,name=extents,proto3" json:"extents,omitempty"`
+	Copies  []*CopyReport   `protobuf:"bytes,3,rep,name=copies,proto3" json:"copies,omitempty"`
+	DryRun  bool            `protobuf:"varint,4,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+}
+
+func (m *InventoryRequest) Reset()         { *m = InventoryRequest{} }
+func (m *InventoryRequest) String() string { return proto.CompactTextString(m) }
+func (*InventoryRequest) ProtoMessage()    {}
+func (*InventoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{63}
+}
+func (m *InventoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InventoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InventoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExtentServiceServer).ReAvali(ctx, req.(*ReAvaliRequest))
+}
+func (m *InventoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InventoryRequest.Merge(m, src)
+}
+func (m *InventoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *InventoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InventoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InventoryRequest proto.InternalMessageInfo
+
+func (m *InventoryRequest) GetNodeID() uint64 {
+	if m != nil {
+		return m.NodeID
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _ExtentService_CopyExtent_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(CopyExtentRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *InventoryRequest) GetExtents() []*ExtentReport {
+	if m != nil {
+		return m.Extents
 	}
-	return srv.(ExtentServiceServer).CopyExtent(m, &extentServiceCopyExtentServer{stream})
+	return nil
 }
 
-type ExtentService_CopyExtentServer interface {
-	Send(*CopyExtentResponse) error
-	grpc.ServerStream
+func (m *InventoryRequest) GetCopies() []*CopyReport {
+	if m != nil {
+		return m.Copies
+	}
+	return nil
 }
 
-type extentServiceCopyExtentServer struct {
-	grpc.ServerStream
+func (m *InventoryRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
 }
 
-func (x *extentServiceCopyExtentServer) Send(m *CopyExtentResponse) error {
-	return x.ServerStream.SendMsg(m)
+type InventoryResponse struct {
+	Code        Code          `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	CodeDes     string        `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
+	Orphans     []uint64      `protobuf:"varint,3,rep,packed,name=orphans,proto3" json:"orphans,omitempty"`
+	Missing     []uint64      `protobuf:"varint,4,rep,packed,name=missing,proto3" json:"missing,omitempty"`
+	Mismatched  []uint64      `protobuf:"varint,5,rep,packed,name=mismatched,proto3" json:"mismatched,omitempty"`
+	StaleCopies []*CopyReport `protobuf:"bytes,6,rep,name=staleCopies,proto3" json:"staleCopies,omitempty"`
 }
 
-func _ExtentService_Df_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DfRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ExtentServiceServer).Df(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.ExtentService/Df",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExtentServiceServer).Df(ctx, req.(*DfRequest))
+func (m *InventoryResponse) Reset()         { *m = InventoryResponse{} }
+func (m *InventoryResponse) String() string { return proto.CompactTextString(m) }
+func (*InventoryResponse) ProtoMessage()    {}
+func (*InventoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{64}
+}
+func (m *InventoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InventoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InventoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *InventoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InventoryResponse.Merge(m, src)
+}
+func (m *InventoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *InventoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InventoryResponse.DiscardUnknown(m)
 }
 
-func _ExtentService_RequireRecovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RequireRecoveryRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ExtentServiceServer).RequireRecovery(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.ExtentService/RequireRecovery",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExtentServiceServer).RequireRecovery(ctx, req.(*RequireRecoveryRequest))
+var xxx_messageInfo_InventoryResponse proto.InternalMessageInfo
+
+func (m *InventoryResponse) GetCode() Code {
+	if m != nil {
+		return m.Code
 	}
-	return interceptor(ctx, in, info, handler)
+	return Code_OK
 }
 
-func _ExtentService_CommitLength_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CommitLengthRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ExtentServiceServer).CommitLength(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.ExtentService/CommitLength",
+func (m *InventoryResponse) GetCodeDes() string {
+	if m != nil {
+		return m.CodeDes
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExtentServiceServer).CommitLength(ctx, req.(*CommitLengthRequest))
+	return ""
+}
+
+func (m *InventoryResponse) GetOrphans() []uint64 {
+	if m != nil {
+		return m.Orphans
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _ExtentService_Heartbeat_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(Payload)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *InventoryResponse) GetMissing() []uint64 {
+	if m != nil {
+		return m.Missing
 	}
-	return srv.(ExtentServiceServer).Heartbeat(m, &extentServiceHeartbeatServer{stream})
+	return nil
 }
 
-type ExtentService_HeartbeatServer interface {
-	Send(*Payload) error
-	grpc.ServerStream
+func (m *InventoryResponse) GetMismatched() []uint64 {
+	if m != nil {
+		return m.Mismatched
+	}
+	return nil
 }
 
-type extentServiceHeartbeatServer struct {
-	grpc.ServerStream
+func (m *InventoryResponse) GetStaleCopies() []*CopyReport {
+	if m != nil {
+		return m.StaleCopies
+	}
+	return nil
 }
 
-func (x *extentServiceHeartbeatServer) Send(m *Payload) error {
-	return x.ServerStream.SendMsg(m)
+type DeleteStreamRequest struct {
+	StreamID uint64 `protobuf:"varint,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
+	OwnerKey string `protobuf:"bytes,2,opt,name=ownerKey,proto3" json:"ownerKey,omitempty"`
+	Revision int64  `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
 }
 
-func _ExtentService_AllocExtent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AllocExtentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *DeleteStreamRequest) Reset()         { *m = DeleteStreamRequest{} }
+func (m *DeleteStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteStreamRequest) ProtoMessage()    {}
+func (*DeleteStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{65}
+}
+func (m *DeleteStreamRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteStreamRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(ExtentServiceServer).AllocExtent(ctx, in)
+}
+func (m *DeleteStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteStreamRequest.Merge(m, src)
+}
+func (m *DeleteStreamRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteStreamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteStreamRequest proto.InternalMessageInfo
+
+func (m *DeleteStreamRequest) GetStreamID() uint64 {
+	if m != nil {
+		return m.StreamID
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.ExtentService/AllocExtent",
+	return 0
+}
+
+func (m *DeleteStreamRequest) GetOwnerKey() string {
+	if m != nil {
+		return m.OwnerKey
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExtentServiceServer).AllocExtent(ctx, req.(*AllocExtentRequest))
+	return ""
+}
+
+func (m *DeleteStreamRequest) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _ExtentService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pb.ExtentService",
-	HandlerType: (*ExtentServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ReAvali",
-			Handler:    _ExtentService_ReAvali_Handler,
-		},
-		{
-			MethodName: "Df",
-			Handler:    _ExtentService_Df_Handler,
-		},
-		{
-			MethodName: "RequireRecovery",
-			Handler:    _ExtentService_RequireRecovery_Handler,
-		},
-		{
-			MethodName: "CommitLength",
-			Handler:    _ExtentService_CommitLength_Handler,
-		},
-		{
-			MethodName: "AllocExtent",
-			Handler:    _ExtentService_AllocExtent_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "Append",
-			Handler:       _ExtentService_Append_Handler,
-			ClientStreams: true,
-		},
-		{
-			StreamName:    "ReadBlocks",
-			Handler:       _ExtentService_ReadBlocks_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "CopyExtent",
-			Handler:       _ExtentService_CopyExtent_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "Heartbeat",
-			Handler:       _ExtentService_Heartbeat_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "pb.proto",
-}
-
-// StreamManagerServiceClient is the client API for StreamManagerService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type StreamManagerServiceClient interface {
-	StreamInfo(ctx context.Context, in *StreamInfoRequest, opts ...grpc.CallOption) (*StreamInfoResponse, error)
-	ExtentInfo(ctx context.Context, in *ExtentInfoRequest, opts ...grpc.CallOption) (*ExtentInfoResponse, error)
-	NodesInfo(ctx context.Context, in *NodesInfoRequest, opts ...grpc.CallOption) (*NodesInfoResponse, error)
-	Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusResponse, error)
-	CheckCommitLength(ctx context.Context, in *CheckCommitLengthRequest, opts ...grpc.CallOption) (*CheckCommitLengthResponse, error)
-	StreamAllocExtent(ctx context.Context, in *StreamAllocExtentRequest, opts ...grpc.CallOption) (*StreamAllocExtentResponse, error)
-	StreamPunchHoles(ctx context.Context, in *PunchHolesRequest, opts ...grpc.CallOption) (*PunchHolesResponse, error)
-	CreateStream(ctx context.Context, in *CreateStreamRequest, opts ...grpc.CallOption) (*CreateStreamResponse, error)
-	RegisterNode(ctx context.Context, in *RegisterNodeRequest, opts ...grpc.CallOption) (*RegisterNodeResponse, error)
-	Truncate(ctx context.Context, in *TruncateRequest, opts ...grpc.CallOption) (*TruncateResponse, error)
-	MultiModifySplit(ctx context.Context, in *MultiModifySplitRequest, opts ...grpc.CallOption) (*MultiModifySplitResponse, error)
+type DeleteStreamResponse struct {
+	Code    Code   `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	CodeDes string `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
 }
 
-type streamManagerServiceClient struct {
-	cc *grpc.ClientConn
+func (m *DeleteStreamResponse) Reset()         { *m = DeleteStreamResponse{} }
+func (m *DeleteStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteStreamResponse) ProtoMessage()    {}
+func (*DeleteStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{66}
 }
-
-func NewStreamManagerServiceClient(cc *grpc.ClientConn) StreamManagerServiceClient {
-	return &streamManagerServiceClient{cc}
+func (m *DeleteStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *streamManagerServiceClient) StreamInfo(ctx context.Context, in *StreamInfoRequest, opts ...grpc.CallOption) (*StreamInfoResponse, error) {
-	out := new(StreamInfoResponse)
-	err := c.cc.Invoke(ctx, "/pb.StreamManagerService/StreamInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DeleteStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *DeleteStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteStreamResponse.Merge(m, src)
+}
+func (m *DeleteStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteStreamResponse.DiscardUnknown(m)
 }
 
-func (c *streamManagerServiceClient) ExtentInfo(ctx context.Context, in *ExtentInfoRequest, opts ...grpc.CallOption) (*ExtentInfoResponse, error) {
-	out := new(ExtentInfoResponse)
-	err := c.cc.Invoke(ctx, "/pb.StreamManagerService/ExtentInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DeleteStreamResponse proto.InternalMessageInfo
+
+func (m *DeleteStreamResponse) GetCode() Code {
+	if m != nil {
+		return m.Code
 	}
-	return out, nil
+	return Code_OK
 }
 
-func (c *streamManagerServiceClient) NodesInfo(ctx context.Context, in *NodesInfoRequest, opts ...grpc.CallOption) (*NodesInfoResponse, error) {
-	out := new(NodesInfoResponse)
-	err := c.cc.Invoke(ctx, "/pb.StreamManagerService/NodesInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DeleteStreamResponse) GetCodeDes() string {
+	if m != nil {
+		return m.CodeDes
 	}
-	return out, nil
+	return ""
 }
 
-func (c *streamManagerServiceClient) Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
-	out := new(StatusResponse)
-	err := c.cc.Invoke(ctx, "/pb.StreamManagerService/Status", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MultiModifySplitRequest struct {
+	PartID                 uint64 `protobuf:"varint,1,opt,name=partID,proto3" json:"partID,omitempty"`
+	MidKey                 []byte `protobuf:"bytes,2,opt,name=midKey,proto3" json:"midKey,omitempty"`
+	OwnerKey               string `protobuf:"bytes,3,opt,name=ownerKey,proto3" json:"ownerKey,omitempty"`
+	Revision               int64  `protobuf:"varint,4,opt,name=revision,proto3" json:"revision,omitempty"`
+	LogStreamSealedLength  uint32 `protobuf:"varint,5,opt,name=logStreamSealedLength,proto3" json:"logStreamSealedLength,omitempty"`
+	RowStreamSealedLength  uint32 `protobuf:"varint,6,opt,name=rowStreamSealedLength,proto3" json:"rowStreamSealedLength,omitempty"`
+	MetaStreamSealedLength uint32 `protobuf:"varint,7,opt,name=metaStreamSealedLength,proto3" json:"metaStreamSealedLength,omitempty"`
 }
 
-func (c *streamManagerServiceClient) CheckCommitLength(ctx context.Context, in *CheckCommitLengthRequest, opts ...grpc.CallOption) (*CheckCommitLengthResponse, error) {
-	out := new(CheckCommitLengthResponse)
-	err := c.cc.Invoke(ctx, "/pb.StreamManagerService/CheckCommitLength", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MultiModifySplitRequest) Reset()         { *m = MultiModifySplitRequest{} }
+func (m *MultiModifySplitRequest) String() string { return proto.CompactTextString(m) }
+func (*MultiModifySplitRequest) ProtoMessage()    {}
+func (*MultiModifySplitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{67}
+}
+func (m *MultiModifySplitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiModifySplitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiModifySplitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MultiModifySplitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiModifySplitRequest.Merge(m, src)
+}
+func (m *MultiModifySplitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiModifySplitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiModifySplitRequest.DiscardUnknown(m)
 }
 
-func (c *streamManagerServiceClient) StreamAllocExtent(ctx context.Context, in *StreamAllocExtentRequest, opts ...grpc.CallOption) (*StreamAllocExtentResponse, error) {
-	out := new(StreamAllocExtentResponse)
-	err := c.cc.Invoke(ctx, "/pb.StreamManagerService/StreamAllocExtent", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MultiModifySplitRequest proto.InternalMessageInfo
+
+func (m *MultiModifySplitRequest) GetPartID() uint64 {
+	if m != nil {
+		return m.PartID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *streamManagerServiceClient) StreamPunchHoles(ctx context.Context, in *PunchHolesRequest, opts ...grpc.CallOption) (*PunchHolesResponse, error) {
-	out := new(PunchHolesResponse)
-	err := c.cc.Invoke(ctx, "/pb.StreamManagerService/StreamPunchHoles", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MultiModifySplitRequest) GetMidKey() []byte {
+	if m != nil {
+		return m.MidKey
 	}
-	return out, nil
+	return nil
 }
 
-func (c *streamManagerServiceClient) CreateStream(ctx context.Context, in *CreateStreamRequest, opts ...grpc.CallOption) (*CreateStreamResponse, error) {
-	out := new(CreateStreamResponse)
-	err := c.cc.Invoke(ctx, "/pb.StreamManagerService/CreateStream", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MultiModifySplitRequest) GetOwnerKey() string {
+	if m != nil {
+		return m.OwnerKey
 	}
-	return out, nil
+	return ""
 }
 
-func (c *streamManagerServiceClient) RegisterNode(ctx context.Context, in *RegisterNodeRequest, opts ...grpc.CallOption) (*RegisterNodeResponse, error) {
-	out := new(RegisterNodeResponse)
-	err := c.cc.Invoke(ctx, "/pb.StreamManagerService/RegisterNode", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MultiModifySplitRequest) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
 	}
-	return out, nil
+	return 0
 }
 
-func (c *streamManagerServiceClient) Truncate(ctx context.Context, in *TruncateRequest, opts ...grpc.CallOption) (*TruncateResponse, error) {
-	out := new(TruncateResponse)
-	err := c.cc.Invoke(ctx, "/pb.StreamManagerService/Truncate", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MultiModifySplitRequest) GetLogStreamSealedLength() uint32 {
+	if m != nil {
+		return m.LogStreamSealedLength
 	}
-	return out, nil
+	return 0
 }
 
-func (c *streamManagerServiceClient) MultiModifySplit(ctx context.Context, in *MultiModifySplitRequest, opts ...grpc.CallOption) (*MultiModifySplitResponse, error) {
-	out := new(MultiModifySplitResponse)
-	err := c.cc.Invoke(ctx, "/pb.StreamManagerService/MultiModifySplit", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MultiModifySplitRequest) GetRowStreamSealedLength() uint32 {
+	if m != nil {
+		return m.RowStreamSealedLength
 	}
-	return out, nil
+	return 0
 }
 
-// StreamManagerServiceServer is the server API for StreamManagerService service.
-type StreamManagerServiceServer interface {
-	StreamInfo(context.Context, *StreamInfoRequest) (*StreamInfoResponse, error)
-	ExtentInfo(context.Context, *ExtentInfoRequest) (*ExtentInfoResponse, error)
-	NodesInfo(context.Context, *NodesInfoRequest) (*NodesInfoResponse, error)
-	Status(context.Context, *StatusRequest) (*StatusResponse, error)
-	CheckCommitLength(context.Context, *CheckCommitLengthRequest) (*CheckCommitLengthResponse, error)
-	StreamAllocExtent(context.Context, *StreamAllocExtentRequest) (*StreamAllocExtentResponse, error)
-	StreamPunchHoles(context.Context, *PunchHolesRequest) (*PunchHolesResponse, error)
-	CreateStream(context.Context, *CreateStreamRequest) (*CreateStreamResponse, error)
-	RegisterNode(context.Context, *RegisterNodeRequest) (*RegisterNodeResponse, error)
-	Truncate(context.Context, *TruncateRequest) (*TruncateResponse, error)
-	MultiModifySplit(context.Context, *MultiModifySplitRequest) (*MultiModifySplitResponse, error)
+func (m *MultiModifySplitRequest) GetMetaStreamSealedLength() uint32 {
+	if m != nil {
+		return m.MetaStreamSealedLength
+	}
+	return 0
 }
 
-// UnimplementedStreamManagerServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedStreamManagerServiceServer struct {
+type MultiModifySplitResponse struct {
+	Code    Code   `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	CodeDes string `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
 }
 
-func (*UnimplementedStreamManagerServiceServer) StreamInfo(ctx context.Context, req *StreamInfoRequest) (*StreamInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StreamInfo not implemented")
+func (m *MultiModifySplitResponse) Reset()         { *m = MultiModifySplitResponse{} }
+func (m *MultiModifySplitResponse) String() string { return proto.CompactTextString(m) }
+func (*MultiModifySplitResponse) ProtoMessage()    {}
+func (*MultiModifySplitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{68}
 }
-func (*UnimplementedStreamManagerServiceServer) ExtentInfo(ctx context.Context, req *ExtentInfoRequest) (*ExtentInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExtentInfo not implemented")
+func (m *MultiModifySplitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedStreamManagerServiceServer) NodesInfo(ctx context.Context, req *NodesInfoRequest) (*NodesInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NodesInfo not implemented")
+func (m *MultiModifySplitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiModifySplitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedStreamManagerServiceServer) Status(ctx context.Context, req *StatusRequest) (*StatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
+func (m *MultiModifySplitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiModifySplitResponse.Merge(m, src)
 }
-func (*UnimplementedStreamManagerServiceServer) CheckCommitLength(ctx context.Context, req *CheckCommitLengthRequest) (*CheckCommitLengthResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckCommitLength not implemented")
+func (m *MultiModifySplitResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedStreamManagerServiceServer) StreamAllocExtent(ctx context.Context, req *StreamAllocExtentRequest) (*StreamAllocExtentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StreamAllocExtent not implemented")
+func (m *MultiModifySplitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiModifySplitResponse.DiscardUnknown(m)
 }
-func (*UnimplementedStreamManagerServiceServer) StreamPunchHoles(ctx context.Context, req *PunchHolesRequest) (*PunchHolesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StreamPunchHoles not implemented")
+
+var xxx_messageInfo_MultiModifySplitResponse proto.InternalMessageInfo
+
+func (m *MultiModifySplitResponse) GetCode() Code {
+	if m != nil {
+		return m.Code
+	}
+	return Code_OK
 }
-func (*UnimplementedStreamManagerServiceServer) CreateStream(ctx context.Context, req *CreateStreamRequest) (*CreateStreamResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateStream not implemented")
+
+func (m *MultiModifySplitResponse) GetCodeDes() string {
+	if m != nil {
+		return m.CodeDes
+	}
+	return ""
 }
-func (*UnimplementedStreamManagerServiceServer) RegisterNode(ctx context.Context, req *RegisterNodeRequest) (*RegisterNodeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterNode not implemented")
+
+//seal and duplicate streams of a partition into a snapshot
+type SnapshotPartitionRequest struct {
+	PartID                 uint64 `protobuf:"varint,1,opt,name=partID,proto3" json:"partID,omitempty"`
+	OwnerKey               string `protobuf:"bytes,2,opt,name=ownerKey,proto3" json:"ownerKey,omitempty"`
+	Revision               int64  `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	LogStreamSealedLength  uint32 `protobuf:"varint,4,opt,name=logStreamSealedLength,proto3" json:"logStreamSealedLength,omitempty"`
+	RowStreamSealedLength  uint32 `protobuf:"varint,5,opt,name=rowStreamSealedLength,proto3" json:"rowStreamSealedLength,omitempty"`
+	MetaStreamSealedLength uint32 `protobuf:"varint,6,opt,name=metaStreamSealedLength,proto3" json:"metaStreamSealedLength,omitempty"`
 }
-func (*UnimplementedStreamManagerServiceServer) Truncate(ctx context.Context, req *TruncateRequest) (*TruncateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Truncate not implemented")
+
+func (m *SnapshotPartitionRequest) Reset()         { *m = SnapshotPartitionRequest{} }
+func (m *SnapshotPartitionRequest) String() string { return proto.CompactTextString(m) }
+func (*SnapshotPartitionRequest) ProtoMessage()    {}
+func (*SnapshotPartitionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{69}
 }
-func (*UnimplementedStreamManagerServiceServer) MultiModifySplit(ctx context.Context, req *MultiModifySplitRequest) (*MultiModifySplitResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MultiModifySplit not implemented")
+func (m *SnapshotPartitionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func RegisterStreamManagerServiceServer(s *grpc.Server, srv StreamManagerServiceServer) {
-	s.RegisterService(&_StreamManagerService_serviceDesc, srv)
+func (m *SnapshotPartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotPartitionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SnapshotPartitionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotPartitionRequest.Merge(m, src)
+}
+func (m *SnapshotPartitionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotPartitionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotPartitionRequest.DiscardUnknown(m)
 }
 
-func _StreamManagerService_StreamInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StreamInfoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(StreamManagerServiceServer).StreamInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.StreamManagerService/StreamInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StreamManagerServiceServer).StreamInfo(ctx, req.(*StreamInfoRequest))
+var xxx_messageInfo_SnapshotPartitionRequest proto.InternalMessageInfo
+
+func (m *SnapshotPartitionRequest) GetPartID() uint64 {
+	if m != nil {
+		return m.PartID
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _StreamManagerService_ExtentInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExtentInfoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(StreamManagerServiceServer).ExtentInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.StreamManagerService/ExtentInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StreamManagerServiceServer).ExtentInfo(ctx, req.(*ExtentInfoRequest))
+func (m *SnapshotPartitionRequest) GetOwnerKey() string {
+	if m != nil {
+		return m.OwnerKey
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _StreamManagerService_NodesInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NodesInfoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(StreamManagerServiceServer).NodesInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.StreamManagerService/NodesInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StreamManagerServiceServer).NodesInfo(ctx, req.(*NodesInfoRequest))
+func (m *SnapshotPartitionRequest) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _StreamManagerService_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StatusRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(StreamManagerServiceServer).Status(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.StreamManagerService/Status",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StreamManagerServiceServer).Status(ctx, req.(*StatusRequest))
+func (m *SnapshotPartitionRequest) GetLogStreamSealedLength() uint32 {
+	if m != nil {
+		return m.LogStreamSealedLength
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _StreamManagerService_CheckCommitLength_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckCommitLengthRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(StreamManagerServiceServer).CheckCommitLength(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.StreamManagerService/CheckCommitLength",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StreamManagerServiceServer).CheckCommitLength(ctx, req.(*CheckCommitLengthRequest))
+func (m *SnapshotPartitionRequest) GetRowStreamSealedLength() uint32 {
+	if m != nil {
+		return m.RowStreamSealedLength
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _StreamManagerService_StreamAllocExtent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StreamAllocExtentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(StreamManagerServiceServer).StreamAllocExtent(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.StreamManagerService/StreamAllocExtent",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StreamManagerServiceServer).StreamAllocExtent(ctx, req.(*StreamAllocExtentRequest))
+func (m *SnapshotPartitionRequest) GetMetaStreamSealedLength() uint32 {
+	if m != nil {
+		return m.MetaStreamSealedLength
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _StreamManagerService_StreamPunchHoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PunchHolesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(StreamManagerServiceServer).StreamPunchHoles(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.StreamManagerService/StreamPunchHoles",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StreamManagerServiceServer).StreamPunchHoles(ctx, req.(*PunchHolesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type SnapshotPartitionResponse struct {
+	Code       Code   `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	CodeDes    string `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
+	SnapshotID uint64 `protobuf:"varint,3,opt,name=snapshotID,proto3" json:"snapshotID,omitempty"`
 }
 
-func _StreamManagerService_CreateStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateStreamRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(StreamManagerServiceServer).CreateStream(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.StreamManagerService/CreateStream",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StreamManagerServiceServer).CreateStream(ctx, req.(*CreateStreamRequest))
+func (m *SnapshotPartitionResponse) Reset()         { *m = SnapshotPartitionResponse{} }
+func (m *SnapshotPartitionResponse) String() string { return proto.CompactTextString(m) }
+func (*SnapshotPartitionResponse) ProtoMessage()    {}
+func (*SnapshotPartitionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{70}
+}
+func (m *SnapshotPartitionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotPartitionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotPartitionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *SnapshotPartitionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotPartitionResponse.Merge(m, src)
+}
+func (m *SnapshotPartitionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotPartitionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotPartitionResponse.DiscardUnknown(m)
 }
 
-func _StreamManagerService_RegisterNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterNodeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(StreamManagerServiceServer).RegisterNode(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.StreamManagerService/RegisterNode",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StreamManagerServiceServer).RegisterNode(ctx, req.(*RegisterNodeRequest))
+var xxx_messageInfo_SnapshotPartitionResponse proto.InternalMessageInfo
+
+func (m *SnapshotPartitionResponse) GetCode() Code {
+	if m != nil {
+		return m.Code
 	}
-	return interceptor(ctx, in, info, handler)
+	return Code_OK
 }
 
-func _StreamManagerService_Truncate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TruncateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(StreamManagerServiceServer).Truncate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.StreamManagerService/Truncate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StreamManagerServiceServer).Truncate(ctx, req.(*TruncateRequest))
+func (m *SnapshotPartitionResponse) GetCodeDes() string {
+	if m != nil {
+		return m.CodeDes
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _StreamManagerService_MultiModifySplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MultiModifySplitRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(StreamManagerServiceServer).MultiModifySplit(ctx, in)
+func (m *SnapshotPartitionResponse) GetSnapshotID() uint64 {
+	if m != nil {
+		return m.SnapshotID
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.StreamManagerService/MultiModifySplit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StreamManagerServiceServer).MultiModifySplit(ctx, req.(*MultiModifySplitRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _StreamManagerService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pb.StreamManagerService",
-	HandlerType: (*StreamManagerServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "StreamInfo",
-			Handler:    _StreamManagerService_StreamInfo_Handler,
-		},
-		{
-			MethodName: "ExtentInfo",
-			Handler:    _StreamManagerService_ExtentInfo_Handler,
-		},
-		{
-			MethodName: "NodesInfo",
-			Handler:    _StreamManagerService_NodesInfo_Handler,
-		},
-		{
-			MethodName: "Status",
-			Handler:    _StreamManagerService_Status_Handler,
-		},
-		{
-			MethodName: "CheckCommitLength",
-			Handler:    _StreamManagerService_CheckCommitLength_Handler,
-		},
-		{
-			MethodName: "StreamAllocExtent",
-			Handler:    _StreamManagerService_StreamAllocExtent_Handler,
-		},
-		{
-			MethodName: "StreamPunchHoles",
-			Handler:    _StreamManagerService_StreamPunchHoles_Handler,
-		},
-		{
-			MethodName: "CreateStream",
-			Handler:    _StreamManagerService_CreateStream_Handler,
-		},
-		{
-			MethodName: "RegisterNode",
-			Handler:    _StreamManagerService_RegisterNode_Handler,
-		},
-		{
-			MethodName: "Truncate",
-			Handler:    _StreamManagerService_Truncate_Handler,
-		},
-		{
-			MethodName: "MultiModifySplit",
-			Handler:    _StreamManagerService_MultiModifySplit_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "pb.proto",
+//replace streams of a partition with copies of a snapshot
+type RestoreSnapshotRequest struct {
+	SnapshotID uint64 `protobuf:"varint,1,opt,name=snapshotID,proto3" json:"snapshotID,omitempty"`
+	OwnerKey   string `protobuf:"bytes,2,opt,name=ownerKey,proto3" json:"ownerKey,omitempty"`
+	Revision   int64  `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
 }
 
-func (m *AppendRequestHeader) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *RestoreSnapshotRequest) Reset()         { *m = RestoreSnapshotRequest{} }
+func (m *RestoreSnapshotRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreSnapshotRequest) ProtoMessage()    {}
+func (*RestoreSnapshotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{71}
 }
-
-func (m *AppendRequestHeader) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RestoreSnapshotRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *AppendRequestHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Blocks) > 0 {
-		dAtA2 := make([]byte, len(m.Blocks)*10)
-		var j1 int
-		for _, num := range m.Blocks {
-			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
-			}
-			dAtA2[j1] = uint8(num)
-			j1++
-		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintPb(dAtA, i, uint64(j1))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.MustSync {
-		i--
-		if m.MustSync {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *RestoreSnapshotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestoreSnapshotRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Revision != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Revision))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Commit != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Commit))
-		i--
-		dAtA[i] = 0x18
+		return b[:n], nil
 	}
-	if m.Eversion != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Eversion))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *RestoreSnapshotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreSnapshotRequest.Merge(m, src)
+}
+func (m *RestoreSnapshotRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestoreSnapshotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreSnapshotRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreSnapshotRequest proto.InternalMessageInfo
+
+func (m *RestoreSnapshotRequest) GetSnapshotID() uint64 {
+	if m != nil {
+		return m.SnapshotID
 	}
-	if m.ExtentID != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ExtentID))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *RestoreSnapshotRequest) GetOwnerKey() string {
+	if m != nil {
+		return m.OwnerKey
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *AppendRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RestoreSnapshotRequest) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *AppendRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type RestoreSnapshotResponse struct {
+	Code    Code   `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	CodeDes string `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
 }
 
-func (m *AppendRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Data != nil {
-		{
-			size := m.Data.Size()
-			i -= size
-			if _, err := m.Data.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+func (m *RestoreSnapshotResponse) Reset()         { *m = RestoreSnapshotResponse{} }
+func (m *RestoreSnapshotResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreSnapshotResponse) ProtoMessage()    {}
+func (*RestoreSnapshotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{72}
+}
+func (m *RestoreSnapshotResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestoreSnapshotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestoreSnapshotResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *RestoreSnapshotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreSnapshotResponse.Merge(m, src)
+}
+func (m *RestoreSnapshotResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestoreSnapshotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreSnapshotResponse.DiscardUnknown(m)
 }
 
-func (m *AppendRequest_Header) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_RestoreSnapshotResponse proto.InternalMessageInfo
+
+func (m *RestoreSnapshotResponse) GetCode() Code {
+	if m != nil {
+		return m.Code
+	}
+	return Code_OK
 }
 
-func (m *AppendRequest_Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Header != nil {
-		{
-			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *RestoreSnapshotResponse) GetCodeDes() string {
+	if m != nil {
+		return m.CodeDes
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
-func (m *AppendRequest_Payload) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+type DeleteSnapshotRequest struct {
+	SnapshotID uint64 `protobuf:"varint,1,opt,name=snapshotID,proto3" json:"snapshotID,omitempty"`
 }
 
-func (m *AppendRequest_Payload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Payload != nil {
-		i -= len(m.Payload)
-		copy(dAtA[i:], m.Payload)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Payload)))
-		i--
-		dAtA[i] = 0x12
+func (m *DeleteSnapshotRequest) Reset()         { *m = DeleteSnapshotRequest{} }
+func (m *DeleteSnapshotRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteSnapshotRequest) ProtoMessage()    {}
+func (*DeleteSnapshotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{73}
+}
+func (m *DeleteSnapshotRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteSnapshotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteSnapshotRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *AppendResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DeleteSnapshotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteSnapshotRequest.Merge(m, src)
+}
+func (m *DeleteSnapshotRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteSnapshotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteSnapshotRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteSnapshotRequest proto.InternalMessageInfo
+
+func (m *DeleteSnapshotRequest) GetSnapshotID() uint64 {
+	if m != nil {
+		return m.SnapshotID
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *AppendResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type DeleteSnapshotResponse struct {
+	Code    Code   `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	CodeDes string `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
 }
 
-func (m *AppendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.End != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.End))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Offsets) > 0 {
-		dAtA5 := make([]byte, len(m.Offsets)*10)
-		var j4 int
-		for _, num := range m.Offsets {
-			for num >= 1<<7 {
-				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j4++
-			}
-			dAtA5[j4] = uint8(num)
-			j4++
+func (m *DeleteSnapshotResponse) Reset()         { *m = DeleteSnapshotResponse{} }
+func (m *DeleteSnapshotResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteSnapshotResponse) ProtoMessage()    {}
+func (*DeleteSnapshotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{74}
+}
+func (m *DeleteSnapshotResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteSnapshotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteSnapshotResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j4
-		copy(dAtA[i:], dAtA5[:j4])
-		i = encodeVarintPb(dAtA, i, uint64(j4))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.CodeDes) > 0 {
-		i -= len(m.CodeDes)
-		copy(dAtA[i:], m.CodeDes)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Code != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Code))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *CreateExtentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *DeleteSnapshotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteSnapshotResponse.Merge(m, src)
 }
-
-func (m *CreateExtentRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DeleteSnapshotResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteSnapshotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteSnapshotResponse.DiscardUnknown(m)
 }
 
-func (m *CreateExtentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.StreamID != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.StreamID))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_DeleteSnapshotResponse proto.InternalMessageInfo
+
+func (m *DeleteSnapshotResponse) GetCode() Code {
+	if m != nil {
+		return m.Code
 	}
-	return len(dAtA) - i, nil
+	return Code_OK
 }
 
-func (m *CreateExtentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DeleteSnapshotResponse) GetCodeDes() string {
+	if m != nil {
+		return m.CodeDes
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *CreateExtentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type StatusRequest struct {
 }
 
-func (m *CreateExtentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ExtentID != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ExtentID))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.CodeDes) > 0 {
-		i -= len(m.CodeDes)
-		copy(dAtA[i:], m.CodeDes)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Code != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Code))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *StatusRequest) Reset()         { *m = StatusRequest{} }
+func (m *StatusRequest) String() string { return proto.CompactTextString(m) }
+func (*StatusRequest) ProtoMessage()    {}
+func (*StatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{75}
 }
-
-func (m *ReadBlocksRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *StatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *StatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusRequest.Merge(m, src)
+}
+func (m *StatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusRequest.DiscardUnknown(m)
 }
 
-func (m *ReadBlocksRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_StatusRequest proto.InternalMessageInfo
+
+type StatusResponse struct {
+	Code    Code   `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	CodeDes string `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
+	//current leader of stream managers
+	Leader *MemberValue `protobuf:"bytes,3,opt,name=leader,proto3" json:"leader,omitempty"`
 }
 
-func (m *ReadBlocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.OnlyLastBlock {
-		i--
-		if m.OnlyLastBlock {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *StatusResponse) Reset()         { *m = StatusResponse{} }
+func (m *StatusResponse) String() string { return proto.CompactTextString(m) }
+func (*StatusResponse) ProtoMessage()    {}
+func (*StatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{76}
+}
+func (m *StatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Eversion != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Eversion))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.NumOfBlocks != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.NumOfBlocks))
-		i--
-		dAtA[i] = 0x18
+		return b[:n], nil
 	}
-	if m.Offset != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Offset))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *StatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusResponse.Merge(m, src)
+}
+func (m *StatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatusResponse proto.InternalMessageInfo
+
+func (m *StatusResponse) GetCode() Code {
+	if m != nil {
+		return m.Code
 	}
-	if m.ExtentID != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ExtentID))
-		i--
-		dAtA[i] = 0x8
+	return Code_OK
+}
+
+func (m *StatusResponse) GetCodeDes() string {
+	if m != nil {
+		return m.CodeDes
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ReadBlockResponseHeader) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *StatusResponse) GetLeader() *MemberValue {
+	if m != nil {
+		return m.Leader
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ReadBlockResponseHeader) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type PunchHolesRequest struct {
+	StreamID  uint64   `protobuf:"varint,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
+	ExtentIDs []uint64 `protobuf:"varint,2,rep,packed,name=extentIDs,proto3" json:"extentIDs,omitempty"`
+	OwnerKey  string   `protobuf:"bytes,4,opt,name=ownerKey,proto3" json:"ownerKey,omitempty"`
+	Revision  int64    `protobuf:"varint,5,opt,name=revision,proto3" json:"revision,omitempty"`
 }
 
-func (m *ReadBlockResponseHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BlockSizes) > 0 {
-		dAtA7 := make([]byte, len(m.BlockSizes)*10)
-		var j6 int
-		for _, num := range m.BlockSizes {
-			for num >= 1<<7 {
-				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j6++
-			}
-			dAtA7[j6] = uint8(num)
-			j6++
+func (m *PunchHolesRequest) Reset()         { *m = PunchHolesRequest{} }
+func (m *PunchHolesRequest) String() string { return proto.CompactTextString(m) }
+func (*PunchHolesRequest) ProtoMessage()    {}
+func (*PunchHolesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{77}
+}
+func (m *PunchHolesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PunchHolesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PunchHolesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j6
-		copy(dAtA[i:], dAtA7[:j6])
-		i = encodeVarintPb(dAtA, i, uint64(j6))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.End != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.End))
-		i--
-		dAtA[i] = 0x20
+		return b[:n], nil
 	}
-	if len(m.Offsets) > 0 {
-		dAtA9 := make([]byte, len(m.Offsets)*10)
-		var j8 int
-		for _, num := range m.Offsets {
-			for num >= 1<<7 {
-				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j8++
-			}
-			dAtA9[j8] = uint8(num)
-			j8++
-		}
-		i -= j8
-		copy(dAtA[i:], dAtA9[:j8])
-		i = encodeVarintPb(dAtA, i, uint64(j8))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *PunchHolesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PunchHolesRequest.Merge(m, src)
+}
+func (m *PunchHolesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PunchHolesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PunchHolesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PunchHolesRequest proto.InternalMessageInfo
+
+func (m *PunchHolesRequest) GetStreamID() uint64 {
+	if m != nil {
+		return m.StreamID
 	}
-	if len(m.CodeDes) > 0 {
-		i -= len(m.CodeDes)
-		copy(dAtA[i:], m.CodeDes)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *PunchHolesRequest) GetExtentIDs() []uint64 {
+	if m != nil {
+		return m.ExtentIDs
 	}
-	if m.Code != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Code))
-		i--
-		dAtA[i] = 0x8
+	return nil
+}
+
+func (m *PunchHolesRequest) GetOwnerKey() string {
+	if m != nil {
+		return m.OwnerKey
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ReadBlocksResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PunchHolesRequest) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ReadBlocksResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type PunchHolesResponse struct {
+	Code    Code        `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	CodeDes string      `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
+	Stream  *StreamInfo `protobuf:"bytes,3,opt,name=stream,proto3" json:"stream,omitempty"`
 }
 
-func (m *ReadBlocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Data != nil {
-		{
-			size := m.Data.Size()
-			i -= size
-			if _, err := m.Data.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+func (m *PunchHolesResponse) Reset()         { *m = PunchHolesResponse{} }
+func (m *PunchHolesResponse) String() string { return proto.CompactTextString(m) }
+func (*PunchHolesResponse) ProtoMessage()    {}
+func (*PunchHolesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{78}
+}
+func (m *PunchHolesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PunchHolesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PunchHolesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PunchHolesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PunchHolesResponse.Merge(m, src)
+}
+func (m *PunchHolesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PunchHolesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PunchHolesResponse.DiscardUnknown(m)
 }
 
-func (m *ReadBlocksResponse_Header) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_PunchHolesResponse proto.InternalMessageInfo
+
+func (m *PunchHolesResponse) GetCode() Code {
+	if m != nil {
+		return m.Code
+	}
+	return Code_OK
 }
 
-func (m *ReadBlocksResponse_Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Header != nil {
-		{
-			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *PunchHolesResponse) GetCodeDes() string {
+	if m != nil {
+		return m.CodeDes
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
-func (m *ReadBlocksResponse_Payload) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (m *PunchHolesResponse) GetStream() *StreamInfo {
+	if m != nil {
+		return m.Stream
+	}
+	return nil
 }
 
-func (m *ReadBlocksResponse_Payload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Payload != nil {
-		i -= len(m.Payload)
-		copy(dAtA[i:], m.Payload)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Payload)))
-		i--
-		dAtA[i] = 0x12
+//sealed replicated extents older than minAge are converted to EC in background
+type ECPolicy struct {
+	DataShard   uint32        `protobuf:"varint,1,opt,name=dataShard,proto3" json:"dataShard,omitempty"`
+	ParityShard uint32        `protobuf:"varint,2,opt,name=parityShard,proto3" json:"parityShard,omitempty"`
+	MinAge      int64         `protobuf:"varint,3,opt,name=minAge,proto3" json:"minAge,omitempty"`
+	Codec       *ErasureCodec `protobuf:"bytes,4,opt,name=codec,proto3" json:"codec,omitempty"`
+}
+
+func (m *ECPolicy) Reset()         { *m = ECPolicy{} }
+func (m *ECPolicy) String() string { return proto.CompactTextString(m) }
+func (*ECPolicy) ProtoMessage()    {}
+func (*ECPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{79}
+}
+func (m *ECPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ECPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ECPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *Payload) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ECPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ECPolicy.Merge(m, src)
+}
+func (m *ECPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ECPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ECPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ECPolicy proto.InternalMessageInfo
+
+func (m *ECPolicy) GetDataShard() uint32 {
+	if m != nil {
+		return m.DataShard
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Payload) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ECPolicy) GetParityShard() uint32 {
+	if m != nil {
+		return m.ParityShard
+	}
+	return 0
 }
 
-func (m *Payload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0xa
+func (m *ECPolicy) GetMinAge() int64 {
+	if m != nil {
+		return m.MinAge
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *CommitLengthRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ECPolicy) GetCodec() *ErasureCodec {
+	if m != nil {
+		return m.Codec
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *CommitLengthRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type SetECPolicyRequest struct {
+	StreamID uint64    `protobuf:"varint,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
+	Policy   *ECPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
 }
 
-func (m *CommitLengthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Revision != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Revision))
-		i--
-		dAtA[i] = 0x10
+func (m *SetECPolicyRequest) Reset()         { *m = SetECPolicyRequest{} }
+func (m *SetECPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*SetECPolicyRequest) ProtoMessage()    {}
+func (*SetECPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{80}
+}
+func (m *SetECPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetECPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetECPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.ExtentID != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ExtentID))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *SetECPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetECPolicyRequest.Merge(m, src)
+}
+func (m *SetECPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetECPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetECPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetECPolicyRequest proto.InternalMessageInfo
+
+func (m *SetECPolicyRequest) GetStreamID() uint64 {
+	if m != nil {
+		return m.StreamID
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *CommitLengthResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SetECPolicyRequest) GetPolicy() *ECPolicy {
+	if m != nil {
+		return m.Policy
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *CommitLengthResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type SetECPolicyResponse struct {
+	Code    Code   `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	CodeDes string `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
 }
 
-func (m *CommitLengthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Length != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Length))
-		i--
-		dAtA[i] = 0x18
+func (m *SetECPolicyResponse) Reset()         { *m = SetECPolicyResponse{} }
+func (m *SetECPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*SetECPolicyResponse) ProtoMessage()    {}
+func (*SetECPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{81}
+}
+func (m *SetECPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetECPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetECPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.CodeDes) > 0 {
-		i -= len(m.CodeDes)
-		copy(dAtA[i:], m.CodeDes)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *SetECPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetECPolicyResponse.Merge(m, src)
+}
+func (m *SetECPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetECPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetECPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetECPolicyResponse proto.InternalMessageInfo
+
+func (m *SetECPolicyResponse) GetCode() Code {
+	if m != nil {
+		return m.Code
 	}
-	if m.Code != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Code))
-		i--
-		dAtA[i] = 0x8
+	return Code_OK
+}
+
+func (m *SetECPolicyResponse) GetCodeDes() string {
+	if m != nil {
+		return m.CodeDes
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *DF) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+type ECConversionStatusRequest struct {
+	StreamID uint64 `protobuf:"varint,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
+}
+
+func (m *ECConversionStatusRequest) Reset()         { *m = ECConversionStatusRequest{} }
+func (m *ECConversionStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*ECConversionStatusRequest) ProtoMessage()    {}
+func (*ECConversionStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{82}
+}
+func (m *ECConversionStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ECConversionStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ECConversionStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *ECConversionStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ECConversionStatusRequest.Merge(m, src)
+}
+func (m *ECConversionStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ECConversionStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ECConversionStatusRequest.DiscardUnknown(m)
 }
 
-func (m *DF) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ECConversionStatusRequest proto.InternalMessageInfo
+
+func (m *ECConversionStatusRequest) GetStreamID() uint64 {
+	if m != nil {
+		return m.StreamID
+	}
+	return 0
 }
 
-func (m *DF) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Online {
-		i--
-		if m.Online {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+type ECConversionProgress struct {
+	StreamID       uint64    `protobuf:"varint,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
+	Policy         *ECPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	Pending        uint32    `protobuf:"varint,3,opt,name=pending,proto3" json:"pending,omitempty"`
+	Running        uint32    `protobuf:"varint,4,opt,name=running,proto3" json:"running,omitempty"`
+	Converted      uint32    `protobuf:"varint,5,opt,name=converted,proto3" json:"converted,omitempty"`
+	PendingBytes   uint64    `protobuf:"varint,6,opt,name=pendingBytes,proto3" json:"pendingBytes,omitempty"`
+	ConvertedBytes uint64    `protobuf:"varint,7,opt,name=convertedBytes,proto3" json:"convertedBytes,omitempty"`
+	LastError      string    `protobuf:"bytes,8,opt,name=lastError,proto3" json:"lastError,omitempty"`
+}
+
+func (m *ECConversionProgress) Reset()         { *m = ECConversionProgress{} }
+func (m *ECConversionProgress) String() string { return proto.CompactTextString(m) }
+func (*ECConversionProgress) ProtoMessage()    {}
+func (*ECConversionProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{83}
+}
+func (m *ECConversionProgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ECConversionProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ECConversionProgress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x18
+		return b[:n], nil
 	}
-	if m.Free != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Free))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *ECConversionProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ECConversionProgress.Merge(m, src)
+}
+func (m *ECConversionProgress) XXX_Size() int {
+	return m.Size()
+}
+func (m *ECConversionProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_ECConversionProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ECConversionProgress proto.InternalMessageInfo
+
+func (m *ECConversionProgress) GetStreamID() uint64 {
+	if m != nil {
+		return m.StreamID
 	}
-	if m.Total != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Total))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *ECConversionProgress) GetPolicy() *ECPolicy {
+	if m != nil {
+		return m.Policy
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *DfRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ECConversionProgress) GetPending() uint32 {
+	if m != nil {
+		return m.Pending
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *DfRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ECConversionProgress) GetRunning() uint32 {
+	if m != nil {
+		return m.Running
+	}
+	return 0
 }
 
-func (m *DfRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DiskIDs) > 0 {
-		dAtA12 := make([]byte, len(m.DiskIDs)*10)
-		var j11 int
-		for _, num := range m.DiskIDs {
-			for num >= 1<<7 {
-				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j11++
-			}
-			dAtA12[j11] = uint8(num)
-			j11++
-		}
-		i -= j11
-		copy(dAtA[i:], dAtA12[:j11])
-		i = encodeVarintPb(dAtA, i, uint64(j11))
-		i--
-		dAtA[i] = 0x12
+func (m *ECConversionProgress) GetConverted() uint32 {
+	if m != nil {
+		return m.Converted
 	}
-	if len(m.Tasks) > 0 {
-		for iNdEx := len(m.Tasks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Tasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return 0
+}
+
+func (m *ECConversionProgress) GetPendingBytes() uint64 {
+	if m != nil {
+		return m.PendingBytes
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *DfResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ECConversionProgress) GetConvertedBytes() uint64 {
+	if m != nil {
+		return m.ConvertedBytes
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *DfResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ECConversionProgress) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
 }
 
-func (m *DfResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DiskStatus) > 0 {
-		for k := range m.DiskStatus {
-			v := m.DiskStatus[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintPb(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i = encodeVarintPb(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.DoneTask) > 0 {
-		for iNdEx := len(m.DoneTask) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DoneTask[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	return len(dAtA) - i, nil
+type ECConversionStatusResponse struct {
+	Code     Code                    `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	CodeDes  string                  `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
+	Progress []*ECConversionProgress `protobuf:"bytes,3,rep,name=progress,proto3" json:"progress,omitempty"`
 }
 
-func (m *RecoveryTaskStatus) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ECConversionStatusResponse) Reset()         { *m = ECConversionStatusResponse{} }
+func (m *ECConversionStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*ECConversionStatusResponse) ProtoMessage()    {}
+func (*ECConversionStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{84}
+}
+func (m *ECConversionStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ECConversionStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ECConversionStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *RecoveryTaskStatus) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ECConversionStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ECConversionStatusResponse.Merge(m, src)
+}
+func (m *ECConversionStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ECConversionStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ECConversionStatusResponse.DiscardUnknown(m)
 }
 
-func (m *RecoveryTaskStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ReadyDiskID != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ReadyDiskID))
-		i--
-		dAtA[i] = 0x10
+var xxx_messageInfo_ECConversionStatusResponse proto.InternalMessageInfo
+
+func (m *ECConversionStatusResponse) GetCode() Code {
+	if m != nil {
+		return m.Code
 	}
-	if m.Task != nil {
-		{
-			size, err := m.Task.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return Code_OK
+}
+
+func (m *ECConversionStatusResponse) GetCodeDes() string {
+	if m != nil {
+		return m.CodeDes
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *RecoveryTask) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ECConversionStatusResponse) GetProgress() []*ECConversionProgress {
+	if m != nil {
+		return m.Progress
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *RecoveryTask) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type VerifyExtentRequest struct {
+	ExtentID uint64 `protobuf:"varint,1,opt,name=extentID,proto3" json:"extentID,omitempty"`
 }
 
-func (m *RecoveryTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.StartTime != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.StartTime))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.NodeID != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.NodeID))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.ReplaceID != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ReplaceID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.ExtentID != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ExtentID))
-		i--
-		dAtA[i] = 0x8
+func (m *VerifyExtentRequest) Reset()         { *m = VerifyExtentRequest{} }
+func (m *VerifyExtentRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifyExtentRequest) ProtoMessage()    {}
+func (*VerifyExtentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{85}
+}
+func (m *VerifyExtentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerifyExtentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerifyExtentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *VerifyExtentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyExtentRequest.Merge(m, src)
+}
+func (m *VerifyExtentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerifyExtentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyExtentRequest.DiscardUnknown(m)
 }
 
-func (m *RequireRecoveryRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_VerifyExtentRequest proto.InternalMessageInfo
+
+func (m *VerifyExtentRequest) GetExtentID() uint64 {
+	if m != nil {
+		return m.ExtentID
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *RequireRecoveryRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type VerifyExtentResponse struct {
+	Code      Code        `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	CodeDes   string      `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
+	Checksums []int64     `protobuf:"varint,3,rep,packed,name=checksums,proto3" json:"checksums,omitempty"`
+	Corrupted uint32      `protobuf:"varint,4,opt,name=corrupted,proto3" json:"corrupted,omitempty"`
+	Extent    *ExtentInfo `protobuf:"bytes,5,opt,name=extent,proto3" json:"extent,omitempty"`
 }
 
-func (m *RequireRecoveryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Task != nil {
-		{
-			size, err := m.Task.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
+func (m *VerifyExtentResponse) Reset()         { *m = VerifyExtentResponse{} }
+func (m *VerifyExtentResponse) String() string { return proto.CompactTextString(m) }
+func (*VerifyExtentResponse) ProtoMessage()    {}
+func (*VerifyExtentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{86}
+}
+func (m *VerifyExtentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerifyExtentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerifyExtentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *VerifyExtentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyExtentResponse.Merge(m, src)
+}
+func (m *VerifyExtentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerifyExtentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyExtentResponse.DiscardUnknown(m)
 }
 
-func (m *RequireRecoveryResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_VerifyExtentResponse proto.InternalMessageInfo
+
+func (m *VerifyExtentResponse) GetCode() Code {
+	if m != nil {
+		return m.Code
 	}
-	return dAtA[:n], nil
+	return Code_OK
 }
 
-func (m *RequireRecoveryResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *VerifyExtentResponse) GetCodeDes() string {
+	if m != nil {
+		return m.CodeDes
+	}
+	return ""
 }
 
-func (m *RequireRecoveryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CodeDes) > 0 {
-		i -= len(m.CodeDes)
-		copy(dAtA[i:], m.CodeDes)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
-		i--
-		dAtA[i] = 0x12
+func (m *VerifyExtentResponse) GetChecksums() []int64 {
+	if m != nil {
+		return m.Checksums
 	}
-	if m.Code != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Code))
-		i--
-		dAtA[i] = 0x8
+	return nil
+}
+
+func (m *VerifyExtentResponse) GetCorrupted() uint32 {
+	if m != nil {
+		return m.Corrupted
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *CopyResponseHeader) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VerifyExtentResponse) GetExtent() *ExtentInfo {
+	if m != nil {
+		return m.Extent
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *CopyResponseHeader) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+//used in Etcd Campaign
+type MemberValue struct {
+	ID      uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name    string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	GrpcURL string `protobuf:"bytes,3,opt,name=GrpcURL,proto3" json:"GrpcURL,omitempty"`
 }
 
-func (m *CopyResponseHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PayloadLen != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.PayloadLen))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.CodeDes) > 0 {
-		i -= len(m.CodeDes)
-		copy(dAtA[i:], m.CodeDes)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Code != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Code))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *MemberValue) Reset()         { *m = MemberValue{} }
+func (m *MemberValue) String() string { return proto.CompactTextString(m) }
+func (*MemberValue) ProtoMessage()    {}
+func (*MemberValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{87}
 }
-
-func (m *CopyExtentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MemberValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MemberValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MemberValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *CopyExtentRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MemberValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MemberValue.Merge(m, src)
+}
+func (m *MemberValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *MemberValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_MemberValue.DiscardUnknown(m)
 }
 
-func (m *CopyExtentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Eversion != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Eversion))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Size_ != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Size_))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Offset != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Offset))
-		i--
-		dAtA[i] = 0x10
+var xxx_messageInfo_MemberValue proto.InternalMessageInfo
+
+func (m *MemberValue) GetID() uint64 {
+	if m != nil {
+		return m.ID
 	}
-	if m.ExtentID != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ExtentID))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *MemberValue) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *CopyExtentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MemberValue) GetGrpcURL() string {
+	if m != nil {
+		return m.GrpcURL
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *CopyExtentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ExtentInfo struct {
+	ExtentID       uint64   `protobuf:"varint,1,opt,name=extentID,proto3" json:"extentID,omitempty"`
+	Replicates     []uint64 `protobuf:"varint,2,rep,packed,name=replicates,proto3" json:"replicates,omitempty"`
+	Parity         []uint64 `protobuf:"varint,3,rep,packed,name=parity,proto3" json:"parity,omitempty"`
+	Eversion       uint64   `protobuf:"varint,4,opt,name=eversion,proto3" json:"eversion,omitempty"`
+	Refs           uint64   `protobuf:"varint,5,opt,name=refs,proto3" json:"refs,omitempty"`
+	SealedLength   uint64   `protobuf:"varint,6,opt,name=SealedLength,proto3" json:"SealedLength,omitempty"`
+	Avali          uint32   `protobuf:"varint,7,opt,name=avali,proto3" json:"avali,omitempty"`
+	ReplicateDisks []uint64 `protobuf:"varint,8,rep,packed,name=replicateDisks,proto3" json:"replicateDisks,omitempty"`
+	ParityDisk     []uint64 `protobuf:"varint,9,rep,packed,name=parityDisk,proto3" json:"parityDisk,omitempty"`
+	//if fragmentSize > 0, the extent is converted from replicates, data is split into
+	//len(replicates) fragments of fragmentSize bytes, not striped by blocks
+	FragmentSize uint64        `protobuf:"varint,10,opt,name=fragmentSize,proto3" json:"fragmentSize,omitempty"`
+	SealedTime   int64         `protobuf:"varint,11,opt,name=sealedTime,proto3" json:"sealedTime,omitempty"`
+	Codec        *ErasureCodec `protobuf:"bytes,12,opt,name=codec,proto3" json:"codec,omitempty"`
+	Checksums    []uint32      `protobuf:"varint,13,rep,packed,name=checksums,proto3" json:"checksums,omitempty"`
+	ChecksumMask uint32        `protobuf:"varint,14,opt,name=checksumMask,proto3" json:"checksumMask,omitempty"`
+	DeletedTime  int64         `protobuf:"varint,15,opt,name=deletedTime,proto3" json:"deletedTime,omitempty"`
 }
 
-func (m *CopyExtentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Data != nil {
-		{
-			size := m.Data.Size()
-			i -= size
-			if _, err := m.Data.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+func (m *ExtentInfo) Reset()         { *m = ExtentInfo{} }
+func (m *ExtentInfo) String() string { return proto.CompactTextString(m) }
+func (*ExtentInfo) ProtoMessage()    {}
+func (*ExtentInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{88}
+}
+func (m *ExtentInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtentInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtentInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *CopyExtentResponse_Header) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ExtentInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtentInfo.Merge(m, src)
+}
+func (m *ExtentInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtentInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtentInfo.DiscardUnknown(m)
 }
 
-func (m *CopyExtentResponse_Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Header != nil {
-		{
-			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_ExtentInfo proto.InternalMessageInfo
+
+func (m *ExtentInfo) GetExtentID() uint64 {
+	if m != nil {
+		return m.ExtentID
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
-func (m *CopyExtentResponse_Payload) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (m *ExtentInfo) GetReplicates() []uint64 {
+	if m != nil {
+		return m.Replicates
+	}
+	return nil
 }
 
-func (m *CopyExtentResponse_Payload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Payload != nil {
-		i -= len(m.Payload)
-		copy(dAtA[i:], m.Payload)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Payload)))
-		i--
-		dAtA[i] = 0x12
+func (m *ExtentInfo) GetParity() []uint64 {
+	if m != nil {
+		return m.Parity
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
-func (m *ReAvaliRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *ExtentInfo) GetEversion() uint64 {
+	if m != nil {
+		return m.Eversion
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ReAvaliRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ExtentInfo) GetRefs() uint64 {
+	if m != nil {
+		return m.Refs
+	}
+	return 0
 }
 
-func (m *ReAvaliRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Eversion != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Eversion))
-		i--
-		dAtA[i] = 0x10
+func (m *ExtentInfo) GetSealedLength() uint64 {
+	if m != nil {
+		return m.SealedLength
 	}
-	if m.ExtentID != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ExtentID))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *ExtentInfo) GetAvali() uint32 {
+	if m != nil {
+		return m.Avali
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ReAvaliResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ExtentInfo) GetReplicateDisks() []uint64 {
+	if m != nil {
+		return m.ReplicateDisks
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ReAvaliResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ExtentInfo) GetParityDisk() []uint64 {
+	if m != nil {
+		return m.ParityDisk
+	}
+	return nil
 }
 
-func (m *ReAvaliResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CodeDes) > 0 {
-		i -= len(m.CodeDes)
-		copy(dAtA[i:], m.CodeDes)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
-		i--
-		dAtA[i] = 0x12
+func (m *ExtentInfo) GetFragmentSize() uint64 {
+	if m != nil {
+		return m.FragmentSize
 	}
-	if m.Code != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Code))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *ExtentInfo) GetSealedTime() int64 {
+	if m != nil {
+		return m.SealedTime
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *AllocExtentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ExtentInfo) GetCodec() *ErasureCodec {
+	if m != nil {
+		return m.Codec
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *AllocExtentRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ExtentInfo) GetChecksums() []uint32 {
+	if m != nil {
+		return m.Checksums
+	}
+	return nil
 }
 
-func (m *AllocExtentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ExtentID != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ExtentID))
-		i--
-		dAtA[i] = 0x8
+func (m *ExtentInfo) GetChecksumMask() uint32 {
+	if m != nil {
+		return m.ChecksumMask
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *AllocExtentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ExtentInfo) GetDeletedTime() int64 {
+	if m != nil {
+		return m.DeletedTime
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *AllocExtentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type StreamInfo struct {
+	StreamID    uint64          `protobuf:"varint,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
+	ExtentIDs   []uint64        `protobuf:"varint,2,rep,packed,name=extentIDs,proto3" json:"extentIDs,omitempty"`
+	Replication ReplicationMode `protobuf:"varint,3,opt,name=replication,proto3,enum=pb.ReplicationMode" json:"replication,omitempty"`
+	EcPolicy    *ECPolicy       `protobuf:"bytes,4,opt,name=ecPolicy,proto3" json:"ecPolicy,omitempty"`
+	Codec       *ErasureCodec   `protobuf:"bytes,5,opt,name=codec,proto3" json:"codec,omitempty"`
 }
 
-func (m *AllocExtentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DiskID != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.DiskID))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.CodeDes) > 0 {
-		i -= len(m.CodeDes)
-		copy(dAtA[i:], m.CodeDes)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Code != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Code))
-		i--
-		dAtA[i] = 0x8
+func (m *StreamInfo) Reset()         { *m = StreamInfo{} }
+func (m *StreamInfo) String() string { return proto.CompactTextString(m) }
+func (*StreamInfo) ProtoMessage()    {}
+func (*StreamInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{89}
+}
+func (m *StreamInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *StreamInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamInfo.Merge(m, src)
+}
+func (m *StreamInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamInfo.DiscardUnknown(m)
 }
 
-func (m *CheckCommitLengthRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_StreamInfo proto.InternalMessageInfo
+
+func (m *StreamInfo) GetStreamID() uint64 {
+	if m != nil {
+		return m.StreamID
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *CheckCommitLengthRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StreamInfo) GetExtentIDs() []uint64 {
+	if m != nil {
+		return m.ExtentIDs
+	}
+	return nil
 }
 
-func (m *CheckCommitLengthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Revision != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Revision))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.OwnerKey) > 0 {
-		i -= len(m.OwnerKey)
-		copy(dAtA[i:], m.OwnerKey)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.OwnerKey)))
-		i--
-		dAtA[i] = 0x12
+func (m *StreamInfo) GetReplication() ReplicationMode {
+	if m != nil {
+		return m.Replication
 	}
-	if m.StreamID != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.StreamID))
-		i--
-		dAtA[i] = 0x8
+	return ReplicationMode_Leaderless
+}
+
+func (m *StreamInfo) GetEcPolicy() *ECPolicy {
+	if m != nil {
+		return m.EcPolicy
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *CheckCommitLengthResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *StreamInfo) GetCodec() *ErasureCodec {
+	if m != nil {
+		return m.Codec
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *CheckCommitLengthResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type NodeInfo struct {
+	NodeID          uint64    `protobuf:"varint,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	Address         string    `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Disks           []uint64  `protobuf:"varint,3,rep,packed,name=disks,proto3" json:"disks,omitempty"`
+	Decommissioning bool      `protobuf:"varint,4,opt,name=decommissioning,proto3" json:"decommissioning,omitempty"`
+	Topology        *Topology `protobuf:"bytes,5,opt,name=topology,proto3" json:"topology,omitempty"`
 }
 
-func (m *CheckCommitLengthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LastExInfo != nil {
-		{
-			size, err := m.LastExInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.End != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.End))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.StreamInfo != nil {
-		{
-			size, err := m.StreamInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
+func (m *NodeInfo) Reset()         { *m = NodeInfo{} }
+func (m *NodeInfo) String() string { return proto.CompactTextString(m) }
+func (*NodeInfo) ProtoMessage()    {}
+func (*NodeInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{90}
+}
+func (m *NodeInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NodeInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.CodeDes) > 0 {
-		i -= len(m.CodeDes)
-		copy(dAtA[i:], m.CodeDes)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Code != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Code))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *NodeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeInfo.Merge(m, src)
+}
+func (m *NodeInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeInfo.DiscardUnknown(m)
 }
 
-func (m *StreamAllocExtentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_NodeInfo proto.InternalMessageInfo
+
+func (m *NodeInfo) GetNodeID() uint64 {
+	if m != nil {
+		return m.NodeID
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *StreamAllocExtentRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *NodeInfo) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *StreamAllocExtentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.End != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.End))
-		i--
-		dAtA[i] = 0x20
+func (m *NodeInfo) GetDisks() []uint64 {
+	if m != nil {
+		return m.Disks
 	}
-	if m.Revision != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Revision))
-		i--
-		dAtA[i] = 0x18
+	return nil
+}
+
+func (m *NodeInfo) GetDecommissioning() bool {
+	if m != nil {
+		return m.Decommissioning
 	}
-	if len(m.OwnerKey) > 0 {
-		i -= len(m.OwnerKey)
-		copy(dAtA[i:], m.OwnerKey)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.OwnerKey)))
-		i--
-		dAtA[i] = 0x12
+	return false
+}
+
+func (m *NodeInfo) GetTopology() *Topology {
+	if m != nil {
+		return m.Topology
 	}
-	if m.StreamID != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.StreamID))
-		i--
-		dAtA[i] = 0x8
+	return nil
+}
+
+type DiskInfo struct {
+	DiskID uint64 `protobuf:"varint,1,opt,name=diskID,proto3" json:"diskID,omitempty"`
+	Online bool   `protobuf:"varint,2,opt,name=online,proto3" json:"online,omitempty"`
+	Uuid   string `protobuf:"bytes,3,opt,name=uuid,proto3" json:"uuid,omitempty"`
+}
+
+func (m *DiskInfo) Reset()         { *m = DiskInfo{} }
+func (m *DiskInfo) String() string { return proto.CompactTextString(m) }
+func (*DiskInfo) ProtoMessage()    {}
+func (*DiskInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{91}
+}
+func (m *DiskInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DiskInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DiskInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *DiskInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiskInfo.Merge(m, src)
+}
+func (m *DiskInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *DiskInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiskInfo.DiscardUnknown(m)
 }
 
-func (m *StreamAllocExtentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DiskInfo proto.InternalMessageInfo
+
+func (m *DiskInfo) GetDiskID() uint64 {
+	if m != nil {
+		return m.DiskID
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *StreamAllocExtentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DiskInfo) GetOnline() bool {
+	if m != nil {
+		return m.Online
+	}
+	return false
 }
 
-func (m *StreamAllocExtentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LastExInfo != nil {
-		{
-			size, err := m.LastExInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+func (m *DiskInfo) GetUuid() string {
+	if m != nil {
+		return m.Uuid
 	}
-	if m.StreamInfo != nil {
-		{
-			size, err := m.StreamInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
+	return ""
+}
+
+//MetaBackup is a dump of cluster metadata in etcd at revision, keys with lease are not included
+type MetaBackup struct {
+	Version     uint32    `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Revision    int64     `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	CreatedTime int64     `protobuf:"varint,3,opt,name=createdTime,proto3" json:"createdTime,omitempty"`
+	Kvs         []*MetaKV `protobuf:"bytes,4,rep,name=kvs,proto3" json:"kvs,omitempty"`
+}
+
+func (m *MetaBackup) Reset()         { *m = MetaBackup{} }
+func (m *MetaBackup) String() string { return proto.CompactTextString(m) }
+func (*MetaBackup) ProtoMessage()    {}
+func (*MetaBackup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{92}
+}
+func (m *MetaBackup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MetaBackup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MetaBackup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if len(m.CodeDes) > 0 {
-		i -= len(m.CodeDes)
-		copy(dAtA[i:], m.CodeDes)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MetaBackup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetaBackup.Merge(m, src)
+}
+func (m *MetaBackup) XXX_Size() int {
+	return m.Size()
+}
+func (m *MetaBackup) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetaBackup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MetaBackup proto.InternalMessageInfo
+
+func (m *MetaBackup) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
 	}
-	if m.Code != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Code))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *MetaBackup) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *StreamInfoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MetaBackup) GetCreatedTime() int64 {
+	if m != nil {
+		return m.CreatedTime
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *StreamInfoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MetaBackup) GetKvs() []*MetaKV {
+	if m != nil {
+		return m.Kvs
+	}
+	return nil
 }
 
-func (m *StreamInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.StreamIDs) > 0 {
-		dAtA22 := make([]byte, len(m.StreamIDs)*10)
-		var j21 int
-		for _, num := range m.StreamIDs {
-			for num >= 1<<7 {
-				dAtA22[j21] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j21++
-			}
-			dAtA22[j21] = uint8(num)
-			j21++
+type MetaKV struct {
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *MetaKV) Reset()         { *m = MetaKV{} }
+func (m *MetaKV) String() string { return proto.CompactTextString(m) }
+func (*MetaKV) ProtoMessage()    {}
+func (*MetaKV) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{93}
+}
+func (m *MetaKV) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MetaKV) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MetaKV.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j21
-		copy(dAtA[i:], dAtA22[:j21])
-		i = encodeVarintPb(dAtA, i, uint64(j21))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MetaKV) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetaKV.Merge(m, src)
+}
+func (m *MetaKV) XXX_Size() int {
+	return m.Size()
+}
+func (m *MetaKV) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetaKV.DiscardUnknown(m)
 }
 
-func (m *StreamInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MetaKV proto.InternalMessageInfo
+
+func (m *MetaKV) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *StreamInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MetaKV) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
 }
 
-func (m *StreamInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Extents) > 0 {
-		for k := range m.Extents {
-			v := m.Extents[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintPb(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i = encodeVarintPb(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.Streams) > 0 {
-		for k := range m.Streams {
-			v := m.Streams[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintPb(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i = encodeVarintPb(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.CodeDes) > 0 {
-		i -= len(m.CodeDes)
-		copy(dAtA[i:], m.CodeDes)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Code != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Code))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterEnum("pb.Code", Code_name, Code_value)
+	proto.RegisterEnum("pb.ReplicationMode", ReplicationMode_name, ReplicationMode_value)
+	proto.RegisterEnum("pb.CodecType", CodecType_name, CodecType_value)
+	proto.RegisterType((*ErasureCodec)(nil), "pb.ErasureCodec")
+	proto.RegisterType((*AppendRequestHeader)(nil), "pb.AppendRequestHeader")
+	proto.RegisterType((*AppendRequest)(nil), "pb.AppendRequest")
+	proto.RegisterType((*AppendResponse)(nil), "pb.AppendResponse")
+	proto.RegisterType((*CreateExtentRequest)(nil), "pb.CreateExtentRequest")
+	proto.RegisterType((*CreateExtentResponse)(nil), "pb.CreateExtentResponse")
+	proto.RegisterType((*ReadBlocksRequest)(nil), "pb.ReadBlocksRequest")
+	proto.RegisterType((*ReadBlockResponseHeader)(nil), "pb.ReadBlockResponseHeader")
+	proto.RegisterType((*ReadBlocksResponse)(nil), "pb.ReadBlocksResponse")
+	proto.RegisterType((*Payload)(nil), "pb.Payload")
+	proto.RegisterType((*CommitLengthRequest)(nil), "pb.CommitLengthRequest")
+	proto.RegisterType((*CommitLengthResponse)(nil), "pb.CommitLengthResponse")
+	proto.RegisterType((*DF)(nil), "pb.DF")
+	proto.RegisterType((*DfRequest)(nil), "pb.DfRequest")
+	proto.RegisterType((*DfResponse)(nil), "pb.DfResponse")
+	proto.RegisterMapType((map[uint64]*DF)(nil), "pb.DfResponse.DiskStatusEntry")
+	proto.RegisterType((*RecoveryTaskStatus)(nil), "pb.RecoveryTaskStatus")
+	proto.RegisterType((*RecoveryTask)(nil), "pb.RecoveryTask")
+	proto.RegisterType((*RequireRecoveryRequest)(nil), "pb.RequireRecoveryRequest")
+	proto.RegisterType((*RequireRecoveryResponse)(nil), "pb.RequireRecoveryResponse")
+	proto.RegisterType((*CopyResponseHeader)(nil), "pb.CopyResponseHeader")
+	proto.RegisterType((*CopyExtentRequest)(nil), "pb.CopyExtentRequest")
+	proto.RegisterType((*CopyExtentResponse)(nil), "pb.CopyExtentResponse")
+	proto.RegisterType((*ConvertExtentRequest)(nil), "pb.ConvertExtentRequest")
+	proto.RegisterType((*ConvertExtentResponse)(nil), "pb.ConvertExtentResponse")
+	proto.RegisterType((*ExtentChecksumRequest)(nil), "pb.ExtentChecksumRequest")
+	proto.RegisterType((*ExtentChecksumResponse)(nil), "pb.ExtentChecksumResponse")
+	proto.RegisterType((*WriteFragmentHeader)(nil), "pb.WriteFragmentHeader")
+	proto.RegisterType((*WriteFragmentRequest)(nil), "pb.WriteFragmentRequest")
+	proto.RegisterType((*WriteFragmentResponse)(nil), "pb.WriteFragmentResponse")
+	proto.RegisterType((*ReAvaliRequest)(nil), "pb.ReAvaliRequest")
+	proto.RegisterType((*ReAvaliResponse)(nil), "pb.ReAvaliResponse")
+	proto.RegisterType((*ReconcileRequest)(nil), "pb.ReconcileRequest")
+	proto.RegisterType((*WalStatsRequest)(nil), "pb.WalStatsRequest")
+	proto.RegisterType((*WalStats)(nil), "pb.WalStats")
+	proto.RegisterType((*WalStatsResponse)(nil), "pb.WalStatsResponse")
+	proto.RegisterType((*DeleteExtentRequest)(nil), "pb.DeleteExtentRequest")
+	proto.RegisterType((*DeleteExtentResponse)(nil), "pb.DeleteExtentResponse")
+	proto.RegisterType((*AllocExtentRequest)(nil), "pb.AllocExtentRequest")
+	proto.RegisterType((*AllocExtentResponse)(nil), "pb.AllocExtentResponse")
+	proto.RegisterType((*CheckCommitLengthRequest)(nil), "pb.CheckCommitLengthRequest")
+	proto.RegisterType((*CheckCommitLengthResponse)(nil), "pb.CheckCommitLengthResponse")
+	proto.RegisterType((*StreamAllocExtentRequest)(nil), "pb.StreamAllocExtentRequest")
+	proto.RegisterType((*StreamAllocExtentResponse)(nil), "pb.StreamAllocExtentResponse")
+	proto.RegisterType((*StreamInfoRequest)(nil), "pb.StreamInfoRequest")
+	proto.RegisterType((*StreamInfoResponse)(nil), "pb.StreamInfoResponse")
+	proto.RegisterMapType((map[uint64]*ExtentInfo)(nil), "pb.StreamInfoResponse.ExtentsEntry")
+	proto.RegisterMapType((map[uint64]*StreamInfo)(nil), "pb.StreamInfoResponse.StreamsEntry")
+	proto.RegisterType((*ExtentInfoRequest)(nil), "pb.ExtentInfoRequest")
+	proto.RegisterType((*ExtentInfoResponse)(nil), "pb.ExtentInfoResponse")
+	proto.RegisterType((*NodesInfoRequest)(nil), "pb.NodesInfoRequest")
+	proto.RegisterType((*NodesInfoResponse)(nil), "pb.NodesInfoResponse")
+	proto.RegisterMapType((map[uint64]*DecommissionProgress)(nil), "pb.NodesInfoResponse.DecommissionEntry")
+	proto.RegisterMapType((map[uint64]*NodeInfo)(nil), "pb.NodesInfoResponse.NodesEntry")
+	proto.RegisterType((*DecommissionProgress)(nil), "pb.DecommissionProgress")
+	proto.RegisterType((*DecommissionNodeRequest)(nil), "pb.DecommissionNodeRequest")
+	proto.RegisterType((*DecommissionNodeResponse)(nil), "pb.DecommissionNodeResponse")
+	proto.RegisterType((*RegisterNodeRequest)(nil), "pb.RegisterNodeRequest")
+	proto.RegisterType((*Topology)(nil), "pb.Topology")
+	proto.RegisterType((*RegisterNodeResponse)(nil), "pb.RegisterNodeResponse")
+	proto.RegisterMapType((map[string]uint64)(nil), "pb.RegisterNodeResponse.DiskUUIDsEntry")
+	proto.RegisterType((*RegisterDiskRequest)(nil), "pb.RegisterDiskRequest")
+	proto.RegisterType((*RegisterDiskResponse)(nil), "pb.RegisterDiskResponse")
+	proto.RegisterType((*CreateStreamRequest)(nil), "pb.CreateStreamRequest")
+	proto.RegisterType((*CreateStreamResponse)(nil), "pb.CreateStreamResponse")
+	proto.RegisterType((*TruncateRequest)(nil), "pb.TruncateRequest")
+	proto.RegisterType((*TruncateResponse)(nil), "pb.TruncateResponse")
+	proto.RegisterType((*ExtentReport)(nil), "pb.ExtentReport")
+	proto.RegisterType((*CopyReport)(nil), "pb.CopyReport")
+	proto.RegisterType((*InventoryRequest)(nil), "pb.InventoryRequest")
+	proto.RegisterType((*InventoryResponse)(nil), "pb.InventoryResponse")
+	proto.RegisterType((*DeleteStreamRequest)(nil), "pb.DeleteStreamRequest")
+	proto.RegisterType((*DeleteStreamResponse)(nil), "pb.DeleteStreamResponse")
+	proto.RegisterType((*MultiModifySplitRequest)(nil), "pb.MultiModifySplitRequest")
+	proto.RegisterType((*MultiModifySplitResponse)(nil), "pb.MultiModifySplitResponse")
+	proto.RegisterType((*SnapshotPartitionRequest)(nil), "pb.SnapshotPartitionRequest")
+	proto.RegisterType((*SnapshotPartitionResponse)(nil), "pb.SnapshotPartitionResponse")
+	proto.RegisterType((*RestoreSnapshotRequest)(nil), "pb.RestoreSnapshotRequest")
+	proto.RegisterType((*RestoreSnapshotResponse)(nil), "pb.RestoreSnapshotResponse")
+	proto.RegisterType((*DeleteSnapshotRequest)(nil), "pb.DeleteSnapshotRequest")
+	proto.RegisterType((*DeleteSnapshotResponse)(nil), "pb.DeleteSnapshotResponse")
+	proto.RegisterType((*StatusRequest)(nil), "pb.StatusRequest")
+	proto.RegisterType((*StatusResponse)(nil), "pb.StatusResponse")
+	proto.RegisterType((*PunchHolesRequest)(nil), "pb.PunchHolesRequest")
+	proto.RegisterType((*PunchHolesResponse)(nil), "pb.PunchHolesResponse")
+	proto.RegisterType((*ECPolicy)(nil), "pb.ECPolicy")
+	proto.RegisterType((*SetECPolicyRequest)(nil), "pb.SetECPolicyRequest")
+	proto.RegisterType((*SetECPolicyResponse)(nil), "pb.SetECPolicyResponse")
+	proto.RegisterType((*ECConversionStatusRequest)(nil), "pb.ECConversionStatusRequest")
+	proto.RegisterType((*ECConversionProgress)(nil), "pb.ECConversionProgress")
+	proto.RegisterType((*ECConversionStatusResponse)(nil), "pb.ECConversionStatusResponse")
+	proto.RegisterType((*VerifyExtentRequest)(nil), "pb.VerifyExtentRequest")
+	proto.RegisterType((*VerifyExtentResponse)(nil), "pb.VerifyExtentResponse")
+	proto.RegisterType((*MemberValue)(nil), "pb.MemberValue")
+	proto.RegisterType((*ExtentInfo)(nil), "pb.ExtentInfo")
+	proto.RegisterType((*StreamInfo)(nil), "pb.StreamInfo")
+	proto.RegisterType((*NodeInfo)(nil), "pb.NodeInfo")
+	proto.RegisterType((*DiskInfo)(nil), "pb.DiskInfo")
+	proto.RegisterType((*MetaBackup)(nil), "pb.MetaBackup")
+	proto.RegisterType((*MetaKV)(nil), "pb.MetaKV")
 }
 
-func (m *ExtentInfoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() { proto.RegisterFile("pb.proto", fileDescriptor_f80abaa17e25ccc8) }
+
+var fileDescriptor_f80abaa17e25ccc8 = []byte{
+	// 3793 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x3b, 0x4d, 0x73, 0x1c, 0x4b,
+	0x52, 0xea, 0x99, 0xd6, 0x68, 0x26, 0x47, 0x1f, 0xa3, 0xd2, 0x57, 0xbb, 0x2d, 0x2b, 0x44, 0xe3,
+	0x7d, 0x2b, 0x1c, 0x84, 0x6c, 0x6b, 0xdf, 0x7e, 0xc4, 0xc6, 0xee, 0xb2, 0xb6, 0x46, 0x5a, 0x19,
+	0x4b, 0xb6, 0x69, 0xd9, 0x7e, 0x01, 0xc1, 0xa5, 0x35, 0x5d, 0x1a, 0xf5, 0x53, 0x4f, 0x77, 0xbf,
+	0xee, 0x1a, 0xd9, 0xf3, 0x0e, 0x04, 0x10, 0x10, 0x41, 0x00, 0x41, 0x70, 0x20, 0xb8, 0x41, 0x70,
+	0x81, 0x0b, 0x37, 0xe0, 0x2f, 0x10, 0x10, 0xc1, 0x81, 0xc7, 0x8d, 0x23, 0xe1, 0x77, 0x84, 0x1b,
+	0xfc, 0x00, 0xa2, 0xbe, 0xba, 0xab, 0x3f, 0x66, 0x24, 0x7b, 0xfc, 0xde, 0x49, 0x93, 0x99, 0x5d,
+	0x59, 0x59, 0x99, 0x59, 0x59, 0x59, 0x59, 0x29, 0x68, 0x46, 0x67, 0xbb, 0x51, 0x1c, 0x92, 0x10,
+	0xd5, 0xa2, 0x33, 0xeb, 0x14, 0xe6, 0x0f, 0x62, 0x27, 0x19, 0xc6, 0x78, 0x3f, 0x74, 0x71, 0x0f,
+	0xfd, 0x12, 0xe8, 0x64, 0x14, 0x61, 0x43, 0xdb, 0xd6, 0x76, 0x16, 0xf7, 0x16, 0x76, 0xa3, 0xb3,
+	0x5d, 0x46, 0x78, 0x39, 0x8a, 0xb0, 0xcd, 0x48, 0x68, 0x1b, 0xda, 0x7e, 0xd8, 0x73, 0xfc, 0x5f,
+	0xc4, 0xe1, 0x30, 0x4a, 0x8c, 0xda, 0xb6, 0xb6, 0xb3, 0x60, 0xab, 0x28, 0xeb, 0xdf, 0x34, 0x58,
+	0x79, 0x14, 0x45, 0x38, 0x70, 0x6d, 0xfc, 0xc5, 0x10, 0x27, 0xe4, 0x08, 0x3b, 0x2e, 0x8e, 0x91,
+	0x09, 0x4d, 0xfc, 0x96, 0xe0, 0x80, 0x3c, 0xe9, 0xb2, 0x09, 0x74, 0x3b, 0x85, 0x19, 0xed, 0x0a,
+	0xc7, 0x89, 0x17, 0x06, 0x46, 0x4d, 0xd0, 0x04, 0x8c, 0xd6, 0xa1, 0xd1, 0x0b, 0x07, 0x03, 0x8f,
+	0x18, 0x75, 0x36, 0x99, 0x80, 0xe8, 0x98, 0x18, 0x5f, 0x79, 0x6c, 0x8c, 0xbe, 0xad, 0xed, 0xd4,
+	0xed, 0x14, 0xa6, 0xb4, 0xc1, 0x30, 0x21, 0xa7, 0xa3, 0xa0, 0x67, 0xcc, 0x6e, 0x6b, 0x3b, 0x4d,
+	0x3b, 0x85, 0x29, 0xbf, 0x33, 0x3f, 0xec, 0x5d, 0x26, 0x46, 0x63, 0xbb, 0x4e, 0xf9, 0x71, 0x08,
+	0xad, 0xc2, 0x6c, 0xef, 0xc2, 0xf1, 0x02, 0x63, 0x6e, 0xbb, 0xbe, 0xd3, 0xb2, 0x39, 0x60, 0x9d,
+	0xc3, 0x42, 0x6e, 0x31, 0xe8, 0x21, 0x34, 0x2e, 0xd8, 0x82, 0xd8, 0x22, 0xda, 0x7b, 0x1b, 0x54,
+	0x4b, 0x15, 0xeb, 0x3d, 0x9a, 0xb1, 0xc5, 0x87, 0xc8, 0x84, 0xb9, 0xc8, 0x19, 0xf9, 0xa1, 0xe3,
+	0xb2, 0xc5, 0xcd, 0x1f, 0xcd, 0xd8, 0x12, 0xf1, 0xb8, 0x01, 0xba, 0xeb, 0x10, 0xc7, 0x22, 0xb0,
+	0x28, 0x99, 0x24, 0x51, 0x18, 0x24, 0x18, 0x6d, 0x82, 0xde, 0x0b, 0x5d, 0x69, 0x8c, 0xa6, 0x34,
+	0x86, 0xcd, 0xb0, 0xc8, 0x80, 0x39, 0xfa, 0xb7, 0x8b, 0xb9, 0x0d, 0x5a, 0xb6, 0x04, 0x29, 0x25,
+	0x3c, 0x3f, 0x4f, 0x30, 0x49, 0x8c, 0x3a, 0x5b, 0xa0, 0x04, 0x51, 0x07, 0xea, 0x38, 0x70, 0x99,
+	0xb2, 0x16, 0x6c, 0xfa, 0xd3, 0x7a, 0x08, 0x2b, 0xfb, 0x31, 0x76, 0x08, 0x3e, 0x60, 0x96, 0x90,
+	0x6b, 0x34, 0xa1, 0x99, 0x90, 0x18, 0x3b, 0x83, 0xcc, 0x54, 0x12, 0xb6, 0x3e, 0x87, 0xd5, 0xfc,
+	0x90, 0x29, 0xc5, 0x55, 0xdd, 0xa2, 0x9e, 0x77, 0x0b, 0xeb, 0xef, 0x35, 0x58, 0xb6, 0xb1, 0xe3,
+	0x3e, 0x66, 0x16, 0x52, 0xa4, 0x1b, 0xeb, 0x48, 0xeb, 0xd0, 0xe0, 0xab, 0x15, 0x9e, 0x29, 0x20,
+	0xea, 0xb6, 0xc1, 0x70, 0xf0, 0xfc, 0x9c, 0x73, 0x12, 0x9e, 0xa4, 0xa2, 0x72, 0x2e, 0xa8, 0x17,
+	0x5c, 0xf0, 0x2e, 0x2c, 0x84, 0x81, 0x3f, 0x3a, 0x76, 0x12, 0xc2, 0xbe, 0x16, 0x3e, 0x95, 0x47,
+	0x5a, 0x7f, 0xa5, 0xc1, 0x46, 0x2a, 0xad, 0xd4, 0x8b, 0x70, 0xfe, 0x6f, 0xc1, 0x98, 0x68, 0x0b,
+	0x80, 0xb9, 0xf2, 0xa9, 0xf7, 0x25, 0x4e, 0x8c, 0x59, 0xf6, 0xb9, 0x82, 0xb1, 0x42, 0x40, 0xaa,
+	0x32, 0x85, 0xdd, 0xbe, 0x5f, 0xf0, 0xe7, 0xdb, 0x54, 0xb6, 0x31, 0xcb, 0x78, 0x4f, 0x9f, 0xbe,
+	0x03, 0x73, 0x2f, 0x38, 0x0a, 0x21, 0xd0, 0xbb, 0x0e, 0x71, 0xd8, 0x1c, 0xf3, 0x36, 0xfb, 0x6d,
+	0x39, 0xb0, 0xb2, 0xcf, 0xb6, 0xf2, 0x31, 0x0e, 0xfa, 0xe4, 0xe2, 0x26, 0xe6, 0x55, 0xf7, 0x7c,
+	0xad, 0xb0, 0xe7, 0x11, 0xe8, 0x09, 0x76, 0x7c, 0x66, 0xdb, 0xa6, 0xcd, 0x7e, 0x5b, 0xe7, 0xb0,
+	0x9a, 0x9f, 0x62, 0x4a, 0x67, 0x5d, 0x87, 0x86, 0xcf, 0x38, 0xc9, 0x58, 0xc4, 0x21, 0xeb, 0x10,
+	0x6a, 0xdd, 0x43, 0x1a, 0x41, 0x48, 0x48, 0x1c, 0x5f, 0x88, 0xcd, 0x01, 0x2a, 0xd7, 0x79, 0x8c,
+	0xb1, 0x88, 0x6b, 0xec, 0x37, 0x73, 0xd3, 0xc0, 0xf7, 0x02, 0x2c, 0xa4, 0x15, 0x90, 0x75, 0x02,
+	0xad, 0xee, 0xb9, 0x54, 0xc4, 0x27, 0x30, 0x4b, 0x9c, 0xe4, 0x32, 0x31, 0xb4, 0xed, 0xfa, 0x4e,
+	0x7b, 0xaf, 0xc3, 0x0d, 0xd3, 0x0b, 0xaf, 0x70, 0x3c, 0x7a, 0xe9, 0x24, 0x97, 0x36, 0x27, 0x53,
+	0x71, 0x5d, 0x2f, 0xb9, 0x7c, 0xd2, 0xa5, 0xe2, 0xd6, 0x77, 0x74, 0x5b, 0x82, 0xd6, 0xbf, 0x68,
+	0x00, 0xdd, 0xf3, 0x74, 0xd5, 0x7b, 0xd0, 0x74, 0xc3, 0x00, 0xd3, 0xb1, 0x86, 0xce, 0x78, 0xae,
+	0x17, 0x79, 0x9e, 0x12, 0x87, 0x0c, 0x13, 0x3b, 0xfd, 0x0e, 0xfd, 0x0c, 0xc0, 0xf5, 0x24, 0x9e,
+	0x39, 0x55, 0x7b, 0x6f, 0x8b, 0x8e, 0xca, 0xf8, 0xee, 0x76, 0xd3, 0x0f, 0x0e, 0x02, 0x12, 0x8f,
+	0x6c, 0x65, 0x84, 0x79, 0x00, 0x4b, 0x05, 0x32, 0xf5, 0xdc, 0x4b, 0x3c, 0x12, 0x4a, 0xa2, 0x3f,
+	0xd1, 0x26, 0xcc, 0x5e, 0x39, 0xfe, 0x90, 0xeb, 0xa8, 0xbd, 0xd7, 0x60, 0xfc, 0x0f, 0x6d, 0x8e,
+	0xfc, 0x71, 0xed, 0x47, 0x9a, 0xf5, 0xdb, 0x80, 0xca, 0x62, 0xa2, 0xbb, 0xa0, 0x53, 0x15, 0x08,
+	0xcf, 0x2d, 0x2b, 0x88, 0x51, 0xe9, 0xde, 0x8f, 0xb1, 0xe3, 0x8e, 0xba, 0x4c, 0x2b, 0xc2, 0x0e,
+	0x2a, 0xca, 0xfa, 0x1d, 0x98, 0x57, 0xc7, 0x4d, 0x74, 0xc1, 0x4d, 0x68, 0xc5, 0x38, 0xf2, 0x9d,
+	0x1e, 0x4e, 0x79, 0x65, 0x08, 0x6a, 0xd8, 0x20, 0x74, 0x71, 0x1a, 0xcb, 0x04, 0x44, 0x47, 0x25,
+	0xc4, 0x89, 0xc9, 0x4b, 0x6f, 0x80, 0xc5, 0x69, 0x95, 0x21, 0xac, 0x9f, 0xc1, 0x3a, 0x35, 0xba,
+	0x17, 0x63, 0x29, 0x86, 0xf4, 0x81, 0x1b, 0xad, 0xd0, 0xfa, 0x0d, 0xd8, 0x28, 0x8d, 0x9f, 0xce,
+	0xd3, 0x2d, 0x1f, 0xd0, 0x7e, 0x18, 0x8d, 0x3e, 0x52, 0x18, 0xdb, 0x02, 0x10, 0xc1, 0xe1, 0x18,
+	0x07, 0x42, 0x35, 0x0a, 0xc6, 0x7a, 0x03, 0xcb, 0x74, 0xb6, 0xd2, 0x29, 0x74, 0xc3, 0x38, 0xaf,
+	0xa7, 0x71, 0x9e, 0x06, 0x01, 0xef, 0x4b, 0x2c, 0xa6, 0x60, 0xbf, 0x27, 0x45, 0x76, 0xeb, 0x73,
+	0x40, 0xea, 0xc4, 0x42, 0x69, 0x0f, 0x0a, 0x31, 0x71, 0x9d, 0x2f, 0x34, 0x1a, 0x4d, 0x15, 0x0e,
+	0xbf, 0xd2, 0x68, 0x34, 0x0a, 0xae, 0x70, 0x4c, 0x6e, 0xbe, 0xd0, 0x49, 0x99, 0xd1, 0x26, 0xb4,
+	0x28, 0xe3, 0xd3, 0x0b, 0x27, 0x76, 0x45, 0x40, 0xca, 0x10, 0xd4, 0xed, 0x23, 0x27, 0xf6, 0xc8,
+	0x88, 0xd3, 0xf9, 0x41, 0xa1, 0xa2, 0xa8, 0xbd, 0x88, 0x13, 0xf7, 0x31, 0xe1, 0x1b, 0xbb, 0x65,
+	0x4b, 0x90, 0x86, 0x1e, 0x6a, 0xba, 0x9e, 0xd1, 0xc8, 0xfc, 0x4e, 0xcd, 0x14, 0x6d, 0x4e, 0xa6,
+	0x07, 0xf4, 0x5a, 0x61, 0x49, 0x53, 0x46, 0x58, 0x0b, 0xe6, 0xcf, 0x63, 0xa7, 0x3f, 0xc0, 0x01,
+	0x39, 0xcd, 0x0c, 0x99, 0xc3, 0xa9, 0x01, 0x4f, 0xcf, 0x05, 0x3c, 0xaa, 0x91, 0xde, 0x05, 0xee,
+	0x5d, 0x26, 0xc3, 0x81, 0x3c, 0x01, 0x33, 0x84, 0xd5, 0x87, 0x35, 0x2e, 0xe5, 0xbe, 0x40, 0x4d,
+	0x6b, 0x00, 0x9a, 0x9a, 0x3a, 0xbd, 0x0b, 0xec, 0xca, 0x30, 0xce, 0x21, 0xeb, 0x0f, 0x34, 0x58,
+	0x2f, 0xce, 0x34, 0x7d, 0x9a, 0x24, 0x17, 0x22, 0x4c, 0x9d, 0xc2, 0xca, 0xa9, 0xa4, 0xe7, 0x4e,
+	0xa5, 0x03, 0x58, 0xf9, 0x2c, 0xf6, 0x08, 0x3e, 0x14, 0xca, 0xbb, 0x41, 0x22, 0x2e, 0xf7, 0x4f,
+	0x2d, 0xdb, 0x3f, 0xd6, 0x00, 0x56, 0x73, 0x6c, 0x26, 0x66, 0xc2, 0x15, 0x13, 0xbe, 0xe7, 0x36,
+	0xe9, 0xc3, 0x5a, 0x61, 0xba, 0xe9, 0x0f, 0x6d, 0xee, 0x1f, 0x32, 0x26, 0x73, 0xc8, 0x3a, 0x82,
+	0x45, 0x1b, 0x3f, 0xba, 0x72, 0x7c, 0x6f, 0x4a, 0x3f, 0xb0, 0x9e, 0xc0, 0x52, 0xca, 0x69, 0xca,
+	0xb8, 0x7b, 0x0f, 0x3a, 0x34, 0x86, 0x07, 0x3d, 0xcf, 0xc7, 0x52, 0x2c, 0xba, 0x80, 0x78, 0x64,
+	0x0f, 0x03, 0xc6, 0xad, 0x69, 0x0b, 0xc8, 0x5a, 0x86, 0xa5, 0xcf, 0x1c, 0x9f, 0x9e, 0x85, 0x32,
+	0x37, 0xb6, 0xfe, 0x46, 0x83, 0xa6, 0xc4, 0xd1, 0x83, 0xd6, 0xf5, 0xb8, 0x75, 0x5a, 0x36, 0xfd,
+	0xc9, 0xe7, 0xa5, 0xf9, 0x50, 0x22, 0xd6, 0x20, 0x41, 0x4a, 0xc1, 0x01, 0x89, 0x3d, 0x9c, 0x08,
+	0x2d, 0x49, 0x90, 0x66, 0x35, 0x67, 0x23, 0x82, 0x13, 0x11, 0x3b, 0x39, 0x40, 0xbf, 0xf7, 0x1d,
+	0x82, 0x83, 0xde, 0x88, 0x25, 0xc3, 0x75, 0x5b, 0x82, 0x34, 0xd6, 0x0f, 0x9c, 0xb7, 0xc7, 0x82,
+	0xd8, 0x60, 0x44, 0x05, 0x63, 0xfd, 0x91, 0x06, 0x9d, 0x4c, 0xec, 0x29, 0x6d, 0xbb, 0x0d, 0xba,
+	0xeb, 0xc5, 0x3c, 0x39, 0x6e, 0xef, 0xcd, 0x33, 0x0f, 0x94, 0xbc, 0x19, 0x85, 0xda, 0xed, 0x6c,
+	0x14, 0x39, 0x49, 0x82, 0x5d, 0x19, 0xfd, 0x25, 0x4c, 0xaf, 0x3f, 0x5d, 0xec, 0xe3, 0x8a, 0xeb,
+	0xcf, 0x38, 0x37, 0xb0, 0x9e, 0xc1, 0x6a, 0x7e, 0xc8, 0x94, 0xf6, 0x7e, 0x00, 0xe8, 0x91, 0xef,
+	0x87, 0xbd, 0x9b, 0x4b, 0x80, 0x61, 0x25, 0x37, 0xe2, 0x1b, 0xda, 0x1d, 0x01, 0x18, 0x2c, 0x78,
+	0x8d, 0x49, 0xd1, 0xc7, 0xdd, 0x0f, 0x29, 0x2d, 0x7c, 0x13, 0xe0, 0xf8, 0x29, 0x1e, 0x89, 0xa9,
+	0x52, 0x38, 0x97, 0xbe, 0xd7, 0xf3, 0xe9, 0xbb, 0xf5, 0xcf, 0x1a, 0xdc, 0xaa, 0x98, 0x70, 0xca,
+	0xd5, 0xed, 0x02, 0x08, 0xc9, 0x82, 0xf3, 0x90, 0xcd, 0xd9, 0xde, 0x5b, 0xa4, 0xa3, 0x4f, 0x53,
+	0xac, 0xad, 0x7c, 0x51, 0x71, 0xab, 0xda, 0x05, 0xf0, 0x9d, 0x84, 0x1c, 0xbc, 0x65, 0x1c, 0x66,
+	0x33, 0x0e, 0x5c, 0xff, 0x9c, 0x43, 0xf6, 0x85, 0xf5, 0xbb, 0x1a, 0x18, 0x9c, 0x79, 0xb5, 0x5d,
+	0x3f, 0xb6, 0xe2, 0x2a, 0x6e, 0xf5, 0xff, 0xa8, 0xc1, 0xad, 0x0a, 0x11, 0xbe, 0x65, 0x55, 0xe6,
+	0x15, 0xa7, 0x5f, 0xab, 0xb8, 0x87, 0xb0, 0xac, 0x70, 0x12, 0x0a, 0x63, 0x79, 0x33, 0x57, 0x10,
+	0xbf, 0x07, 0xe9, 0x76, 0x86, 0xb0, 0xde, 0xd5, 0x00, 0xa9, 0x63, 0xa6, 0x5c, 0xe1, 0x4f, 0x61,
+	0x8e, 0xf3, 0x96, 0xf1, 0xe4, 0x97, 0x0b, 0xcb, 0x93, 0x17, 0x1e, 0x8e, 0x12, 0xb7, 0x1d, 0x39,
+	0x86, 0x0e, 0xe7, 0x9b, 0x34, 0x31, 0xf4, 0x89, 0xc3, 0xb9, 0x02, 0xe4, 0x70, 0x31, 0xc6, 0xfc,
+	0x75, 0x98, 0x57, 0xf9, 0x56, 0x5c, 0x93, 0xee, 0xe6, 0xaf, 0x49, 0x45, 0xe5, 0x67, 0xd7, 0x25,
+	0xca, 0x4b, 0x9d, 0xe4, 0x86, 0xbc, 0x14, 0xc3, 0x28, 0x57, 0xaf, 0xfb, 0xb0, 0xac, 0x10, 0x6e,
+	0x10, 0xa0, 0x08, 0x20, 0x75, 0xc0, 0x94, 0x46, 0xf9, 0x04, 0x1a, 0xf8, 0x6d, 0xd1, 0xe5, 0x14,
+	0xfe, 0x82, 0x6a, 0x21, 0xe8, 0x3c, 0x0b, 0x5d, 0x9c, 0x28, 0x52, 0x5a, 0xff, 0x5b, 0x83, 0x65,
+	0x05, 0x39, 0xa5, 0x24, 0x3f, 0x80, 0x59, 0x7a, 0x9b, 0x93, 0xce, 0xb1, 0x4d, 0x07, 0x96, 0xb8,
+	0x73, 0x0c, 0x37, 0x2d, 0xff, 0x1c, 0x3d, 0x85, 0x79, 0x17, 0xb3, 0x73, 0x36, 0x11, 0x77, 0x10,
+	0x3a, 0xfc, 0xbb, 0xd5, 0xc3, 0xbb, 0xca, 0x97, 0x9c, 0x4b, 0x6e, 0xb0, 0x79, 0x08, 0x90, 0xcd,
+	0x50, 0x61, 0x57, 0x2b, 0x6f, 0xd7, 0x79, 0x39, 0x4b, 0xd1, 0x43, 0x7e, 0x13, 0x96, 0x4b, 0x53,
+	0x55, 0xb0, 0xdb, 0xcd, 0xb3, 0x33, 0xd8, 0xcd, 0x5c, 0x19, 0xf7, 0x22, 0x0e, 0xfb, 0x31, 0x4e,
+	0x12, 0xd5, 0x61, 0x7e, 0x5f, 0x83, 0xd5, 0xaa, 0x6f, 0x94, 0xcb, 0xb1, 0x56, 0xbc, 0x1c, 0xc7,
+	0x78, 0xe0, 0x78, 0x81, 0x17, 0xf4, 0x45, 0xdd, 0x2e, 0x43, 0x50, 0x83, 0xc4, 0xc3, 0x80, 0xd1,
+	0x78, 0xe2, 0x2b, 0x41, 0xea, 0x84, 0xc3, 0x20, 0xc1, 0x8e, 0x8f, 0x65, 0xf8, 0x4b, 0x61, 0xeb,
+	0x09, 0x6c, 0xa8, 0x32, 0x50, 0x15, 0x28, 0xe9, 0x54, 0xa5, 0x18, 0x2c, 0x9b, 0x0f, 0x7a, 0xd8,
+	0x37, 0x6a, 0x32, 0x9b, 0xa7, 0x90, 0x65, 0x83, 0x51, 0x66, 0x35, 0xe5, 0xb1, 0xff, 0x05, 0xac,
+	0xd8, 0xb8, 0xef, 0x25, 0x04, 0xc7, 0xaa, 0x68, 0x08, 0x74, 0xc7, 0x75, 0x65, 0xca, 0xc6, 0x7e,
+	0xb3, 0x5b, 0x9e, 0x97, 0x5c, 0xbe, 0x7a, 0x25, 0x0b, 0x3c, 0x2d, 0x3b, 0x43, 0xa0, 0x1d, 0x68,
+	0x92, 0x30, 0x0a, 0xfd, 0xb0, 0x3f, 0x32, 0xea, 0x99, 0xc9, 0x5f, 0x0a, 0x9c, 0x9d, 0x52, 0xad,
+	0x43, 0x68, 0x4a, 0x2c, 0x9d, 0xe7, 0xcb, 0x30, 0xc0, 0x72, 0x1e, 0xfa, 0x9b, 0xe2, 0x62, 0xa7,
+	0x77, 0x29, 0x24, 0x65, 0xbf, 0x29, 0xee, 0x22, 0x4c, 0x78, 0xe5, 0xbd, 0x65, 0xb3, 0xdf, 0xd6,
+	0x7f, 0x6b, 0xb0, 0x9a, 0x97, 0x7d, 0xfa, 0x0c, 0x84, 0x59, 0xc0, 0xcd, 0xd5, 0x4c, 0x5c, 0x74,
+	0xa0, 0x2e, 0x5c, 0xd9, 0x34, 0x55, 0x93, 0xef, 0x76, 0xe5, 0x97, 0x7c, 0xd3, 0x64, 0x23, 0xcd,
+	0x9f, 0xc0, 0x62, 0x9e, 0xa8, 0xba, 0x79, 0x8b, 0xbb, 0xf9, 0xaa, 0xea, 0xe6, 0xba, 0xea, 0xcc,
+	0x61, 0x66, 0x28, 0xca, 0xe5, 0x3a, 0x1f, 0x32, 0xa1, 0x29, 0x67, 0x96, 0x87, 0xb8, 0x84, 0x69,
+	0x15, 0x59, 0x14, 0x8a, 0xba, 0x6a, 0xc2, 0x95, 0x47, 0xd2, 0x92, 0x65, 0x7e, 0xc2, 0x6f, 0x28,
+	0xbf, 0xfb, 0x27, 0x4d, 0xd6, 0xfe, 0xf9, 0x11, 0xa2, 0x9c, 0xb8, 0x59, 0x51, 0x41, 0xbb, 0xa6,
+	0xa8, 0x50, 0x2b, 0x17, 0x15, 0xbe, 0x4f, 0xab, 0x6d, 0x91, 0xef, 0xf5, 0x1c, 0x22, 0xb3, 0x95,
+	0xc5, 0xbd, 0x15, 0x6e, 0xb7, 0x14, 0x7d, 0x42, 0x25, 0x57, 0xbf, 0xcb, 0x2a, 0x0e, 0xfa, 0xe4,
+	0x8a, 0xc3, 0x5f, 0x6b, 0xb0, 0x9a, 0x17, 0x7b, 0xfa, 0xf3, 0x85, 0x1f, 0xe0, 0x63, 0x52, 0x1a,
+	0x41, 0xe5, 0xe7, 0x10, 0xc1, 0x01, 0x19, 0x93, 0xca, 0x08, 0xaa, 0xf5, 0x7b, 0x1a, 0x2c, 0xbd,
+	0x8c, 0x87, 0x41, 0xcf, 0x21, 0xf8, 0x86, 0x69, 0x5f, 0x7a, 0x92, 0xd6, 0xca, 0x77, 0xce, 0x34,
+	0x25, 0xac, 0x4f, 0x48, 0x09, 0x0b, 0xcf, 0x5f, 0xec, 0x8a, 0x95, 0xc9, 0x30, 0xa5, 0x82, 0x7e,
+	0x02, 0xcb, 0xc3, 0xc8, 0x75, 0x08, 0x76, 0x4f, 0xaf, 0x4b, 0xff, 0xca, 0x1f, 0x5a, 0x7f, 0xaa,
+	0xc9, 0x54, 0xc4, 0xc6, 0x51, 0x18, 0x5f, 0x5b, 0xd5, 0x73, 0xd5, 0x22, 0xad, 0x80, 0x28, 0x5e,
+	0x84, 0x79, 0x51, 0x67, 0xe1, 0xd0, 0xb8, 0xc2, 0x07, 0x5d, 0xcc, 0x20, 0x74, 0x59, 0xad, 0x55,
+	0x5c, 0x4e, 0x05, 0x68, 0xbd, 0x05, 0xe0, 0x75, 0xbc, 0x6b, 0x65, 0xb9, 0xb6, 0xce, 0x5b, 0xb5,
+	0xab, 0xd4, 0x99, 0xf5, 0xfc, 0xcc, 0x7f, 0xa9, 0x41, 0xe7, 0x49, 0x70, 0x85, 0x03, 0x12, 0xc6,
+	0xa3, 0xeb, 0xc2, 0xc8, 0xbd, 0x2c, 0x95, 0xac, 0x65, 0xc5, 0x7f, 0x55, 0x8f, 0x69, 0xde, 0x48,
+	0x1d, 0xb3, 0x17, 0x46, 0x5e, 0x9a, 0x97, 0x2c, 0x66, 0xc5, 0x4a, 0xf6, 0xa1, 0xa0, 0x2a, 0x55,
+	0x04, 0x3d, 0x57, 0x45, 0xf8, 0x0f, 0x0d, 0x96, 0x15, 0xc1, 0x3e, 0xc2, 0xeb, 0x63, 0x1c, 0x5d,
+	0x38, 0x01, 0x17, 0x47, 0xb7, 0x25, 0xc8, 0x54, 0x43, 0x4f, 0xd0, 0xa0, 0x2f, 0xab, 0x76, 0x02,
+	0x64, 0x15, 0x03, 0x2f, 0x19, 0x38, 0x84, 0x95, 0xd2, 0x66, 0x19, 0x51, 0xc1, 0xa0, 0x07, 0xd0,
+	0x4e, 0x88, 0xe3, 0xe3, 0x7d, 0xbe, 0xcc, 0x46, 0xe5, 0x32, 0xd5, 0x4f, 0x2c, 0x4f, 0x5e, 0xec,
+	0xf3, 0xb1, 0xed, 0x9b, 0xb8, 0xb7, 0xa6, 0x05, 0x81, 0x8f, 0x13, 0x8f, 0xac, 0xbf, 0xad, 0xc1,
+	0xc6, 0xc9, 0xd0, 0x27, 0xde, 0x49, 0xe8, 0x7a, 0xe7, 0xa3, 0xd3, 0xc8, 0xf7, 0x88, 0xe2, 0x2e,
+	0x91, 0x13, 0x67, 0xde, 0x2a, 0x20, 0x8a, 0x1f, 0x78, 0xae, 0x94, 0x7c, 0xde, 0x16, 0xd0, 0x87,
+	0xc6, 0x0f, 0xf4, 0x29, 0xac, 0xf9, 0x61, 0x9f, 0x2f, 0xe8, 0x94, 0x6d, 0x35, 0x7e, 0x1d, 0x67,
+	0xbb, 0x69, 0xc1, 0xae, 0x26, 0xd2, 0x51, 0x71, 0xf8, 0xa6, 0x62, 0x54, 0x83, 0x8f, 0xaa, 0x24,
+	0xa2, 0x1f, 0xc0, 0xfa, 0x00, 0x13, 0xa7, 0x62, 0xd8, 0x1c, 0x1b, 0x36, 0x86, 0x4a, 0xb3, 0xb2,
+	0xb2, 0x9a, 0xa6, 0xd4, 0xfd, 0x9f, 0xd5, 0xc0, 0x38, 0x0d, 0x9c, 0x28, 0xb9, 0x08, 0xc9, 0x0b,
+	0x27, 0x26, 0x1e, 0x3d, 0x9a, 0xae, 0x53, 0xfe, 0x87, 0xde, 0xdb, 0xc7, 0x2a, 0x59, 0xff, 0x20,
+	0x25, 0xcf, 0x7e, 0x98, 0x92, 0x1b, 0x13, 0x95, 0x9c, 0xc0, 0xad, 0x0a, 0x7d, 0x4c, 0x19, 0x22,
+	0xb6, 0x00, 0x12, 0xc1, 0x34, 0x8d, 0x9f, 0x0a, 0xc6, 0x8a, 0xe8, 0x6b, 0x58, 0x42, 0xc2, 0x18,
+	0xcb, 0xb9, 0xa5, 0x09, 0xf2, 0x23, 0xb5, 0xe2, 0xc8, 0x0f, 0xde, 0xc3, 0xec, 0xfd, 0xac, 0x30,
+	0xe3, 0x94, 0xae, 0xf4, 0x43, 0x58, 0x13, 0x61, 0xe1, 0xfd, 0xd6, 0x60, 0xbd, 0x80, 0xf5, 0xe2,
+	0xc0, 0x29, 0x45, 0x59, 0x82, 0x05, 0xf1, 0xac, 0x2b, 0xae, 0xc5, 0x5f, 0xc0, 0xa2, 0x44, 0x4c,
+	0x69, 0xca, 0xef, 0xd2, 0x03, 0x98, 0x3d, 0x01, 0xf0, 0x84, 0x60, 0x89, 0x8e, 0x3c, 0xc1, 0x83,
+	0x33, 0x1c, 0xbf, 0xa6, 0x29, 0xb4, 0x2d, 0xc8, 0xd6, 0x1f, 0x6a, 0xb0, 0xfc, 0x62, 0x18, 0xf4,
+	0x2e, 0x8e, 0x42, 0x1f, 0x27, 0x37, 0x89, 0xc7, 0x9b, 0xd0, 0x92, 0x67, 0xb1, 0x7c, 0xd7, 0xce,
+	0x10, 0x39, 0x4b, 0xeb, 0x13, 0x2c, 0x3d, 0x5b, 0xb0, 0x34, 0x01, 0xa4, 0x8a, 0xf1, 0xed, 0xe4,
+	0x8e, 0xd6, 0x1f, 0x6b, 0xd0, 0x3c, 0xd8, 0x7f, 0x11, 0xfa, 0x5e, 0x6f, 0x34, 0x75, 0x82, 0xcd,
+	0x82, 0x7d, 0xf0, 0xa8, 0x8f, 0x85, 0x1b, 0x0b, 0xe8, 0xc6, 0x19, 0xf4, 0x6b, 0x40, 0xa7, 0x98,
+	0x48, 0x71, 0x6e, 0x62, 0x8a, 0xbb, 0xd0, 0x88, 0xd8, 0xc7, 0x6a, 0x51, 0x21, 0x65, 0x20, 0x68,
+	0xd6, 0x09, 0xac, 0xe4, 0xf8, 0x4e, 0xbd, 0x81, 0x6e, 0x1d, 0xec, 0xf3, 0xb7, 0x45, 0x6a, 0xb9,
+	0x9c, 0x07, 0x4f, 0x6c, 0x50, 0xfa, 0x8b, 0x1a, 0xac, 0xaa, 0x23, 0xd3, 0xf2, 0xc3, 0xd4, 0x4b,
+	0xa4, 0xd2, 0x46, 0x38, 0x70, 0x95, 0x52, 0x84, 0x00, 0xd5, 0x22, 0x85, 0x9e, 0x2f, 0x52, 0xd0,
+	0x27, 0x49, 0x26, 0x0b, 0x61, 0xb9, 0x0d, 0x33, 0x77, 0x8a, 0xa0, 0xcf, 0x9d, 0x82, 0xc5, 0x63,
+	0xf6, 0xc6, 0xd2, 0xe0, 0xcf, 0x9d, 0x2a, 0x0e, 0x7d, 0x02, 0x8b, 0xe9, 0x00, 0xfe, 0xd5, 0x1c,
+	0xfb, 0xaa, 0x80, 0xa5, 0x33, 0xb1, 0x72, 0x6a, 0x1c, 0x87, 0xb1, 0xd1, 0x64, 0xda, 0xcc, 0x10,
+	0xd4, 0x07, 0xcd, 0x2a, 0x85, 0x4e, 0xb9, 0x05, 0x3e, 0x85, 0x66, 0x24, 0x14, 0x2c, 0xf2, 0x4f,
+	0x83, 0xab, 0xae, 0x6c, 0x00, 0x3b, 0xfd, 0x92, 0x3e, 0xbc, 0xbc, 0xc6, 0xb1, 0x77, 0x7e, 0xf3,
+	0x17, 0x7f, 0xeb, 0x1f, 0x34, 0x58, 0xcd, 0x8f, 0x99, 0x52, 0xf2, 0xdc, 0x5b, 0x31, 0x15, 0xbd,
+	0xae, 0xbc, 0x15, 0x73, 0xb3, 0xc5, 0xf1, 0x30, 0x22, 0x69, 0x71, 0x29, 0x43, 0x28, 0x97, 0xc1,
+	0xd9, 0x89, 0x97, 0xc1, 0xa7, 0xd0, 0x56, 0xa2, 0x21, 0x5a, 0x84, 0x5a, 0xba, 0xb2, 0x1a, 0x7f,
+	0x6d, 0x7d, 0xe6, 0x0c, 0xb0, 0x2c, 0xb9, 0xd0, 0xdf, 0x54, 0xe0, 0x5f, 0xc4, 0x51, 0xef, 0x95,
+	0x7d, 0x2c, 0x52, 0x36, 0x09, 0x5a, 0xef, 0xea, 0x00, 0xd9, 0x1c, 0x13, 0x2f, 0x2f, 0x5b, 0x00,
+	0xf2, 0x72, 0x8d, 0x65, 0xf4, 0x54, 0x30, 0x22, 0x97, 0xf1, 0xc8, 0x48, 0x24, 0xe9, 0x02, 0x9a,
+	0xd8, 0x04, 0x47, 0xeb, 0x43, 0xf8, 0x3c, 0x61, 0x2b, 0xd6, 0x6d, 0xf6, 0x9b, 0xba, 0x6f, 0x29,
+	0x9b, 0xd0, 0xed, 0x1c, 0x8e, 0xd6, 0x56, 0x1c, 0xfa, 0x34, 0x2a, 0xf2, 0x39, 0x0e, 0x50, 0xa7,
+	0x4e, 0xe5, 0xa1, 0x75, 0x8e, 0xc4, 0x68, 0x32, 0x49, 0x0a, 0x58, 0xde, 0x39, 0x42, 0x65, 0xa3,
+	0xa0, 0xd1, 0xe2, 0x2b, 0xc9, 0x30, 0xa5, 0x7e, 0x01, 0xa8, 0xe8, 0x17, 0xa0, 0x47, 0x2e, 0x93,
+	0x88, 0xdd, 0xcb, 0xda, 0xfc, 0x45, 0x32, 0xc3, 0x64, 0x91, 0x73, 0x7e, 0x62, 0xe4, 0xcc, 0x7b,
+	0xcc, 0x42, 0xa1, 0xbb, 0x80, 0x4a, 0x22, 0x81, 0x13, 0xda, 0xb2, 0xb3, 0xc8, 0x96, 0x9b, 0xc3,
+	0xd1, 0xe8, 0xee, 0xb2, 0xc3, 0x9d, 0x8b, 0xb2, 0xc4, 0x44, 0x51, 0x51, 0xd6, 0xbf, 0x6b, 0x00,
+	0xd9, 0x09, 0x32, 0xc5, 0x09, 0xf9, 0x81, 0x75, 0x98, 0x1d, 0x68, 0xe2, 0x1e, 0x0f, 0x7b, 0x86,
+	0x5e, 0x11, 0x0a, 0x53, 0x6a, 0xa6, 0xb5, 0xd9, 0xc9, 0xe7, 0xcd, 0xdf, 0x69, 0xd0, 0x94, 0x15,
+	0xe8, 0xb1, 0x17, 0x5e, 0x03, 0xe6, 0x68, 0xb1, 0x13, 0x27, 0xe9, 0x36, 0x15, 0x20, 0x75, 0x1f,
+	0x97, 0xf9, 0x07, 0xf7, 0x54, 0x0e, 0xa0, 0x1d, 0x58, 0x52, 0xcb, 0xe2, 0x32, 0xee, 0x36, 0xed,
+	0x22, 0x3a, 0x57, 0x20, 0x9d, 0x9d, 0x58, 0x20, 0x7d, 0x06, 0x4d, 0x56, 0x83, 0x13, 0x72, 0x8a,
+	0xfb, 0xbd, 0x56, 0xac, 0x44, 0x88, 0xc6, 0xbd, 0x9a, 0xda, 0xb8, 0x47, 0x37, 0xc7, 0x70, 0xe8,
+	0xb9, 0xb2, 0x50, 0x4a, 0x7f, 0xd3, 0x3a, 0x38, 0x9c, 0x60, 0xe2, 0x3c, 0x76, 0x7a, 0x97, 0xc3,
+	0x88, 0x2e, 0x51, 0x6e, 0x2d, 0x7e, 0xea, 0x4b, 0x70, 0x62, 0x57, 0xe3, 0x36, 0xb4, 0x7b, 0xac,
+	0xdc, 0xc5, 0x3d, 0x86, 0x1f, 0xf9, 0x2a, 0x0a, 0x6d, 0x42, 0xfd, 0xf2, 0x4a, 0x16, 0x48, 0x81,
+	0x27, 0x60, 0xc4, 0x79, 0xfa, 0xda, 0xa6, 0x68, 0xeb, 0x01, 0x34, 0x38, 0x78, 0x5d, 0xd5, 0x73,
+	0x5e, 0x54, 0x3d, 0xef, 0xfd, 0x89, 0x06, 0x3a, 0x35, 0x20, 0x6a, 0x40, 0xed, 0xf9, 0xd3, 0xce,
+	0x0c, 0x6a, 0xc1, 0xec, 0x81, 0x6d, 0x3f, 0xb7, 0x3b, 0x1a, 0x5a, 0x82, 0xf6, 0x41, 0xe0, 0x3e,
+	0x3f, 0xe7, 0x61, 0xa8, 0x53, 0x63, 0x88, 0xd7, 0x7c, 0x19, 0xc7, 0xe1, 0x9b, 0x8e, 0x8e, 0x16,
+	0xa0, 0xf5, 0x2c, 0x24, 0xc7, 0x07, 0x8f, 0xba, 0x07, 0x76, 0x67, 0x16, 0x2d, 0xc3, 0xc2, 0x71,
+	0xd8, 0xbb, 0xa4, 0x47, 0xd4, 0x73, 0x72, 0x81, 0xe3, 0x4e, 0x03, 0x6d, 0x81, 0xb9, 0xef, 0x7b,
+	0x74, 0x6f, 0x32, 0x47, 0x16, 0xa3, 0x5f, 0x86, 0xe1, 0x91, 0xd7, 0xbf, 0xe8, 0xcc, 0xa1, 0x79,
+	0xea, 0x2e, 0xe4, 0x30, 0x1c, 0x06, 0x6e, 0xa7, 0x79, 0xef, 0x57, 0x69, 0x6b, 0x45, 0xce, 0x5f,
+	0xd1, 0x22, 0xc0, 0x31, 0xcb, 0x2a, 0x7d, 0x9c, 0x24, 0x5c, 0xbe, 0x7d, 0xda, 0xab, 0xdd, 0xd1,
+	0xee, 0x7d, 0x07, 0x5a, 0x69, 0xc3, 0x3a, 0x95, 0xcd, 0xc6, 0xd8, 0x3d, 0x0d, 0xfd, 0x70, 0x10,
+	0x06, 0x9d, 0x19, 0x34, 0x07, 0xf5, 0x63, 0x7b, 0xbf, 0xa3, 0xed, 0xfd, 0xdf, 0x1c, 0x2c, 0xf0,
+	0x25, 0x9c, 0xe2, 0xf8, 0xca, 0xeb, 0x61, 0xf4, 0x3d, 0x68, 0xf0, 0xf6, 0x6b, 0xb4, 0x5c, 0xea,
+	0xe7, 0x36, 0x91, 0x8a, 0xe2, 0xa7, 0x8e, 0x35, 0xb3, 0xa3, 0xa1, 0x1f, 0x41, 0x9b, 0x4d, 0xfc,
+	0xfe, 0x23, 0x7f, 0x0d, 0x20, 0x6b, 0xc5, 0x45, 0x6b, 0xb9, 0x96, 0x5b, 0x99, 0xe4, 0x98, 0xeb,
+	0x45, 0xb4, 0x64, 0xf0, 0x40, 0x43, 0x9f, 0xc2, 0x9c, 0xe8, 0x38, 0x41, 0x88, 0x7f, 0xa6, 0x36,
+	0xb2, 0x98, 0x2b, 0x39, 0x9c, 0x1c, 0x47, 0xa7, 0xcd, 0xba, 0xdd, 0xf8, 0xb4, 0xa5, 0xb6, 0x3b,
+	0x73, 0xbd, 0x88, 0x56, 0xa6, 0xfd, 0x0e, 0xd4, 0xba, 0xe7, 0x68, 0x41, 0xf6, 0x7f, 0xf2, 0x01,
+	0x8b, 0xf9, 0x76, 0x50, 0x6b, 0x06, 0x1d, 0xc3, 0x52, 0xa1, 0x1f, 0x11, 0x99, 0x5c, 0xa2, 0xaa,
+	0x26, 0x47, 0xf3, 0x76, 0x25, 0x2d, 0xe5, 0xb6, 0x0f, 0xf3, 0x6a, 0x4f, 0x00, 0xda, 0xe0, 0x02,
+	0x96, 0xda, 0x12, 0x4c, 0xa3, 0x4c, 0x48, 0x99, 0xfc, 0x0a, 0xb4, 0x8e, 0xb0, 0x13, 0x93, 0x33,
+	0xec, 0x10, 0xd4, 0xa6, 0x1f, 0x8a, 0xd6, 0x64, 0x53, 0x05, 0xd8, 0x22, 0x7f, 0x0e, 0x6d, 0xe5,
+	0xdd, 0x1c, 0x31, 0x7d, 0x94, 0xdf, 0xf2, 0xcd, 0x8d, 0x12, 0x3e, 0x9d, 0xec, 0x10, 0x16, 0x72,
+	0x5d, 0x71, 0x48, 0x48, 0x56, 0xee, 0xfd, 0x33, 0x6f, 0x55, 0x50, 0x52, 0x3e, 0x47, 0xb0, 0x90,
+	0x6b, 0x85, 0xe2, 0x7c, 0xaa, 0x9a, 0xb1, 0xcc, 0x5b, 0x15, 0x14, 0xc5, 0xe1, 0x9e, 0xc0, 0x62,
+	0xbe, 0x21, 0x0d, 0xdd, 0xca, 0x52, 0x96, 0x42, 0x3b, 0x9c, 0x69, 0x56, 0x91, 0x54, 0x73, 0xa8,
+	0x1d, 0x30, 0xdc, 0x1c, 0x15, 0x6d, 0x34, 0xa6, 0x51, 0x26, 0xa4, 0x4c, 0x7e, 0x0c, 0xad, 0xb4,
+	0xcd, 0x09, 0xad, 0xca, 0xb6, 0x56, 0xb5, 0xeb, 0xc9, 0x64, 0xee, 0x59, 0x2a, 0x4c, 0x5a, 0x33,
+	0xe8, 0x87, 0x4a, 0x8b, 0xd3, 0x4a, 0xae, 0xe3, 0x47, 0x8c, 0x5c, 0xcd, 0x23, 0xe5, 0xc0, 0xbd,
+	0xff, 0x69, 0xc3, 0x2a, 0x0f, 0x3a, 0x27, 0x4e, 0xe0, 0xf4, 0x71, 0x2c, 0x77, 0xff, 0x4f, 0x73,
+	0x67, 0xee, 0x5a, 0xf1, 0xd9, 0x5e, 0xd9, 0x17, 0xe5, 0xd7, 0x7c, 0x6b, 0x86, 0x0e, 0x57, 0xf2,
+	0xb2, 0xb5, 0x42, 0x2e, 0xa8, 0x0e, 0x2f, 0xbf, 0x8b, 0x73, 0x5d, 0xa4, 0xef, 0xc0, 0x5c, 0x17,
+	0xc5, 0x87, 0x6c, 0x73, 0xad, 0x80, 0x4d, 0xc7, 0x3e, 0x84, 0x86, 0xe8, 0x85, 0x5e, 0xe6, 0xe2,
+	0x29, 0xb7, 0x24, 0x13, 0xa9, 0x28, 0xd5, 0x7e, 0x6a, 0x1e, 0xcd, 0xed, 0x57, 0x91, 0x8d, 0x9b,
+	0x46, 0x99, 0x90, 0x32, 0xb1, 0x61, 0xb9, 0xd4, 0xac, 0x83, 0x36, 0x99, 0x2f, 0x8f, 0x69, 0x1a,
+	0x32, 0xef, 0x8c, 0xa1, 0xaa, 0x3c, 0x4b, 0x5d, 0x2b, 0x9c, 0xe7, 0xb8, 0x7e, 0x1a, 0xf3, 0xce,
+	0x18, 0xaa, 0xb2, 0xd8, 0x0e, 0x27, 0x67, 0xb7, 0x7e, 0x6e, 0xa0, 0x52, 0x31, 0xc2, 0x5c, 0x2f,
+	0xa2, 0x73, 0x01, 0x48, 0x79, 0x72, 0x12, 0x01, 0xa8, 0xfc, 0x76, 0x66, 0x1a, 0x65, 0x82, 0xca,
+	0x44, 0x7d, 0xb8, 0xe4, 0x4c, 0x2a, 0xde, 0x80, 0x4d, 0xa3, 0x4c, 0xa8, 0x62, 0xc2, 0xb2, 0xdf,
+	0x1c, 0x13, 0xe5, 0x7d, 0xd2, 0x34, 0xca, 0x84, 0x94, 0xc9, 0x73, 0xe8, 0x14, 0xdf, 0xb3, 0xd1,
+	0xed, 0xe2, 0xc3, 0xbe, 0x2a, 0xd1, 0x66, 0x35, 0x51, 0xdd, 0x90, 0xf2, 0xb5, 0x89, 0x6f, 0xc8,
+	0xc2, 0xfb, 0x97, 0xb9, 0x9a, 0x47, 0x96, 0x43, 0x89, 0xaa, 0xd8, 0x8a, 0xc2, 0xbd, 0x69, 0x94,
+	0x09, 0x29, 0x93, 0x9f, 0xb3, 0x0c, 0x21, 0x8c, 0x49, 0x1a, 0x2b, 0xf8, 0x26, 0x2a, 0x3e, 0xb6,
+	0x8c, 0x0f, 0x28, 0xcf, 0xa1, 0x53, 0x2c, 0x25, 0x73, 0x85, 0x8c, 0xa9, 0xc3, 0x9b, 0x9b, 0xd5,
+	0xc4, 0x9c, 0x27, 0x17, 0xcb, 0xa6, 0xc2, 0x93, 0xc7, 0x54, 0x97, 0xcd, 0x3b, 0x63, 0xa8, 0xf9,
+	0x33, 0x35, 0x57, 0xa3, 0x94, 0x67, 0x6a, 0x55, 0xa9, 0xd4, 0xbc, 0x5d, 0x49, 0x4b, 0xb9, 0x3d,
+	0x81, 0xc5, 0x7c, 0x95, 0x91, 0x9f, 0x07, 0x95, 0x25, 0x4b, 0xd3, 0xac, 0x22, 0x29, 0xfa, 0x6f,
+	0x2b, 0x75, 0x1f, 0x7e, 0x5c, 0x96, 0x0b, 0x4c, 0xe6, 0x46, 0x09, 0x9f, 0x72, 0x78, 0x05, 0xa8,
+	0x5c, 0x99, 0x40, 0x77, 0x8a, 0x75, 0x84, 0x7c, 0x70, 0xdb, 0x1a, 0x47, 0x96, 0x6c, 0x1f, 0x77,
+	0xff, 0xf5, 0xdd, 0x96, 0xf6, 0xd5, 0xbb, 0x2d, 0xed, 0xbf, 0xde, 0x6d, 0x69, 0x7f, 0xfe, 0xf5,
+	0xd6, 0xcc, 0x57, 0x5f, 0x6f, 0xcd, 0xfc, 0xe7, 0xd7, 0x5b, 0x33, 0xbf, 0x75, 0xaf, 0xef, 0x91,
+	0x8b, 0xe1, 0xd9, 0x6e, 0x2f, 0x1c, 0xdc, 0xff, 0x3c, 0x1c, 0xc6, 0x01, 0x1e, 0x0d, 0x3c, 0x37,
+	0xf0, 0xfa, 0x17, 0xe4, 0xbe, 0x33, 0x24, 0xc3, 0x41, 0x70, 0x9f, 0xfd, 0x93, 0xe4, 0xfd, 0xe8,
+	0xec, 0xac, 0xc1, 0x7e, 0x7d, 0xef, 0xff, 0x07, 0x00, 0x27, 0x3f, 0xbf, 0xcf, 0x3a, 0x39, 0x00,
+	0x00,
 }
 
-func (m *ExtentInfoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ExtentServiceClient is the client API for ExtentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ExtentServiceClient interface {
+	//from stream client
+	Append(ctx context.Context, opts ...grpc.CallOption) (ExtentService_AppendClient, error)
+	//append blocks and forward them to header.chain, response after all nodes in chain are done
+	ChainAppend(ctx context.Context, opts ...grpc.CallOption) (ExtentService_ChainAppendClient, error)
+	//rpc ReadEntries(ReadEntriesRequest) returns (ReadEntriesResponse){}
+	//rpc SmartReadBlocks(ReadBlocksRequest) returns (ReadBlocksResponse){}
+	ReadBlocks(ctx context.Context, in *ReadBlocksRequest, opts ...grpc.CallOption) (ExtentService_ReadBlocksClient, error)
+	//internal rpc
+	ReAvali(ctx context.Context, in *ReAvaliRequest, opts ...grpc.CallOption) (*ReAvaliResponse, error)
+	CopyExtent(ctx context.Context, in *CopyExtentRequest, opts ...grpc.CallOption) (ExtentService_CopyExtentClient, error)
+	Df(ctx context.Context, in *DfRequest, opts ...grpc.CallOption) (*DfResponse, error)
+	RequireRecovery(ctx context.Context, in *RequireRecoveryRequest, opts ...grpc.CallOption) (*RequireRecoveryResponse, error)
+	CommitLength(ctx context.Context, in *CommitLengthRequest, opts ...grpc.CallOption) (*CommitLengthResponse, error)
+	Heartbeat(ctx context.Context, in *Payload, opts ...grpc.CallOption) (ExtentService_HeartbeatClient, error)
+	//rpc ReplicateBlocks(ReplicateBlocksRequest) returns (ReplicateBlocksResponse) {}
+	AllocExtent(ctx context.Context, in *AllocExtentRequest, opts ...grpc.CallOption) (*AllocExtentResponse, error)
+	ConvertExtent(ctx context.Context, in *ConvertExtentRequest, opts ...grpc.CallOption) (*ConvertExtentResponse, error)
+	WriteFragment(ctx context.Context, opts ...grpc.CallOption) (ExtentService_WriteFragmentClient, error)
+	ExtentChecksum(ctx context.Context, in *ExtentChecksumRequest, opts ...grpc.CallOption) (*ExtentChecksumResponse, error)
+	//remove an extent whose refs is 0
+	DeleteExtent(ctx context.Context, in *DeleteExtentRequest, opts ...grpc.CallOption) (*DeleteExtentResponse, error)
+	//compare extents on node with stream manager, orphans are quarantined
+	Reconcile(ctx context.Context, in *ReconcileRequest, opts ...grpc.CallOption) (*InventoryResponse, error)
+	//stats of wal group commits
+	WalStats(ctx context.Context, in *WalStatsRequest, opts ...grpc.CallOption) (*WalStatsResponse, error)
 }
 
-func (m *ExtentInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ExtentID != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ExtentID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+type extentServiceClient struct {
+	cc *grpc.ClientConn
 }
 
-func (m *ExtentInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func NewExtentServiceClient(cc *grpc.ClientConn) ExtentServiceClient {
+	return &extentServiceClient{cc}
+}
+
+func (c *extentServiceClient) Append(ctx context.Context, opts ...grpc.CallOption) (ExtentService_AppendClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ExtentService_serviceDesc.Streams[0], "/pb.ExtentService/Append", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &extentServiceAppendClient{stream}
+	return x, nil
 }
 
-func (m *ExtentInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ExtentService_AppendClient interface {
+	Send(*AppendRequest) error
+	CloseAndRecv() (*AppendResponse, error)
+	grpc.ClientStream
 }
 
-func (m *ExtentInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ExInfo != nil {
-		{
-			size, err := m.ExInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.CodeDes) > 0 {
-		i -= len(m.CodeDes)
-		copy(dAtA[i:], m.CodeDes)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
-		i--
-		dAtA[i] = 0x12
+type extentServiceAppendClient struct {
+	grpc.ClientStream
+}
+
+func (x *extentServiceAppendClient) Send(m *AppendRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *extentServiceAppendClient) CloseAndRecv() (*AppendResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	if m.Code != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Code))
-		i--
-		dAtA[i] = 0x8
+	m := new(AppendResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return m, nil
 }
 
-func (m *NodesInfoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *extentServiceClient) ChainAppend(ctx context.Context, opts ...grpc.CallOption) (ExtentService_ChainAppendClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ExtentService_serviceDesc.Streams[1], "/pb.ExtentService/ChainAppend", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &extentServiceChainAppendClient{stream}
+	return x, nil
 }
 
-func (m *NodesInfoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ExtentService_ChainAppendClient interface {
+	Send(*AppendRequest) error
+	CloseAndRecv() (*AppendResponse, error)
+	grpc.ClientStream
 }
 
-func (m *NodesInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type extentServiceChainAppendClient struct {
+	grpc.ClientStream
 }
 
-func (m *NodesInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (x *extentServiceChainAppendClient) Send(m *AppendRequest) error {
+	return x.ClientStream.SendMsg(m)
 }
 
-func (m *NodesInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (x *extentServiceChainAppendClient) CloseAndRecv() (*AppendResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(AppendResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-func (m *NodesInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Nodes) > 0 {
-		for k := range m.Nodes {
-			v := m.Nodes[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintPb(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i = encodeVarintPb(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1a
-		}
+func (c *extentServiceClient) ReadBlocks(ctx context.Context, in *ReadBlocksRequest, opts ...grpc.CallOption) (ExtentService_ReadBlocksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ExtentService_serviceDesc.Streams[2], "/pb.ExtentService/ReadBlocks", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.CodeDes) > 0 {
-		i -= len(m.CodeDes)
-		copy(dAtA[i:], m.CodeDes)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
-		i--
-		dAtA[i] = 0x12
+	x := &extentServiceReadBlocksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if m.Code != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Code))
-		i--
-		dAtA[i] = 0x8
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return x, nil
 }
 
-func (m *RegisterNodeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type ExtentService_ReadBlocksClient interface {
+	Recv() (*ReadBlocksResponse, error)
+	grpc.ClientStream
 }
 
-func (m *RegisterNodeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type extentServiceReadBlocksClient struct {
+	grpc.ClientStream
 }
 
-func (m *RegisterNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DiskUUIDs) > 0 {
-		for iNdEx := len(m.DiskUUIDs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.DiskUUIDs[iNdEx])
-			copy(dAtA[i:], m.DiskUUIDs[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.DiskUUIDs[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+func (x *extentServiceReadBlocksClient) Recv() (*ReadBlocksResponse, error) {
+	m := new(ReadBlocksResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	if len(m.Addr) > 0 {
-		i -= len(m.Addr)
-		copy(dAtA[i:], m.Addr)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Addr)))
-		i--
-		dAtA[i] = 0xa
+	return m, nil
+}
+
+func (c *extentServiceClient) ReAvali(ctx context.Context, in *ReAvaliRequest, opts ...grpc.CallOption) (*ReAvaliResponse, error) {
+	out := new(ReAvaliResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExtentService/ReAvali", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *RegisterNodeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *extentServiceClient) CopyExtent(ctx context.Context, in *CopyExtentRequest, opts ...grpc.CallOption) (ExtentService_CopyExtentClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ExtentService_serviceDesc.Streams[3], "/pb.ExtentService/CopyExtent", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &extentServiceCopyExtentClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (m *RegisterNodeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ExtentService_CopyExtentClient interface {
+	Recv() (*CopyExtentResponse, error)
+	grpc.ClientStream
 }
 
-func (m *RegisterNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DiskUUIDs) > 0 {
-		for k := range m.DiskUUIDs {
-			v := m.DiskUUIDs[k]
-			baseI := i
-			i = encodeVarintPb(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintPb(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x22
-		}
+type extentServiceCopyExtentClient struct {
+	grpc.ClientStream
+}
+
+func (x *extentServiceCopyExtentClient) Recv() (*CopyExtentResponse, error) {
+	m := new(CopyExtentResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	if m.NodeId != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.NodeId))
-		i--
-		dAtA[i] = 0x18
+	return m, nil
+}
+
+func (c *extentServiceClient) Df(ctx context.Context, in *DfRequest, opts ...grpc.CallOption) (*DfResponse, error) {
+	out := new(DfResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExtentService/Df", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.CodeDes) > 0 {
-		i -= len(m.CodeDes)
-		copy(dAtA[i:], m.CodeDes)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
-		i--
-		dAtA[i] = 0x12
+	return out, nil
+}
+
+func (c *extentServiceClient) RequireRecovery(ctx context.Context, in *RequireRecoveryRequest, opts ...grpc.CallOption) (*RequireRecoveryResponse, error) {
+	out := new(RequireRecoveryResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExtentService/RequireRecovery", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Code != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Code))
-		i--
-		dAtA[i] = 0x8
+	return out, nil
+}
+
+func (c *extentServiceClient) CommitLength(ctx context.Context, in *CommitLengthRequest, opts ...grpc.CallOption) (*CommitLengthResponse, error) {
+	out := new(CommitLengthResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExtentService/CommitLength", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *CreateStreamRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *extentServiceClient) Heartbeat(ctx context.Context, in *Payload, opts ...grpc.CallOption) (ExtentService_HeartbeatClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ExtentService_serviceDesc.Streams[4], "/pb.ExtentService/Heartbeat", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &extentServiceHeartbeatClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (m *CreateStreamRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ExtentService_HeartbeatClient interface {
+	Recv() (*Payload, error)
+	grpc.ClientStream
 }
 
-func (m *CreateStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ParityShard != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ParityShard))
-		i--
-		dAtA[i] = 0x10
+type extentServiceHeartbeatClient struct {
+	grpc.ClientStream
+}
+
+func (x *extentServiceHeartbeatClient) Recv() (*Payload, error) {
+	m := new(Payload)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	if m.DataShard != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.DataShard))
-		i--
-		dAtA[i] = 0x8
+	return m, nil
+}
+
+func (c *extentServiceClient) AllocExtent(ctx context.Context, in *AllocExtentRequest, opts ...grpc.CallOption) (*AllocExtentResponse, error) {
+	out := new(AllocExtentResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExtentService/AllocExtent", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *CreateStreamResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *extentServiceClient) ConvertExtent(ctx context.Context, in *ConvertExtentRequest, opts ...grpc.CallOption) (*ConvertExtentResponse, error) {
+	out := new(ConvertExtentResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExtentService/ConvertExtent", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *CreateStreamResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *extentServiceClient) WriteFragment(ctx context.Context, opts ...grpc.CallOption) (ExtentService_WriteFragmentClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ExtentService_serviceDesc.Streams[5], "/pb.ExtentService/WriteFragment", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &extentServiceWriteFragmentClient{stream}
+	return x, nil
 }
 
-func (m *CreateStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Extent != nil {
-		{
-			size, err := m.Extent.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Stream != nil {
-		{
-			size, err := m.Stream.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.CodeDes) > 0 {
-		i -= len(m.CodeDes)
-		copy(dAtA[i:], m.CodeDes)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Code != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Code))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+type ExtentService_WriteFragmentClient interface {
+	Send(*WriteFragmentRequest) error
+	CloseAndRecv() (*WriteFragmentResponse, error)
+	grpc.ClientStream
 }
 
-func (m *TruncateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type extentServiceWriteFragmentClient struct {
+	grpc.ClientStream
 }
 
-func (m *TruncateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (x *extentServiceWriteFragmentClient) Send(m *WriteFragmentRequest) error {
+	return x.ClientStream.SendMsg(m)
 }
 
-func (m *TruncateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Revision != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Revision))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.OwnerKey) > 0 {
-		i -= len(m.OwnerKey)
-		copy(dAtA[i:], m.OwnerKey)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.OwnerKey)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.ExtentID != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ExtentID))
-		i--
-		dAtA[i] = 0x10
+func (x *extentServiceWriteFragmentClient) CloseAndRecv() (*WriteFragmentResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	if m.StreamID != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.StreamID))
-		i--
-		dAtA[i] = 0x8
+	m := new(WriteFragmentResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return m, nil
 }
 
-func (m *TruncateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *extentServiceClient) ExtentChecksum(ctx context.Context, in *ExtentChecksumRequest, opts ...grpc.CallOption) (*ExtentChecksumResponse, error) {
+	out := new(ExtentChecksumResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExtentService/ExtentChecksum", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TruncateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TruncateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UpdatedStreamInfo != nil {
-		{
-			size, err := m.UpdatedStreamInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.CodeDes) > 0 {
-		i -= len(m.CodeDes)
-		copy(dAtA[i:], m.CodeDes)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Code != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Code))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MultiModifySplitRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *extentServiceClient) DeleteExtent(ctx context.Context, in *DeleteExtentRequest, opts ...grpc.CallOption) (*DeleteExtentResponse, error) {
+	out := new(DeleteExtentResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExtentService/DeleteExtent", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MultiModifySplitRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MultiModifySplitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MetaStreamSealedLength != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.MetaStreamSealedLength))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.RowStreamSealedLength != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.RowStreamSealedLength))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.LogStreamSealedLength != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.LogStreamSealedLength))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Revision != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Revision))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.OwnerKey) > 0 {
-		i -= len(m.OwnerKey)
-		copy(dAtA[i:], m.OwnerKey)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.OwnerKey)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.MidKey) > 0 {
-		i -= len(m.MidKey)
-		copy(dAtA[i:], m.MidKey)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.MidKey)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.PartID != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.PartID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MultiModifySplitResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *extentServiceClient) Reconcile(ctx context.Context, in *ReconcileRequest, opts ...grpc.CallOption) (*InventoryResponse, error) {
+	out := new(InventoryResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExtentService/Reconcile", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MultiModifySplitResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MultiModifySplitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CodeDes) > 0 {
-		i -= len(m.CodeDes)
-		copy(dAtA[i:], m.CodeDes)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Code != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Code))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *StatusRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *extentServiceClient) WalStats(ctx context.Context, in *WalStatsRequest, opts ...grpc.CallOption) (*WalStatsResponse, error) {
+	out := new(WalStatsResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExtentService/WalStats", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *StatusRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ExtentServiceServer is the server API for ExtentService service.
+type ExtentServiceServer interface {
+	//from stream client
+	Append(ExtentService_AppendServer) error
+	//append blocks and forward them to header.chain, response after all nodes in chain are done
+	ChainAppend(ExtentService_ChainAppendServer) error
+	//rpc ReadEntries(ReadEntriesRequest) returns (ReadEntriesResponse){}
+	//rpc SmartReadBlocks(ReadBlocksRequest) returns (ReadBlocksResponse){}
+	ReadBlocks(*ReadBlocksRequest, ExtentService_ReadBlocksServer) error
+	//internal rpc
+	ReAvali(context.Context, *ReAvaliRequest) (*ReAvaliResponse, error)
+	CopyExtent(*CopyExtentRequest, ExtentService_CopyExtentServer) error
+	Df(context.Context, *DfRequest) (*DfResponse, error)
+	RequireRecovery(context.Context, *RequireRecoveryRequest) (*RequireRecoveryResponse, error)
+	CommitLength(context.Context, *CommitLengthRequest) (*CommitLengthResponse, error)
+	Heartbeat(*Payload, ExtentService_HeartbeatServer) error
+	//rpc ReplicateBlocks(ReplicateBlocksRequest) returns (ReplicateBlocksResponse) {}
+	AllocExtent(context.Context, *AllocExtentRequest) (*AllocExtentResponse, error)
+	ConvertExtent(context.Context, *ConvertExtentRequest) (*ConvertExtentResponse, error)
+	WriteFragment(ExtentService_WriteFragmentServer) error
+	ExtentChecksum(context.Context, *ExtentChecksumRequest) (*ExtentChecksumResponse, error)
+	//remove an extent whose refs is 0
+	DeleteExtent(context.Context, *DeleteExtentRequest) (*DeleteExtentResponse, error)
+	//compare extents on node with stream manager, orphans are quarantined
+	Reconcile(context.Context, *ReconcileRequest) (*InventoryResponse, error)
+	//stats of wal group commits
+	WalStats(context.Context, *WalStatsRequest) (*WalStatsResponse, error)
 }
 
-func (m *StatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// UnimplementedExtentServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedExtentServiceServer struct {
 }
 
-func (m *StatusResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedExtentServiceServer) Append(srv ExtentService_AppendServer) error {
+	return status.Errorf(codes.Unimplemented, "method Append not implemented")
 }
-
-func (m *StatusResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedExtentServiceServer) ChainAppend(srv ExtentService_ChainAppendServer) error {
+	return status.Errorf(codes.Unimplemented, "method ChainAppend not implemented")
 }
-
-func (m *StatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CodeDes) > 0 {
-		i -= len(m.CodeDes)
-		copy(dAtA[i:], m.CodeDes)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Code != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Code))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedExtentServiceServer) ReadBlocks(req *ReadBlocksRequest, srv ExtentService_ReadBlocksServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReadBlocks not implemented")
+}
+func (*UnimplementedExtentServiceServer) ReAvali(ctx context.Context, req *ReAvaliRequest) (*ReAvaliResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReAvali not implemented")
+}
+func (*UnimplementedExtentServiceServer) CopyExtent(req *CopyExtentRequest, srv ExtentService_CopyExtentServer) error {
+	return status.Errorf(codes.Unimplemented, "method CopyExtent not implemented")
+}
+func (*UnimplementedExtentServiceServer) Df(ctx context.Context, req *DfRequest) (*DfResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Df not implemented")
+}
+func (*UnimplementedExtentServiceServer) RequireRecovery(ctx context.Context, req *RequireRecoveryRequest) (*RequireRecoveryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequireRecovery not implemented")
+}
+func (*UnimplementedExtentServiceServer) CommitLength(ctx context.Context, req *CommitLengthRequest) (*CommitLengthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitLength not implemented")
+}
+func (*UnimplementedExtentServiceServer) Heartbeat(req *Payload, srv ExtentService_HeartbeatServer) error {
+	return status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
+}
+func (*UnimplementedExtentServiceServer) AllocExtent(ctx context.Context, req *AllocExtentRequest) (*AllocExtentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllocExtent not implemented")
+}
+func (*UnimplementedExtentServiceServer) ConvertExtent(ctx context.Context, req *ConvertExtentRequest) (*ConvertExtentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertExtent not implemented")
+}
+func (*UnimplementedExtentServiceServer) WriteFragment(srv ExtentService_WriteFragmentServer) error {
+	return status.Errorf(codes.Unimplemented, "method WriteFragment not implemented")
+}
+func (*UnimplementedExtentServiceServer) ExtentChecksum(ctx context.Context, req *ExtentChecksumRequest) (*ExtentChecksumResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtentChecksum not implemented")
+}
+func (*UnimplementedExtentServiceServer) DeleteExtent(ctx context.Context, req *DeleteExtentRequest) (*DeleteExtentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteExtent not implemented")
+}
+func (*UnimplementedExtentServiceServer) Reconcile(ctx context.Context, req *ReconcileRequest) (*InventoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reconcile not implemented")
+}
+func (*UnimplementedExtentServiceServer) WalStats(ctx context.Context, req *WalStatsRequest) (*WalStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WalStats not implemented")
 }
 
-func (m *PunchHolesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func RegisterExtentServiceServer(s *grpc.Server, srv ExtentServiceServer) {
+	s.RegisterService(&_ExtentService_serviceDesc, srv)
 }
 
-func (m *PunchHolesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _ExtentService_Append_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ExtentServiceServer).Append(&extentServiceAppendServer{stream})
 }
 
-func (m *PunchHolesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Revision != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Revision))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.OwnerKey) > 0 {
-		i -= len(m.OwnerKey)
-		copy(dAtA[i:], m.OwnerKey)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.OwnerKey)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ExtentIDs) > 0 {
-		dAtA31 := make([]byte, len(m.ExtentIDs)*10)
-		var j30 int
-		for _, num := range m.ExtentIDs {
-			for num >= 1<<7 {
-				dAtA31[j30] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j30++
-			}
-			dAtA31[j30] = uint8(num)
-			j30++
-		}
-		i -= j30
-		copy(dAtA[i:], dAtA31[:j30])
-		i = encodeVarintPb(dAtA, i, uint64(j30))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.StreamID != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.StreamID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+type ExtentService_AppendServer interface {
+	SendAndClose(*AppendResponse) error
+	Recv() (*AppendRequest, error)
+	grpc.ServerStream
 }
 
-func (m *PunchHolesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+type extentServiceAppendServer struct {
+	grpc.ServerStream
+}
+
+func (x *extentServiceAppendServer) SendAndClose(m *AppendResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *extentServiceAppendServer) Recv() (*AppendRequest, error) {
+	m := new(AppendRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return m, nil
 }
 
-func (m *PunchHolesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _ExtentService_ChainAppend_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ExtentServiceServer).ChainAppend(&extentServiceChainAppendServer{stream})
 }
 
-func (m *PunchHolesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Stream != nil {
-		{
-			size, err := m.Stream.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.CodeDes) > 0 {
-		i -= len(m.CodeDes)
-		copy(dAtA[i:], m.CodeDes)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Code != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Code))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+type ExtentService_ChainAppendServer interface {
+	SendAndClose(*AppendResponse) error
+	Recv() (*AppendRequest, error)
+	grpc.ServerStream
 }
 
-func (m *MemberValue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+type extentServiceChainAppendServer struct {
+	grpc.ServerStream
+}
+
+func (x *extentServiceChainAppendServer) SendAndClose(m *AppendResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *extentServiceChainAppendServer) Recv() (*AppendRequest, error) {
+	m := new(AppendRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return m, nil
 }
 
-func (m *MemberValue) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _ExtentService_ReadBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReadBlocksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExtentServiceServer).ReadBlocks(m, &extentServiceReadBlocksServer{stream})
 }
 
-func (m *MemberValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.GrpcURL) > 0 {
-		i -= len(m.GrpcURL)
-		copy(dAtA[i:], m.GrpcURL)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.GrpcURL)))
-		i--
-		dAtA[i] = 0x1a
+type ExtentService_ReadBlocksServer interface {
+	Send(*ReadBlocksResponse) error
+	grpc.ServerStream
+}
+
+type extentServiceReadBlocksServer struct {
+	grpc.ServerStream
+}
+
+func (x *extentServiceReadBlocksServer) Send(m *ReadBlocksResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ExtentService_ReAvali_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReAvaliRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(ExtentServiceServer).ReAvali(ctx, in)
 	}
-	if m.ID != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ID))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ExtentService/ReAvali",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtentServiceServer).ReAvali(ctx, req.(*ReAvaliRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ExtentInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func _ExtentService_CopyExtent_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CopyExtentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(ExtentServiceServer).CopyExtent(m, &extentServiceCopyExtentServer{stream})
 }
 
-func (m *ExtentInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ExtentService_CopyExtentServer interface {
+	Send(*CopyExtentResponse) error
+	grpc.ServerStream
 }
 
-func (m *ExtentInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ParityDisk) > 0 {
-		dAtA34 := make([]byte, len(m.ParityDisk)*10)
-		var j33 int
-		for _, num := range m.ParityDisk {
-			for num >= 1<<7 {
-				dAtA34[j33] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j33++
-			}
-			dAtA34[j33] = uint8(num)
-			j33++
-		}
-		i -= j33
-		copy(dAtA[i:], dAtA34[:j33])
-		i = encodeVarintPb(dAtA, i, uint64(j33))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.ReplicateDisks) > 0 {
-		dAtA36 := make([]byte, len(m.ReplicateDisks)*10)
-		var j35 int
-		for _, num := range m.ReplicateDisks {
-			for num >= 1<<7 {
-				dAtA36[j35] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j35++
-			}
-			dAtA36[j35] = uint8(num)
-			j35++
-		}
-		i -= j35
-		copy(dAtA[i:], dAtA36[:j35])
-		i = encodeVarintPb(dAtA, i, uint64(j35))
-		i--
-		dAtA[i] = 0x42
+type extentServiceCopyExtentServer struct {
+	grpc.ServerStream
+}
+
+func (x *extentServiceCopyExtentServer) Send(m *CopyExtentResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ExtentService_Df_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DfRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Avali != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Avali))
-		i--
-		dAtA[i] = 0x38
+	if interceptor == nil {
+		return srv.(ExtentServiceServer).Df(ctx, in)
 	}
-	if m.SealedLength != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.SealedLength))
-		i--
-		dAtA[i] = 0x30
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ExtentService/Df",
 	}
-	if m.Refs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Refs))
-		i--
-		dAtA[i] = 0x28
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtentServiceServer).Df(ctx, req.(*DfRequest))
 	}
-	if m.Eversion != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Eversion))
-		i--
-		dAtA[i] = 0x20
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExtentService_RequireRecovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequireRecoveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Parity) > 0 {
-		dAtA38 := make([]byte, len(m.Parity)*10)
-		var j37 int
-		for _, num := range m.Parity {
-			for num >= 1<<7 {
-				dAtA38[j37] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j37++
-			}
-			dAtA38[j37] = uint8(num)
-			j37++
-		}
-		i -= j37
-		copy(dAtA[i:], dAtA38[:j37])
-		i = encodeVarintPb(dAtA, i, uint64(j37))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(ExtentServiceServer).RequireRecovery(ctx, in)
 	}
-	if len(m.Replicates) > 0 {
-		dAtA40 := make([]byte, len(m.Replicates)*10)
-		var j39 int
-		for _, num := range m.Replicates {
-			for num >= 1<<7 {
-				dAtA40[j39] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j39++
-			}
-			dAtA40[j39] = uint8(num)
-			j39++
-		}
-		i -= j39
-		copy(dAtA[i:], dAtA40[:j39])
-		i = encodeVarintPb(dAtA, i, uint64(j39))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ExtentService/RequireRecovery",
 	}
-	if m.ExtentID != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ExtentID))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtentServiceServer).RequireRecovery(ctx, req.(*RequireRecoveryRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *StreamInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ExtentService_CommitLength_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitLengthRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(ExtentServiceServer).CommitLength(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ExtentService/CommitLength",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtentServiceServer).CommitLength(ctx, req.(*CommitLengthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *StreamInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _ExtentService_Heartbeat_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Payload)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExtentServiceServer).Heartbeat(m, &extentServiceHeartbeatServer{stream})
 }
 
-func (m *StreamInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExtentIDs) > 0 {
-		dAtA42 := make([]byte, len(m.ExtentIDs)*10)
-		var j41 int
-		for _, num := range m.ExtentIDs {
-			for num >= 1<<7 {
-				dAtA42[j41] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j41++
-			}
-			dAtA42[j41] = uint8(num)
-			j41++
-		}
-		i -= j41
-		copy(dAtA[i:], dAtA42[:j41])
-		i = encodeVarintPb(dAtA, i, uint64(j41))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.StreamID != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.StreamID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+type ExtentService_HeartbeatServer interface {
+	Send(*Payload) error
+	grpc.ServerStream
 }
 
-func (m *NodeInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type extentServiceHeartbeatServer struct {
+	grpc.ServerStream
 }
 
-func (m *NodeInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (x *extentServiceHeartbeatServer) Send(m *Payload) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *NodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Disks) > 0 {
-		dAtA44 := make([]byte, len(m.Disks)*10)
-		var j43 int
-		for _, num := range m.Disks {
-			for num >= 1<<7 {
-				dAtA44[j43] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j43++
-			}
-			dAtA44[j43] = uint8(num)
-			j43++
-		}
-		i -= j43
-		copy(dAtA[i:], dAtA44[:j43])
-		i = encodeVarintPb(dAtA, i, uint64(j43))
-		i--
-		dAtA[i] = 0x1a
+func _ExtentService_AllocExtent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllocExtentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(ExtentServiceServer).AllocExtent(ctx, in)
 	}
-	if m.NodeID != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.NodeID))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ExtentService/AllocExtent",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtentServiceServer).AllocExtent(ctx, req.(*AllocExtentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DiskInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ExtentService_ConvertExtent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertExtentRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(ExtentServiceServer).ConvertExtent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ExtentService/ConvertExtent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtentServiceServer).ConvertExtent(ctx, req.(*ConvertExtentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DiskInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _ExtentService_WriteFragment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ExtentServiceServer).WriteFragment(&extentServiceWriteFragmentServer{stream})
 }
 
-func (m *DiskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Uuid) > 0 {
-		i -= len(m.Uuid)
-		copy(dAtA[i:], m.Uuid)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Uuid)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Online {
-		i--
-		if m.Online {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.DiskID != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.DiskID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+type ExtentService_WriteFragmentServer interface {
+	SendAndClose(*WriteFragmentResponse) error
+	Recv() (*WriteFragmentRequest, error)
+	grpc.ServerStream
 }
 
-func encodeVarintPb(dAtA []byte, offset int, v uint64) int {
-	offset -= sovPb(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *AppendRequestHeader) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ExtentID != 0 {
-		n += 1 + sovPb(uint64(m.ExtentID))
-	}
-	if m.Eversion != 0 {
-		n += 1 + sovPb(uint64(m.Eversion))
-	}
-	if m.Commit != 0 {
-		n += 1 + sovPb(uint64(m.Commit))
-	}
-	if m.Revision != 0 {
-		n += 1 + sovPb(uint64(m.Revision))
-	}
-	if m.MustSync {
-		n += 2
-	}
-	if len(m.Blocks) > 0 {
-		l = 0
-		for _, e := range m.Blocks {
-			l += sovPb(uint64(e))
-		}
-		n += 1 + sovPb(uint64(l)) + l
-	}
-	return n
-}
-
-func (m *AppendRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Data != nil {
-		n += m.Data.Size()
-	}
-	return n
+type extentServiceWriteFragmentServer struct {
+	grpc.ServerStream
 }
 
-func (m *AppendRequest_Header) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPb(uint64(l))
-	}
-	return n
-}
-func (m *AppendRequest_Payload) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Payload != nil {
-		l = len(m.Payload)
-		n += 1 + l + sovPb(uint64(l))
-	}
-	return n
-}
-func (m *AppendResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovPb(uint64(m.Code))
-	}
-	l = len(m.CodeDes)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if len(m.Offsets) > 0 {
-		l = 0
-		for _, e := range m.Offsets {
-			l += sovPb(uint64(e))
-		}
-		n += 1 + sovPb(uint64(l)) + l
-	}
-	if m.End != 0 {
-		n += 1 + sovPb(uint64(m.End))
-	}
-	return n
+func (x *extentServiceWriteFragmentServer) SendAndClose(m *WriteFragmentResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *CreateExtentRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.StreamID != 0 {
-		n += 1 + sovPb(uint64(m.StreamID))
+func (x *extentServiceWriteFragmentServer) Recv() (*WriteFragmentRequest, error) {
+	m := new(WriteFragmentRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return n
+	return m, nil
 }
 
-func (m *CreateExtentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _ExtentService_ExtentChecksum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtentChecksumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovPb(uint64(m.Code))
+	if interceptor == nil {
+		return srv.(ExtentServiceServer).ExtentChecksum(ctx, in)
 	}
-	l = len(m.CodeDes)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ExtentService/ExtentChecksum",
 	}
-	if m.ExtentID != 0 {
-		n += 1 + sovPb(uint64(m.ExtentID))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtentServiceServer).ExtentChecksum(ctx, req.(*ExtentChecksumRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ReadBlocksRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ExtentID != 0 {
-		n += 1 + sovPb(uint64(m.ExtentID))
-	}
-	if m.Offset != 0 {
-		n += 1 + sovPb(uint64(m.Offset))
+func _ExtentService_DeleteExtent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteExtentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.NumOfBlocks != 0 {
-		n += 1 + sovPb(uint64(m.NumOfBlocks))
+	if interceptor == nil {
+		return srv.(ExtentServiceServer).DeleteExtent(ctx, in)
 	}
-	if m.Eversion != 0 {
-		n += 1 + sovPb(uint64(m.Eversion))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ExtentService/DeleteExtent",
 	}
-	if m.OnlyLastBlock {
-		n += 2
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtentServiceServer).DeleteExtent(ctx, req.(*DeleteExtentRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ReadBlockResponseHeader) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovPb(uint64(m.Code))
-	}
-	l = len(m.CodeDes)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if len(m.Offsets) > 0 {
-		l = 0
-		for _, e := range m.Offsets {
-			l += sovPb(uint64(e))
-		}
-		n += 1 + sovPb(uint64(l)) + l
-	}
-	if m.End != 0 {
-		n += 1 + sovPb(uint64(m.End))
+func _ExtentService_Reconcile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.BlockSizes) > 0 {
-		l = 0
-		for _, e := range m.BlockSizes {
-			l += sovPb(uint64(e))
-		}
-		n += 1 + sovPb(uint64(l)) + l
+	if interceptor == nil {
+		return srv.(ExtentServiceServer).Reconcile(ctx, in)
 	}
-	return n
-}
-
-func (m *ReadBlocksResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ExtentService/Reconcile",
 	}
-	var l int
-	_ = l
-	if m.Data != nil {
-		n += m.Data.Size()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtentServiceServer).Reconcile(ctx, req.(*ReconcileRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ReadBlocksResponse_Header) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPb(uint64(l))
-	}
-	return n
-}
-func (m *ReadBlocksResponse_Payload) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Payload != nil {
-		l = len(m.Payload)
-		n += 1 + l + sovPb(uint64(l))
-	}
-	return n
-}
-func (m *Payload) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+func _ExtentService_WalStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WalStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
-}
-
-func (m *CommitLengthRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(ExtentServiceServer).WalStats(ctx, in)
 	}
-	var l int
-	_ = l
-	if m.ExtentID != 0 {
-		n += 1 + sovPb(uint64(m.ExtentID))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ExtentService/WalStats",
 	}
-	if m.Revision != 0 {
-		n += 1 + sovPb(uint64(m.Revision))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtentServiceServer).WalStats(ctx, req.(*WalStatsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CommitLengthResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovPb(uint64(m.Code))
-	}
-	l = len(m.CodeDes)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.Length != 0 {
-		n += 1 + sovPb(uint64(m.Length))
-	}
-	return n
+var _ExtentService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.ExtentService",
+	HandlerType: (*ExtentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ReAvali",
+			Handler:    _ExtentService_ReAvali_Handler,
+		},
+		{
+			MethodName: "Df",
+			Handler:    _ExtentService_Df_Handler,
+		},
+		{
+			MethodName: "RequireRecovery",
+			Handler:    _ExtentService_RequireRecovery_Handler,
+		},
+		{
+			MethodName: "CommitLength",
+			Handler:    _ExtentService_CommitLength_Handler,
+		},
+		{
+			MethodName: "AllocExtent",
+			Handler:    _ExtentService_AllocExtent_Handler,
+		},
+		{
+			MethodName: "ConvertExtent",
+			Handler:    _ExtentService_ConvertExtent_Handler,
+		},
+		{
+			MethodName: "ExtentChecksum",
+			Handler:    _ExtentService_ExtentChecksum_Handler,
+		},
+		{
+			MethodName: "DeleteExtent",
+			Handler:    _ExtentService_DeleteExtent_Handler,
+		},
+		{
+			MethodName: "Reconcile",
+			Handler:    _ExtentService_Reconcile_Handler,
+		},
+		{
+			MethodName: "WalStats",
+			Handler:    _ExtentService_WalStats_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Append",
+			Handler:       _ExtentService_Append_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ChainAppend",
+			Handler:       _ExtentService_ChainAppend_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ReadBlocks",
+			Handler:       _ExtentService_ReadBlocks_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "CopyExtent",
+			Handler:       _ExtentService_CopyExtent_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Heartbeat",
+			Handler:       _ExtentService_Heartbeat_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WriteFragment",
+			Handler:       _ExtentService_WriteFragment_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "pb.proto",
 }
 
-func (m *DF) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Total != 0 {
-		n += 1 + sovPb(uint64(m.Total))
+// StreamManagerServiceClient is the client API for StreamManagerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type StreamManagerServiceClient interface {
+	StreamInfo(ctx context.Context, in *StreamInfoRequest, opts ...grpc.CallOption) (*StreamInfoResponse, error)
+	ExtentInfo(ctx context.Context, in *ExtentInfoRequest, opts ...grpc.CallOption) (*ExtentInfoResponse, error)
+	NodesInfo(ctx context.Context, in *NodesInfoRequest, opts ...grpc.CallOption) (*NodesInfoResponse, error)
+	Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	//verify checksums of all copies of an extent, recovery is dispatched for corrupted copies
+	VerifyExtent(ctx context.Context, in *VerifyExtentRequest, opts ...grpc.CallOption) (*VerifyExtentResponse, error)
+	CheckCommitLength(ctx context.Context, in *CheckCommitLengthRequest, opts ...grpc.CallOption) (*CheckCommitLengthResponse, error)
+	StreamAllocExtent(ctx context.Context, in *StreamAllocExtentRequest, opts ...grpc.CallOption) (*StreamAllocExtentResponse, error)
+	StreamPunchHoles(ctx context.Context, in *PunchHolesRequest, opts ...grpc.CallOption) (*PunchHolesResponse, error)
+	CreateStream(ctx context.Context, in *CreateStreamRequest, opts ...grpc.CallOption) (*CreateStreamResponse, error)
+	RegisterNode(ctx context.Context, in *RegisterNodeRequest, opts ...grpc.CallOption) (*RegisterNodeResponse, error)
+	//add a new or replaced disk to a registered node
+	RegisterDisk(ctx context.Context, in *RegisterDiskRequest, opts ...grpc.CallOption) (*RegisterDiskResponse, error)
+	//move all extents away from a node, then remove it
+	DecommissionNode(ctx context.Context, in *DecommissionNodeRequest, opts ...grpc.CallOption) (*DecommissionNodeResponse, error)
+	Truncate(ctx context.Context, in *TruncateRequest, opts ...grpc.CallOption) (*TruncateResponse, error)
+	DeleteStream(ctx context.Context, in *DeleteStreamRequest, opts ...grpc.CallOption) (*DeleteStreamResponse, error)
+	//nodes report extents they hold, sm answers orphans, missing and mismatched extents
+	ReportInventory(ctx context.Context, in *InventoryRequest, opts ...grpc.CallOption) (*InventoryResponse, error)
+	MultiModifySplit(ctx context.Context, in *MultiModifySplitRequest, opts ...grpc.CallOption) (*MultiModifySplitResponse, error)
+	SnapshotPartition(ctx context.Context, in *SnapshotPartitionRequest, opts ...grpc.CallOption) (*SnapshotPartitionResponse, error)
+	RestoreSnapshot(ctx context.Context, in *RestoreSnapshotRequest, opts ...grpc.CallOption) (*RestoreSnapshotResponse, error)
+	DeleteSnapshot(ctx context.Context, in *DeleteSnapshotRequest, opts ...grpc.CallOption) (*DeleteSnapshotResponse, error)
+	SetECPolicy(ctx context.Context, in *SetECPolicyRequest, opts ...grpc.CallOption) (*SetECPolicyResponse, error)
+	ECConversionStatus(ctx context.Context, in *ECConversionStatusRequest, opts ...grpc.CallOption) (*ECConversionStatusResponse, error)
+}
+
+type streamManagerServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewStreamManagerServiceClient(cc *grpc.ClientConn) StreamManagerServiceClient {
+	return &streamManagerServiceClient{cc}
+}
+
+func (c *streamManagerServiceClient) StreamInfo(ctx context.Context, in *StreamInfoRequest, opts ...grpc.CallOption) (*StreamInfoResponse, error) {
+	out := new(StreamInfoResponse)
+	err := c.cc.Invoke(ctx, "/pb.StreamManagerService/StreamInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Free != 0 {
-		n += 1 + sovPb(uint64(m.Free))
+	return out, nil
+}
+
+func (c *streamManagerServiceClient) ExtentInfo(ctx context.Context, in *ExtentInfoRequest, opts ...grpc.CallOption) (*ExtentInfoResponse, error) {
+	out := new(ExtentInfoResponse)
+	err := c.cc.Invoke(ctx, "/pb.StreamManagerService/ExtentInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Online {
-		n += 2
+	return out, nil
+}
+
+func (c *streamManagerServiceClient) NodesInfo(ctx context.Context, in *NodesInfoRequest, opts ...grpc.CallOption) (*NodesInfoResponse, error) {
+	out := new(NodesInfoResponse)
+	err := c.cc.Invoke(ctx, "/pb.StreamManagerService/NodesInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *DfRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *streamManagerServiceClient) Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, "/pb.StreamManagerService/Status", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Tasks) > 0 {
-		for _, e := range m.Tasks {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *streamManagerServiceClient) VerifyExtent(ctx context.Context, in *VerifyExtentRequest, opts ...grpc.CallOption) (*VerifyExtentResponse, error) {
+	out := new(VerifyExtentResponse)
+	err := c.cc.Invoke(ctx, "/pb.StreamManagerService/VerifyExtent", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.DiskIDs) > 0 {
-		l = 0
-		for _, e := range m.DiskIDs {
-			l += sovPb(uint64(e))
-		}
-		n += 1 + sovPb(uint64(l)) + l
+	return out, nil
+}
+
+func (c *streamManagerServiceClient) CheckCommitLength(ctx context.Context, in *CheckCommitLengthRequest, opts ...grpc.CallOption) (*CheckCommitLengthResponse, error) {
+	out := new(CheckCommitLengthResponse)
+	err := c.cc.Invoke(ctx, "/pb.StreamManagerService/CheckCommitLength", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *DfResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *streamManagerServiceClient) StreamAllocExtent(ctx context.Context, in *StreamAllocExtentRequest, opts ...grpc.CallOption) (*StreamAllocExtentResponse, error) {
+	out := new(StreamAllocExtentResponse)
+	err := c.cc.Invoke(ctx, "/pb.StreamManagerService/StreamAllocExtent", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.DoneTask) > 0 {
-		for _, e := range m.DoneTask {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *streamManagerServiceClient) StreamPunchHoles(ctx context.Context, in *PunchHolesRequest, opts ...grpc.CallOption) (*PunchHolesResponse, error) {
+	out := new(PunchHolesResponse)
+	err := c.cc.Invoke(ctx, "/pb.StreamManagerService/StreamPunchHoles", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.DiskStatus) > 0 {
-		for k, v := range m.DiskStatus {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovPb(uint64(l))
-			}
-			mapEntrySize := 1 + sovPb(uint64(k)) + l
-			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
-		}
+	return out, nil
+}
+
+func (c *streamManagerServiceClient) CreateStream(ctx context.Context, in *CreateStreamRequest, opts ...grpc.CallOption) (*CreateStreamResponse, error) {
+	out := new(CreateStreamResponse)
+	err := c.cc.Invoke(ctx, "/pb.StreamManagerService/CreateStream", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *RecoveryTaskStatus) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *streamManagerServiceClient) RegisterNode(ctx context.Context, in *RegisterNodeRequest, opts ...grpc.CallOption) (*RegisterNodeResponse, error) {
+	out := new(RegisterNodeResponse)
+	err := c.cc.Invoke(ctx, "/pb.StreamManagerService/RegisterNode", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Task != nil {
-		l = m.Task.Size()
-		n += 1 + l + sovPb(uint64(l))
+	return out, nil
+}
+
+func (c *streamManagerServiceClient) RegisterDisk(ctx context.Context, in *RegisterDiskRequest, opts ...grpc.CallOption) (*RegisterDiskResponse, error) {
+	out := new(RegisterDiskResponse)
+	err := c.cc.Invoke(ctx, "/pb.StreamManagerService/RegisterDisk", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.ReadyDiskID != 0 {
-		n += 1 + sovPb(uint64(m.ReadyDiskID))
+	return out, nil
+}
+
+func (c *streamManagerServiceClient) DecommissionNode(ctx context.Context, in *DecommissionNodeRequest, opts ...grpc.CallOption) (*DecommissionNodeResponse, error) {
+	out := new(DecommissionNodeResponse)
+	err := c.cc.Invoke(ctx, "/pb.StreamManagerService/DecommissionNode", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *RecoveryTask) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *streamManagerServiceClient) Truncate(ctx context.Context, in *TruncateRequest, opts ...grpc.CallOption) (*TruncateResponse, error) {
+	out := new(TruncateResponse)
+	err := c.cc.Invoke(ctx, "/pb.StreamManagerService/Truncate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.ExtentID != 0 {
-		n += 1 + sovPb(uint64(m.ExtentID))
-	}
-	if m.ReplaceID != 0 {
-		n += 1 + sovPb(uint64(m.ReplaceID))
+	return out, nil
+}
+
+func (c *streamManagerServiceClient) DeleteStream(ctx context.Context, in *DeleteStreamRequest, opts ...grpc.CallOption) (*DeleteStreamResponse, error) {
+	out := new(DeleteStreamResponse)
+	err := c.cc.Invoke(ctx, "/pb.StreamManagerService/DeleteStream", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.NodeID != 0 {
-		n += 1 + sovPb(uint64(m.NodeID))
+	return out, nil
+}
+
+func (c *streamManagerServiceClient) ReportInventory(ctx context.Context, in *InventoryRequest, opts ...grpc.CallOption) (*InventoryResponse, error) {
+	out := new(InventoryResponse)
+	err := c.cc.Invoke(ctx, "/pb.StreamManagerService/ReportInventory", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.StartTime != 0 {
-		n += 1 + sovPb(uint64(m.StartTime))
+	return out, nil
+}
+
+func (c *streamManagerServiceClient) MultiModifySplit(ctx context.Context, in *MultiModifySplitRequest, opts ...grpc.CallOption) (*MultiModifySplitResponse, error) {
+	out := new(MultiModifySplitResponse)
+	err := c.cc.Invoke(ctx, "/pb.StreamManagerService/MultiModifySplit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *RequireRecoveryRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *streamManagerServiceClient) SnapshotPartition(ctx context.Context, in *SnapshotPartitionRequest, opts ...grpc.CallOption) (*SnapshotPartitionResponse, error) {
+	out := new(SnapshotPartitionResponse)
+	err := c.cc.Invoke(ctx, "/pb.StreamManagerService/SnapshotPartition", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Task != nil {
-		l = m.Task.Size()
-		n += 1 + l + sovPb(uint64(l))
+	return out, nil
+}
+
+func (c *streamManagerServiceClient) RestoreSnapshot(ctx context.Context, in *RestoreSnapshotRequest, opts ...grpc.CallOption) (*RestoreSnapshotResponse, error) {
+	out := new(RestoreSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/pb.StreamManagerService/RestoreSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *RequireRecoveryResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *streamManagerServiceClient) DeleteSnapshot(ctx context.Context, in *DeleteSnapshotRequest, opts ...grpc.CallOption) (*DeleteSnapshotResponse, error) {
+	out := new(DeleteSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/pb.StreamManagerService/DeleteSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovPb(uint64(m.Code))
+	return out, nil
+}
+
+func (c *streamManagerServiceClient) SetECPolicy(ctx context.Context, in *SetECPolicyRequest, opts ...grpc.CallOption) (*SetECPolicyResponse, error) {
+	out := new(SetECPolicyResponse)
+	err := c.cc.Invoke(ctx, "/pb.StreamManagerService/SetECPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.CodeDes)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	return out, nil
+}
+
+func (c *streamManagerServiceClient) ECConversionStatus(ctx context.Context, in *ECConversionStatusRequest, opts ...grpc.CallOption) (*ECConversionStatusResponse, error) {
+	out := new(ECConversionStatusResponse)
+	err := c.cc.Invoke(ctx, "/pb.StreamManagerService/ECConversionStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *CopyResponseHeader) Size() (n int) {
-	if m == nil {
-		return 0
+// StreamManagerServiceServer is the server API for StreamManagerService service.
+type StreamManagerServiceServer interface {
+	StreamInfo(context.Context, *StreamInfoRequest) (*StreamInfoResponse, error)
+	ExtentInfo(context.Context, *ExtentInfoRequest) (*ExtentInfoResponse, error)
+	NodesInfo(context.Context, *NodesInfoRequest) (*NodesInfoResponse, error)
+	Status(context.Context, *StatusRequest) (*StatusResponse, error)
+	//verify checksums of all copies of an extent, recovery is dispatched for corrupted copies
+	VerifyExtent(context.Context, *VerifyExtentRequest) (*VerifyExtentResponse, error)
+	CheckCommitLength(context.Context, *CheckCommitLengthRequest) (*CheckCommitLengthResponse, error)
+	StreamAllocExtent(context.Context, *StreamAllocExtentRequest) (*StreamAllocExtentResponse, error)
+	StreamPunchHoles(context.Context, *PunchHolesRequest) (*PunchHolesResponse, error)
+	CreateStream(context.Context, *CreateStreamRequest) (*CreateStreamResponse, error)
+	RegisterNode(context.Context, *RegisterNodeRequest) (*RegisterNodeResponse, error)
+	//add a new or replaced disk to a registered node
+	RegisterDisk(context.Context, *RegisterDiskRequest) (*RegisterDiskResponse, error)
+	//move all extents away from a node, then remove it
+	DecommissionNode(context.Context, *DecommissionNodeRequest) (*DecommissionNodeResponse, error)
+	Truncate(context.Context, *TruncateRequest) (*TruncateResponse, error)
+	DeleteStream(context.Context, *DeleteStreamRequest) (*DeleteStreamResponse, error)
+	//nodes report extents they hold, sm answers orphans, missing and mismatched extents
+	ReportInventory(context.Context, *InventoryRequest) (*InventoryResponse, error)
+	MultiModifySplit(context.Context, *MultiModifySplitRequest) (*MultiModifySplitResponse, error)
+	SnapshotPartition(context.Context, *SnapshotPartitionRequest) (*SnapshotPartitionResponse, error)
+	RestoreSnapshot(context.Context, *RestoreSnapshotRequest) (*RestoreSnapshotResponse, error)
+	DeleteSnapshot(context.Context, *DeleteSnapshotRequest) (*DeleteSnapshotResponse, error)
+	SetECPolicy(context.Context, *SetECPolicyRequest) (*SetECPolicyResponse, error)
+	ECConversionStatus(context.Context, *ECConversionStatusRequest) (*ECConversionStatusResponse, error)
+}
+
+// UnimplementedStreamManagerServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedStreamManagerServiceServer struct {
+}
+
+func (*UnimplementedStreamManagerServiceServer) StreamInfo(ctx context.Context, req *StreamInfoRequest) (*StreamInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StreamInfo not implemented")
+}
+func (*UnimplementedStreamManagerServiceServer) ExtentInfo(ctx context.Context, req *ExtentInfoRequest) (*ExtentInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtentInfo not implemented")
+}
+func (*UnimplementedStreamManagerServiceServer) NodesInfo(ctx context.Context, req *NodesInfoRequest) (*NodesInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NodesInfo not implemented")
+}
+func (*UnimplementedStreamManagerServiceServer) Status(ctx context.Context, req *StatusRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
+}
+func (*UnimplementedStreamManagerServiceServer) VerifyExtent(ctx context.Context, req *VerifyExtentRequest) (*VerifyExtentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyExtent not implemented")
+}
+func (*UnimplementedStreamManagerServiceServer) CheckCommitLength(ctx context.Context, req *CheckCommitLengthRequest) (*CheckCommitLengthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckCommitLength not implemented")
+}
+func (*UnimplementedStreamManagerServiceServer) StreamAllocExtent(ctx context.Context, req *StreamAllocExtentRequest) (*StreamAllocExtentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StreamAllocExtent not implemented")
+}
+func (*UnimplementedStreamManagerServiceServer) StreamPunchHoles(ctx context.Context, req *PunchHolesRequest) (*PunchHolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StreamPunchHoles not implemented")
+}
+func (*UnimplementedStreamManagerServiceServer) CreateStream(ctx context.Context, req *CreateStreamRequest) (*CreateStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStream not implemented")
+}
+func (*UnimplementedStreamManagerServiceServer) RegisterNode(ctx context.Context, req *RegisterNodeRequest) (*RegisterNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterNode not implemented")
+}
+func (*UnimplementedStreamManagerServiceServer) RegisterDisk(ctx context.Context, req *RegisterDiskRequest) (*RegisterDiskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterDisk not implemented")
+}
+func (*UnimplementedStreamManagerServiceServer) DecommissionNode(ctx context.Context, req *DecommissionNodeRequest) (*DecommissionNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecommissionNode not implemented")
+}
+func (*UnimplementedStreamManagerServiceServer) Truncate(ctx context.Context, req *TruncateRequest) (*TruncateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Truncate not implemented")
+}
+func (*UnimplementedStreamManagerServiceServer) DeleteStream(ctx context.Context, req *DeleteStreamRequest) (*DeleteStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteStream not implemented")
+}
+func (*UnimplementedStreamManagerServiceServer) ReportInventory(ctx context.Context, req *InventoryRequest) (*InventoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportInventory not implemented")
+}
+func (*UnimplementedStreamManagerServiceServer) MultiModifySplit(ctx context.Context, req *MultiModifySplitRequest) (*MultiModifySplitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiModifySplit not implemented")
+}
+func (*UnimplementedStreamManagerServiceServer) SnapshotPartition(ctx context.Context, req *SnapshotPartitionRequest) (*SnapshotPartitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SnapshotPartition not implemented")
+}
+func (*UnimplementedStreamManagerServiceServer) RestoreSnapshot(ctx context.Context, req *RestoreSnapshotRequest) (*RestoreSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreSnapshot not implemented")
+}
+func (*UnimplementedStreamManagerServiceServer) DeleteSnapshot(ctx context.Context, req *DeleteSnapshotRequest) (*DeleteSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSnapshot not implemented")
+}
+func (*UnimplementedStreamManagerServiceServer) SetECPolicy(ctx context.Context, req *SetECPolicyRequest) (*SetECPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetECPolicy not implemented")
+}
+func (*UnimplementedStreamManagerServiceServer) ECConversionStatus(ctx context.Context, req *ECConversionStatusRequest) (*ECConversionStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ECConversionStatus not implemented")
+}
+
+func RegisterStreamManagerServiceServer(s *grpc.Server, srv StreamManagerServiceServer) {
+	s.RegisterService(&_StreamManagerService_serviceDesc, srv)
+}
+
+func _StreamManagerService_StreamInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StreamInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovPb(uint64(m.Code))
+	if interceptor == nil {
+		return srv.(StreamManagerServiceServer).StreamInfo(ctx, in)
 	}
-	l = len(m.CodeDes)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StreamManagerService/StreamInfo",
 	}
-	if m.PayloadLen != 0 {
-		n += 1 + sovPb(uint64(m.PayloadLen))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamManagerServiceServer).StreamInfo(ctx, req.(*StreamInfoRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CopyExtentRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ExtentID != 0 {
-		n += 1 + sovPb(uint64(m.ExtentID))
+func _StreamManagerService_ExtentInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtentInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Offset != 0 {
-		n += 1 + sovPb(uint64(m.Offset))
+	if interceptor == nil {
+		return srv.(StreamManagerServiceServer).ExtentInfo(ctx, in)
 	}
-	if m.Size_ != 0 {
-		n += 1 + sovPb(uint64(m.Size_))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StreamManagerService/ExtentInfo",
 	}
-	if m.Eversion != 0 {
-		n += 1 + sovPb(uint64(m.Eversion))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamManagerServiceServer).ExtentInfo(ctx, req.(*ExtentInfoRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CopyExtentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _StreamManagerService_NodesInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodesInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Data != nil {
-		n += m.Data.Size()
+	if interceptor == nil {
+		return srv.(StreamManagerServiceServer).NodesInfo(ctx, in)
 	}
-	return n
-}
-
-func (m *CopyExtentResponse_Header) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StreamManagerService/NodesInfo",
 	}
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPb(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamManagerServiceServer).NodesInfo(ctx, req.(*NodesInfoRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
-func (m *CopyExtentResponse_Payload) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Payload != nil {
-		l = len(m.Payload)
-		n += 1 + l + sovPb(uint64(l))
+
+func _StreamManagerService_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
-}
-func (m *ReAvaliRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(StreamManagerServiceServer).Status(ctx, in)
 	}
-	var l int
-	_ = l
-	if m.ExtentID != 0 {
-		n += 1 + sovPb(uint64(m.ExtentID))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StreamManagerService/Status",
 	}
-	if m.Eversion != 0 {
-		n += 1 + sovPb(uint64(m.Eversion))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamManagerServiceServer).Status(ctx, req.(*StatusRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ReAvaliResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _StreamManagerService_VerifyExtent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyExtentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovPb(uint64(m.Code))
+	if interceptor == nil {
+		return srv.(StreamManagerServiceServer).VerifyExtent(ctx, in)
 	}
-	l = len(m.CodeDes)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StreamManagerService/VerifyExtent",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamManagerServiceServer).VerifyExtent(ctx, req.(*VerifyExtentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AllocExtentRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _StreamManagerService_CheckCommitLength_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckCommitLengthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.ExtentID != 0 {
-		n += 1 + sovPb(uint64(m.ExtentID))
+	if interceptor == nil {
+		return srv.(StreamManagerServiceServer).CheckCommitLength(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StreamManagerService/CheckCommitLength",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamManagerServiceServer).CheckCommitLength(ctx, req.(*CheckCommitLengthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AllocExtentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _StreamManagerService_StreamAllocExtent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StreamAllocExtentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovPb(uint64(m.Code))
+	if interceptor == nil {
+		return srv.(StreamManagerServiceServer).StreamAllocExtent(ctx, in)
 	}
-	l = len(m.CodeDes)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StreamManagerService/StreamAllocExtent",
 	}
-	if m.DiskID != 0 {
-		n += 1 + sovPb(uint64(m.DiskID))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamManagerServiceServer).StreamAllocExtent(ctx, req.(*StreamAllocExtentRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CheckCommitLengthRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _StreamManagerService_StreamPunchHoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PunchHolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.StreamID != 0 {
-		n += 1 + sovPb(uint64(m.StreamID))
+	if interceptor == nil {
+		return srv.(StreamManagerServiceServer).StreamPunchHoles(ctx, in)
 	}
-	l = len(m.OwnerKey)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StreamManagerService/StreamPunchHoles",
 	}
-	if m.Revision != 0 {
-		n += 1 + sovPb(uint64(m.Revision))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamManagerServiceServer).StreamPunchHoles(ctx, req.(*PunchHolesRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CheckCommitLengthResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovPb(uint64(m.Code))
-	}
-	l = len(m.CodeDes)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+func _StreamManagerService_CreateStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.StreamInfo != nil {
-		l = m.StreamInfo.Size()
-		n += 1 + l + sovPb(uint64(l))
+	if interceptor == nil {
+		return srv.(StreamManagerServiceServer).CreateStream(ctx, in)
 	}
-	if m.End != 0 {
-		n += 1 + sovPb(uint64(m.End))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StreamManagerService/CreateStream",
 	}
-	if m.LastExInfo != nil {
-		l = m.LastExInfo.Size()
-		n += 1 + l + sovPb(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamManagerServiceServer).CreateStream(ctx, req.(*CreateStreamRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *StreamAllocExtentRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.StreamID != 0 {
-		n += 1 + sovPb(uint64(m.StreamID))
+func _StreamManagerService_RegisterNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.OwnerKey)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if interceptor == nil {
+		return srv.(StreamManagerServiceServer).RegisterNode(ctx, in)
 	}
-	if m.Revision != 0 {
-		n += 1 + sovPb(uint64(m.Revision))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StreamManagerService/RegisterNode",
 	}
-	if m.End != 0 {
-		n += 1 + sovPb(uint64(m.End))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamManagerServiceServer).RegisterNode(ctx, req.(*RegisterNodeRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *StreamAllocExtentResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovPb(uint64(m.Code))
+func _StreamManagerService_RegisterDisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterDiskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.CodeDes)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if interceptor == nil {
+		return srv.(StreamManagerServiceServer).RegisterDisk(ctx, in)
 	}
-	if m.StreamInfo != nil {
-		l = m.StreamInfo.Size()
-		n += 1 + l + sovPb(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StreamManagerService/RegisterDisk",
 	}
-	if m.LastExInfo != nil {
-		l = m.LastExInfo.Size()
-		n += 1 + l + sovPb(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamManagerServiceServer).RegisterDisk(ctx, req.(*RegisterDiskRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *StreamInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _StreamManagerService_DecommissionNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecommissionNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.StreamIDs) > 0 {
-		l = 0
-		for _, e := range m.StreamIDs {
-			l += sovPb(uint64(e))
-		}
-		n += 1 + sovPb(uint64(l)) + l
+	if interceptor == nil {
+		return srv.(StreamManagerServiceServer).DecommissionNode(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StreamManagerService/DecommissionNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamManagerServiceServer).DecommissionNode(ctx, req.(*DecommissionNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *StreamInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovPb(uint64(m.Code))
+func _StreamManagerService_Truncate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TruncateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.CodeDes)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if interceptor == nil {
+		return srv.(StreamManagerServiceServer).Truncate(ctx, in)
 	}
-	if len(m.Streams) > 0 {
-		for k, v := range m.Streams {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovPb(uint64(l))
-			}
-			mapEntrySize := 1 + sovPb(uint64(k)) + l
-			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StreamManagerService/Truncate",
 	}
-	if len(m.Extents) > 0 {
-		for k, v := range m.Extents {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovPb(uint64(l))
-			}
-			mapEntrySize := 1 + sovPb(uint64(k)) + l
-			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamManagerServiceServer).Truncate(ctx, req.(*TruncateRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ExtentInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _StreamManagerService_DeleteStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.ExtentID != 0 {
-		n += 1 + sovPb(uint64(m.ExtentID))
+	if interceptor == nil {
+		return srv.(StreamManagerServiceServer).DeleteStream(ctx, in)
 	}
-	return n
-}
-
-func (m *ExtentInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovPb(uint64(m.Code))
-	}
-	l = len(m.CodeDes)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.ExInfo != nil {
-		l = m.ExInfo.Size()
-		n += 1 + l + sovPb(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StreamManagerService/DeleteStream",
 	}
-	return n
-}
-
-func (m *NodesInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamManagerServiceServer).DeleteStream(ctx, req.(*DeleteStreamRequest))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *NodesInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovPb(uint64(m.Code))
-	}
-	l = len(m.CodeDes)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if len(m.Nodes) > 0 {
-		for k, v := range m.Nodes {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovPb(uint64(l))
-			}
-			mapEntrySize := 1 + sovPb(uint64(k)) + l
-			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
-		}
+func _StreamManagerService_ReportInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InventoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
-}
-
-func (m *RegisterNodeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(StreamManagerServiceServer).ReportInventory(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StreamManagerService/ReportInventory",
 	}
-	if len(m.DiskUUIDs) > 0 {
-		for _, s := range m.DiskUUIDs {
-			l = len(s)
-			n += 1 + l + sovPb(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamManagerServiceServer).ReportInventory(ctx, req.(*InventoryRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RegisterNodeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovPb(uint64(m.Code))
-	}
-	l = len(m.CodeDes)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.NodeId != 0 {
-		n += 1 + sovPb(uint64(m.NodeId))
-	}
-	if len(m.DiskUUIDs) > 0 {
-		for k, v := range m.DiskUUIDs {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovPb(uint64(len(k))) + 1 + sovPb(uint64(v))
-			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
-		}
+func _StreamManagerService_MultiModifySplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiModifySplitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
-}
-
-func (m *CreateStreamRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(StreamManagerServiceServer).MultiModifySplit(ctx, in)
 	}
-	var l int
-	_ = l
-	if m.DataShard != 0 {
-		n += 1 + sovPb(uint64(m.DataShard))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StreamManagerService/MultiModifySplit",
 	}
-	if m.ParityShard != 0 {
-		n += 1 + sovPb(uint64(m.ParityShard))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamManagerServiceServer).MultiModifySplit(ctx, req.(*MultiModifySplitRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CreateStreamResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovPb(uint64(m.Code))
+func _StreamManagerService_SnapshotPartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnapshotPartitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.CodeDes)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if interceptor == nil {
+		return srv.(StreamManagerServiceServer).SnapshotPartition(ctx, in)
 	}
-	if m.Stream != nil {
-		l = m.Stream.Size()
-		n += 1 + l + sovPb(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StreamManagerService/SnapshotPartition",
 	}
-	if m.Extent != nil {
-		l = m.Extent.Size()
-		n += 1 + l + sovPb(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamManagerServiceServer).SnapshotPartition(ctx, req.(*SnapshotPartitionRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TruncateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.StreamID != 0 {
-		n += 1 + sovPb(uint64(m.StreamID))
+func _StreamManagerService_RestoreSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ExtentID != 0 {
-		n += 1 + sovPb(uint64(m.ExtentID))
+	if interceptor == nil {
+		return srv.(StreamManagerServiceServer).RestoreSnapshot(ctx, in)
 	}
-	l = len(m.OwnerKey)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StreamManagerService/RestoreSnapshot",
 	}
-	if m.Revision != 0 {
-		n += 1 + sovPb(uint64(m.Revision))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamManagerServiceServer).RestoreSnapshot(ctx, req.(*RestoreSnapshotRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TruncateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _StreamManagerService_DeleteSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovPb(uint64(m.Code))
+	if interceptor == nil {
+		return srv.(StreamManagerServiceServer).DeleteSnapshot(ctx, in)
 	}
-	l = len(m.CodeDes)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StreamManagerService/DeleteSnapshot",
 	}
-	if m.UpdatedStreamInfo != nil {
-		l = m.UpdatedStreamInfo.Size()
-		n += 1 + l + sovPb(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamManagerServiceServer).DeleteSnapshot(ctx, req.(*DeleteSnapshotRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MultiModifySplitRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PartID != 0 {
-		n += 1 + sovPb(uint64(m.PartID))
-	}
-	l = len(m.MidKey)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	l = len(m.OwnerKey)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.Revision != 0 {
-		n += 1 + sovPb(uint64(m.Revision))
+func _StreamManagerService_SetECPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetECPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.LogStreamSealedLength != 0 {
-		n += 1 + sovPb(uint64(m.LogStreamSealedLength))
+	if interceptor == nil {
+		return srv.(StreamManagerServiceServer).SetECPolicy(ctx, in)
 	}
-	if m.RowStreamSealedLength != 0 {
-		n += 1 + sovPb(uint64(m.RowStreamSealedLength))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StreamManagerService/SetECPolicy",
 	}
-	if m.MetaStreamSealedLength != 0 {
-		n += 1 + sovPb(uint64(m.MetaStreamSealedLength))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamManagerServiceServer).SetECPolicy(ctx, req.(*SetECPolicyRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MultiModifySplitResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _StreamManagerService_ECConversionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ECConversionStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovPb(uint64(m.Code))
+	if interceptor == nil {
+		return srv.(StreamManagerServiceServer).ECConversionStatus(ctx, in)
 	}
-	l = len(m.CodeDes)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StreamManagerService/ECConversionStatus",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamManagerServiceServer).ECConversionStatus(ctx, req.(*ECConversionStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *StatusRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var _StreamManagerService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.StreamManagerService",
+	HandlerType: (*StreamManagerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StreamInfo",
+			Handler:    _StreamManagerService_StreamInfo_Handler,
+		},
+		{
+			MethodName: "ExtentInfo",
+			Handler:    _StreamManagerService_ExtentInfo_Handler,
+		},
+		{
+			MethodName: "NodesInfo",
+			Handler:    _StreamManagerService_NodesInfo_Handler,
+		},
+		{
+			MethodName: "Status",
+			Handler:    _StreamManagerService_Status_Handler,
+		},
+		{
+			MethodName: "VerifyExtent",
+			Handler:    _StreamManagerService_VerifyExtent_Handler,
+		},
+		{
+			MethodName: "CheckCommitLength",
+			Handler:    _StreamManagerService_CheckCommitLength_Handler,
+		},
+		{
+			MethodName: "StreamAllocExtent",
+			Handler:    _StreamManagerService_StreamAllocExtent_Handler,
+		},
+		{
+			MethodName: "StreamPunchHoles",
+			Handler:    _StreamManagerService_StreamPunchHoles_Handler,
+		},
+		{
+			MethodName: "CreateStream",
+			Handler:    _StreamManagerService_CreateStream_Handler,
+		},
+		{
+			MethodName: "RegisterNode",
+			Handler:    _StreamManagerService_RegisterNode_Handler,
+		},
+		{
+			MethodName: "RegisterDisk",
+			Handler:    _StreamManagerService_RegisterDisk_Handler,
+		},
+		{
+			MethodName: "DecommissionNode",
+			Handler:    _StreamManagerService_DecommissionNode_Handler,
+		},
+		{
+			MethodName: "Truncate",
+			Handler:    _StreamManagerService_Truncate_Handler,
+		},
+		{
+			MethodName: "DeleteStream",
+			Handler:    _StreamManagerService_DeleteStream_Handler,
+		},
+		{
+			MethodName: "ReportInventory",
+			Handler:    _StreamManagerService_ReportInventory_Handler,
+		},
+		{
+			MethodName: "MultiModifySplit",
+			Handler:    _StreamManagerService_MultiModifySplit_Handler,
+		},
+		{
+			MethodName: "SnapshotPartition",
+			Handler:    _StreamManagerService_SnapshotPartition_Handler,
+		},
+		{
+			MethodName: "RestoreSnapshot",
+			Handler:    _StreamManagerService_RestoreSnapshot_Handler,
+		},
+		{
+			MethodName: "DeleteSnapshot",
+			Handler:    _StreamManagerService_DeleteSnapshot_Handler,
+		},
+		{
+			MethodName: "SetECPolicy",
+			Handler:    _StreamManagerService_SetECPolicy_Handler,
+		},
+		{
+			MethodName: "ECConversionStatus",
+			Handler:    _StreamManagerService_ECConversionStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pb.proto",
 }
 
-func (m *StatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ErasureCodec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ErasureCodec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ErasureCodec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Code != 0 {
-		n += 1 + sovPb(uint64(m.Code))
+	if m.LocalGroups != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.LocalGroups))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.CodeDes)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if m.Type != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PunchHolesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AppendRequestHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AppendRequestHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AppendRequestHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.StreamID != 0 {
-		n += 1 + sovPb(uint64(m.StreamID))
+	if len(m.Chain) > 0 {
+		for iNdEx := len(m.Chain) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Chain[iNdEx])
+			copy(dAtA[i:], m.Chain[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Chain[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
-	if len(m.ExtentIDs) > 0 {
-		l = 0
-		for _, e := range m.ExtentIDs {
-			l += sovPb(uint64(e))
+	if len(m.Blocks) > 0 {
+		dAtA2 := make([]byte, len(m.Blocks)*10)
+		var j1 int
+		for _, num := range m.Blocks {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
 		}
-		n += 1 + sovPb(uint64(l)) + l
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintPb(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.OwnerKey)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if m.MustSync {
+		i--
+		if m.MustSync {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
 	if m.Revision != 0 {
-		n += 1 + sovPb(uint64(m.Revision))
-	}
-	return n
-}
-
-func (m *PunchHolesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+		i = encodeVarintPb(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x20
 	}
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovPb(uint64(m.Code))
+	if m.Commit != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Commit))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.CodeDes)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if m.Eversion != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Eversion))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Stream != nil {
-		l = m.Stream.Size()
-		n += 1 + l + sovPb(uint64(l))
+	if m.ExtentID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ExtentID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MemberValue) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ID != 0 {
-		n += 1 + sovPb(uint64(m.ID))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	l = len(m.GrpcURL)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+func (m *AppendRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ExtentInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AppendRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AppendRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ExtentID != 0 {
-		n += 1 + sovPb(uint64(m.ExtentID))
-	}
-	if len(m.Replicates) > 0 {
-		l = 0
-		for _, e := range m.Replicates {
-			l += sovPb(uint64(e))
-		}
-		n += 1 + sovPb(uint64(l)) + l
-	}
-	if len(m.Parity) > 0 {
-		l = 0
-		for _, e := range m.Parity {
-			l += sovPb(uint64(e))
+	if m.Data != nil {
+		{
+			size := m.Data.Size()
+			i -= size
+			if _, err := m.Data.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
 		}
-		n += 1 + sovPb(uint64(l)) + l
-	}
-	if m.Eversion != 0 {
-		n += 1 + sovPb(uint64(m.Eversion))
-	}
-	if m.Refs != 0 {
-		n += 1 + sovPb(uint64(m.Refs))
 	}
-	if m.SealedLength != 0 {
-		n += 1 + sovPb(uint64(m.SealedLength))
+	return len(dAtA) - i, nil
+}
+
+func (m *AppendRequest_Header) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AppendRequest_Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Avali != 0 {
-		n += 1 + sovPb(uint64(m.Avali))
+	return len(dAtA) - i, nil
+}
+func (m *AppendRequest_Payload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AppendRequest_Payload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Payload != nil {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.ReplicateDisks) > 0 {
-		l = 0
-		for _, e := range m.ReplicateDisks {
-			l += sovPb(uint64(e))
-		}
-		n += 1 + sovPb(uint64(l)) + l
+	return len(dAtA) - i, nil
+}
+func (m *AppendResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.ParityDisk) > 0 {
-		l = 0
-		for _, e := range m.ParityDisk {
-			l += sovPb(uint64(e))
+	return dAtA[:n], nil
+}
+
+func (m *AppendResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AppendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.End != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.End))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Offsets) > 0 {
+		dAtA5 := make([]byte, len(m.Offsets)*10)
+		var j4 int
+		for _, num := range m.Offsets {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
 		}
-		n += 1 + sovPb(uint64(l)) + l
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintPb(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *StreamInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CreateExtentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateExtentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateExtentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.StreamID != 0 {
-		n += 1 + sovPb(uint64(m.StreamID))
+		i = encodeVarintPb(dAtA, i, uint64(m.StreamID))
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.ExtentIDs) > 0 {
-		l = 0
-		for _, e := range m.ExtentIDs {
-			l += sovPb(uint64(e))
-		}
-		n += 1 + sovPb(uint64(l)) + l
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateExtentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *NodeInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CreateExtentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateExtentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExtentID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ExtentID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReadBlocksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadBlocksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReadBlocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.NodeID != 0 {
-		n += 1 + sovPb(uint64(m.NodeID))
+	if m.OnlyLastBlock {
+		i--
+		if m.OnlyLastBlock {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if m.Eversion != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Eversion))
+		i--
+		dAtA[i] = 0x20
 	}
-	if len(m.Disks) > 0 {
-		l = 0
-		for _, e := range m.Disks {
-			l += sovPb(uint64(e))
+	if m.NumOfBlocks != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.NumOfBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Offset != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ExtentID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ExtentID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReadBlockResponseHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadBlockResponseHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReadBlockResponseHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlockSizes) > 0 {
+		dAtA7 := make([]byte, len(m.BlockSizes)*10)
+		var j6 int
+		for _, num := range m.BlockSizes {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintPb(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.End != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.End))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Offsets) > 0 {
+		dAtA9 := make([]byte, len(m.Offsets)*10)
+		var j8 int
+		for _, num := range m.Offsets {
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintPb(dAtA, i, uint64(j8))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReadBlocksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadBlocksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReadBlocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Data != nil {
+		{
+			size := m.Data.Size()
+			i -= size
+			if _, err := m.Data.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReadBlocksResponse_Header) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReadBlocksResponse_Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ReadBlocksResponse_Payload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReadBlocksResponse_Payload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Payload != nil {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Payload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Payload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Payload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitLengthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitLengthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitLengthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Seal {
+		i--
+		if m.Seal {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Revision != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ExtentID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ExtentID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitLengthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitLengthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitLengthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Length != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Length))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DF) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DF) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DF) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Online {
+		i--
+		if m.Online {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Free != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Free))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Total != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DfRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DfRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DfRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DiskIDs) > 0 {
+		dAtA12 := make([]byte, len(m.DiskIDs)*10)
+		var j11 int
+		for _, num := range m.DiskIDs {
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
+		}
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintPb(dAtA, i, uint64(j11))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tasks) > 0 {
+		for iNdEx := len(m.Tasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DfResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DfResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DfResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DiskStatus) > 0 {
+		for k := range m.DiskStatus {
+			v := m.DiskStatus[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintPb(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintPb(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.DoneTask) > 0 {
+		for iNdEx := len(m.DoneTask) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DoneTask[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecoveryTaskStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecoveryTaskStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecoveryTaskStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReadyDiskID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ReadyDiskID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Task != nil {
+		{
+			size, err := m.Task.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecoveryTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecoveryTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecoveryTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StartTime != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NodeID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.NodeID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ReplaceID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ReplaceID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ExtentID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ExtentID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RequireRecoveryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequireRecoveryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequireRecoveryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Task != nil {
+		{
+			size, err := m.Task.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RequireRecoveryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequireRecoveryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequireRecoveryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CopyResponseHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CopyResponseHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CopyResponseHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PayloadLen != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.PayloadLen))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CopyExtentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CopyExtentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CopyExtentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Eversion != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Eversion))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Size_ != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Offset != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ExtentID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ExtentID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CopyExtentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CopyExtentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CopyExtentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Data != nil {
+		{
+			size := m.Data.Size()
+			i -= size
+			if _, err := m.Data.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CopyExtentResponse_Header) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CopyExtentResponse_Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *CopyExtentResponse_Payload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CopyExtentResponse_Payload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Payload != nil {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ConvertExtentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConvertExtentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConvertExtentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Codec != nil {
+		{
+			size, err := m.Codec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Targets) > 0 {
+		for iNdEx := len(m.Targets) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Targets[iNdEx])
+			copy(dAtA[i:], m.Targets[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Targets[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.ParityShard != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ParityShard))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DataShard != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.DataShard))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Eversion != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Eversion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ExtentID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ExtentID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConvertExtentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConvertExtentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConvertExtentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Checksums) > 0 {
+		dAtA19 := make([]byte, len(m.Checksums)*10)
+		var j18 int
+		for _, num := range m.Checksums {
+			for num >= 1<<7 {
+				dAtA19[j18] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j18++
+			}
+			dAtA19[j18] = uint8(num)
+			j18++
+		}
+		i -= j18
+		copy(dAtA[i:], dAtA19[:j18])
+		i = encodeVarintPb(dAtA, i, uint64(j18))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DiskIDs) > 0 {
+		dAtA21 := make([]byte, len(m.DiskIDs)*10)
+		var j20 int
+		for _, num := range m.DiskIDs {
+			for num >= 1<<7 {
+				dAtA21[j20] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j20++
+			}
+			dAtA21[j20] = uint8(num)
+			j20++
+		}
+		i -= j20
+		copy(dAtA[i:], dAtA21[:j20])
+		i = encodeVarintPb(dAtA, i, uint64(j20))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.FragmentSize != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.FragmentSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExtentChecksumRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtentChecksumRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtentChecksumRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Cached {
+		i--
+		if m.Cached {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Eversion != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Eversion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ExtentID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ExtentID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExtentChecksumResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtentChecksumResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtentChecksumResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Length != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Length))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Checksum != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Checksum))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WriteFragmentHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WriteFragmentHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WriteFragmentHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Size_ != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ExtentID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ExtentID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WriteFragmentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WriteFragmentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WriteFragmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Data != nil {
+		{
+			size := m.Data.Size()
+			i -= size
+			if _, err := m.Data.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WriteFragmentRequest_Header) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WriteFragmentRequest_Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *WriteFragmentRequest_Payload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WriteFragmentRequest_Payload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Payload != nil {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *WriteFragmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WriteFragmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WriteFragmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DiskID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.DiskID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReAvaliRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReAvaliRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReAvaliRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Eversion != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Eversion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ExtentID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ExtentID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReAvaliResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReAvaliResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReAvaliResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReconcileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReconcileRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReconcileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WalStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WalStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WalStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *WalStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WalStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WalStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxLatency != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.MaxLatency))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Latency != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Latency))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Bytes != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Bytes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Entries != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Entries))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Commits != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Commits))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Dir) > 0 {
+		i -= len(m.Dir)
+		copy(dAtA[i:], m.Dir)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Dir)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WalStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WalStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WalStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Bypassed != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Bypassed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Dirs) > 0 {
+		for iNdEx := len(m.Dirs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Dirs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteExtentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteExtentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteExtentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExtentID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ExtentID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteExtentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteExtentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteExtentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllocExtentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllocExtentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllocExtentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExtentID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ExtentID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllocExtentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllocExtentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllocExtentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DiskID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.DiskID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckCommitLengthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckCommitLengthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckCommitLengthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Revision != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OwnerKey) > 0 {
+		i -= len(m.OwnerKey)
+		copy(dAtA[i:], m.OwnerKey)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.OwnerKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StreamID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.StreamID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckCommitLengthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckCommitLengthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckCommitLengthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastExInfo != nil {
+		{
+			size, err := m.LastExInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.End != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.End))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StreamInfo != nil {
+		{
+			size, err := m.StreamInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamAllocExtentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamAllocExtentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamAllocExtentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.End != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.End))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Revision != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OwnerKey) > 0 {
+		i -= len(m.OwnerKey)
+		copy(dAtA[i:], m.OwnerKey)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.OwnerKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StreamID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.StreamID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamAllocExtentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamAllocExtentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamAllocExtentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastExInfo != nil {
+		{
+			size, err := m.LastExInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.StreamInfo != nil {
+		{
+			size, err := m.StreamInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StreamIDs) > 0 {
+		dAtA28 := make([]byte, len(m.StreamIDs)*10)
+		var j27 int
+		for _, num := range m.StreamIDs {
+			for num >= 1<<7 {
+				dAtA28[j27] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j27++
+			}
+			dAtA28[j27] = uint8(num)
+			j27++
+		}
+		i -= j27
+		copy(dAtA[i:], dAtA28[:j27])
+		i = encodeVarintPb(dAtA, i, uint64(j27))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Extents) > 0 {
+		for k := range m.Extents {
+			v := m.Extents[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintPb(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintPb(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Streams) > 0 {
+		for k := range m.Streams {
+			v := m.Streams[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintPb(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintPb(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExtentInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtentInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtentInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExtentID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ExtentID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExtentInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtentInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtentInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExInfo != nil {
+		{
+			size, err := m.ExInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NodesInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodesInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodesInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *NodesInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodesInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodesInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Decommission) > 0 {
+		for k := range m.Decommission {
+			v := m.Decommission[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintPb(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintPb(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Nodes) > 0 {
+		for k := range m.Nodes {
+			v := m.Nodes[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintPb(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintPb(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DecommissionProgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecommissionProgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DecommissionProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Unsealed != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Unsealed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Running != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Running))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Remaining != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Remaining))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.NodeID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.NodeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DecommissionNodeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecommissionNodeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DecommissionNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Cancel {
+		i--
+		if m.Cancel {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.NodeID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.NodeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DecommissionNodeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecommissionNodeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DecommissionNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterNodeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterNodeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Topology != nil {
+		{
+			size, err := m.Topology.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DiskUUIDs) > 0 {
+		for iNdEx := len(m.DiskUUIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DiskUUIDs[iNdEx])
+			copy(dAtA[i:], m.DiskUUIDs[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.DiskUUIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Topology) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Topology) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Topology) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Host) > 0 {
+		i -= len(m.Host)
+		copy(dAtA[i:], m.Host)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Host)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Rack) > 0 {
+		i -= len(m.Rack)
+		copy(dAtA[i:], m.Rack)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Rack)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Zone) > 0 {
+		i -= len(m.Zone)
+		copy(dAtA[i:], m.Zone)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Zone)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterNodeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterNodeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DiskUUIDs) > 0 {
+		for k := range m.DiskUUIDs {
+			v := m.DiskUUIDs[k]
+			baseI := i
+			i = encodeVarintPb(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintPb(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.NodeId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.NodeId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterDiskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterDiskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterDiskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReplaceDiskID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ReplaceDiskID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.DiskUUID) > 0 {
+		i -= len(m.DiskUUID)
+		copy(dAtA[i:], m.DiskUUID)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.DiskUUID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.NodeID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.NodeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterDiskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterDiskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterDiskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DiskID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.DiskID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateStreamRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateStreamRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Codec != nil {
+		{
+			size, err := m.Codec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Replication != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Replication))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ParityShard != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ParityShard))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DataShard != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.DataShard))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Extent != nil {
+		{
+			size, err := m.Extent.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Stream != nil {
+		{
+			size, err := m.Stream.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TruncateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TruncateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TruncateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Revision != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.OwnerKey) > 0 {
+		i -= len(m.OwnerKey)
+		copy(dAtA[i:], m.OwnerKey)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.OwnerKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ExtentID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ExtentID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StreamID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.StreamID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TruncateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TruncateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TruncateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpdatedStreamInfo != nil {
+		{
+			size, err := m.UpdatedStreamInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExtentReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtentReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtentReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ModTime != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ModTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Length != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Length))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Sealed {
+		i--
+		if m.Sealed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DiskID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.DiskID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ExtentID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ExtentID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CopyReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CopyReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CopyReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ModTime != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ModTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DiskID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.DiskID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ReplaceID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ReplaceID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ExtentID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ExtentID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InventoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InventoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InventoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Copies) > 0 {
+		for iNdEx := len(m.Copies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Copies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Extents) > 0 {
+		for iNdEx := len(m.Extents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Extents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.NodeID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.NodeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InventoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InventoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InventoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StaleCopies) > 0 {
+		for iNdEx := len(m.StaleCopies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StaleCopies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Mismatched) > 0 {
+		dAtA40 := make([]byte, len(m.Mismatched)*10)
+		var j39 int
+		for _, num := range m.Mismatched {
+			for num >= 1<<7 {
+				dAtA40[j39] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j39++
+			}
+			dAtA40[j39] = uint8(num)
+			j39++
+		}
+		i -= j39
+		copy(dAtA[i:], dAtA40[:j39])
+		i = encodeVarintPb(dAtA, i, uint64(j39))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Missing) > 0 {
+		dAtA42 := make([]byte, len(m.Missing)*10)
+		var j41 int
+		for _, num := range m.Missing {
+			for num >= 1<<7 {
+				dAtA42[j41] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j41++
+			}
+			dAtA42[j41] = uint8(num)
+			j41++
+		}
+		i -= j41
+		copy(dAtA[i:], dAtA42[:j41])
+		i = encodeVarintPb(dAtA, i, uint64(j41))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Orphans) > 0 {
+		dAtA44 := make([]byte, len(m.Orphans)*10)
+		var j43 int
+		for _, num := range m.Orphans {
+			for num >= 1<<7 {
+				dAtA44[j43] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j43++
+			}
+			dAtA44[j43] = uint8(num)
+			j43++
+		}
+		i -= j43
+		copy(dAtA[i:], dAtA44[:j43])
+		i = encodeVarintPb(dAtA, i, uint64(j43))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteStreamRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteStreamRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Revision != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OwnerKey) > 0 {
+		i -= len(m.OwnerKey)
+		copy(dAtA[i:], m.OwnerKey)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.OwnerKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StreamID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.StreamID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MultiModifySplitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiModifySplitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiModifySplitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MetaStreamSealedLength != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.MetaStreamSealedLength))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RowStreamSealedLength != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.RowStreamSealedLength))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.LogStreamSealedLength != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.LogStreamSealedLength))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Revision != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.OwnerKey) > 0 {
+		i -= len(m.OwnerKey)
+		copy(dAtA[i:], m.OwnerKey)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.OwnerKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MidKey) > 0 {
+		i -= len(m.MidKey)
+		copy(dAtA[i:], m.MidKey)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.MidKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PartID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.PartID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MultiModifySplitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiModifySplitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiModifySplitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SnapshotPartitionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotPartitionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotPartitionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MetaStreamSealedLength != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.MetaStreamSealedLength))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RowStreamSealedLength != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.RowStreamSealedLength))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LogStreamSealedLength != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.LogStreamSealedLength))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Revision != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OwnerKey) > 0 {
+		i -= len(m.OwnerKey)
+		copy(dAtA[i:], m.OwnerKey)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.OwnerKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PartID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.PartID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SnapshotPartitionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotPartitionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotPartitionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SnapshotID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.SnapshotID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RestoreSnapshotRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestoreSnapshotRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestoreSnapshotRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Revision != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OwnerKey) > 0 {
+		i -= len(m.OwnerKey)
+		copy(dAtA[i:], m.OwnerKey)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.OwnerKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SnapshotID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.SnapshotID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RestoreSnapshotResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestoreSnapshotResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestoreSnapshotResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteSnapshotRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteSnapshotRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteSnapshotRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SnapshotID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.SnapshotID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteSnapshotResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteSnapshotResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteSnapshotResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *StatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Leader != nil {
+		{
+			size, err := m.Leader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PunchHolesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PunchHolesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PunchHolesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Revision != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.OwnerKey) > 0 {
+		i -= len(m.OwnerKey)
+		copy(dAtA[i:], m.OwnerKey)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.OwnerKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ExtentIDs) > 0 {
+		dAtA47 := make([]byte, len(m.ExtentIDs)*10)
+		var j46 int
+		for _, num := range m.ExtentIDs {
+			for num >= 1<<7 {
+				dAtA47[j46] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j46++
+			}
+			dAtA47[j46] = uint8(num)
+			j46++
+		}
+		i -= j46
+		copy(dAtA[i:], dAtA47[:j46])
+		i = encodeVarintPb(dAtA, i, uint64(j46))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StreamID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.StreamID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PunchHolesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PunchHolesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PunchHolesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Stream != nil {
+		{
+			size, err := m.Stream.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ECPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ECPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ECPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Codec != nil {
+		{
+			size, err := m.Codec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MinAge != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.MinAge))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ParityShard != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ParityShard))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DataShard != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.DataShard))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetECPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetECPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetECPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Policy != nil {
+		{
+			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StreamID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.StreamID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetECPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetECPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetECPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ECConversionStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ECConversionStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ECConversionStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StreamID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.StreamID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ECConversionProgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ECConversionProgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ECConversionProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ConvertedBytes != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ConvertedBytes))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.PendingBytes != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.PendingBytes))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Converted != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Converted))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Running != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Running))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Pending != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Pending))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Policy != nil {
+		{
+			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StreamID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.StreamID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ECConversionStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ECConversionStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ECConversionStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Progress) > 0 {
+		for iNdEx := len(m.Progress) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Progress[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerifyExtentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifyExtentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifyExtentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExtentID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ExtentID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerifyExtentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifyExtentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifyExtentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Extent != nil {
+		{
+			size, err := m.Extent.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Corrupted != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Corrupted))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Checksums) > 0 {
+		dAtA54 := make([]byte, len(m.Checksums)*10)
+		var j53 int
+		for _, num1 := range m.Checksums {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA54[j53] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j53++
+			}
+			dAtA54[j53] = uint8(num)
+			j53++
+		}
+		i -= j53
+		copy(dAtA[i:], dAtA54[:j53])
+		i = encodeVarintPb(dAtA, i, uint64(j53))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MemberValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MemberValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MemberValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GrpcURL) > 0 {
+		i -= len(m.GrpcURL)
+		copy(dAtA[i:], m.GrpcURL)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.GrpcURL)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExtentInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtentInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtentInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeletedTime != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.DeletedTime))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.ChecksumMask != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ChecksumMask))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.Checksums) > 0 {
+		dAtA56 := make([]byte, len(m.Checksums)*10)
+		var j55 int
+		for _, num := range m.Checksums {
+			for num >= 1<<7 {
+				dAtA56[j55] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j55++
+			}
+			dAtA56[j55] = uint8(num)
+			j55++
+		}
+		i -= j55
+		copy(dAtA[i:], dAtA56[:j55])
+		i = encodeVarintPb(dAtA, i, uint64(j55))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Codec != nil {
+		{
+			size, err := m.Codec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.SealedTime != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.SealedTime))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.FragmentSize != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.FragmentSize))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.ParityDisk) > 0 {
+		dAtA59 := make([]byte, len(m.ParityDisk)*10)
+		var j58 int
+		for _, num := range m.ParityDisk {
+			for num >= 1<<7 {
+				dAtA59[j58] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j58++
+			}
+			dAtA59[j58] = uint8(num)
+			j58++
+		}
+		i -= j58
+		copy(dAtA[i:], dAtA59[:j58])
+		i = encodeVarintPb(dAtA, i, uint64(j58))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ReplicateDisks) > 0 {
+		dAtA61 := make([]byte, len(m.ReplicateDisks)*10)
+		var j60 int
+		for _, num := range m.ReplicateDisks {
+			for num >= 1<<7 {
+				dAtA61[j60] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j60++
+			}
+			dAtA61[j60] = uint8(num)
+			j60++
+		}
+		i -= j60
+		copy(dAtA[i:], dAtA61[:j60])
+		i = encodeVarintPb(dAtA, i, uint64(j60))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Avali != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Avali))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SealedLength != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.SealedLength))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Refs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Refs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Eversion != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Eversion))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Parity) > 0 {
+		dAtA63 := make([]byte, len(m.Parity)*10)
+		var j62 int
+		for _, num := range m.Parity {
+			for num >= 1<<7 {
+				dAtA63[j62] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j62++
+			}
+			dAtA63[j62] = uint8(num)
+			j62++
+		}
+		i -= j62
+		copy(dAtA[i:], dAtA63[:j62])
+		i = encodeVarintPb(dAtA, i, uint64(j62))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Replicates) > 0 {
+		dAtA65 := make([]byte, len(m.Replicates)*10)
+		var j64 int
+		for _, num := range m.Replicates {
+			for num >= 1<<7 {
+				dAtA65[j64] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j64++
+			}
+			dAtA65[j64] = uint8(num)
+			j64++
+		}
+		i -= j64
+		copy(dAtA[i:], dAtA65[:j64])
+		i = encodeVarintPb(dAtA, i, uint64(j64))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ExtentID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ExtentID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Codec != nil {
+		{
+			size, err := m.Codec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.EcPolicy != nil {
+		{
+			size, err := m.EcPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Replication != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Replication))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ExtentIDs) > 0 {
+		dAtA69 := make([]byte, len(m.ExtentIDs)*10)
+		var j68 int
+		for _, num := range m.ExtentIDs {
+			for num >= 1<<7 {
+				dAtA69[j68] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j68++
+			}
+			dAtA69[j68] = uint8(num)
+			j68++
+		}
+		i -= j68
+		copy(dAtA[i:], dAtA69[:j68])
+		i = encodeVarintPb(dAtA, i, uint64(j68))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StreamID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.StreamID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NodeInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Topology != nil {
+		{
+			size, err := m.Topology.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Decommissioning {
+		i--
+		if m.Decommissioning {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Disks) > 0 {
+		dAtA72 := make([]byte, len(m.Disks)*10)
+		var j71 int
+		for _, num := range m.Disks {
+			for num >= 1<<7 {
+				dAtA72[j71] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j71++
+			}
+			dAtA72[j71] = uint8(num)
+			j71++
+		}
+		i -= j71
+		copy(dAtA[i:], dAtA72[:j71])
+		i = encodeVarintPb(dAtA, i, uint64(j71))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.NodeID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.NodeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DiskInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DiskInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DiskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Uuid) > 0 {
+		i -= len(m.Uuid)
+		copy(dAtA[i:], m.Uuid)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Uuid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Online {
+		i--
+		if m.Online {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DiskID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.DiskID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MetaBackup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MetaBackup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MetaBackup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Kvs) > 0 {
+		for iNdEx := len(m.Kvs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Kvs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.CreatedTime != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.CreatedTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Revision != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Version != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MetaKV) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MetaKV) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MetaKV) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPb(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPb(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ErasureCodec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovPb(uint64(m.Type))
+	}
+	if m.LocalGroups != 0 {
+		n += 1 + sovPb(uint64(m.LocalGroups))
+	}
+	return n
+}
+
+func (m *AppendRequestHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExtentID != 0 {
+		n += 1 + sovPb(uint64(m.ExtentID))
+	}
+	if m.Eversion != 0 {
+		n += 1 + sovPb(uint64(m.Eversion))
+	}
+	if m.Commit != 0 {
+		n += 1 + sovPb(uint64(m.Commit))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovPb(uint64(m.Revision))
+	}
+	if m.MustSync {
+		n += 2
+	}
+	if len(m.Blocks) > 0 {
+		l = 0
+		for _, e := range m.Blocks {
+			l += sovPb(uint64(e))
+		}
+		n += 1 + sovPb(uint64(l)) + l
+	}
+	if len(m.Chain) > 0 {
+		for _, s := range m.Chain {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AppendRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data != nil {
+		n += m.Data.Size()
+	}
+	return n
+}
+
+func (m *AppendRequest_Header) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+func (m *AppendRequest_Payload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Payload != nil {
+		l = len(m.Payload)
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+func (m *AppendResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if len(m.Offsets) > 0 {
+		l = 0
+		for _, e := range m.Offsets {
+			l += sovPb(uint64(e))
+		}
+		n += 1 + sovPb(uint64(l)) + l
+	}
+	if m.End != 0 {
+		n += 1 + sovPb(uint64(m.End))
+	}
+	return n
+}
+
+func (m *CreateExtentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamID != 0 {
+		n += 1 + sovPb(uint64(m.StreamID))
+	}
+	return n
+}
+
+func (m *CreateExtentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.ExtentID != 0 {
+		n += 1 + sovPb(uint64(m.ExtentID))
+	}
+	return n
+}
+
+func (m *ReadBlocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExtentID != 0 {
+		n += 1 + sovPb(uint64(m.ExtentID))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovPb(uint64(m.Offset))
+	}
+	if m.NumOfBlocks != 0 {
+		n += 1 + sovPb(uint64(m.NumOfBlocks))
+	}
+	if m.Eversion != 0 {
+		n += 1 + sovPb(uint64(m.Eversion))
+	}
+	if m.OnlyLastBlock {
+		n += 2
+	}
+	return n
+}
+
+func (m *ReadBlockResponseHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if len(m.Offsets) > 0 {
+		l = 0
+		for _, e := range m.Offsets {
+			l += sovPb(uint64(e))
+		}
+		n += 1 + sovPb(uint64(l)) + l
+	}
+	if m.End != 0 {
+		n += 1 + sovPb(uint64(m.End))
+	}
+	if len(m.BlockSizes) > 0 {
+		l = 0
+		for _, e := range m.BlockSizes {
+			l += sovPb(uint64(e))
+		}
+		n += 1 + sovPb(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *ReadBlocksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data != nil {
+		n += m.Data.Size()
+	}
+	return n
+}
+
+func (m *ReadBlocksResponse_Header) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+func (m *ReadBlocksResponse_Payload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Payload != nil {
+		l = len(m.Payload)
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+func (m *Payload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+
+func (m *CommitLengthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExtentID != 0 {
+		n += 1 + sovPb(uint64(m.ExtentID))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovPb(uint64(m.Revision))
+	}
+	if m.Seal {
+		n += 2
+	}
+	return n
+}
+
+func (m *CommitLengthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Length != 0 {
+		n += 1 + sovPb(uint64(m.Length))
+	}
+	return n
+}
+
+func (m *DF) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Total != 0 {
+		n += 1 + sovPb(uint64(m.Total))
+	}
+	if m.Free != 0 {
+		n += 1 + sovPb(uint64(m.Free))
+	}
+	if m.Online {
+		n += 2
+	}
+	return n
+}
+
+func (m *DfRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tasks) > 0 {
+		for _, e := range m.Tasks {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if len(m.DiskIDs) > 0 {
+		l = 0
+		for _, e := range m.DiskIDs {
+			l += sovPb(uint64(e))
+		}
+		n += 1 + sovPb(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *DfResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DoneTask) > 0 {
+		for _, e := range m.DoneTask {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if len(m.DiskStatus) > 0 {
+		for k, v := range m.DiskStatus {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovPb(uint64(l))
+			}
+			mapEntrySize := 1 + sovPb(uint64(k)) + l
+			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *RecoveryTaskStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Task != nil {
+		l = m.Task.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.ReadyDiskID != 0 {
+		n += 1 + sovPb(uint64(m.ReadyDiskID))
+	}
+	return n
+}
+
+func (m *RecoveryTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExtentID != 0 {
+		n += 1 + sovPb(uint64(m.ExtentID))
+	}
+	if m.ReplaceID != 0 {
+		n += 1 + sovPb(uint64(m.ReplaceID))
+	}
+	if m.NodeID != 0 {
+		n += 1 + sovPb(uint64(m.NodeID))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovPb(uint64(m.StartTime))
+	}
+	return n
+}
+
+func (m *RequireRecoveryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Task != nil {
+		l = m.Task.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+
+func (m *RequireRecoveryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+
+func (m *CopyResponseHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.PayloadLen != 0 {
+		n += 1 + sovPb(uint64(m.PayloadLen))
+	}
+	return n
+}
+
+func (m *CopyExtentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExtentID != 0 {
+		n += 1 + sovPb(uint64(m.ExtentID))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovPb(uint64(m.Offset))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovPb(uint64(m.Size_))
+	}
+	if m.Eversion != 0 {
+		n += 1 + sovPb(uint64(m.Eversion))
+	}
+	return n
+}
+
+func (m *CopyExtentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data != nil {
+		n += m.Data.Size()
+	}
+	return n
+}
+
+func (m *CopyExtentResponse_Header) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+func (m *CopyExtentResponse_Payload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Payload != nil {
+		l = len(m.Payload)
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+func (m *ConvertExtentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExtentID != 0 {
+		n += 1 + sovPb(uint64(m.ExtentID))
+	}
+	if m.Eversion != 0 {
+		n += 1 + sovPb(uint64(m.Eversion))
+	}
+	if m.DataShard != 0 {
+		n += 1 + sovPb(uint64(m.DataShard))
+	}
+	if m.ParityShard != 0 {
+		n += 1 + sovPb(uint64(m.ParityShard))
+	}
+	if len(m.Targets) > 0 {
+		for _, s := range m.Targets {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.Codec != nil {
+		l = m.Codec.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+
+func (m *ConvertExtentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.FragmentSize != 0 {
+		n += 1 + sovPb(uint64(m.FragmentSize))
+	}
+	if len(m.DiskIDs) > 0 {
+		l = 0
+		for _, e := range m.DiskIDs {
+			l += sovPb(uint64(e))
+		}
+		n += 1 + sovPb(uint64(l)) + l
+	}
+	if len(m.Checksums) > 0 {
+		l = 0
+		for _, e := range m.Checksums {
+			l += sovPb(uint64(e))
+		}
+		n += 1 + sovPb(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *ExtentChecksumRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExtentID != 0 {
+		n += 1 + sovPb(uint64(m.ExtentID))
+	}
+	if m.Eversion != 0 {
+		n += 1 + sovPb(uint64(m.Eversion))
+	}
+	if m.Cached {
+		n += 2
+	}
+	return n
+}
+
+func (m *ExtentChecksumResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Checksum != 0 {
+		n += 1 + sovPb(uint64(m.Checksum))
+	}
+	if m.Length != 0 {
+		n += 1 + sovPb(uint64(m.Length))
+	}
+	return n
+}
+
+func (m *WriteFragmentHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExtentID != 0 {
+		n += 1 + sovPb(uint64(m.ExtentID))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovPb(uint64(m.Size_))
+	}
+	return n
+}
+
+func (m *WriteFragmentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data != nil {
+		n += m.Data.Size()
+	}
+	return n
+}
+
+func (m *WriteFragmentRequest_Header) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+func (m *WriteFragmentRequest_Payload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Payload != nil {
+		l = len(m.Payload)
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+func (m *WriteFragmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.DiskID != 0 {
+		n += 1 + sovPb(uint64(m.DiskID))
+	}
+	return n
+}
+
+func (m *ReAvaliRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExtentID != 0 {
+		n += 1 + sovPb(uint64(m.ExtentID))
+	}
+	if m.Eversion != 0 {
+		n += 1 + sovPb(uint64(m.Eversion))
+	}
+	return n
+}
+
+func (m *ReAvaliResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+
+func (m *ReconcileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DryRun {
+		n += 2
+	}
+	return n
+}
+
+func (m *WalStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *WalStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Dir)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Commits != 0 {
+		n += 1 + sovPb(uint64(m.Commits))
+	}
+	if m.Entries != 0 {
+		n += 1 + sovPb(uint64(m.Entries))
+	}
+	if m.Bytes != 0 {
+		n += 1 + sovPb(uint64(m.Bytes))
+	}
+	if m.Latency != 0 {
+		n += 1 + sovPb(uint64(m.Latency))
+	}
+	if m.MaxLatency != 0 {
+		n += 1 + sovPb(uint64(m.MaxLatency))
+	}
+	return n
+}
+
+func (m *WalStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if len(m.Dirs) > 0 {
+		for _, e := range m.Dirs {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.Bypassed != 0 {
+		n += 1 + sovPb(uint64(m.Bypassed))
+	}
+	return n
+}
+
+func (m *DeleteExtentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExtentID != 0 {
+		n += 1 + sovPb(uint64(m.ExtentID))
+	}
+	return n
+}
+
+func (m *DeleteExtentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+
+func (m *AllocExtentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExtentID != 0 {
+		n += 1 + sovPb(uint64(m.ExtentID))
+	}
+	return n
+}
+
+func (m *AllocExtentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.DiskID != 0 {
+		n += 1 + sovPb(uint64(m.DiskID))
+	}
+	return n
+}
+
+func (m *CheckCommitLengthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamID != 0 {
+		n += 1 + sovPb(uint64(m.StreamID))
+	}
+	l = len(m.OwnerKey)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovPb(uint64(m.Revision))
+	}
+	return n
+}
+
+func (m *CheckCommitLengthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.StreamInfo != nil {
+		l = m.StreamInfo.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.End != 0 {
+		n += 1 + sovPb(uint64(m.End))
+	}
+	if m.LastExInfo != nil {
+		l = m.LastExInfo.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+
+func (m *StreamAllocExtentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamID != 0 {
+		n += 1 + sovPb(uint64(m.StreamID))
+	}
+	l = len(m.OwnerKey)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovPb(uint64(m.Revision))
+	}
+	if m.End != 0 {
+		n += 1 + sovPb(uint64(m.End))
+	}
+	return n
+}
+
+func (m *StreamAllocExtentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.StreamInfo != nil {
+		l = m.StreamInfo.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.LastExInfo != nil {
+		l = m.LastExInfo.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+
+func (m *StreamInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StreamIDs) > 0 {
+		l = 0
+		for _, e := range m.StreamIDs {
+			l += sovPb(uint64(e))
+		}
+		n += 1 + sovPb(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *StreamInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if len(m.Streams) > 0 {
+		for k, v := range m.Streams {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovPb(uint64(l))
+			}
+			mapEntrySize := 1 + sovPb(uint64(k)) + l
+			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Extents) > 0 {
+		for k, v := range m.Extents {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovPb(uint64(l))
+			}
+			mapEntrySize := 1 + sovPb(uint64(k)) + l
+			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *ExtentInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExtentID != 0 {
+		n += 1 + sovPb(uint64(m.ExtentID))
+	}
+	return n
+}
+
+func (m *ExtentInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.ExInfo != nil {
+		l = m.ExInfo.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+
+func (m *NodesInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *NodesInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if len(m.Nodes) > 0 {
+		for k, v := range m.Nodes {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovPb(uint64(l))
+			}
+			mapEntrySize := 1 + sovPb(uint64(k)) + l
+			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Decommission) > 0 {
+		for k, v := range m.Decommission {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovPb(uint64(l))
+			}
+			mapEntrySize := 1 + sovPb(uint64(k)) + l
+			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *DecommissionProgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NodeID != 0 {
+		n += 1 + sovPb(uint64(m.NodeID))
+	}
+	if m.Remaining != 0 {
+		n += 1 + sovPb(uint64(m.Remaining))
+	}
+	if m.Running != 0 {
+		n += 1 + sovPb(uint64(m.Running))
+	}
+	if m.Unsealed != 0 {
+		n += 1 + sovPb(uint64(m.Unsealed))
+	}
+	return n
+}
+
+func (m *DecommissionNodeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NodeID != 0 {
+		n += 1 + sovPb(uint64(m.NodeID))
+	}
+	if m.Cancel {
+		n += 2
+	}
+	return n
+}
+
+func (m *DecommissionNodeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+
+func (m *RegisterNodeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if len(m.DiskUUIDs) > 0 {
+		for _, s := range m.DiskUUIDs {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.Topology != nil {
+		l = m.Topology.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+
+func (m *Topology) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Zone)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.Rack)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.Host)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+
+func (m *RegisterNodeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.NodeId != 0 {
+		n += 1 + sovPb(uint64(m.NodeId))
+	}
+	if len(m.DiskUUIDs) > 0 {
+		for k, v := range m.DiskUUIDs {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPb(uint64(len(k))) + 1 + sovPb(uint64(v))
+			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *RegisterDiskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NodeID != 0 {
+		n += 1 + sovPb(uint64(m.NodeID))
+	}
+	l = len(m.DiskUUID)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.ReplaceDiskID != 0 {
+		n += 1 + sovPb(uint64(m.ReplaceDiskID))
+	}
+	return n
+}
+
+func (m *RegisterDiskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.DiskID != 0 {
+		n += 1 + sovPb(uint64(m.DiskID))
+	}
+	return n
+}
+
+func (m *CreateStreamRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DataShard != 0 {
+		n += 1 + sovPb(uint64(m.DataShard))
+	}
+	if m.ParityShard != 0 {
+		n += 1 + sovPb(uint64(m.ParityShard))
+	}
+	if m.Replication != 0 {
+		n += 1 + sovPb(uint64(m.Replication))
+	}
+	if m.Codec != nil {
+		l = m.Codec.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Stream != nil {
+		l = m.Stream.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Extent != nil {
+		l = m.Extent.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+
+func (m *TruncateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamID != 0 {
+		n += 1 + sovPb(uint64(m.StreamID))
+	}
+	if m.ExtentID != 0 {
+		n += 1 + sovPb(uint64(m.ExtentID))
+	}
+	l = len(m.OwnerKey)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovPb(uint64(m.Revision))
+	}
+	return n
+}
+
+func (m *TruncateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.UpdatedStreamInfo != nil {
+		l = m.UpdatedStreamInfo.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+
+func (m *ExtentReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExtentID != 0 {
+		n += 1 + sovPb(uint64(m.ExtentID))
+	}
+	if m.DiskID != 0 {
+		n += 1 + sovPb(uint64(m.DiskID))
+	}
+	if m.Sealed {
+		n += 2
+	}
+	if m.Length != 0 {
+		n += 1 + sovPb(uint64(m.Length))
+	}
+	if m.ModTime != 0 {
+		n += 1 + sovPb(uint64(m.ModTime))
+	}
+	return n
+}
+
+func (m *CopyReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExtentID != 0 {
+		n += 1 + sovPb(uint64(m.ExtentID))
+	}
+	if m.ReplaceID != 0 {
+		n += 1 + sovPb(uint64(m.ReplaceID))
+	}
+	if m.DiskID != 0 {
+		n += 1 + sovPb(uint64(m.DiskID))
+	}
+	if m.ModTime != 0 {
+		n += 1 + sovPb(uint64(m.ModTime))
+	}
+	return n
+}
+
+func (m *InventoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NodeID != 0 {
+		n += 1 + sovPb(uint64(m.NodeID))
+	}
+	if len(m.Extents) > 0 {
+		for _, e := range m.Extents {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if len(m.Copies) > 0 {
+		for _, e := range m.Copies {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.DryRun {
+		n += 2
+	}
+	return n
+}
+
+func (m *InventoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if len(m.Orphans) > 0 {
+		l = 0
+		for _, e := range m.Orphans {
+			l += sovPb(uint64(e))
+		}
+		n += 1 + sovPb(uint64(l)) + l
+	}
+	if len(m.Missing) > 0 {
+		l = 0
+		for _, e := range m.Missing {
+			l += sovPb(uint64(e))
+		}
+		n += 1 + sovPb(uint64(l)) + l
+	}
+	if len(m.Mismatched) > 0 {
+		l = 0
+		for _, e := range m.Mismatched {
+			l += sovPb(uint64(e))
+		}
+		n += 1 + sovPb(uint64(l)) + l
+	}
+	if len(m.StaleCopies) > 0 {
+		for _, e := range m.StaleCopies {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeleteStreamRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamID != 0 {
+		n += 1 + sovPb(uint64(m.StreamID))
+	}
+	l = len(m.OwnerKey)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovPb(uint64(m.Revision))
+	}
+	return n
+}
+
+func (m *DeleteStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+
+func (m *MultiModifySplitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartID != 0 {
+		n += 1 + sovPb(uint64(m.PartID))
+	}
+	l = len(m.MidKey)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.OwnerKey)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovPb(uint64(m.Revision))
+	}
+	if m.LogStreamSealedLength != 0 {
+		n += 1 + sovPb(uint64(m.LogStreamSealedLength))
+	}
+	if m.RowStreamSealedLength != 0 {
+		n += 1 + sovPb(uint64(m.RowStreamSealedLength))
+	}
+	if m.MetaStreamSealedLength != 0 {
+		n += 1 + sovPb(uint64(m.MetaStreamSealedLength))
+	}
+	return n
+}
+
+func (m *MultiModifySplitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+
+func (m *SnapshotPartitionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartID != 0 {
+		n += 1 + sovPb(uint64(m.PartID))
+	}
+	l = len(m.OwnerKey)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovPb(uint64(m.Revision))
+	}
+	if m.LogStreamSealedLength != 0 {
+		n += 1 + sovPb(uint64(m.LogStreamSealedLength))
+	}
+	if m.RowStreamSealedLength != 0 {
+		n += 1 + sovPb(uint64(m.RowStreamSealedLength))
+	}
+	if m.MetaStreamSealedLength != 0 {
+		n += 1 + sovPb(uint64(m.MetaStreamSealedLength))
+	}
+	return n
+}
+
+func (m *SnapshotPartitionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.SnapshotID != 0 {
+		n += 1 + sovPb(uint64(m.SnapshotID))
+	}
+	return n
+}
+
+func (m *RestoreSnapshotRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SnapshotID != 0 {
+		n += 1 + sovPb(uint64(m.SnapshotID))
+	}
+	l = len(m.OwnerKey)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovPb(uint64(m.Revision))
+	}
+	return n
+}
+
+func (m *RestoreSnapshotResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteSnapshotRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SnapshotID != 0 {
+		n += 1 + sovPb(uint64(m.SnapshotID))
+	}
+	return n
+}
+
+func (m *DeleteSnapshotResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+
+func (m *StatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *StatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Leader != nil {
+		l = m.Leader.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+
+func (m *PunchHolesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamID != 0 {
+		n += 1 + sovPb(uint64(m.StreamID))
+	}
+	if len(m.ExtentIDs) > 0 {
+		l = 0
+		for _, e := range m.ExtentIDs {
+			l += sovPb(uint64(e))
+		}
+		n += 1 + sovPb(uint64(l)) + l
+	}
+	l = len(m.OwnerKey)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovPb(uint64(m.Revision))
+	}
+	return n
+}
+
+func (m *PunchHolesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Stream != nil {
+		l = m.Stream.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+
+func (m *ECPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DataShard != 0 {
+		n += 1 + sovPb(uint64(m.DataShard))
+	}
+	if m.ParityShard != 0 {
+		n += 1 + sovPb(uint64(m.ParityShard))
+	}
+	if m.MinAge != 0 {
+		n += 1 + sovPb(uint64(m.MinAge))
+	}
+	if m.Codec != nil {
+		l = m.Codec.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+
+func (m *SetECPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamID != 0 {
+		n += 1 + sovPb(uint64(m.StreamID))
+	}
+	if m.Policy != nil {
+		l = m.Policy.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+
+func (m *SetECPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+
+func (m *ECConversionStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamID != 0 {
+		n += 1 + sovPb(uint64(m.StreamID))
+	}
+	return n
+}
+
+func (m *ECConversionProgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamID != 0 {
+		n += 1 + sovPb(uint64(m.StreamID))
+	}
+	if m.Policy != nil {
+		l = m.Policy.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Pending != 0 {
+		n += 1 + sovPb(uint64(m.Pending))
+	}
+	if m.Running != 0 {
+		n += 1 + sovPb(uint64(m.Running))
+	}
+	if m.Converted != 0 {
+		n += 1 + sovPb(uint64(m.Converted))
+	}
+	if m.PendingBytes != 0 {
+		n += 1 + sovPb(uint64(m.PendingBytes))
+	}
+	if m.ConvertedBytes != 0 {
+		n += 1 + sovPb(uint64(m.ConvertedBytes))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+
+func (m *ECConversionStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if len(m.Progress) > 0 {
+		for _, e := range m.Progress {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VerifyExtentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExtentID != 0 {
+		n += 1 + sovPb(uint64(m.ExtentID))
+	}
+	return n
+}
+
+func (m *VerifyExtentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if len(m.Checksums) > 0 {
+		l = 0
+		for _, e := range m.Checksums {
+			l += sovPb(uint64(e))
+		}
+		n += 1 + sovPb(uint64(l)) + l
+	}
+	if m.Corrupted != 0 {
+		n += 1 + sovPb(uint64(m.Corrupted))
+	}
+	if m.Extent != nil {
+		l = m.Extent.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+
+func (m *MemberValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovPb(uint64(m.ID))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.GrpcURL)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+
+func (m *ExtentInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExtentID != 0 {
+		n += 1 + sovPb(uint64(m.ExtentID))
+	}
+	if len(m.Replicates) > 0 {
+		l = 0
+		for _, e := range m.Replicates {
+			l += sovPb(uint64(e))
+		}
+		n += 1 + sovPb(uint64(l)) + l
+	}
+	if len(m.Parity) > 0 {
+		l = 0
+		for _, e := range m.Parity {
+			l += sovPb(uint64(e))
+		}
+		n += 1 + sovPb(uint64(l)) + l
+	}
+	if m.Eversion != 0 {
+		n += 1 + sovPb(uint64(m.Eversion))
+	}
+	if m.Refs != 0 {
+		n += 1 + sovPb(uint64(m.Refs))
+	}
+	if m.SealedLength != 0 {
+		n += 1 + sovPb(uint64(m.SealedLength))
+	}
+	if m.Avali != 0 {
+		n += 1 + sovPb(uint64(m.Avali))
+	}
+	if len(m.ReplicateDisks) > 0 {
+		l = 0
+		for _, e := range m.ReplicateDisks {
+			l += sovPb(uint64(e))
+		}
+		n += 1 + sovPb(uint64(l)) + l
+	}
+	if len(m.ParityDisk) > 0 {
+		l = 0
+		for _, e := range m.ParityDisk {
+			l += sovPb(uint64(e))
+		}
+		n += 1 + sovPb(uint64(l)) + l
+	}
+	if m.FragmentSize != 0 {
+		n += 1 + sovPb(uint64(m.FragmentSize))
+	}
+	if m.SealedTime != 0 {
+		n += 1 + sovPb(uint64(m.SealedTime))
+	}
+	if m.Codec != nil {
+		l = m.Codec.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if len(m.Checksums) > 0 {
+		l = 0
+		for _, e := range m.Checksums {
+			l += sovPb(uint64(e))
+		}
+		n += 1 + sovPb(uint64(l)) + l
+	}
+	if m.ChecksumMask != 0 {
+		n += 1 + sovPb(uint64(m.ChecksumMask))
+	}
+	if m.DeletedTime != 0 {
+		n += 1 + sovPb(uint64(m.DeletedTime))
+	}
+	return n
+}
+
+func (m *StreamInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamID != 0 {
+		n += 1 + sovPb(uint64(m.StreamID))
+	}
+	if len(m.ExtentIDs) > 0 {
+		l = 0
+		for _, e := range m.ExtentIDs {
+			l += sovPb(uint64(e))
+		}
+		n += 1 + sovPb(uint64(l)) + l
+	}
+	if m.Replication != 0 {
+		n += 1 + sovPb(uint64(m.Replication))
+	}
+	if m.EcPolicy != nil {
+		l = m.EcPolicy.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Codec != nil {
+		l = m.Codec.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+
+func (m *NodeInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NodeID != 0 {
+		n += 1 + sovPb(uint64(m.NodeID))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if len(m.Disks) > 0 {
+		l = 0
+		for _, e := range m.Disks {
+			l += sovPb(uint64(e))
+		}
+		n += 1 + sovPb(uint64(l)) + l
+	}
+	if m.Decommissioning {
+		n += 2
+	}
+	if m.Topology != nil {
+		l = m.Topology.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+
+func (m *DiskInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DiskID != 0 {
+		n += 1 + sovPb(uint64(m.DiskID))
+	}
+	if m.Online {
+		n += 2
+	}
+	l = len(m.Uuid)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+
+func (m *MetaBackup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovPb(uint64(m.Version))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovPb(uint64(m.Revision))
+	}
+	if m.CreatedTime != 0 {
+		n += 1 + sovPb(uint64(m.CreatedTime))
+	}
+	if len(m.Kvs) > 0 {
+		for _, e := range m.Kvs {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MetaKV) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+
+func sovPb(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPb(x uint64) (n int) {
+	return sovPb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ErasureCodec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ErasureCodec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ErasureCodec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= CodecType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalGroups", wireType)
+			}
+			m.LocalGroups = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LocalGroups |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AppendRequestHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppendRequestHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppendRequestHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtentID", wireType)
+			}
+			m.ExtentID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtentID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eversion", wireType)
+			}
+			m.Eversion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Eversion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			m.Commit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Commit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MustSync", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MustSync = bool(v != 0)
+		case 6:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Blocks = append(m.Blocks, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Blocks) == 0 {
+					m.Blocks = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Blocks = append(m.Blocks, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = append(m.Chain, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AppendRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppendRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppendRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AppendRequestHeader{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Data = &AppendRequest_Header{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Data = &AppendRequest_Payload{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AppendResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppendResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= Code(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeDes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Offsets = append(m.Offsets, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Offsets) == 0 {
+					m.Offsets = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Offsets = append(m.Offsets, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offsets", wireType)
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			m.End = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.End |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateExtentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateExtentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateExtentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamID", wireType)
+			}
+			m.StreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateExtentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateExtentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateExtentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= Code(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeDes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtentID", wireType)
+			}
+			m.ExtentID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtentID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadBlocksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadBlocksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadBlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtentID", wireType)
+			}
+			m.ExtentID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtentID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOfBlocks", wireType)
+			}
+			m.NumOfBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumOfBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eversion", wireType)
+			}
+			m.Eversion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Eversion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnlyLastBlock", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OnlyLastBlock = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadBlockResponseHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadBlockResponseHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadBlockResponseHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= Code(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeDes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Offsets = append(m.Offsets, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Offsets) == 0 {
+					m.Offsets = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Offsets = append(m.Offsets, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offsets", wireType)
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			m.End = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.End |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BlockSizes = append(m.BlockSizes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.BlockSizes) == 0 {
+					m.BlockSizes = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BlockSizes = append(m.BlockSizes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockSizes", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadBlocksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadBlocksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadBlocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ReadBlockResponseHeader{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Data = &ReadBlocksResponse_Header{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Data = &ReadBlocksResponse_Payload{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Payload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Payload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Payload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitLengthRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitLengthRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitLengthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtentID", wireType)
+			}
+			m.ExtentID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtentID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seal", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Seal = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitLengthResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitLengthResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitLengthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= Code(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeDes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			}
+			m.Length = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Length |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DF) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DF: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DF: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Free", wireType)
+			}
+			m.Free = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Free |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Online", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Online = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DfRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DfRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DfRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tasks = append(m.Tasks, &RecoveryTask{})
+			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.DiskIDs = append(m.DiskIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.DiskIDs) == 0 {
+					m.DiskIDs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.DiskIDs = append(m.DiskIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskIDs", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DfResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DfResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DfResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoneTask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoneTask = append(m.DoneTask, &RecoveryTaskStatus{})
+			if err := m.DoneTask[len(m.DoneTask)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DiskStatus == nil {
+				m.DiskStatus = make(map[uint64]*DF)
+			}
+			var mapkey uint64
+			var mapvalue *DF
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthPb
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthPb
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &DF{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.DiskStatus[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecoveryTaskStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecoveryTaskStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecoveryTaskStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Task == nil {
+				m.Task = &RecoveryTask{}
+			}
+			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadyDiskID", wireType)
+			}
+			m.ReadyDiskID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadyDiskID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecoveryTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecoveryTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecoveryTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtentID", wireType)
+			}
+			m.ExtentID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtentID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplaceID", wireType)
+			}
+			m.ReplaceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplaceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+			}
+			m.NodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequireRecoveryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequireRecoveryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequireRecoveryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Task == nil {
+				m.Task = &RecoveryTask{}
+			}
+			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequireRecoveryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequireRecoveryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequireRecoveryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= Code(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeDes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CopyResponseHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CopyResponseHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CopyResponseHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= Code(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeDes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadLen", wireType)
+			}
+			m.PayloadLen = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayloadLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CopyExtentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CopyExtentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CopyExtentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtentID", wireType)
+			}
+			m.ExtentID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtentID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eversion", wireType)
+			}
+			m.Eversion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Eversion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CopyExtentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CopyExtentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CopyExtentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &CopyResponseHeader{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Data = &CopyExtentResponse_Header{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Data = &CopyExtentResponse_Payload{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConvertExtentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConvertExtentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConvertExtentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtentID", wireType)
+			}
+			m.ExtentID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtentID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eversion", wireType)
+			}
+			m.Eversion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Eversion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataShard", wireType)
+			}
+			m.DataShard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataShard |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParityShard", wireType)
+			}
+			m.ParityShard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParityShard |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Targets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Targets = append(m.Targets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Codec == nil {
+				m.Codec = &ErasureCodec{}
+			}
+			if err := m.Codec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConvertExtentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConvertExtentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConvertExtentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= Code(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeDes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FragmentSize", wireType)
+			}
+			m.FragmentSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FragmentSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.DiskIDs = append(m.DiskIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.DiskIDs) == 0 {
+					m.DiskIDs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.DiskIDs = append(m.DiskIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskIDs", wireType)
+			}
+		case 5:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Checksums = append(m.Checksums, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Checksums) == 0 {
+					m.Checksums = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Checksums = append(m.Checksums, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksums", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExtentChecksumRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtentChecksumRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtentChecksumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtentID", wireType)
+			}
+			m.ExtentID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtentID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eversion", wireType)
+			}
+			m.Eversion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Eversion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cached", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cached = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExtentChecksumResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtentChecksumResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtentChecksumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= Code(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeDes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			m.Checksum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Checksum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			}
+			m.Length = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Length |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WriteFragmentHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WriteFragmentHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WriteFragmentHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtentID", wireType)
+			}
+			m.ExtentID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtentID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WriteFragmentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WriteFragmentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WriteFragmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &WriteFragmentHeader{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Data = &WriteFragmentRequest_Header{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Data = &WriteFragmentRequest_Payload{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WriteFragmentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WriteFragmentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WriteFragmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= Code(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeDes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskID", wireType)
+			}
+			m.DiskID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiskID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReAvaliRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReAvaliRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReAvaliRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtentID", wireType)
+			}
+			m.ExtentID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtentID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eversion", wireType)
+			}
+			m.Eversion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Eversion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReAvaliResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReAvaliResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReAvaliResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= Code(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeDes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReconcileRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReconcileRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReconcileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WalStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WalStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WalStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WalStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WalStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WalStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
+			}
+			m.Commits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Commits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			m.Entries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Entries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
+			}
+			m.Bytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Latency", wireType)
+			}
+			m.Latency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Latency |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLatency", wireType)
+			}
+			m.MaxLatency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLatency |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WalStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WalStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WalStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= Code(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeDes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dirs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dirs = append(m.Dirs, &WalStats{})
+			if err := m.Dirs[len(m.Dirs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bypassed", wireType)
+			}
+			m.Bypassed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bypassed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteExtentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteExtentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteExtentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtentID", wireType)
+			}
+			m.ExtentID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtentID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteExtentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteExtentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteExtentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= Code(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeDes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllocExtentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllocExtentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllocExtentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtentID", wireType)
+			}
+			m.ExtentID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtentID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllocExtentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllocExtentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllocExtentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= Code(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeDes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskID", wireType)
+			}
+			m.DiskID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiskID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckCommitLengthRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckCommitLengthRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckCommitLengthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamID", wireType)
+			}
+			m.StreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckCommitLengthResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckCommitLengthResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckCommitLengthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= Code(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeDes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StreamInfo == nil {
+				m.StreamInfo = &StreamInfo{}
+			}
+			if err := m.StreamInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			m.End = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.End |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastExInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastExInfo == nil {
+				m.LastExInfo = &ExtentInfo{}
+			}
+			if err := m.LastExInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		n += 1 + sovPb(uint64(l)) + l
 	}
-	return n
-}
 
-func (m *DiskInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.DiskID != 0 {
-		n += 1 + sovPb(uint64(m.DiskID))
-	}
-	if m.Online {
-		n += 2
-	}
-	l = len(m.Uuid)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovPb(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozPb(x uint64) (n int) {
-	return sovPb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *AppendRequestHeader) Unmarshal(dAtA []byte) error {
+func (m *StreamAllocExtentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8069,17 +20040,17 @@ func (m *AppendRequestHeader) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AppendRequestHeader: wiretype end group for non-group")
+			return fmt.Errorf("proto: StreamAllocExtentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AppendRequestHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StreamAllocExtentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtentID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamID", wireType)
 			}
-			m.ExtentID = 0
+			m.StreamID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -8089,16 +20060,136 @@ func (m *AppendRequestHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExtentID |= uint64(b&0x7F) << shift
+				m.StreamID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Eversion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
-			m.Eversion = 0
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			m.End = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.End |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamAllocExtentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamAllocExtentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamAllocExtentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -8108,16 +20199,16 @@ func (m *AppendRequestHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Eversion |= uint64(b&0x7F) << shift
+				m.Code |= Code(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
 			}
-			m.Commit = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -8127,16 +20218,29 @@ func (m *AppendRequestHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Commit |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
 			}
-			m.Revision = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeDes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamInfo", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -8146,16 +20250,33 @@ func (m *AppendRequestHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Revision |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MustSync", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPb
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StreamInfo == nil {
+				m.StreamInfo = &StreamInfo{}
+			}
+			if err := m.StreamInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastExInfo", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -8165,88 +20286,28 @@ func (m *AppendRequestHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.MustSync = bool(v != 0)
-		case 6:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Blocks = append(m.Blocks, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPb
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPb
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Blocks) == 0 {
-					m.Blocks = make([]uint32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Blocks = append(m.Blocks, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastExInfo == nil {
+				m.LastExInfo = &ExtentInfo{}
+			}
+			if err := m.LastExInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -8268,7 +20329,7 @@ func (m *AppendRequestHeader) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AppendRequest) Unmarshal(dAtA []byte) error {
+func (m *StreamInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8291,80 +20352,88 @@ func (m *AppendRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AppendRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StreamInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AppendRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StreamInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				m.StreamIDs = append(m.StreamIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if packedLen < 0 {
+					return ErrInvalidLengthPb
 				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &AppendRequestHeader{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Data = &AppendRequest_Header{v}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPb
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.StreamIDs) == 0 {
+					m.StreamIDs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.StreamIDs = append(m.StreamIDs, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamIDs", wireType)
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := make([]byte, postIndex-iNdEx)
-			copy(v, dAtA[iNdEx:postIndex])
-			m.Data = &AppendRequest_Payload{v}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -8386,7 +20455,7 @@ func (m *AppendRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AppendResponse) Unmarshal(dAtA []byte) error {
+func (m *StreamInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8409,10 +20478,10 @@ func (m *AppendResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AppendResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: StreamInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AppendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StreamInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8467,25 +20536,42 @@ func (m *AppendResponse) Unmarshal(dAtA []byte) error {
 			m.CodeDes = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
 				}
-				m.Offsets = append(m.Offsets, v)
-			} else if wireType == 2 {
-				var packedLen int
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Streams == nil {
+				m.Streams = make(map[uint64]*StreamInfo)
+			}
+			var mapkey uint64
+			var mapvalue *StreamInfo
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return ErrIntOverflowPb
@@ -8495,34 +20581,29 @@ func (m *AppendResponse) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					packedLen |= int(b&0x7F) << shift
+					wire |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPb
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPb
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
 					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Offsets) == 0 {
-					m.Offsets = make([]uint32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
+				} else if fieldNum == 2 {
+					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowPb
@@ -8532,21 +20613,48 @@ func (m *AppendResponse) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						v |= uint32(b&0x7F) << shift
+						mapmsglen |= int(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					m.Offsets = append(m.Offsets, v)
+					if mapmsglen < 0 {
+						return ErrInvalidLengthPb
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthPb
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &StreamInfo{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offsets", wireType)
 			}
+			m.Streams[mapkey] = mapvalue
+			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extents", wireType)
 			}
-			m.End = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -8556,11 +20664,107 @@ func (m *AppendResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.End |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Extents == nil {
+				m.Extents = make(map[uint64]*ExtentInfo)
+			}
+			var mapkey uint64
+			var mapvalue *ExtentInfo
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthPb
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthPb
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &ExtentInfo{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Extents[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -8582,7 +20786,7 @@ func (m *AppendResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateExtentRequest) Unmarshal(dAtA []byte) error {
+func (m *ExtentInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8605,17 +20809,17 @@ func (m *CreateExtentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateExtentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExtentInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateExtentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExtentInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StreamID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtentID", wireType)
 			}
-			m.StreamID = 0
+			m.ExtentID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -8625,7 +20829,7 @@ func (m *CreateExtentRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StreamID |= uint64(b&0x7F) << shift
+				m.ExtentID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -8651,7 +20855,7 @@ func (m *CreateExtentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateExtentResponse) Unmarshal(dAtA []byte) error {
+func (m *ExtentInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8674,10 +20878,10 @@ func (m *CreateExtentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateExtentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExtentInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateExtentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExtentInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8732,10 +20936,10 @@ func (m *CreateExtentResponse) Unmarshal(dAtA []byte) error {
 			m.CodeDes = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtentID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExInfo", wireType)
 			}
-			m.ExtentID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -8745,11 +20949,28 @@ func (m *CreateExtentResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExtentID |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExInfo == nil {
+				m.ExInfo = &ExtentInfo{}
+			}
+			if err := m.ExInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -8771,7 +20992,7 @@ func (m *CreateExtentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReadBlocksRequest) Unmarshal(dAtA []byte) error {
+func (m *NodesInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8794,17 +21015,67 @@ func (m *ReadBlocksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReadBlocksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: NodesInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReadBlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NodesInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodesInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodesInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodesInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtentID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			m.ExtentID = 0
+			m.Code = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -8814,16 +21085,163 @@ func (m *ReadBlocksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExtentID |= uint64(b&0x7F) << shift
+				m.Code |= Code(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeDes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Nodes == nil {
+				m.Nodes = make(map[uint64]*NodeInfo)
+			}
+			var mapkey uint64
+			var mapvalue *NodeInfo
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthPb
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthPb
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &NodeInfo{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Nodes[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decommission", wireType)
 			}
-			m.Offset = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -8833,69 +21251,107 @@ func (m *ReadBlocksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Offset |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumOfBlocks", wireType)
-			}
-			m.NumOfBlocks = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NumOfBlocks |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Eversion", wireType)
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
 			}
-			m.Eversion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Eversion |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OnlyLastBlock", wireType)
+			if m.Decommission == nil {
+				m.Decommission = make(map[uint64]*DecommissionProgress)
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey uint64
+			var mapvalue *DecommissionProgress
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthPb
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthPb
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &DecommissionProgress{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			m.OnlyLastBlock = bool(v != 0)
+			m.Decommission[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -8917,7 +21373,7 @@ func (m *ReadBlocksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReadBlockResponseHeader) Unmarshal(dAtA []byte) error {
+func (m *DecommissionProgress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8940,144 +21396,36 @@ func (m *ReadBlockResponseHeader) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReadBlockResponseHeader: wiretype end group for non-group")
+			return fmt.Errorf("proto: DecommissionProgress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReadBlockResponseHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DecommissionProgress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
-			}
-			m.Code = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Code |= Code(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
 			}
-			m.CodeDes = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Offsets = append(m.Offsets, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPb
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPb
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+			m.NodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.Offsets) == 0 {
-					m.Offsets = make([]uint32, 0, elementCount)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Offsets = append(m.Offsets, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offsets", wireType)
 			}
-		case 4:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
 			}
-			m.End = 0
+			m.Remaining = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -9087,86 +21435,48 @@ func (m *ReadBlockResponseHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.End |= uint32(b&0x7F) << shift
+				m.Remaining |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Running", wireType)
+			}
+			m.Running = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
 				}
-				m.BlockSizes = append(m.BlockSizes, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPb
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Running |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPb
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unsealed", wireType)
+			}
+			m.Unsealed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.BlockSizes) == 0 {
-					m.BlockSizes = make([]uint32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.BlockSizes = append(m.BlockSizes, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Unsealed |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockSizes", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -9189,7 +21499,7 @@ func (m *ReadBlockResponseHeader) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReadBlocksResponse) Unmarshal(dAtA []byte) error {
+func (m *DecommissionNodeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9212,17 +21522,17 @@ func (m *ReadBlocksResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReadBlocksResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DecommissionNodeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReadBlocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DecommissionNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
 			}
-			var msglen int
+			m.NodeID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -9232,32 +21542,16 @@ func (m *ReadBlocksResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.NodeID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &ReadBlockResponseHeader{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Data = &ReadBlocksResponse_Header{v}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancel", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -9267,25 +21561,12 @@ func (m *ReadBlocksResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := make([]byte, postIndex-iNdEx)
-			copy(v, dAtA[iNdEx:postIndex])
-			m.Data = &ReadBlocksResponse_Payload{v}
-			iNdEx = postIndex
+			m.Cancel = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -9307,7 +21588,7 @@ func (m *ReadBlocksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Payload) Unmarshal(dAtA []byte) error {
+func (m *DecommissionNodeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9330,17 +21611,36 @@ func (m *Payload) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Payload: wiretype end group for non-group")
+			return fmt.Errorf("proto: DecommissionNodeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Payload: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DecommissionNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= Code(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -9350,25 +21650,23 @@ func (m *Payload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
+			m.CodeDes = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9391,7 +21689,7 @@ func (m *Payload) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommitLengthRequest) Unmarshal(dAtA []byte) error {
+func (m *RegisterNodeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9410,21 +21708,53 @@ func (m *CommitLengthRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CommitLengthRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitLengthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtentID", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterNodeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskUUIDs", wireType)
 			}
-			m.ExtentID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -9434,16 +21764,29 @@ func (m *CommitLengthRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExtentID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
 			}
-			m.Revision = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DiskUUIDs = append(m.DiskUUIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topology", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -9453,11 +21796,28 @@ func (m *CommitLengthRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Revision |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Topology == nil {
+				m.Topology = &Topology{}
+			}
+			if err := m.Topology.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -9479,7 +21839,7 @@ func (m *CommitLengthRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommitLengthResponse) Unmarshal(dAtA []byte) error {
+func (m *Topology) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9502,17 +21862,17 @@ func (m *CommitLengthResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommitLengthResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: Topology: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitLengthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Topology: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zone", wireType)
 			}
-			m.Code = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -9522,14 +21882,27 @@ func (m *CommitLengthResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Code |= Code(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rack", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9557,13 +21930,13 @@ func (m *CommitLengthResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CodeDes = string(dAtA[iNdEx:postIndex])
+			m.Rack = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
 			}
-			m.Length = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -9573,11 +21946,24 @@ func (m *CommitLengthResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Length |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Host = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -9599,7 +21985,7 @@ func (m *CommitLengthResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DF) Unmarshal(dAtA []byte) error {
+func (m *RegisterNodeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9622,17 +22008,17 @@ func (m *DF) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DF: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegisterNodeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DF: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegisterNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			m.Total = 0
+			m.Code = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -9642,16 +22028,16 @@ func (m *DF) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Total |= uint64(b&0x7F) << shift
+				m.Code |= Code(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Free", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
 			}
-			m.Free = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -9661,16 +22047,29 @@ func (m *DF) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Free |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeDes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Online", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
 			}
-			var v int
+			m.NodeId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -9680,12 +22079,124 @@ func (m *DF) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.NodeId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Online = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskUUIDs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DiskUUIDs == nil {
+				m.DiskUUIDs = make(map[string]uint64)
+			}
+			var mapkey string
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPb
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPb
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.DiskUUIDs[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -9707,7 +22218,7 @@ func (m *DF) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DfRequest) Unmarshal(dAtA []byte) error {
+func (m *RegisterDiskRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9730,17 +22241,36 @@ func (m *DfRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DfRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegisterDiskRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DfRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegisterDiskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+			}
+			m.NodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskUUID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -9750,101 +22280,42 @@ func (m *DfRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tasks = append(m.Tasks, &RecoveryTask{})
-			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DiskUUID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.DiskIDs = append(m.DiskIDs, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPb
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPb
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplaceDiskID", wireType)
+			}
+			m.ReplaceDiskID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.DiskIDs) == 0 {
-					m.DiskIDs = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.DiskIDs = append(m.DiskIDs, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplaceDiskID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field DiskIDs", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -9867,7 +22338,7 @@ func (m *DfRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DfResponse) Unmarshal(dAtA []byte) error {
+func (m *RegisterDiskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9890,17 +22361,17 @@ func (m *DfResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DfResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegisterDiskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DfResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegisterDiskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DoneTask", wireType)
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			var msglen int
+			m.Code = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -9910,141 +22381,62 @@ func (m *DfResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Code |= Code(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DoneTask = append(m.DoneTask, &RecoveryTaskStatus{})
-			if err := m.DoneTask[len(m.DoneTask)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DiskStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.DiskStatus == nil {
-				m.DiskStatus = make(map[uint64]*DF)
-			}
-			var mapkey uint64
-			var mapvalue *DF
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthPb
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthPb
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &DF{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPb(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthPb
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.DiskStatus[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeDes = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskID", wireType)
+			}
+			m.DiskID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiskID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -10066,7 +22458,7 @@ func (m *DfResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecoveryTaskStatus) Unmarshal(dAtA []byte) error {
+func (m *CreateStreamRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10089,17 +22481,17 @@ func (m *RecoveryTaskStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecoveryTaskStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateStreamRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecoveryTaskStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataShard", wireType)
 			}
-			var msglen int
+			m.DataShard = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -10109,33 +22501,54 @@ func (m *RecoveryTaskStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DataShard |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParityShard", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ParityShard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParityShard |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.Task == nil {
-				m.Task = &RecoveryTask{}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replication", wireType)
 			}
-			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Replication = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Replication |= ReplicationMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadyDiskID", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codec", wireType)
 			}
-			m.ReadyDiskID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -10145,11 +22558,28 @@ func (m *RecoveryTaskStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReadyDiskID |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Codec == nil {
+				m.Codec = &ErasureCodec{}
+			}
+			if err := m.Codec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -10171,7 +22601,7 @@ func (m *RecoveryTaskStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecoveryTask) Unmarshal(dAtA []byte) error {
+func (m *CreateStreamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10194,17 +22624,17 @@ func (m *RecoveryTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecoveryTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateStreamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecoveryTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtentID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			m.ExtentID = 0
+			m.Code = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -10214,16 +22644,16 @@ func (m *RecoveryTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExtentID |= uint64(b&0x7F) << shift
+				m.Code |= Code(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplaceID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
 			}
-			m.ReplaceID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -10233,16 +22663,29 @@ func (m *RecoveryTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReplaceID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeDes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
 			}
-			m.NodeID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -10252,16 +22695,33 @@ func (m *RecoveryTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NodeID |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stream == nil {
+				m.Stream = &StreamInfo{}
+			}
+			if err := m.Stream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extent", wireType)
 			}
-			m.StartTime = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -10271,11 +22731,28 @@ func (m *RecoveryTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartTime |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Extent == nil {
+				m.Extent = &ExtentInfo{}
+			}
+			if err := m.Extent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -10297,7 +22774,7 @@ func (m *RecoveryTask) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RequireRecoveryRequest) Unmarshal(dAtA []byte) error {
+func (m *TruncateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10320,17 +22797,55 @@ func (m *RequireRecoveryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RequireRecoveryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TruncateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequireRecoveryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TruncateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamID", wireType)
+			}
+			m.StreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtentID", wireType)
+			}
+			m.ExtentID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtentID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerKey", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -10340,28 +22855,43 @@ func (m *RequireRecoveryRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Task == nil {
-				m.Task = &RecoveryTask{}
+			m.OwnerKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
-			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -10383,7 +22913,7 @@ func (m *RequireRecoveryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RequireRecoveryResponse) Unmarshal(dAtA []byte) error {
+func (m *TruncateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10406,10 +22936,10 @@ func (m *RequireRecoveryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RequireRecoveryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TruncateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequireRecoveryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TruncateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10463,6 +22993,42 @@ func (m *RequireRecoveryResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.CodeDes = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedStreamInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdatedStreamInfo == nil {
+				m.UpdatedStreamInfo = &StreamInfo{}
+			}
+			if err := m.UpdatedStreamInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -10484,7 +23050,7 @@ func (m *RequireRecoveryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CopyResponseHeader) Unmarshal(dAtA []byte) error {
+func (m *ExtentReport) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10507,17 +23073,17 @@ func (m *CopyResponseHeader) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CopyResponseHeader: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExtentReport: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CopyResponseHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExtentReport: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtentID", wireType)
 			}
-			m.Code = 0
+			m.ExtentID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -10527,16 +23093,16 @@ func (m *CopyResponseHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Code |= Code(b&0x7F) << shift
+				m.ExtentID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskID", wireType)
 			}
-			var stringLen uint64
+			m.DiskID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -10546,29 +23112,55 @@ func (m *CopyResponseHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DiskID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sealed", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Sealed = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
 			}
-			m.CodeDes = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			m.Length = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Length |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayloadLen", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModTime", wireType)
 			}
-			m.PayloadLen = 0
+			m.ModTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -10578,7 +23170,7 @@ func (m *CopyResponseHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PayloadLen |= uint64(b&0x7F) << shift
+				m.ModTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -10604,7 +23196,7 @@ func (m *CopyResponseHeader) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CopyExtentRequest) Unmarshal(dAtA []byte) error {
+func (m *CopyReport) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10627,10 +23219,10 @@ func (m *CopyExtentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CopyExtentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CopyReport: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CopyExtentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CopyReport: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10654,9 +23246,9 @@ func (m *CopyExtentRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplaceID", wireType)
 			}
-			m.Offset = 0
+			m.ReplaceID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -10666,16 +23258,16 @@ func (m *CopyExtentRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Offset |= uint64(b&0x7F) << shift
+				m.ReplaceID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskID", wireType)
 			}
-			m.Size_ = 0
+			m.DiskID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -10685,16 +23277,16 @@ func (m *CopyExtentRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Size_ |= uint64(b&0x7F) << shift
+				m.DiskID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Eversion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModTime", wireType)
 			}
-			m.Eversion = 0
+			m.ModTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -10704,7 +23296,7 @@ func (m *CopyExtentRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Eversion |= uint64(b&0x7F) << shift
+				m.ModTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -10730,7 +23322,7 @@ func (m *CopyExtentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CopyExtentResponse) Unmarshal(dAtA []byte) error {
+func (m *InventoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10753,17 +23345,17 @@ func (m *CopyExtentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CopyExtentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: InventoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CopyExtentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InventoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
 			}
-			var msglen int
+			m.NodeID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -10773,32 +23365,16 @@ func (m *CopyExtentResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.NodeID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &CopyResponseHeader{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Data = &CopyExtentResponse_Header{v}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Extents", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -10808,80 +23384,31 @@ func (m *CopyExtentResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := make([]byte, postIndex-iNdEx)
-			copy(v, dAtA[iNdEx:postIndex])
-			m.Data = &CopyExtentResponse_Payload{v}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
+			m.Extents = append(m.Extents, &ExtentReport{})
+			if err := m.Extents[len(m.Extents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ReAvaliRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ReAvaliRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReAvaliRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtentID", wireType)
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Copies", wireType)
 			}
-			m.ExtentID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -10891,16 +23418,31 @@ func (m *ReAvaliRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExtentID |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Copies = append(m.Copies, &CopyReport{})
+			if err := m.Copies[len(m.Copies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Eversion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
 			}
-			m.Eversion = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -10910,11 +23452,12 @@ func (m *ReAvaliRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Eversion |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.DryRun = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -10936,7 +23479,7 @@ func (m *ReAvaliRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReAvaliResponse) Unmarshal(dAtA []byte) error {
+func (m *InventoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10959,10 +23502,10 @@ func (m *ReAvaliResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReAvaliResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: InventoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReAvaliResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InventoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11016,61 +23559,239 @@ func (m *ReAvaliResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.CodeDes = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AllocExtentRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Orphans = append(m.Orphans, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Orphans) == 0 {
+					m.Orphans = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Orphans = append(m.Orphans, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orphans", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Missing = append(m.Missing, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Missing) == 0 {
+					m.Missing = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Missing = append(m.Missing, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Missing", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Mismatched = append(m.Mismatched, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Mismatched) == 0 {
+					m.Mismatched = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Mismatched = append(m.Mismatched, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mismatched", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AllocExtentRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllocExtentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtentID", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StaleCopies", wireType)
 			}
-			m.ExtentID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -11080,11 +23801,26 @@ func (m *AllocExtentRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExtentID |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StaleCopies = append(m.StaleCopies, &CopyReport{})
+			if err := m.StaleCopies[len(m.StaleCopies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -11106,7 +23842,7 @@ func (m *AllocExtentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllocExtentResponse) Unmarshal(dAtA []byte) error {
+func (m *DeleteStreamRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11129,17 +23865,17 @@ func (m *AllocExtentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllocExtentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteStreamRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllocExtentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamID", wireType)
 			}
-			m.Code = 0
+			m.StreamID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -11149,14 +23885,14 @@ func (m *AllocExtentResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Code |= Code(b&0x7F) << shift
+				m.StreamID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11184,13 +23920,13 @@ func (m *AllocExtentResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CodeDes = string(dAtA[iNdEx:postIndex])
+			m.OwnerKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DiskID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
-			m.DiskID = 0
+			m.Revision = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -11200,7 +23936,7 @@ func (m *AllocExtentResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DiskID |= uint64(b&0x7F) << shift
+				m.Revision |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -11226,7 +23962,7 @@ func (m *AllocExtentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CheckCommitLengthRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteStreamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11249,17 +23985,17 @@ func (m *CheckCommitLengthRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CheckCommitLengthRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteStreamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CheckCommitLengthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StreamID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			m.StreamID = 0
+			m.Code = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -11269,14 +24005,14 @@ func (m *CheckCommitLengthRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StreamID |= uint64(b&0x7F) << shift
+				m.Code |= Code(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11304,27 +24040,8 @@ func (m *CheckCommitLengthRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OwnerKey = string(dAtA[iNdEx:postIndex])
+			m.CodeDes = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
-			}
-			m.Revision = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Revision |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -11346,7 +24063,7 @@ func (m *CheckCommitLengthRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CheckCommitLengthResponse) Unmarshal(dAtA []byte) error {
+func (m *MultiModifySplitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11369,17 +24086,17 @@ func (m *CheckCommitLengthResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CheckCommitLengthResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MultiModifySplitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CheckCommitLengthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MultiModifySplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartID", wireType)
 			}
-			m.Code = 0
+			m.PartID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -11389,16 +24106,16 @@ func (m *CheckCommitLengthResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Code |= Code(b&0x7F) << shift
+				m.PartID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MidKey", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -11408,29 +24125,31 @@ func (m *CheckCommitLengthResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CodeDes = string(dAtA[iNdEx:postIndex])
+			m.MidKey = append(m.MidKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.MidKey == nil {
+				m.MidKey = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StreamInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerKey", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -11440,33 +24159,155 @@ func (m *CheckCommitLengthResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StreamInfo == nil {
-				m.StreamInfo = &StreamInfo{}
+			m.OwnerKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
-			if err := m.StreamInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamSealedLength", wireType)
+			}
+			m.LogStreamSealedLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamSealedLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowStreamSealedLength", wireType)
+			}
+			m.RowStreamSealedLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RowStreamSealedLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetaStreamSealedLength", wireType)
+			}
+			m.MetaStreamSealedLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MetaStreamSealedLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultiModifySplitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiModifySplitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiModifySplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			m.End = 0
+			m.Code = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -11476,16 +24317,16 @@ func (m *CheckCommitLengthResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.End |= uint32(b&0x7F) << shift
+				m.Code |= Code(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastExInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -11495,27 +24336,23 @@ func (m *CheckCommitLengthResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastExInfo == nil {
-				m.LastExInfo = &ExtentInfo{}
-			}
-			if err := m.LastExInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CodeDes = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11538,7 +24375,7 @@ func (m *CheckCommitLengthResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StreamAllocExtentRequest) Unmarshal(dAtA []byte) error {
+func (m *SnapshotPartitionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11561,17 +24398,17 @@ func (m *StreamAllocExtentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StreamAllocExtentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SnapshotPartitionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StreamAllocExtentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SnapshotPartitionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StreamID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartID", wireType)
 			}
-			m.StreamID = 0
+			m.PartID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -11581,7 +24418,7 @@ func (m *StreamAllocExtentRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StreamID |= uint64(b&0x7F) << shift
+				m.PartID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -11639,9 +24476,9 @@ func (m *StreamAllocExtentRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamSealedLength", wireType)
 			}
-			m.End = 0
+			m.LogStreamSealedLength = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -11651,7 +24488,45 @@ func (m *StreamAllocExtentRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.End |= uint32(b&0x7F) << shift
+				m.LogStreamSealedLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowStreamSealedLength", wireType)
+			}
+			m.RowStreamSealedLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RowStreamSealedLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetaStreamSealedLength", wireType)
+			}
+			m.MetaStreamSealedLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MetaStreamSealedLength |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -11677,7 +24552,7 @@ func (m *StreamAllocExtentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StreamAllocExtentResponse) Unmarshal(dAtA []byte) error {
+func (m *SnapshotPartitionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11700,10 +24575,10 @@ func (m *StreamAllocExtentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StreamAllocExtentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SnapshotPartitionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StreamAllocExtentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SnapshotPartitionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11758,46 +24633,10 @@ func (m *StreamAllocExtentResponse) Unmarshal(dAtA []byte) error {
 			m.CodeDes = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StreamInfo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.StreamInfo == nil {
-				m.StreamInfo = &StreamInfo{}
-			}
-			if err := m.StreamInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastExInfo", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotID", wireType)
 			}
-			var msglen int
+			m.SnapshotID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -11807,28 +24646,11 @@ func (m *StreamAllocExtentResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SnapshotID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LastExInfo == nil {
-				m.LastExInfo = &ExtentInfo{}
-			}
-			if err := m.LastExInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -11850,7 +24672,7 @@ func (m *StreamAllocExtentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StreamInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *RestoreSnapshotRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11873,87 +24695,81 @@ func (m *StreamInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StreamInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RestoreSnapshotRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StreamInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RestoreSnapshotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotID", wireType)
+			}
+			m.SnapshotID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
 				}
-				m.StreamIDs = append(m.StreamIDs, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPb
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPb
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.StreamIDs) == 0 {
-					m.StreamIDs = make([]uint64, 0, elementCount)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.StreamIDs = append(m.StreamIDs, v)
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field StreamIDs", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -11976,7 +24792,7 @@ func (m *StreamInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StreamInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *RestoreSnapshotResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11999,10 +24815,10 @@ func (m *StreamInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StreamInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RestoreSnapshotResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StreamInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RestoreSnapshotResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12056,236 +24872,6 @@ func (m *StreamInfoResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.CodeDes = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Streams == nil {
-				m.Streams = make(map[uint64]*StreamInfo)
-			}
-			var mapkey uint64
-			var mapvalue *StreamInfo
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthPb
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthPb
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &StreamInfo{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPb(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthPb
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Streams[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Extents", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Extents == nil {
-				m.Extents = make(map[uint64]*ExtentInfo)
-			}
-			var mapkey uint64
-			var mapvalue *ExtentInfo
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthPb
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthPb
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &ExtentInfo{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPb(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthPb
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Extents[mapkey] = mapvalue
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -12307,7 +24893,7 @@ func (m *StreamInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExtentInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteSnapshotRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12330,17 +24916,17 @@ func (m *ExtentInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExtentInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteSnapshotRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExtentInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteSnapshotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtentID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotID", wireType)
 			}
-			m.ExtentID = 0
+			m.SnapshotID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -12350,7 +24936,7 @@ func (m *ExtentInfoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExtentID |= uint64(b&0x7F) << shift
+				m.SnapshotID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -12376,7 +24962,7 @@ func (m *ExtentInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExtentInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *DeleteSnapshotResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12399,10 +24985,10 @@ func (m *ExtentInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExtentInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteSnapshotResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExtentInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteSnapshotResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12456,42 +25042,6 @@ func (m *ExtentInfoResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.CodeDes = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExInfo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ExInfo == nil {
-				m.ExInfo = &ExtentInfo{}
-			}
-			if err := m.ExInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -12513,7 +25063,7 @@ func (m *ExtentInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NodesInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *StatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12536,10 +25086,10 @@ func (m *NodesInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NodesInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodesInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -12563,7 +25113,7 @@ func (m *NodesInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NodesInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *StatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12586,10 +25136,10 @@ func (m *NodesInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NodesInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: StatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodesInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12645,7 +25195,7 @@ func (m *NodesInfoResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12672,91 +25222,12 @@ func (m *NodesInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Nodes == nil {
-				m.Nodes = make(map[uint64]*NodeInfo)
+			if m.Leader == nil {
+				m.Leader = &MemberValue{}
 			}
-			var mapkey uint64
-			var mapvalue *NodeInfo
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthPb
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthPb
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &NodeInfo{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPb(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthPb
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Leader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Nodes[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12779,7 +25250,7 @@ func (m *NodesInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterNodeRequest) Unmarshal(dAtA []byte) error {
+func (m *PunchHolesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12802,17 +25273,17 @@ func (m *RegisterNodeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterNodeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PunchHolesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PunchHolesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamID", wireType)
 			}
-			var stringLen uint64
+			m.StreamID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -12822,27 +25293,90 @@ func (m *RegisterNodeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StreamID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ExtentIDs = append(m.ExtentIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ExtentIDs) == 0 {
+					m.ExtentIDs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ExtentIDs = append(m.ExtentIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtentIDs", wireType)
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DiskUUIDs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12870,8 +25404,27 @@ func (m *RegisterNodeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DiskUUIDs = append(m.DiskUUIDs, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+			m.OwnerKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -12893,7 +25446,7 @@ func (m *RegisterNodeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterNodeResponse) Unmarshal(dAtA []byte) error {
+func (m *PunchHolesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12916,10 +25469,10 @@ func (m *RegisterNodeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterNodeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PunchHolesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PunchHolesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12974,27 +25527,8 @@ func (m *RegisterNodeResponse) Unmarshal(dAtA []byte) error {
 			m.CodeDes = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
-			}
-			m.NodeId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NodeId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DiskUUIDs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13021,89 +25555,12 @@ func (m *RegisterNodeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DiskUUIDs == nil {
-				m.DiskUUIDs = make(map[string]uint64)
+			if m.Stream == nil {
+				m.Stream = &StreamInfo{}
 			}
-			var mapkey string
-			var mapvalue uint64
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthPb
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthPb
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPb(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthPb
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Stream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.DiskUUIDs[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13126,7 +25583,7 @@ func (m *RegisterNodeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateStreamRequest) Unmarshal(dAtA []byte) error {
+func (m *ECPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13149,10 +25606,10 @@ func (m *CreateStreamRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateStreamRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ECPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ECPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13193,146 +25650,28 @@ func (m *CreateStreamRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CreateStreamResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CreateStreamResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
-			}
-			m.Code = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Code |= Code(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CodeDes = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAge", wireType)
 			}
-			var msglen int
+			m.MinAge = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Stream == nil {
-				m.Stream = &StreamInfo{}
-			}
-			if err := m.Stream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinAge |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Extent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Codec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13359,10 +25698,10 @@ func (m *CreateStreamResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Extent == nil {
-				m.Extent = &ExtentInfo{}
+			if m.Codec == nil {
+				m.Codec = &ErasureCodec{}
 			}
-			if err := m.Extent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Codec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13387,7 +25726,7 @@ func (m *CreateStreamResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TruncateRequest) Unmarshal(dAtA []byte) error {
+func (m *SetECPolicyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13410,10 +25749,10 @@ func (m *TruncateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TruncateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetECPolicyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TruncateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetECPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13436,29 +25775,10 @@ func (m *TruncateRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtentID", wireType)
-			}
-			m.ExtentID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExtentID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -13468,43 +25788,28 @@ func (m *TruncateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OwnerKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			if m.Policy == nil {
+				m.Policy = &ECPolicy{}
 			}
-			m.Revision = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Revision |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -13526,7 +25831,7 @@ func (m *TruncateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TruncateResponse) Unmarshal(dAtA []byte) error {
+func (m *SetECPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13549,10 +25854,10 @@ func (m *TruncateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TruncateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetECPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TruncateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetECPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13606,11 +25911,61 @@ func (m *TruncateResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.CodeDes = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedStreamInfo", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ECConversionStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ECConversionStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ECConversionStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamID", wireType)
+			}
+			m.StreamID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -13620,28 +25975,11 @@ func (m *TruncateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.StreamID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.UpdatedStreamInfo == nil {
-				m.UpdatedStreamInfo = &StreamInfo{}
-			}
-			if err := m.UpdatedStreamInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -13663,7 +26001,7 @@ func (m *TruncateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MultiModifySplitRequest) Unmarshal(dAtA []byte) error {
+func (m *ECConversionProgress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13686,17 +26024,17 @@ func (m *MultiModifySplitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MultiModifySplitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ECConversionProgress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MultiModifySplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ECConversionProgress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamID", wireType)
 			}
-			m.PartID = 0
+			m.StreamID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -13706,16 +26044,16 @@ func (m *MultiModifySplitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PartID |= uint64(b&0x7F) << shift
+				m.StreamID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MidKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -13725,31 +26063,33 @@ func (m *MultiModifySplitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MidKey = append(m.MidKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.MidKey == nil {
-				m.MidKey = []byte{}
+			if m.Policy == nil {
+				m.Policy = &ECPolicy{}
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
 			}
-			var stringLen uint64
+			m.Pending = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -13759,29 +26099,16 @@ func (m *MultiModifySplitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Pending |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OwnerKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Running", wireType)
 			}
-			m.Revision = 0
+			m.Running = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -13791,16 +26118,16 @@ func (m *MultiModifySplitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Revision |= int64(b&0x7F) << shift
+				m.Running |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamSealedLength", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Converted", wireType)
 			}
-			m.LogStreamSealedLength = 0
+			m.Converted = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -13810,16 +26137,16 @@ func (m *MultiModifySplitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LogStreamSealedLength |= uint32(b&0x7F) << shift
+				m.Converted |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RowStreamSealedLength", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingBytes", wireType)
 			}
-			m.RowStreamSealedLength = 0
+			m.PendingBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -13829,16 +26156,16 @@ func (m *MultiModifySplitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RowStreamSealedLength |= uint32(b&0x7F) << shift
+				m.PendingBytes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetaStreamSealedLength", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConvertedBytes", wireType)
 			}
-			m.MetaStreamSealedLength = 0
+			m.ConvertedBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -13848,11 +26175,43 @@ func (m *MultiModifySplitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MetaStreamSealedLength |= uint32(b&0x7F) << shift
+				m.ConvertedBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -13874,7 +26233,7 @@ func (m *MultiModifySplitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MultiModifySplitResponse) Unmarshal(dAtA []byte) error {
+func (m *ECConversionStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13897,10 +26256,10 @@ func (m *MultiModifySplitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MultiModifySplitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ECConversionStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MultiModifySplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ECConversionStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13922,11 +26281,43 @@ func (m *MultiModifySplitResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeDes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -13936,23 +26327,25 @@ func (m *MultiModifySplitResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CodeDes = string(dAtA[iNdEx:postIndex])
+			m.Progress = append(m.Progress, &ECConversionProgress{})
+			if err := m.Progress[len(m.Progress)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13975,7 +26368,7 @@ func (m *MultiModifySplitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StatusRequest) Unmarshal(dAtA []byte) error {
+func (m *VerifyExtentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13998,12 +26391,31 @@ func (m *StatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: VerifyExtentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VerifyExtentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtentID", wireType)
+			}
+			m.ExtentID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtentID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -14025,7 +26437,7 @@ func (m *StatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StatusResponse) Unmarshal(dAtA []byte) error {
+func (m *VerifyExtentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14048,10 +26460,10 @@ func (m *StatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: VerifyExtentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VerifyExtentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -14105,78 +26517,9 @@ func (m *StatusResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.CodeDes = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PunchHolesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PunchHolesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PunchHolesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StreamID", wireType)
-			}
-			m.StreamID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StreamID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
+		case 3:
 			if wireType == 0 {
-				var v uint64
+				var v int64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return ErrIntOverflowPb
@@ -14186,12 +26529,12 @@ func (m *PunchHolesRequest) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= uint64(b&0x7F) << shift
+					v |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				m.ExtentIDs = append(m.ExtentIDs, v)
+				m.Checksums = append(m.Checksums, v)
 			} else if wireType == 2 {
 				var packedLen int
 				for shift := uint(0); ; shift += 7 {
@@ -14226,155 +26569,35 @@ func (m *PunchHolesRequest) Unmarshal(dAtA []byte) error {
 					}
 				}
 				elementCount = count
-				if elementCount != 0 && len(m.ExtentIDs) == 0 {
-					m.ExtentIDs = make([]uint64, 0, elementCount)
+				if elementCount != 0 && len(m.Checksums) == 0 {
+					m.Checksums = make([]int64, 0, elementCount)
 				}
 				for iNdEx < postIndex {
-					var v uint64
+					var v int64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ExtentIDs = append(m.ExtentIDs, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtentIDs", wireType)
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerKey", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OwnerKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
-			}
-			m.Revision = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Revision |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PunchHolesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PunchHolesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PunchHolesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
-			}
-			m.Code = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Code |= Code(b&0x7F) << shift
-				if b < 0x80 {
-					break
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Checksums = append(m.Checksums, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksums", wireType)
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Corrupted", wireType)
 			}
-			var stringLen uint64
+			m.Corrupted = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14384,27 +26607,14 @@ func (m *PunchHolesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Corrupted |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CodeDes = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Extent", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14431,10 +26641,10 @@ func (m *PunchHolesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stream == nil {
-				m.Stream = &StreamInfo{}
+			if m.Extent == nil {
+				m.Extent = &ExtentInfo{}
 			}
-			if err := m.Stream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Extent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14602,30 +26812,258 @@ func (m *ExtentInfo) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowPb
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtentInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtentInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtentID", wireType)
+			}
+			m.ExtentID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtentID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Replicates = append(m.Replicates, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Replicates) == 0 {
+					m.Replicates = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Replicates = append(m.Replicates, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicates", wireType)
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Parity = append(m.Parity, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Parity) == 0 {
+					m.Parity = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Parity = append(m.Parity, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parity", wireType)
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eversion", wireType)
+			}
+			m.Eversion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Eversion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refs", wireType)
+			}
+			m.Refs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Refs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SealedLength", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.SealedLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SealedLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ExtentInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExtentInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtentID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Avali", wireType)
 			}
-			m.ExtentID = 0
+			m.Avali = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14635,12 +27073,12 @@ func (m *ExtentInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExtentID |= uint64(b&0x7F) << shift
+				m.Avali |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 8:
 			if wireType == 0 {
 				var v uint64
 				for shift := uint(0); ; shift += 7 {
@@ -14657,7 +27095,7 @@ func (m *ExtentInfo) Unmarshal(dAtA []byte) error {
 						break
 					}
 				}
-				m.Replicates = append(m.Replicates, v)
+				m.ReplicateDisks = append(m.ReplicateDisks, v)
 			} else if wireType == 2 {
 				var packedLen int
 				for shift := uint(0); ; shift += 7 {
@@ -14692,8 +27130,8 @@ func (m *ExtentInfo) Unmarshal(dAtA []byte) error {
 					}
 				}
 				elementCount = count
-				if elementCount != 0 && len(m.Replicates) == 0 {
-					m.Replicates = make([]uint64, 0, elementCount)
+				if elementCount != 0 && len(m.ReplicateDisks) == 0 {
+					m.ReplicateDisks = make([]uint64, 0, elementCount)
 				}
 				for iNdEx < postIndex {
 					var v uint64
@@ -14711,12 +27149,12 @@ func (m *ExtentInfo) Unmarshal(dAtA []byte) error {
 							break
 						}
 					}
-					m.Replicates = append(m.Replicates, v)
+					m.ReplicateDisks = append(m.ReplicateDisks, v)
 				}
 			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicateDisks", wireType)
 			}
-		case 3:
+		case 9:
 			if wireType == 0 {
 				var v uint64
 				for shift := uint(0); ; shift += 7 {
@@ -14733,7 +27171,7 @@ func (m *ExtentInfo) Unmarshal(dAtA []byte) error {
 						break
 					}
 				}
-				m.Parity = append(m.Parity, v)
+				m.ParityDisk = append(m.ParityDisk, v)
 			} else if wireType == 2 {
 				var packedLen int
 				for shift := uint(0); ; shift += 7 {
@@ -14768,8 +27206,8 @@ func (m *ExtentInfo) Unmarshal(dAtA []byte) error {
 					}
 				}
 				elementCount = count
-				if elementCount != 0 && len(m.Parity) == 0 {
-					m.Parity = make([]uint64, 0, elementCount)
+				if elementCount != 0 && len(m.ParityDisk) == 0 {
+					m.ParityDisk = make([]uint64, 0, elementCount)
 				}
 				for iNdEx < postIndex {
 					var v uint64
@@ -14787,16 +27225,16 @@ func (m *ExtentInfo) Unmarshal(dAtA []byte) error {
 							break
 						}
 					}
-					m.Parity = append(m.Parity, v)
+					m.ParityDisk = append(m.ParityDisk, v)
 				}
 			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParityDisk", wireType)
 			}
-		case 4:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Eversion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FragmentSize", wireType)
 			}
-			m.Eversion = 0
+			m.FragmentSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14806,16 +27244,16 @@ func (m *ExtentInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Eversion |= uint64(b&0x7F) << shift
+				m.FragmentSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Refs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SealedTime", wireType)
 			}
-			m.Refs = 0
+			m.SealedTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14825,16 +27263,128 @@ func (m *ExtentInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Refs |= uint64(b&0x7F) << shift
+				m.SealedTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Codec == nil {
+				m.Codec = &ErasureCodec{}
+			}
+			if err := m.Codec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Checksums = append(m.Checksums, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Checksums) == 0 {
+					m.Checksums = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Checksums = append(m.Checksums, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksums", wireType)
+			}
+		case 14:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SealedLength", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChecksumMask", wireType)
 			}
-			m.SealedLength = 0
+			m.ChecksumMask = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14844,16 +27394,85 @@ func (m *ExtentInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SealedLength |= uint64(b&0x7F) << shift
+				m.ChecksumMask |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedTime", wireType)
+			}
+			m.DeletedTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeletedTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Avali", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamID", wireType)
 			}
-			m.Avali = 0
+			m.StreamID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14863,12 +27482,12 @@ func (m *ExtentInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Avali |= uint32(b&0x7F) << shift
+				m.StreamID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 2:
 			if wireType == 0 {
 				var v uint64
 				for shift := uint(0); ; shift += 7 {
@@ -14885,7 +27504,7 @@ func (m *ExtentInfo) Unmarshal(dAtA []byte) error {
 						break
 					}
 				}
-				m.ReplicateDisks = append(m.ReplicateDisks, v)
+				m.ExtentIDs = append(m.ExtentIDs, v)
 			} else if wireType == 2 {
 				var packedLen int
 				for shift := uint(0); ; shift += 7 {
@@ -14920,8 +27539,8 @@ func (m *ExtentInfo) Unmarshal(dAtA []byte) error {
 					}
 				}
 				elementCount = count
-				if elementCount != 0 && len(m.ReplicateDisks) == 0 {
-					m.ReplicateDisks = make([]uint64, 0, elementCount)
+				if elementCount != 0 && len(m.ExtentIDs) == 0 {
+					m.ExtentIDs = make([]uint64, 0, elementCount)
 				}
 				for iNdEx < postIndex {
 					var v uint64
@@ -14939,87 +27558,102 @@ func (m *ExtentInfo) Unmarshal(dAtA []byte) error {
 							break
 						}
 					}
-					m.ReplicateDisks = append(m.ReplicateDisks, v)
+					m.ExtentIDs = append(m.ExtentIDs, v)
 				}
 			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicateDisks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtentIDs", wireType)
 			}
-		case 9:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replication", wireType)
+			}
+			m.Replication = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
 				}
-				m.ParityDisk = append(m.ParityDisk, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPb
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Replication |= ReplicationMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPb
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EcPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.ParityDisk) == 0 {
-					m.ParityDisk = make([]uint64, 0, elementCount)
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EcPolicy == nil {
+				m.EcPolicy = &ECPolicy{}
+			}
+			if err := m.EcPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ParityDisk = append(m.ParityDisk, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParityDisk", wireType)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Codec == nil {
+				m.Codec = &ErasureCodec{}
+			}
+			if err := m.Codec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -15041,7 +27675,7 @@ func (m *ExtentInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StreamInfo) Unmarshal(dAtA []byte) error {
+func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15064,17 +27698,17 @@ func (m *StreamInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StreamInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: NodeInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StreamInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NodeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StreamID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
 			}
-			m.StreamID = 0
+			m.NodeID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15084,12 +27718,44 @@ func (m *StreamInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StreamID |= uint64(b&0x7F) << shift
+				m.NodeID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType == 0 {
 				var v uint64
 				for shift := uint(0); ; shift += 7 {
@@ -15106,7 +27772,7 @@ func (m *StreamInfo) Unmarshal(dAtA []byte) error {
 						break
 					}
 				}
-				m.ExtentIDs = append(m.ExtentIDs, v)
+				m.Disks = append(m.Disks, v)
 			} else if wireType == 2 {
 				var packedLen int
 				for shift := uint(0); ; shift += 7 {
@@ -15141,8 +27807,8 @@ func (m *StreamInfo) Unmarshal(dAtA []byte) error {
 					}
 				}
 				elementCount = count
-				if elementCount != 0 && len(m.ExtentIDs) == 0 {
-					m.ExtentIDs = make([]uint64, 0, elementCount)
+				if elementCount != 0 && len(m.Disks) == 0 {
+					m.Disks = make([]uint64, 0, elementCount)
 				}
 				for iNdEx < postIndex {
 					var v uint64
@@ -15160,11 +27826,67 @@ func (m *StreamInfo) Unmarshal(dAtA []byte) error {
 							break
 						}
 					}
-					m.ExtentIDs = append(m.ExtentIDs, v)
+					m.Disks = append(m.Disks, v)
 				}
 			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtentIDs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Disks", wireType)
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decommissioning", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Decommissioning = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topology", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Topology == nil {
+				m.Topology = &Topology{}
+			}
+			if err := m.Topology.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -15186,7 +27908,7 @@ func (m *StreamInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NodeInfo) Unmarshal(dAtA []byte) error {
+func (m *DiskInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15209,17 +27931,17 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NodeInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: DiskInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DiskInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskID", wireType)
 			}
-			m.NodeID = 0
+			m.DiskID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15229,14 +27951,34 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NodeID |= uint64(b&0x7F) << shift
+				m.DiskID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Online", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Online = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uuid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15264,84 +28006,8 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Uuid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Disks = append(m.Disks, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPb
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPb
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Disks) == 0 {
-					m.Disks = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Disks = append(m.Disks, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Disks", wireType)
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -15363,7 +28029,7 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DiskInfo) Unmarshal(dAtA []byte) error {
+func (m *MetaBackup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15386,17 +28052,17 @@ func (m *DiskInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DiskInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: MetaBackup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DiskInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MetaBackup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DiskID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			m.DiskID = 0
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15406,16 +28072,16 @@ func (m *DiskInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DiskID |= uint64(b&0x7F) << shift
+				m.Version |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Online", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
-			var v int
+			m.Revision = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15425,15 +28091,117 @@ func (m *DiskInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Revision |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Online = bool(v != 0)
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedTime", wireType)
+			}
+			m.CreatedTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kvs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kvs = append(m.Kvs, &MetaKV{})
+			if err := m.Kvs[len(m.Kvs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MetaKV) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetaKV: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetaKV: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15461,7 +28229,41 @@ func (m *DiskInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uuid = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
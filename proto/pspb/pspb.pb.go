@@ -27,6 +27,59 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type TxnStatus int32
+
+const (
+	TxnStatus_PENDING   TxnStatus = 0
+	TxnStatus_COMMITTED TxnStatus = 1
+	TxnStatus_ABORTED   TxnStatus = 2
+)
+
+var TxnStatus_name = map[int32]string{
+	0: "PENDING",
+	1: "COMMITTED",
+	2: "ABORTED",
+}
+
+var TxnStatus_value = map[string]int32{
+	"PENDING":   0,
+	"COMMITTED": 1,
+	"ABORTED":   2,
+}
+
+func (x TxnStatus) String() string {
+	return proto.EnumName(TxnStatus_name, int32(x))
+}
+
+func (TxnStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{0}
+}
+
+type WatchEvent_EventType int32
+
+const (
+	WatchEvent_PUT    WatchEvent_EventType = 0
+	WatchEvent_DELETE WatchEvent_EventType = 1
+)
+
+var WatchEvent_EventType_name = map[int32]string{
+	0: "PUT",
+	1: "DELETE",
+}
+
+var WatchEvent_EventType_value = map[string]int32{
+	"PUT":    0,
+	"DELETE": 1,
+}
+
+func (x WatchEvent_EventType) String() string {
+	return proto.EnumName(WatchEvent_EventType_name, int32(x))
+}
+
+func (WatchEvent_EventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{31, 0}
+}
+
 type RegionInfo struct {
 	Rg     *Range `protobuf:"bytes,1,opt,name=rg,proto3" json:"rg,omitempty"`
 	PartID uint64 `protobuf:"varint,2,opt,name=PartID,proto3" json:"PartID,omitempty"`
@@ -236,7 +289,9 @@ func (m *Location) GetOffset() uint32 {
 }
 
 type TableLocations struct {
-	Locs []*Location `protobuf:"bytes,1,rep,name=locs,proto3" json:"locs,omitempty"`
+	Locs        []*Location `protobuf:"bytes,1,rep,name=locs,proto3" json:"locs,omitempty"`
+	LogGCSeq    uint64      `protobuf:"varint,2,opt,name=logGCSeq,proto3" json:"logGCSeq,omitempty"`
+	DeleteGCSeq uint64      `protobuf:"varint,3,opt,name=deleteGCSeq,proto3" json:"deleteGCSeq,omitempty"`
 }
 
 func (m *TableLocations) Reset()         { *m = TableLocations{} }
@@ -279,6 +334,20 @@ func (m *TableLocations) GetLocs() []*Location {
 	return nil
 }
 
+func (m *TableLocations) GetLogGCSeq() uint64 {
+	if m != nil {
+		return m.LogGCSeq
+	}
+	return 0
+}
+
+func (m *TableLocations) GetDeleteGCSeq() uint64 {
+	if m != nil {
+		return m.DeleteGCSeq
+	}
+	return 0
+}
+
 type PartitionMeta struct {
 	LogStream  uint64 `protobuf:"varint,2,opt,name=logStream,proto3" json:"logStream,omitempty"`
 	RowStream  uint64 `protobuf:"varint,3,opt,name=rowStream,proto3" json:"rowStream,omitempty"`
@@ -355,6 +424,99 @@ func (m *PartitionMeta) GetMetaStream() uint64 {
 	return 0
 }
 
+//read-only point-in-time clone of a partition, saved as snapshots/{snapshotID}
+type PartitionSnapshot struct {
+	SnapshotID uint64 `protobuf:"varint,1,opt,name=snapshotID,proto3" json:"snapshotID,omitempty"`
+	PartID     uint64 `protobuf:"varint,2,opt,name=partID,proto3" json:"partID,omitempty"`
+	LogStream  uint64 `protobuf:"varint,3,opt,name=logStream,proto3" json:"logStream,omitempty"`
+	RowStream  uint64 `protobuf:"varint,4,opt,name=rowStream,proto3" json:"rowStream,omitempty"`
+	MetaStream uint64 `protobuf:"varint,5,opt,name=metaStream,proto3" json:"metaStream,omitempty"`
+	Rg         *Range `protobuf:"bytes,6,opt,name=rg,proto3" json:"rg,omitempty"`
+	CreatedAt  int64  `protobuf:"varint,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+}
+
+func (m *PartitionSnapshot) Reset()         { *m = PartitionSnapshot{} }
+func (m *PartitionSnapshot) String() string { return proto.CompactTextString(m) }
+func (*PartitionSnapshot) ProtoMessage()    {}
+func (*PartitionSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{6}
+}
+func (m *PartitionSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PartitionSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PartitionSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PartitionSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartitionSnapshot.Merge(m, src)
+}
+func (m *PartitionSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *PartitionSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartitionSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartitionSnapshot proto.InternalMessageInfo
+
+func (m *PartitionSnapshot) GetSnapshotID() uint64 {
+	if m != nil {
+		return m.SnapshotID
+	}
+	return 0
+}
+
+func (m *PartitionSnapshot) GetPartID() uint64 {
+	if m != nil {
+		return m.PartID
+	}
+	return 0
+}
+
+func (m *PartitionSnapshot) GetLogStream() uint64 {
+	if m != nil {
+		return m.LogStream
+	}
+	return 0
+}
+
+func (m *PartitionSnapshot) GetRowStream() uint64 {
+	if m != nil {
+		return m.RowStream
+	}
+	return 0
+}
+
+func (m *PartitionSnapshot) GetMetaStream() uint64 {
+	if m != nil {
+		return m.MetaStream
+	}
+	return 0
+}
+
+func (m *PartitionSnapshot) GetRg() *Range {
+	if m != nil {
+		return m.Rg
+	}
+	return nil
+}
+
+func (m *PartitionSnapshot) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
 type PSDetail struct {
 	PSID    uint64 `protobuf:"varint,1,opt,name=PSID,proto3" json:"PSID,omitempty"`
 	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
@@ -364,7 +526,7 @@ func (m *PSDetail) Reset()         { *m = PSDetail{} }
 func (m *PSDetail) String() string { return proto.CompactTextString(m) }
 func (*PSDetail) ProtoMessage()    {}
 func (*PSDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e3c719c85d382a4, []int{6}
+	return fileDescriptor_3e3c719c85d382a4, []int{7}
 }
 func (m *PSDetail) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -407,29 +569,23 @@ func (m *PSDetail) GetAddress() string {
 	return ""
 }
 
-type BlockMeta struct {
-	TableIndex       *TableIndex      `protobuf:"bytes,1,opt,name=tableIndex,proto3" json:"tableIndex,omitempty"`
-	CompressedSize   uint32           `protobuf:"varint,2,opt,name=CompressedSize,proto3" json:"CompressedSize,omitempty"`
-	UnCompressedSize uint32           `protobuf:"varint,3,opt,name=UnCompressedSize,proto3" json:"UnCompressedSize,omitempty"`
-	VpExtentID       uint64           `protobuf:"varint,4,opt,name=vpExtentID,proto3" json:"vpExtentID,omitempty"`
-	VpOffset         uint32           `protobuf:"varint,5,opt,name=vpOffset,proto3" json:"vpOffset,omitempty"`
-	SeqNum           uint64           `protobuf:"varint,6,opt,name=seqNum,proto3" json:"seqNum,omitempty"`
-	Discards         map[uint64]int64 `protobuf:"bytes,7,rep,name=discards,proto3" json:"discards,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	CompressionType  uint32           `protobuf:"varint,8,opt,name=CompressionType,proto3" json:"CompressionType,omitempty"`
+type ByteRange struct {
+	Offset uint32 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Length uint32 `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
 }
 
-func (m *BlockMeta) Reset()         { *m = BlockMeta{} }
-func (m *BlockMeta) String() string { return proto.CompactTextString(m) }
-func (*BlockMeta) ProtoMessage()    {}
-func (*BlockMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e3c719c85d382a4, []int{7}
+func (m *ByteRange) Reset()         { *m = ByteRange{} }
+func (m *ByteRange) String() string { return proto.CompactTextString(m) }
+func (*ByteRange) ProtoMessage()    {}
+func (*ByteRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{8}
 }
-func (m *BlockMeta) XXX_Unmarshal(b []byte) error {
+func (m *ByteRange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BlockMeta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ByteRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BlockMeta.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ByteRange.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -439,92 +595,151 @@ func (m *BlockMeta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *BlockMeta) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BlockMeta.Merge(m, src)
+func (m *ByteRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ByteRange.Merge(m, src)
 }
-func (m *BlockMeta) XXX_Size() int {
+func (m *ByteRange) XXX_Size() int {
 	return m.Size()
 }
-func (m *BlockMeta) XXX_DiscardUnknown() {
-	xxx_messageInfo_BlockMeta.DiscardUnknown(m)
+func (m *ByteRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ByteRange.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BlockMeta proto.InternalMessageInfo
+var xxx_messageInfo_ByteRange proto.InternalMessageInfo
 
-func (m *BlockMeta) GetTableIndex() *TableIndex {
+func (m *ByteRange) GetOffset() uint32 {
 	if m != nil {
-		return m.TableIndex
+		return m.Offset
 	}
-	return nil
+	return 0
 }
 
-func (m *BlockMeta) GetCompressedSize() uint32 {
+func (m *ByteRange) GetLength() uint32 {
 	if m != nil {
-		return m.CompressedSize
+		return m.Length
 	}
 	return 0
 }
 
-func (m *BlockMeta) GetUnCompressedSize() uint32 {
-	if m != nil {
-		return m.UnCompressedSize
+//secondary index on keys with keyPrefix, the indexed field is extracted from value
+type IndexInfo struct {
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	KeyPrefix []byte `protobuf:"bytes,2,opt,name=keyPrefix,proto3" json:"keyPrefix,omitempty"`
+	// Types that are valid to be assigned to Field:
+	//	*IndexInfo_JsonPath
+	//	*IndexInfo_ByteRange
+	Field isIndexInfo_Field `protobuf_oneof:"field"`
+}
+
+func (m *IndexInfo) Reset()         { *m = IndexInfo{} }
+func (m *IndexInfo) String() string { return proto.CompactTextString(m) }
+func (*IndexInfo) ProtoMessage()    {}
+func (*IndexInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{9}
+}
+func (m *IndexInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IndexInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *IndexInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexInfo.Merge(m, src)
+}
+func (m *IndexInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *IndexInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexInfo.DiscardUnknown(m)
 }
 
-func (m *BlockMeta) GetVpExtentID() uint64 {
+var xxx_messageInfo_IndexInfo proto.InternalMessageInfo
+
+type isIndexInfo_Field interface {
+	isIndexInfo_Field()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type IndexInfo_JsonPath struct {
+	JsonPath string `protobuf:"bytes,3,opt,name=jsonPath,proto3,oneof" json:"jsonPath,omitempty"`
+}
+type IndexInfo_ByteRange struct {
+	ByteRange *ByteRange `protobuf:"bytes,4,opt,name=byteRange,proto3,oneof" json:"byteRange,omitempty"`
+}
+
+func (*IndexInfo_JsonPath) isIndexInfo_Field()  {}
+func (*IndexInfo_ByteRange) isIndexInfo_Field() {}
+
+func (m *IndexInfo) GetField() isIndexInfo_Field {
 	if m != nil {
-		return m.VpExtentID
+		return m.Field
 	}
-	return 0
+	return nil
 }
 
-func (m *BlockMeta) GetVpOffset() uint32 {
+func (m *IndexInfo) GetName() string {
 	if m != nil {
-		return m.VpOffset
+		return m.Name
 	}
-	return 0
+	return ""
 }
 
-func (m *BlockMeta) GetSeqNum() uint64 {
+func (m *IndexInfo) GetKeyPrefix() []byte {
 	if m != nil {
-		return m.SeqNum
+		return m.KeyPrefix
 	}
-	return 0
+	return nil
 }
 
-func (m *BlockMeta) GetDiscards() map[uint64]int64 {
-	if m != nil {
-		return m.Discards
+func (m *IndexInfo) GetJsonPath() string {
+	if x, ok := m.GetField().(*IndexInfo_JsonPath); ok {
+		return x.JsonPath
+	}
+	return ""
+}
+
+func (m *IndexInfo) GetByteRange() *ByteRange {
+	if x, ok := m.GetField().(*IndexInfo_ByteRange); ok {
+		return x.ByteRange
 	}
 	return nil
 }
 
-func (m *BlockMeta) GetCompressionType() uint32 {
-	if m != nil {
-		return m.CompressionType
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*IndexInfo) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*IndexInfo_JsonPath)(nil),
+		(*IndexInfo_ByteRange)(nil),
 	}
-	return 0
 }
 
-type BlockOffset struct {
-	Key      []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	ExtentID uint64 `protobuf:"varint,2,opt,name=extentID,proto3" json:"extentID,omitempty"`
-	Offset   uint32 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+type PrefixQuota struct {
+	Prefix   []byte `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	MaxBytes uint64 `protobuf:"varint,2,opt,name=maxBytes,proto3" json:"maxBytes,omitempty"`
 }
 
-func (m *BlockOffset) Reset()         { *m = BlockOffset{} }
-func (m *BlockOffset) String() string { return proto.CompactTextString(m) }
-func (*BlockOffset) ProtoMessage()    {}
-func (*BlockOffset) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e3c719c85d382a4, []int{8}
+func (m *PrefixQuota) Reset()         { *m = PrefixQuota{} }
+func (m *PrefixQuota) String() string { return proto.CompactTextString(m) }
+func (*PrefixQuota) ProtoMessage()    {}
+func (*PrefixQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{10}
 }
-func (m *BlockOffset) XXX_Unmarshal(b []byte) error {
+func (m *PrefixQuota) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BlockOffset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PrefixQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BlockOffset.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PrefixQuota.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -534,58 +749,52 @@ func (m *BlockOffset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *BlockOffset) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BlockOffset.Merge(m, src)
+func (m *PrefixQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrefixQuota.Merge(m, src)
 }
-func (m *BlockOffset) XXX_Size() int {
+func (m *PrefixQuota) XXX_Size() int {
 	return m.Size()
 }
-func (m *BlockOffset) XXX_DiscardUnknown() {
-	xxx_messageInfo_BlockOffset.DiscardUnknown(m)
+func (m *PrefixQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrefixQuota.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BlockOffset proto.InternalMessageInfo
+var xxx_messageInfo_PrefixQuota proto.InternalMessageInfo
 
-func (m *BlockOffset) GetKey() []byte {
+func (m *PrefixQuota) GetPrefix() []byte {
 	if m != nil {
-		return m.Key
+		return m.Prefix
 	}
 	return nil
 }
 
-func (m *BlockOffset) GetExtentID() uint64 {
-	if m != nil {
-		return m.ExtentID
-	}
-	return 0
-}
-
-func (m *BlockOffset) GetOffset() uint32 {
+func (m *PrefixQuota) GetMaxBytes() uint64 {
 	if m != nil {
-		return m.Offset
+		return m.MaxBytes
 	}
 	return 0
 }
 
-type TableIndex struct {
-	Offsets       []*BlockOffset `protobuf:"bytes,1,rep,name=offsets,proto3" json:"offsets,omitempty"`
-	BloomFilter   []byte         `protobuf:"bytes,2,opt,name=bloomFilter,proto3" json:"bloomFilter,omitempty"`
-	EstimatedSize uint64         `protobuf:"varint,3,opt,name=estimatedSize,proto3" json:"estimatedSize,omitempty"`
-	NumOfBlocks   uint32         `protobuf:"varint,4,opt,name=numOfBlocks,proto3" json:"numOfBlocks,omitempty"`
+//0 means unlimited
+type TenantLimit struct {
+	Tenant      string         `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	OpsPerSec   uint64         `protobuf:"varint,2,opt,name=opsPerSec,proto3" json:"opsPerSec,omitempty"`
+	BytesPerSec uint64         `protobuf:"varint,3,opt,name=bytesPerSec,proto3" json:"bytesPerSec,omitempty"`
+	Quotas      []*PrefixQuota `protobuf:"bytes,4,rep,name=quotas,proto3" json:"quotas,omitempty"`
 }
 
-func (m *TableIndex) Reset()         { *m = TableIndex{} }
-func (m *TableIndex) String() string { return proto.CompactTextString(m) }
-func (*TableIndex) ProtoMessage()    {}
-func (*TableIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e3c719c85d382a4, []int{9}
+func (m *TenantLimit) Reset()         { *m = TenantLimit{} }
+func (m *TenantLimit) String() string { return proto.CompactTextString(m) }
+func (*TenantLimit) ProtoMessage()    {}
+func (*TenantLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{11}
 }
-func (m *TableIndex) XXX_Unmarshal(b []byte) error {
+func (m *TenantLimit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TableIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TenantLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TableIndex.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TenantLimit.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -595,58 +804,294 @@ func (m *TableIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *TableIndex) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TableIndex.Merge(m, src)
+func (m *TenantLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TenantLimit.Merge(m, src)
 }
-func (m *TableIndex) XXX_Size() int {
+func (m *TenantLimit) XXX_Size() int {
 	return m.Size()
 }
-func (m *TableIndex) XXX_DiscardUnknown() {
-	xxx_messageInfo_TableIndex.DiscardUnknown(m)
+func (m *TenantLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_TenantLimit.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TableIndex proto.InternalMessageInfo
+var xxx_messageInfo_TenantLimit proto.InternalMessageInfo
 
-func (m *TableIndex) GetOffsets() []*BlockOffset {
+func (m *TenantLimit) GetTenant() string {
 	if m != nil {
-		return m.Offsets
+		return m.Tenant
 	}
-	return nil
+	return ""
 }
 
-func (m *TableIndex) GetBloomFilter() []byte {
+func (m *TenantLimit) GetOpsPerSec() uint64 {
 	if m != nil {
-		return m.BloomFilter
+		return m.OpsPerSec
 	}
-	return nil
+	return 0
 }
 
-func (m *TableIndex) GetEstimatedSize() uint64 {
+func (m *TenantLimit) GetBytesPerSec() uint64 {
 	if m != nil {
-		return m.EstimatedSize
+		return m.BytesPerSec
 	}
 	return 0
 }
 
-func (m *TableIndex) GetNumOfBlocks() uint32 {
+func (m *TenantLimit) GetQuotas() []*PrefixQuota {
 	if m != nil {
-		return m.NumOfBlocks
+		return m.Quotas
 	}
-	return 0
-}
-
-type PutRequest struct {
-	Key       []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value     []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	ExpiresAt uint64 `protobuf:"varint,3,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
-	Partid    uint64 `protobuf:"varint,4,opt,name=partid,proto3" json:"partid,omitempty"`
+	return nil
+}
+
+type BlockMeta struct {
+	TableIndex       *TableIndex      `protobuf:"bytes,1,opt,name=tableIndex,proto3" json:"tableIndex,omitempty"`
+	CompressedSize   uint32           `protobuf:"varint,2,opt,name=CompressedSize,proto3" json:"CompressedSize,omitempty"`
+	UnCompressedSize uint32           `protobuf:"varint,3,opt,name=UnCompressedSize,proto3" json:"UnCompressedSize,omitempty"`
+	VpExtentID       uint64           `protobuf:"varint,4,opt,name=vpExtentID,proto3" json:"vpExtentID,omitempty"`
+	VpOffset         uint32           `protobuf:"varint,5,opt,name=vpOffset,proto3" json:"vpOffset,omitempty"`
+	SeqNum           uint64           `protobuf:"varint,6,opt,name=seqNum,proto3" json:"seqNum,omitempty"`
+	Discards         map[uint64]int64 `protobuf:"bytes,7,rep,name=discards,proto3" json:"discards,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	CompressionType  uint32           `protobuf:"varint,8,opt,name=CompressionType,proto3" json:"CompressionType,omitempty"`
+	HasTxnIntents    bool             `protobuf:"varint,9,opt,name=hasTxnIntents,proto3" json:"hasTxnIntents,omitempty"`
+}
+
+func (m *BlockMeta) Reset()         { *m = BlockMeta{} }
+func (m *BlockMeta) String() string { return proto.CompactTextString(m) }
+func (*BlockMeta) ProtoMessage()    {}
+func (*BlockMeta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{12}
+}
+func (m *BlockMeta) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockMeta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockMeta.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockMeta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockMeta.Merge(m, src)
+}
+func (m *BlockMeta) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockMeta) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockMeta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockMeta proto.InternalMessageInfo
+
+func (m *BlockMeta) GetTableIndex() *TableIndex {
+	if m != nil {
+		return m.TableIndex
+	}
+	return nil
+}
+
+func (m *BlockMeta) GetCompressedSize() uint32 {
+	if m != nil {
+		return m.CompressedSize
+	}
+	return 0
+}
+
+func (m *BlockMeta) GetUnCompressedSize() uint32 {
+	if m != nil {
+		return m.UnCompressedSize
+	}
+	return 0
+}
+
+func (m *BlockMeta) GetVpExtentID() uint64 {
+	if m != nil {
+		return m.VpExtentID
+	}
+	return 0
+}
+
+func (m *BlockMeta) GetVpOffset() uint32 {
+	if m != nil {
+		return m.VpOffset
+	}
+	return 0
+}
+
+func (m *BlockMeta) GetSeqNum() uint64 {
+	if m != nil {
+		return m.SeqNum
+	}
+	return 0
+}
+
+func (m *BlockMeta) GetDiscards() map[uint64]int64 {
+	if m != nil {
+		return m.Discards
+	}
+	return nil
+}
+
+func (m *BlockMeta) GetCompressionType() uint32 {
+	if m != nil {
+		return m.CompressionType
+	}
+	return 0
+}
+
+func (m *BlockMeta) GetHasTxnIntents() bool {
+	if m != nil {
+		return m.HasTxnIntents
+	}
+	return false
+}
+
+type BlockOffset struct {
+	Key      []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	ExtentID uint64 `protobuf:"varint,2,opt,name=extentID,proto3" json:"extentID,omitempty"`
+	Offset   uint32 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (m *BlockOffset) Reset()         { *m = BlockOffset{} }
+func (m *BlockOffset) String() string { return proto.CompactTextString(m) }
+func (*BlockOffset) ProtoMessage()    {}
+func (*BlockOffset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{13}
+}
+func (m *BlockOffset) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockOffset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockOffset.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockOffset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockOffset.Merge(m, src)
+}
+func (m *BlockOffset) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockOffset) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockOffset.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockOffset proto.InternalMessageInfo
+
+func (m *BlockOffset) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *BlockOffset) GetExtentID() uint64 {
+	if m != nil {
+		return m.ExtentID
+	}
+	return 0
+}
+
+func (m *BlockOffset) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type TableIndex struct {
+	Offsets       []*BlockOffset `protobuf:"bytes,1,rep,name=offsets,proto3" json:"offsets,omitempty"`
+	BloomFilter   []byte         `protobuf:"bytes,2,opt,name=bloomFilter,proto3" json:"bloomFilter,omitempty"`
+	EstimatedSize uint64         `protobuf:"varint,3,opt,name=estimatedSize,proto3" json:"estimatedSize,omitempty"`
+	NumOfBlocks   uint32         `protobuf:"varint,4,opt,name=numOfBlocks,proto3" json:"numOfBlocks,omitempty"`
+}
+
+func (m *TableIndex) Reset()         { *m = TableIndex{} }
+func (m *TableIndex) String() string { return proto.CompactTextString(m) }
+func (*TableIndex) ProtoMessage()    {}
+func (*TableIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{14}
+}
+func (m *TableIndex) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TableIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TableIndex.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TableIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TableIndex.Merge(m, src)
+}
+func (m *TableIndex) XXX_Size() int {
+	return m.Size()
+}
+func (m *TableIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_TableIndex.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TableIndex proto.InternalMessageInfo
+
+func (m *TableIndex) GetOffsets() []*BlockOffset {
+	if m != nil {
+		return m.Offsets
+	}
+	return nil
+}
+
+func (m *TableIndex) GetBloomFilter() []byte {
+	if m != nil {
+		return m.BloomFilter
+	}
+	return nil
+}
+
+func (m *TableIndex) GetEstimatedSize() uint64 {
+	if m != nil {
+		return m.EstimatedSize
+	}
+	return 0
+}
+
+func (m *TableIndex) GetNumOfBlocks() uint32 {
+	if m != nil {
+		return m.NumOfBlocks
+	}
+	return 0
+}
+
+type PutRequest struct {
+	Key       []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value     []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	ExpiresAt uint64 `protobuf:"varint,3,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
+	Partid    uint64 `protobuf:"varint,4,opt,name=partid,proto3" json:"partid,omitempty"`
 }
 
 func (m *PutRequest) Reset()         { *m = PutRequest{} }
 func (m *PutRequest) String() string { return proto.CompactTextString(m) }
 func (*PutRequest) ProtoMessage()    {}
 func (*PutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e3c719c85d382a4, []int{10}
+	return fileDescriptor_3e3c719c85d382a4, []int{15}
 }
 func (m *PutRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -711,7 +1156,7 @@ func (m *PutResponse) Reset()         { *m = PutResponse{} }
 func (m *PutResponse) String() string { return proto.CompactTextString(m) }
 func (*PutResponse) ProtoMessage()    {}
 func (*PutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e3c719c85d382a4, []int{11}
+	return fileDescriptor_3e3c719c85d382a4, []int{16}
 }
 func (m *PutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -756,7 +1201,7 @@ func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
 func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRequest) ProtoMessage()    {}
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e3c719c85d382a4, []int{12}
+	return fileDescriptor_3e3c719c85d382a4, []int{17}
 }
 func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -807,7 +1252,7 @@ func (m *DeleteResponse) Reset()         { *m = DeleteResponse{} }
 func (m *DeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteResponse) ProtoMessage()    {}
 func (*DeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e3c719c85d382a4, []int{13}
+	return fileDescriptor_3e3c719c85d382a4, []int{18}
 }
 func (m *DeleteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -844,15 +1289,18 @@ func (m *DeleteResponse) GetKey() []byte {
 }
 
 type GetRequest struct {
-	Key    []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Partid uint64 `protobuf:"varint,2,opt,name=partid,proto3" json:"partid,omitempty"`
+	Key           []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Partid        uint64 `protobuf:"varint,2,opt,name=partid,proto3" json:"partid,omitempty"`
+	AllowNotFound bool   `protobuf:"varint,3,opt,name=allowNotFound,proto3" json:"allowNotFound,omitempty"`
+	WithOrigin    bool   `protobuf:"varint,4,opt,name=withOrigin,proto3" json:"withOrigin,omitempty"`
+	SnapshotID    uint64 `protobuf:"varint,5,opt,name=snapshotID,proto3" json:"snapshotID,omitempty"`
 }
 
 func (m *GetRequest) Reset()         { *m = GetRequest{} }
 func (m *GetRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRequest) ProtoMessage()    {}
 func (*GetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e3c719c85d382a4, []int{14}
+	return fileDescriptor_3e3c719c85d382a4, []int{19}
 }
 func (m *GetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -895,16 +1343,42 @@ func (m *GetRequest) GetPartid() uint64 {
 	return 0
 }
 
-type GetResponse struct {
-	Key   []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+func (m *GetRequest) GetAllowNotFound() bool {
+	if m != nil {
+		return m.AllowNotFound
+	}
+	return false
+}
+
+func (m *GetRequest) GetWithOrigin() bool {
+	if m != nil {
+		return m.WithOrigin
+	}
+	return false
+}
+
+func (m *GetRequest) GetSnapshotID() uint64 {
+	if m != nil {
+		return m.SnapshotID
+	}
+	return 0
+}
+
+type GetResponse struct {
+	Key           []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Version       uint64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	NotFound      bool   `protobuf:"varint,4,opt,name=notFound,proto3" json:"notFound,omitempty"`
+	OriginCluster uint64 `protobuf:"varint,5,opt,name=originCluster,proto3" json:"originCluster,omitempty"`
+	OriginSeq     uint64 `protobuf:"varint,6,opt,name=originSeq,proto3" json:"originSeq,omitempty"`
+	ExpiresAt     uint64 `protobuf:"varint,7,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
 }
 
 func (m *GetResponse) Reset()         { *m = GetResponse{} }
 func (m *GetResponse) String() string { return proto.CompactTextString(m) }
 func (*GetResponse) ProtoMessage()    {}
 func (*GetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e3c719c85d382a4, []int{15}
+	return fileDescriptor_3e3c719c85d382a4, []int{20}
 }
 func (m *GetResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -947,6 +1421,41 @@ func (m *GetResponse) GetValue() []byte {
 	return nil
 }
 
+func (m *GetResponse) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *GetResponse) GetNotFound() bool {
+	if m != nil {
+		return m.NotFound
+	}
+	return false
+}
+
+func (m *GetResponse) GetOriginCluster() uint64 {
+	if m != nil {
+		return m.OriginCluster
+	}
+	return 0
+}
+
+func (m *GetResponse) GetOriginSeq() uint64 {
+	if m != nil {
+		return m.OriginSeq
+	}
+	return 0
+}
+
+func (m *GetResponse) GetExpiresAt() uint64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
 type RequestOp struct {
 	// Types that are valid to be assigned to Request:
 	//	*RequestOp_RequestPut
@@ -959,7 +1468,7 @@ func (m *RequestOp) Reset()         { *m = RequestOp{} }
 func (m *RequestOp) String() string { return proto.CompactTextString(m) }
 func (*RequestOp) ProtoMessage()    {}
 func (*RequestOp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e3c719c85d382a4, []int{16}
+	return fileDescriptor_3e3c719c85d382a4, []int{21}
 }
 func (m *RequestOp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1057,7 +1566,7 @@ func (m *ResponseOp) Reset()         { *m = ResponseOp{} }
 func (m *ResponseOp) String() string { return proto.CompactTextString(m) }
 func (*ResponseOp) ProtoMessage()    {}
 func (*ResponseOp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e3c719c85d382a4, []int{17}
+	return fileDescriptor_3e3c719c85d382a4, []int{22}
 }
 func (m *ResponseOp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1151,7 +1660,7 @@ func (m *BatchRequest) Reset()         { *m = BatchRequest{} }
 func (m *BatchRequest) String() string { return proto.CompactTextString(m) }
 func (*BatchRequest) ProtoMessage()    {}
 func (*BatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e3c719c85d382a4, []int{18}
+	return fileDescriptor_3e3c719c85d382a4, []int{23}
 }
 func (m *BatchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1195,7 +1704,7 @@ func (m *BatchResponse) Reset()         { *m = BatchResponse{} }
 func (m *BatchResponse) String() string { return proto.CompactTextString(m) }
 func (*BatchResponse) ProtoMessage()    {}
 func (*BatchResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e3c719c85d382a4, []int{19}
+	return fileDescriptor_3e3c719c85d382a4, []int{24}
 }
 func (m *BatchResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1233,17 +1742,19 @@ func (m *BatchResponse) GetRes() []*RequestOp {
 
 //return message KeyValue?
 type RangeRequest struct {
-	Prefix []byte `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
-	Start  []byte `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
-	Limit  uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	Partid uint64 `protobuf:"varint,4,opt,name=partid,proto3" json:"partid,omitempty"`
+	Prefix     []byte `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Start      []byte `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	Limit      uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Partid     uint64 `protobuf:"varint,4,opt,name=partid,proto3" json:"partid,omitempty"`
+	SinceSeq   uint64 `protobuf:"varint,5,opt,name=sinceSeq,proto3" json:"sinceSeq,omitempty"`
+	SnapshotID uint64 `protobuf:"varint,6,opt,name=snapshotID,proto3" json:"snapshotID,omitempty"`
 }
 
 func (m *RangeRequest) Reset()         { *m = RangeRequest{} }
 func (m *RangeRequest) String() string { return proto.CompactTextString(m) }
 func (*RangeRequest) ProtoMessage()    {}
 func (*RangeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e3c719c85d382a4, []int{20}
+	return fileDescriptor_3e3c719c85d382a4, []int{25}
 }
 func (m *RangeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1300,16 +1811,33 @@ func (m *RangeRequest) GetPartid() uint64 {
 	return 0
 }
 
+func (m *RangeRequest) GetSinceSeq() uint64 {
+	if m != nil {
+		return m.SinceSeq
+	}
+	return 0
+}
+
+func (m *RangeRequest) GetSnapshotID() uint64 {
+	if m != nil {
+		return m.SnapshotID
+	}
+	return 0
+}
+
 type RangeResponse struct {
-	Truncated bool     `protobuf:"varint,1,opt,name=truncated,proto3" json:"truncated,omitempty"`
-	Keys      [][]byte `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	Truncated   bool     `protobuf:"varint,1,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	Keys        [][]byte `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	Deleted     []bool   `protobuf:"varint,3,rep,packed,name=deleted,proto3" json:"deleted,omitempty"`
+	Seq         uint64   `protobuf:"varint,4,opt,name=seq,proto3" json:"seq,omitempty"`
+	DeleteGCSeq uint64   `protobuf:"varint,5,opt,name=deleteGCSeq,proto3" json:"deleteGCSeq,omitempty"`
 }
 
 func (m *RangeResponse) Reset()         { *m = RangeResponse{} }
 func (m *RangeResponse) String() string { return proto.CompactTextString(m) }
 func (*RangeResponse) ProtoMessage()    {}
 func (*RangeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e3c719c85d382a4, []int{21}
+	return fileDescriptor_3e3c719c85d382a4, []int{26}
 }
 func (m *RangeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1352,22 +1880,47 @@ func (m *RangeResponse) GetKeys() [][]byte {
 	return nil
 }
 
-type SplitPartRequest struct {
-	Partid uint64 `protobuf:"varint,1,opt,name=partid,proto3" json:"partid,omitempty"`
+func (m *RangeResponse) GetDeleted() []bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return nil
 }
 
-func (m *SplitPartRequest) Reset()         { *m = SplitPartRequest{} }
-func (m *SplitPartRequest) String() string { return proto.CompactTextString(m) }
-func (*SplitPartRequest) ProtoMessage()    {}
-func (*SplitPartRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e3c719c85d382a4, []int{22}
+func (m *RangeResponse) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
 }
-func (m *SplitPartRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *RangeResponse) GetDeleteGCSeq() uint64 {
+	if m != nil {
+		return m.DeleteGCSeq
+	}
+	return 0
+}
+
+type IndexRangeRequest struct {
+	Index  string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	Value  []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Exact  bool   `protobuf:"varint,3,opt,name=exact,proto3" json:"exact,omitempty"`
+	Limit  uint32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Partid uint64 `protobuf:"varint,5,opt,name=partid,proto3" json:"partid,omitempty"`
+}
+
+func (m *IndexRangeRequest) Reset()         { *m = IndexRangeRequest{} }
+func (m *IndexRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*IndexRangeRequest) ProtoMessage()    {}
+func (*IndexRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{27}
+}
+func (m *IndexRangeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SplitPartRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *IndexRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SplitPartRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_IndexRangeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1377,40 +1930,70 @@ func (m *SplitPartRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *SplitPartRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SplitPartRequest.Merge(m, src)
+func (m *IndexRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexRangeRequest.Merge(m, src)
 }
-func (m *SplitPartRequest) XXX_Size() int {
+func (m *IndexRangeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *SplitPartRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SplitPartRequest.DiscardUnknown(m)
+func (m *IndexRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexRangeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SplitPartRequest proto.InternalMessageInfo
+var xxx_messageInfo_IndexRangeRequest proto.InternalMessageInfo
 
-func (m *SplitPartRequest) GetPartid() uint64 {
+func (m *IndexRangeRequest) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *IndexRangeRequest) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *IndexRangeRequest) GetExact() bool {
+	if m != nil {
+		return m.Exact
+	}
+	return false
+}
+
+func (m *IndexRangeRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *IndexRangeRequest) GetPartid() uint64 {
 	if m != nil {
 		return m.Partid
 	}
 	return 0
 }
 
-type SplitPartResponse struct {
+type IndexEntry struct {
+	Value []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Key   []byte `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
 }
 
-func (m *SplitPartResponse) Reset()         { *m = SplitPartResponse{} }
-func (m *SplitPartResponse) String() string { return proto.CompactTextString(m) }
-func (*SplitPartResponse) ProtoMessage()    {}
-func (*SplitPartResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e3c719c85d382a4, []int{23}
+func (m *IndexEntry) Reset()         { *m = IndexEntry{} }
+func (m *IndexEntry) String() string { return proto.CompactTextString(m) }
+func (*IndexEntry) ProtoMessage()    {}
+func (*IndexEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{28}
 }
-func (m *SplitPartResponse) XXX_Unmarshal(b []byte) error {
+func (m *IndexEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SplitPartResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *IndexEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SplitPartResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_IndexEntry.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1420,33 +2003,49 @@ func (m *SplitPartResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *SplitPartResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SplitPartResponse.Merge(m, src)
+func (m *IndexEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexEntry.Merge(m, src)
 }
-func (m *SplitPartResponse) XXX_Size() int {
+func (m *IndexEntry) XXX_Size() int {
 	return m.Size()
 }
-func (m *SplitPartResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SplitPartResponse.DiscardUnknown(m)
+func (m *IndexEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexEntry.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SplitPartResponse proto.InternalMessageInfo
+var xxx_messageInfo_IndexEntry proto.InternalMessageInfo
 
-type CompactOp struct {
+func (m *IndexEntry) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
 }
 
-func (m *CompactOp) Reset()         { *m = CompactOp{} }
-func (m *CompactOp) String() string { return proto.CompactTextString(m) }
-func (*CompactOp) ProtoMessage()    {}
-func (*CompactOp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e3c719c85d382a4, []int{24}
+func (m *IndexEntry) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
 }
-func (m *CompactOp) XXX_Unmarshal(b []byte) error {
+
+type IndexRangeResponse struct {
+	Truncated bool          `protobuf:"varint,1,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	Entries   []*IndexEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (m *IndexRangeResponse) Reset()         { *m = IndexRangeResponse{} }
+func (m *IndexRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*IndexRangeResponse) ProtoMessage()    {}
+func (*IndexRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{29}
+}
+func (m *IndexRangeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CompactOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *IndexRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CompactOp.Marshal(b, m, deterministic)
+		return xxx_messageInfo_IndexRangeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1456,33 +2055,51 @@ func (m *CompactOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *CompactOp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CompactOp.Merge(m, src)
+func (m *IndexRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexRangeResponse.Merge(m, src)
 }
-func (m *CompactOp) XXX_Size() int {
+func (m *IndexRangeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *CompactOp) XXX_DiscardUnknown() {
-	xxx_messageInfo_CompactOp.DiscardUnknown(m)
+func (m *IndexRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexRangeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CompactOp proto.InternalMessageInfo
+var xxx_messageInfo_IndexRangeResponse proto.InternalMessageInfo
 
-type AutoGCOp struct {
+func (m *IndexRangeResponse) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
+	}
+	return false
 }
 
-func (m *AutoGCOp) Reset()         { *m = AutoGCOp{} }
-func (m *AutoGCOp) String() string { return proto.CompactTextString(m) }
-func (*AutoGCOp) ProtoMessage()    {}
-func (*AutoGCOp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e3c719c85d382a4, []int{25}
+func (m *IndexRangeResponse) GetEntries() []*IndexEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
 }
-func (m *AutoGCOp) XXX_Unmarshal(b []byte) error {
+
+type WatchRequest struct {
+	Prefix  []byte `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	FromSeq uint64 `protobuf:"varint,2,opt,name=fromSeq,proto3" json:"fromSeq,omitempty"`
+	FromNow bool   `protobuf:"varint,3,opt,name=fromNow,proto3" json:"fromNow,omitempty"`
+	Partid  uint64 `protobuf:"varint,4,opt,name=partid,proto3" json:"partid,omitempty"`
+}
+
+func (m *WatchRequest) Reset()         { *m = WatchRequest{} }
+func (m *WatchRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchRequest) ProtoMessage()    {}
+func (*WatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{30}
+}
+func (m *WatchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AutoGCOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *WatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AutoGCOp.Marshal(b, m, deterministic)
+		return xxx_messageInfo_WatchRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1492,34 +2109,68 @@ func (m *AutoGCOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *AutoGCOp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AutoGCOp.Merge(m, src)
+func (m *WatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchRequest.Merge(m, src)
 }
-func (m *AutoGCOp) XXX_Size() int {
+func (m *WatchRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *AutoGCOp) XXX_DiscardUnknown() {
-	xxx_messageInfo_AutoGCOp.DiscardUnknown(m)
+func (m *WatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AutoGCOp proto.InternalMessageInfo
+var xxx_messageInfo_WatchRequest proto.InternalMessageInfo
 
-type ForceGCOp struct {
-	ExIDs []uint64 `protobuf:"varint,1,rep,packed,name=exIDs,proto3" json:"exIDs,omitempty"`
+func (m *WatchRequest) GetPrefix() []byte {
+	if m != nil {
+		return m.Prefix
+	}
+	return nil
 }
 
-func (m *ForceGCOp) Reset()         { *m = ForceGCOp{} }
-func (m *ForceGCOp) String() string { return proto.CompactTextString(m) }
-func (*ForceGCOp) ProtoMessage()    {}
-func (*ForceGCOp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e3c719c85d382a4, []int{26}
+func (m *WatchRequest) GetFromSeq() uint64 {
+	if m != nil {
+		return m.FromSeq
+	}
+	return 0
 }
-func (m *ForceGCOp) XXX_Unmarshal(b []byte) error {
+
+func (m *WatchRequest) GetFromNow() bool {
+	if m != nil {
+		return m.FromNow
+	}
+	return false
+}
+
+func (m *WatchRequest) GetPartid() uint64 {
+	if m != nil {
+		return m.Partid
+	}
+	return 0
+}
+
+type WatchEvent struct {
+	Type          WatchEvent_EventType `protobuf:"varint,1,opt,name=type,proto3,enum=pspb.WatchEvent_EventType" json:"type,omitempty"`
+	Key           []byte               `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Value         []byte               `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	ExpiresAt     uint64               `protobuf:"varint,4,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	Seq           uint64               `protobuf:"varint,5,opt,name=seq,proto3" json:"seq,omitempty"`
+	OriginCluster uint64               `protobuf:"varint,6,opt,name=originCluster,proto3" json:"originCluster,omitempty"`
+	OriginSeq     uint64               `protobuf:"varint,7,opt,name=originSeq,proto3" json:"originSeq,omitempty"`
+}
+
+func (m *WatchEvent) Reset()         { *m = WatchEvent{} }
+func (m *WatchEvent) String() string { return proto.CompactTextString(m) }
+func (*WatchEvent) ProtoMessage()    {}
+func (*WatchEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{31}
+}
+func (m *WatchEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ForceGCOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *WatchEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ForceGCOp.Marshal(b, m, deterministic)
+		return xxx_messageInfo_WatchEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1529,46 +2180,85 @@ func (m *ForceGCOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *ForceGCOp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ForceGCOp.Merge(m, src)
+func (m *WatchEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchEvent.Merge(m, src)
 }
-func (m *ForceGCOp) XXX_Size() int {
+func (m *WatchEvent) XXX_Size() int {
 	return m.Size()
 }
-func (m *ForceGCOp) XXX_DiscardUnknown() {
-	xxx_messageInfo_ForceGCOp.DiscardUnknown(m)
+func (m *WatchEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ForceGCOp proto.InternalMessageInfo
+var xxx_messageInfo_WatchEvent proto.InternalMessageInfo
 
-func (m *ForceGCOp) GetExIDs() []uint64 {
+func (m *WatchEvent) GetType() WatchEvent_EventType {
 	if m != nil {
-		return m.ExIDs
+		return m.Type
+	}
+	return WatchEvent_PUT
+}
+
+func (m *WatchEvent) GetKey() []byte {
+	if m != nil {
+		return m.Key
 	}
 	return nil
 }
 
-type MaintenanceRequest struct {
-	Partid uint64 `protobuf:"varint,1,opt,name=partid,proto3" json:"partid,omitempty"`
-	// Types that are valid to be assigned to OP:
-	//	*MaintenanceRequest_Compact
-	//	*MaintenanceRequest_Autogc
-	//	*MaintenanceRequest_Forcegc
-	OP isMaintenanceRequest_OP `protobuf_oneof:"OP"`
+func (m *WatchEvent) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
 }
 
-func (m *MaintenanceRequest) Reset()         { *m = MaintenanceRequest{} }
-func (m *MaintenanceRequest) String() string { return proto.CompactTextString(m) }
-func (*MaintenanceRequest) ProtoMessage()    {}
-func (*MaintenanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e3c719c85d382a4, []int{27}
+func (m *WatchEvent) GetExpiresAt() uint64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
 }
-func (m *MaintenanceRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *WatchEvent) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *WatchEvent) GetOriginCluster() uint64 {
+	if m != nil {
+		return m.OriginCluster
+	}
+	return 0
+}
+
+func (m *WatchEvent) GetOriginSeq() uint64 {
+	if m != nil {
+		return m.OriginSeq
+	}
+	return 0
+}
+
+type WatchResponse struct {
+	Events     []*WatchEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Checkpoint uint64        `protobuf:"varint,2,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	Rg         *Range        `protobuf:"bytes,3,opt,name=rg,proto3" json:"rg,omitempty"`
+}
+
+func (m *WatchResponse) Reset()         { *m = WatchResponse{} }
+func (m *WatchResponse) String() string { return proto.CompactTextString(m) }
+func (*WatchResponse) ProtoMessage()    {}
+func (*WatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{32}
+}
+func (m *WatchResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MaintenanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *WatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MaintenanceRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_WatchResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1578,97 +2268,126 @@ func (m *MaintenanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MaintenanceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MaintenanceRequest.Merge(m, src)
+func (m *WatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchResponse.Merge(m, src)
 }
-func (m *MaintenanceRequest) XXX_Size() int {
+func (m *WatchResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MaintenanceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MaintenanceRequest.DiscardUnknown(m)
+func (m *WatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MaintenanceRequest proto.InternalMessageInfo
+var xxx_messageInfo_WatchResponse proto.InternalMessageInfo
 
-type isMaintenanceRequest_OP interface {
-	isMaintenanceRequest_OP()
-	MarshalTo([]byte) (int, error)
-	Size() int
+func (m *WatchResponse) GetEvents() []*WatchEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
 }
 
-type MaintenanceRequest_Compact struct {
-	Compact *CompactOp `protobuf:"bytes,2,opt,name=compact,proto3,oneof" json:"compact,omitempty"`
-}
-type MaintenanceRequest_Autogc struct {
-	Autogc *AutoGCOp `protobuf:"bytes,3,opt,name=autogc,proto3,oneof" json:"autogc,omitempty"`
-}
-type MaintenanceRequest_Forcegc struct {
-	Forcegc *ForceGCOp `protobuf:"bytes,4,opt,name=forcegc,proto3,oneof" json:"forcegc,omitempty"`
+func (m *WatchResponse) GetCheckpoint() uint64 {
+	if m != nil {
+		return m.Checkpoint
+	}
+	return 0
 }
 
-func (*MaintenanceRequest_Compact) isMaintenanceRequest_OP() {}
-func (*MaintenanceRequest_Autogc) isMaintenanceRequest_OP()  {}
-func (*MaintenanceRequest_Forcegc) isMaintenanceRequest_OP() {}
-
-func (m *MaintenanceRequest) GetOP() isMaintenanceRequest_OP {
+func (m *WatchResponse) GetRg() *Range {
 	if m != nil {
-		return m.OP
+		return m.Rg
 	}
 	return nil
 }
 
-func (m *MaintenanceRequest) GetPartid() uint64 {
-	if m != nil {
-		return m.Partid
+type TxnRecord struct {
+	TxnID    string    `protobuf:"bytes,1,opt,name=txnID,proto3" json:"txnID,omitempty"`
+	Status   TxnStatus `protobuf:"varint,2,opt,name=status,proto3,enum=pspb.TxnStatus" json:"status,omitempty"`
+	Deadline int64     `protobuf:"varint,3,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	Keys     [][]byte  `protobuf:"bytes,4,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (m *TxnRecord) Reset()         { *m = TxnRecord{} }
+func (m *TxnRecord) String() string { return proto.CompactTextString(m) }
+func (*TxnRecord) ProtoMessage()    {}
+func (*TxnRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{33}
+}
+func (m *TxnRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxnRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxnRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *TxnRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxnRecord.Merge(m, src)
+}
+func (m *TxnRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxnRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxnRecord.DiscardUnknown(m)
 }
 
-func (m *MaintenanceRequest) GetCompact() *CompactOp {
-	if x, ok := m.GetOP().(*MaintenanceRequest_Compact); ok {
-		return x.Compact
+var xxx_messageInfo_TxnRecord proto.InternalMessageInfo
+
+func (m *TxnRecord) GetTxnID() string {
+	if m != nil {
+		return m.TxnID
 	}
-	return nil
+	return ""
 }
 
-func (m *MaintenanceRequest) GetAutogc() *AutoGCOp {
-	if x, ok := m.GetOP().(*MaintenanceRequest_Autogc); ok {
-		return x.Autogc
+func (m *TxnRecord) GetStatus() TxnStatus {
+	if m != nil {
+		return m.Status
 	}
-	return nil
+	return TxnStatus_PENDING
 }
 
-func (m *MaintenanceRequest) GetForcegc() *ForceGCOp {
-	if x, ok := m.GetOP().(*MaintenanceRequest_Forcegc); ok {
-		return x.Forcegc
+func (m *TxnRecord) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
 	}
-	return nil
+	return 0
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*MaintenanceRequest) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*MaintenanceRequest_Compact)(nil),
-		(*MaintenanceRequest_Autogc)(nil),
-		(*MaintenanceRequest_Forcegc)(nil),
+func (m *TxnRecord) GetKeys() [][]byte {
+	if m != nil {
+		return m.Keys
 	}
+	return nil
 }
 
-type MaintenanceResponse struct {
+//value of key whose latest version is a txn intent
+type TxnIntent struct {
+	TxnID  string `protobuf:"bytes,1,opt,name=txnID,proto3" json:"txnID,omitempty"`
+	Delete bool   `protobuf:"varint,2,opt,name=delete,proto3" json:"delete,omitempty"`
+	Value  []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (m *MaintenanceResponse) Reset()         { *m = MaintenanceResponse{} }
-func (m *MaintenanceResponse) String() string { return proto.CompactTextString(m) }
-func (*MaintenanceResponse) ProtoMessage()    {}
-func (*MaintenanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e3c719c85d382a4, []int{28}
+func (m *TxnIntent) Reset()         { *m = TxnIntent{} }
+func (m *TxnIntent) String() string { return proto.CompactTextString(m) }
+func (*TxnIntent) ProtoMessage()    {}
+func (*TxnIntent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{34}
 }
-func (m *MaintenanceResponse) XXX_Unmarshal(b []byte) error {
+func (m *TxnIntent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MaintenanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TxnIntent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MaintenanceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TxnIntent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1678,35 +2397,60 @@ func (m *MaintenanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MaintenanceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MaintenanceResponse.Merge(m, src)
+func (m *TxnIntent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxnIntent.Merge(m, src)
 }
-func (m *MaintenanceResponse) XXX_Size() int {
+func (m *TxnIntent) XXX_Size() int {
 	return m.Size()
 }
-func (m *MaintenanceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MaintenanceResponse.DiscardUnknown(m)
+func (m *TxnIntent) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxnIntent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MaintenanceResponse proto.InternalMessageInfo
+var xxx_messageInfo_TxnIntent proto.InternalMessageInfo
 
-type HeadRequest struct {
-	Key    []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Partid uint64 `protobuf:"varint,2,opt,name=partid,proto3" json:"partid,omitempty"`
+func (m *TxnIntent) GetTxnID() string {
+	if m != nil {
+		return m.TxnID
+	}
+	return ""
 }
 
-func (m *HeadRequest) Reset()         { *m = HeadRequest{} }
-func (m *HeadRequest) String() string { return proto.CompactTextString(m) }
-func (*HeadRequest) ProtoMessage()    {}
-func (*HeadRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e3c719c85d382a4, []int{29}
+func (m *TxnIntent) GetDelete() bool {
+	if m != nil {
+		return m.Delete
+	}
+	return false
 }
-func (m *HeadRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *TxnIntent) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+type TxnMutation struct {
+	Key          []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value        []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Delete       bool   `protobuf:"varint,3,opt,name=delete,proto3" json:"delete,omitempty"`
+	CheckVersion bool   `protobuf:"varint,4,opt,name=checkVersion,proto3" json:"checkVersion,omitempty"`
+	Version      uint64 `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	CheckOnly    bool   `protobuf:"varint,6,opt,name=checkOnly,proto3" json:"checkOnly,omitempty"`
+}
+
+func (m *TxnMutation) Reset()         { *m = TxnMutation{} }
+func (m *TxnMutation) String() string { return proto.CompactTextString(m) }
+func (*TxnMutation) ProtoMessage()    {}
+func (*TxnMutation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{35}
+}
+func (m *TxnMutation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *HeadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TxnMutation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_HeadRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TxnMutation.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1716,48 +2460,78 @@ func (m *HeadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *HeadRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HeadRequest.Merge(m, src)
+func (m *TxnMutation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxnMutation.Merge(m, src)
 }
-func (m *HeadRequest) XXX_Size() int {
+func (m *TxnMutation) XXX_Size() int {
 	return m.Size()
 }
-func (m *HeadRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_HeadRequest.DiscardUnknown(m)
+func (m *TxnMutation) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxnMutation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_HeadRequest proto.InternalMessageInfo
+var xxx_messageInfo_TxnMutation proto.InternalMessageInfo
 
-func (m *HeadRequest) GetKey() []byte {
+func (m *TxnMutation) GetKey() []byte {
 	if m != nil {
 		return m.Key
 	}
 	return nil
 }
 
-func (m *HeadRequest) GetPartid() uint64 {
+func (m *TxnMutation) GetValue() []byte {
 	if m != nil {
-		return m.Partid
+		return m.Value
+	}
+	return nil
+}
+
+func (m *TxnMutation) GetDelete() bool {
+	if m != nil {
+		return m.Delete
+	}
+	return false
+}
+
+func (m *TxnMutation) GetCheckVersion() bool {
+	if m != nil {
+		return m.CheckVersion
+	}
+	return false
+}
+
+func (m *TxnMutation) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
 	}
 	return 0
 }
 
-type HeadResponse struct {
-	Info *HeadInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+func (m *TxnMutation) GetCheckOnly() bool {
+	if m != nil {
+		return m.CheckOnly
+	}
+	return false
 }
 
-func (m *HeadResponse) Reset()         { *m = HeadResponse{} }
-func (m *HeadResponse) String() string { return proto.CompactTextString(m) }
-func (*HeadResponse) ProtoMessage()    {}
-func (*HeadResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e3c719c85d382a4, []int{30}
+type TxnPrepareRequest struct {
+	TxnID     string         `protobuf:"bytes,1,opt,name=txnID,proto3" json:"txnID,omitempty"`
+	Mutations []*TxnMutation `protobuf:"bytes,2,rep,name=mutations,proto3" json:"mutations,omitempty"`
+	Partid    uint64         `protobuf:"varint,3,opt,name=partid,proto3" json:"partid,omitempty"`
 }
-func (m *HeadResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *TxnPrepareRequest) Reset()         { *m = TxnPrepareRequest{} }
+func (m *TxnPrepareRequest) String() string { return proto.CompactTextString(m) }
+func (*TxnPrepareRequest) ProtoMessage()    {}
+func (*TxnPrepareRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{36}
+}
+func (m *TxnPrepareRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *HeadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TxnPrepareRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_HeadResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TxnPrepareRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1767,42 +2541,54 @@ func (m *HeadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *HeadResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HeadResponse.Merge(m, src)
+func (m *TxnPrepareRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxnPrepareRequest.Merge(m, src)
 }
-func (m *HeadResponse) XXX_Size() int {
+func (m *TxnPrepareRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *HeadResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_HeadResponse.DiscardUnknown(m)
+func (m *TxnPrepareRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxnPrepareRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_HeadResponse proto.InternalMessageInfo
+var xxx_messageInfo_TxnPrepareRequest proto.InternalMessageInfo
 
-func (m *HeadResponse) GetInfo() *HeadInfo {
+func (m *TxnPrepareRequest) GetTxnID() string {
 	if m != nil {
-		return m.Info
+		return m.TxnID
+	}
+	return ""
+}
+
+func (m *TxnPrepareRequest) GetMutations() []*TxnMutation {
+	if m != nil {
+		return m.Mutations
 	}
 	return nil
 }
 
-type HeadInfo struct {
-	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Len uint32 `protobuf:"varint,3,opt,name=len,proto3" json:"len,omitempty"`
+func (m *TxnPrepareRequest) GetPartid() uint64 {
+	if m != nil {
+		return m.Partid
+	}
+	return 0
 }
 
-func (m *HeadInfo) Reset()         { *m = HeadInfo{} }
-func (m *HeadInfo) String() string { return proto.CompactTextString(m) }
-func (*HeadInfo) ProtoMessage()    {}
-func (*HeadInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e3c719c85d382a4, []int{31}
+type TxnPrepareResponse struct {
 }
-func (m *HeadInfo) XXX_Unmarshal(b []byte) error {
+
+func (m *TxnPrepareResponse) Reset()         { *m = TxnPrepareResponse{} }
+func (m *TxnPrepareResponse) String() string { return proto.CompactTextString(m) }
+func (*TxnPrepareResponse) ProtoMessage()    {}
+func (*TxnPrepareResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{37}
+}
+func (m *TxnPrepareResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *HeadInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TxnPrepareResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_HeadInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TxnPrepareResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1812,51 +2598,37 @@ func (m *HeadInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *HeadInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HeadInfo.Merge(m, src)
+func (m *TxnPrepareResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxnPrepareResponse.Merge(m, src)
 }
-func (m *HeadInfo) XXX_Size() int {
+func (m *TxnPrepareResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *HeadInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_HeadInfo.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_HeadInfo proto.InternalMessageInfo
-
-func (m *HeadInfo) GetKey() []byte {
-	if m != nil {
-		return m.Key
-	}
-	return nil
+func (m *TxnPrepareResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxnPrepareResponse.DiscardUnknown(m)
 }
 
-func (m *HeadInfo) GetLen() uint32 {
-	if m != nil {
-		return m.Len
-	}
-	return 0
-}
+var xxx_messageInfo_TxnPrepareResponse proto.InternalMessageInfo
 
-type StreamPutRequestHeader struct {
-	Key        []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	LenOfValue uint32 `protobuf:"varint,2,opt,name=lenOfValue,proto3" json:"lenOfValue,omitempty"`
-	ExpiresAt  uint64 `protobuf:"varint,3,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
-	Partid     uint64 `protobuf:"varint,4,opt,name=partid,proto3" json:"partid,omitempty"`
+type TxnResolveRequest struct {
+	TxnID  string   `protobuf:"bytes,1,opt,name=txnID,proto3" json:"txnID,omitempty"`
+	Keys   [][]byte `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	Commit bool     `protobuf:"varint,3,opt,name=commit,proto3" json:"commit,omitempty"`
+	Partid uint64   `protobuf:"varint,4,opt,name=partid,proto3" json:"partid,omitempty"`
 }
 
-func (m *StreamPutRequestHeader) Reset()         { *m = StreamPutRequestHeader{} }
-func (m *StreamPutRequestHeader) String() string { return proto.CompactTextString(m) }
-func (*StreamPutRequestHeader) ProtoMessage()    {}
-func (*StreamPutRequestHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e3c719c85d382a4, []int{32}
+func (m *TxnResolveRequest) Reset()         { *m = TxnResolveRequest{} }
+func (m *TxnResolveRequest) String() string { return proto.CompactTextString(m) }
+func (*TxnResolveRequest) ProtoMessage()    {}
+func (*TxnResolveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{38}
 }
-func (m *StreamPutRequestHeader) XXX_Unmarshal(b []byte) error {
+func (m *TxnResolveRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StreamPutRequestHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TxnResolveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_StreamPutRequestHeader.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TxnResolveRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1866,65 +2638,61 @@ func (m *StreamPutRequestHeader) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *StreamPutRequestHeader) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StreamPutRequestHeader.Merge(m, src)
+func (m *TxnResolveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxnResolveRequest.Merge(m, src)
 }
-func (m *StreamPutRequestHeader) XXX_Size() int {
+func (m *TxnResolveRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *StreamPutRequestHeader) XXX_DiscardUnknown() {
-	xxx_messageInfo_StreamPutRequestHeader.DiscardUnknown(m)
+func (m *TxnResolveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxnResolveRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StreamPutRequestHeader proto.InternalMessageInfo
+var xxx_messageInfo_TxnResolveRequest proto.InternalMessageInfo
 
-func (m *StreamPutRequestHeader) GetKey() []byte {
+func (m *TxnResolveRequest) GetTxnID() string {
 	if m != nil {
-		return m.Key
+		return m.TxnID
 	}
-	return nil
+	return ""
 }
 
-func (m *StreamPutRequestHeader) GetLenOfValue() uint32 {
+func (m *TxnResolveRequest) GetKeys() [][]byte {
 	if m != nil {
-		return m.LenOfValue
+		return m.Keys
 	}
-	return 0
+	return nil
 }
 
-func (m *StreamPutRequestHeader) GetExpiresAt() uint64 {
+func (m *TxnResolveRequest) GetCommit() bool {
 	if m != nil {
-		return m.ExpiresAt
+		return m.Commit
 	}
-	return 0
+	return false
 }
 
-func (m *StreamPutRequestHeader) GetPartid() uint64 {
+func (m *TxnResolveRequest) GetPartid() uint64 {
 	if m != nil {
 		return m.Partid
 	}
 	return 0
 }
 
-type StreamPutRequest struct {
-	// Types that are valid to be assigned to Data:
-	//	*StreamPutRequest_Header
-	//	*StreamPutRequest_Payload
-	Data isStreamPutRequest_Data `protobuf_oneof:"data"`
+type TxnResolveResponse struct {
 }
 
-func (m *StreamPutRequest) Reset()         { *m = StreamPutRequest{} }
-func (m *StreamPutRequest) String() string { return proto.CompactTextString(m) }
-func (*StreamPutRequest) ProtoMessage()    {}
-func (*StreamPutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e3c719c85d382a4, []int{33}
+func (m *TxnResolveResponse) Reset()         { *m = TxnResolveResponse{} }
+func (m *TxnResolveResponse) String() string { return proto.CompactTextString(m) }
+func (*TxnResolveResponse) ProtoMessage()    {}
+func (*TxnResolveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{39}
 }
-func (m *StreamPutRequest) XXX_Unmarshal(b []byte) error {
+func (m *TxnResolveResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StreamPutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TxnResolveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_StreamPutRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TxnResolveResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1934,2831 +2702,11953 @@ func (m *StreamPutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *StreamPutRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StreamPutRequest.Merge(m, src)
+func (m *TxnResolveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxnResolveResponse.Merge(m, src)
 }
-func (m *StreamPutRequest) XXX_Size() int {
+func (m *TxnResolveResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *StreamPutRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_StreamPutRequest.DiscardUnknown(m)
+func (m *TxnResolveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxnResolveResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StreamPutRequest proto.InternalMessageInfo
+var xxx_messageInfo_TxnResolveResponse proto.InternalMessageInfo
 
-type isStreamPutRequest_Data interface {
-	isStreamPutRequest_Data()
-	MarshalTo([]byte) (int, error)
-	Size() int
+type ReplicateOp struct {
+	Key           []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	ExpiresAt     uint64 `protobuf:"varint,3,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	Deleted       bool   `protobuf:"varint,4,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	OriginCluster uint64 `protobuf:"varint,5,opt,name=originCluster,proto3" json:"originCluster,omitempty"`
+	OriginSeq     uint64 `protobuf:"varint,6,opt,name=originSeq,proto3" json:"originSeq,omitempty"`
 }
 
-type StreamPutRequest_Header struct {
-	Header *StreamPutRequestHeader `protobuf:"bytes,1,opt,name=header,proto3,oneof" json:"header,omitempty"`
+func (m *ReplicateOp) Reset()         { *m = ReplicateOp{} }
+func (m *ReplicateOp) String() string { return proto.CompactTextString(m) }
+func (*ReplicateOp) ProtoMessage()    {}
+func (*ReplicateOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{40}
 }
-type StreamPutRequest_Payload struct {
-	Payload []byte `protobuf:"bytes,2,opt,name=payload,proto3,oneof" json:"payload,omitempty"`
+func (m *ReplicateOp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplicateOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplicateOp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReplicateOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicateOp.Merge(m, src)
+}
+func (m *ReplicateOp) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplicateOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicateOp.DiscardUnknown(m)
 }
 
-func (*StreamPutRequest_Header) isStreamPutRequest_Data()  {}
-func (*StreamPutRequest_Payload) isStreamPutRequest_Data() {}
+var xxx_messageInfo_ReplicateOp proto.InternalMessageInfo
 
-func (m *StreamPutRequest) GetData() isStreamPutRequest_Data {
+func (m *ReplicateOp) GetKey() []byte {
 	if m != nil {
-		return m.Data
+		return m.Key
 	}
 	return nil
 }
 
-func (m *StreamPutRequest) GetHeader() *StreamPutRequestHeader {
-	if x, ok := m.GetData().(*StreamPutRequest_Header); ok {
-		return x.Header
+func (m *ReplicateOp) GetValue() []byte {
+	if m != nil {
+		return m.Value
 	}
 	return nil
 }
 
-func (m *StreamPutRequest) GetPayload() []byte {
-	if x, ok := m.GetData().(*StreamPutRequest_Payload); ok {
-		return x.Payload
+func (m *ReplicateOp) GetExpiresAt() uint64 {
+	if m != nil {
+		return m.ExpiresAt
 	}
-	return nil
+	return 0
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*StreamPutRequest) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*StreamPutRequest_Header)(nil),
-		(*StreamPutRequest_Payload)(nil),
+func (m *ReplicateOp) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
 	}
+	return false
 }
 
-func init() {
-	proto.RegisterType((*RegionInfo)(nil), "pspb.RegionInfo")
-	proto.RegisterType((*Regions)(nil), "pspb.Regions")
-	proto.RegisterMapType((map[uint64]*RegionInfo)(nil), "pspb.Regions.RegionsEntry")
-	proto.RegisterType((*Range)(nil), "pspb.Range")
-	proto.RegisterType((*Location)(nil), "pspb.Location")
-	proto.RegisterType((*TableLocations)(nil), "pspb.TableLocations")
-	proto.RegisterType((*PartitionMeta)(nil), "pspb.PartitionMeta")
-	proto.RegisterType((*PSDetail)(nil), "pspb.PSDetail")
-	proto.RegisterType((*BlockMeta)(nil), "pspb.BlockMeta")
-	proto.RegisterMapType((map[uint64]int64)(nil), "pspb.BlockMeta.DiscardsEntry")
-	proto.RegisterType((*BlockOffset)(nil), "pspb.BlockOffset")
-	proto.RegisterType((*TableIndex)(nil), "pspb.TableIndex")
-	proto.RegisterType((*PutRequest)(nil), "pspb.PutRequest")
-	proto.RegisterType((*PutResponse)(nil), "pspb.PutResponse")
-	proto.RegisterType((*DeleteRequest)(nil), "pspb.DeleteRequest")
-	proto.RegisterType((*DeleteResponse)(nil), "pspb.DeleteResponse")
-	proto.RegisterType((*GetRequest)(nil), "pspb.GetRequest")
-	proto.RegisterType((*GetResponse)(nil), "pspb.GetResponse")
-	proto.RegisterType((*RequestOp)(nil), "pspb.RequestOp")
-	proto.RegisterType((*ResponseOp)(nil), "pspb.ResponseOp")
-	proto.RegisterType((*BatchRequest)(nil), "pspb.BatchRequest")
-	proto.RegisterType((*BatchResponse)(nil), "pspb.BatchResponse")
-	proto.RegisterType((*RangeRequest)(nil), "pspb.RangeRequest")
-	proto.RegisterType((*RangeResponse)(nil), "pspb.RangeResponse")
-	proto.RegisterType((*SplitPartRequest)(nil), "pspb.SplitPartRequest")
-	proto.RegisterType((*SplitPartResponse)(nil), "pspb.SplitPartResponse")
-	proto.RegisterType((*CompactOp)(nil), "pspb.CompactOp")
-	proto.RegisterType((*AutoGCOp)(nil), "pspb.AutoGCOp")
-	proto.RegisterType((*ForceGCOp)(nil), "pspb.ForceGCOp")
-	proto.RegisterType((*MaintenanceRequest)(nil), "pspb.MaintenanceRequest")
-	proto.RegisterType((*MaintenanceResponse)(nil), "pspb.MaintenanceResponse")
-	proto.RegisterType((*HeadRequest)(nil), "pspb.HeadRequest")
-	proto.RegisterType((*HeadResponse)(nil), "pspb.HeadResponse")
-	proto.RegisterType((*HeadInfo)(nil), "pspb.HeadInfo")
-	proto.RegisterType((*StreamPutRequestHeader)(nil), "pspb.StreamPutRequestHeader")
-	proto.RegisterType((*StreamPutRequest)(nil), "pspb.StreamPutRequest")
+func (m *ReplicateOp) GetOriginCluster() uint64 {
+	if m != nil {
+		return m.OriginCluster
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("pspb.proto", fileDescriptor_3e3c719c85d382a4) }
-
-var fileDescriptor_3e3c719c85d382a4 = []byte{
-	// 1472 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x4b, 0x6f, 0xdb, 0xc6,
-	0x16, 0x16, 0x25, 0xd9, 0x92, 0x0e, 0x25, 0xd9, 0x1e, 0x27, 0xb9, 0xba, 0xba, 0xbe, 0xba, 0xce,
-	0xe0, 0x22, 0x10, 0xd2, 0xc0, 0x6a, 0x94, 0x47, 0xf3, 0x28, 0x12, 0xc4, 0x51, 0x62, 0x1b, 0x49,
-	0x2a, 0x63, 0x9c, 0x64, 0xd1, 0x4d, 0x31, 0x96, 0x46, 0x32, 0x13, 0x8a, 0x64, 0xc8, 0xa1, 0x6b,
-	0x77, 0x55, 0x74, 0xd3, 0x55, 0x81, 0x02, 0xfd, 0x03, 0x05, 0xfa, 0x1f, 0xda, 0x5d, 0xd7, 0xed,
-	0x2e, 0x40, 0x37, 0x5d, 0x16, 0x49, 0x7f, 0x48, 0x31, 0x2f, 0x8a, 0xb4, 0xe4, 0x06, 0xe9, 0x4a,
-	0x73, 0xde, 0x8f, 0xef, 0xcc, 0x19, 0x0a, 0x20, 0x88, 0x82, 0xfd, 0x8d, 0x20, 0xf4, 0xb9, 0x8f,
-	0x8a, 0xe2, 0xdc, 0x5c, 0x1b, 0xfb, 0xfe, 0xd8, 0x65, 0x1d, 0x1a, 0x38, 0x1d, 0xea, 0x79, 0x3e,
-	0xa7, 0xdc, 0xf1, 0xbd, 0x48, 0xe9, 0xe0, 0x67, 0x00, 0x84, 0x8d, 0x1d, 0xdf, 0xdb, 0xf1, 0x46,
-	0x3e, 0xfa, 0x0f, 0xe4, 0xc3, 0x71, 0xc3, 0x5a, 0xb7, 0xda, 0x76, 0xd7, 0xde, 0x90, 0xae, 0x08,
-	0xf5, 0xc6, 0x8c, 0xe4, 0xc3, 0x31, 0x3a, 0x07, 0x8b, 0xbb, 0x34, 0xe4, 0x3b, 0xbd, 0x46, 0x7e,
-	0xdd, 0x6a, 0x17, 0x89, 0xa6, 0x10, 0x82, 0xe2, 0xee, 0xde, 0x4e, 0xaf, 0x51, 0x90, 0x5c, 0x79,
-	0xc6, 0xdf, 0x58, 0x50, 0x52, 0x7e, 0x23, 0x74, 0x15, 0x4a, 0xa1, 0x3a, 0x36, 0xac, 0xf5, 0x42,
-	0xdb, 0xee, 0x36, 0xb5, 0x67, 0xc5, 0x34, 0xbf, 0x0f, 0x3c, 0x1e, 0x1e, 0x13, 0xa3, 0xda, 0x7c,
-	0x0c, 0xd5, 0xb4, 0x00, 0x2d, 0x43, 0xe1, 0x25, 0x3b, 0x96, 0xb9, 0x15, 0x89, 0x38, 0xa2, 0x0b,
-	0xb0, 0x70, 0x48, 0xdd, 0x98, 0xc9, 0x74, 0xec, 0xee, 0x72, 0xda, 0xab, 0xa8, 0x86, 0x28, 0xf1,
-	0xad, 0xfc, 0x0d, 0x0b, 0xdf, 0x86, 0x05, 0x59, 0x08, 0x6a, 0x42, 0x39, 0xe2, 0x34, 0xe4, 0x8f,
-	0xb4, 0xaf, 0x2a, 0x49, 0x68, 0x51, 0x20, 0xf3, 0x86, 0x42, 0x92, 0x97, 0x12, 0x4d, 0xe1, 0x3b,
-	0x50, 0x7e, 0xec, 0x0f, 0x64, 0xdb, 0x84, 0x3d, 0x3b, 0xe2, 0xcc, 0x13, 0x6d, 0x50, 0xb9, 0x24,
-	0xb4, 0xb0, 0xf7, 0x47, 0xa3, 0x88, 0x71, 0x69, 0x5f, 0x23, 0x9a, 0xc2, 0x57, 0xa1, 0xfe, 0x94,
-	0xee, 0xbb, 0xcc, 0x38, 0x89, 0x10, 0x86, 0xa2, 0xeb, 0x0f, 0x4c, 0x3f, 0xea, 0x2a, 0x73, 0x23,
-	0x26, 0x52, 0x86, 0xbf, 0xb7, 0xa0, 0x26, 0x3a, 0xec, 0x08, 0xde, 0x13, 0xc6, 0x29, 0x5a, 0x83,
-	0x8a, 0xeb, 0x8f, 0xf7, 0x78, 0xc8, 0xe8, 0x44, 0x63, 0x30, 0x65, 0x08, 0x69, 0xe8, 0x7f, 0xae,
-	0xa5, 0x0a, 0x8b, 0x29, 0x43, 0x23, 0x5b, 0x7a, 0x17, 0xb2, 0xe5, 0x0c, 0xb2, 0x2d, 0x80, 0x09,
-	0xe3, 0x54, 0xfb, 0xac, 0x48, 0x59, 0x8a, 0x83, 0x6f, 0x40, 0x79, 0x77, 0xaf, 0xc7, 0x38, 0x75,
-	0xdc, 0x64, 0x0a, 0xac, 0xe9, 0x14, 0xa0, 0x06, 0x94, 0xe8, 0x70, 0x18, 0xb2, 0x28, 0x92, 0xe9,
-	0x56, 0x88, 0x21, 0xf1, 0xd7, 0x05, 0xa8, 0x6c, 0xba, 0xfe, 0xe0, 0xa5, 0x2c, 0xec, 0x43, 0x00,
-	0x2e, 0x1a, 0xb4, 0xe3, 0x0d, 0xd9, 0x91, 0x1e, 0x3f, 0x0d, 0xe7, 0xd3, 0x84, 0x4f, 0x52, 0x3a,
-	0xe8, 0x02, 0xd4, 0xef, 0xfb, 0x93, 0x40, 0xf8, 0x62, 0xc3, 0x3d, 0xe7, 0x0b, 0xa6, 0x5b, 0x7e,
-	0x82, 0x8b, 0x2e, 0xc2, 0xf2, 0x33, 0xef, 0x84, 0x66, 0x41, 0x6a, 0xce, 0xf0, 0x45, 0xb5, 0x87,
-	0xc1, 0x03, 0x03, 0x6e, 0x51, 0x55, 0x3b, 0xe5, 0x08, 0xe8, 0x0f, 0x83, 0xbe, 0x02, 0x78, 0x41,
-	0xfa, 0x48, 0x68, 0xd1, 0xc1, 0x88, 0xbd, 0xfa, 0x24, 0x9e, 0x34, 0x16, 0x55, 0x07, 0x15, 0x85,
-	0x6e, 0x42, 0x79, 0xe8, 0x44, 0x03, 0x1a, 0x0e, 0xa3, 0x46, 0x49, 0x82, 0xfd, 0x5f, 0x55, 0x57,
-	0x52, 0xfc, 0x46, 0x4f, 0xcb, 0xd5, 0xfc, 0x27, 0xea, 0xa8, 0x0d, 0x4b, 0x26, 0x41, 0xc7, 0xf7,
-	0x9e, 0x1e, 0x07, 0x4c, 0xa2, 0x53, 0x23, 0x27, 0xd9, 0xcd, 0xdb, 0x50, 0xcb, 0x38, 0x99, 0x73,
-	0x57, 0xce, 0xa4, 0xef, 0x4a, 0x21, 0x7d, 0x33, 0xf6, 0xc0, 0x96, 0xb9, 0xe8, 0x42, 0x52, 0xa6,
-	0x55, 0x65, 0x9a, 0x9e, 0xf8, 0xfc, 0xa9, 0x13, 0x5f, 0xc8, 0x4c, 0xfc, 0x0f, 0x16, 0xc0, 0x14,
-	0x39, 0xf4, 0x01, 0x94, 0x94, 0xc0, 0x4c, 0xfc, 0x4a, 0xaa, 0x09, 0x2a, 0x30, 0x31, 0x1a, 0x68,
-	0x1d, 0xec, 0x7d, 0xd7, 0xf7, 0x27, 0x0f, 0x1d, 0x97, 0xb3, 0x50, 0x5f, 0xc5, 0x34, 0x0b, 0xfd,
-	0x1f, 0x6a, 0x2c, 0xe2, 0xce, 0x84, 0xf2, 0x14, 0xa2, 0x45, 0x92, 0x65, 0x0a, 0x3f, 0x5e, 0x3c,
-	0xe9, 0x8f, 0x64, 0x90, 0x48, 0xe2, 0x59, 0x23, 0x69, 0x16, 0x7e, 0x01, 0xb0, 0x1b, 0x73, 0xc2,
-	0x5e, 0xc5, 0x2c, 0x9a, 0x57, 0x79, 0xa6, 0x69, 0x55, 0xdd, 0x34, 0x71, 0xcf, 0x1e, 0x1c, 0x05,
-	0x4e, 0xc8, 0xa2, 0x7b, 0xdc, 0xdc, 0xb3, 0x84, 0x21, 0x3a, 0x12, 0x88, 0x4b, 0x3b, 0xd4, 0x03,
-	0xa4, 0x29, 0xfc, 0x3f, 0xb0, 0x65, 0xac, 0x28, 0xf0, 0xbd, 0x88, 0xcd, 0x06, 0xc3, 0x37, 0xa1,
-	0xd6, 0x63, 0x2e, 0xe3, 0xec, 0xf4, 0x7c, 0xa6, 0xbe, 0xf3, 0x19, 0xdf, 0x18, 0xea, 0xc6, 0xf4,
-	0x54, 0xf7, 0xd7, 0x01, 0xb6, 0x18, 0x7f, 0x7f, 0xdf, 0xd7, 0xc0, 0x96, 0x76, 0xa7, 0x39, 0x9e,
-	0xdf, 0x24, 0xfc, 0xb3, 0x05, 0x15, 0x1d, 0xac, 0x1f, 0xa0, 0x2b, 0x60, 0x87, 0x8a, 0xf8, 0x2c,
-	0x88, 0x79, 0xf6, 0x82, 0x4f, 0x11, 0xd8, 0xce, 0x11, 0xd0, 0x6a, 0xbb, 0x31, 0x47, 0x1f, 0x43,
-	0xdd, 0x18, 0x0d, 0x65, 0x75, 0x7a, 0xcf, 0xaf, 0x2a, 0xbb, 0x4c, 0xb3, 0xb6, 0x73, 0xa4, 0xa6,
-	0x95, 0x15, 0x3f, 0x1d, 0x72, 0xac, 0xc7, 0x33, 0x09, 0x39, 0x6d, 0x44, 0x2a, 0xe4, 0x16, 0xe3,
-	0x9b, 0x15, 0xf1, 0x52, 0x49, 0x0a, 0xff, 0x6a, 0x89, 0x87, 0x51, 0x55, 0xdd, 0x0f, 0xd0, 0x75,
-	0xa8, 0x86, 0x9a, 0x4a, 0x95, 0xb0, 0x92, 0x2a, 0x41, 0x09, 0xb7, 0x73, 0xc4, 0x36, 0x8a, 0xa2,
-	0x88, 0xbb, 0xb0, 0x94, 0xd8, 0x65, 0xaa, 0x38, 0x93, 0xad, 0x22, 0xb1, 0xae, 0x1b, 0x75, 0x5d,
-	0x47, 0x3a, 0xf0, 0xb4, 0x90, 0x95, 0x54, 0x21, 0xb3, 0x81, 0x45, 0x29, 0x00, 0x65, 0x43, 0xe2,
-	0xcb, 0x50, 0xdd, 0xa4, 0x7c, 0x70, 0x60, 0xd0, 0x3f, 0x0f, 0x85, 0x90, 0xbd, 0xd2, 0x57, 0x71,
-	0xc9, 0x3c, 0x9b, 0x1a, 0x2c, 0x22, 0x64, 0xb8, 0x0b, 0x35, 0x6d, 0xa2, 0x81, 0x97, 0x36, 0xd1,
-	0xdf, 0xd8, 0x88, 0xeb, 0x54, 0x55, 0x4f, 0x8a, 0x0e, 0x23, 0x46, 0x2a, 0x64, 0x23, 0xe7, 0x48,
-	0x8f, 0x8b, 0xa6, 0xc4, 0xc4, 0xc8, 0x27, 0xd7, 0x4c, 0x8c, 0x24, 0x04, 0xd7, 0x75, 0x26, 0x8e,
-	0xd9, 0x24, 0x8a, 0x38, 0xf5, 0x3a, 0xdd, 0x83, 0x9a, 0x8e, 0xa5, 0xf3, 0x5b, 0x83, 0x0a, 0x0f,
-	0x63, 0x6f, 0x20, 0xae, 0xbf, 0x8c, 0x57, 0x26, 0x53, 0x86, 0x78, 0x9c, 0x5e, 0xb2, 0x63, 0xf1,
-	0x0a, 0x15, 0xda, 0x55, 0x22, 0xcf, 0xf8, 0x22, 0x2c, 0xef, 0x05, 0xae, 0xc3, 0xc5, 0x5b, 0x97,
-	0x4e, 0x59, 0x85, 0xb3, 0x32, 0xe1, 0x56, 0x61, 0x25, 0xa5, 0xab, 0xdb, 0x6a, 0x43, 0x45, 0x6c,
-	0x62, 0x3a, 0xe0, 0xfd, 0x00, 0x03, 0x94, 0xef, 0xc5, 0xdc, 0xdf, 0xba, 0xdf, 0x0f, 0xf0, 0x79,
-	0xa8, 0x3c, 0xf4, 0xc3, 0x01, 0x13, 0x84, 0xa8, 0x8b, 0x1d, 0xed, 0xf4, 0x54, 0xeb, 0x8a, 0x44,
-	0x11, 0xf8, 0x47, 0x0b, 0xd0, 0x13, 0xea, 0x78, 0x9c, 0x79, 0xd4, 0x1b, 0xb0, 0x77, 0xc4, 0x17,
-	0x0b, 0x74, 0xa0, 0x42, 0xe9, 0xf1, 0xd1, 0x08, 0x24, 0xf1, 0xb7, 0x73, 0xc4, 0x68, 0xa0, 0x36,
-	0x2c, 0xd2, 0x98, 0xfb, 0xe3, 0x81, 0x1e, 0x16, 0xfd, 0x79, 0x61, 0xd2, 0xdb, 0xce, 0x11, 0x2d,
-	0x17, 0x6e, 0x47, 0x22, 0xd1, 0xf1, 0x40, 0xb6, 0x37, 0x71, 0x9b, 0x64, 0x2f, 0xdc, 0x6a, 0x8d,
-	0xcd, 0x22, 0xe4, 0xfb, 0xbb, 0xf8, 0x2c, 0xac, 0x66, 0xf2, 0xd6, 0xbd, 0xf8, 0x08, 0xec, 0x6d,
-	0x46, 0x87, 0xef, 0xbf, 0x5f, 0xba, 0x50, 0x55, 0x86, 0x1a, 0x47, 0x0c, 0x45, 0xc7, 0x1b, 0xf9,
-	0xfa, 0x82, 0xe9, 0xd4, 0x85, 0x86, 0xfc, 0xa2, 0x93, 0x32, 0xbc, 0x01, 0x65, 0xc3, 0x99, 0x13,
-	0x69, 0x19, 0x0a, 0x2e, 0xf3, 0xf4, 0x18, 0x89, 0x23, 0xfe, 0xd2, 0x82, 0x73, 0xea, 0x8b, 0x25,
-	0xb5, 0x6c, 0x18, 0x1d, 0xb2, 0x70, 0x8e, 0x79, 0x0b, 0xc0, 0x65, 0x5e, 0x7f, 0xf4, 0x3c, 0x59,
-	0x6a, 0x35, 0x92, 0xe2, 0xfc, 0xc3, 0xf5, 0xef, 0xc1, 0xf2, 0xc9, 0x0c, 0xd0, 0x75, 0x58, 0x3c,
-	0x90, 0x59, 0xe8, 0x62, 0xd7, 0x54, 0xb1, 0xf3, 0x33, 0x15, 0xa8, 0x29, 0x6d, 0xd4, 0x84, 0x52,
-	0x40, 0x8f, 0x5d, 0x9f, 0xaa, 0x5e, 0x56, 0x05, 0x48, 0x9a, 0xb1, 0xb9, 0x08, 0xc5, 0x21, 0xe5,
-	0xb4, 0xfb, 0x53, 0x11, 0xec, 0xe4, 0xe3, 0xf1, 0xd1, 0x73, 0xd4, 0x85, 0x05, 0x79, 0x9f, 0x11,
-	0xd2, 0x2f, 0x6f, 0x6a, 0x1f, 0x34, 0x57, 0x33, 0x3c, 0x8d, 0x68, 0x0e, 0x5d, 0x82, 0x82, 0x58,
-	0x61, 0x33, 0x7b, 0xba, 0x39, 0xbb, 0xf6, 0x70, 0x0e, 0xdd, 0x87, 0xa2, 0xc8, 0x14, 0xad, 0x4c,
-	0x21, 0x33, 0xfa, 0x28, 0xcd, 0xd2, 0x06, 0x67, 0xbe, 0xfa, 0xed, 0xcf, 0xef, 0xf2, 0x75, 0x54,
-	0x95, 0xff, 0x4b, 0x0e, 0x2f, 0x77, 0x44, 0x71, 0xe8, 0x2e, 0x14, 0xb6, 0x58, 0x12, 0x72, 0xba,
-	0xa7, 0x9b, 0xb3, 0x0b, 0x0f, 0xaf, 0x4a, 0x0f, 0x35, 0x64, 0x1b, 0x0f, 0x63, 0xc6, 0xd1, 0x35,
-	0x58, 0xd4, 0x8b, 0x73, 0xde, 0x33, 0xd1, 0x9c, 0xbb, 0x75, 0x71, 0x0e, 0x6d, 0x99, 0xbf, 0x07,
-	0x28, 0xfd, 0x69, 0x9c, 0x6d, 0x4f, 0x66, 0xdf, 0xe0, 0xb3, 0x32, 0xfa, 0x12, 0xaa, 0x99, 0xe8,
-	0xa1, 0xb4, 0xbf, 0x05, 0x95, 0x04, 0x3f, 0x74, 0x6e, 0x3e, 0xa0, 0x73, 0xfb, 0xd7, 0xb6, 0xd0,
-	0x1d, 0xa8, 0x24, 0x4b, 0x26, 0xb1, 0x3d, 0xb1, 0xa1, 0x9a, 0xff, 0x9a, 0xe1, 0x27, 0x45, 0xf4,
-	0xc0, 0x4e, 0x5d, 0x4d, 0xd4, 0x50, 0x9a, 0xb3, 0x5b, 0xa6, 0xf9, 0xef, 0x39, 0x12, 0xe3, 0x65,
-	0xf3, 0xe1, 0x2f, 0x6f, 0x5a, 0xd6, 0xeb, 0x37, 0x2d, 0xeb, 0x8f, 0x37, 0x2d, 0xeb, 0xdb, 0xb7,
-	0xad, 0xdc, 0xeb, 0xb7, 0xad, 0xdc, 0xef, 0x6f, 0x5b, 0xb9, 0x4f, 0x2f, 0x8d, 0x1d, 0x7e, 0x10,
-	0xef, 0x6f, 0x0c, 0xfc, 0x49, 0xe7, 0x85, 0x1f, 0x87, 0x1e, 0x3b, 0x9e, 0x38, 0x43, 0xcf, 0x19,
-	0x1f, 0xf0, 0x0e, 0x8d, 0x79, 0x3c, 0xf1, 0x3a, 0xf2, 0x3f, 0x65, 0x47, 0x78, 0xdf, 0x5f, 0x94,
-	0xe7, 0x2b, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0xd6, 0x79, 0x19, 0xfc, 0x91, 0x0e, 0x00, 0x00,
+func (m *ReplicateOp) GetOriginSeq() uint64 {
+	if m != nil {
+		return m.OriginSeq
+	}
+	return 0
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// PartitionKVClient is the client API for PartitionKV service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type PartitionKVClient interface {
-	Batch(ctx context.Context, in *BatchRequest, opts ...grpc.CallOption) (*BatchResponse, error)
-	Put(ctx context.Context, in *PutRequest, opts ...grpc.CallOption) (*PutResponse, error)
-	Head(ctx context.Context, in *HeadRequest, opts ...grpc.CallOption) (*HeadResponse, error)
-	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
-	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
-	Range(ctx context.Context, in *RangeRequest, opts ...grpc.CallOption) (*RangeResponse, error)
-	StreamPut(ctx context.Context, opts ...grpc.CallOption) (PartitionKV_StreamPutClient, error)
-	//ps management API
-	//system performace
-	SplitPart(ctx context.Context, in *SplitPartRequest, opts ...grpc.CallOption) (*SplitPartResponse, error)
-	Maintenance(ctx context.Context, in *MaintenanceRequest, opts ...grpc.CallOption) (*MaintenanceResponse, error)
+type ReplicateRequest struct {
+	Ops       []*ReplicateOp `protobuf:"bytes,1,rep,name=ops,proto3" json:"ops,omitempty"`
+	ClusterID uint64         `protobuf:"varint,2,opt,name=clusterID,proto3" json:"clusterID,omitempty"`
+	Partid    uint64         `protobuf:"varint,3,opt,name=partid,proto3" json:"partid,omitempty"`
 }
 
-type partitionKVClient struct {
-	cc *grpc.ClientConn
+func (m *ReplicateRequest) Reset()         { *m = ReplicateRequest{} }
+func (m *ReplicateRequest) String() string { return proto.CompactTextString(m) }
+func (*ReplicateRequest) ProtoMessage()    {}
+func (*ReplicateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{41}
 }
-
-func NewPartitionKVClient(cc *grpc.ClientConn) PartitionKVClient {
-	return &partitionKVClient{cc}
+func (m *ReplicateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *partitionKVClient) Batch(ctx context.Context, in *BatchRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
-	out := new(BatchResponse)
-	err := c.cc.Invoke(ctx, "/pspb.PartitionKV/Batch", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ReplicateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplicateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *partitionKVClient) Put(ctx context.Context, in *PutRequest, opts ...grpc.CallOption) (*PutResponse, error) {
-	out := new(PutResponse)
-	err := c.cc.Invoke(ctx, "/pspb.PartitionKV/Put", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ReplicateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicateRequest.Merge(m, src)
+}
+func (m *ReplicateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplicateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicateRequest.DiscardUnknown(m)
 }
 
-func (c *partitionKVClient) Head(ctx context.Context, in *HeadRequest, opts ...grpc.CallOption) (*HeadResponse, error) {
-	out := new(HeadResponse)
-	err := c.cc.Invoke(ctx, "/pspb.PartitionKV/Head", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ReplicateRequest proto.InternalMessageInfo
+
+func (m *ReplicateRequest) GetOps() []*ReplicateOp {
+	if m != nil {
+		return m.Ops
 	}
-	return out, nil
+	return nil
 }
 
-func (c *partitionKVClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error) {
-	out := new(GetResponse)
-	err := c.cc.Invoke(ctx, "/pspb.PartitionKV/Get", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ReplicateRequest) GetClusterID() uint64 {
+	if m != nil {
+		return m.ClusterID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *partitionKVClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
-	out := new(DeleteResponse)
-	err := c.cc.Invoke(ctx, "/pspb.PartitionKV/Delete", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ReplicateRequest) GetPartid() uint64 {
+	if m != nil {
+		return m.Partid
 	}
-	return out, nil
+	return 0
 }
 
-func (c *partitionKVClient) Range(ctx context.Context, in *RangeRequest, opts ...grpc.CallOption) (*RangeResponse, error) {
-	out := new(RangeResponse)
-	err := c.cc.Invoke(ctx, "/pspb.PartitionKV/Range", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type ReplicateResponse struct {
+	Applied uint32 `protobuf:"varint,1,opt,name=applied,proto3" json:"applied,omitempty"`
 }
 
-func (c *partitionKVClient) StreamPut(ctx context.Context, opts ...grpc.CallOption) (PartitionKV_StreamPutClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_PartitionKV_serviceDesc.Streams[0], "/pspb.PartitionKV/StreamPut", opts...)
-	if err != nil {
-		return nil, err
+func (m *ReplicateResponse) Reset()         { *m = ReplicateResponse{} }
+func (m *ReplicateResponse) String() string { return proto.CompactTextString(m) }
+func (*ReplicateResponse) ProtoMessage()    {}
+func (*ReplicateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{42}
+}
+func (m *ReplicateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplicateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplicateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	x := &partitionKVStreamPutClient{stream}
-	return x, nil
 }
-
-type PartitionKV_StreamPutClient interface {
-	Send(*StreamPutRequest) error
-	CloseAndRecv() (*PutResponse, error)
-	grpc.ClientStream
+func (m *ReplicateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicateResponse.Merge(m, src)
 }
-
-type partitionKVStreamPutClient struct {
-	grpc.ClientStream
+func (m *ReplicateResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (x *partitionKVStreamPutClient) Send(m *StreamPutRequest) error {
-	return x.ClientStream.SendMsg(m)
+func (m *ReplicateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicateResponse.DiscardUnknown(m)
 }
 
-func (x *partitionKVStreamPutClient) CloseAndRecv() (*PutResponse, error) {
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	m := new(PutResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+var xxx_messageInfo_ReplicateResponse proto.InternalMessageInfo
+
+func (m *ReplicateResponse) GetApplied() uint32 {
+	if m != nil {
+		return m.Applied
 	}
-	return m, nil
+	return 0
 }
 
-func (c *partitionKVClient) SplitPart(ctx context.Context, in *SplitPartRequest, opts ...grpc.CallOption) (*SplitPartResponse, error) {
-	out := new(SplitPartResponse)
-	err := c.cc.Invoke(ctx, "/pspb.PartitionKV/SplitPart", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+//checkpoint of replication of a partition, saved in etcd
+type ReplicationCheckpoint struct {
+	Seq       uint64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	Rg        *Range `protobuf:"bytes,2,opt,name=rg,proto3" json:"rg,omitempty"`
+	UpdatedAt int64  `protobuf:"varint,3,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
 }
 
-func (c *partitionKVClient) Maintenance(ctx context.Context, in *MaintenanceRequest, opts ...grpc.CallOption) (*MaintenanceResponse, error) {
-	out := new(MaintenanceResponse)
-	err := c.cc.Invoke(ctx, "/pspb.PartitionKV/Maintenance", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ReplicationCheckpoint) Reset()         { *m = ReplicationCheckpoint{} }
+func (m *ReplicationCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*ReplicationCheckpoint) ProtoMessage()    {}
+func (*ReplicationCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{43}
+}
+func (m *ReplicationCheckpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplicationCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplicationCheckpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// PartitionKVServer is the server API for PartitionKV service.
-type PartitionKVServer interface {
-	Batch(context.Context, *BatchRequest) (*BatchResponse, error)
-	Put(context.Context, *PutRequest) (*PutResponse, error)
-	Head(context.Context, *HeadRequest) (*HeadResponse, error)
-	Get(context.Context, *GetRequest) (*GetResponse, error)
-	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
-	Range(context.Context, *RangeRequest) (*RangeResponse, error)
-	StreamPut(PartitionKV_StreamPutServer) error
-	//ps management API
-	//system performace
-	SplitPart(context.Context, *SplitPartRequest) (*SplitPartResponse, error)
-	Maintenance(context.Context, *MaintenanceRequest) (*MaintenanceResponse, error)
+func (m *ReplicationCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicationCheckpoint.Merge(m, src)
+}
+func (m *ReplicationCheckpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplicationCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicationCheckpoint.DiscardUnknown(m)
 }
 
-// UnimplementedPartitionKVServer can be embedded to have forward compatible implementations.
-type UnimplementedPartitionKVServer struct {
+var xxx_messageInfo_ReplicationCheckpoint proto.InternalMessageInfo
+
+func (m *ReplicationCheckpoint) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
 }
 
-func (*UnimplementedPartitionKVServer) Batch(ctx context.Context, req *BatchRequest) (*BatchResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Batch not implemented")
+func (m *ReplicationCheckpoint) GetRg() *Range {
+	if m != nil {
+		return m.Rg
+	}
+	return nil
 }
-func (*UnimplementedPartitionKVServer) Put(ctx context.Context, req *PutRequest) (*PutResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Put not implemented")
+
+func (m *ReplicationCheckpoint) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
 }
-func (*UnimplementedPartitionKVServer) Head(ctx context.Context, req *HeadRequest) (*HeadResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Head not implemented")
+
+type SplitPartRequest struct {
+	Partid uint64 `protobuf:"varint,1,opt,name=partid,proto3" json:"partid,omitempty"`
 }
-func (*UnimplementedPartitionKVServer) Get(ctx context.Context, req *GetRequest) (*GetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+
+func (m *SplitPartRequest) Reset()         { *m = SplitPartRequest{} }
+func (m *SplitPartRequest) String() string { return proto.CompactTextString(m) }
+func (*SplitPartRequest) ProtoMessage()    {}
+func (*SplitPartRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{44}
 }
-func (*UnimplementedPartitionKVServer) Delete(ctx context.Context, req *DeleteRequest) (*DeleteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+func (m *SplitPartRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedPartitionKVServer) Range(ctx context.Context, req *RangeRequest) (*RangeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Range not implemented")
+func (m *SplitPartRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SplitPartRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedPartitionKVServer) StreamPut(srv PartitionKV_StreamPutServer) error {
-	return status.Errorf(codes.Unimplemented, "method StreamPut not implemented")
+func (m *SplitPartRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SplitPartRequest.Merge(m, src)
 }
-func (*UnimplementedPartitionKVServer) SplitPart(ctx context.Context, req *SplitPartRequest) (*SplitPartResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SplitPart not implemented")
+func (m *SplitPartRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedPartitionKVServer) Maintenance(ctx context.Context, req *MaintenanceRequest) (*MaintenanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Maintenance not implemented")
+func (m *SplitPartRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SplitPartRequest.DiscardUnknown(m)
 }
 
-func RegisterPartitionKVServer(s *grpc.Server, srv PartitionKVServer) {
-	s.RegisterService(&_PartitionKV_serviceDesc, srv)
-}
+var xxx_messageInfo_SplitPartRequest proto.InternalMessageInfo
 
-func _PartitionKV_Batch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(PartitionKVServer).Batch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pspb.PartitionKV/Batch",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PartitionKVServer).Batch(ctx, req.(*BatchRequest))
+func (m *SplitPartRequest) GetPartid() uint64 {
+	if m != nil {
+		return m.Partid
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _PartitionKV_Put_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PutRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(PartitionKVServer).Put(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pspb.PartitionKV/Put",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PartitionKVServer).Put(ctx, req.(*PutRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type SplitPartResponse struct {
 }
 
-func _PartitionKV_Head_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HeadRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(PartitionKVServer).Head(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pspb.PartitionKV/Head",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PartitionKVServer).Head(ctx, req.(*HeadRequest))
+func (m *SplitPartResponse) Reset()         { *m = SplitPartResponse{} }
+func (m *SplitPartResponse) String() string { return proto.CompactTextString(m) }
+func (*SplitPartResponse) ProtoMessage()    {}
+func (*SplitPartResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{45}
+}
+func (m *SplitPartResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SplitPartResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SplitPartResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *SplitPartResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SplitPartResponse.Merge(m, src)
+}
+func (m *SplitPartResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SplitPartResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SplitPartResponse.DiscardUnknown(m)
 }
 
-func _PartitionKV_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(PartitionKVServer).Get(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pspb.PartitionKV/Get",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PartitionKVServer).Get(ctx, req.(*GetRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_SplitPartResponse proto.InternalMessageInfo
+
+type SnapshotPartRequest struct {
+	Partid uint64 `protobuf:"varint,1,opt,name=partid,proto3" json:"partid,omitempty"`
 }
 
-func _PartitionKV_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(PartitionKVServer).Delete(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pspb.PartitionKV/Delete",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PartitionKVServer).Delete(ctx, req.(*DeleteRequest))
+func (m *SnapshotPartRequest) Reset()         { *m = SnapshotPartRequest{} }
+func (m *SnapshotPartRequest) String() string { return proto.CompactTextString(m) }
+func (*SnapshotPartRequest) ProtoMessage()    {}
+func (*SnapshotPartRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{46}
+}
+func (m *SnapshotPartRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotPartRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotPartRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *SnapshotPartRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotPartRequest.Merge(m, src)
+}
+func (m *SnapshotPartRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotPartRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotPartRequest.DiscardUnknown(m)
 }
 
-func _PartitionKV_Range_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RangeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(PartitionKVServer).Range(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pspb.PartitionKV/Range",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PartitionKVServer).Range(ctx, req.(*RangeRequest))
+var xxx_messageInfo_SnapshotPartRequest proto.InternalMessageInfo
+
+func (m *SnapshotPartRequest) GetPartid() uint64 {
+	if m != nil {
+		return m.Partid
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _PartitionKV_StreamPut_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(PartitionKVServer).StreamPut(&partitionKVStreamPutServer{stream})
+type SnapshotPartResponse struct {
+	Snapshot *PartitionSnapshot `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
 }
 
-type PartitionKV_StreamPutServer interface {
-	SendAndClose(*PutResponse) error
-	Recv() (*StreamPutRequest, error)
-	grpc.ServerStream
+func (m *SnapshotPartResponse) Reset()         { *m = SnapshotPartResponse{} }
+func (m *SnapshotPartResponse) String() string { return proto.CompactTextString(m) }
+func (*SnapshotPartResponse) ProtoMessage()    {}
+func (*SnapshotPartResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{47}
 }
-
-type partitionKVStreamPutServer struct {
-	grpc.ServerStream
+func (m *SnapshotPartResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (x *partitionKVStreamPutServer) SendAndClose(m *PutResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func (x *partitionKVStreamPutServer) Recv() (*StreamPutRequest, error) {
-	m := new(StreamPutRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *SnapshotPartResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotPartResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
 }
-
-func _PartitionKV_SplitPart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SplitPartRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(PartitionKVServer).SplitPart(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pspb.PartitionKV/SplitPart",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PartitionKVServer).SplitPart(ctx, req.(*SplitPartRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *SnapshotPartResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotPartResponse.Merge(m, src)
 }
-
-func _PartitionKV_Maintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MaintenanceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(PartitionKVServer).Maintenance(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pspb.PartitionKV/Maintenance",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PartitionKVServer).Maintenance(ctx, req.(*MaintenanceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *SnapshotPartResponse) XXX_Size() int {
+	return m.Size()
 }
-
-var _PartitionKV_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pspb.PartitionKV",
-	HandlerType: (*PartitionKVServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Batch",
-			Handler:    _PartitionKV_Batch_Handler,
-		},
-		{
-			MethodName: "Put",
-			Handler:    _PartitionKV_Put_Handler,
-		},
-		{
-			MethodName: "Head",
-			Handler:    _PartitionKV_Head_Handler,
-		},
-		{
-			MethodName: "Get",
-			Handler:    _PartitionKV_Get_Handler,
-		},
-		{
-			MethodName: "Delete",
-			Handler:    _PartitionKV_Delete_Handler,
-		},
-		{
-			MethodName: "Range",
-			Handler:    _PartitionKV_Range_Handler,
-		},
-		{
-			MethodName: "SplitPart",
-			Handler:    _PartitionKV_SplitPart_Handler,
-		},
-		{
-			MethodName: "Maintenance",
-			Handler:    _PartitionKV_Maintenance_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "StreamPut",
-			Handler:       _PartitionKV_StreamPut_Handler,
-			ClientStreams: true,
-		},
-	},
-	Metadata: "pspb.proto",
+func (m *SnapshotPartResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotPartResponse.DiscardUnknown(m)
 }
 
-func (m *RegionInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SnapshotPartResponse proto.InternalMessageInfo
+
+func (m *SnapshotPartResponse) GetSnapshot() *PartitionSnapshot {
+	if m != nil {
+		return m.Snapshot
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *RegionInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type RestorePartRequest struct {
+	Partid     uint64 `protobuf:"varint,1,opt,name=partid,proto3" json:"partid,omitempty"`
+	SnapshotID uint64 `protobuf:"varint,2,opt,name=snapshotID,proto3" json:"snapshotID,omitempty"`
 }
 
-func (m *RegionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PSID != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.PSID))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.PartID != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.PartID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Rg != nil {
-		{
-			size, err := m.Rg.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPspb(dAtA, i, uint64(size))
+func (m *RestorePartRequest) Reset()         { *m = RestorePartRequest{} }
+func (m *RestorePartRequest) String() string { return proto.CompactTextString(m) }
+func (*RestorePartRequest) ProtoMessage()    {}
+func (*RestorePartRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{48}
+}
+func (m *RestorePartRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestorePartRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestorePartRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *Regions) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *RestorePartRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestorePartRequest.Merge(m, src)
 }
-
-func (m *Regions) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RestorePartRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestorePartRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestorePartRequest.DiscardUnknown(m)
 }
 
-func (m *Regions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Regions) > 0 {
-		for k := range m.Regions {
-			v := m.Regions[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintPspb(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i = encodeVarintPspb(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintPspb(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
+var xxx_messageInfo_RestorePartRequest proto.InternalMessageInfo
+
+func (m *RestorePartRequest) GetPartid() uint64 {
+	if m != nil {
+		return m.Partid
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Range) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RestorePartRequest) GetSnapshotID() uint64 {
+	if m != nil {
+		return m.SnapshotID
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Range) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type RestorePartResponse struct {
 }
 
-func (m *Range) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EndKey) > 0 {
-		i -= len(m.EndKey)
-		copy(dAtA[i:], m.EndKey)
-		i = encodeVarintPspb(dAtA, i, uint64(len(m.EndKey)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.StartKey) > 0 {
-		i -= len(m.StartKey)
-		copy(dAtA[i:], m.StartKey)
-		i = encodeVarintPspb(dAtA, i, uint64(len(m.StartKey)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *RestorePartResponse) Reset()         { *m = RestorePartResponse{} }
+func (m *RestorePartResponse) String() string { return proto.CompactTextString(m) }
+func (*RestorePartResponse) ProtoMessage()    {}
+func (*RestorePartResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{49}
 }
-
-func (m *Location) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RestorePartResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestorePartResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestorePartResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *RestorePartResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestorePartResponse.Merge(m, src)
+}
+func (m *RestorePartResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestorePartResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestorePartResponse.DiscardUnknown(m)
 }
 
-func (m *Location) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_RestorePartResponse proto.InternalMessageInfo
+
+type CompactOp struct {
 }
 
-func (m *Location) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Offset != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.Offset))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.ExtentID != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.ExtentID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *CompactOp) Reset()         { *m = CompactOp{} }
+func (m *CompactOp) String() string { return proto.CompactTextString(m) }
+func (*CompactOp) ProtoMessage()    {}
+func (*CompactOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{50}
 }
-
-func (m *TableLocations) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CompactOp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompactOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompactOp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *CompactOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactOp.Merge(m, src)
+}
+func (m *CompactOp) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompactOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactOp.DiscardUnknown(m)
 }
 
-func (m *TableLocations) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_CompactOp proto.InternalMessageInfo
+
+type AutoGCOp struct {
 }
 
-func (m *TableLocations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Locs) > 0 {
-		for iNdEx := len(m.Locs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Locs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPspb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *AutoGCOp) Reset()         { *m = AutoGCOp{} }
+func (m *AutoGCOp) String() string { return proto.CompactTextString(m) }
+func (*AutoGCOp) ProtoMessage()    {}
+func (*AutoGCOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{51}
+}
+func (m *AutoGCOp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoGCOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoGCOp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *PartitionMeta) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *AutoGCOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoGCOp.Merge(m, src)
+}
+func (m *AutoGCOp) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoGCOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoGCOp.DiscardUnknown(m)
 }
 
-func (m *PartitionMeta) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_AutoGCOp proto.InternalMessageInfo
+
+type ForceGCOp struct {
+	ExIDs []uint64 `protobuf:"varint,1,rep,packed,name=exIDs,proto3" json:"exIDs,omitempty"`
 }
 
-func (m *PartitionMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MetaStream != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.MetaStream))
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.PartID != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.PartID))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.Rg != nil {
-		{
-			size, err := m.Rg.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPspb(dAtA, i, uint64(size))
+func (m *ForceGCOp) Reset()         { *m = ForceGCOp{} }
+func (m *ForceGCOp) String() string { return proto.CompactTextString(m) }
+func (*ForceGCOp) ProtoMessage()    {}
+func (*ForceGCOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{52}
+}
+func (m *ForceGCOp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForceGCOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForceGCOp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.RowStream != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.RowStream))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.LogStream != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.LogStream))
-		i--
-		dAtA[i] = 0x10
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ForceGCOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForceGCOp.Merge(m, src)
+}
+func (m *ForceGCOp) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForceGCOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForceGCOp.DiscardUnknown(m)
 }
 
-func (m *PSDetail) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ForceGCOp proto.InternalMessageInfo
+
+func (m *ForceGCOp) GetExIDs() []uint64 {
+	if m != nil {
+		return m.ExIDs
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *PSDetail) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MaintenanceRequest struct {
+	Partid uint64 `protobuf:"varint,1,opt,name=partid,proto3" json:"partid,omitempty"`
+	// Types that are valid to be assigned to OP:
+	//	*MaintenanceRequest_Compact
+	//	*MaintenanceRequest_Autogc
+	//	*MaintenanceRequest_Forcegc
+	OP isMaintenanceRequest_OP `protobuf_oneof:"OP"`
 }
 
-func (m *PSDetail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintPspb(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.PSID != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.PSID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *MaintenanceRequest) Reset()         { *m = MaintenanceRequest{} }
+func (m *MaintenanceRequest) String() string { return proto.CompactTextString(m) }
+func (*MaintenanceRequest) ProtoMessage()    {}
+func (*MaintenanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{53}
 }
-
-func (m *BlockMeta) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MaintenanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaintenanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaintenanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *BlockMeta) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MaintenanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceRequest.Merge(m, src)
+}
+func (m *MaintenanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaintenanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceRequest.DiscardUnknown(m)
 }
 
-func (m *BlockMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CompressionType != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.CompressionType))
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.Discards) > 0 {
-		for k := range m.Discards {
-			v := m.Discards[k]
-			baseI := i
-			i = encodeVarintPspb(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i = encodeVarintPspb(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintPspb(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x3a
-		}
-	}
-	if m.SeqNum != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.SeqNum))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.VpOffset != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.VpOffset))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.VpExtentID != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.VpExtentID))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.UnCompressedSize != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.UnCompressedSize))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.CompressedSize != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.CompressedSize))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.TableIndex != nil {
-		{
-			size, err := m.TableIndex.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPspb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
+var xxx_messageInfo_MaintenanceRequest proto.InternalMessageInfo
 
-func (m *BlockOffset) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type isMaintenanceRequest_OP interface {
+	isMaintenanceRequest_OP()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
 
-func (m *BlockOffset) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MaintenanceRequest_Compact struct {
+	Compact *CompactOp `protobuf:"bytes,2,opt,name=compact,proto3,oneof" json:"compact,omitempty"`
 }
-
-func (m *BlockOffset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Offset != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.Offset))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.ExtentID != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.ExtentID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintPspb(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type MaintenanceRequest_Autogc struct {
+	Autogc *AutoGCOp `protobuf:"bytes,3,opt,name=autogc,proto3,oneof" json:"autogc,omitempty"`
 }
-
-func (m *TableIndex) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type MaintenanceRequest_Forcegc struct {
+	Forcegc *ForceGCOp `protobuf:"bytes,4,opt,name=forcegc,proto3,oneof" json:"forcegc,omitempty"`
 }
 
-func (m *TableIndex) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+func (*MaintenanceRequest_Compact) isMaintenanceRequest_OP() {}
+func (*MaintenanceRequest_Autogc) isMaintenanceRequest_OP()  {}
+func (*MaintenanceRequest_Forcegc) isMaintenanceRequest_OP() {}
 
-func (m *TableIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NumOfBlocks != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.NumOfBlocks))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.EstimatedSize != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.EstimatedSize))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.BloomFilter) > 0 {
-		i -= len(m.BloomFilter)
-		copy(dAtA[i:], m.BloomFilter)
-		i = encodeVarintPspb(dAtA, i, uint64(len(m.BloomFilter)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Offsets) > 0 {
-		for iNdEx := len(m.Offsets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Offsets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPspb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *MaintenanceRequest) GetOP() isMaintenanceRequest_OP {
+	if m != nil {
+		return m.OP
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *PutRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MaintenanceRequest) GetPartid() uint64 {
+	if m != nil {
+		return m.Partid
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *PutRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MaintenanceRequest) GetCompact() *CompactOp {
+	if x, ok := m.GetOP().(*MaintenanceRequest_Compact); ok {
+		return x.Compact
+	}
+	return nil
 }
 
-func (m *PutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Partid != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.Partid))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.ExpiresAt != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.ExpiresAt))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintPspb(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintPspb(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+func (m *MaintenanceRequest) GetAutogc() *AutoGCOp {
+	if x, ok := m.GetOP().(*MaintenanceRequest_Autogc); ok {
+		return x.Autogc
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *PutResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MaintenanceRequest) GetForcegc() *ForceGCOp {
+	if x, ok := m.GetOP().(*MaintenanceRequest_Forcegc); ok {
+		return x.Forcegc
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *PutResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*MaintenanceRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*MaintenanceRequest_Compact)(nil),
+		(*MaintenanceRequest_Autogc)(nil),
+		(*MaintenanceRequest_Forcegc)(nil),
+	}
 }
 
-func (m *PutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintPspb(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type MaintenanceResponse struct {
 }
 
-func (m *DeleteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MaintenanceResponse) Reset()         { *m = MaintenanceResponse{} }
+func (m *MaintenanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MaintenanceResponse) ProtoMessage()    {}
+func (*MaintenanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{54}
+}
+func (m *MaintenanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaintenanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaintenanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *DeleteRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MaintenanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceResponse.Merge(m, src)
+}
+func (m *MaintenanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaintenanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceResponse.DiscardUnknown(m)
 }
 
-func (m *DeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Partid != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.Partid))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintPspb(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MaintenanceResponse proto.InternalMessageInfo
+
+type HeadRequest struct {
+	Key        []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Partid     uint64 `protobuf:"varint,2,opt,name=partid,proto3" json:"partid,omitempty"`
+	SnapshotID uint64 `protobuf:"varint,3,opt,name=snapshotID,proto3" json:"snapshotID,omitempty"`
 }
 
-func (m *DeleteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *HeadRequest) Reset()         { *m = HeadRequest{} }
+func (m *HeadRequest) String() string { return proto.CompactTextString(m) }
+func (*HeadRequest) ProtoMessage()    {}
+func (*HeadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{55}
+}
+func (m *HeadRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeadRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *HeadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeadRequest.Merge(m, src)
+}
+func (m *HeadRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeadRequest.DiscardUnknown(m)
 }
 
-func (m *DeleteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_HeadRequest proto.InternalMessageInfo
+
+func (m *HeadRequest) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
 }
 
-func (m *DeleteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintPspb(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+func (m *HeadRequest) GetPartid() uint64 {
+	if m != nil {
+		return m.Partid
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *GetRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *HeadRequest) GetSnapshotID() uint64 {
+	if m != nil {
+		return m.SnapshotID
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GetRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type HeadResponse struct {
+	Info *HeadInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
 }
 
-func (m *GetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Partid != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.Partid))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintPspb(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+func (m *HeadResponse) Reset()         { *m = HeadResponse{} }
+func (m *HeadResponse) String() string { return proto.CompactTextString(m) }
+func (*HeadResponse) ProtoMessage()    {}
+func (*HeadResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{56}
+}
+func (m *HeadResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeadResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *HeadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeadResponse.Merge(m, src)
+}
+func (m *HeadResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeadResponse.DiscardUnknown(m)
 }
 
-func (m *GetResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_HeadResponse proto.InternalMessageInfo
+
+func (m *HeadResponse) GetInfo() *HeadInfo {
+	if m != nil {
+		return m.Info
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type HeadInfo struct {
+	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Len uint32 `protobuf:"varint,3,opt,name=len,proto3" json:"len,omitempty"`
 }
 
-func (m *GetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintPspb(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
+func (m *HeadInfo) Reset()         { *m = HeadInfo{} }
+func (m *HeadInfo) String() string { return proto.CompactTextString(m) }
+func (*HeadInfo) ProtoMessage()    {}
+func (*HeadInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{57}
+}
+func (m *HeadInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeadInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeadInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintPspb(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *HeadInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeadInfo.Merge(m, src)
+}
+func (m *HeadInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeadInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeadInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeadInfo proto.InternalMessageInfo
+
+func (m *HeadInfo) GetKey() []byte {
+	if m != nil {
+		return m.Key
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *RequestOp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *HeadInfo) GetLen() uint32 {
+	if m != nil {
+		return m.Len
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *RequestOp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type StreamPutRequestHeader struct {
+	Key        []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	LenOfValue uint32 `protobuf:"varint,2,opt,name=lenOfValue,proto3" json:"lenOfValue,omitempty"`
+	ExpiresAt  uint64 `protobuf:"varint,3,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
+	Partid     uint64 `protobuf:"varint,4,opt,name=partid,proto3" json:"partid,omitempty"`
 }
 
-func (m *RequestOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Request != nil {
-		{
-			size := m.Request.Size()
-			i -= size
-			if _, err := m.Request.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
-	}
-	return len(dAtA) - i, nil
+func (m *StreamPutRequestHeader) Reset()         { *m = StreamPutRequestHeader{} }
+func (m *StreamPutRequestHeader) String() string { return proto.CompactTextString(m) }
+func (*StreamPutRequestHeader) ProtoMessage()    {}
+func (*StreamPutRequestHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{58}
 }
-
-func (m *RequestOp_RequestPut) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StreamPutRequestHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *RequestOp_RequestPut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.RequestPut != nil {
-		{
-			size, err := m.RequestPut.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPspb(dAtA, i, uint64(size))
+func (m *StreamPutRequestHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamPutRequestHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *RequestOp_RequestDelete) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StreamPutRequestHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamPutRequestHeader.Merge(m, src)
 }
-
-func (m *RequestOp_RequestDelete) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.RequestDelete != nil {
-		{
-			size, err := m.RequestDelete.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPspb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	return len(dAtA) - i, nil
+func (m *StreamPutRequestHeader) XXX_Size() int {
+	return m.Size()
 }
-func (m *RequestOp_RequestGet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StreamPutRequestHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamPutRequestHeader.DiscardUnknown(m)
 }
 
-func (m *RequestOp_RequestGet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.RequestGet != nil {
-		{
-			size, err := m.RequestGet.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPspb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+var xxx_messageInfo_StreamPutRequestHeader proto.InternalMessageInfo
+
+func (m *StreamPutRequestHeader) GetKey() []byte {
+	if m != nil {
+		return m.Key
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
-func (m *ResponseOp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *StreamPutRequestHeader) GetLenOfValue() uint32 {
+	if m != nil {
+		return m.LenOfValue
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ResponseOp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StreamPutRequestHeader) GetExpiresAt() uint64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
 }
 
-func (m *ResponseOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Response != nil {
-		{
-			size := m.Response.Size()
-			i -= size
-			if _, err := m.Response.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+func (m *StreamPutRequestHeader) GetPartid() uint64 {
+	if m != nil {
+		return m.Partid
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ResponseOp_ResponsePut) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type StreamPutRequest struct {
+	// Types that are valid to be assigned to Data:
+	//	*StreamPutRequest_Header
+	//	*StreamPutRequest_Payload
+	Data isStreamPutRequest_Data `protobuf_oneof:"data"`
 }
 
-func (m *ResponseOp_ResponsePut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.ResponsePut != nil {
-		{
-			size, err := m.ResponsePut.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPspb(dAtA, i, uint64(size))
+func (m *StreamPutRequest) Reset()         { *m = StreamPutRequest{} }
+func (m *StreamPutRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamPutRequest) ProtoMessage()    {}
+func (*StreamPutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{59}
+}
+func (m *StreamPutRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamPutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamPutRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *ResponseOp_ResponseDelete) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StreamPutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamPutRequest.Merge(m, src)
+}
+func (m *StreamPutRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamPutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamPutRequest.DiscardUnknown(m)
 }
 
-func (m *ResponseOp_ResponseDelete) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.ResponseDelete != nil {
-		{
-			size, err := m.ResponseDelete.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPspb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_StreamPutRequest proto.InternalMessageInfo
+
+type isStreamPutRequest_Data interface {
+	isStreamPutRequest_Data()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
-func (m *ResponseOp_ResponseGet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+type StreamPutRequest_Header struct {
+	Header *StreamPutRequestHeader `protobuf:"bytes,1,opt,name=header,proto3,oneof" json:"header,omitempty"`
+}
+type StreamPutRequest_Payload struct {
+	Payload []byte `protobuf:"bytes,2,opt,name=payload,proto3,oneof" json:"payload,omitempty"`
 }
 
-func (m *ResponseOp_ResponseGet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.ResponseGet != nil {
-		{
-			size, err := m.ResponseGet.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPspb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+func (*StreamPutRequest_Header) isStreamPutRequest_Data()  {}
+func (*StreamPutRequest_Payload) isStreamPutRequest_Data() {}
+
+func (m *StreamPutRequest) GetData() isStreamPutRequest_Data {
+	if m != nil {
+		return m.Data
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
-func (m *BatchRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *StreamPutRequest) GetHeader() *StreamPutRequestHeader {
+	if x, ok := m.GetData().(*StreamPutRequest_Header); ok {
+		return x.Header
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *BatchRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *BatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Req) > 0 {
-		for iNdEx := len(m.Req) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Req[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPspb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *StreamPutRequest) GetPayload() []byte {
+	if x, ok := m.GetData().(*StreamPutRequest_Payload); ok {
+		return x.Payload
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *BatchResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*StreamPutRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*StreamPutRequest_Header)(nil),
+		(*StreamPutRequest_Payload)(nil),
 	}
-	return dAtA[:n], nil
 }
 
-func (m *BatchResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+//payloads are table files(see range_partition/table/table_file.go) one after another
+type IngestTablesRequestHeader struct {
+	Partid      uint64 `protobuf:"varint,1,opt,name=partid,proto3" json:"partid,omitempty"`
+	NumOfTables uint32 `protobuf:"varint,2,opt,name=numOfTables,proto3" json:"numOfTables,omitempty"`
 }
 
-func (m *BatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Res) > 0 {
-		for iNdEx := len(m.Res) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Res[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPspb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *IngestTablesRequestHeader) Reset()         { *m = IngestTablesRequestHeader{} }
+func (m *IngestTablesRequestHeader) String() string { return proto.CompactTextString(m) }
+func (*IngestTablesRequestHeader) ProtoMessage()    {}
+func (*IngestTablesRequestHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{60}
+}
+func (m *IngestTablesRequestHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IngestTablesRequestHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IngestTablesRequestHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *RangeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *IngestTablesRequestHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IngestTablesRequestHeader.Merge(m, src)
 }
-
-func (m *RangeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *IngestTablesRequestHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *IngestTablesRequestHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_IngestTablesRequestHeader.DiscardUnknown(m)
 }
 
-func (m *RangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Partid != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.Partid))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Limit != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Start) > 0 {
-		i -= len(m.Start)
-		copy(dAtA[i:], m.Start)
-		i = encodeVarintPspb(dAtA, i, uint64(len(m.Start)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Prefix) > 0 {
-		i -= len(m.Prefix)
-		copy(dAtA[i:], m.Prefix)
-		i = encodeVarintPspb(dAtA, i, uint64(len(m.Prefix)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_IngestTablesRequestHeader proto.InternalMessageInfo
+
+func (m *IngestTablesRequestHeader) GetPartid() uint64 {
+	if m != nil {
+		return m.Partid
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *RangeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *IngestTablesRequestHeader) GetNumOfTables() uint32 {
+	if m != nil {
+		return m.NumOfTables
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *RangeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type IngestTablesRequest struct {
+	// Types that are valid to be assigned to Data:
+	//	*IngestTablesRequest_Header
+	//	*IngestTablesRequest_Payload
+	Data isIngestTablesRequest_Data `protobuf_oneof:"data"`
 }
 
-func (m *RangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Keys) > 0 {
-		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Keys[iNdEx])
-			copy(dAtA[i:], m.Keys[iNdEx])
-			i = encodeVarintPspb(dAtA, i, uint64(len(m.Keys[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Truncated {
-		i--
-		if m.Truncated {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *IngestTablesRequest) Reset()         { *m = IngestTablesRequest{} }
+func (m *IngestTablesRequest) String() string { return proto.CompactTextString(m) }
+func (*IngestTablesRequest) ProtoMessage()    {}
+func (*IngestTablesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{61}
+}
+func (m *IngestTablesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IngestTablesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IngestTablesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *SplitPartRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *IngestTablesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IngestTablesRequest.Merge(m, src)
 }
-
-func (m *SplitPartRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *IngestTablesRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *SplitPartRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Partid != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.Partid))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *IngestTablesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_IngestTablesRequest.DiscardUnknown(m)
 }
 
-func (m *SplitPartResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_IngestTablesRequest proto.InternalMessageInfo
 
-func (m *SplitPartResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type isIngestTablesRequest_Data interface {
+	isIngestTablesRequest_Data()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
 
-func (m *SplitPartResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type IngestTablesRequest_Header struct {
+	Header *IngestTablesRequestHeader `protobuf:"bytes,1,opt,name=header,proto3,oneof" json:"header,omitempty"`
+}
+type IngestTablesRequest_Payload struct {
+	Payload []byte `protobuf:"bytes,2,opt,name=payload,proto3,oneof" json:"payload,omitempty"`
 }
 
-func (m *CompactOp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (*IngestTablesRequest_Header) isIngestTablesRequest_Data()  {}
+func (*IngestTablesRequest_Payload) isIngestTablesRequest_Data() {}
+
+func (m *IngestTablesRequest) GetData() isIngestTablesRequest_Data {
+	if m != nil {
+		return m.Data
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *CompactOp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *IngestTablesRequest) GetHeader() *IngestTablesRequestHeader {
+	if x, ok := m.GetData().(*IngestTablesRequest_Header); ok {
+		return x.Header
+	}
+	return nil
 }
 
-func (m *CompactOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *IngestTablesRequest) GetPayload() []byte {
+	if x, ok := m.GetData().(*IngestTablesRequest_Payload); ok {
+		return x.Payload
+	}
+	return nil
 }
 
-func (m *AutoGCOp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*IngestTablesRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*IngestTablesRequest_Header)(nil),
+		(*IngestTablesRequest_Payload)(nil),
 	}
-	return dAtA[:n], nil
 }
 
-func (m *AutoGCOp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type IngestTablesResponse struct {
+	Seq uint64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
 }
 
-func (m *AutoGCOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *IngestTablesResponse) Reset()         { *m = IngestTablesResponse{} }
+func (m *IngestTablesResponse) String() string { return proto.CompactTextString(m) }
+func (*IngestTablesResponse) ProtoMessage()    {}
+func (*IngestTablesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{62}
 }
-
-func (m *ForceGCOp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *IngestTablesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IngestTablesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IngestTablesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *IngestTablesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IngestTablesResponse.Merge(m, src)
+}
+func (m *IngestTablesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *IngestTablesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_IngestTablesResponse.DiscardUnknown(m)
 }
 
-func (m *ForceGCOp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_IngestTablesResponse proto.InternalMessageInfo
+
+func (m *IngestTablesResponse) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
 }
 
-func (m *ForceGCOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExIDs) > 0 {
-		dAtA12 := make([]byte, len(m.ExIDs)*10)
-		var j11 int
-		for _, num := range m.ExIDs {
-			for num >= 1<<7 {
-				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j11++
-			}
-			dAtA12[j11] = uint8(num)
-			j11++
+//archive of exported keys, see kv_archive
+type ArchiveEntry struct {
+	Key       []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value     []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Deleted   bool   `protobuf:"varint,3,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	ExpiresAt uint64 `protobuf:"varint,4,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+}
+
+func (m *ArchiveEntry) Reset()         { *m = ArchiveEntry{} }
+func (m *ArchiveEntry) String() string { return proto.CompactTextString(m) }
+func (*ArchiveEntry) ProtoMessage()    {}
+func (*ArchiveEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{63}
+}
+func (m *ArchiveEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchiveEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchiveEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j11
-		copy(dAtA[i:], dAtA12[:j11])
-		i = encodeVarintPspb(dAtA, i, uint64(j11))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ArchiveEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveEntry.Merge(m, src)
+}
+func (m *ArchiveEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchiveEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveEntry.DiscardUnknown(m)
 }
 
-func (m *MaintenanceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ArchiveEntry proto.InternalMessageInfo
+
+func (m *ArchiveEntry) GetKey() []byte {
+	if m != nil {
+		return m.Key
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MaintenanceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ArchiveEntry) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
 }
 
-func (m *MaintenanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.OP != nil {
-		{
-			size := m.OP.Size()
-			i -= size
-			if _, err := m.OP.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+func (m *ArchiveEntry) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
 	}
-	if m.Partid != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.Partid))
-		i--
-		dAtA[i] = 0x8
+	return false
+}
+
+func (m *ArchiveEntry) GetExpiresAt() uint64 {
+	if m != nil {
+		return m.ExpiresAt
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MaintenanceRequest_Compact) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ArchiveState struct {
+	Prefix         []byte            `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	BaseSeqs       map[uint64]uint64 `protobuf:"bytes,2,rep,name=baseSeqs,proto3" json:"baseSeqs,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Seqs           map[uint64]uint64 `protobuf:"bytes,3,rep,name=seqs,proto3" json:"seqs,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	DonePartitions []uint64          `protobuf:"varint,4,rep,packed,name=donePartitions,proto3" json:"donePartitions,omitempty"`
+	PartID         uint64            `protobuf:"varint,5,opt,name=partID,proto3" json:"partID,omitempty"`
+	LastKey        []byte            `protobuf:"bytes,6,opt,name=lastKey,proto3" json:"lastKey,omitempty"`
+	Count          uint64            `protobuf:"varint,7,opt,name=count,proto3" json:"count,omitempty"`
+	Done           bool              `protobuf:"varint,8,opt,name=done,proto3" json:"done,omitempty"`
+	StartKey       []byte            `protobuf:"bytes,9,opt,name=startKey,proto3" json:"startKey,omitempty"`
+	ExportedRanges []*Range          `protobuf:"bytes,10,rep,name=exportedRanges,proto3" json:"exportedRanges,omitempty"`
 }
 
-func (m *MaintenanceRequest_Compact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Compact != nil {
-		{
-			size, err := m.Compact.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPspb(dAtA, i, uint64(size))
+func (m *ArchiveState) Reset()         { *m = ArchiveState{} }
+func (m *ArchiveState) String() string { return proto.CompactTextString(m) }
+func (*ArchiveState) ProtoMessage()    {}
+func (*ArchiveState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{64}
+}
+func (m *ArchiveState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchiveState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchiveState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *MaintenanceRequest_Autogc) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ArchiveState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveState.Merge(m, src)
+}
+func (m *ArchiveState) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchiveState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveState.DiscardUnknown(m)
 }
 
-func (m *MaintenanceRequest_Autogc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Autogc != nil {
-		{
-			size, err := m.Autogc.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPspb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+var xxx_messageInfo_ArchiveState proto.InternalMessageInfo
+
+func (m *ArchiveState) GetPrefix() []byte {
+	if m != nil {
+		return m.Prefix
 	}
-	return len(dAtA) - i, nil
-}
-func (m *MaintenanceRequest_Forcegc) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return nil
 }
 
-func (m *MaintenanceRequest_Forcegc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Forcegc != nil {
-		{
-			size, err := m.Forcegc.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPspb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+func (m *ArchiveState) GetBaseSeqs() map[uint64]uint64 {
+	if m != nil {
+		return m.BaseSeqs
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
-func (m *MaintenanceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *ArchiveState) GetSeqs() map[uint64]uint64 {
+	if m != nil {
+		return m.Seqs
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MaintenanceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ArchiveState) GetDonePartitions() []uint64 {
+	if m != nil {
+		return m.DonePartitions
+	}
+	return nil
 }
 
-func (m *MaintenanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *ArchiveState) GetPartID() uint64 {
+	if m != nil {
+		return m.PartID
+	}
+	return 0
 }
 
-func (m *HeadRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ArchiveState) GetLastKey() []byte {
+	if m != nil {
+		return m.LastKey
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *HeadRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ArchiveState) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
 }
 
-func (m *HeadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Partid != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.Partid))
-		i--
-		dAtA[i] = 0x10
+func (m *ArchiveState) GetDone() bool {
+	if m != nil {
+		return m.Done
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintPspb(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+	return false
+}
+
+func (m *ArchiveState) GetStartKey() []byte {
+	if m != nil {
+		return m.StartKey
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *HeadResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ArchiveState) GetExportedRanges() []*Range {
+	if m != nil {
+		return m.ExportedRanges
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *HeadResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+//ArchiveChunk has entries and the state after them
+type ArchiveChunk struct {
+	Entries []*ArchiveEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	State   *ArchiveState   `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
 }
 
-func (m *HeadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Info != nil {
-		{
-			size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPspb(dAtA, i, uint64(size))
+func (m *ArchiveChunk) Reset()         { *m = ArchiveChunk{} }
+func (m *ArchiveChunk) String() string { return proto.CompactTextString(m) }
+func (*ArchiveChunk) ProtoMessage()    {}
+func (*ArchiveChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{65}
+}
+func (m *ArchiveChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchiveChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchiveChunk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ArchiveChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveChunk.Merge(m, src)
+}
+func (m *ArchiveChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchiveChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveChunk.DiscardUnknown(m)
 }
 
-func (m *HeadInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ArchiveChunk proto.InternalMessageInfo
+
+func (m *ArchiveChunk) GetEntries() []*ArchiveEntry {
+	if m != nil {
+		return m.Entries
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *HeadInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ArchiveChunk) GetState() *ArchiveState {
+	if m != nil {
+		return m.State
+	}
+	return nil
 }
 
-func (m *HeadInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Len != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.Len))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintPspb(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterEnum("pspb.TxnStatus", TxnStatus_name, TxnStatus_value)
+	proto.RegisterEnum("pspb.WatchEvent_EventType", WatchEvent_EventType_name, WatchEvent_EventType_value)
+	proto.RegisterType((*RegionInfo)(nil), "pspb.RegionInfo")
+	proto.RegisterType((*Regions)(nil), "pspb.Regions")
+	proto.RegisterMapType((map[uint64]*RegionInfo)(nil), "pspb.Regions.RegionsEntry")
+	proto.RegisterType((*Range)(nil), "pspb.Range")
+	proto.RegisterType((*Location)(nil), "pspb.Location")
+	proto.RegisterType((*TableLocations)(nil), "pspb.TableLocations")
+	proto.RegisterType((*PartitionMeta)(nil), "pspb.PartitionMeta")
+	proto.RegisterType((*PartitionSnapshot)(nil), "pspb.PartitionSnapshot")
+	proto.RegisterType((*PSDetail)(nil), "pspb.PSDetail")
+	proto.RegisterType((*ByteRange)(nil), "pspb.ByteRange")
+	proto.RegisterType((*IndexInfo)(nil), "pspb.IndexInfo")
+	proto.RegisterType((*PrefixQuota)(nil), "pspb.PrefixQuota")
+	proto.RegisterType((*TenantLimit)(nil), "pspb.TenantLimit")
+	proto.RegisterType((*BlockMeta)(nil), "pspb.BlockMeta")
+	proto.RegisterMapType((map[uint64]int64)(nil), "pspb.BlockMeta.DiscardsEntry")
+	proto.RegisterType((*BlockOffset)(nil), "pspb.BlockOffset")
+	proto.RegisterType((*TableIndex)(nil), "pspb.TableIndex")
+	proto.RegisterType((*PutRequest)(nil), "pspb.PutRequest")
+	proto.RegisterType((*PutResponse)(nil), "pspb.PutResponse")
+	proto.RegisterType((*DeleteRequest)(nil), "pspb.DeleteRequest")
+	proto.RegisterType((*DeleteResponse)(nil), "pspb.DeleteResponse")
+	proto.RegisterType((*GetRequest)(nil), "pspb.GetRequest")
+	proto.RegisterType((*GetResponse)(nil), "pspb.GetResponse")
+	proto.RegisterType((*RequestOp)(nil), "pspb.RequestOp")
+	proto.RegisterType((*ResponseOp)(nil), "pspb.ResponseOp")
+	proto.RegisterType((*BatchRequest)(nil), "pspb.BatchRequest")
+	proto.RegisterType((*BatchResponse)(nil), "pspb.BatchResponse")
+	proto.RegisterType((*RangeRequest)(nil), "pspb.RangeRequest")
+	proto.RegisterType((*RangeResponse)(nil), "pspb.RangeResponse")
+	proto.RegisterType((*IndexRangeRequest)(nil), "pspb.IndexRangeRequest")
+	proto.RegisterType((*IndexEntry)(nil), "pspb.IndexEntry")
+	proto.RegisterType((*IndexRangeResponse)(nil), "pspb.IndexRangeResponse")
+	proto.RegisterType((*WatchRequest)(nil), "pspb.WatchRequest")
+	proto.RegisterType((*WatchEvent)(nil), "pspb.WatchEvent")
+	proto.RegisterType((*WatchResponse)(nil), "pspb.WatchResponse")
+	proto.RegisterType((*TxnRecord)(nil), "pspb.TxnRecord")
+	proto.RegisterType((*TxnIntent)(nil), "pspb.TxnIntent")
+	proto.RegisterType((*TxnMutation)(nil), "pspb.TxnMutation")
+	proto.RegisterType((*TxnPrepareRequest)(nil), "pspb.TxnPrepareRequest")
+	proto.RegisterType((*TxnPrepareResponse)(nil), "pspb.TxnPrepareResponse")
+	proto.RegisterType((*TxnResolveRequest)(nil), "pspb.TxnResolveRequest")
+	proto.RegisterType((*TxnResolveResponse)(nil), "pspb.TxnResolveResponse")
+	proto.RegisterType((*ReplicateOp)(nil), "pspb.ReplicateOp")
+	proto.RegisterType((*ReplicateRequest)(nil), "pspb.ReplicateRequest")
+	proto.RegisterType((*ReplicateResponse)(nil), "pspb.ReplicateResponse")
+	proto.RegisterType((*ReplicationCheckpoint)(nil), "pspb.ReplicationCheckpoint")
+	proto.RegisterType((*SplitPartRequest)(nil), "pspb.SplitPartRequest")
+	proto.RegisterType((*SplitPartResponse)(nil), "pspb.SplitPartResponse")
+	proto.RegisterType((*SnapshotPartRequest)(nil), "pspb.SnapshotPartRequest")
+	proto.RegisterType((*SnapshotPartResponse)(nil), "pspb.SnapshotPartResponse")
+	proto.RegisterType((*RestorePartRequest)(nil), "pspb.RestorePartRequest")
+	proto.RegisterType((*RestorePartResponse)(nil), "pspb.RestorePartResponse")
+	proto.RegisterType((*CompactOp)(nil), "pspb.CompactOp")
+	proto.RegisterType((*AutoGCOp)(nil), "pspb.AutoGCOp")
+	proto.RegisterType((*ForceGCOp)(nil), "pspb.ForceGCOp")
+	proto.RegisterType((*MaintenanceRequest)(nil), "pspb.MaintenanceRequest")
+	proto.RegisterType((*MaintenanceResponse)(nil), "pspb.MaintenanceResponse")
+	proto.RegisterType((*HeadRequest)(nil), "pspb.HeadRequest")
+	proto.RegisterType((*HeadResponse)(nil), "pspb.HeadResponse")
+	proto.RegisterType((*HeadInfo)(nil), "pspb.HeadInfo")
+	proto.RegisterType((*StreamPutRequestHeader)(nil), "pspb.StreamPutRequestHeader")
+	proto.RegisterType((*StreamPutRequest)(nil), "pspb.StreamPutRequest")
+	proto.RegisterType((*IngestTablesRequestHeader)(nil), "pspb.IngestTablesRequestHeader")
+	proto.RegisterType((*IngestTablesRequest)(nil), "pspb.IngestTablesRequest")
+	proto.RegisterType((*IngestTablesResponse)(nil), "pspb.IngestTablesResponse")
+	proto.RegisterType((*ArchiveEntry)(nil), "pspb.ArchiveEntry")
+	proto.RegisterType((*ArchiveState)(nil), "pspb.ArchiveState")
+	proto.RegisterMapType((map[uint64]uint64)(nil), "pspb.ArchiveState.BaseSeqsEntry")
+	proto.RegisterMapType((map[uint64]uint64)(nil), "pspb.ArchiveState.SeqsEntry")
+	proto.RegisterType((*ArchiveChunk)(nil), "pspb.ArchiveChunk")
 }
 
-func (m *StreamPutRequestHeader) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("pspb.proto", fileDescriptor_3e3c719c85d382a4) }
+
+var fileDescriptor_3e3c719c85d382a4 = []byte{
+	// 2929 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x3a, 0xcd, 0x6f, 0x1b, 0xc7,
+	0xf5, 0x5c, 0x2e, 0xbf, 0xf6, 0x91, 0x94, 0xa5, 0xb1, 0x62, 0xd3, 0xfc, 0xf9, 0xa7, 0x28, 0xdb,
+	0x22, 0x55, 0x9d, 0x44, 0x4a, 0x64, 0x37, 0xcd, 0x17, 0x12, 0xe8, 0xcb, 0x92, 0x10, 0xdb, 0x54,
+	0x47, 0x72, 0x0c, 0xb4, 0x40, 0x8b, 0x35, 0x39, 0xa2, 0x36, 0x5e, 0xce, 0xae, 0x77, 0x87, 0x32,
+	0x95, 0x53, 0xd1, 0x9e, 0x8b, 0x06, 0x28, 0x7a, 0xeb, 0xa1, 0x40, 0x81, 0x02, 0x3d, 0xf4, 0xd0,
+	0x4b, 0x8f, 0x3d, 0xb7, 0x87, 0x02, 0x01, 0x7a, 0xe9, 0xb1, 0x75, 0x7a, 0xea, 0x9f, 0xd0, 0x53,
+	0x31, 0x5f, 0xbb, 0xb3, 0x4b, 0x32, 0xb6, 0x83, 0x5e, 0xec, 0x7d, 0xef, 0xcd, 0xbc, 0xaf, 0x79,
+	0xef, 0xcd, 0x9b, 0x47, 0x01, 0x44, 0x49, 0xf4, 0x70, 0x3d, 0x8a, 0x43, 0x16, 0xa2, 0x0a, 0xff,
+	0xee, 0x5e, 0x1f, 0x86, 0xe1, 0x30, 0x20, 0x1b, 0x5e, 0xe4, 0x6f, 0x78, 0x94, 0x86, 0xcc, 0x63,
+	0x7e, 0x48, 0x13, 0xb9, 0xc6, 0xbd, 0x0f, 0x80, 0xc9, 0xd0, 0x0f, 0xe9, 0x21, 0x3d, 0x0d, 0xd1,
+	0xff, 0x41, 0x39, 0x1e, 0x76, 0xac, 0x55, 0x6b, 0xad, 0xb9, 0xd9, 0x5c, 0x17, 0xac, 0xb0, 0x47,
+	0x87, 0x04, 0x97, 0xe3, 0x21, 0xba, 0x02, 0xb5, 0x23, 0x2f, 0x66, 0x87, 0xbb, 0x9d, 0xf2, 0xaa,
+	0xb5, 0x56, 0xc1, 0x0a, 0x42, 0x08, 0x2a, 0x47, 0xc7, 0x87, 0xbb, 0x1d, 0x5b, 0x60, 0xc5, 0xb7,
+	0xfb, 0x33, 0x0b, 0xea, 0x92, 0x6f, 0x82, 0x6e, 0x41, 0x3d, 0x96, 0x9f, 0x1d, 0x6b, 0xd5, 0x5e,
+	0x6b, 0x6e, 0x76, 0x15, 0x67, 0x89, 0xd4, 0xff, 0xef, 0x51, 0x16, 0x5f, 0x60, 0xbd, 0xb4, 0x7b,
+	0x07, 0x5a, 0x26, 0x01, 0x2d, 0x82, 0xfd, 0x88, 0x5c, 0x08, 0xdd, 0x2a, 0x98, 0x7f, 0xa2, 0x57,
+	0xa1, 0x7a, 0xee, 0x05, 0x63, 0x22, 0xd4, 0x69, 0x6e, 0x2e, 0x9a, 0x5c, 0xb9, 0x35, 0x58, 0x92,
+	0xdf, 0x2b, 0xbf, 0x63, 0xb9, 0xef, 0x43, 0x55, 0x18, 0x82, 0xba, 0xd0, 0x48, 0x98, 0x17, 0xb3,
+	0x8f, 0x15, 0xaf, 0x16, 0x4e, 0x61, 0x6e, 0x20, 0xa1, 0x03, 0x4e, 0x29, 0x0b, 0x8a, 0x82, 0xdc,
+	0x0f, 0xa1, 0x71, 0x27, 0xec, 0x0b, 0xb7, 0xf1, 0xfd, 0x64, 0xc2, 0x08, 0xe5, 0x6e, 0x90, 0xba,
+	0xa4, 0x30, 0xdf, 0x1f, 0x9e, 0x9e, 0x26, 0x84, 0x89, 0xfd, 0x6d, 0xac, 0x20, 0x37, 0x86, 0x85,
+	0x13, 0xef, 0x61, 0x40, 0x34, 0x93, 0x04, 0xb9, 0x50, 0x09, 0xc2, 0xbe, 0xf6, 0xc7, 0x82, 0xd4,
+	0x5c, 0x93, 0xb1, 0xa0, 0x71, 0x49, 0x41, 0x38, 0xdc, 0xdf, 0x39, 0x26, 0x8f, 0x95, 0xc3, 0x53,
+	0x18, 0xad, 0x42, 0x73, 0x40, 0x02, 0xc2, 0x88, 0x24, 0x4b, 0xcf, 0x9b, 0x28, 0xf7, 0xd7, 0x16,
+	0xb4, 0xf9, 0xf9, 0xf8, 0x9c, 0xe3, 0x5d, 0xc2, 0x3c, 0x74, 0x1d, 0x9c, 0x20, 0x1c, 0x1e, 0xb3,
+	0x98, 0x78, 0x23, 0xc5, 0x30, 0x43, 0x70, 0x6a, 0x1c, 0x3e, 0x51, 0x54, 0xc9, 0x2f, 0x43, 0xa8,
+	0xb8, 0xa8, 0x3f, 0x2b, 0x2e, 0x1a, 0xb9, 0xb8, 0x58, 0x01, 0x18, 0x11, 0xe6, 0x29, 0x9e, 0x8e,
+	0xa0, 0x19, 0x18, 0xf7, 0xa9, 0x05, 0x4b, 0xa9, 0x8a, 0xc7, 0xd4, 0x8b, 0x92, 0xb3, 0x90, 0xf1,
+	0x5d, 0x89, 0xfa, 0x4e, 0x5d, 0x6c, 0x60, 0xb8, 0xb4, 0x28, 0x17, 0x85, 0x12, 0xca, 0x9b, 0x67,
+	0x7f, 0xa5, 0x79, 0x95, 0xa2, 0x79, 0x79, 0x4d, 0xab, 0x45, 0x4d, 0x95, 0xf9, 0xb5, 0xd9, 0xe6,
+	0x5f, 0x07, 0xa7, 0x1f, 0x13, 0x8f, 0x91, 0xc1, 0x16, 0x13, 0x2e, 0xb2, 0x71, 0x86, 0x70, 0xdf,
+	0x81, 0xc6, 0xd1, 0xf1, 0x2e, 0x61, 0x9e, 0x1f, 0xa4, 0x89, 0x62, 0x65, 0x89, 0x82, 0x3a, 0x50,
+	0xf7, 0x06, 0x83, 0x98, 0x24, 0x89, 0xb0, 0xc7, 0xc1, 0x1a, 0x74, 0xdf, 0x07, 0x67, 0xfb, 0x82,
+	0x11, 0x19, 0xb6, 0x59, 0x68, 0x59, 0x66, 0x68, 0x71, 0x7c, 0x40, 0xe8, 0x90, 0x9d, 0xe9, 0x90,
+	0x93, 0x90, 0xfb, 0x4b, 0x0b, 0x9c, 0x43, 0x3a, 0x20, 0x13, 0x91, 0xd6, 0x08, 0x2a, 0xd4, 0x1b,
+	0x11, 0xb1, 0xd7, 0xc1, 0xe2, 0x9b, 0xab, 0xfd, 0x88, 0x5c, 0x1c, 0xc5, 0xe4, 0xd4, 0x9f, 0xa8,
+	0x78, 0xcf, 0x10, 0xe8, 0x3a, 0x34, 0x3e, 0x4d, 0x42, 0x7a, 0xe4, 0xb1, 0x33, 0xe1, 0x4c, 0xe7,
+	0xa0, 0x84, 0x53, 0x0c, 0xda, 0x00, 0xe7, 0xa1, 0x56, 0x4d, 0x78, 0xb3, 0xb9, 0x79, 0x49, 0xba,
+	0x25, 0xd5, 0xf8, 0xa0, 0x84, 0xb3, 0x35, 0xdb, 0x75, 0xa8, 0x9e, 0xfa, 0x24, 0x18, 0xb8, 0x5b,
+	0xd0, 0x94, 0x12, 0xbe, 0x37, 0x0e, 0x99, 0x27, 0x0e, 0x53, 0x6a, 0x20, 0x73, 0x51, 0x41, 0x3c,
+	0xf6, 0x47, 0xde, 0x84, 0x33, 0x4b, 0x74, 0xec, 0x6b, 0xd8, 0xfd, 0xdc, 0x82, 0xe6, 0x09, 0xa1,
+	0x1e, 0x65, 0x77, 0xfc, 0x91, 0x2f, 0x5c, 0xc0, 0x04, 0xa8, 0xcc, 0x53, 0x10, 0x37, 0x30, 0x8c,
+	0x92, 0x23, 0x12, 0x1f, 0x93, 0xbe, 0x8e, 0xf7, 0x14, 0xc1, 0x33, 0x88, 0xab, 0xa7, 0xe9, 0x2a,
+	0x83, 0x0c, 0x14, 0xfa, 0x36, 0xd4, 0x1e, 0x73, 0x25, 0x93, 0x4e, 0x45, 0x64, 0xe9, 0x92, 0xb4,
+	0xd0, 0x50, 0x1f, 0xab, 0x05, 0xee, 0xef, 0x6d, 0x70, 0xb6, 0x83, 0xb0, 0xff, 0x48, 0x24, 0xda,
+	0x9b, 0x00, 0x8c, 0xa7, 0xbb, 0xf0, 0x7f, 0xc7, 0x32, 0x8b, 0xd3, 0x49, 0x8a, 0xc7, 0xc6, 0x1a,
+	0xf4, 0x2a, 0x2c, 0xec, 0x84, 0xa3, 0x88, 0x1f, 0x3b, 0x19, 0x1c, 0xfb, 0x9f, 0x11, 0x75, 0x9a,
+	0x05, 0x2c, 0xba, 0x01, 0x8b, 0xf7, 0x69, 0x61, 0xa5, 0x2d, 0x56, 0x4e, 0xe1, 0x79, 0x4c, 0x9f,
+	0x47, 0x7b, 0xba, 0x54, 0xc9, 0x90, 0x37, 0x30, 0xdc, 0xc5, 0xe7, 0x51, 0x4f, 0xc6, 0x54, 0x55,
+	0xf0, 0x48, 0x61, 0xee, 0xd2, 0x84, 0x3c, 0xbe, 0x37, 0x1e, 0x89, 0x98, 0xaf, 0x60, 0x05, 0xa1,
+	0x77, 0xa1, 0x31, 0xf0, 0x93, 0xbe, 0x17, 0x0f, 0x92, 0x4e, 0x5d, 0x38, 0xe5, 0xff, 0xd5, 0xb1,
+	0x6b, 0xe3, 0xd7, 0x77, 0x15, 0x5d, 0x56, 0xf3, 0x74, 0x39, 0x5a, 0x83, 0x4b, 0x5a, 0x41, 0x3f,
+	0xa4, 0x27, 0x17, 0x11, 0x11, 0xd5, 0xa2, 0x8d, 0x8b, 0x68, 0xf4, 0x4d, 0x68, 0x9f, 0x79, 0xc9,
+	0xc9, 0x84, 0x1e, 0x52, 0xae, 0x6a, 0x22, 0x2a, 0x47, 0x03, 0xe7, 0x91, 0xdd, 0xf7, 0xa1, 0x9d,
+	0x13, 0x35, 0xe3, 0x7e, 0x58, 0x36, 0xef, 0x07, 0xdb, 0xbc, 0x0d, 0x8e, 0xa1, 0x29, 0x34, 0x56,
+	0xe6, 0x1a, 0x5b, 0x5b, 0x72, 0xab, 0x59, 0xe5, 0xcb, 0x73, 0xab, 0xbc, 0x9d, 0xab, 0xf2, 0xbf,
+	0xb1, 0x00, 0xb2, 0xf3, 0x45, 0xaf, 0x41, 0x5d, 0x12, 0x74, 0x95, 0x5f, 0x32, 0x5c, 0x25, 0x05,
+	0x63, 0xbd, 0x42, 0x44, 0x63, 0x10, 0x86, 0xa3, 0xdb, 0x7e, 0xc0, 0x48, 0xac, 0xd2, 0xd1, 0x44,
+	0x71, 0xaf, 0x90, 0x84, 0xf9, 0x23, 0x8f, 0xc9, 0xf3, 0x55, 0x11, 0x9b, 0x47, 0x72, 0x3e, 0x74,
+	0x3c, 0xea, 0x9d, 0x0a, 0x21, 0x89, 0x38, 0xf5, 0x36, 0x36, 0x51, 0xee, 0xa7, 0x00, 0x47, 0x63,
+	0x86, 0xc9, 0xe3, 0x31, 0x49, 0x66, 0x59, 0x9e, 0x73, 0x5a, 0x4b, 0x39, 0x8d, 0xe7, 0xd2, 0xde,
+	0x24, 0xf2, 0x63, 0x92, 0x6c, 0x31, 0x5d, 0x5c, 0x53, 0x84, 0x2e, 0xc9, 0xfe, 0x40, 0x85, 0x99,
+	0x82, 0xdc, 0x97, 0xa1, 0x29, 0x64, 0x25, 0x51, 0x48, 0x13, 0x32, 0x2d, 0xcc, 0x7d, 0x17, 0xda,
+	0xbb, 0xe2, 0xce, 0x9a, 0xaf, 0x4f, 0xc6, 0xbb, 0x9c, 0xe3, 0xed, 0xc2, 0x82, 0xde, 0x3a, 0x97,
+	0xfd, 0xaf, 0x2c, 0x80, 0x7d, 0xc2, 0x5e, 0x98, 0x39, 0x77, 0xb6, 0x17, 0x04, 0xe1, 0x93, 0x7b,
+	0x21, 0xbb, 0x1d, 0x8e, 0xe9, 0x40, 0x98, 0xdc, 0xc0, 0x79, 0x24, 0xcf, 0xb0, 0x27, 0x3e, 0x3b,
+	0xeb, 0xc5, 0xfe, 0xd0, 0xa7, 0xc2, 0xf4, 0x06, 0x36, 0x30, 0x85, 0x9b, 0xac, 0x5a, 0xbc, 0xc9,
+	0xdc, 0xbf, 0x5a, 0xd0, 0x14, 0xea, 0xcd, 0x33, 0x60, 0xce, 0x61, 0x74, 0xa0, 0x7e, 0x4e, 0x62,
+	0x9e, 0x2f, 0xea, 0x28, 0x34, 0xc8, 0xc3, 0x96, 0x6a, 0x95, 0xa5, 0x3e, 0x29, 0xcc, 0x6d, 0x0a,
+	0x85, 0x5e, 0x3b, 0xc1, 0x38, 0xe1, 0x41, 0x26, 0x15, 0xca, 0x23, 0x45, 0xd1, 0x14, 0x08, 0xde,
+	0x56, 0xd4, 0x54, 0xd1, 0xd4, 0x08, 0x4e, 0x25, 0x69, 0x18, 0xd4, 0x25, 0x35, 0x45, 0xb8, 0x7f,
+	0xb2, 0xc0, 0x51, 0xbe, 0xee, 0x45, 0xe8, 0x26, 0x34, 0x63, 0x09, 0xfc, 0x28, 0x1a, 0xb3, 0x7c,
+	0x19, 0xcc, 0x22, 0xf0, 0xa0, 0x84, 0x41, 0x2d, 0x3b, 0x1a, 0x33, 0xf4, 0x01, 0x2c, 0xe8, 0x4d,
+	0xb2, 0x99, 0x51, 0xbd, 0xdd, 0x65, 0xb9, 0x2f, 0x17, 0x2c, 0x07, 0x25, 0xdc, 0x56, 0x8b, 0x25,
+	0xde, 0x14, 0x39, 0x54, 0xe9, 0x99, 0x8a, 0xdc, 0x27, 0x33, 0x44, 0xee, 0x13, 0xb6, 0xed, 0x40,
+	0x5d, 0x41, 0xee, 0x5f, 0x2c, 0x00, 0x7d, 0x1a, 0xbd, 0x08, 0xbd, 0x0d, 0xad, 0x58, 0x41, 0x86,
+	0x09, 0x4b, 0x86, 0x09, 0x92, 0x78, 0x50, 0xc2, 0x4d, 0xbd, 0x90, 0x1b, 0xf1, 0x11, 0x5c, 0x4a,
+	0xf7, 0xe5, 0xac, 0x58, 0xce, 0x5b, 0x91, 0xee, 0x5e, 0xd0, 0xcb, 0x95, 0x1d, 0xa6, 0xe0, 0xcc,
+	0x90, 0x25, 0xc3, 0x90, 0x69, 0xc1, 0xdc, 0x14, 0x80, 0x86, 0x06, 0xdd, 0xb7, 0xa0, 0xb5, 0xed,
+	0xb1, 0xfe, 0x99, 0x0e, 0xfe, 0x57, 0xc0, 0x8e, 0xc9, 0x63, 0x55, 0x8a, 0x2e, 0xe9, 0x56, 0x59,
+	0x1d, 0x16, 0xe6, 0x34, 0x77, 0x13, 0xda, 0x6a, 0x8b, 0x0a, 0x48, 0xb1, 0x27, 0xf9, 0x8a, 0x3d,
+	0x89, 0xfb, 0x5b, 0x0b, 0x5a, 0xb2, 0x15, 0x52, 0x72, 0xe6, 0xdd, 0xe8, 0xcb, 0x50, 0x15, 0x7d,
+	0xb6, 0x0e, 0x65, 0x01, 0x70, 0x6c, 0xc0, 0x2f, 0x71, 0x55, 0x4a, 0x25, 0x30, 0xaf, 0x9e, 0x88,
+	0xde, 0xdd, 0xa7, 0x7d, 0xc2, 0x63, 0x53, 0x46, 0x6f, 0x0a, 0x17, 0x92, 0xad, 0x36, 0x95, 0x6c,
+	0x3f, 0xb7, 0xa0, 0xad, 0x14, 0x55, 0xd6, 0x5d, 0x07, 0x87, 0xc5, 0x63, 0xda, 0xe7, 0xc5, 0x53,
+	0x28, 0xdb, 0xc0, 0x19, 0x82, 0xb7, 0x4c, 0x8f, 0xc8, 0x05, 0xef, 0x3e, 0xec, 0xb5, 0x16, 0x16,
+	0xdf, 0x3c, 0xf1, 0xe4, 0x79, 0xf2, 0x82, 0x60, 0xaf, 0x35, 0xb0, 0x06, 0x79, 0xea, 0x26, 0xe4,
+	0xb1, 0x52, 0x97, 0x7f, 0x16, 0x3b, 0xf4, 0xea, 0x74, 0x87, 0xfe, 0x53, 0x0b, 0x96, 0x64, 0x2b,
+	0x60, 0xfa, 0x6f, 0x19, 0xaa, 0x7e, 0xda, 0x37, 0x38, 0x58, 0x02, 0x73, 0x0a, 0xc1, 0x32, 0x54,
+	0xc9, 0xc4, 0xeb, 0x33, 0x55, 0x9e, 0x24, 0x90, 0xf9, 0xb4, 0x32, 0xdb, 0xa7, 0xd5, 0x5c, 0x1d,
+	0xbd, 0x05, 0x20, 0x94, 0x90, 0x97, 0x68, 0x2a, 0xc7, 0x32, 0xe5, 0xa8, 0xc2, 0x54, 0xce, 0x2a,
+	0xeb, 0x0f, 0x01, 0x99, 0xaa, 0x3f, 0x97, 0x47, 0x6f, 0x40, 0x9d, 0x50, 0x16, 0xfb, 0x44, 0x3a,
+	0x35, 0xcd, 0xcc, 0x4c, 0x3c, 0xd6, 0x0b, 0xdc, 0x18, 0x5a, 0x0f, 0xcc, 0xe8, 0x9d, 0x17, 0x55,
+	0x1d, 0xa8, 0x9f, 0xc6, 0xe1, 0x28, 0x7b, 0x22, 0x69, 0x50, 0x53, 0xee, 0x85, 0x4f, 0x94, 0x77,
+	0x34, 0x38, 0xf7, 0xb6, 0xfa, 0x8f, 0x05, 0x20, 0x84, 0xee, 0x9d, 0x13, 0xca, 0xd0, 0x3a, 0x54,
+	0x18, 0xef, 0x52, 0xb8, 0xc0, 0x05, 0xfd, 0x64, 0xcd, 0xe8, 0xeb, 0xe2, 0x5f, 0xde, 0xb0, 0x60,
+	0xb1, 0x6e, 0xda, 0x49, 0x99, 0x33, 0xed, 0xc2, 0x55, 0x9a, 0xd5, 0xd0, 0x4a, 0xa1, 0x86, 0xea,
+	0x40, 0xaa, 0x66, 0x81, 0x34, 0x55, 0xb7, 0x6b, 0xcf, 0xac, 0xdb, 0xf5, 0x42, 0xdd, 0x76, 0x57,
+	0xc1, 0x49, 0xd5, 0x45, 0x75, 0xb0, 0x8f, 0xee, 0x9f, 0x2c, 0x96, 0x10, 0x40, 0x6d, 0x77, 0xef,
+	0xce, 0xde, 0xc9, 0xde, 0xa2, 0xe5, 0x9e, 0x43, 0xfb, 0x41, 0x2e, 0xf7, 0xd7, 0xa0, 0x46, 0xce,
+	0x45, 0xfb, 0x65, 0x99, 0x87, 0x95, 0x39, 0x00, 0x2b, 0x3a, 0xcf, 0xbc, 0xfe, 0x19, 0xe9, 0x3f,
+	0x8a, 0x42, 0x9f, 0x32, 0x75, 0x0c, 0x06, 0x46, 0x3d, 0x9e, 0xec, 0x99, 0x8f, 0x27, 0xf7, 0x33,
+	0x70, 0x4e, 0x26, 0x14, 0x93, 0x7e, 0x18, 0x0f, 0xb8, 0xc3, 0xd8, 0x84, 0xaa, 0x07, 0x92, 0x83,
+	0x25, 0x80, 0xbe, 0x05, 0xb5, 0x84, 0x79, 0x6c, 0x2c, 0x5f, 0x02, 0x0b, 0xba, 0x10, 0x9d, 0x4c,
+	0xe8, 0xb1, 0x40, 0x63, 0x45, 0xe6, 0xe5, 0x61, 0x40, 0xbc, 0x41, 0xe0, 0x53, 0xe9, 0x72, 0x1b,
+	0xa7, 0x70, 0x9a, 0xce, 0x95, 0x2c, 0x9d, 0xdd, 0x1e, 0x38, 0x69, 0x43, 0x39, 0x47, 0xf6, 0x15,
+	0xa8, 0x19, 0x15, 0xbc, 0x81, 0x15, 0x34, 0xfb, 0x68, 0xdd, 0xdf, 0xf1, 0x97, 0xc9, 0x84, 0xde,
+	0x1d, 0xcb, 0x11, 0xcb, 0x73, 0x5f, 0xe8, 0x99, 0x14, 0x3b, 0x27, 0xc5, 0x85, 0x96, 0xf0, 0xe3,
+	0x27, 0xea, 0xb6, 0x97, 0x57, 0x7a, 0x0e, 0x67, 0x36, 0x03, 0xd5, 0x7c, 0x33, 0xc0, 0xdf, 0xa5,
+	0x7c, 0x65, 0x8f, 0x06, 0x17, 0x22, 0x68, 0x1a, 0x38, 0x43, 0xb8, 0x31, 0x2c, 0x9d, 0x4c, 0xe8,
+	0x51, 0x4c, 0x22, 0x2f, 0x36, 0x8b, 0xcf, 0x0c, 0x27, 0x6c, 0x80, 0x33, 0x52, 0x26, 0xe9, 0xd4,
+	0x5d, 0x4a, 0xcf, 0x40, 0x1b, 0x8b, 0xb3, 0x35, 0x46, 0x86, 0xd9, 0xb9, 0x0c, 0x5b, 0x06, 0x64,
+	0xca, 0x54, 0x37, 0xd5, 0x48, 0x68, 0x82, 0x49, 0x12, 0x06, 0xe7, 0xcf, 0xd0, 0x64, 0x56, 0x51,
+	0xbe, 0x02, 0xb5, 0x7e, 0x38, 0xd2, 0x77, 0x48, 0x03, 0x2b, 0x68, 0x6e, 0x9a, 0x4b, 0x25, 0x52,
+	0x71, 0x4a, 0x89, 0x3f, 0x58, 0xd0, 0xc4, 0x24, 0x0a, 0x7c, 0x5e, 0xab, 0x7a, 0xd1, 0x8b, 0x34,
+	0xc6, 0xa4, 0xd8, 0x18, 0xa7, 0x08, 0xf3, 0xc2, 0x90, 0x67, 0xa7, 0xc1, 0xff, 0x45, 0x37, 0xe6,
+	0x8e, 0x60, 0x31, 0x55, 0x59, 0xfb, 0xed, 0x1b, 0x60, 0x87, 0x51, 0xe1, 0xc5, 0x61, 0xd8, 0x85,
+	0x39, 0x55, 0x44, 0x86, 0x94, 0x90, 0x3e, 0x6f, 0x32, 0xc4, 0xdc, 0xd3, 0x7b, 0x03, 0x96, 0x0c,
+	0x71, 0xaa, 0x4c, 0xf0, 0xf1, 0x45, 0x14, 0x05, 0xbe, 0x2a, 0xf8, 0x6d, 0xac, 0x41, 0x77, 0x00,
+	0x2f, 0xe9, 0xe5, 0x7e, 0x48, 0x77, 0xb2, 0x7a, 0xa0, 0x4a, 0x9c, 0x95, 0x95, 0x38, 0x59, 0x21,
+	0xca, 0x73, 0xc7, 0x2b, 0xe3, 0x68, 0xa0, 0xc6, 0x2b, 0x32, 0xad, 0x33, 0x84, 0x7b, 0x03, 0x16,
+	0x8f, 0xa3, 0xc0, 0x67, 0x7c, 0x8e, 0x64, 0x5e, 0x16, 0xd2, 0x00, 0x2b, 0x67, 0xc0, 0x65, 0x58,
+	0x32, 0xd6, 0xaa, 0x83, 0x7f, 0x03, 0x2e, 0xeb, 0xd1, 0xd3, 0xf3, 0xf0, 0xf8, 0x18, 0x96, 0xf3,
+	0xcb, 0x95, 0x1f, 0x6e, 0x42, 0x43, 0x37, 0x1b, 0xaa, 0x4f, 0xbc, 0xaa, 0xfa, 0xc4, 0xe2, 0x80,
+	0x0b, 0xa7, 0x0b, 0xdd, 0x3b, 0x80, 0x30, 0x49, 0x58, 0x18, 0x93, 0xe7, 0x10, 0x5d, 0xe8, 0x70,
+	0xca, 0x53, 0x1d, 0xce, 0x4b, 0x70, 0x39, 0xc7, 0x4d, 0x19, 0xd8, 0x04, 0x87, 0xbf, 0xb0, 0xbd,
+	0x3e, 0xeb, 0x45, 0x2e, 0x40, 0x63, 0x6b, 0xcc, 0xc2, 0xfd, 0x9d, 0x5e, 0xe4, 0xbe, 0x02, 0xce,
+	0xed, 0x30, 0xee, 0x13, 0x0e, 0xc8, 0x56, 0xe2, 0x70, 0x57, 0x46, 0x4e, 0x05, 0x4b, 0xc0, 0xfd,
+	0xa3, 0x05, 0xe8, 0xae, 0xe7, 0x53, 0x31, 0x51, 0xe9, 0x93, 0x67, 0x69, 0xf8, 0x1a, 0xd4, 0xfb,
+	0x52, 0x94, 0x3a, 0x4c, 0x55, 0xaa, 0x53, 0xf9, 0x07, 0x25, 0xac, 0x57, 0xf0, 0x0b, 0xc6, 0x1b,
+	0xb3, 0x70, 0xd8, 0x57, 0x57, 0x83, 0x1a, 0x82, 0x6a, 0xf5, 0x0e, 0x4a, 0x58, 0xd1, 0x39, 0xdb,
+	0x53, 0xae, 0xe8, 0xb0, 0x9f, 0x9f, 0x35, 0xa5, 0xda, 0x73, 0xb6, 0x6a, 0xc5, 0x76, 0x05, 0xca,
+	0xbd, 0x23, 0xee, 0x8b, 0x9c, 0xde, 0xca, 0x17, 0x0f, 0xa0, 0x79, 0x40, 0xbc, 0xc1, 0x8b, 0x3f,
+	0x08, 0xf3, 0xbe, 0xb7, 0xa7, 0x7c, 0xbf, 0x09, 0x2d, 0xc9, 0x58, 0x85, 0x83, 0x0b, 0x15, 0x9f,
+	0x9e, 0x86, 0x1d, 0xcb, 0x34, 0x8d, 0xaf, 0x10, 0x73, 0x69, 0x41, 0x73, 0xd7, 0xa1, 0xa1, 0x31,
+	0x33, 0x34, 0x59, 0x04, 0x3b, 0x20, 0x54, 0xf5, 0xc5, 0xfc, 0xd3, 0xfd, 0xb1, 0x05, 0x57, 0xe4,
+	0x3c, 0xd2, 0x78, 0x3e, 0x11, 0x6f, 0x40, 0xe2, 0x19, 0xdb, 0x57, 0x00, 0x02, 0x42, 0x7b, 0xa7,
+	0x9f, 0xa4, 0x25, 0xab, 0x8d, 0x0d, 0xcc, 0xd7, 0x7c, 0xd0, 0x53, 0x58, 0x2c, 0x6a, 0x80, 0xde,
+	0x86, 0xda, 0x99, 0xd0, 0x42, 0x19, 0x7b, 0x5d, 0x1a, 0x3b, 0x5b, 0x53, 0x7e, 0xaa, 0x72, 0x35,
+	0xea, 0x42, 0x3d, 0xf2, 0x2e, 0x82, 0xd0, 0x93, 0xbe, 0x6e, 0xf1, 0x43, 0x54, 0x88, 0xed, 0x1a,
+	0x54, 0x06, 0x1e, 0xf3, 0xdc, 0xfb, 0x70, 0xed, 0x90, 0x0e, 0x49, 0xc2, 0xc4, 0x5c, 0x25, 0xc9,
+	0x1b, 0x3d, 0x2f, 0x0a, 0xf5, 0x0c, 0x44, 0xee, 0x51, 0xb6, 0x9b, 0x28, 0x97, 0xc1, 0xe5, 0x19,
+	0x6c, 0xd1, 0xbb, 0x05, 0x4b, 0x5e, 0xd6, 0xfd, 0xe9, 0x1c, 0x0d, 0x5e, 0xd0, 0x98, 0x35, 0x58,
+	0xce, 0xb3, 0xca, 0x9e, 0xfd, 0xf9, 0x7a, 0xe8, 0x52, 0x68, 0x6d, 0xc5, 0xfd, 0x33, 0xff, 0x9c,
+	0x4c, 0x8d, 0xb6, 0x9e, 0x35, 0x18, 0xc8, 0xde, 0x27, 0xb9, 0xeb, 0xe6, 0x2b, 0x9b, 0x4e, 0xf7,
+	0x9f, 0x76, 0x2a, 0x90, 0xb7, 0x54, 0x64, 0x6e, 0xbb, 0xfd, 0x01, 0x34, 0x1e, 0x7a, 0x09, 0x7f,
+	0x6f, 0xe9, 0x46, 0x60, 0x55, 0x65, 0xad, 0xb1, 0x7b, 0x7d, 0x5b, 0x2d, 0x51, 0x23, 0x40, 0xbd,
+	0x03, 0xbd, 0x09, 0x95, 0x84, 0xef, 0xb4, 0x57, 0xed, 0x2c, 0x4e, 0x72, 0x3b, 0xb3, 0x5d, 0x62,
+	0x25, 0x9f, 0x8b, 0x0e, 0x42, 0x4a, 0xd2, 0x1a, 0x2a, 0xfb, 0xb7, 0x0a, 0x2e, 0x60, 0x8d, 0xdf,
+	0x04, 0xaa, 0xb9, 0xdf, 0x04, 0x3a, 0x50, 0x0f, 0xbc, 0x44, 0xfc, 0xd6, 0x53, 0x13, 0x86, 0x68,
+	0x90, 0x3b, 0xb0, 0x1f, 0x8e, 0xa9, 0x9e, 0x62, 0x48, 0x80, 0xf7, 0x17, 0x9c, 0xb3, 0x98, 0x4c,
+	0x36, 0xb0, 0xf8, 0xce, 0xfd, 0x60, 0xe4, 0x14, 0x7e, 0x30, 0xba, 0x09, 0x0b, 0x64, 0x12, 0x85,
+	0x31, 0x23, 0x03, 0x71, 0x61, 0x25, 0x1d, 0x58, 0xb5, 0x8b, 0x97, 0x58, 0x61, 0x09, 0x9f, 0x5c,
+	0xe6, 0x3c, 0xf4, 0xac, 0xc9, 0x65, 0xc5, 0x98, 0x5c, 0x76, 0xbf, 0x0b, 0xce, 0xd7, 0xda, 0xe8,
+	0x9e, 0xa6, 0x47, 0xbc, 0x73, 0x36, 0xa6, 0x8f, 0xd0, 0xeb, 0xd9, 0x6b, 0x4c, 0x36, 0x0b, 0x28,
+	0x77, 0x1e, 0xf9, 0xf7, 0x18, 0x5a, 0x13, 0xaf, 0xf7, 0x74, 0x90, 0x81, 0xa6, 0xcf, 0x0e, 0xcb,
+	0x05, 0x37, 0x6e, 0x89, 0xa6, 0x5a, 0x76, 0xe6, 0xa8, 0x09, 0xf5, 0xa3, 0xbd, 0x7b, 0xbb, 0x87,
+	0xf7, 0xf6, 0x17, 0x4b, 0xa8, 0x0d, 0xce, 0x4e, 0xef, 0xee, 0xdd, 0xc3, 0x93, 0x93, 0xbd, 0xdd,
+	0x45, 0x8b, 0xd3, 0xb6, 0xb6, 0x7b, 0x98, 0x03, 0xe5, 0xcd, 0x7f, 0x37, 0xa0, 0x99, 0x9e, 0xe7,
+	0xc7, 0x9f, 0xa0, 0x4d, 0xa8, 0x8a, 0x51, 0x04, 0x52, 0x92, 0xcc, 0x51, 0x46, 0xf7, 0x72, 0x0e,
+	0xa7, 0x4a, 0x7b, 0x09, 0xbd, 0x0e, 0x36, 0x9f, 0xbe, 0x4c, 0x8d, 0x98, 0xba, 0xd3, 0x13, 0x1b,
+	0xb7, 0x84, 0x76, 0xa0, 0xc2, 0xb3, 0x18, 0x2d, 0x65, 0xb5, 0x59, 0xaf, 0x47, 0x26, 0x4a, 0x6d,
+	0x58, 0xfe, 0xc9, 0xdf, 0xfe, 0xf5, 0x8b, 0xf2, 0x02, 0x6a, 0x89, 0x9f, 0x51, 0xcf, 0xdf, 0xda,
+	0xe0, 0x89, 0x8f, 0x3e, 0x02, 0x7b, 0x9f, 0xa4, 0x22, 0xf7, 0x49, 0x51, 0xa4, 0x31, 0xab, 0x71,
+	0x2f, 0x0b, 0x0e, 0x6d, 0xd4, 0xd4, 0x1c, 0x86, 0x84, 0xa1, 0xef, 0x40, 0x4d, 0xcd, 0x7c, 0x66,
+	0x4d, 0xb8, 0xba, 0x33, 0x07, 0x46, 0x6e, 0x09, 0xed, 0xeb, 0x5f, 0x33, 0x91, 0x19, 0x68, 0x79,
+	0xf7, 0xe4, 0x9e, 0xe6, 0xee, 0x4b, 0x42, 0xfa, 0x25, 0xd4, 0xd6, 0xd2, 0x63, 0xb1, 0xff, 0x3d,
+	0x70, 0xd2, 0x42, 0x8d, 0xae, 0xcc, 0xae, 0xdc, 0x33, 0xfd, 0xb7, 0x66, 0xa1, 0x1f, 0xa8, 0xc9,
+	0x81, 0xd4, 0xe4, 0xaa, 0xf1, 0x98, 0xcf, 0xa9, 0xd3, 0x99, 0x26, 0x28, 0x26, 0x5d, 0xa1, 0xd3,
+	0x32, 0x42, 0x5a, 0x27, 0x31, 0xeb, 0x90, 0x8a, 0xdd, 0x82, 0xea, 0x03, 0x33, 0x00, 0x1e, 0xcc,
+	0x08, 0x80, 0x07, 0xf9, 0x00, 0x78, 0xd3, 0x42, 0x5b, 0x00, 0xd9, 0x03, 0x43, 0xab, 0x34, 0xf5,
+	0xcc, 0xe9, 0x76, 0xa6, 0x09, 0xa9, 0x6b, 0x25, 0x0b, 0xf5, 0x3c, 0x30, 0x58, 0xe4, 0xdf, 0x27,
+	0xdd, 0xce, 0x34, 0x21, 0x65, 0x71, 0x08, 0x2d, 0xb3, 0xd0, 0xa3, 0x6b, 0x73, 0xef, 0x91, 0x6e,
+	0x77, 0x16, 0xc9, 0xf0, 0xf1, 0x87, 0xe0, 0xe8, 0x26, 0x9a, 0xe8, 0xf3, 0x29, 0xf6, 0xfc, 0xdd,
+	0xab, 0x53, 0xf8, 0x54, 0x95, 0x0f, 0xc1, 0x49, 0x5b, 0xde, 0xf4, 0x7c, 0x0b, 0xfd, 0x72, 0xf7,
+	0xea, 0x14, 0xde, 0x08, 0xb4, 0x96, 0xd9, 0xee, 0x6a, 0x53, 0x66, 0x74, 0xcc, 0xdd, 0xee, 0x2c,
+	0x52, 0xca, 0x68, 0x17, 0x9a, 0x46, 0x73, 0x8a, 0x3a, 0x5a, 0xe5, 0x62, 0xf7, 0xdb, 0xbd, 0x36,
+	0x83, 0x62, 0x72, 0x31, 0xda, 0x3a, 0xcd, 0x65, 0xba, 0x43, 0xed, 0x5e, 0x9b, 0x41, 0xd1, 0x5c,
+	0xb6, 0x6f, 0xff, 0xf9, 0xe9, 0x8a, 0xf5, 0xc5, 0xd3, 0x15, 0xeb, 0x1f, 0x4f, 0x57, 0xac, 0xcf,
+	0xbf, 0x5c, 0x29, 0x7d, 0xf1, 0xe5, 0x4a, 0xe9, 0xef, 0x5f, 0xae, 0x94, 0xbe, 0xff, 0xfa, 0xd0,
+	0x67, 0x67, 0xe3, 0x87, 0xeb, 0xfd, 0x70, 0xb4, 0xf1, 0x69, 0x38, 0x8e, 0x29, 0xb9, 0x18, 0xf9,
+	0x03, 0xea, 0x0f, 0xcf, 0xd8, 0x86, 0x37, 0x66, 0xe3, 0x11, 0xdd, 0x10, 0x7f, 0x35, 0xb1, 0xc1,
+	0xb9, 0x3f, 0xac, 0x89, 0xef, 0x9b, 0xff, 0x1d, 0x00, 0xc8, 0x8c, 0x12, 0xcb, 0x73, 0x21, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// PartitionKVClient is the client API for PartitionKV service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PartitionKVClient interface {
+	Batch(ctx context.Context, in *BatchRequest, opts ...grpc.CallOption) (*BatchResponse, error)
+	Put(ctx context.Context, in *PutRequest, opts ...grpc.CallOption) (*PutResponse, error)
+	Head(ctx context.Context, in *HeadRequest, opts ...grpc.CallOption) (*HeadResponse, error)
+	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
+	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	Range(ctx context.Context, in *RangeRequest, opts ...grpc.CallOption) (*RangeResponse, error)
+	StreamPut(ctx context.Context, opts ...grpc.CallOption) (PartitionKV_StreamPutClient, error)
+	IndexRange(ctx context.Context, in *IndexRangeRequest, opts ...grpc.CallOption) (*IndexRangeResponse, error)
+	//Watch returns status OutOfRange if events after fromSeq have been GC'd
+	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (PartitionKV_WatchClient, error)
+	//TxnPrepare writes intents, returns status Aborted if any key conflicts
+	TxnPrepare(ctx context.Context, in *TxnPrepareRequest, opts ...grpc.CallOption) (*TxnPrepareResponse, error)
+	TxnResolve(ctx context.Context, in *TxnResolveRequest, opts ...grpc.CallOption) (*TxnResolveResponse, error)
+	//IngestTables adds tables built out of the cluster to a partition at once
+	IngestTables(ctx context.Context, opts ...grpc.CallOption) (PartitionKV_IngestTablesClient, error)
+	Replicate(ctx context.Context, in *ReplicateRequest, opts ...grpc.CallOption) (*ReplicateResponse, error)
+	//ps management API
+	//system performace
+	SplitPart(ctx context.Context, in *SplitPartRequest, opts ...grpc.CallOption) (*SplitPartResponse, error)
+	SnapshotPart(ctx context.Context, in *SnapshotPartRequest, opts ...grpc.CallOption) (*SnapshotPartResponse, error)
+	RestorePart(ctx context.Context, in *RestorePartRequest, opts ...grpc.CallOption) (*RestorePartResponse, error)
+	Maintenance(ctx context.Context, in *MaintenanceRequest, opts ...grpc.CallOption) (*MaintenanceResponse, error)
+}
+
+type partitionKVClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewPartitionKVClient(cc *grpc.ClientConn) PartitionKVClient {
+	return &partitionKVClient{cc}
+}
+
+func (c *partitionKVClient) Batch(ctx context.Context, in *BatchRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, "/pspb.PartitionKV/Batch", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *StreamPutRequestHeader) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *partitionKVClient) Put(ctx context.Context, in *PutRequest, opts ...grpc.CallOption) (*PutResponse, error) {
+	out := new(PutResponse)
+	err := c.cc.Invoke(ctx, "/pspb.PartitionKV/Put", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *StreamPutRequestHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Partid != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.Partid))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.ExpiresAt != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.ExpiresAt))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.LenOfValue != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.LenOfValue))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintPspb(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+func (c *partitionKVClient) Head(ctx context.Context, in *HeadRequest, opts ...grpc.CallOption) (*HeadResponse, error) {
+	out := new(HeadResponse)
+	err := c.cc.Invoke(ctx, "/pspb.PartitionKV/Head", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *StreamPutRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *partitionKVClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error) {
+	out := new(GetResponse)
+	err := c.cc.Invoke(ctx, "/pspb.PartitionKV/Get", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *StreamPutRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *partitionKVClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, "/pspb.PartitionKV/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *StreamPutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Data != nil {
-		{
-			size := m.Data.Size()
-			i -= size
-			if _, err := m.Data.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+func (c *partitionKVClient) Range(ctx context.Context, in *RangeRequest, opts ...grpc.CallOption) (*RangeResponse, error) {
+	out := new(RangeResponse)
+	err := c.cc.Invoke(ctx, "/pspb.PartitionKV/Range", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *StreamPutRequest_Header) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *partitionKVClient) StreamPut(ctx context.Context, opts ...grpc.CallOption) (PartitionKV_StreamPutClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_PartitionKV_serviceDesc.Streams[0], "/pspb.PartitionKV/StreamPut", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &partitionKVStreamPutClient{stream}
+	return x, nil
 }
 
-func (m *StreamPutRequest_Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Header != nil {
-		{
-			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPspb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type PartitionKV_StreamPutClient interface {
+	Send(*StreamPutRequest) error
+	CloseAndRecv() (*PutResponse, error)
+	grpc.ClientStream
 }
-func (m *StreamPutRequest_Payload) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+type partitionKVStreamPutClient struct {
+	grpc.ClientStream
 }
 
-func (m *StreamPutRequest_Payload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Payload != nil {
-		i -= len(m.Payload)
-		copy(dAtA[i:], m.Payload)
-		i = encodeVarintPspb(dAtA, i, uint64(len(m.Payload)))
-		i--
-		dAtA[i] = 0x12
-	}
-	return len(dAtA) - i, nil
-}
-func encodeVarintPspb(dAtA []byte, offset int, v uint64) int {
-	offset -= sovPspb(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (x *partitionKVStreamPutClient) Send(m *StreamPutRequest) error {
+	return x.ClientStream.SendMsg(m)
 }
-func (m *RegionInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Rg != nil {
-		l = m.Rg.Size()
-		n += 1 + l + sovPspb(uint64(l))
-	}
-	if m.PartID != 0 {
-		n += 1 + sovPspb(uint64(m.PartID))
+
+func (x *partitionKVStreamPutClient) CloseAndRecv() (*PutResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	if m.PSID != 0 {
-		n += 1 + sovPspb(uint64(m.PSID))
+	m := new(PutResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return n
+	return m, nil
 }
 
-func (m *Regions) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Regions) > 0 {
-		for k, v := range m.Regions {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovPspb(uint64(l))
-			}
-			mapEntrySize := 1 + sovPspb(uint64(k)) + l
-			n += mapEntrySize + 1 + sovPspb(uint64(mapEntrySize))
-		}
+func (c *partitionKVClient) IndexRange(ctx context.Context, in *IndexRangeRequest, opts ...grpc.CallOption) (*IndexRangeResponse, error) {
+	out := new(IndexRangeResponse)
+	err := c.cc.Invoke(ctx, "/pspb.PartitionKV/IndexRange", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *Range) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *partitionKVClient) Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (PartitionKV_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_PartitionKV_serviceDesc.Streams[1], "/pspb.PartitionKV/Watch", opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.StartKey)
-	if l > 0 {
-		n += 1 + l + sovPspb(uint64(l))
+	x := &partitionKVWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	l = len(m.EndKey)
-	if l > 0 {
-		n += 1 + l + sovPspb(uint64(l))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return n
+	return x, nil
 }
 
-func (m *Location) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ExtentID != 0 {
-		n += 1 + sovPspb(uint64(m.ExtentID))
-	}
-	if m.Offset != 0 {
-		n += 1 + sovPspb(uint64(m.Offset))
-	}
-	return n
+type PartitionKV_WatchClient interface {
+	Recv() (*WatchResponse, error)
+	grpc.ClientStream
 }
 
-func (m *TableLocations) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Locs) > 0 {
-		for _, e := range m.Locs {
-			l = e.Size()
-			n += 1 + l + sovPspb(uint64(l))
-		}
-	}
-	return n
+type partitionKVWatchClient struct {
+	grpc.ClientStream
 }
 
-func (m *PartitionMeta) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.LogStream != 0 {
-		n += 1 + sovPspb(uint64(m.LogStream))
-	}
-	if m.RowStream != 0 {
-		n += 1 + sovPspb(uint64(m.RowStream))
-	}
-	if m.Rg != nil {
-		l = m.Rg.Size()
-		n += 1 + l + sovPspb(uint64(l))
-	}
-	if m.PartID != 0 {
-		n += 1 + sovPspb(uint64(m.PartID))
-	}
-	if m.MetaStream != 0 {
-		n += 1 + sovPspb(uint64(m.MetaStream))
+func (x *partitionKVWatchClient) Recv() (*WatchResponse, error) {
+	m := new(WatchResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return n
+	return m, nil
 }
 
-func (m *PSDetail) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PSID != 0 {
-		n += 1 + sovPspb(uint64(m.PSID))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovPspb(uint64(l))
+func (c *partitionKVClient) TxnPrepare(ctx context.Context, in *TxnPrepareRequest, opts ...grpc.CallOption) (*TxnPrepareResponse, error) {
+	out := new(TxnPrepareResponse)
+	err := c.cc.Invoke(ctx, "/pspb.PartitionKV/TxnPrepare", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *BlockMeta) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TableIndex != nil {
-		l = m.TableIndex.Size()
-		n += 1 + l + sovPspb(uint64(l))
-	}
-	if m.CompressedSize != 0 {
-		n += 1 + sovPspb(uint64(m.CompressedSize))
-	}
-	if m.UnCompressedSize != 0 {
-		n += 1 + sovPspb(uint64(m.UnCompressedSize))
-	}
-	if m.VpExtentID != 0 {
-		n += 1 + sovPspb(uint64(m.VpExtentID))
-	}
-	if m.VpOffset != 0 {
-		n += 1 + sovPspb(uint64(m.VpOffset))
+func (c *partitionKVClient) TxnResolve(ctx context.Context, in *TxnResolveRequest, opts ...grpc.CallOption) (*TxnResolveResponse, error) {
+	out := new(TxnResolveResponse)
+	err := c.cc.Invoke(ctx, "/pspb.PartitionKV/TxnResolve", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.SeqNum != 0 {
-		n += 1 + sovPspb(uint64(m.SeqNum))
+	return out, nil
+}
+
+func (c *partitionKVClient) IngestTables(ctx context.Context, opts ...grpc.CallOption) (PartitionKV_IngestTablesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_PartitionKV_serviceDesc.Streams[2], "/pspb.PartitionKV/IngestTables", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Discards) > 0 {
-		for k, v := range m.Discards {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + sovPspb(uint64(k)) + 1 + sovPspb(uint64(v))
-			n += mapEntrySize + 1 + sovPspb(uint64(mapEntrySize))
-		}
+	x := &partitionKVIngestTablesClient{stream}
+	return x, nil
+}
+
+type PartitionKV_IngestTablesClient interface {
+	Send(*IngestTablesRequest) error
+	CloseAndRecv() (*IngestTablesResponse, error)
+	grpc.ClientStream
+}
+
+type partitionKVIngestTablesClient struct {
+	grpc.ClientStream
+}
+
+func (x *partitionKVIngestTablesClient) Send(m *IngestTablesRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *partitionKVIngestTablesClient) CloseAndRecv() (*IngestTablesResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	if m.CompressionType != 0 {
-		n += 1 + sovPspb(uint64(m.CompressionType))
+	m := new(IngestTablesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return n
+	return m, nil
 }
 
-func (m *BlockOffset) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *partitionKVClient) Replicate(ctx context.Context, in *ReplicateRequest, opts ...grpc.CallOption) (*ReplicateResponse, error) {
+	out := new(ReplicateResponse)
+	err := c.cc.Invoke(ctx, "/pspb.PartitionKV/Replicate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovPspb(uint64(l))
+	return out, nil
+}
+
+func (c *partitionKVClient) SplitPart(ctx context.Context, in *SplitPartRequest, opts ...grpc.CallOption) (*SplitPartResponse, error) {
+	out := new(SplitPartResponse)
+	err := c.cc.Invoke(ctx, "/pspb.PartitionKV/SplitPart", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.ExtentID != 0 {
-		n += 1 + sovPspb(uint64(m.ExtentID))
+	return out, nil
+}
+
+func (c *partitionKVClient) SnapshotPart(ctx context.Context, in *SnapshotPartRequest, opts ...grpc.CallOption) (*SnapshotPartResponse, error) {
+	out := new(SnapshotPartResponse)
+	err := c.cc.Invoke(ctx, "/pspb.PartitionKV/SnapshotPart", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Offset != 0 {
-		n += 1 + sovPspb(uint64(m.Offset))
+	return out, nil
+}
+
+func (c *partitionKVClient) RestorePart(ctx context.Context, in *RestorePartRequest, opts ...grpc.CallOption) (*RestorePartResponse, error) {
+	out := new(RestorePartResponse)
+	err := c.cc.Invoke(ctx, "/pspb.PartitionKV/RestorePart", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *TableIndex) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *partitionKVClient) Maintenance(ctx context.Context, in *MaintenanceRequest, opts ...grpc.CallOption) (*MaintenanceResponse, error) {
+	out := new(MaintenanceResponse)
+	err := c.cc.Invoke(ctx, "/pspb.PartitionKV/Maintenance", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Offsets) > 0 {
-		for _, e := range m.Offsets {
-			l = e.Size()
-			n += 1 + l + sovPspb(uint64(l))
-		}
+	return out, nil
+}
+
+// PartitionKVServer is the server API for PartitionKV service.
+type PartitionKVServer interface {
+	Batch(context.Context, *BatchRequest) (*BatchResponse, error)
+	Put(context.Context, *PutRequest) (*PutResponse, error)
+	Head(context.Context, *HeadRequest) (*HeadResponse, error)
+	Get(context.Context, *GetRequest) (*GetResponse, error)
+	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
+	Range(context.Context, *RangeRequest) (*RangeResponse, error)
+	StreamPut(PartitionKV_StreamPutServer) error
+	IndexRange(context.Context, *IndexRangeRequest) (*IndexRangeResponse, error)
+	//Watch returns status OutOfRange if events after fromSeq have been GC'd
+	Watch(*WatchRequest, PartitionKV_WatchServer) error
+	//TxnPrepare writes intents, returns status Aborted if any key conflicts
+	TxnPrepare(context.Context, *TxnPrepareRequest) (*TxnPrepareResponse, error)
+	TxnResolve(context.Context, *TxnResolveRequest) (*TxnResolveResponse, error)
+	//IngestTables adds tables built out of the cluster to a partition at once
+	IngestTables(PartitionKV_IngestTablesServer) error
+	Replicate(context.Context, *ReplicateRequest) (*ReplicateResponse, error)
+	//ps management API
+	//system performace
+	SplitPart(context.Context, *SplitPartRequest) (*SplitPartResponse, error)
+	SnapshotPart(context.Context, *SnapshotPartRequest) (*SnapshotPartResponse, error)
+	RestorePart(context.Context, *RestorePartRequest) (*RestorePartResponse, error)
+	Maintenance(context.Context, *MaintenanceRequest) (*MaintenanceResponse, error)
+}
+
+// UnimplementedPartitionKVServer can be embedded to have forward compatible implementations.
+type UnimplementedPartitionKVServer struct {
+}
+
+func (*UnimplementedPartitionKVServer) Batch(ctx context.Context, req *BatchRequest) (*BatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Batch not implemented")
+}
+func (*UnimplementedPartitionKVServer) Put(ctx context.Context, req *PutRequest) (*PutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Put not implemented")
+}
+func (*UnimplementedPartitionKVServer) Head(ctx context.Context, req *HeadRequest) (*HeadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Head not implemented")
+}
+func (*UnimplementedPartitionKVServer) Get(ctx context.Context, req *GetRequest) (*GetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedPartitionKVServer) Delete(ctx context.Context, req *DeleteRequest) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (*UnimplementedPartitionKVServer) Range(ctx context.Context, req *RangeRequest) (*RangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Range not implemented")
+}
+func (*UnimplementedPartitionKVServer) StreamPut(srv PartitionKV_StreamPutServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamPut not implemented")
+}
+func (*UnimplementedPartitionKVServer) IndexRange(ctx context.Context, req *IndexRangeRequest) (*IndexRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IndexRange not implemented")
+}
+func (*UnimplementedPartitionKVServer) Watch(req *WatchRequest, srv PartitionKV_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+func (*UnimplementedPartitionKVServer) TxnPrepare(ctx context.Context, req *TxnPrepareRequest) (*TxnPrepareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxnPrepare not implemented")
+}
+func (*UnimplementedPartitionKVServer) TxnResolve(ctx context.Context, req *TxnResolveRequest) (*TxnResolveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxnResolve not implemented")
+}
+func (*UnimplementedPartitionKVServer) IngestTables(srv PartitionKV_IngestTablesServer) error {
+	return status.Errorf(codes.Unimplemented, "method IngestTables not implemented")
+}
+func (*UnimplementedPartitionKVServer) Replicate(ctx context.Context, req *ReplicateRequest) (*ReplicateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Replicate not implemented")
+}
+func (*UnimplementedPartitionKVServer) SplitPart(ctx context.Context, req *SplitPartRequest) (*SplitPartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SplitPart not implemented")
+}
+func (*UnimplementedPartitionKVServer) SnapshotPart(ctx context.Context, req *SnapshotPartRequest) (*SnapshotPartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SnapshotPart not implemented")
+}
+func (*UnimplementedPartitionKVServer) RestorePart(ctx context.Context, req *RestorePartRequest) (*RestorePartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestorePart not implemented")
+}
+func (*UnimplementedPartitionKVServer) Maintenance(ctx context.Context, req *MaintenanceRequest) (*MaintenanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Maintenance not implemented")
+}
+
+func RegisterPartitionKVServer(s *grpc.Server, srv PartitionKVServer) {
+	s.RegisterService(&_PartitionKV_serviceDesc, srv)
+}
+
+func _PartitionKV_Batch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.BloomFilter)
-	if l > 0 {
-		n += 1 + l + sovPspb(uint64(l))
+	if interceptor == nil {
+		return srv.(PartitionKVServer).Batch(ctx, in)
 	}
-	if m.EstimatedSize != 0 {
-		n += 1 + sovPspb(uint64(m.EstimatedSize))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pspb.PartitionKV/Batch",
 	}
-	if m.NumOfBlocks != 0 {
-		n += 1 + sovPspb(uint64(m.NumOfBlocks))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionKVServer).Batch(ctx, req.(*BatchRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PutRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovPspb(uint64(l))
+func _PartitionKV_Put_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovPspb(uint64(l))
+	if interceptor == nil {
+		return srv.(PartitionKVServer).Put(ctx, in)
 	}
-	if m.ExpiresAt != 0 {
-		n += 1 + sovPspb(uint64(m.ExpiresAt))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pspb.PartitionKV/Put",
 	}
-	if m.Partid != 0 {
-		n += 1 + sovPspb(uint64(m.Partid))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionKVServer).Put(ctx, req.(*PutRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PutResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovPspb(uint64(l))
+func _PartitionKV_Head_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
-}
-
-func (m *DeleteRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(PartitionKVServer).Head(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovPspb(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pspb.PartitionKV/Head",
 	}
-	if m.Partid != 0 {
-		n += 1 + sovPspb(uint64(m.Partid))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionKVServer).Head(ctx, req.(*HeadRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DeleteResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovPspb(uint64(l))
+func _PartitionKV_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
-}
-
-func (m *GetRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(PartitionKVServer).Get(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovPspb(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pspb.PartitionKV/Get",
 	}
-	if m.Partid != 0 {
-		n += 1 + sovPspb(uint64(m.Partid))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionKVServer).Get(ctx, req.(*GetRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovPspb(uint64(l))
+func _PartitionKV_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovPspb(uint64(l))
+	if interceptor == nil {
+		return srv.(PartitionKVServer).Delete(ctx, in)
 	}
-	return n
-}
-
-func (m *RequestOp) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pspb.PartitionKV/Delete",
 	}
-	var l int
-	_ = l
-	if m.Request != nil {
-		n += m.Request.Size()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionKVServer).Delete(ctx, req.(*DeleteRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RequestOp_RequestPut) Size() (n int) {
-	if m == nil {
-		return 0
+func _PartitionKV_Range_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.RequestPut != nil {
-		l = m.RequestPut.Size()
-		n += 1 + l + sovPspb(uint64(l))
+	if interceptor == nil {
+		return srv.(PartitionKVServer).Range(ctx, in)
 	}
-	return n
-}
-func (m *RequestOp_RequestDelete) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pspb.PartitionKV/Range",
 	}
-	var l int
-	_ = l
-	if m.RequestDelete != nil {
-		l = m.RequestDelete.Size()
-		n += 1 + l + sovPspb(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionKVServer).Range(ctx, req.(*RangeRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
-func (m *RequestOp_RequestGet) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RequestGet != nil {
-		l = m.RequestGet.Size()
-		n += 1 + l + sovPspb(uint64(l))
-	}
-	return n
+
+func _PartitionKV_StreamPut_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PartitionKVServer).StreamPut(&partitionKVStreamPutServer{stream})
 }
-func (m *ResponseOp) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Response != nil {
-		n += m.Response.Size()
-	}
-	return n
+
+type PartitionKV_StreamPutServer interface {
+	SendAndClose(*PutResponse) error
+	Recv() (*StreamPutRequest, error)
+	grpc.ServerStream
 }
 
-func (m *ResponseOp_ResponsePut) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ResponsePut != nil {
-		l = m.ResponsePut.Size()
-		n += 1 + l + sovPspb(uint64(l))
-	}
-	return n
+type partitionKVStreamPutServer struct {
+	grpc.ServerStream
 }
-func (m *ResponseOp_ResponseDelete) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ResponseDelete != nil {
-		l = m.ResponseDelete.Size()
-		n += 1 + l + sovPspb(uint64(l))
+
+func (x *partitionKVStreamPutServer) SendAndClose(m *PutResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *partitionKVStreamPutServer) Recv() (*StreamPutRequest, error) {
+	m := new(StreamPutRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return n
+	return m, nil
 }
-func (m *ResponseOp_ResponseGet) Size() (n int) {
-	if m == nil {
-		return 0
+
+func _PartitionKV_IndexRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IndexRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.ResponseGet != nil {
-		l = m.ResponseGet.Size()
-		n += 1 + l + sovPspb(uint64(l))
+	if interceptor == nil {
+		return srv.(PartitionKVServer).IndexRange(ctx, in)
 	}
-	return n
-}
-func (m *BatchRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pspb.PartitionKV/IndexRange",
 	}
-	var l int
-	_ = l
-	if len(m.Req) > 0 {
-		for _, e := range m.Req {
-			l = e.Size()
-			n += 1 + l + sovPspb(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionKVServer).IndexRange(ctx, req.(*IndexRangeRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BatchResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Res) > 0 {
-		for _, e := range m.Res {
-			l = e.Size()
-			n += 1 + l + sovPspb(uint64(l))
-		}
+func _PartitionKV_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return n
+	return srv.(PartitionKVServer).Watch(m, &partitionKVWatchServer{stream})
 }
 
-func (m *RangeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Prefix)
-	if l > 0 {
-		n += 1 + l + sovPspb(uint64(l))
+type PartitionKV_WatchServer interface {
+	Send(*WatchResponse) error
+	grpc.ServerStream
+}
+
+type partitionKVWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *partitionKVWatchServer) Send(m *WatchResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PartitionKV_TxnPrepare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxnPrepareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Start)
-	if l > 0 {
-		n += 1 + l + sovPspb(uint64(l))
+	if interceptor == nil {
+		return srv.(PartitionKVServer).TxnPrepare(ctx, in)
 	}
-	if m.Limit != 0 {
-		n += 1 + sovPspb(uint64(m.Limit))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pspb.PartitionKV/TxnPrepare",
 	}
-	if m.Partid != 0 {
-		n += 1 + sovPspb(uint64(m.Partid))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionKVServer).TxnPrepare(ctx, req.(*TxnPrepareRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RangeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _PartitionKV_TxnResolve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxnResolveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Truncated {
-		n += 2
+	if interceptor == nil {
+		return srv.(PartitionKVServer).TxnResolve(ctx, in)
 	}
-	if len(m.Keys) > 0 {
-		for _, b := range m.Keys {
-			l = len(b)
-			n += 1 + l + sovPspb(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pspb.PartitionKV/TxnResolve",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionKVServer).TxnResolve(ctx, req.(*TxnResolveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SplitPartRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Partid != 0 {
-		n += 1 + sovPspb(uint64(m.Partid))
-	}
-	return n
+func _PartitionKV_IngestTables_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PartitionKVServer).IngestTables(&partitionKVIngestTablesServer{stream})
 }
 
-func (m *SplitPartResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+type PartitionKV_IngestTablesServer interface {
+	SendAndClose(*IngestTablesResponse) error
+	Recv() (*IngestTablesRequest, error)
+	grpc.ServerStream
 }
 
-func (m *CompactOp) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+type partitionKVIngestTablesServer struct {
+	grpc.ServerStream
 }
 
-func (m *AutoGCOp) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (x *partitionKVIngestTablesServer) SendAndClose(m *IngestTablesResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *ForceGCOp) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ExIDs) > 0 {
-		l = 0
-		for _, e := range m.ExIDs {
-			l += sovPspb(uint64(e))
-		}
-		n += 1 + sovPspb(uint64(l)) + l
+func (x *partitionKVIngestTablesServer) Recv() (*IngestTablesRequest, error) {
+	m := new(IngestTablesRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return n
+	return m, nil
 }
 
-func (m *MaintenanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _PartitionKV_Replicate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplicateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Partid != 0 {
-		n += 1 + sovPspb(uint64(m.Partid))
+	if interceptor == nil {
+		return srv.(PartitionKVServer).Replicate(ctx, in)
 	}
-	if m.OP != nil {
-		n += m.OP.Size()
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pspb.PartitionKV/Replicate",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionKVServer).Replicate(ctx, req.(*ReplicateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MaintenanceRequest_Compact) Size() (n int) {
-	if m == nil {
-		return 0
+func _PartitionKV_SplitPart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SplitPartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Compact != nil {
-		l = m.Compact.Size()
-		n += 1 + l + sovPspb(uint64(l))
+	if interceptor == nil {
+		return srv.(PartitionKVServer).SplitPart(ctx, in)
 	}
-	return n
-}
-func (m *MaintenanceRequest_Autogc) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pspb.PartitionKV/SplitPart",
 	}
-	var l int
-	_ = l
-	if m.Autogc != nil {
-		l = m.Autogc.Size()
-		n += 1 + l + sovPspb(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionKVServer).SplitPart(ctx, req.(*SplitPartRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
-func (m *MaintenanceRequest_Forcegc) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Forcegc != nil {
-		l = m.Forcegc.Size()
-		n += 1 + l + sovPspb(uint64(l))
+
+func _PartitionKV_SnapshotPart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnapshotPartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
-}
-func (m *MaintenanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(PartitionKVServer).SnapshotPart(ctx, in)
 	}
-	var l int
-	_ = l
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pspb.PartitionKV/SnapshotPart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionKVServer).SnapshotPart(ctx, req.(*SnapshotPartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *HeadRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _PartitionKV_RestorePart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestorePartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovPspb(uint64(l))
+	if interceptor == nil {
+		return srv.(PartitionKVServer).RestorePart(ctx, in)
 	}
-	if m.Partid != 0 {
-		n += 1 + sovPspb(uint64(m.Partid))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pspb.PartitionKV/RestorePart",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionKVServer).RestorePart(ctx, req.(*RestorePartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *HeadResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _PartitionKV_Maintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MaintenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Info != nil {
-		l = m.Info.Size()
-		n += 1 + l + sovPspb(uint64(l))
+	if interceptor == nil {
+		return srv.(PartitionKVServer).Maintenance(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pspb.PartitionKV/Maintenance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionKVServer).Maintenance(ctx, req.(*MaintenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *HeadInfo) Size() (n int) {
-	if m == nil {
-		return 0
+var _PartitionKV_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pspb.PartitionKV",
+	HandlerType: (*PartitionKVServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Batch",
+			Handler:    _PartitionKV_Batch_Handler,
+		},
+		{
+			MethodName: "Put",
+			Handler:    _PartitionKV_Put_Handler,
+		},
+		{
+			MethodName: "Head",
+			Handler:    _PartitionKV_Head_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _PartitionKV_Get_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _PartitionKV_Delete_Handler,
+		},
+		{
+			MethodName: "Range",
+			Handler:    _PartitionKV_Range_Handler,
+		},
+		{
+			MethodName: "IndexRange",
+			Handler:    _PartitionKV_IndexRange_Handler,
+		},
+		{
+			MethodName: "TxnPrepare",
+			Handler:    _PartitionKV_TxnPrepare_Handler,
+		},
+		{
+			MethodName: "TxnResolve",
+			Handler:    _PartitionKV_TxnResolve_Handler,
+		},
+		{
+			MethodName: "Replicate",
+			Handler:    _PartitionKV_Replicate_Handler,
+		},
+		{
+			MethodName: "SplitPart",
+			Handler:    _PartitionKV_SplitPart_Handler,
+		},
+		{
+			MethodName: "SnapshotPart",
+			Handler:    _PartitionKV_SnapshotPart_Handler,
+		},
+		{
+			MethodName: "RestorePart",
+			Handler:    _PartitionKV_RestorePart_Handler,
+		},
+		{
+			MethodName: "Maintenance",
+			Handler:    _PartitionKV_Maintenance_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamPut",
+			Handler:       _PartitionKV_StreamPut_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Watch",
+			Handler:       _PartitionKV_Watch_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "IngestTables",
+			Handler:       _PartitionKV_IngestTables_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "pspb.proto",
+}
+
+func (m *RegionInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RegionInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovPspb(uint64(l))
+	if m.PSID != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.PSID))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Len != 0 {
-		n += 1 + sovPspb(uint64(m.Len))
+	if m.PartID != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.PartID))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.Rg != nil {
+		{
+			size, err := m.Rg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPspb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *StreamPutRequestHeader) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Regions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Regions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Regions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovPspb(uint64(l))
-	}
-	if m.LenOfValue != 0 {
-		n += 1 + sovPspb(uint64(m.LenOfValue))
-	}
-	if m.ExpiresAt != 0 {
-		n += 1 + sovPspb(uint64(m.ExpiresAt))
-	}
-	if m.Partid != 0 {
-		n += 1 + sovPspb(uint64(m.Partid))
+	if len(m.Regions) > 0 {
+		for k := range m.Regions {
+			v := m.Regions[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintPspb(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintPspb(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintPspb(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *StreamPutRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Range) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Range) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Range) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Data != nil {
-		n += m.Data.Size()
+	if len(m.EndKey) > 0 {
+		i -= len(m.EndKey)
+		copy(dAtA[i:], m.EndKey)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.EndKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StartKey) > 0 {
+		i -= len(m.StartKey)
+		copy(dAtA[i:], m.StartKey)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.StartKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Location) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Location) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Location) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Offset != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ExtentID != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.ExtentID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TableLocations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TableLocations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TableLocations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeleteGCSeq != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.DeleteGCSeq))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LogGCSeq != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.LogGCSeq))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Locs) > 0 {
+		for iNdEx := len(m.Locs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPspb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PartitionMeta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartitionMeta) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PartitionMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MetaStream != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.MetaStream))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.PartID != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.PartID))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Rg != nil {
+		{
+			size, err := m.Rg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPspb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.RowStream != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.RowStream))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LogStream != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.LogStream))
+		i--
+		dAtA[i] = 0x10
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PartitionSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartitionSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PartitionSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedAt != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Rg != nil {
+		{
+			size, err := m.Rg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPspb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MetaStream != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.MetaStream))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.RowStream != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.RowStream))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LogStream != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.LogStream))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PartID != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.PartID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SnapshotID != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.SnapshotID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PSDetail) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PSDetail) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PSDetail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PSID != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.PSID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ByteRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ByteRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ByteRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Length != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Length))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Offset != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndexInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Field != nil {
+		{
+			size := m.Field.Size()
+			i -= size
+			if _, err := m.Field.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if len(m.KeyPrefix) > 0 {
+		i -= len(m.KeyPrefix)
+		copy(dAtA[i:], m.KeyPrefix)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.KeyPrefix)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndexInfo_JsonPath) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexInfo_JsonPath) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.JsonPath)
+	copy(dAtA[i:], m.JsonPath)
+	i = encodeVarintPspb(dAtA, i, uint64(len(m.JsonPath)))
+	i--
+	dAtA[i] = 0x1a
+	return len(dAtA) - i, nil
+}
+func (m *IndexInfo_ByteRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexInfo_ByteRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ByteRange != nil {
+		{
+			size, err := m.ByteRange.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPspb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *PrefixQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrefixQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrefixQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxBytes != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.MaxBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Prefix) > 0 {
+		i -= len(m.Prefix)
+		copy(dAtA[i:], m.Prefix)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Prefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TenantLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TenantLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TenantLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Quotas) > 0 {
+		for iNdEx := len(m.Quotas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Quotas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPspb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.BytesPerSec != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.BytesPerSec))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.OpsPerSec != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.OpsPerSec))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockMeta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockMeta) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HasTxnIntents {
+		i--
+		if m.HasTxnIntents {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.CompressionType != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.CompressionType))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Discards) > 0 {
+		for k := range m.Discards {
+			v := m.Discards[k]
+			baseI := i
+			i = encodeVarintPspb(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintPspb(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintPspb(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.SeqNum != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.SeqNum))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.VpOffset != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.VpOffset))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.VpExtentID != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.VpExtentID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UnCompressedSize != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.UnCompressedSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CompressedSize != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.CompressedSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TableIndex != nil {
+		{
+			size, err := m.TableIndex.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPspb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockOffset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockOffset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockOffset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Offset != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ExtentID != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.ExtentID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TableIndex) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TableIndex) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TableIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumOfBlocks != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.NumOfBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EstimatedSize != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.EstimatedSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BloomFilter) > 0 {
+		i -= len(m.BloomFilter)
+		copy(dAtA[i:], m.BloomFilter)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.BloomFilter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Offsets) > 0 {
+		for iNdEx := len(m.Offsets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Offsets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPspb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Partid != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Partid))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Partid != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Partid))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SnapshotID != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.SnapshotID))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.WithOrigin {
+		i--
+		if m.WithOrigin {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AllowNotFound {
+		i--
+		if m.AllowNotFound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Partid != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Partid))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAt != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.OriginSeq != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.OriginSeq))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.OriginCluster != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.OriginCluster))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.NotFound {
+		i--
+		if m.NotFound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Version != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RequestOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
+		{
+			size := m.Request.Size()
+			i -= size
+			if _, err := m.Request.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RequestOp_RequestPut) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestOp_RequestPut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RequestPut != nil {
+		{
+			size, err := m.RequestPut.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPspb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *RequestOp_RequestDelete) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestOp_RequestDelete) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RequestDelete != nil {
+		{
+			size, err := m.RequestDelete.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPspb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *RequestOp_RequestGet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestOp_RequestGet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RequestGet != nil {
+		{
+			size, err := m.RequestGet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPspb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ResponseOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResponseOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResponseOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Response != nil {
+		{
+			size := m.Response.Size()
+			i -= size
+			if _, err := m.Response.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResponseOp_ResponsePut) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResponseOp_ResponsePut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ResponsePut != nil {
+		{
+			size, err := m.ResponsePut.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPspb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ResponseOp_ResponseDelete) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResponseOp_ResponseDelete) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ResponseDelete != nil {
+		{
+			size, err := m.ResponseDelete.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPspb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ResponseOp_ResponseGet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResponseOp_ResponseGet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ResponseGet != nil {
+		{
+			size, err := m.ResponseGet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPspb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BatchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Req) > 0 {
+		for iNdEx := len(m.Req) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Req[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPspb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Res) > 0 {
+		for iNdEx := len(m.Res) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Res[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPspb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SnapshotID != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.SnapshotID))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SinceSeq != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.SinceSeq))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Partid != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Partid))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Limit != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Start) > 0 {
+		i -= len(m.Start)
+		copy(dAtA[i:], m.Start)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Start)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Prefix) > 0 {
+		i -= len(m.Prefix)
+		copy(dAtA[i:], m.Prefix)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Prefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeleteGCSeq != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.DeleteGCSeq))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Seq != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Deleted) > 0 {
+		for iNdEx := len(m.Deleted) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if m.Deleted[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+		}
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Deleted)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintPspb(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Truncated {
+		i--
+		if m.Truncated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndexRangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexRangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Partid != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Partid))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Limit != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Exact {
+		i--
+		if m.Exact {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndexEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndexRangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexRangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPspb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Truncated {
+		i--
+		if m.Truncated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Partid != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Partid))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FromNow {
+		i--
+		if m.FromNow {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FromSeq != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.FromSeq))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Prefix) > 0 {
+		i -= len(m.Prefix)
+		copy(dAtA[i:], m.Prefix)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Prefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OriginSeq != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.OriginSeq))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.OriginCluster != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.OriginCluster))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Seq != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Rg != nil {
+		{
+			size, err := m.Rg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPspb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Checkpoint != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Checkpoint))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPspb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxnRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxnRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxnRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintPspb(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Deadline != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Status != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxnID) > 0 {
+		i -= len(m.TxnID)
+		copy(dAtA[i:], m.TxnID)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.TxnID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxnIntent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxnIntent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxnIntent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Delete {
+		i--
+		if m.Delete {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxnID) > 0 {
+		i -= len(m.TxnID)
+		copy(dAtA[i:], m.TxnID)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.TxnID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxnMutation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxnMutation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxnMutation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CheckOnly {
+		i--
+		if m.CheckOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Version != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CheckVersion {
+		i--
+		if m.CheckVersion {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Delete {
+		i--
+		if m.Delete {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxnPrepareRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxnPrepareRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxnPrepareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Partid != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Partid))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Mutations) > 0 {
+		for iNdEx := len(m.Mutations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Mutations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPspb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TxnID) > 0 {
+		i -= len(m.TxnID)
+		copy(dAtA[i:], m.TxnID)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.TxnID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxnPrepareResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxnPrepareResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxnPrepareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *TxnResolveRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxnResolveRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxnResolveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Partid != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Partid))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Commit {
+		i--
+		if m.Commit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintPspb(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TxnID) > 0 {
+		i -= len(m.TxnID)
+		copy(dAtA[i:], m.TxnID)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.TxnID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxnResolveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxnResolveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxnResolveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplicateOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicateOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicateOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OriginSeq != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.OriginSeq))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.OriginCluster != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.OriginCluster))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Deleted {
+		i--
+		if m.Deleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplicateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Partid != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Partid))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ClusterID != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.ClusterID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Ops) > 0 {
+		for iNdEx := len(m.Ops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPspb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplicateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Applied != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Applied))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplicationCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicationCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicationCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpdatedAt != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Rg != nil {
+		{
+			size, err := m.Rg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPspb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Seq != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SplitPartRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SplitPartRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SplitPartRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Partid != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Partid))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SplitPartResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SplitPartResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SplitPartResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *SnapshotPartRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotPartRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotPartRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Partid != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Partid))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SnapshotPartResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotPartResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotPartResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Snapshot != nil {
+		{
+			size, err := m.Snapshot.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPspb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RestorePartRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestorePartRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestorePartRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SnapshotID != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.SnapshotID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Partid != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Partid))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RestorePartResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestorePartResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestorePartResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *CompactOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompactOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompactOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoGCOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoGCOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoGCOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ForceGCOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForceGCOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForceGCOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExIDs) > 0 {
+		dAtA17 := make([]byte, len(m.ExIDs)*10)
+		var j16 int
+		for _, num := range m.ExIDs {
+			for num >= 1<<7 {
+				dAtA17[j16] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j16++
+			}
+			dAtA17[j16] = uint8(num)
+			j16++
+		}
+		i -= j16
+		copy(dAtA[i:], dAtA17[:j16])
+		i = encodeVarintPspb(dAtA, i, uint64(j16))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MaintenanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaintenanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaintenanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OP != nil {
+		{
+			size := m.OP.Size()
+			i -= size
+			if _, err := m.OP.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.Partid != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Partid))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MaintenanceRequest_Compact) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaintenanceRequest_Compact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Compact != nil {
+		{
+			size, err := m.Compact.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPspb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *MaintenanceRequest_Autogc) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaintenanceRequest_Autogc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Autogc != nil {
+		{
+			size, err := m.Autogc.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPspb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *MaintenanceRequest_Forcegc) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaintenanceRequest_Forcegc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Forcegc != nil {
+		{
+			size, err := m.Forcegc.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPspb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *MaintenanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaintenanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaintenanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *HeadRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeadRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SnapshotID != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.SnapshotID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Partid != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Partid))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HeadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeadResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Info != nil {
+		{
+			size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPspb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HeadInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeadInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeadInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Len != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Len))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamPutRequestHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamPutRequestHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamPutRequestHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Partid != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Partid))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LenOfValue != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.LenOfValue))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamPutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamPutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamPutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Data != nil {
+		{
+			size := m.Data.Size()
+			i -= size
+			if _, err := m.Data.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamPutRequest_Header) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamPutRequest_Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPspb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StreamPutRequest_Payload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamPutRequest_Payload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Payload != nil {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *IngestTablesRequestHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IngestTablesRequestHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IngestTablesRequestHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumOfTables != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.NumOfTables))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Partid != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Partid))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IngestTablesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IngestTablesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IngestTablesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Data != nil {
+		{
+			size := m.Data.Size()
+			i -= size
+			if _, err := m.Data.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IngestTablesRequest_Header) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IngestTablesRequest_Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPspb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *IngestTablesRequest_Payload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IngestTablesRequest_Payload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Payload != nil {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *IngestTablesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IngestTablesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IngestTablesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArchiveEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchiveEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchiveEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAt != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Deleted {
+		i--
+		if m.Deleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArchiveState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchiveState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchiveState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExportedRanges) > 0 {
+		for iNdEx := len(m.ExportedRanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExportedRanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPspb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.StartKey) > 0 {
+		i -= len(m.StartKey)
+		copy(dAtA[i:], m.StartKey)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.StartKey)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Done {
+		i--
+		if m.Done {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Count != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.LastKey) > 0 {
+		i -= len(m.LastKey)
+		copy(dAtA[i:], m.LastKey)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.LastKey)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.PartID != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.PartID))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.DonePartitions) > 0 {
+		dAtA25 := make([]byte, len(m.DonePartitions)*10)
+		var j24 int
+		for _, num := range m.DonePartitions {
+			for num >= 1<<7 {
+				dAtA25[j24] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j24++
+			}
+			dAtA25[j24] = uint8(num)
+			j24++
+		}
+		i -= j24
+		copy(dAtA[i:], dAtA25[:j24])
+		i = encodeVarintPspb(dAtA, i, uint64(j24))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Seqs) > 0 {
+		for k := range m.Seqs {
+			v := m.Seqs[k]
+			baseI := i
+			i = encodeVarintPspb(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintPspb(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintPspb(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.BaseSeqs) > 0 {
+		for k := range m.BaseSeqs {
+			v := m.BaseSeqs[k]
+			baseI := i
+			i = encodeVarintPspb(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintPspb(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintPspb(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Prefix) > 0 {
+		i -= len(m.Prefix)
+		copy(dAtA[i:], m.Prefix)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Prefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArchiveChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchiveChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchiveChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.State != nil {
+		{
+			size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPspb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPspb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPspb(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPspb(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RegionInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Rg != nil {
+		l = m.Rg.Size()
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.PartID != 0 {
+		n += 1 + sovPspb(uint64(m.PartID))
+	}
+	if m.PSID != 0 {
+		n += 1 + sovPspb(uint64(m.PSID))
+	}
+	return n
+}
+
+func (m *Regions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Regions) > 0 {
+		for k, v := range m.Regions {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovPspb(uint64(l))
+			}
+			mapEntrySize := 1 + sovPspb(uint64(k)) + l
+			n += mapEntrySize + 1 + sovPspb(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *Range) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StartKey)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	l = len(m.EndKey)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+
+func (m *Location) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExtentID != 0 {
+		n += 1 + sovPspb(uint64(m.ExtentID))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovPspb(uint64(m.Offset))
+	}
+	return n
+}
+
+func (m *TableLocations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Locs) > 0 {
+		for _, e := range m.Locs {
+			l = e.Size()
+			n += 1 + l + sovPspb(uint64(l))
+		}
+	}
+	if m.LogGCSeq != 0 {
+		n += 1 + sovPspb(uint64(m.LogGCSeq))
+	}
+	if m.DeleteGCSeq != 0 {
+		n += 1 + sovPspb(uint64(m.DeleteGCSeq))
+	}
+	return n
+}
+
+func (m *PartitionMeta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LogStream != 0 {
+		n += 1 + sovPspb(uint64(m.LogStream))
+	}
+	if m.RowStream != 0 {
+		n += 1 + sovPspb(uint64(m.RowStream))
+	}
+	if m.Rg != nil {
+		l = m.Rg.Size()
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.PartID != 0 {
+		n += 1 + sovPspb(uint64(m.PartID))
+	}
+	if m.MetaStream != 0 {
+		n += 1 + sovPspb(uint64(m.MetaStream))
+	}
+	return n
+}
+
+func (m *PartitionSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SnapshotID != 0 {
+		n += 1 + sovPspb(uint64(m.SnapshotID))
+	}
+	if m.PartID != 0 {
+		n += 1 + sovPspb(uint64(m.PartID))
+	}
+	if m.LogStream != 0 {
+		n += 1 + sovPspb(uint64(m.LogStream))
+	}
+	if m.RowStream != 0 {
+		n += 1 + sovPspb(uint64(m.RowStream))
+	}
+	if m.MetaStream != 0 {
+		n += 1 + sovPspb(uint64(m.MetaStream))
+	}
+	if m.Rg != nil {
+		l = m.Rg.Size()
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovPspb(uint64(m.CreatedAt))
+	}
+	return n
+}
+
+func (m *PSDetail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PSID != 0 {
+		n += 1 + sovPspb(uint64(m.PSID))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+
+func (m *ByteRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Offset != 0 {
+		n += 1 + sovPspb(uint64(m.Offset))
+	}
+	if m.Length != 0 {
+		n += 1 + sovPspb(uint64(m.Length))
+	}
+	return n
+}
+
+func (m *IndexInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	l = len(m.KeyPrefix)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.Field != nil {
+		n += m.Field.Size()
+	}
+	return n
+}
+
+func (m *IndexInfo_JsonPath) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JsonPath)
+	n += 1 + l + sovPspb(uint64(l))
+	return n
+}
+func (m *IndexInfo_ByteRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ByteRange != nil {
+		l = m.ByteRange.Size()
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+func (m *PrefixQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.MaxBytes != 0 {
+		n += 1 + sovPspb(uint64(m.MaxBytes))
+	}
+	return n
+}
+
+func (m *TenantLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.OpsPerSec != 0 {
+		n += 1 + sovPspb(uint64(m.OpsPerSec))
+	}
+	if m.BytesPerSec != 0 {
+		n += 1 + sovPspb(uint64(m.BytesPerSec))
+	}
+	if len(m.Quotas) > 0 {
+		for _, e := range m.Quotas {
+			l = e.Size()
+			n += 1 + l + sovPspb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BlockMeta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TableIndex != nil {
+		l = m.TableIndex.Size()
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.CompressedSize != 0 {
+		n += 1 + sovPspb(uint64(m.CompressedSize))
+	}
+	if m.UnCompressedSize != 0 {
+		n += 1 + sovPspb(uint64(m.UnCompressedSize))
+	}
+	if m.VpExtentID != 0 {
+		n += 1 + sovPspb(uint64(m.VpExtentID))
+	}
+	if m.VpOffset != 0 {
+		n += 1 + sovPspb(uint64(m.VpOffset))
+	}
+	if m.SeqNum != 0 {
+		n += 1 + sovPspb(uint64(m.SeqNum))
+	}
+	if len(m.Discards) > 0 {
+		for k, v := range m.Discards {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovPspb(uint64(k)) + 1 + sovPspb(uint64(v))
+			n += mapEntrySize + 1 + sovPspb(uint64(mapEntrySize))
+		}
+	}
+	if m.CompressionType != 0 {
+		n += 1 + sovPspb(uint64(m.CompressionType))
+	}
+	if m.HasTxnIntents {
+		n += 2
+	}
+	return n
+}
+
+func (m *BlockOffset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.ExtentID != 0 {
+		n += 1 + sovPspb(uint64(m.ExtentID))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovPspb(uint64(m.Offset))
+	}
+	return n
+}
+
+func (m *TableIndex) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Offsets) > 0 {
+		for _, e := range m.Offsets {
+			l = e.Size()
+			n += 1 + l + sovPspb(uint64(l))
+		}
+	}
+	l = len(m.BloomFilter)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.EstimatedSize != 0 {
+		n += 1 + sovPspb(uint64(m.EstimatedSize))
+	}
+	if m.NumOfBlocks != 0 {
+		n += 1 + sovPspb(uint64(m.NumOfBlocks))
+	}
+	return n
+}
+
+func (m *PutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovPspb(uint64(m.ExpiresAt))
+	}
+	if m.Partid != 0 {
+		n += 1 + sovPspb(uint64(m.Partid))
+	}
+	return n
+}
+
+func (m *PutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.Partid != 0 {
+		n += 1 + sovPspb(uint64(m.Partid))
+	}
+	return n
+}
+
+func (m *DeleteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+
+func (m *GetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.Partid != 0 {
+		n += 1 + sovPspb(uint64(m.Partid))
+	}
+	if m.AllowNotFound {
+		n += 2
+	}
+	if m.WithOrigin {
+		n += 2
+	}
+	if m.SnapshotID != 0 {
+		n += 1 + sovPspb(uint64(m.SnapshotID))
+	}
+	return n
+}
+
+func (m *GetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovPspb(uint64(m.Version))
+	}
+	if m.NotFound {
+		n += 2
+	}
+	if m.OriginCluster != 0 {
+		n += 1 + sovPspb(uint64(m.OriginCluster))
+	}
+	if m.OriginSeq != 0 {
+		n += 1 + sovPspb(uint64(m.OriginSeq))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovPspb(uint64(m.ExpiresAt))
+	}
+	return n
+}
+
+func (m *RequestOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		n += m.Request.Size()
+	}
+	return n
+}
+
+func (m *RequestOp_RequestPut) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestPut != nil {
+		l = m.RequestPut.Size()
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+func (m *RequestOp_RequestDelete) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestDelete != nil {
+		l = m.RequestDelete.Size()
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+func (m *RequestOp_RequestGet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestGet != nil {
+		l = m.RequestGet.Size()
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+func (m *ResponseOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Response != nil {
+		n += m.Response.Size()
+	}
+	return n
+}
+
+func (m *ResponseOp_ResponsePut) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResponsePut != nil {
+		l = m.ResponsePut.Size()
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+func (m *ResponseOp_ResponseDelete) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResponseDelete != nil {
+		l = m.ResponseDelete.Size()
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+func (m *ResponseOp_ResponseGet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResponseGet != nil {
+		l = m.ResponseGet.Size()
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+func (m *BatchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Req) > 0 {
+		for _, e := range m.Req {
+			l = e.Size()
+			n += 1 + l + sovPspb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Res) > 0 {
+		for _, e := range m.Res {
+			l = e.Size()
+			n += 1 + l + sovPspb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	l = len(m.Start)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovPspb(uint64(m.Limit))
+	}
+	if m.Partid != 0 {
+		n += 1 + sovPspb(uint64(m.Partid))
+	}
+	if m.SinceSeq != 0 {
+		n += 1 + sovPspb(uint64(m.SinceSeq))
+	}
+	if m.SnapshotID != 0 {
+		n += 1 + sovPspb(uint64(m.SnapshotID))
+	}
+	return n
+}
+
+func (m *RangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Truncated {
+		n += 2
+	}
+	if len(m.Keys) > 0 {
+		for _, b := range m.Keys {
+			l = len(b)
+			n += 1 + l + sovPspb(uint64(l))
+		}
+	}
+	if len(m.Deleted) > 0 {
+		n += 1 + sovPspb(uint64(len(m.Deleted))) + len(m.Deleted)*1
+	}
+	if m.Seq != 0 {
+		n += 1 + sovPspb(uint64(m.Seq))
+	}
+	if m.DeleteGCSeq != 0 {
+		n += 1 + sovPspb(uint64(m.DeleteGCSeq))
+	}
+	return n
+}
+
+func (m *IndexRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.Exact {
+		n += 2
+	}
+	if m.Limit != 0 {
+		n += 1 + sovPspb(uint64(m.Limit))
+	}
+	if m.Partid != 0 {
+		n += 1 + sovPspb(uint64(m.Partid))
+	}
+	return n
+}
+
+func (m *IndexEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+
+func (m *IndexRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Truncated {
+		n += 2
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovPspb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WatchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.FromSeq != 0 {
+		n += 1 + sovPspb(uint64(m.FromSeq))
+	}
+	if m.FromNow {
+		n += 2
+	}
+	if m.Partid != 0 {
+		n += 1 + sovPspb(uint64(m.Partid))
+	}
+	return n
+}
+
+func (m *WatchEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovPspb(uint64(m.Type))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovPspb(uint64(m.ExpiresAt))
+	}
+	if m.Seq != 0 {
+		n += 1 + sovPspb(uint64(m.Seq))
+	}
+	if m.OriginCluster != 0 {
+		n += 1 + sovPspb(uint64(m.OriginCluster))
+	}
+	if m.OriginSeq != 0 {
+		n += 1 + sovPspb(uint64(m.OriginSeq))
+	}
+	return n
+}
+
+func (m *WatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovPspb(uint64(l))
+		}
+	}
+	if m.Checkpoint != 0 {
+		n += 1 + sovPspb(uint64(m.Checkpoint))
+	}
+	if m.Rg != nil {
+		l = m.Rg.Size()
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+
+func (m *TxnRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxnID)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovPspb(uint64(m.Status))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovPspb(uint64(m.Deadline))
+	}
+	if len(m.Keys) > 0 {
+		for _, b := range m.Keys {
+			l = len(b)
+			n += 1 + l + sovPspb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TxnIntent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxnID)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.Delete {
+		n += 2
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+
+func (m *TxnMutation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.Delete {
+		n += 2
+	}
+	if m.CheckVersion {
+		n += 2
+	}
+	if m.Version != 0 {
+		n += 1 + sovPspb(uint64(m.Version))
+	}
+	if m.CheckOnly {
+		n += 2
+	}
+	return n
+}
+
+func (m *TxnPrepareRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxnID)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if len(m.Mutations) > 0 {
+		for _, e := range m.Mutations {
+			l = e.Size()
+			n += 1 + l + sovPspb(uint64(l))
+		}
+	}
+	if m.Partid != 0 {
+		n += 1 + sovPspb(uint64(m.Partid))
+	}
+	return n
+}
+
+func (m *TxnPrepareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *TxnResolveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxnID)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if len(m.Keys) > 0 {
+		for _, b := range m.Keys {
+			l = len(b)
+			n += 1 + l + sovPspb(uint64(l))
+		}
+	}
+	if m.Commit {
+		n += 2
+	}
+	if m.Partid != 0 {
+		n += 1 + sovPspb(uint64(m.Partid))
+	}
+	return n
+}
+
+func (m *TxnResolveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ReplicateOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovPspb(uint64(m.ExpiresAt))
+	}
+	if m.Deleted {
+		n += 2
+	}
+	if m.OriginCluster != 0 {
+		n += 1 + sovPspb(uint64(m.OriginCluster))
+	}
+	if m.OriginSeq != 0 {
+		n += 1 + sovPspb(uint64(m.OriginSeq))
+	}
+	return n
+}
+
+func (m *ReplicateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ops) > 0 {
+		for _, e := range m.Ops {
+			l = e.Size()
+			n += 1 + l + sovPspb(uint64(l))
+		}
+	}
+	if m.ClusterID != 0 {
+		n += 1 + sovPspb(uint64(m.ClusterID))
+	}
+	if m.Partid != 0 {
+		n += 1 + sovPspb(uint64(m.Partid))
+	}
+	return n
+}
+
+func (m *ReplicateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Applied != 0 {
+		n += 1 + sovPspb(uint64(m.Applied))
+	}
+	return n
+}
+
+func (m *ReplicationCheckpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		n += 1 + sovPspb(uint64(m.Seq))
+	}
+	if m.Rg != nil {
+		l = m.Rg.Size()
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovPspb(uint64(m.UpdatedAt))
+	}
+	return n
+}
+
+func (m *SplitPartRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Partid != 0 {
+		n += 1 + sovPspb(uint64(m.Partid))
+	}
+	return n
+}
+
+func (m *SplitPartResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SnapshotPartRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Partid != 0 {
+		n += 1 + sovPspb(uint64(m.Partid))
+	}
+	return n
+}
+
+func (m *SnapshotPartResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Snapshot != nil {
+		l = m.Snapshot.Size()
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+
+func (m *RestorePartRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Partid != 0 {
+		n += 1 + sovPspb(uint64(m.Partid))
+	}
+	if m.SnapshotID != 0 {
+		n += 1 + sovPspb(uint64(m.SnapshotID))
+	}
+	return n
+}
+
+func (m *RestorePartResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CompactOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AutoGCOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ForceGCOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExIDs) > 0 {
+		l = 0
+		for _, e := range m.ExIDs {
+			l += sovPspb(uint64(e))
+		}
+		n += 1 + sovPspb(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MaintenanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Partid != 0 {
+		n += 1 + sovPspb(uint64(m.Partid))
+	}
+	if m.OP != nil {
+		n += m.OP.Size()
+	}
+	return n
+}
+
+func (m *MaintenanceRequest_Compact) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Compact != nil {
+		l = m.Compact.Size()
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+func (m *MaintenanceRequest_Autogc) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Autogc != nil {
+		l = m.Autogc.Size()
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+func (m *MaintenanceRequest_Forcegc) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Forcegc != nil {
+		l = m.Forcegc.Size()
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+func (m *MaintenanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *HeadRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.Partid != 0 {
+		n += 1 + sovPspb(uint64(m.Partid))
+	}
+	if m.SnapshotID != 0 {
+		n += 1 + sovPspb(uint64(m.SnapshotID))
+	}
+	return n
+}
+
+func (m *HeadResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+
+func (m *HeadInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.Len != 0 {
+		n += 1 + sovPspb(uint64(m.Len))
+	}
+	return n
+}
+
+func (m *StreamPutRequestHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.LenOfValue != 0 {
+		n += 1 + sovPspb(uint64(m.LenOfValue))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovPspb(uint64(m.ExpiresAt))
+	}
+	if m.Partid != 0 {
+		n += 1 + sovPspb(uint64(m.Partid))
+	}
+	return n
+}
+
+func (m *StreamPutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data != nil {
+		n += m.Data.Size()
+	}
+	return n
+}
+
+func (m *StreamPutRequest_Header) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+func (m *StreamPutRequest_Payload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Payload != nil {
+		l = len(m.Payload)
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+func (m *IngestTablesRequestHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Partid != 0 {
+		n += 1 + sovPspb(uint64(m.Partid))
+	}
+	if m.NumOfTables != 0 {
+		n += 1 + sovPspb(uint64(m.NumOfTables))
+	}
+	return n
+}
+
+func (m *IngestTablesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data != nil {
+		n += m.Data.Size()
+	}
+	return n
+}
+
+func (m *IngestTablesRequest_Header) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+func (m *IngestTablesRequest_Payload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Payload != nil {
+		l = len(m.Payload)
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+func (m *IngestTablesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		n += 1 + sovPspb(uint64(m.Seq))
+	}
+	return n
+}
+
+func (m *ArchiveEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.Deleted {
+		n += 2
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovPspb(uint64(m.ExpiresAt))
+	}
+	return n
+}
+
+func (m *ArchiveState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if len(m.BaseSeqs) > 0 {
+		for k, v := range m.BaseSeqs {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovPspb(uint64(k)) + 1 + sovPspb(uint64(v))
+			n += mapEntrySize + 1 + sovPspb(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Seqs) > 0 {
+		for k, v := range m.Seqs {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovPspb(uint64(k)) + 1 + sovPspb(uint64(v))
+			n += mapEntrySize + 1 + sovPspb(uint64(mapEntrySize))
+		}
+	}
+	if len(m.DonePartitions) > 0 {
+		l = 0
+		for _, e := range m.DonePartitions {
+			l += sovPspb(uint64(e))
+		}
+		n += 1 + sovPspb(uint64(l)) + l
+	}
+	if m.PartID != 0 {
+		n += 1 + sovPspb(uint64(m.PartID))
+	}
+	l = len(m.LastKey)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovPspb(uint64(m.Count))
+	}
+	if m.Done {
+		n += 2
+	}
+	l = len(m.StartKey)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if len(m.ExportedRanges) > 0 {
+		for _, e := range m.ExportedRanges {
+			l = e.Size()
+			n += 1 + l + sovPspb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ArchiveChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovPspb(uint64(l))
+		}
+	}
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+
+func sovPspb(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPspb(x uint64) (n int) {
+	return sovPspb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RegionInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegionInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Rg == nil {
+				m.Rg = &Range{}
+			}
+			if err := m.Rg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartID", wireType)
+			}
+			m.PartID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PSID", wireType)
+			}
+			m.PSID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PSID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Regions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Regions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Regions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Regions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Regions == nil {
+				m.Regions = make(map[uint64]*RegionInfo)
+			}
+			var mapkey uint64
+			var mapvalue *RegionInfo
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPspb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPspb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPspb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthPspb
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthPspb
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &RegionInfo{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPspb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPspb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Regions[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Range) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Range: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Range: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartKey = append(m.StartKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StartKey == nil {
+				m.StartKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndKey = append(m.EndKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.EndKey == nil {
+				m.EndKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Location) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Location: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Location: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtentID", wireType)
+			}
+			m.ExtentID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtentID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TableLocations) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TableLocations: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TableLocations: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locs = append(m.Locs, &Location{})
+			if err := m.Locs[len(m.Locs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogGCSeq", wireType)
+			}
+			m.LogGCSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogGCSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteGCSeq", wireType)
+			}
+			m.DeleteGCSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeleteGCSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PartitionMeta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PartitionMeta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PartitionMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStream", wireType)
+			}
+			m.LogStream = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStream |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowStream", wireType)
+			}
+			m.RowStream = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RowStream |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Rg == nil {
+				m.Rg = &Range{}
+			}
+			if err := m.Rg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartID", wireType)
+			}
+			m.PartID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetaStream", wireType)
+			}
+			m.MetaStream = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MetaStream |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PartitionSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PartitionSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PartitionSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotID", wireType)
+			}
+			m.SnapshotID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartID", wireType)
+			}
+			m.PartID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStream", wireType)
+			}
+			m.LogStream = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStream |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowStream", wireType)
+			}
+			m.RowStream = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RowStream |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetaStream", wireType)
+			}
+			m.MetaStream = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MetaStream |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Rg == nil {
+				m.Rg = &Range{}
+			}
+			if err := m.Rg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PSDetail) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PSDetail: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PSDetail: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PSID", wireType)
+			}
+			m.PSID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PSID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ByteRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ByteRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ByteRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			}
+			m.Length = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Length |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndexInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyPrefix", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyPrefix = append(m.KeyPrefix[:0], dAtA[iNdEx:postIndex]...)
+			if m.KeyPrefix == nil {
+				m.KeyPrefix = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JsonPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = &IndexInfo_JsonPath{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ByteRange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ByteRange{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Field = &IndexInfo_ByteRange{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrefixQuota) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrefixQuota: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrefixQuota: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefix = append(m.Prefix[:0], dAtA[iNdEx:postIndex]...)
+			if m.Prefix == nil {
+				m.Prefix = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytes", wireType)
+			}
+			m.MaxBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TenantLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TenantLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TenantLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpsPerSec", wireType)
+			}
+			m.OpsPerSec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpsPerSec |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesPerSec", wireType)
+			}
+			m.BytesPerSec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesPerSec |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quotas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quotas = append(m.Quotas, &PrefixQuota{})
+			if err := m.Quotas[len(m.Quotas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockMeta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockMeta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableIndex", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TableIndex == nil {
+				m.TableIndex = &TableIndex{}
+			}
+			if err := m.TableIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressedSize", wireType)
+			}
+			m.CompressedSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompressedSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnCompressedSize", wireType)
+			}
+			m.UnCompressedSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnCompressedSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VpExtentID", wireType)
+			}
+			m.VpExtentID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VpExtentID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VpOffset", wireType)
+			}
+			m.VpOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VpOffset |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeqNum", wireType)
+			}
+			m.SeqNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeqNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Discards == nil {
+				m.Discards = make(map[uint64]int64)
+			}
+			var mapkey uint64
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPspb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPspb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPspb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPspb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPspb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Discards[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressionType", wireType)
+			}
+			m.CompressionType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompressionType |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasTxnIntents", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasTxnIntents = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockOffset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockOffset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockOffset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtentID", wireType)
+			}
+			m.ExtentID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtentID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TableIndex) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TableIndex: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TableIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offsets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Offsets = append(m.Offsets, &BlockOffset{})
+			if err := m.Offsets[len(m.Offsets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BloomFilter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BloomFilter = append(m.BloomFilter[:0], dAtA[iNdEx:postIndex]...)
+			if m.BloomFilter == nil {
+				m.BloomFilter = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedSize", wireType)
+			}
+			m.EstimatedSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOfBlocks", wireType)
+			}
+			m.NumOfBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumOfBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PutRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PutRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partid", wireType)
+			}
+			m.Partid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partid", wireType)
+			}
+			m.Partid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partid", wireType)
+			}
+			m.Partid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowNotFound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowNotFound = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithOrigin", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithOrigin = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotID", wireType)
+			}
+			m.SnapshotID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotFound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NotFound = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginCluster", wireType)
+			}
+			m.OriginCluster = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OriginCluster |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginSeq", wireType)
+			}
+			m.OriginSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OriginSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestOp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestOp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestOp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestPut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &PutRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Request = &RequestOp_RequestPut{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestDelete", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &DeleteRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Request = &RequestOp_RequestDelete{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestGet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &GetRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Request = &RequestOp_RequestGet{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResponseOp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponseOp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponseOp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponsePut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &PutResponse{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Response = &ResponseOp_ResponsePut{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseDelete", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &DeleteResponse{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Response = &ResponseOp_ResponseDelete{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseGet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &GetResponse{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Response = &ResponseOp_ResponseGet{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Req", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Req = append(m.Req, &RequestOp{})
+			if err := m.Req[len(m.Req)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Res", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Res = append(m.Res, &RequestOp{})
+			if err := m.Res[len(m.Res)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefix = append(m.Prefix[:0], dAtA[iNdEx:postIndex]...)
+			if m.Prefix == nil {
+				m.Prefix = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Start = append(m.Start[:0], dAtA[iNdEx:postIndex]...)
+			if m.Start == nil {
+				m.Start = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partid", wireType)
+			}
+			m.Partid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceSeq", wireType)
+			}
+			m.SinceSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SinceSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotID", wireType)
+			}
+			m.SnapshotID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Truncated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Truncated = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, make([]byte, postIndex-iNdEx))
+			copy(m.Keys[len(m.Keys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPspb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Deleted = append(m.Deleted, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPspb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPspb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPspb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.Deleted) == 0 {
+					m.Deleted = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPspb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Deleted = append(m.Deleted, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteGCSeq", wireType)
+			}
+			m.DeleteGCSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeleteGCSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndexRangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexRangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exact", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Exact = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partid", wireType)
+			}
+			m.Partid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndexEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndexRangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexRangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Truncated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Truncated = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, &IndexEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefix = append(m.Prefix[:0], dAtA[iNdEx:postIndex]...)
+			if m.Prefix == nil {
+				m.Prefix = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromSeq", wireType)
+			}
+			m.FromSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromNow", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FromNow = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partid", wireType)
+			}
+			m.Partid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= WatchEvent_EventType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginCluster", wireType)
+			}
+			m.OriginCluster = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OriginCluster |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginSeq", wireType)
+			}
+			m.OriginSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OriginSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, &WatchEvent{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
+			}
+			m.Checkpoint = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Checkpoint |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Rg == nil {
+				m.Rg = &Range{}
+			}
+			if err := m.Rg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *StreamPutRequest_Header) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPspb(uint64(l))
-	}
-	return n
-}
-func (m *StreamPutRequest_Payload) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Payload != nil {
-		l = len(m.Payload)
-		n += 1 + l + sovPspb(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovPspb(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozPspb(x uint64) (n int) {
-	return sovPspb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *RegionInfo) Unmarshal(dAtA []byte) error {
+func (m *TxnRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4781,17 +14671,17 @@ func (m *RegionInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegionInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxnRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxnRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxnID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -4801,33 +14691,29 @@ func (m *RegionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPspb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPspb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Rg == nil {
-				m.Rg = &Range{}
-			}
-			if err := m.Rg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TxnID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.PartID = 0
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -4837,16 +14723,16 @@ func (m *RegionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PartID |= uint64(b&0x7F) << shift
+				m.Status |= TxnStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PSID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
 			}
-			m.PSID = 0
+			m.Deadline = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -4856,11 +14742,43 @@ func (m *RegionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PSID |= uint64(b&0x7F) << shift
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, make([]byte, postIndex-iNdEx))
+			copy(m.Keys[len(m.Keys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPspb(dAtA[iNdEx:])
@@ -4882,7 +14800,7 @@ func (m *RegionInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Regions) Unmarshal(dAtA []byte) error {
+func (m *TxnIntent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4905,17 +14823,17 @@ func (m *Regions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Regions: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxnIntent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Regions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxnIntent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Regions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxnID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -4925,106 +14843,77 @@ func (m *Regions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPspb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPspb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Regions == nil {
-				m.Regions = make(map[uint64]*RegionInfo)
+			m.TxnID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
 			}
-			var mapkey uint64
-			var mapvalue *RegionInfo
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPspb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPspb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPspb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthPspb
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthPspb
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &RegionInfo{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPspb(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthPspb
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Regions[mapkey] = mapvalue
+			m.Delete = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5047,7 +14936,7 @@ func (m *Regions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Range) Unmarshal(dAtA []byte) error {
+func (m *TxnMutation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5070,15 +14959,15 @@ func (m *Range) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Range: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxnMutation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Range: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxnMutation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5105,14 +14994,14 @@ func (m *Range) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StartKey = append(m.StartKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.StartKey == nil {
-				m.StartKey = []byte{}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5139,11 +15028,90 @@ func (m *Range) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EndKey = append(m.EndKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.EndKey == nil {
-				m.EndKey = []byte{}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Delete = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckVersion", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CheckVersion = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CheckOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPspb(dAtA[iNdEx:])
@@ -5165,7 +15133,7 @@ func (m *Range) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Location) Unmarshal(dAtA []byte) error {
+func (m *TxnPrepareRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5188,17 +15156,17 @@ func (m *Location) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Location: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxnPrepareRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Location: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxnPrepareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtentID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxnID", wireType)
 			}
-			m.ExtentID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -5208,16 +15176,63 @@ func (m *Location) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExtentID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxnID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mutations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mutations = append(m.Mutations, &TxnMutation{})
+			if err := m.Mutations[len(m.Mutations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Partid", wireType)
 			}
-			m.Offset = 0
+			m.Partid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -5227,7 +15242,7 @@ func (m *Location) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Offset |= uint32(b&0x7F) << shift
+				m.Partid |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5253,7 +15268,7 @@ func (m *Location) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TableLocations) Unmarshal(dAtA []byte) error {
+func (m *TxnPrepareResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5276,46 +15291,12 @@ func (m *TableLocations) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TableLocations: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxnPrepareResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TableLocations: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxnPrepareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locs", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPspb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPspb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPspb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Locs = append(m.Locs, &Location{})
-			if err := m.Locs[len(m.Locs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPspb(dAtA[iNdEx:])
@@ -5337,7 +15318,7 @@ func (m *TableLocations) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PartitionMeta) Unmarshal(dAtA []byte) error {
+func (m *TxnResolveRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5360,17 +15341,17 @@ func (m *PartitionMeta) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PartitionMeta: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxnResolveRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PartitionMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxnResolveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStream", wireType)
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxnID", wireType)
 			}
-			m.LogStream = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -5380,35 +15361,29 @@ func (m *PartitionMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LogStream |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RowStream", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPspb
 			}
-			m.RowStream = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPspb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RowStream |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
 			}
-		case 7:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxnID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -5418,33 +15393,29 @@ func (m *PartitionMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPspb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPspb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Rg == nil {
-				m.Rg = &Range{}
-			}
-			if err := m.Rg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Keys = append(m.Keys, make([]byte, postIndex-iNdEx))
+			copy(m.Keys[len(m.Keys)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
-			m.PartID = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -5454,16 +15425,17 @@ func (m *PartitionMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PartID |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+			m.Commit = bool(v != 0)
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetaStream", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Partid", wireType)
 			}
-			m.MetaStream = 0
+			m.Partid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -5473,7 +15445,7 @@ func (m *PartitionMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MetaStream |= uint64(b&0x7F) << shift
+				m.Partid |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5499,7 +15471,7 @@ func (m *PartitionMeta) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PSDetail) Unmarshal(dAtA []byte) error {
+func (m *TxnResolveResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5522,63 +15494,12 @@ func (m *PSDetail) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PSDetail: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxnResolveResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PSDetail: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxnResolveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PSID", wireType)
-			}
-			m.PSID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPspb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PSID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPspb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPspb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPspb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPspb(dAtA[iNdEx:])
@@ -5600,7 +15521,7 @@ func (m *PSDetail) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockMeta) Unmarshal(dAtA []byte) error {
+func (m *ReplicateOp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5623,17 +15544,17 @@ func (m *BlockMeta) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockMeta: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplicateOp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplicateOp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -5643,33 +15564,31 @@ func (m *BlockMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPspb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPspb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TableIndex == nil {
-				m.TableIndex = &TableIndex{}
-			}
-			if err := m.TableIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompressedSize", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			m.CompressedSize = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -5679,16 +15598,31 @@ func (m *BlockMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CompressedSize |= uint32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnCompressedSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
-			m.UnCompressedSize = 0
+			m.ExpiresAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -5698,16 +15632,16 @@ func (m *BlockMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnCompressedSize |= uint32(b&0x7F) << shift
+				m.ExpiresAt |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VpExtentID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
 			}
-			m.VpExtentID = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -5717,16 +15651,17 @@ func (m *BlockMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VpExtentID |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Deleted = bool(v != 0)
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VpOffset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginCluster", wireType)
 			}
-			m.VpOffset = 0
+			m.OriginCluster = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -5736,16 +15671,16 @@ func (m *BlockMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VpOffset |= uint32(b&0x7F) << shift
+				m.OriginCluster |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SeqNum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginSeq", wireType)
 			}
-			m.SeqNum = 0
+			m.OriginSeq = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -5755,14 +15690,64 @@ func (m *BlockMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SeqNum |= uint64(b&0x7F) << shift
+				m.OriginSeq |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplicateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplicateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplicateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Discards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ops", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5789,81 +15774,35 @@ func (m *BlockMeta) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Discards == nil {
-				m.Discards = make(map[uint64]int64)
+			m.Ops = append(m.Ops, &ReplicateOp{})
+			if err := m.Ops[len(m.Ops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey uint64
-			var mapvalue int64
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPspb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			}
+			m.ClusterID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPspb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPspb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvalue |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPspb(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthPspb
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClusterID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Discards[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompressionType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Partid", wireType)
 			}
-			m.CompressionType = 0
+			m.Partid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -5873,7 +15812,7 @@ func (m *BlockMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CompressionType |= uint32(b&0x7F) << shift
+				m.Partid |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5899,7 +15838,7 @@ func (m *BlockMeta) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockOffset) Unmarshal(dAtA []byte) error {
+func (m *ReplicateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5922,70 +15861,17 @@ func (m *BlockOffset) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockOffset: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplicateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockOffset: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplicateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPspb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthPspb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPspb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtentID", wireType)
-			}
-			m.ExtentID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPspb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExtentID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Applied", wireType)
 			}
-			m.Offset = 0
+			m.Applied = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -5995,7 +15881,7 @@ func (m *BlockOffset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Offset |= uint32(b&0x7F) << shift
+				m.Applied |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6021,7 +15907,7 @@ func (m *BlockOffset) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TableIndex) Unmarshal(dAtA []byte) error {
+func (m *ReplicationCheckpoint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6044,17 +15930,17 @@ func (m *TableIndex) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TableIndex: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplicationCheckpoint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TableIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplicationCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offsets", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
 			}
-			var msglen int
+			m.Seq = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -6064,31 +15950,16 @@ func (m *TableIndex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Seq |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPspb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPspb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Offsets = append(m.Offsets, &BlockOffset{})
-			if err := m.Offsets[len(m.Offsets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BloomFilter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rg", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -6098,50 +15969,33 @@ func (m *TableIndex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPspb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPspb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BloomFilter = append(m.BloomFilter[:0], dAtA[iNdEx:postIndex]...)
-			if m.BloomFilter == nil {
-				m.BloomFilter = []byte{}
+			if m.Rg == nil {
+				m.Rg = &Range{}
+			}
+			if err := m.Rg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedSize", wireType)
-			}
-			m.EstimatedSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPspb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EstimatedSize |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumOfBlocks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
 			}
-			m.NumOfBlocks = 0
+			m.UpdatedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -6151,7 +16005,7 @@ func (m *TableIndex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumOfBlocks |= uint32(b&0x7F) << shift
+				m.UpdatedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6177,7 +16031,7 @@ func (m *TableIndex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutRequest) Unmarshal(dAtA []byte) error {
+func (m *SplitPartRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6200,17 +16054,17 @@ func (m *PutRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SplitPartRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SplitPartRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partid", wireType)
 			}
-			var byteLen int
+			m.Partid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -6220,80 +16074,112 @@ func (m *PutRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Partid |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthPspb
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPspb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SplitPartResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
 			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPspb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthPspb
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SplitPartResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SplitPartResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPspb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SnapshotPartRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.ExpiresAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPspb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExpiresAt |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotPartRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotPartRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Partid", wireType)
 			}
@@ -6333,7 +16219,7 @@ func (m *PutRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutResponse) Unmarshal(dAtA []byte) error {
+func (m *SnapshotPartResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6356,17 +16242,17 @@ func (m *PutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SnapshotPartResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SnapshotPartResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -6376,24 +16262,26 @@ func (m *PutResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPspb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPspb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
+			if m.Snapshot == nil {
+				m.Snapshot = &PartitionSnapshot{}
+			}
+			if err := m.Snapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -6417,7 +16305,7 @@ func (m *PutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
+func (m *RestorePartRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6440,17 +16328,17 @@ func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RestorePartRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RestorePartRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partid", wireType)
 			}
-			var byteLen int
+			m.Partid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -6460,31 +16348,16 @@ func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Partid |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthPspb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPspb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Partid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotID", wireType)
 			}
-			m.Partid = 0
+			m.SnapshotID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -6494,7 +16367,7 @@ func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Partid |= uint64(b&0x7F) << shift
+				m.SnapshotID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6520,7 +16393,7 @@ func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
+func (m *RestorePartResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6543,46 +16416,12 @@ func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RestorePartResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RestorePartResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPspb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthPspb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPspb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPspb(dAtA[iNdEx:])
@@ -6604,7 +16443,7 @@ func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRequest) Unmarshal(dAtA []byte) error {
+func (m *CompactOp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6627,65 +16466,62 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CompactOp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CompactOp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPspb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthPspb
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPspb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoGCOp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
 			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Partid", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Partid = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPspb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Partid |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoGCOp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoGCOp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPspb(dAtA[iNdEx:])
@@ -6707,7 +16543,7 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetResponse) Unmarshal(dAtA []byte) error {
+func (m *ForceGCOp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6730,80 +16566,88 @@ func (m *GetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ForceGCOp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ForceGCOp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPspb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPspb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthPspb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPspb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPspb
+				m.ExIDs = append(m.ExIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPspb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				if packedLen < 0 {
+					return ErrInvalidLengthPspb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPspb
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
+				elementCount = count
+				if elementCount != 0 && len(m.ExIDs) == 0 {
+					m.ExIDs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPspb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ExIDs = append(m.ExIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExIDs", wireType)
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthPspb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPspb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPspb(dAtA[iNdEx:])
@@ -6825,7 +16669,7 @@ func (m *GetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RequestOp) Unmarshal(dAtA []byte) error {
+func (m *MaintenanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6848,15 +16692,34 @@ func (m *RequestOp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RequestOp: wiretype end group for non-group")
+			return fmt.Errorf("proto: MaintenanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestOp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MaintenanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partid", wireType)
+			}
+			m.Partid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestPut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Compact", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6883,15 +16746,15 @@ func (m *RequestOp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &PutRequest{}
+			v := &CompactOp{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Request = &RequestOp_RequestPut{v}
+			m.OP = &MaintenanceRequest_Compact{v}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestDelete", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Autogc", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6918,15 +16781,15 @@ func (m *RequestOp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &DeleteRequest{}
+			v := &AutoGCOp{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Request = &RequestOp_RequestDelete{v}
+			m.OP = &MaintenanceRequest_Autogc{v}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestGet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Forcegc", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6953,11 +16816,11 @@ func (m *RequestOp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &GetRequest{}
+			v := &ForceGCOp{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Request = &RequestOp_RequestGet{v}
+			m.OP = &MaintenanceRequest_Forcegc{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6980,7 +16843,7 @@ func (m *RequestOp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResponseOp) Unmarshal(dAtA []byte) error {
+func (m *MaintenanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7003,17 +16866,67 @@ func (m *ResponseOp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResponseOp: wiretype end group for non-group")
+			return fmt.Errorf("proto: MaintenanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResponseOp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MaintenanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HeadRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeadRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResponsePut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -7023,32 +16936,31 @@ func (m *ResponseOp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPspb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPspb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &PutResponse{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
 			}
-			m.Response = &ResponseOp_ResponsePut{v}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResponseDelete", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partid", wireType)
 			}
-			var msglen int
+			m.Partid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -7058,32 +16970,16 @@ func (m *ResponseOp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Partid |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPspb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPspb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &DeleteResponse{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Response = &ResponseOp_ResponseDelete{v}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResponseGet", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotID", wireType)
 			}
-			var msglen int
+			m.SnapshotID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -7093,27 +16989,11 @@ func (m *ResponseOp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SnapshotID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPspb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPspb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &GetResponse{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Response = &ResponseOp_ResponseGet{v}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPspb(dAtA[iNdEx:])
@@ -7135,7 +17015,7 @@ func (m *ResponseOp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchRequest) Unmarshal(dAtA []byte) error {
+func (m *HeadResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7158,15 +17038,15 @@ func (m *BatchRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BatchRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: HeadResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HeadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Req", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7193,8 +17073,10 @@ func (m *BatchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Req = append(m.Req, &RequestOp{})
-			if err := m.Req[len(m.Req)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Info == nil {
+				m.Info = &HeadInfo{}
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7219,7 +17101,7 @@ func (m *BatchRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchResponse) Unmarshal(dAtA []byte) error {
+func (m *HeadInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7242,17 +17124,17 @@ func (m *BatchResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BatchResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: HeadInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HeadInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Res", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -7262,26 +17144,45 @@ func (m *BatchResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPspb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPspb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Res = append(m.Res, &RequestOp{})
-			if err := m.Res[len(m.Res)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Len", wireType)
+			}
+			m.Len = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Len |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPspb(dAtA[iNdEx:])
@@ -7303,7 +17204,7 @@ func (m *BatchResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RangeRequest) Unmarshal(dAtA []byte) error {
+func (m *StreamPutRequestHeader) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7326,15 +17227,15 @@ func (m *RangeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RangeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StreamPutRequestHeader: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StreamPutRequestHeader: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -7361,16 +17262,16 @@ func (m *RangeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prefix = append(m.Prefix[:0], dAtA[iNdEx:postIndex]...)
-			if m.Prefix == nil {
-				m.Prefix = []byte{}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LenOfValue", wireType)
 			}
-			var byteLen int
+			m.LenOfValue = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -7380,31 +17281,16 @@ func (m *RangeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.LenOfValue |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthPspb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPspb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Start = append(m.Start[:0], dAtA[iNdEx:postIndex]...)
-			if m.Start == nil {
-				m.Start = []byte{}
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
-			m.Limit = 0
+			m.ExpiresAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -7414,7 +17300,7 @@ func (m *RangeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= uint32(b&0x7F) << shift
+				m.ExpiresAt |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7459,7 +17345,7 @@ func (m *RangeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RangeResponse) Unmarshal(dAtA []byte) error {
+func (m *StreamPutRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7482,17 +17368,17 @@ func (m *RangeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RangeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: StreamPutRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StreamPutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Truncated", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -7502,15 +17388,30 @@ func (m *RangeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Truncated = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &StreamPutRequestHeader{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Data = &StreamPutRequest_Header{v}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -7537,8 +17438,9 @@ func (m *RangeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Keys = append(m.Keys, make([]byte, postIndex-iNdEx))
-			copy(m.Keys[len(m.Keys)-1], dAtA[iNdEx:postIndex])
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Data = &StreamPutRequest_Payload{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7561,7 +17463,7 @@ func (m *RangeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SplitPartRequest) Unmarshal(dAtA []byte) error {
+func (m *IngestTablesRequestHeader) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7584,10 +17486,10 @@ func (m *SplitPartRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SplitPartRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: IngestTablesRequestHeader: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SplitPartRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IngestTablesRequestHeader: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7609,6 +17511,25 @@ func (m *SplitPartRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOfTables", wireType)
+			}
+			m.NumOfTables = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumOfTables |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPspb(dAtA[iNdEx:])
@@ -7630,7 +17551,7 @@ func (m *SplitPartRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SplitPartResponse) Unmarshal(dAtA []byte) error {
+func (m *IngestTablesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7653,112 +17574,80 @@ func (m *SplitPartResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SplitPartResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: IngestTablesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SplitPartResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IngestTablesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPspb(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPspb
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CompactOp) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPspb
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CompactOp: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CompactOp: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPspb(dAtA[iNdEx:])
-			if err != nil {
+			v := &IngestTablesRequestHeader{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPspb
+			m.Data = &IngestTablesRequest_Header{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AutoGCOp) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPspb
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AutoGCOp: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AutoGCOp: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Data = &IngestTablesRequest_Payload{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPspb(dAtA[iNdEx:])
@@ -7780,7 +17669,7 @@ func (m *AutoGCOp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ForceGCOp) Unmarshal(dAtA []byte) error {
+func (m *IngestTablesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7803,87 +17692,30 @@ func (m *ForceGCOp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ForceGCOp: wiretype end group for non-group")
+			return fmt.Errorf("proto: IngestTablesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ForceGCOp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IngestTablesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPspb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.ExIDs = append(m.ExIDs, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPspb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPspb
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPspb
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.ExIDs) == 0 {
-					m.ExIDs = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPspb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ExIDs = append(m.ExIDs, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExIDs", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -7906,7 +17738,7 @@ func (m *ForceGCOp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MaintenanceRequest) Unmarshal(dAtA []byte) error {
+func (m *ArchiveEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7929,17 +17761,17 @@ func (m *MaintenanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MaintenanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ArchiveEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MaintenanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ArchiveEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Partid", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			m.Partid = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -7949,16 +17781,31 @@ func (m *MaintenanceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Partid |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Compact", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -7968,32 +17815,31 @@ func (m *MaintenanceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPspb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPspb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &CompactOp{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
-			m.OP = &MaintenanceRequest_Compact{v}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Autogc", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -8003,32 +17849,17 @@ func (m *MaintenanceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPspb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPspb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &AutoGCOp{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.OP = &MaintenanceRequest_Autogc{v}
-			iNdEx = postIndex
+			m.Deleted = bool(v != 0)
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Forcegc", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
-			var msglen int
+			m.ExpiresAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -8038,27 +17869,11 @@ func (m *MaintenanceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ExpiresAt |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPspb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPspb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &ForceGCOp{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.OP = &MaintenanceRequest_Forcegc{v}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPspb(dAtA[iNdEx:])
@@ -8080,7 +17895,7 @@ func (m *MaintenanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MaintenanceResponse) Unmarshal(dAtA []byte) error {
+func (m *ArchiveState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8103,67 +17918,51 @@ func (m *MaintenanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MaintenanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ArchiveState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MaintenanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ArchiveState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPspb(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPspb
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HeadRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPspb
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Prefix = append(m.Prefix[:0], dAtA[iNdEx:postIndex]...)
+			if m.Prefix == nil {
+				m.Prefix = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HeadRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HeadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseSeqs", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -8173,31 +17972,96 @@ func (m *HeadRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPspb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPspb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
+			if m.BaseSeqs == nil {
+				m.BaseSeqs = make(map[uint64]uint64)
+			}
+			var mapkey uint64
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPspb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPspb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPspb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPspb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPspb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.BaseSeqs[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Partid", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seqs", wireType)
 			}
-			m.Partid = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -8207,66 +18071,172 @@ func (m *HeadRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Partid |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPspb(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthPspb
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HeadResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPspb
+			if m.Seqs == nil {
+				m.Seqs = make(map[uint64]uint64)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var mapkey uint64
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPspb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPspb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPspb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPspb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPspb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Seqs[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPspb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.DonePartitions = append(m.DonePartitions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPspb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPspb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPspb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.DonePartitions) == 0 {
+					m.DonePartitions = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPspb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.DonePartitions = append(m.DonePartitions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field DonePartitions", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HeadResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HeadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartID", wireType)
 			}
-			var msglen int
+			m.PartID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -8276,81 +18246,14 @@ func (m *HeadResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PartID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPspb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPspb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Info == nil {
-				m.Info = &HeadInfo{}
-			}
-			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPspb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPspb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HeadInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPspb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HeadInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HeadInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastKey", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -8377,16 +18280,16 @@ func (m *HeadInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
+			m.LastKey = append(m.LastKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.LastKey == nil {
+				m.LastKey = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Len", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			m.Len = 0
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -8396,64 +18299,34 @@ func (m *HeadInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Len |= uint32(b&0x7F) << shift
+				m.Count |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPspb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPspb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *StreamPutRequestHeader) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPspb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Done", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StreamPutRequestHeader: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StreamPutRequestHeader: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Done = bool(v != 0)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartKey", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -8480,16 +18353,16 @@ func (m *StreamPutRequestHeader) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
+			m.StartKey = append(m.StartKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StartKey == nil {
+				m.StartKey = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LenOfValue", wireType)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExportedRanges", wireType)
 			}
-			m.LenOfValue = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -8499,49 +18372,26 @@ func (m *StreamPutRequestHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LenOfValue |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
 			}
-			m.ExpiresAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPspb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExpiresAt |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Partid", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Partid = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPspb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Partid |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.ExportedRanges = append(m.ExportedRanges, &Range{})
+			if err := m.ExportedRanges[len(m.ExportedRanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPspb(dAtA[iNdEx:])
@@ -8563,7 +18413,7 @@ func (m *StreamPutRequestHeader) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StreamPutRequest) Unmarshal(dAtA []byte) error {
+func (m *ArchiveChunk) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8586,15 +18436,15 @@ func (m *StreamPutRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StreamPutRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ArchiveChunk: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StreamPutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ArchiveChunk: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8621,17 +18471,16 @@ func (m *StreamPutRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &StreamPutRequestHeader{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, &ArchiveEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Data = &StreamPutRequest_Header{v}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -8641,24 +18490,27 @@ func (m *StreamPutRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPspb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPspb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := make([]byte, postIndex-iNdEx)
-			copy(v, dAtA[iNdEx:postIndex])
-			m.Data = &StreamPutRequest_Payload{v}
+			if m.State == nil {
+				m.State = &ArchiveState{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -223,6 +223,11 @@ func (rp *RangePartition) doCompact(tbls []*table.Table, major bool) {
 		return tbls[i].LastSeq > tbls[j].LastSeq
 	})
 
+	//deletions in tbls are dropped, deleteGCSeq is saved with the compacted tables
+	if major && tbls[0].LastSeq > atomic.LoadUint64(&rp.deleteGCSeq) {
+		atomic.StoreUint64(&rp.deleteGCSeq, tbls[0].LastSeq)
+	}
+
 	discards := getDiscards(tbls)
 
 	var iters []y.Iterator
@@ -256,6 +261,7 @@ func (rp *RangePartition) doCompact(tbls []*table.Table, major bool) {
 	capacity := int64(2 * rp.opt.MaxSkipList)
 	for it.Valid() {
 		var skipKey []byte
+		var underIntent bool //the kept version of skipKey is a txn intent, keep the last committed version too
 		timeStart := time.Now()
 		var numKeys, numSkips uint64
 		memStore := NewMemTable(capacity) //compation memtable size is twice of op.MaxSkipList
@@ -270,11 +276,11 @@ func (rp *RangePartition) doCompact(tbls []*table.Table, major bool) {
 			}
 
 			if len(skipKey) > 0 {
-				if y.SameKey(it.Key(), skipKey) {
+				if y.SameKey(it.Key(), skipKey) && !underIntent {
 					updateStats(it.Value())
 					numSkips++
 					continue
-				} else {
+				} else if !y.SameKey(it.Key(), skipKey) {
 					skipKey = skipKey[:0]
 				}
 			}
@@ -282,8 +288,13 @@ func (rp *RangePartition) doCompact(tbls []*table.Table, major bool) {
 			vs := it.Value()
 
 			skipKey = y.SafeCopy(skipKey, it.Key())
+			underIntent = vs.Meta&BitTxnIntent > 0
 
 			if major && isDeletedOrExpired(vs.Meta, vs.ExpiresAt) {
+				//a newer version may be in memtable
+				if vs.Meta&BitDelete == 0 && rp.opt.OnExpired != nil && rp.getValueStruct(userKey, 0).Version == ts {
+					rp.opt.OnExpired(userKey, int64(len(userKey))+int64(valueLen(vs)))
+				}
 				updateStats(it.Value()) //it is expired && bolb value, add discard
 				numSkips++
 				continue
@@ -165,3 +165,44 @@ func TestDicardBigData(t *testing.T) {
 	// }
 
 }
+
+func TestCompactionOnExpired(t *testing.T) {
+	logStream := streamclient.NewMockStreamClient("log")
+	rowStream := streamclient.NewMockStreamClient("sst")
+	metaStream := streamclient.NewMockStreamClient("meta")
+
+	defer logStream.Close()
+	defer rowStream.Close()
+	defer metaStream.Close()
+
+	var mu sync.Mutex
+	expired := make(map[string]int64)
+	onExpired := WithOnExpired(func(userKey []byte, size int64) {
+		mu.Lock()
+		defer mu.Unlock()
+		expired[string(userKey)] += size
+	})
+
+	rp, err := OpenRangePartition(3, metaStream, rowStream, logStream,
+		[]byte(""), []byte(""), TestOption(), onExpired)
+	require.Nil(t, err)
+	expiresAt := uint64(time.Now().Unix()) + 1
+	for _, key := range []string{"e1", "e2", "e3"} {
+		require.Nil(t, rp.WriteEntries([]*Entry{NewPutKVEntry([]byte(key), []byte("value"), expiresAt)}))
+	}
+	//overwritten before expired
+	require.Nil(t, rp.Write([]byte("e2"), []byte("value")))
+	require.Nil(t, rp.Write([]byte("k"), []byte("value")))
+	rp.Close()
+
+	rp, err = OpenRangePartition(3, metaStream, rowStream, logStream,
+		[]byte(""), []byte(""), TestOption(), onExpired)
+	require.Nil(t, err)
+	defer rp.Close()
+	//the new version is in memtable
+	require.Nil(t, rp.Write([]byte("e3"), []byte("value")))
+
+	time.Sleep(time.Until(time.Unix(int64(expiresAt)+1, 0)))
+	rp.doCompact(rp.getTables(), true)
+	require.Equal(t, map[string]int64{"e1": 7}, expired)
+}
@@ -44,6 +44,9 @@ type block = []byte
 const (
 	BitDelete       byte = 1 << 0    // Set if the key has been deleted.
 	BitValuePointer byte = 1 << 1    // Set if the value is NOT stored directly next to key.
+	BitGCMoved      byte = 1 << 2    // Set if the entry is rewritten by GC, watchers skip it.
+	BitTxnIntent    byte = 1 << 3    // Set if the value is a txn intent, empty value means the intent is cleared.
+	BitReplicated   byte = 1 << 4    // Set if the entry is written by replication, value starts with its origin.
 	ValueThrottle        = (4 << 10) // 4 * KB
 )
 
@@ -82,6 +85,13 @@ func NewPutKVEntry(k, v []byte, expireAt uint64) *Entry {
 	return entry
 }
 
+//NewTxnIntentEntry creates an intent entry, Get skips intents and returns the last committed value
+func NewTxnIntentEntry(userKey []byte, intent []byte) *Entry {
+	entry := NewPutKVEntry(userKey, intent, 0)
+	entry.setMeta(entry.Meta | uint32(BitTxnIntent))
+	return entry
+}
+
 func NewPutEntry(userKey []byte, expireAt uint64, lenOfValue uint32) *Entry {
 	entry := &Entry{
 		inner: make([]byte, len(userKey)+int(lenOfValue)+28),
@@ -125,6 +135,13 @@ func (entry *Entry) UpdateTS(ts uint64) {
 	binary.BigEndian.PutUint64(entry.Key[len(entry.Key)-8:], math.MaxUint64-ts)
 }
 
+//setMeta updates meta in both entry.Meta and inner
+func (entry *Entry) setMeta(meta uint32) {
+	keyLength := binary.BigEndian.Uint32(entry.inner[:4])
+	binary.BigEndian.PutUint32(entry.inner[4+keyLength+8:], meta)
+	entry.Meta = meta
+}
+
 func (entry *Entry) FinishWrite() error {
 	return entry.Decode()
 }
@@ -0,0 +1,186 @@
+package range_partition
+
+import (
+	"bytes"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/journeymidnight/autumn/range_partition/table"
+	"github.com/journeymidnight/autumn/range_partition/y"
+	"github.com/journeymidnight/autumn/xlog"
+	"github.com/pkg/errors"
+)
+
+/*
+tables built out of the cluster have keys with any ts, and a key could be in several ingested tables, so
+keys are rewritten with one seq when they are appended to rowStream. memtable is flushed before the
+ingested tables, so keys in memtable are always newer than keys in tables. log replay of an ingested
+table starts from where the flushed memtable ends.
+
+ingested keys are not written to logStream, watchers never see them. partition servers reject ingestion
+to partitions whose keys are indexed or replicated
+*/
+
+//ingestTask is sent to flushChan after the memtable
+type ingestTask struct {
+	srcs     []*table.Table
+	seq      uint64
+	resultCh chan struct{}
+}
+
+//checkIngestTables makes sure keys of srcs are sorted, in range of rp, and srcs do not overlap each other
+func (rp *RangePartition) checkIngestTables(srcs []*table.Table) error {
+	if len(srcs) == 0 {
+		return errors.New("no table to ingest")
+	}
+	type span struct {
+		first, last []byte
+	}
+	spans := make([]span, len(srcs))
+	for i, src := range srcs {
+		it := src.NewIterator(false)
+		var last []byte
+		for it.Rewind(); it.Valid(); it.Next() {
+			userKey := y.ParseKey(it.Key())
+			if last != nil && bytes.Compare(last, userKey) >= 0 {
+				it.Close()
+				return errors.Errorf("keys of table %d are not sorted at %q", i, userKey)
+			}
+			if !rp.IsUserKeyInRange(userKey) {
+				it.Close()
+				return errors.Errorf("key %q of table %d is not in partition %d", userKey, i, rp.PartID)
+			}
+			if meta := it.Value().Meta; meta&^BitDelete != 0 {
+				it.Close()
+				return errors.Errorf("key %q of table %d has unsupported meta %d", userKey, i, meta)
+			}
+			if spans[i].first == nil {
+				spans[i].first = y.Copy(userKey)
+			}
+			last = y.Copy(userKey)
+		}
+		it.Close()
+		if err := it.Error(); err != nil {
+			return errors.Wrapf(err, "read table %d", i)
+		}
+		if last == nil {
+			return errors.Errorf("table %d is empty", i)
+		}
+		spans[i].last = last
+	}
+
+	sort.Slice(spans, func(i, j int) bool {
+		return bytes.Compare(spans[i].first, spans[j].first) < 0
+	})
+	for i := 1; i < len(spans); i++ {
+		if bytes.Compare(spans[i-1].last, spans[i].first) >= 0 {
+			return errors.Errorf("tables overlap at %q", spans[i].first)
+		}
+	}
+	return nil
+}
+
+//IngestTables appends srcs to rowStream and adds them to rp.tables at once. keys of srcs get one seq above
+//all existing data, so they overwrite older values, and are overwritten by later writes. ingested keys are
+//not published to watchers. it returns the seq of ingested keys
+func (rp *RangePartition) IngestTables(srcs []*table.Table) (uint64, error) {
+	if err := rp.checkIngestTables(srcs); err != nil {
+		return 0, err
+	}
+	if atomic.LoadInt32(&rp.blockWrites) == 1 {
+		return 0, ErrBlockedWrites
+	}
+
+	task := &ingestTask{srcs: srcs, resultCh: make(chan struct{}, 1)}
+	req := requestPool.Get().(*request)
+	req.reset()
+	req.ingest = task
+	req.wg.Add(1)
+	req.IncrRef()
+	rp.writeCh <- req
+	if err := req.Wait(); err != nil {
+		return 0, err
+	}
+	return task.seq, nil
+}
+
+//submitIngest is called in the order of commits, after all previous writes are in memtable and before any
+//later write. it flushes memtable, and sends task to flushChan after it. keys in memtable are older than task.seq
+func (rp *RangePartition) submitIngest(task *ingestTask) {
+	vp := rp.vhead
+	if vp.extentID == 0 {
+		//nothing has been written to logStream, log replay starts from the first extent
+		vp = valuePointer{extentID: rp.logStream.StreamInfo().ExtentIDs[0]}
+	}
+
+	for !rp.mt.Empty() {
+		pushed := func() bool {
+			rp.Lock()
+			defer rp.Unlock()
+			select {
+			case rp.flushChan <- flushTask{mt: rp.mt, vptr: rp.vhead, seqNum: task.seq - 1, discards: rp.mt.OriginDiscard}:
+				rp.imm = append(rp.imm, rp.mt)
+				rp.mt = NewMemTable(rp.opt.MaxSkipList)
+				rp.unCommitedLogSize = 0
+				return true
+			default:
+				//the flusher needs rp.Lock to update rp.imm
+				return false
+			}
+		}()
+		if !pushed {
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+
+	rp.flushChan <- flushTask{vptr: vp, seqNum: task.seq, ingest: task}
+}
+
+//handleIngestTask is called by flushMemtable, it retries until all tables are saved
+func (rp *RangePartition) handleIngestTask(ft flushTask) {
+	tbls := make([]*table.Table, 0, len(ft.ingest.srcs))
+	for _, src := range ft.ingest.srcs {
+		for {
+			tbl, err := rp.rewriteTable(src, ft)
+			if err == nil {
+				tbls = append(tbls, tbl)
+				break
+			}
+			xlog.Logger.Errorf("Failure while ingesting table: %v. Retrying...", err)
+			time.Sleep(time.Second)
+		}
+		src.Close()
+	}
+
+	rp.tableLock.Lock()
+	rp.tables = append(rp.tables, tbls...)
+	rp.tableLock.Unlock()
+	rp.saveTableLocs()
+
+	xlog.Logger.Infof("ingested %d tables to partition %d, seq %d", len(tbls), rp.PartID, ft.seqNum)
+	ft.ingest.resultCh <- struct{}{}
+}
+
+//rewriteTable writes keys of src with ft.seqNum to rowStream, src has been read by checkIngestTables
+func (rp *RangePartition) rewriteTable(src *table.Table, ft flushTask) (*table.Table, error) {
+	b := table.NewTableBuilder(rp.rowStream, rp.opt.CompressionType)
+	defer b.Close()
+
+	var size uint64
+	it := src.NewIterator(false)
+	defer it.Close()
+	for it.Rewind(); it.Valid(); it.Next() {
+		key := y.KeyWithTs(y.ParseKey(it.Key()), ft.seqNum)
+		vs := it.Value()
+		b.Add(key, vs)
+		size += uint64(estimatedVS(key, vs))
+	}
+	b.FinishBlock()
+
+	id, offset, err := b.FinishAll(ft.vptr.extentID, ft.vptr.offset, ft.seqNum, nil, size)
+	if err != nil {
+		return nil, err
+	}
+	return table.OpenTable(rp.rowStream, id, offset)
+}
@@ -27,6 +27,8 @@ type Option struct {
 	CompressionType table.CompressionType
 	AssertKeys      bool
 	MaxUnCommitedLogSize uint64
+	ReadOnly        bool //nothing is written to streams, used to open snapshots
+	OnExpired       func(userKey []byte, size int64) //called when major compaction drops an expired key
 }
 
 type OptionFunc func(*Option)
@@ -60,6 +62,19 @@ func WithAssertKeys() OptionFunc {
 		opt.AssertKeys = true
 	}
 }
+//WithReadOnly opens range partition without writing, writes return ErrBlockedWrites
+func WithReadOnly() OptionFunc {
+	return func(opt *Option) {
+		opt.ReadOnly = true
+	}
+}
+//WithOnExpired calls f when major compaction drops the latest version of a key because it
+//has expired, size is the size of key and value
+func WithOnExpired(f func(userKey []byte, size int64)) OptionFunc {
+	return func(opt *Option) {
+		opt.OnExpired = f
+	}
+}
 func WithSync(b bool) OptionFunc {
 	return func(opt *Option) {
 		opt.MustSync = b
@@ -47,8 +47,16 @@ var (
 	errNoRoom        = errors.New("No room for write")
 	errNotFound      = errors.New("not found")
 	ErrBlockedWrites = errors.New("Writes are blocked, possibly due to DropAll or Close")
+	ErrTxnConflict   = errors.New("txn conflict: key has been updated")
+	ErrTxnLocked     = errors.New("key is locked by a txn intent")
+	errGCTxnIntent   = errors.New("GC: entry is under a txn intent")
+	errReadOnlyFull  = errors.New("unflushed log of read-only partition does not fit in memtables")
 )
 
+//a live partition keeps at most flushChanSize+1 memtables waiting for flush, a read-only partition
+//replays no more than that
+const flushChanSize = 16
+
 type MemTable struct {
 	*skiplist.Skiplist
 	/*
@@ -79,12 +87,16 @@ type RangePartition struct {
 	imm             []*MemTable
 	utils.SafeMutex //protect mt,imm when swapping mt, imm
 
-	flushStopper *utils.Stopper
-	flushChan    chan flushTask
-	writeStopper *utils.Stopper
-	tableLock    utils.SafeMutex //protect tables
-	tables       []*table.Table
-	seqNumber    uint64
+	flushStopper  *utils.Stopper
+	flushChan     chan flushTask
+	writeStopper  *utils.Stopper
+	lastCommit    chan struct{}     //closed when the last batch of writeRequests is in memtable
+	lastTxnCommit chan struct{}     //closed when the last txn request is in memtable
+	intentLock    sync.Mutex        //protect intents
+	intents       map[string]uint64 //keys which may have txn intents => ts of their last intent
+	tableLock     utils.SafeMutex   //protect tables
+	tables        []*table.Table
+	seqNumber     uint64
 
 	PartID   uint64
 	StartKey []byte
@@ -105,6 +117,15 @@ type RangePartition struct {
 
 	gcRunChan chan GcTask
 	gcStopper *utils.Stopper
+
+	metaLock    sync.Mutex //serialize saveTableLocs
+	logGCSeq    uint64     //atomic, entries whose seq <= logGCSeq may have been GC'd
+	deleteGCSeq uint64     //atomic, deletions whose seq <= deleteGCSeq may have been dropped by major compaction
+
+	watchLock    sync.Mutex //protect watchers
+	watchers     map[*logWatcher]struct{}
+	watchClosed  bool
+	committedSeq uint64 //atomic, seq of the last entry written to LSM
 	unCommitedLogSize uint64// if unCommitedLogSize is biggger than throshold, force to flush sst.
 }
 
@@ -135,8 +156,12 @@ func OpenRangePartition(id uint64, metaStream streamclient.StreamClient, rowStre
 		EndKey:      endKey,
 		PartID:      id,
 		opt:         opt,
+		watchers:    make(map[*logWatcher]struct{}),
+		intents:     make(map[string]uint64),
+	}
+	if !opt.ReadOnly {
+		rp.startMemoryFlush()
 	}
-	rp.startMemoryFlush()
 
 	/*
 		fmt.Printf("log end is %d\n", logStream.CommitEnd())
@@ -156,6 +181,8 @@ func OpenRangePartition(id uint64, metaStream streamclient.StreamClient, rowStre
 	}
 
 	fmt.Printf("table locs is %v\n", tableLocs.Locs)
+	rp.logGCSeq = tableLocs.LogGCSeq
+	rp.deleteGCSeq = tableLocs.DeleteGCSeq
 
 	//replay log
 	//open tables
@@ -169,6 +196,11 @@ func OpenRangePartition(id uint64, metaStream streamclient.StreamClient, rowStre
 			goto retry
 		}
 		rp.tables = append(rp.tables, tbl)
+		if tbl.HasTxnIntents {
+			if err = rp.addTableIntents(tbl); err != nil {
+				return nil, err
+			}
+		}
 
 		key := y.ParseKey(tbl.Smallest())
 
@@ -229,7 +261,8 @@ func OpenRangePartition(id uint64, metaStream streamclient.StreamClient, rowStre
 		* 放不到mt里面,会强制刷memtable, 而这个table的vp, 指向block的offset
 		* 并且刷出来的table只有前2个entry, 总之结果就是有2个table有overlap的key的情况
 		 */
-		for err := rp.ensureRoomForWrite(entriesReady, head); err == errNoRoom; err = rp.ensureRoomForWrite(entriesReady, head) {
+		err := rp.ensureRoomForWrite(entriesReady, head)
+		for ; err == errNoRoom; err = rp.ensureRoomForWrite(entriesReady, head) {
 			i++
 			if i%100 == 0 {
 				xlog.Logger.Infof("Making room for writes")
@@ -239,6 +272,9 @@ func OpenRangePartition(id uint64, metaStream streamclient.StreamClient, rowStre
 			// you will get a deadlock.
 			time.Sleep(10 * time.Millisecond)
 		}
+		if err != nil {
+			return false, err
+		}
 		/*
 			if len(ei.Log.Key) == 0 {
 				return true, nil
@@ -248,6 +284,7 @@ func OpenRangePartition(id uint64, metaStream streamclient.StreamClient, rowStre
 
 
 		rp.writeToLSM([]*Entry{ei})
+		rp.addIntents([]*Entry{ei})
 
 		rp.vhead = head
 		return true, nil
@@ -271,10 +308,24 @@ func OpenRangePartition(id uint64, metaStream streamclient.StreamClient, rowStre
 	}
 
 	rp.unCommitedLogSize = logSizeRead
+	rp.committedSeq = rp.seqNumber
+
+	//intents found in tables and log may have been resolved later
+	for key := range rp.intents {
+		if !isIntent(rp.getValueStruct([]byte(key), 0)) {
+			delete(rp.intents, key)
+		}
+	}
 
 	//xlog.Logger.Infof("replayed log number: %d, time taken %v\n", replayedLog, time.Since(start))
 	fmt.Printf("replayed log number: %d, mt size is %d, time taken %v, read size %v \n", replayedLog, rp.mt.MemSize(), time.Since(start), logSizeRead)
 
+	if rp.opt.ReadOnly {
+		//no write loop, compaction or gc
+		atomic.StoreInt32(&rp.blockWrites, 1)
+		return rp, nil
+	}
+
 	//start real write
 	rp.startWriteLoop()
 
@@ -301,6 +352,7 @@ type flushTask struct {
 	isCompact    bool           //如果是compact任务, 不需要修改rp.mt
 	resultCh     chan struct{}  //也可以用wg, 但是防止未来还需要发数据
 	removedTable []*table.Table //一次compact可以新建多个table, 只有最后一个table有removedTable和discard
+	ingest       *ingestTask
 }
 
 //split相关, 提供相关参数给上层
@@ -362,6 +414,9 @@ func (rp *RangePartition) LogRowMetaStreamEnd() CommitEnds {
 func (rp *RangePartition) startWriteLoop() {
 	rp.writeStopper = utils.NewStopper()
 	rp.writeCh = make(chan *request, rp.opt.WriteChCapacity)
+	rp.lastCommit = make(chan struct{})
+	close(rp.lastCommit)
+	rp.lastTxnCommit = rp.lastCommit
 
 	rp.writeStopper.RunWorker(rp.doWrites)
 }
@@ -374,7 +429,7 @@ func (rp *RangePartition) startMemoryFlush() {
 	// Start memory fluhser.
 
 	rp.flushStopper = utils.NewStopper()
-	rp.flushChan = make(chan flushTask, 16)
+	rp.flushChan = make(chan flushTask, flushChanSize)
 
 	rp.flushStopper.RunWorker(rp.flushMemtable)
 }
@@ -398,6 +453,9 @@ func (rp *RangePartition) handleFlushTask(ft flushTask) (*table.Table, error) {
 		}
 		//fmt.Printf("%s:%s\n", string(iter.Key()), iter.Value().Value)
 		b.Add(iter.Key(), iter.Value())
+		if isIntent(iter.Value()) {
+			b.MarkTxnIntents()
+		}
 		last = iter.Key()
 	}
 
@@ -450,6 +508,10 @@ func (rp *RangePartition) flushMemtable() {
 	compactedTbls := make([]*table.Table, 0, 10)
 
 	for ft := range rp.flushChan {
+		if ft.ingest != nil {
+			rp.handleIngestTask(ft)
+			continue
+		}
 		if ft.mt == nil {
 			// We close db.flushChan now, instead of sending a nil ft.mt.
 			continue
@@ -522,7 +584,12 @@ func (rp *RangePartition) flushMemtable() {
 }
 
 func (rp *RangePartition) saveTableLocs() {
+	rp.metaLock.Lock()
+	defer rp.metaLock.Unlock()
+
 	var locations pspb.TableLocations
+	locations.LogGCSeq = atomic.LoadUint64(&rp.logGCSeq)
+	locations.DeleteGCSeq = atomic.LoadUint64(&rp.deleteGCSeq)
 
 	//save all table's offset in metaStream
 	rp.tableLock.RLock()
@@ -571,7 +638,9 @@ type request struct {
 	//A = "x", A:time = "20191224", A:md5 = "fbd80028d33edaa2d7bace539f534cb2"
 	entries []*Entry
 
-	isGCRequest bool
+	isGCRequest  bool
+	isTxnRequest bool //all entries are written only if their keys are not updated after entry's ts
+	ingest       *ingestTask
 	// Output values and wait group stuff below
 	wg  sync.WaitGroup
 	Err error
@@ -621,6 +690,8 @@ func (req *request) reset() {
 	req.Err = nil
 	req.ref = 0
 	req.isGCRequest = false
+	req.isTxnRequest = false
+	req.ingest = nil
 }
 
 func (req *request) IncrRef() {
@@ -642,12 +713,48 @@ func (req *request) Wait() error {
 	return err
 }
 
+func finishRequests(reqs []*request, err error) {
+	for _, r := range reqs {
+		r.Err = err
+		r.wg.Done()
+	}
+}
+
 // writeRequests is called serially by only one goroutine.
+// it sends entries to logStream without waiting for the result, so writes of several
+// batches are overlapped, commitRequests writes them to memtable in order.
 func (rp *RangePartition) writeRequests(reqs []*request) error {
 	if len(reqs) == 0 {
 		return nil
 	}
 
+	done := func(err error) {
+		finishRequests(reqs, err)
+	}
+
+	//checks of GC and txn read memtable, wait for all previous writes
+	if reqs[0].isGCRequest || reqs[0].isTxnRequest {
+		<-rp.lastCommit
+	}
+
+	if reqs[0].ingest != nil {
+		utils.AssertTruef(len(reqs) == 1, "ingest request should be the only request")
+		//later writes get larger seqs, memtable is flushed after previous writes are in it
+		task := reqs[0].ingest
+		task.seq = atomic.AddUint64(&rp.seqNumber, 1)
+		prev := rp.lastCommit
+		next := make(chan struct{})
+		rp.lastCommit = next
+		go func() {
+			<-prev
+			rp.submitIngest(task)
+			close(next)
+			<-task.resultCh
+			done(nil)
+		}()
+		return nil
+	}
+
 	if reqs[0].isGCRequest {
 		utils.AssertTruef(len(reqs) == 1, "GC request should be the only request")
 		gcRequest := reqs[0]
@@ -655,6 +762,11 @@ func (rp *RangePartition) writeRequests(reqs []*request) error {
 			userKey := y.ParseKey(gcRequest.entries[i].Key)
 			vs := rp.getValueStruct(userKey, 0)
 			if vs.Version > y.ParseTs(gcRequest.entries[i].Key) {
+				//moving the last committed version would put it above the intent
+				if vs.Meta&BitTxnIntent > 0 && rp.getCommittedValueStruct(userKey).Version == y.ParseTs(gcRequest.entries[i].Key) {
+					done(errGCTxnIntent)
+					return errGCTxnIntent
+				}
 				//exclude this entry
 				gcRequest.entries = append(gcRequest.entries[:i], gcRequest.entries[i+1:]...)
 				continue
@@ -662,6 +774,28 @@ func (rp *RangePartition) writeRequests(reqs []*request) error {
 		}
 	}
 
+	//plain writes never overwrite txn intents, the intent must be resolved first.
+	//intents are written only by txn requests, if a key may have an intent, wait until
+	//the last one is in memtable and check it
+	if !reqs[0].isGCRequest && !reqs[0].isTxnRequest && rp.mayBeLocked(reqs) {
+		<-rp.lastTxnCommit
+		if reqs = rp.rejectLocked(reqs); len(reqs) == 0 {
+			return nil
+		}
+	}
+
+	if reqs[0].isTxnRequest {
+		utils.AssertTruef(len(reqs) == 1, "txn request should be the only request")
+		for _, e := range reqs[0].entries {
+			vs := rp.getValueStruct(y.ParseKey(e.Key), 0)
+			if vs.Version > y.ParseTs(e.Key) {
+				//not an error of writes
+				done(ErrTxnConflict)
+				return nil
+			}
+		}
+	}
+
 	//update entry's ts, make sure all entry's ts is strictly increasing
 	for i := range reqs {
 		for j := range reqs[i].entries {
@@ -669,17 +803,126 @@ func (rp *RangePartition) writeRequests(reqs []*request) error {
 			reqs[i].entries[j].UpdateTS(atomic.AddUint64(&rp.seqNumber, 1))
 		}
 	}
+	if reqs[0].isTxnRequest {
+		rp.addIntents(reqs[0].entries)
+	}
 
-	done := func(err error) {
-		for _, r := range reqs {
-			r.Err = err
-			r.wg.Done()
+	xlog.Logger.Debugf("writeRequests called. Writing to log, len[%d]", len(reqs))
+
+	wait := rp.writeValueLog(reqs)
+	prev := rp.lastCommit
+	next := make(chan struct{})
+	rp.lastCommit = next
+	if reqs[0].isTxnRequest {
+		rp.lastTxnCommit = next
+	}
+	go func() {
+		defer close(next)
+		<-prev
+		if err := rp.commitRequests(reqs, wait); err != nil {
+			xlog.Logger.Errorf("writeRequests: %v", err)
+		} else if reqs[0].isTxnRequest {
+			rp.forgetIntents(reqs[0].entries)
 		}
+	}()
+	return nil
+}
+
+//rejectLocked finishes requests which write a key under txn intent with ErrTxnLocked,
+//returns the other requests
+func (rp *RangePartition) rejectLocked(reqs []*request) []*request {
+	n := 0
+	for _, r := range reqs {
+		locked := false
+		for _, e := range r.entries {
+			if isIntent(rp.getValueStruct(y.ParseKey(e.Key), 0)) {
+				locked = true
+				break
+			}
+		}
+		if locked {
+			finishRequests([]*request{r}, ErrTxnLocked)
+			continue
+		}
+		reqs[n] = r
+		n++
 	}
+	return reqs[:n]
+}
 
-	xlog.Logger.Debugf("writeRequests called. Writing to log, len[%d]", len(reqs))
+//mayBeLocked returns true if any key of reqs may have a txn intent
+func (rp *RangePartition) mayBeLocked(reqs []*request) bool {
+	rp.intentLock.Lock()
+	defer rp.intentLock.Unlock()
+	if len(rp.intents) == 0 {
+		return false
+	}
+	for _, r := range reqs {
+		for _, e := range r.entries {
+			if _, ok := rp.intents[string(y.ParseKey(e.Key))]; ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+//addIntents records keys of intents in entries, it is called before entries are in memtable
+func (rp *RangePartition) addIntents(entries []*Entry) {
+	rp.intentLock.Lock()
+	defer rp.intentLock.Unlock()
+	for _, e := range entries {
+		if isIntentEntry(e) {
+			rp.intents[string(y.ParseKey(e.Key))] = y.ParseTs(e.Key)
+		}
+	}
+}
+
+//forgetIntents drops keys whose intents are overwritten by committed entries
+func (rp *RangePartition) forgetIntents(entries []*Entry) {
+	rp.intentLock.Lock()
+	defer rp.intentLock.Unlock()
+	for _, e := range entries {
+		if isIntentEntry(e) {
+			continue
+		}
+		key := string(y.ParseKey(e.Key))
+		//a later txn may have written a new intent
+		if ts, ok := rp.intents[key]; ok && ts < y.ParseTs(e.Key) {
+			delete(rp.intents, key)
+		}
+	}
+}
+
+//addTableIntents records keys of intents in tbl, tbl may have keys out of range after split
+func (rp *RangePartition) addTableIntents(tbl *table.Table) error {
+	it := tbl.NewIterator(false)
+	defer it.Close()
+	for it.Rewind(); it.Valid(); it.Next() {
+		userKey := y.ParseKey(it.Key())
+		if isIntent(it.Value()) && rp.IsUserKeyInRange(userKey) {
+			rp.intents[string(userKey)] = y.ParseTs(it.Key())
+		}
+	}
+	return it.Error()
+}
+
+func isIntentEntry(e *Entry) bool {
+	return e.Meta&uint32(BitTxnIntent) > 0 && len(e.Value) > 0
+}
+
+//isIntent returns true if vs is a txn intent which is not cleared
+func isIntent(vs y.ValueStruct) bool {
+	return vs.Meta&BitTxnIntent > 0 && (vs.Meta&BitValuePointer > 0 || len(vs.Value) > 0)
+}
+
+//commitRequests writes reqs to memtable after they are written to logStream
+func (rp *RangePartition) commitRequests(reqs []*request, wait func() ([]*Entry, valuePointer, error)) error {
+	done := func(err error) {
+		finishRequests(reqs, err)
+	}
 
-	entriesReady, head, err := rp.writeValueLog(reqs)
+	entriesReady, head, err := wait()
 	if err != nil {
 		done(err)
 		return err
@@ -719,6 +962,10 @@ func (rp *RangePartition) writeRequests(reqs []*request) error {
 	}
 
 	rp.vhead = head
+	if !reqs[0].isGCRequest && len(entriesReady) > 0 {
+		atomic.StoreUint64(&rp.committedSeq, y.ParseTs(entriesReady[len(entriesReady)-1].Key))
+		rp.publish(entriesReady)
+	}
 	done(nil)
 	return nil
 }
@@ -810,10 +1057,12 @@ func (rp *RangePartition) doWrites() {
 		}
 
 		for {
-			if r.isGCRequest {
+			if r.isGCRequest || r.isTxnRequest || r.ingest != nil {
 				pendingCh <- struct{}{} // blocking.
 				if len(reqs) > 0 {
+					//writeRequests takes the pending slot, push it again for r
 					writeRequests(reqs)
+					pendingCh <- struct{}{}
 				}
 				reqs = make([]*request, 1)
 				reqs[0] = r
@@ -854,6 +1103,8 @@ func (rp *RangePartition) doWrites() {
 			default:
 				pendingCh <- struct{}{} // Push to pending before doing a write.
 				writeRequests(reqs)
+				//wait for all requests written to memtable
+				<-rp.lastCommit
 				return
 			}
 		}
@@ -884,6 +1135,16 @@ func (rp *RangePartition) ensureRoomForWrite(entries []*Entry, head valuePointer
 
 	rp.unCommitedLogSize = 0
 
+	if rp.opt.ReadOnly {
+		//memtables are never flushed, keep all of them
+		if len(rp.imm) > flushChanSize {
+			return errReadOnlyFull
+		}
+		rp.imm = append(rp.imm, rp.mt)
+		rp.mt = NewMemTable(rp.opt.MaxSkipList)
+		return nil
+	}
+
 	//non-block, block if flushChan is full,
 	select {
 	case rp.flushChan <- flushTask{mt: rp.mt, vptr: head, seqNum: atomic.LoadUint64(&rp.seqNumber), isCompact: false, discards: rp.mt.OriginDiscard}:
@@ -961,13 +1222,34 @@ func (rp *RangePartition) getTablesForKey(userKey []byte) []*table.Table {
 }
 
 func (rp *RangePartition) Range(prefix []byte, start []byte, limit uint32) [][]byte {
+	out, _, _ := rp.RangeSince(prefix, start, limit, 0)
+	return out
+}
+
+//DeleteGCSeq returns the seq up to which deletions may have been dropped by major compaction, RangeSince
+//misses deleted keys if sinceSeq is less than it
+func (rp *RangePartition) DeleteGCSeq() uint64 {
+	return atomic.LoadUint64(&rp.deleteGCSeq)
+}
+
+//RangeSince returns keys changed after sinceSeq, deleted[i] is true if out[i] is deleted. if sinceSeq is 0,
+//it is the same as Range, deleted keys are not returned. seq is the seq of partition before ranging
+func (rp *RangePartition) RangeSince(prefix []byte, start []byte, limit uint32, sinceSeq uint64) (out [][]byte, deleted []bool, seq uint64) {
+	out, deleted, seq, _ = rp.RangeWithIntents(prefix, start, limit, sinceSeq)
+	return
+}
+
+//RangeWithIntents is the same as RangeSince, and returns keys whose latest version is a txn intent,
+//their last committed versions are in out
+func (rp *RangePartition) RangeWithIntents(prefix []byte, start []byte, limit uint32, sinceSeq uint64) (out [][]byte, deleted []bool, seq uint64, intents [][]byte) {
 
 	hasOverLap := atomic.LoadUint32(&rp.hasOverlap) == 1
 	iter := rp.newIterator()
 	defer iter.Close()
-	var out [][]byte
 	var skipKey []byte //note:包括seqnum
-	startTs := y.KeyWithTs(start, atomic.LoadUint64(&rp.seqNumber))
+	var lastKey []byte //user key of the last entry, the first entry of a user key is the latest version
+	seq = atomic.LoadUint64(&rp.seqNumber)
+	startTs := y.KeyWithTs(start, seq)
 	//readTs: 是否以后支持readTs:如果version比readTS大, 则忽略这个版本
 	for iter.Seek(startTs); iter.Valid() && uint32(len(out)) < limit; iter.Next() {
 
@@ -996,27 +1278,58 @@ func (rp *RangePartition) Range(prefix []byte, start []byte, limit uint32) [][]b
 				skipKey = skipKey[:0] //reset
 			}
 		}
-		skipKey = y.SafeCopy(skipKey, iter.Key())
 
 		vs := iter.Value()
+		latest := !bytes.Equal(userKey, lastKey)
+		lastKey = y.SafeCopy(lastKey, userKey)
+		//skip txn intents, check the next version
+		if vs.Meta&BitTxnIntent > 0 {
+			if latest && isIntent(vs) {
+				intents = append(intents, y.Copy(userKey))
+			}
+			continue
+		}
+		skipKey = y.SafeCopy(skipKey, iter.Key())
 
+		if sinceSeq > 0 && y.ParseTs(iter.Key()) <= sinceSeq {
+			continue
+		}
 		if isDeletedOrExpired(vs.Meta, vs.ExpiresAt) {
+			if sinceSeq > 0 && vs.Meta&BitDelete > 0 {
+				out = append(out, y.Copy(userKey))
+				deleted = append(deleted, true)
+			}
 			continue
 		}
 		//FIXME:slab allocation key
 		out = append(out, y.Copy(userKey))
+		if sinceSeq > 0 {
+			deleted = append(deleted, false)
+		}
 	}
-	return out
+	return
 }
 
 func (rp *RangePartition) Head(userKey []byte) (*pspb.HeadInfo, error) {
 	vs := rp.getValueStruct(userKey, 0)
+	if vs.Meta&BitTxnIntent > 0 {
+		vs = rp.getCommittedValueStruct(userKey)
+	}
 
 	if vs.Version == 0 {
 		return nil, errNotFound
 	} else if (vs.Meta & BitDelete) > 0 {
 		return nil, errNotFound
 	}
+	return &pspb.HeadInfo{
+		Key: userKey,
+		Len: valueLen(vs),
+	}, nil
+
+}
+
+//valueLen returns the length of user's value in vs
+func valueLen(vs y.ValueStruct) uint32 {
 	dataLen := uint32(0)
 	if vs.Meta&BitValuePointer > 0 {
 		var vp valuePointer
@@ -1025,27 +1338,78 @@ func (rp *RangePartition) Head(userKey []byte) (*pspb.HeadInfo, error) {
 	} else {
 		dataLen = uint32(len(vs.Value))
 	}
-
-	return &pspb.HeadInfo{
-		Key: userKey,
-		Len: dataLen,
-	}, nil
-
+	if vs.Meta&BitReplicated > 0 {
+		dataLen -= originSize
+	}
+	return dataLen
 }
 
+//Get returns the last committed value, txn intents are skipped
 func (rp *RangePartition) Get(userKey []byte) ([]byte, error) {
+	value, _, err := rp.GetWithExpiresAt(userKey)
+	return value, err
+}
 
-	vs := rp.getValueStruct(userKey, 0)
+//GetWithExpiresAt returns the last committed value and when it expires, 0 if it never expires
+func (rp *RangePartition) GetWithExpiresAt(userKey []byte) ([]byte, uint64, error) {
 
-	span := opentracing.GlobalTracer().StartSpan("GetObject")
-	defer span.Finish()
-	ctx := opentracing.ContextWithSpan(context.Background(), span)
+	vs := rp.getValueStruct(userKey, 0)
+	if vs.Meta&BitTxnIntent > 0 {
+		vs = rp.getCommittedValueStruct(userKey)
+	}
 
 	if vs.Version == 0 {
-		return nil, errNotFound
+		return nil, 0, errNotFound
 	} else if vs.Meta&BitDelete > 0 {
-		return nil, errNotFound
+		return nil, 0, errNotFound
+	}
+	value, err := rp.readValue(vs)
+	if err != nil || vs.Meta&BitReplicated == 0 {
+		return value, vs.ExpiresAt, err
+	}
+	_, value, err = splitOrigin(value)
+	return value, vs.ExpiresAt, err
+}
+
+//GetIntent returns the txn intent on the latest version of userKey, and the latest version.
+//if the latest version is not an intent, returns errNotFound
+func (rp *RangePartition) GetIntent(userKey []byte) ([]byte, uint64, error) {
+	vs := rp.getValueStruct(userKey, 0)
+	if !isIntent(vs) {
+		return nil, vs.Version, errNotFound
+	}
+	intent, err := rp.readValue(vs)
+	return intent, vs.Version, err
+}
+
+//LatestVersion returns the latest version of userKey, including deletes and txn intents,
+//returns 0 if userKey is not found
+func (rp *RangePartition) LatestVersion(userKey []byte) uint64 {
+	return rp.getValueStruct(userKey, 0).Version
+}
+
+//getCommittedValueStruct returns the latest version of userKey which is not a txn intent
+func (rp *RangePartition) getCommittedValueStruct(userKey []byte) y.ValueStruct {
+	iter := rp.newIterator()
+	defer iter.Close()
+	for iter.Seek(y.KeyWithTs(userKey, atomic.LoadUint64(&rp.seqNumber))); iter.Valid(); iter.Next() {
+		if !bytes.Equal(y.ParseKey(iter.Key()), userKey) {
+			break
+		}
+		vs := iter.Value()
+		if vs.Meta&BitTxnIntent == 0 {
+			vs.Value = y.Copy(vs.Value)
+			vs.Version = y.ParseTs(iter.Key())
+			return vs
+		}
 	}
+	return y.ValueStruct{}
+}
+
+func (rp *RangePartition) readValue(vs y.ValueStruct) ([]byte, error) {
+	span := opentracing.GlobalTracer().StartSpan("GetObject")
+	defer span.Finish()
+	ctx := opentracing.ContextWithSpan(context.Background(), span)
 
 	if vs.Meta&BitValuePointer > 0 {
 		var vp valuePointer
@@ -1146,12 +1510,17 @@ func (rp *RangePartition) Close() error {
 func (rp *RangePartition) close(gracefull bool) error {
 	xlog.Logger.Infof("Closing RangePartion %d", rp.PartID)
 	atomic.StoreInt32(&rp.blockWrites, 1)
+	if rp.opt.ReadOnly {
+		rp.closeWatchers()
+		return nil
+	}
 
 	//stop GC first
 	rp.gcStopper.Stop()
 	rp.compactStopper.Stop()
 	rp.writeStopper.Stop()
 	close(rp.writeCh)
+	rp.closeWatchers()
 
 	//FIXME lost data in mt/imm, will have to replay log
 	//doWrite在返回前,会调用最后一次writeRequest并且等待返回, 所以这里
@@ -1315,8 +1684,27 @@ func (rp *RangePartition) WriteEntries(entries []*Entry) error {
 	return req.Wait()
 }
 
+//WriteEntriesIf writes entries only if no key of entries has been updated after versions[i], all or nothing.
+//returns ErrTxnConflict if any key has a newer version
+func (rp *RangePartition) WriteEntriesIf(entries []*Entry, versions []uint64) error {
+	utils.AssertTrue(len(entries) == len(versions))
+	//writeRequests compares entry's ts with the latest version, and updates ts before writing
+	for i := range entries {
+		entries[i].UpdateTS(versions[i])
+	}
+	req, err := rp.sendRequest(entries, false, true)
+	if err != nil {
+		return err
+	}
+	return req.Wait()
+}
+
 //block API
 func (rp *RangePartition) sendToWriteCh(entries []*Entry, isGC bool) (*request, error) {
+	return rp.sendRequest(entries, isGC, false)
+}
+
+func (rp *RangePartition) sendRequest(entries []*Entry, isGC bool, isTxn bool) (*request, error) {
 	if atomic.LoadInt32(&rp.blockWrites) == 1 {
 		return nil, ErrBlockedWrites
 	}
@@ -1329,6 +1717,7 @@ func (rp *RangePartition) sendToWriteCh(entries []*Entry, isGC bool) (*request,
 	req.wg.Add(1)
 	req.IncrRef()
 	req.isGCRequest = isGC
+	req.isTxnRequest = isTxn
 	rp.writeCh <- req
 	return req, nil
 }
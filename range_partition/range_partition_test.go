@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"math/rand"
 	"sync"
+	"sync/atomic"
 	"testing"
 
 	"github.com/journeymidnight/autumn/range_partition/skiplist"
+	"github.com/journeymidnight/autumn/range_partition/table"
 	"github.com/journeymidnight/autumn/streamclient"
 	"github.com/journeymidnight/autumn/utils"
 	"github.com/journeymidnight/autumn/xlog"
@@ -274,6 +276,63 @@ func TestRange(t *testing.T) {
 	})
 }
 
+func TestRangeSince(t *testing.T) {
+	runRPTest(t, func(t *testing.T, rp *RangePartition) {
+		for i := 0; i < 5; i++ {
+			require.Nil(t, rp.Write([]byte(fmt.Sprintf("key%d", i)), []byte("val")))
+		}
+		_, _, seq := rp.RangeSince(nil, []byte(""), 100, 0)
+
+		require.Nil(t, rp.Write([]byte("key1"), []byte("val1")))
+		require.Nil(t, rp.Delete([]byte("key3")))
+		require.Nil(t, rp.Write([]byte("key5"), []byte("val5")))
+
+		out, deleted, newSeq := rp.RangeSince(nil, []byte(""), 100, seq)
+		require.Equal(t, [][]byte{[]byte("key1"), []byte("key3"), []byte("key5")}, out)
+		require.Equal(t, []bool{false, true, false}, deleted)
+		require.Equal(t, seq+3, newSeq)
+
+		out, deleted, _ = rp.RangeSince(nil, []byte(""), 100, newSeq)
+		require.Nil(t, out)
+		require.Nil(t, deleted)
+	})
+}
+
+func TestDeleteGCSeq(t *testing.T) {
+	logStream := streamclient.NewMockStreamClient("log")
+	rowStream := streamclient.NewMockStreamClient("sst")
+	metaStream := streamclient.NewMockStreamClient("meta")
+
+	defer logStream.Close()
+	defer rowStream.Close()
+	defer metaStream.Close()
+
+	rp, err := OpenRangePartition(3, metaStream, rowStream, logStream, []byte(""), []byte(""), TestOption())
+	require.Nil(t, err)
+	for i := 0; i < 5; i++ {
+		require.Nil(t, rp.Write([]byte(fmt.Sprintf("key%d", i)), []byte("val")))
+	}
+	_, _, seq := rp.RangeSince(nil, []byte(""), 100, 0)
+	require.Nil(t, rp.Delete([]byte("key3")))
+	require.Equal(t, uint64(0), rp.DeleteGCSeq())
+	require.Nil(t, rp.Close())
+
+	//major compaction drops the deletion
+	rp, err = OpenRangePartition(3, metaStream, rowStream, logStream, []byte(""), []byte(""), TestOption())
+	require.Nil(t, err)
+	rp.doCompact(rp.getTables(), true)
+	require.True(t, rp.DeleteGCSeq() > seq)
+	out, _, _ := rp.RangeSince(nil, []byte(""), 100, seq)
+	require.Nil(t, out)
+	gcSeq := rp.DeleteGCSeq()
+	require.Nil(t, rp.Close())
+
+	rp, err = OpenRangePartition(3, metaStream, rowStream, logStream, []byte(""), []byte(""), TestOption())
+	require.Nil(t, err)
+	defer rp.Close()
+	require.Equal(t, gcSeq, rp.DeleteGCSeq())
+}
+
 func TestHead(t *testing.T) {
 	runRPTest(t, func(t *testing.T, rp *RangePartition) {
 		rp.Write([]byte("key0"), []byte("val0"))
@@ -289,3 +348,385 @@ func TestHead(t *testing.T) {
 		require.Equal(t, len(bigValue), int(info.Len))
 	})
 }
+
+func TestTxnIntent(t *testing.T) {
+	runRPTest(t, func(t *testing.T, rp *RangePartition) {
+		require.Nil(t, rp.Write([]byte("key0"), []byte("val0")))
+		_, version, err := rp.GetIntent([]byte("key0"))
+		require.Equal(t, errNotFound, err)
+
+		//intent is invisible to Get and Range
+		e := NewTxnIntentEntry([]byte("key0"), []byte("intent"))
+		require.Nil(t, rp.WriteEntriesIf([]*Entry{e}, []uint64{version}))
+		v, err := rp.Get([]byte("key0"))
+		require.Nil(t, err)
+		require.Equal(t, []byte("val0"), v)
+		require.Equal(t, [][]byte{[]byte("key0")}, rp.Range(nil, []byte(""), 100))
+		_, _, _, intents := rp.RangeWithIntents(nil, []byte(""), 100, 0)
+		require.Equal(t, [][]byte{[]byte("key0")}, intents)
+
+		//plain writes can not overwrite intents
+		require.Equal(t, ErrTxnLocked, rp.Write([]byte("key0"), []byte("val2")))
+		require.Equal(t, ErrTxnLocked, rp.Delete([]byte("key0")))
+
+		data, intentVersion, err := rp.GetIntent([]byte("key0"))
+		require.Nil(t, err)
+		require.Equal(t, []byte("intent"), data)
+		require.True(t, intentVersion > version)
+
+		//stale version conflicts
+		e = NewPutKVEntry([]byte("key0"), []byte("val1"), 0)
+		require.Equal(t, ErrTxnConflict, rp.WriteEntriesIf([]*Entry{e}, []uint64{version}))
+
+		e = NewPutKVEntry([]byte("key0"), []byte("val1"), 0)
+		require.Nil(t, rp.WriteEntriesIf([]*Entry{e}, []uint64{intentVersion}))
+		v, err = rp.Get([]byte("key0"))
+		require.Nil(t, err)
+		require.Equal(t, []byte("val1"), v)
+		_, _, err = rp.GetIntent([]byte("key0"))
+		require.Equal(t, errNotFound, err)
+		require.Nil(t, rp.Write([]byte("key0"), []byte("val2")))
+
+		//cleared intent of a new key
+		e = NewTxnIntentEntry([]byte("key1"), []byte("intent"))
+		require.Nil(t, rp.WriteEntriesIf([]*Entry{e}, []uint64{0}))
+		_, version, err = rp.GetIntent([]byte("key1"))
+		require.Nil(t, err)
+		require.Nil(t, rp.WriteEntriesIf([]*Entry{NewTxnIntentEntry([]byte("key1"), nil)}, []uint64{version}))
+		_, err = rp.Get([]byte("key1"))
+		require.Equal(t, errNotFound, err)
+		require.Equal(t, [][]byte{[]byte("key0")}, rp.Range(nil, []byte(""), 100))
+	})
+}
+
+func TestTxnIntentReopen(t *testing.T) {
+	logStream := streamclient.NewMockStreamClient("log")
+	rowStream := streamclient.NewMockStreamClient("sst")
+	metaStream := streamclient.NewMockStreamClient("meta")
+
+	defer logStream.Close()
+	defer rowStream.Close()
+	defer metaStream.Close()
+
+	rp, err := OpenRangePartition(3, metaStream, rowStream, logStream,
+		[]byte(""), []byte(""), TestOption())
+	require.Nil(t, err)
+	for _, key := range []string{"key0", "key1"} {
+		e := NewTxnIntentEntry([]byte(key), []byte("intent"))
+		require.Nil(t, rp.WriteEntriesIf([]*Entry{e}, []uint64{0}))
+	}
+	_, version, err := rp.GetIntent([]byte("key1"))
+	require.Nil(t, err)
+	e := NewPutKVEntry([]byte("key1"), []byte("val1"), 0)
+	require.Nil(t, rp.WriteEntriesIf([]*Entry{e}, []uint64{version}))
+	require.Nil(t, rp.Write([]byte("key2"), []byte("val2")))
+	require.Equal(t, map[string]uint64{"key0": 1}, rp.intents)
+	rp.Close()
+
+	//intents are found in tables
+	rp, err = OpenRangePartition(3, metaStream, rowStream, logStream,
+		[]byte(""), []byte(""), TestOption())
+	require.Nil(t, err)
+	defer rp.Close()
+	require.True(t, rp.tables[len(rp.tables)-1].HasTxnIntents)
+	require.Equal(t, map[string]uint64{"key0": 1}, rp.intents)
+	require.Equal(t, ErrTxnLocked, rp.Write([]byte("key0"), []byte("val0")))
+	require.Nil(t, rp.Write([]byte("key1"), []byte("val1")))
+}
+
+func buildIngestTable(t *testing.T, from, to int, value string, deleted map[int]bool) *table.Table {
+	mb := new(table.MemBlocks)
+	b := table.NewTableBuilder(mb, table.None)
+	for i := from; i < to; i++ {
+		vs := y.ValueStruct{Value: []byte(value)}
+		if deleted[i] {
+			vs = y.ValueStruct{Meta: BitDelete}
+		}
+		b.Add(y.KeyWithTs([]byte(fmt.Sprintf("key%02d", i)), 0), vs)
+	}
+	b.FinishBlock()
+	_, _, err := b.FinishAll(0, 0, 0, nil, 0)
+	require.Nil(t, err)
+	tbl, err := mb.Open()
+	require.Nil(t, err)
+	return tbl
+}
+
+func TestIngestTables(t *testing.T) {
+	logStream := streamclient.NewMockStreamClient("log")
+	rowStream := streamclient.NewMockStreamClient("sst")
+	metaStream := streamclient.NewMockStreamClient("meta")
+	defer logStream.Close()
+	defer rowStream.Close()
+	defer metaStream.Close()
+
+	rp, err := OpenRangePartition(3, metaStream, rowStream, logStream, []byte(""), []byte("key50"), TestOption())
+	require.Nil(t, err)
+	for i := 0; i < 20; i++ {
+		require.Nil(t, rp.Write([]byte(fmt.Sprintf("key%02d", i)), []byte("old")))
+	}
+
+	//overlapped tables and keys out of range are rejected
+	_, err = rp.IngestTables([]*table.Table{buildIngestTable(t, 0, 10, "new", nil), buildIngestTable(t, 9, 12, "new", nil)})
+	require.NotNil(t, err)
+	_, err = rp.IngestTables([]*table.Table{buildIngestTable(t, 45, 55, "new", nil)})
+	require.NotNil(t, err)
+
+	seq, err := rp.IngestTables([]*table.Table{
+		buildIngestTable(t, 10, 15, "new", map[int]bool{12: true}),
+		buildIngestTable(t, 5, 10, "new", nil),
+	})
+	require.Nil(t, err)
+	require.Nil(t, rp.Write([]byte("key14"), []byte("latest")))
+
+	check := func(rp *RangePartition) {
+		for i := 0; i < 20; i++ {
+			key := []byte(fmt.Sprintf("key%02d", i))
+			v, err := rp.Get(key)
+			switch {
+			case i == 12:
+				require.Equal(t, errNotFound, err)
+			case i == 14:
+				require.Nil(t, err)
+				require.Equal(t, "latest", string(v))
+				require.Greater(t, rp.getValueStruct(key, 0).Version, seq)
+			case i >= 5 && i < 15:
+				require.Nil(t, err)
+				require.Equal(t, "new", string(v))
+				require.Equal(t, seq, rp.getValueStruct(key, 0).Version)
+			default:
+				require.Nil(t, err)
+				require.Equal(t, "old", string(v))
+				require.Less(t, rp.getValueStruct(key, 0).Version, seq)
+			}
+		}
+	}
+	check(rp)
+	require.Nil(t, rp.Close())
+
+	//ingested tables are in metaStream, and seq of new writes is above them
+	rp, err = OpenRangePartition(3, metaStream, rowStream, logStream, []byte(""), []byte("key50"), TestOption())
+	require.Nil(t, err)
+	defer rp.Close()
+	check(rp)
+	require.Nil(t, rp.Write([]byte("key05"), []byte("again")))
+	require.Greater(t, rp.getValueStruct([]byte("key05"), 0).Version, seq)
+}
+
+func TestWriteDuringIngest(t *testing.T) {
+	logStream := streamclient.NewMockStreamClient("log")
+	rowStream := streamclient.NewMockStreamClient("sst")
+	metaStream := streamclient.NewMockStreamClient("meta")
+	defer logStream.Close()
+	defer rowStream.Close()
+	defer metaStream.Close()
+
+	rp, err := OpenRangePartition(3, metaStream, rowStream, logStream, []byte(""), []byte(""), TestOption())
+	require.Nil(t, err)
+	for i := 0; i < 20; i++ {
+		require.Nil(t, rp.Write([]byte(fmt.Sprintf("key%02d", i)), []byte("old")))
+	}
+
+	//writes are not blocked by ingestion, a key has the value of the larger seq
+	var wg sync.WaitGroup
+	var seq uint64
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		seq, err = rp.IngestTables([]*table.Table{buildIngestTable(t, 0, 20, "new", nil)})
+	}()
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		rp.WriteAsync([]byte(fmt.Sprintf("key%02d", i)), []byte("written"), func(e error) {
+			require.Nil(t, e)
+			wg.Done()
+		})
+	}
+	wg.Wait()
+	require.Nil(t, err)
+
+	check := func(rp *RangePartition) {
+		for i := 0; i < 20; i++ {
+			key := []byte(fmt.Sprintf("key%02d", i))
+			v, err := rp.Get(key)
+			require.Nil(t, err)
+			if version := rp.getValueStruct(key, 0).Version; version > seq {
+				require.Equal(t, "written", string(v))
+			} else {
+				require.Equal(t, seq, version)
+				require.Equal(t, "new", string(v))
+			}
+		}
+	}
+	check(rp)
+	require.Nil(t, rp.close(false))
+
+	rp, err = OpenRangePartition(3, metaStream, rowStream, logStream, []byte(""), []byte(""), TestOption())
+	require.Nil(t, err)
+	defer rp.Close()
+	check(rp)
+}
+
+func TestIngestTablesToEmptyPartition(t *testing.T) {
+	logStream := streamclient.NewMockStreamClient("log")
+	rowStream := streamclient.NewMockStreamClient("sst")
+	metaStream := streamclient.NewMockStreamClient("meta")
+	defer logStream.Close()
+	defer rowStream.Close()
+	defer metaStream.Close()
+
+	rp, err := OpenRangePartition(3, metaStream, rowStream, logStream, []byte(""), []byte(""), TestOption())
+	require.Nil(t, err)
+	seq, err := rp.IngestTables([]*table.Table{buildIngestTable(t, 0, 10, "new", nil)})
+	require.Nil(t, err)
+	require.Nil(t, rp.Write([]byte("key00"), []byte("latest")))
+	//memtable is not flushed, key00 is replayed from log
+	require.Nil(t, rp.close(false))
+
+	rp, err = OpenRangePartition(3, metaStream, rowStream, logStream, []byte(""), []byte(""), TestOption())
+	require.Nil(t, err)
+	defer rp.Close()
+	v, err := rp.Get([]byte("key00"))
+	require.Nil(t, err)
+	require.Equal(t, "latest", string(v))
+	v, err = rp.Get([]byte("key09"))
+	require.Nil(t, err)
+	require.Equal(t, "new", string(v))
+	require.Equal(t, seq, rp.getValueStruct([]byte("key09"), 0).Version)
+}
+
+func TestApplyReplicated(t *testing.T) {
+	runRPTest(t, func(t *testing.T, rp *RangePartition) {
+		const local, remote = 1, 2
+		require.Nil(t, rp.Write([]byte("key0"), []byte("local")))
+		seq := atomic.LoadUint64(&rp.seqNumber)
+
+		bigValue := []byte(fmt.Sprintf("%08192d", 10))
+		ops := []ReplicatedOp{
+			{Key: []byte("key0"), Value: []byte("old"), Origin: Origin{ClusterID: remote, Seq: seq - 1}},
+			{Key: []byte("key1"), Value: []byte("v1"), Origin: Origin{ClusterID: remote, Seq: 100}},
+			{Key: []byte("key1"), Value: []byte("v2"), Origin: Origin{ClusterID: remote, Seq: 101}},
+			{Key: []byte("key2"), Value: bigValue, Origin: Origin{ClusterID: remote, Seq: 102}},
+		}
+		n, err := rp.ApplyReplicated(ops, local)
+		require.Nil(t, err)
+		require.Equal(t, 2, n)
+		require.True(t, atomic.LoadUint64(&rp.seqNumber) > 102)
+
+		v, err := rp.Get([]byte("key0"))
+		require.Nil(t, err)
+		require.Equal(t, []byte("local"), v)
+		v, err = rp.Get([]byte("key1"))
+		require.Nil(t, err)
+		require.Equal(t, []byte("v2"), v)
+		v, err = rp.Get([]byte("key2"))
+		require.Nil(t, err)
+		require.Equal(t, bigValue, v)
+		info, err := rp.Head([]byte("key2"))
+		require.Nil(t, err)
+		require.Equal(t, len(bigValue), int(info.Len))
+
+		origin, ok, err := rp.GetOrigin([]byte("key2"))
+		require.Nil(t, err)
+		require.True(t, ok)
+		require.Equal(t, Origin{ClusterID: remote, Seq: 102}, origin)
+
+		//applied again, nothing changes
+		n, err = rp.ApplyReplicated(ops, local)
+		require.Nil(t, err)
+		require.Equal(t, 0, n)
+
+		//same seq, larger cluster id wins
+		n, err = rp.ApplyReplicated([]ReplicatedOp{
+			{Key: []byte("key1"), Deleted: true, Origin: Origin{ClusterID: remote + 1, Seq: 101}},
+		}, local)
+		require.Nil(t, err)
+		require.Equal(t, 1, n)
+		_, err = rp.Get([]byte("key1"))
+		require.Equal(t, errNotFound, err)
+
+		//local writes after replicated ones win
+		require.Nil(t, rp.Write([]byte("key2"), []byte("local")))
+		_, ok, err = rp.GetOrigin([]byte("key2"))
+		require.Nil(t, err)
+		require.False(t, ok)
+		n, err = rp.ApplyReplicated([]ReplicatedOp{
+			{Key: []byte("key2"), Value: []byte("remote"), Origin: Origin{ClusterID: remote, Seq: 103}},
+		}, local)
+		require.Nil(t, err)
+		require.Equal(t, 0, n)
+	})
+}
+
+func TestOpenReadOnly(t *testing.T) {
+	logStream := streamclient.NewMockStreamClient("log")
+	rowStream := streamclient.NewMockStreamClient("sst")
+	metaStream := streamclient.NewMockStreamClient("meta")
+
+	defer logStream.Close()
+	defer rowStream.Close()
+	defer metaStream.Close()
+
+	rp, _ := OpenRangePartition(3, metaStream, rowStream, logStream,
+		[]byte(""), []byte(""), TestOption())
+
+	//more than one memtable is in log
+	val := make([]byte, 2048)
+	var wg sync.WaitGroup
+	for i := 0; i < 1000; i++ {
+		wg.Add(1)
+		rp.WriteAsync([]byte(fmt.Sprintf("key%04d", i)), val, func(e error) {
+			wg.Done()
+		})
+	}
+	wg.Wait()
+	rp.close(false)
+
+	ends := []uint32{logStream.CommitEnd(), rowStream.CommitEnd(), metaStream.CommitEnd()}
+	rp, err := OpenRangePartition(3, metaStream, rowStream, logStream,
+		[]byte(""), []byte(""), TestOption(), WithReadOnly())
+	require.NoError(t, err)
+
+	for i := 0; i < 1000; i++ {
+		v, err := rp.Get([]byte(fmt.Sprintf("key%04d", i)))
+		require.NoError(t, err)
+		require.Equal(t, val, v)
+	}
+	require.Equal(t, ErrBlockedWrites, rp.Write([]byte("key"), []byte("val")))
+	require.Error(t, rp.SubmitCompaction())
+	require.NoError(t, rp.Close())
+
+	require.Equal(t, ends, []uint32{logStream.CommitEnd(), rowStream.CommitEnd(), metaStream.CommitEnd()})
+}
+
+func TestReadOnlyMemTablesBounded(t *testing.T) {
+	logStream := streamclient.NewMockStreamClient("log")
+	rowStream := streamclient.NewMockStreamClient("sst")
+	metaStream := streamclient.NewMockStreamClient("meta")
+
+	defer logStream.Close()
+	defer rowStream.Close()
+	defer metaStream.Close()
+
+	rp, _ := OpenRangePartition(3, metaStream, rowStream, logStream,
+		[]byte(""), []byte(""), TestOption(), WithMaxSkipList(64*MB))
+	val := make([]byte, 2048)
+	for i := 0; i < 100; i++ {
+		require.NoError(t, rp.Write([]byte(fmt.Sprintf("key%04d", i)), val))
+	}
+	//nothing is flushed
+	rp.close(false)
+
+	//the log does not fit in flushChanSize+1 small memtables
+	_, err := OpenRangePartition(3, metaStream, rowStream, logStream,
+		[]byte(""), []byte(""), TestOption(), WithReadOnly(), WithMaxSkipList(4*KB))
+	require.Equal(t, errReadOnlyFull, err)
+
+	rp, err = OpenRangePartition(3, metaStream, rowStream, logStream,
+		[]byte(""), []byte(""), TestOption(), WithReadOnly())
+	require.NoError(t, err)
+	v, err := rp.Get([]byte("key0099"))
+	require.NoError(t, err)
+	require.Equal(t, val, v)
+	require.NoError(t, rp.Close())
+}
@@ -0,0 +1,197 @@
+package range_partition
+
+import (
+	"encoding/binary"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+)
+
+/*
+entries written by replication keep their origin: the cluster and the seq where they were written first.
+clusters replicating to each other pick the same winner of concurrent writes, the one with the larger
+origin seq, then the larger cluster id. values written locally have origin (clusterID, version).
+
+seqNumber is raised to the origin seq of applied entries, so a local write after a replicated one always
+wins, seq works as a lamport clock between clusters
+*/
+
+const originSize = 16
+
+var ErrReplicateTxnIntent = errors.New("key to replicate is under a txn intent")
+
+//Origin is where a value is written first
+type Origin struct {
+	ClusterID uint64
+	Seq       uint64
+}
+
+//NewerThan returns true if o wins p in last-writer-wins
+func (o Origin) NewerThan(p Origin) bool {
+	if o.Seq != p.Seq {
+		return o.Seq > p.Seq
+	}
+	return o.ClusterID > p.ClusterID
+}
+
+func splitOrigin(value []byte) (Origin, []byte, error) {
+	if len(value) < originSize {
+		return Origin{}, nil, errors.Errorf("replicated value has %d bytes, too short", len(value))
+	}
+	origin := Origin{
+		ClusterID: binary.BigEndian.Uint64(value),
+		Seq:       binary.BigEndian.Uint64(value[8:]),
+	}
+	return origin, value[originSize:], nil
+}
+
+//NewReplicatedEntry creates a put or delete entry written by replication, origin is stored before value
+func NewReplicatedEntry(userKey, value []byte, expiresAt uint64, deleted bool, origin Origin) *Entry {
+	if deleted {
+		value = nil
+		expiresAt = 0
+	}
+	entry := NewPutEntry(userKey, expiresAt, uint32(originSize+len(value)))
+	var buf [originSize]byte
+	binary.BigEndian.PutUint64(buf[:], origin.ClusterID)
+	binary.BigEndian.PutUint64(buf[8:], origin.Seq)
+	entry.WriteValue(buf[:])
+	entry.WriteValue(value)
+	entry.FinishWrite()
+
+	meta := entry.Meta | uint32(BitReplicated)
+	if deleted {
+		meta |= uint32(BitDelete)
+	}
+	entry.setMeta(meta)
+	return entry
+}
+
+//ParseOrigin returns the origin and the user value of e, ok is false if e is not written by replication
+func ParseOrigin(e *Entry) (origin Origin, value []byte, ok bool) {
+	if e.Meta&uint32(BitReplicated) == 0 {
+		return Origin{}, e.Value, false
+	}
+	origin, value, err := splitOrigin(e.Value)
+	if err != nil {
+		return Origin{}, e.Value, false
+	}
+	return origin, value, true
+}
+
+//ReplicatedOp is a put or delete from another cluster
+type ReplicatedOp struct {
+	Key       []byte
+	Value     []byte
+	ExpiresAt uint64
+	Deleted   bool
+	Origin    Origin
+}
+
+//latestOrigin returns the origin and version of the latest value of userKey, including deleted one.
+//version is 0 if userKey has never been written
+func (rp *RangePartition) latestOrigin(userKey []byte, clusterID uint64) (Origin, uint64, error) {
+	vs := rp.getValueStruct(userKey, 0)
+	if vs.Meta&BitTxnIntent > 0 {
+		if isIntent(vs) {
+			return Origin{}, 0, ErrReplicateTxnIntent
+		}
+		//intent is cleared, the version is still the latest one
+		committed := rp.getCommittedValueStruct(userKey)
+		committed.Version = vs.Version
+		vs = committed
+	}
+	if vs.Version == 0 {
+		return Origin{}, 0, nil
+	}
+	if vs.Meta&BitReplicated == 0 {
+		return Origin{ClusterID: clusterID, Seq: vs.Version}, vs.Version, nil
+	}
+	value, err := rp.readValue(vs)
+	if err != nil {
+		return Origin{}, 0, err
+	}
+	origin, _, err := splitOrigin(value)
+	return origin, vs.Version, err
+}
+
+//GetOrigin returns the origin of the latest committed value of userKey, including deleted one.
+//ok is false if the value is written locally
+func (rp *RangePartition) GetOrigin(userKey []byte) (origin Origin, ok bool, err error) {
+	vs := rp.getValueStruct(userKey, 0)
+	if vs.Meta&BitTxnIntent > 0 {
+		vs = rp.getCommittedValueStruct(userKey)
+	}
+	if vs.Version == 0 || vs.Meta&BitReplicated == 0 {
+		return Origin{}, false, nil
+	}
+	value, err := rp.readValue(vs)
+	if err != nil {
+		return Origin{}, false, err
+	}
+	origin, _, err = splitOrigin(value)
+	return origin, err == nil, err
+}
+
+//raiseSeq makes sure seqNumber >= seq
+func (rp *RangePartition) raiseSeq(seq uint64) {
+	for {
+		cur := atomic.LoadUint64(&rp.seqNumber)
+		if cur >= seq || atomic.CompareAndSwapUint64(&rp.seqNumber, cur, seq) {
+			return
+		}
+	}
+}
+
+//ApplyReplicated writes ops whose origin is newer than the origin of current values, others are skipped.
+//clusterID is the id of this cluster, the origin of local writes. it returns the number of written ops
+func (rp *RangePartition) ApplyReplicated(ops []ReplicatedOp, clusterID uint64) (int, error) {
+	//an op could be overwritten by a later op of the same key in ops
+	newest := make(map[string]int, len(ops))
+	for i := range ops {
+		if !rp.IsUserKeyInRange(ops[i].Key) {
+			return 0, errors.Errorf("key %q is not in partition %d", ops[i].Key, rp.PartID)
+		}
+		if j, ok := newest[string(ops[i].Key)]; !ok || ops[i].Origin.NewerThan(ops[j].Origin) {
+			newest[string(ops[i].Key)] = i
+		}
+	}
+
+	for retry := 0; ; retry++ {
+		var entries []*Entry
+		var versions []uint64
+		var maxSeq uint64
+		for i := range ops {
+			if newest[string(ops[i].Key)] != i {
+				continue
+			}
+			current, version, err := rp.latestOrigin(ops[i].Key, clusterID)
+			if err != nil {
+				return 0, err
+			}
+			if version > 0 && !ops[i].Origin.NewerThan(current) {
+				continue
+			}
+			entries = append(entries, NewReplicatedEntry(ops[i].Key, ops[i].Value, ops[i].ExpiresAt, ops[i].Deleted, ops[i].Origin))
+			versions = append(versions, version)
+			if ops[i].Origin.Seq > maxSeq {
+				maxSeq = ops[i].Origin.Seq
+			}
+		}
+		if len(entries) == 0 {
+			return 0, nil
+		}
+
+		rp.raiseSeq(maxSeq)
+		err := rp.WriteEntriesIf(entries, versions)
+		if err == ErrTxnConflict && retry < 3 {
+			//a local write is between the check and the write, check again
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+		return len(entries), nil
+	}
+}
+
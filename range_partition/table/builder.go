@@ -28,7 +28,6 @@ import (
 	"github.com/dgryski/go-farm"
 	"github.com/journeymidnight/autumn/proto/pspb"
 	"github.com/journeymidnight/autumn/range_partition/y"
-	"github.com/journeymidnight/autumn/streamclient"
 	"github.com/journeymidnight/autumn/utils"
 	"github.com/journeymidnight/autumn/xlog"
 	"github.com/klauspost/compress/snappy" //snappy use S2 compression
@@ -85,17 +84,23 @@ type Builder struct {
 	entryOffsets     []uint32 // Offsets of entries present in current block.
 	tableIndex       *pspb.TableIndex
 	keyHashes        []uint64 // Used for building the bloomfilter.
-	stream           streamclient.StreamClient
+	stream           BlockWriter
 	writeCh          chan writeBlock
 	stopper          *utils.Stopper
 	originDiscard    map[uint64]int64
 	compressionType  CompressionType
 	compressedSize   uint32
 	unCompressedSize uint32
+	hasTxnIntents    bool
+}
+
+//BlockWriter is where blocks of tables are written, streamclient.StreamClient is a BlockWriter
+type BlockWriter interface {
+	Append(ctx context.Context, blocks []block, mustSync bool) (extentID uint64, offsets []uint32, end uint32, err error)
 }
 
 // NewTableBuilder makes a new TableBuilder.
-func NewTableBuilder(stream streamclient.StreamClient, ct CompressionType) *Builder {
+func NewTableBuilder(stream BlockWriter, ct CompressionType) *Builder {
 	b := &Builder{
 		tableIndex:      &pspb.TableIndex{},
 		keyHashes:       make([]uint64, 0, 1024), // Avoid some malloc calls.
@@ -374,6 +379,11 @@ func (b *Builder) Add(key []byte, value y.ValueStruct) {
 	b.addHelper(key, value)
 }
 
+//MarkTxnIntents records that the table has txn intents, partitions find their intents in such tables when opened
+func (b *Builder) MarkTxnIntents() {
+	b.hasTxnIntents = true
+}
+
 // Finish finishes the table by appending the index.
 /*
 The table structure looks like
@@ -410,6 +420,7 @@ func (b *Builder) FinishAll(headExtentID uint64, headOffset uint32, seqNum uint6
 		TableIndex:      b.tableIndex,
 		Discards:        discards,
 		CompressionType: uint32(b.compressionType),
+		HasTxnIntents:   b.hasTxnIntents,
 	}
 
 	//compressedSize = all block size + meta block size
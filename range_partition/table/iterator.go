@@ -189,6 +189,14 @@ func (itr *Iterator) Valid() bool {
 	return itr.err == nil
 }
 
+//Error returns the error which makes itr invalid, nil at the end of table
+func (itr *Iterator) Error() error {
+	if itr.err == io.EOF {
+		return nil
+	}
+	return itr.err
+}
+
 func (itr *Iterator) seekToFirst() {
 	numBlocks := len(itr.t.blockIndex)
 	if numBlocks == 0 {
@@ -11,7 +11,6 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/journeymidnight/autumn/proto/pspb"
 	"github.com/journeymidnight/autumn/range_partition/y"
-	"github.com/journeymidnight/autumn/streamclient"
 	"github.com/journeymidnight/autumn/utils"
 	"github.com/journeymidnight/autumn/xlog"
 	"github.com/klauspost/compress/snappy" //snappy use S2 compression
@@ -25,9 +24,14 @@ type TableInterface interface {
 	DoesNotHave(hash uint64) bool
 }
 
+//BlockReader reads blocks of tables, streamclient.StreamClient is a BlockReader
+type BlockReader interface {
+	Read(ctx context.Context, extentID uint64, offset uint32, numOfBlocks uint32) ([][]byte, uint32, error)
+}
+
 type Table struct {
 	utils.SafeMutex
-	streamReader streamclient.StreamClient //only to read
+	streamReader BlockReader //only to read
 	blockIndex   []*pspb.BlockOffset
 
 	// The following are initialized once and const.
@@ -50,9 +54,10 @@ type Table struct {
 	CompressionType  CompressionType
 	CompressedSize   uint32
 	UncompressedSize uint32
+	HasTxnIntents    bool //table may have txn intents
 }
 
-func OpenTable(streamReader streamclient.StreamClient,
+func OpenTable(streamReader BlockReader,
 	extentID uint64, offset uint32) (*Table, error) {
 
 	utils.AssertTrue(xlog.Logger != nil)
@@ -113,6 +118,7 @@ func OpenTable(streamReader streamclient.StreamClient,
 		CompressionType:  CompressionType(meta.CompressionType),
 		CompressedSize:   meta.CompressedSize,
 		UncompressedSize: meta.UnCompressedSize,
+		HasTxnIntents:    meta.HasTxnIntents,
 	}
 
 	//read bloom filter
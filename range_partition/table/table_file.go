@@ -0,0 +1,121 @@
+package table
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+/*
+table file:
+magic(8 bytes) | number of blocks(4 bytes) | block | block | ... | meta block
+block: length(4 bytes) | data
+
+table files are built out of the cluster, and are ingested by range partitions. blocks in a table
+file are addressed by their index, extentID is always 0
+*/
+
+const (
+	tableFileMagic   = "AUTUMNST"
+	maxFileBlockSize = 256 << 20
+)
+
+//MemBlocks keeps blocks of a table in memory, it is a BlockWriter and a BlockReader
+type MemBlocks struct {
+	blocks [][]byte
+	size   int
+}
+
+func (m *MemBlocks) Append(ctx context.Context, blocks []block, mustSync bool) (uint64, []uint32, uint32, error) {
+	offsets := make([]uint32, len(blocks))
+	for i, b := range blocks {
+		offsets[i] = uint32(len(m.blocks))
+		m.blocks = append(m.blocks, b)
+		m.size += len(b)
+	}
+	return 0, offsets, uint32(len(m.blocks)), nil
+}
+
+func (m *MemBlocks) Read(ctx context.Context, extentID uint64, offset uint32, numOfBlocks uint32) ([][]byte, uint32, error) {
+	if extentID != 0 || int(offset) >= len(m.blocks) {
+		return nil, 0, errors.Errorf("no block at %d:%d", extentID, offset)
+	}
+	end := int(offset + numOfBlocks)
+	if end > len(m.blocks) {
+		end = len(m.blocks)
+	}
+	return m.blocks[offset:end], uint32(end), nil
+}
+
+//Size returns the number of bytes of all blocks
+func (m *MemBlocks) Size() int {
+	return m.size
+}
+
+//Open opens the table whose meta block is the last block
+func (m *MemBlocks) Open() (*Table, error) {
+	if len(m.blocks) == 0 {
+		return nil, errors.New("table has no block")
+	}
+	return OpenTable(m, 0, uint32(len(m.blocks)-1))
+}
+
+//WriteTo writes blocks as a table file
+func (m *MemBlocks) WriteTo(w io.Writer) (int64, error) {
+	bw := bufio.NewWriter(w)
+	header := make([]byte, 12)
+	copy(header, tableFileMagic)
+	binary.BigEndian.PutUint32(header[8:], uint32(len(m.blocks)))
+	n, err := bw.Write(header)
+	written := int64(n)
+	if err != nil {
+		return written, err
+	}
+	lenBuf := make([]byte, 4)
+	for _, b := range m.blocks {
+		binary.BigEndian.PutUint32(lenBuf, uint32(len(b)))
+		if n, err = bw.Write(lenBuf); err != nil {
+			return written + int64(n), err
+		}
+		written += int64(n)
+		if n, err = bw.Write(b); err != nil {
+			return written + int64(n), err
+		}
+		written += int64(n)
+	}
+	return written, bw.Flush()
+}
+
+//ReadTableFile reads a table file from r, it reads nothing after the table, so several table
+//files could be read from one stream
+func ReadTableFile(r io.Reader) (*MemBlocks, error) {
+	header := make([]byte, 12)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, errors.Wrap(err, "read table file header")
+	}
+	if !bytes.Equal(header[:8], []byte(tableFileMagic)) {
+		return nil, errors.New("not a table file")
+	}
+	n := binary.BigEndian.Uint32(header[8:])
+	m := new(MemBlocks)
+	lenBuf := make([]byte, 4)
+	for i := uint32(0); i < n; i++ {
+		if _, err := io.ReadFull(r, lenBuf); err != nil {
+			return nil, errors.Wrap(err, "read table file")
+		}
+		size := binary.BigEndian.Uint32(lenBuf)
+		if size > maxFileBlockSize {
+			return nil, errors.Errorf("block %d is too large: %d", i, size)
+		}
+		b := make([]byte, size)
+		if _, err := io.ReadFull(r, b); err != nil {
+			return nil, errors.Wrap(err, "read table file")
+		}
+		m.Append(context.Background(), []block{b}, false)
+	}
+	return m, nil
+}
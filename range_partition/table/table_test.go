@@ -17,6 +17,7 @@
 package table
 
 import (
+	"bytes"
 	"fmt"
 	"math"
 	"sort"
@@ -67,6 +68,41 @@ func buildTable(t *testing.T, keyValues [][]string) (streamclient.StreamClient,
 	return stream, ex, offset
 }
 
+func TestTableFile(t *testing.T) {
+	mb := new(MemBlocks)
+	b := NewTableBuilder(mb, Snappy)
+	for i := 0; i < 10000; i++ {
+		b.Add(y.KeyWithTs([]byte(key("key", i)), 1), y.ValueStruct{Value: []byte(fmt.Sprintf("%d", i))})
+	}
+	b.FinishBlock()
+	_, _, err := b.FinishAll(0, 0, 1, nil, 0)
+	require.Nil(t, err)
+
+	var buf bytes.Buffer
+	_, err = mb.WriteTo(&buf)
+	require.Nil(t, err)
+	buf.WriteString("next")
+
+	read, err := ReadTableFile(&buf)
+	require.Nil(t, err)
+	require.Equal(t, "next", buf.String())
+	tbl, err := read.Open()
+	require.Nil(t, err)
+	defer tbl.Close()
+	it := tbl.NewIterator(false)
+	n := 0
+	for it.Rewind(); it.Valid(); it.Next() {
+		require.Equal(t, key("key", n), string(y.ParseKey(it.Key())))
+		require.Equal(t, fmt.Sprintf("%d", n), string(it.Value().Value))
+		n++
+	}
+	require.Nil(t, it.Error())
+	require.Equal(t, 10000, n)
+
+	_, err = ReadTableFile(bytes.NewReader(buf.Bytes()[:0]))
+	require.NotNil(t, err)
+}
+
 func TestMidKey(t *testing.T) {
 	stream, id, offset := buildTestTable(t, "key", 5000)
 	defer stream.Close()
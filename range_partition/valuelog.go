@@ -15,7 +15,10 @@ import (
 
 //replay valuelog
 //compact valuelog
-func (rp *RangePartition) writeValueLog(reqs []*request) ([]*Entry, valuePointer, error) {
+
+//writeValueLog sends entries of reqs to logStream, the returned function waits for the append
+//and returns entries with their positions
+func (rp *RangePartition) writeValueLog(reqs []*request) func() ([]*Entry, valuePointer, error) {
 
 	var blocks []block
 	var entries []*Entry
@@ -28,25 +31,28 @@ func (rp *RangePartition) writeValueLog(reqs []*request) ([]*Entry, valuePointer
 	}
 
 	span := opentracing.GlobalTracer().StartSpan("writeValueLog")
-	defer span.Finish()
 	ctx := opentracing.ContextWithSpan(context.Background(), span)
-	extentID, offsets, tail, err := rp.logStream.Append(ctx, blocks, rp.opt.MustSync)
-	if err != nil {
-		return nil, valuePointer{}, err
-	}
+	future := rp.logStream.AppendAsync(ctx, blocks, rp.opt.MustSync)
 
-	//update entries
-	for i := range entries {
-		entries[i].ExtentID = extentID
-		entries[i].Offset = offsets[i]
-		if i == len(entries)-1 {
-			entries[i].End = tail
-		} else {
-			entries[i].End = offsets[i+1]
+	return func() ([]*Entry, valuePointer, error) {
+		defer span.Finish()
+		extentID, offsets, tail, err := future.Wait()
+		if err != nil {
+			return nil, valuePointer{}, err
 		}
-	}
-	return entries, valuePointer{extentID: extentID, offset: tail}, nil
 
+		//update entries
+		for i := range entries {
+			entries[i].ExtentID = extentID
+			entries[i].Offset = offsets[i]
+			if i == len(entries)-1 {
+				entries[i].End = tail
+			} else {
+				entries[i].End = offsets[i+1]
+			}
+		}
+		return entries, valuePointer{extentID: extentID, offset: tail}, nil
+	}
 }
 
 func replayLog(stream streamclient.StreamClient, replayFunc func(*Entry) (bool, error), opts ...streamclient.ReadOption) error {
@@ -191,6 +197,7 @@ func (rp *RangePartition) runGC(extentID uint64) {
 	var count, moved int
 	var freeSize uint64
 	var moveSize uint64
+	var maxSeq uint64
 	wb := make([]*Entry, 0, 100)
 
 	fe := func(ei *Entry) (bool, error) {
@@ -201,6 +208,9 @@ func (rp *RangePartition) runGC(extentID uint64) {
 		}
 
 		freeSize += uint64(ei.Size())
+		if seq := y.ParseTs(ei.Key); seq > maxSeq {
+			maxSeq = seq
+		}
 
 		userKey := y.ParseKey(ei.Key)
 
@@ -218,6 +228,12 @@ func (rp *RangePartition) runGC(extentID uint64) {
 		//startKey <= userKey < endKey
 
 		vs := rp.getValueStruct(userKey, 0) //get the latest version
+		if vs.Meta&BitTxnIntent > 0 && vs.Version != y.ParseTs(ei.Key) {
+			//moving the last committed version would put it above the intent, try this extent later
+			if rp.getCommittedValueStruct(userKey).Version == y.ParseTs(ei.Key) {
+				return false, errGCTxnIntent
+			}
+		}
 
 		if discardEntry(ei, vs) {
 			//fmt.Printf("discard blob entry, key: %s\n", streamclient.FormatEntry(ei))
@@ -237,6 +253,7 @@ func (rp *RangePartition) runGC(extentID uint64) {
 			//keep seqNum
 
 			ne := ei //use the same entry
+			ne.setMeta(ne.Meta | uint32(BitGCMoved))
 			//fmt.Printf("MOVE %s\n", userKey)
 			if len(wb) > 4 {
 				//如果是GC request, 在写入log之前,还要再读一遍key, 如果有新Key已经写入, 则放弃
@@ -269,6 +286,9 @@ func (rp *RangePartition) runGC(extentID uint64) {
 		req.Wait()
 	}
 
+	//watchers must know entries before maxSeq could be lost, save it before punching holes
+	rp.updateLogGCSeq(maxSeq)
+
 	err = rp.logStream.PunchHoles(context.Background(), []uint64{extentID})
 	if err != nil {
 		xlog.Logger.Errorf("PunchHoles error: %v", err)
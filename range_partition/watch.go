@@ -0,0 +1,283 @@
+package range_partition
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"sync/atomic"
+	"time"
+
+	"github.com/journeymidnight/autumn/range_partition/table"
+	"github.com/journeymidnight/autumn/range_partition/y"
+	"github.com/journeymidnight/autumn/streamclient"
+	"github.com/journeymidnight/autumn/xlog"
+	"github.com/pkg/errors"
+)
+
+/*
+Watch reads logStream and sends entries whose seq > fromSeq, then switches to entries
+published by writeRequests.
+
+1. order of entries in logStream is the order of seq, entries out of range(split) are skipped
+2. entries rewritten by GC have BitGCMoved, they have been sent with their original seq
+3. txn intents are skipped, watchers get entries when intents are resolved
+4. reading logStream starts from the value pointer of the newest table whose LastSeq <= fromSeq,
+entries before it are all in the table. after that, a watcher remembers the end of the last entry
+it has read, if it can not keep up with writes, it is unsubscribed and reads logStream again from there.
+a watcher lagging more than watchMaxLags times without catching up fails with ErrWatchTooSlow
+5. before GC punches an extent, the max seq in the extent is saved as logGCSeq in metaStream.
+if fromSeq < logGCSeq, some entries may have been lost, ErrWatchCompacted is returned, caller
+should resync by Range and watch from now
+*/
+
+const (
+	watchChanSize           = 64
+	watchBatchSize          = 256
+	watchCheckpointInterval = 5 * time.Second
+	watchMaxLags            = 3
+)
+
+var (
+	ErrWatchCompacted = errors.New("entries to watch have been GC'd")
+	ErrWatchClosed    = errors.New("range partition is closed")
+	ErrWatchTooSlow   = errors.New("watcher can not keep up with writes")
+)
+
+//WatchFunc is called with entries in the order of seq, all entries whose seq <= checkpoint
+//have been sent. entries could be empty if only checkpoint moves forward
+type WatchFunc func(entries []*Entry, checkpoint uint64) error
+
+type logWatcher struct {
+	ch     chan []*Entry
+	lagged int32 //set if ch is full when publishing
+}
+
+func (rp *RangePartition) subscribe() *logWatcher {
+	rp.watchLock.Lock()
+	defer rp.watchLock.Unlock()
+	if rp.watchClosed {
+		return nil
+	}
+	w := &logWatcher{
+		ch: make(chan []*Entry, watchChanSize),
+	}
+	rp.watchers[w] = struct{}{}
+	return w
+}
+
+func (rp *RangePartition) unsubscribe(w *logWatcher) {
+	rp.watchLock.Lock()
+	defer rp.watchLock.Unlock()
+	if _, ok := rp.watchers[w]; ok {
+		delete(rp.watchers, w)
+		close(w.ch)
+	}
+}
+
+//publish is called by writeRequests, it never blocks on slow watchers
+func (rp *RangePartition) publish(entries []*Entry) {
+	rp.watchLock.Lock()
+	defer rp.watchLock.Unlock()
+	for w := range rp.watchers {
+		select {
+		case w.ch <- entries:
+		default:
+			atomic.StoreInt32(&w.lagged, 1)
+			delete(rp.watchers, w)
+			close(w.ch)
+		}
+	}
+}
+
+func (rp *RangePartition) closeWatchers() {
+	rp.watchLock.Lock()
+	defer rp.watchLock.Unlock()
+	rp.watchClosed = true
+	for w := range rp.watchers {
+		delete(rp.watchers, w)
+		close(w.ch)
+	}
+}
+
+//updateLogGCSeq is called by GC before punching holes in logStream
+func (rp *RangePartition) updateLogGCSeq(seq uint64) {
+	if seq <= atomic.LoadUint64(&rp.logGCSeq) {
+		return
+	}
+	atomic.StoreUint64(&rp.logGCSeq, seq)
+	rp.saveTableLocs()
+}
+
+//Watch calls f with entries whose key has prefix and seq > fromSeq until ctx is done or f returns error.
+//if fromNow, fromSeq is ignored and f is called with the current seq first
+func (rp *RangePartition) Watch(ctx context.Context, prefix []byte, fromSeq uint64, fromNow bool, f WatchFunc) error {
+	w := rp.subscribe()
+	if w == nil {
+		return ErrWatchClosed
+	}
+	defer func() {
+		rp.unsubscribe(w)
+	}()
+
+	lastSeq := fromSeq
+	var pos valuePointer //end of the last entry read, zero if unknown
+	replay := true
+	if fromNow {
+		//all entries after committedSeq will be published to w
+		lastSeq = atomic.LoadUint64(&rp.committedSeq)
+		if err := f(nil, lastSeq); err != nil {
+			return err
+		}
+		replay = false
+	}
+	sentSeq := lastSeq
+	lags := 0
+
+	ticker := time.NewTicker(watchCheckpointInterval)
+	defer ticker.Stop()
+	for {
+		if replay {
+			if err := rp.watchLog(ctx, prefix, &lastSeq, &pos, f); err != nil {
+				return err
+			}
+			sentSeq = lastSeq
+			replay = false
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case entries, ok := <-w.ch:
+			if !ok {
+				if atomic.LoadInt32(&w.lagged) == 0 {
+					return ErrWatchClosed
+				}
+				//too slow, read logStream again from pos
+				if lags++; lags > watchMaxLags {
+					return ErrWatchTooSlow
+				}
+				if w = rp.subscribe(); w == nil {
+					return ErrWatchClosed
+				}
+				replay = true
+				continue
+			}
+			var matched []*Entry
+			for _, e := range entries {
+				seq := y.ParseTs(e.Key)
+				if seq <= lastSeq {
+					continue
+				}
+				lastSeq = seq
+				pos = valuePointer{extentID: e.ExtentID, offset: e.End}
+				if e.Meta&uint32(BitTxnIntent) == 0 && bytes.HasPrefix(y.ParseKey(e.Key), prefix) {
+					matched = append(matched, e)
+				}
+			}
+			if len(matched) > 0 {
+				if err := f(matched, lastSeq); err != nil {
+					return err
+				}
+				sentSeq = lastSeq
+			}
+		case <-ticker.C:
+			if len(w.ch) == 0 {
+				//caught up
+				lags = 0
+			}
+			if lastSeq > sentSeq {
+				if err := f(nil, lastSeq); err != nil {
+					return err
+				}
+				sentSeq = lastSeq
+			}
+		}
+	}
+}
+
+//watchStart returns the value pointer of the newest table whose LastSeq <= seq, entries
+//before it have seq <= seq. returns false if logStream should be read from the start
+func (rp *RangePartition) watchStart(seq uint64) (valuePointer, bool) {
+	var start *table.Table
+	for _, t := range rp.getTables() {
+		if t.LastSeq <= seq && t.VpExtentID > 0 && (start == nil || t.LastSeq > start.LastSeq) {
+			start = t
+		}
+	}
+	if start == nil {
+		return valuePointer{}, false
+	}
+	return valuePointer{extentID: start.VpExtentID, offset: start.VpOffset}, true
+}
+
+//watchLog sends entries in logStream whose seq > *lastSeq, it reads from *pos if it is known,
+//*pos is updated to the end of the last entry read
+func (rp *RangePartition) watchLog(ctx context.Context, prefix []byte, lastSeq *uint64, pos *valuePointer, f WatchFunc) error {
+	startSeq := *lastSeq
+	//GC saves logGCSeq before punching holes, if any extent was skipped, we can find it
+	//before sending a checkpoint after it
+	compacted := func() bool {
+		return startSeq < atomic.LoadUint64(&rp.logGCSeq)
+	}
+	if compacted() {
+		return ErrWatchCompacted
+	}
+
+	batch := make([]*Entry, 0, watchBatchSize)
+	var sendErr error
+	replay := func(ei *Entry) (bool, error) {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+		*pos = valuePointer{extentID: ei.ExtentID, offset: ei.End}
+		userKey := y.ParseKey(ei.Key)
+		seq := y.ParseTs(ei.Key)
+		if !rp.IsUserKeyInRange(userKey) || seq <= *lastSeq {
+			return true, nil
+		}
+		*lastSeq = seq
+		if ei.Meta&uint32(BitGCMoved|BitTxnIntent) > 0 || !bytes.HasPrefix(userKey, prefix) {
+			return true, nil
+		}
+		batch = append(batch, ei)
+		if len(batch) < watchBatchSize {
+			return true, nil
+		}
+		if compacted() {
+			return false, ErrWatchCompacted
+		}
+		if sendErr = f(batch, *lastSeq); sendErr != nil {
+			return false, sendErr
+		}
+		batch = make([]*Entry, 0, watchBatchSize)
+		return true, nil
+	}
+
+	start := *pos
+	ok := start.extentID > 0
+	if !ok {
+		start, ok = rp.watchStart(*lastSeq)
+	}
+	var err error
+	if ok {
+		err = replayLog(rp.logStream, replay, streamclient.WithReadFrom(start.extentID, start.offset, math.MaxUint32))
+		if err != nil && err != ErrWatchCompacted && sendErr == nil && ctx.Err() == nil {
+			//the extent may have been punched, entries already sent are skipped by seq
+			xlog.Logger.Warnf("watch from extent %d offset %d: %v, read logStream from start", start.extentID, start.offset, err)
+			ok = false
+		}
+	}
+	if !ok {
+		err = replayLog(rp.logStream, replay, streamclient.WithReadFromStart(math.MaxUint32))
+	}
+
+	if compacted() {
+		return ErrWatchCompacted
+	}
+	if err != nil {
+		return err
+	}
+	if len(batch) > 0 || *lastSeq > startSeq {
+		return f(batch, *lastSeq)
+	}
+	return nil
+}
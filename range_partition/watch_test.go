@@ -0,0 +1,189 @@
+package range_partition
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/journeymidnight/autumn/range_partition/y"
+	"github.com/stretchr/testify/require"
+)
+
+type watchedEntry struct {
+	key    string
+	delete bool
+	seq    uint64
+}
+
+func startWatch(rp *RangePartition, prefix string, fromSeq uint64, fromNow bool) (chan watchedEntry, chan uint64, func() error) {
+	ch := make(chan watchedEntry, 100)
+	checkpoints := make(chan uint64, 100)
+	errCh := make(chan error, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		errCh <- rp.Watch(ctx, []byte(prefix), fromSeq, fromNow, func(entries []*Entry, checkpoint uint64) error {
+			for _, e := range entries {
+				ch <- watchedEntry{
+					key:    string(y.ParseKey(e.Key)),
+					delete: e.Meta&uint32(BitDelete) > 0,
+					seq:    y.ParseTs(e.Key),
+				}
+			}
+			checkpoints <- checkpoint
+			return nil
+		})
+	}()
+	return ch, checkpoints, func() error {
+		cancel()
+		return <-errCh
+	}
+}
+
+func receiveWatched(t *testing.T, ch chan watchedEntry) watchedEntry {
+	select {
+	case e := <-ch:
+		return e
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "no entry is watched")
+	}
+	return watchedEntry{}
+}
+
+func TestWatch(t *testing.T) {
+	runRPTest(t, func(t *testing.T, rp *RangePartition) {
+		for i := 0; i < 3; i++ {
+			require.Nil(t, rp.Write([]byte(fmt.Sprintf("a/%d", i)), []byte("v")))
+		}
+		require.Nil(t, rp.Write([]byte("b/1"), []byte("v")))
+
+		ch, _, stop := startWatch(rp, "a/", 0, false)
+
+		var lastSeq uint64
+		for i := 0; i < 3; i++ {
+			e := receiveWatched(t, ch)
+			require.Equal(t, fmt.Sprintf("a/%d", i), e.key)
+			require.True(t, e.seq > lastSeq)
+			lastSeq = e.seq
+		}
+
+		//new writes
+		require.Nil(t, rp.Write([]byte("a/3"), []byte("v")))
+		require.Nil(t, rp.Delete([]byte("a/0")))
+
+		e := receiveWatched(t, ch)
+		require.Equal(t, "a/3", e.key)
+		require.False(t, e.delete)
+		e = receiveWatched(t, ch)
+		require.Equal(t, "a/0", e.key)
+		require.True(t, e.delete)
+		require.Equal(t, context.Canceled, stop())
+
+		//resume from checkpoint
+		ch, _, stop = startWatch(rp, "a/", lastSeq, false)
+		require.Equal(t, "a/3", receiveWatched(t, ch).key)
+		require.Equal(t, "a/0", receiveWatched(t, ch).key)
+		require.Equal(t, context.Canceled, stop())
+	})
+}
+
+func TestWatchFromNow(t *testing.T) {
+	runRPTest(t, func(t *testing.T, rp *RangePartition) {
+		require.Nil(t, rp.Write([]byte("a/1"), []byte("v")))
+
+		ch, checkpoints, stop := startWatch(rp, "a/", 0, true)
+		require.Equal(t, rp.committedSeq, <-checkpoints)
+
+		require.Nil(t, rp.Write([]byte("a/2"), []byte("v")))
+		require.Equal(t, "a/2", receiveWatched(t, ch).key)
+		require.Equal(t, context.Canceled, stop())
+	})
+}
+
+func TestWatchCompacted(t *testing.T) {
+	runRPTest(t, func(t *testing.T, rp *RangePartition) {
+		for i := 0; i < 10; i++ {
+			require.Nil(t, rp.Write([]byte(fmt.Sprintf("a/%d", i)), []byte("v")))
+		}
+		rp.updateLogGCSeq(5)
+
+		err := rp.Watch(context.Background(), []byte("a/"), 3, false, func([]*Entry, uint64) error {
+			return nil
+		})
+		require.Equal(t, ErrWatchCompacted, err)
+
+		ch, _, stop := startWatch(rp, "a/", 5, false)
+		require.Equal(t, "a/5", receiveWatched(t, ch).key)
+		require.Equal(t, context.Canceled, stop())
+	})
+}
+
+func TestWatchStartFromTable(t *testing.T) {
+	runRPTest(t, func(t *testing.T, rp *RangePartition) {
+		_, ok := rp.watchStart(0)
+		require.False(t, ok)
+
+		//fill memtables until a table is flushed, small values are in memtable
+		value := make([]byte, 2<<10)
+		for i := 0; len(rp.getTables()) == 0; i++ {
+			require.Nil(t, rp.Write([]byte(fmt.Sprintf("a/%04d", i)), value))
+			if i > 5000 {
+				require.FailNow(t, "no table is flushed")
+			}
+		}
+		tbl := rp.getTables()[0]
+		vp, ok := rp.watchStart(tbl.LastSeq)
+		require.True(t, ok)
+		require.Equal(t, valuePointer{extentID: tbl.VpExtentID, offset: tbl.VpOffset}, vp)
+
+		//watch from the end of the table reads entries after its value pointer
+		require.Nil(t, rp.Write([]byte("b/1"), []byte("v")))
+		ch, _, stop := startWatch(rp, "b/", tbl.LastSeq, false)
+		e := receiveWatched(t, ch)
+		require.Equal(t, "b/1", e.key)
+		require.True(t, e.seq > tbl.LastSeq)
+		require.Equal(t, context.Canceled, stop())
+	})
+}
+
+func TestWatchTooSlow(t *testing.T) {
+	runRPTest(t, func(t *testing.T, rp *RangePartition) {
+		tokens := make(chan struct{})
+		defer close(tokens)
+		errCh := make(chan error, 1)
+		var seqs []uint64
+		go func() {
+			errCh <- rp.Watch(context.Background(), []byte("a/"), 0, true, func(entries []*Entry, checkpoint uint64) error {
+				if len(entries) == 0 {
+					return nil
+				}
+				for _, e := range entries {
+					seqs = append(seqs, y.ParseTs(e.Key))
+				}
+				<-tokens
+				return nil
+			})
+		}()
+
+		//watcher is blocked while writes overflow its channel
+		for i := 0; ; i++ {
+			for j := 0; j < 2*watchChanSize; j++ {
+				require.Nil(t, rp.Write([]byte(fmt.Sprintf("a/%d/%d", i, j)), []byte("v")))
+			}
+			select {
+			case tokens <- struct{}{}:
+				continue
+			case err := <-errCh:
+				require.Equal(t, ErrWatchTooSlow, err)
+			case <-time.After(5 * time.Second):
+				require.FailNow(t, "watcher is not failed")
+			}
+			break
+		}
+
+		//resumed from where it was, no entry is sent twice
+		for i := 1; i < len(seqs); i++ {
+			require.True(t, seqs[i] > seqs[i-1])
+		}
+	})
+}
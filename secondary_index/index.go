@@ -0,0 +1,189 @@
+package secondary_index
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/pkg/errors"
+)
+
+/*
+Format of index key:
++------------------+
+|  KeyPrefix       | "\x00idx/"
++------------------+
+|  index name      |
++------------------+
+|  "/"             |
++------------------+
+|  field value     | 0x00 is escaped to 0x00 0xff
++------------------+
+|  0x00 0x01       | end of field value
++------------------+
+|  primary key     |
++------------------+
+value of index key is the primary key
+
+escaping keeps index keys sorted by field value, and prefix of field value is
+still prefix of index key
+*/
+
+const (
+	KeyPrefix     = "\x00idx/"
+	EtcdKeyPrefix = "INDEX/"
+)
+
+var (
+	errInvalidIndexKey = errors.New("invalid index key")
+)
+
+func FormatIndexInfoKey(name string) string {
+	return EtcdKeyPrefix + name
+}
+
+func IsIndexKey(key []byte) bool {
+	return bytes.HasPrefix(key, []byte(KeyPrefix))
+}
+
+//IndexPrefix is the prefix of all entries of index name
+func IndexPrefix(name string) []byte {
+	return []byte(KeyPrefix + name + "/")
+}
+
+func escape(buf []byte, field []byte) []byte {
+	for _, c := range field {
+		buf = append(buf, c)
+		if c == 0x00 {
+			buf = append(buf, 0xff)
+		}
+	}
+	return buf
+}
+
+//ValuePrefix returns the prefix of index keys whose field value has prefix value,
+//if exact, field value must be equal to value
+func ValuePrefix(name string, value []byte, exact bool) []byte {
+	buf := IndexPrefix(name)
+	buf = escape(buf, value)
+	if exact {
+		buf = append(buf, 0x00, 0x01)
+	}
+	return buf
+}
+
+func IndexKey(name string, field []byte, primaryKey []byte) []byte {
+	buf := ValuePrefix(name, field, true)
+	return append(buf, primaryKey...)
+}
+
+//ParseIndexKey returns field value and primary key of an index key
+func ParseIndexKey(name string, key []byte) ([]byte, []byte, error) {
+	prefix := IndexPrefix(name)
+	if !bytes.HasPrefix(key, prefix) {
+		return nil, nil, errInvalidIndexKey
+	}
+	key = key[len(prefix):]
+	var field []byte
+	for i := 0; i < len(key); i++ {
+		if key[i] != 0x00 {
+			field = append(field, key[i])
+			continue
+		}
+		if i+1 >= len(key) {
+			return nil, nil, errInvalidIndexKey
+		}
+		switch key[i+1] {
+		case 0xff:
+			field = append(field, 0x00)
+			i++
+		case 0x01:
+			return field, key[i+2:], nil
+		default:
+			return nil, nil, errInvalidIndexKey
+		}
+	}
+	return nil, nil, errInvalidIndexKey
+}
+
+//Extract returns the indexed field of (key, value), false if key is not indexed or
+//value does not have the field
+func Extract(info *pspb.IndexInfo, key []byte, value []byte) ([]byte, bool) {
+	if IsIndexKey(key) || !bytes.HasPrefix(key, info.KeyPrefix) || value == nil {
+		return nil, false
+	}
+	switch f := info.Field.(type) {
+	case *pspb.IndexInfo_ByteRange:
+		end := uint64(f.ByteRange.Offset) + uint64(f.ByteRange.Length)
+		if end > uint64(len(value)) {
+			return nil, false
+		}
+		return value[f.ByteRange.Offset:end], true
+	case *pspb.IndexInfo_JsonPath:
+		return extractJSON(f.JsonPath, value)
+	}
+	return nil, false
+}
+
+//extractJSON walks path like "a.b.0.c", string field returns the raw string,
+//other types return json text
+func extractJSON(path string, value []byte) ([]byte, bool) {
+	decoder := json.NewDecoder(bytes.NewReader(value))
+	decoder.UseNumber()
+	var v interface{}
+	if err := decoder.Decode(&v); err != nil {
+		return nil, false
+	}
+	if len(path) > 0 {
+		for _, p := range strings.Split(path, ".") {
+			switch t := v.(type) {
+			case map[string]interface{}:
+				var ok bool
+				if v, ok = t[p]; !ok {
+					return nil, false
+				}
+			case []interface{}:
+				i, err := strconv.Atoi(p)
+				if err != nil || i < 0 || i >= len(t) {
+					return nil, false
+				}
+				v = t[i]
+			default:
+				return nil, false
+			}
+		}
+	}
+	switch t := v.(type) {
+	case nil:
+		return nil, false
+	case string:
+		return []byte(t), true
+	case json.Number:
+		return []byte(t.String()), true
+	default:
+		data, err := json.Marshal(t)
+		if err != nil {
+			return nil, false
+		}
+		return data, true
+	}
+}
+
+//ValidIndexInfo checks if info can be registered
+func ValidIndexInfo(info *pspb.IndexInfo) error {
+	if len(info.Name) == 0 || strings.Contains(info.Name, "/") {
+		return errors.Errorf("invalid index name [%s]", info.Name)
+	}
+	switch f := info.Field.(type) {
+	case *pspb.IndexInfo_ByteRange:
+		if f.ByteRange.Length == 0 {
+			return errors.New("length of byte range can not be zero")
+		}
+	case *pspb.IndexInfo_JsonPath:
+	default:
+		return errors.New("index has no field")
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package secondary_index
+
+import (
+	"bytes"
+	"sort"
+	"testing"
+
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndexKey(t *testing.T) {
+	cases := []struct {
+		field []byte
+		key   []byte
+	}{
+		{[]byte("hello"), []byte("obj1")},
+		{[]byte("he\x00llo"), []byte("obj\x002")},
+		{[]byte(""), []byte("obj3")},
+		{[]byte("\x00\x01"), []byte("")},
+	}
+	for _, c := range cases {
+		ikey := IndexKey("idx", c.field, c.key)
+		require.True(t, IsIndexKey(ikey))
+		field, pk, err := ParseIndexKey("idx", ikey)
+		require.Nil(t, err)
+		require.Equal(t, string(c.field), string(field))
+		require.Equal(t, string(c.key), string(pk))
+		require.True(t, bytes.HasPrefix(ikey, ValuePrefix("idx", c.field, true)))
+	}
+
+	_, _, err := ParseIndexKey("other", IndexKey("idx", []byte("a"), []byte("b")))
+	require.NotNil(t, err)
+}
+
+func TestIndexKeyOrder(t *testing.T) {
+	fields := []string{"b", "a\x00", "a", "ab", "a\x00\x00"}
+	var keys [][]byte
+	for _, f := range fields {
+		keys = append(keys, IndexKey("idx", []byte(f), []byte("pk")))
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i], keys[j]) < 0
+	})
+	sort.Strings(fields)
+	for i := range keys {
+		field, _, err := ParseIndexKey("idx", keys[i])
+		require.Nil(t, err)
+		require.Equal(t, fields[i], string(field))
+	}
+
+	//prefix of field is prefix of index key
+	require.True(t, bytes.HasPrefix(IndexKey("idx", []byte("ab"), []byte("pk")), ValuePrefix("idx", []byte("a"), false)))
+	require.False(t, bytes.HasPrefix(IndexKey("idx", []byte("ab"), []byte("pk")), ValuePrefix("idx", []byte("a"), true)))
+}
+
+func TestExtract(t *testing.T) {
+	jsonIndex := &pspb.IndexInfo{
+		Name:      "city",
+		KeyPrefix: []byte("user/"),
+		Field:     &pspb.IndexInfo_JsonPath{JsonPath: "addr.city"},
+	}
+	value := []byte(`{"name":"bob","age":30,"addr":{"city":"beijing"},"tags":["a","b"]}`)
+
+	f, ok := Extract(jsonIndex, []byte("user/1"), value)
+	require.True(t, ok)
+	require.Equal(t, "beijing", string(f))
+
+	_, ok = Extract(jsonIndex, []byte("group/1"), value)
+	require.False(t, ok)
+
+	_, ok = Extract(jsonIndex, []byte("user/1"), []byte("not json"))
+	require.False(t, ok)
+
+	jsonIndex.Field = &pspb.IndexInfo_JsonPath{JsonPath: "age"}
+	f, ok = Extract(jsonIndex, []byte("user/1"), value)
+	require.True(t, ok)
+	require.Equal(t, "30", string(f))
+
+	jsonIndex.Field = &pspb.IndexInfo_JsonPath{JsonPath: "tags.1"}
+	f, ok = Extract(jsonIndex, []byte("user/1"), value)
+	require.True(t, ok)
+	require.Equal(t, "b", string(f))
+
+	jsonIndex.Field = &pspb.IndexInfo_JsonPath{JsonPath: "addr.zip"}
+	_, ok = Extract(jsonIndex, []byte("user/1"), value)
+	require.False(t, ok)
+
+	byteIndex := &pspb.IndexInfo{
+		Name:  "type",
+		Field: &pspb.IndexInfo_ByteRange{ByteRange: &pspb.ByteRange{Offset: 2, Length: 3}},
+	}
+	f, ok = Extract(byteIndex, []byte("k"), []byte("0123456"))
+	require.True(t, ok)
+	require.Equal(t, "234", string(f))
+
+	_, ok = Extract(byteIndex, []byte("k"), []byte("0123"))
+	require.False(t, ok)
+
+	//index keys are never indexed
+	_, ok = Extract(byteIndex, IndexKey("type", []byte("234"), []byte("k")), []byte("0123456"))
+	require.False(t, ok)
+}
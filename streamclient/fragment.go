@@ -0,0 +1,262 @@
+package streamclient
+
+import (
+	"context"
+	"io"
+
+	"github.com/journeymidnight/autumn/conn"
+	"github.com/journeymidnight/autumn/erasure_code"
+	"github.com/journeymidnight/autumn/extent/record"
+	"github.com/journeymidnight/autumn/manager/smclient"
+	"github.com/journeymidnight/autumn/proto/pb"
+	"github.com/journeymidnight/autumn/wire_errors"
+	"github.com/journeymidnight/autumn/xlog"
+	"github.com/pkg/errors"
+)
+
+/*
+read path of extents converted to EC in background:
+fragment i of a converted extent is [i*FragmentSize, (i+1)*FragmentSize) of the original extent,
+so blocks are parsed from the logical bytes. if a data fragment is not available, the range is
+reconstructed from other fragments. a reader fetches a window of record.BlockSize at first, the
+window is doubled up to fragmentWindowSize if the reader reads sequentially, so reading a small
+block does not fetch a large range.
+*/
+
+const fragmentWindowSize = 4 << 20
+
+//fetchFunc reads [offset, offset+size) of fragment pos
+type fetchFunc func(ctx context.Context, pos int, offset, size uint64) ([]byte, error)
+
+//fragmentReader is an io.ReadSeeker of [0, SealedLength) of a converted extent
+type fragmentReader struct {
+	ctx    context.Context
+	exInfo *pb.ExtentInfo
+	fetch  fetchFunc
+	order  []int //positions of fragments to reconstruct from, the fastest first
+	//codec of extent, the codec of ECPolicy could be changed after the extent is converted
+	codec erasure_code.ErasureCodec
+
+	length      int64
+	pos         int64
+	window      []byte
+	windowStart int64
+	windowSize  int64 //size of the next window
+}
+
+func newFragmentReader(ctx context.Context, exInfo *pb.ExtentInfo, order []int, fetch fetchFunc) *fragmentReader {
+	return &fragmentReader{
+		ctx:    ctx,
+		exInfo: exInfo,
+		fetch:  fetch,
+		order:  order,
+		codec:  erasure_code.NewCodec(exInfo.Codec),
+		length: int64(exInfo.SealedLength),
+	}
+}
+
+func (r *fragmentReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += r.pos
+	case io.SeekEnd:
+		offset += r.length
+	default:
+		return 0, errors.Errorf("invalid whence %d", whence)
+	}
+	if offset < 0 {
+		return 0, errors.Errorf("negative position %d", offset)
+	}
+	r.pos = offset
+	return offset, nil
+}
+
+func (r *fragmentReader) Read(p []byte) (int, error) {
+	if r.pos >= r.length {
+		return 0, io.EOF
+	}
+	if r.pos < r.windowStart || r.pos >= r.windowStart+int64(len(r.window)) {
+		if err := r.load(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, r.window[r.pos-r.windowStart:])
+	r.pos += int64(n)
+	return n, nil
+}
+
+//load reads the window at r.pos, a window never crosses fragments
+func (r *fragmentReader) load() error {
+	if r.windowSize == 0 {
+		r.windowSize = record.BlockSize
+	} else if r.pos == r.windowStart+int64(len(r.window)) && r.windowSize < fragmentWindowSize {
+		r.windowSize *= 2
+	}
+	fragmentSize := int64(r.exInfo.FragmentSize)
+	idx := int(r.pos / fragmentSize)
+	offset := r.pos % fragmentSize
+	//windows are aligned like blocks of record reader
+	size := r.windowSize - r.pos%r.windowSize
+	if size > fragmentSize-offset {
+		size = fragmentSize - offset
+	}
+	if size > r.length-r.pos {
+		size = r.length - r.pos
+	}
+
+	data, err := r.fetch(r.ctx, idx, uint64(offset), uint64(size))
+	if err != nil {
+		xlog.Logger.Warnf("read fragment %d of extent %d: %v, reconstruct it", idx, r.exInfo.ExtentID, err)
+		if data, err = r.reconstruct(idx, uint64(offset), uint64(size)); err != nil {
+			return err
+		}
+	}
+	r.window = data
+	r.windowStart = r.pos
+	return nil
+}
+
+//reconstruct rebuilds [offset, offset+size) of data fragment idx from other fragments
+func (r *fragmentReader) reconstruct(idx int, offset, size uint64) ([]byte, error) {
+	dataShard := len(r.exInfo.Replicates)
+	parityShard := len(r.exInfo.Parity)
+	shards := make([][]byte, dataShard+parityShard)
+	present := make([]bool, len(shards))
+	tried := make([]bool, len(shards))
+	tried[idx] = true
+	var lastErr error
+	//read the local group first
+	sources := r.codec.RepairSources(idx, dataShard, parityShard)
+	for _, pos := range append(sources, r.order...) {
+		if tried[pos] || r.codec.CanDecode(present, dataShard, parityShard) {
+			continue
+		}
+		tried[pos] = true
+		data, err := r.fetch(r.ctx, pos, offset, size)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		shards[pos] = data
+		present[pos] = true
+	}
+	if !r.codec.CanDecode(present, dataShard, parityShard) {
+		return nil, errors.Errorf("extent %d: can not read enough fragments, %v", r.exInfo.ExtentID, lastErr)
+	}
+	if err := r.codec.ReconstructData(shards, dataShard, parityShard); err != nil {
+		return nil, err
+	}
+	return shards[idx], nil
+}
+
+//readFragmentBlocks has the same results as ReadBlocks of node
+func readFragmentBlocks(r *fragmentReader, offset uint32, numOfBlocks uint32, onlyReadLast bool) ([][]byte, []uint32, uint32, error) {
+	if int64(offset) >= r.length {
+		return nil, nil, 0, wire_errors.EndOfExtent
+	}
+
+	rr := record.GetReader(r)
+	defer record.PutReader(rr)
+	if err := rr.SeekRecord(int64(offset)); err != nil {
+		return nil, nil, 0, err
+	}
+
+	var ret [][]byte
+	var offsets []uint32
+	var end uint32
+	eof := false
+	maxSize := int64(32 << 20)
+	for i := uint32(0); onlyReadLast || i < numOfBlocks; i++ {
+		reader, err := rr.Next()
+		start := rr.Offset()
+		if err == io.EOF {
+			eof = true
+			break
+		}
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		data, err := record.ReadRecordTo(nil, reader)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		if onlyReadLast {
+			ret, offsets = ret[:0], offsets[:0]
+		} else if rr.End()-int64(offset) > maxSize && len(ret) > 0 {
+			return ret, offsets, uint32(start), nil
+		}
+		ret = append(ret, data)
+		offsets = append(offsets, uint32(start))
+		end = uint32(rr.End())
+	}
+	if onlyReadLast {
+		if len(ret) == 0 {
+			return nil, nil, 0, wire_errors.NotFound
+		}
+		return ret, offsets, end, nil
+	}
+	if eof {
+		return ret, offsets, end, wire_errors.EndOfExtent
+	}
+	return ret, offsets, end, nil
+}
+
+//fetchFragment reads a range of fragment from node by CopyExtent
+func (sc *AutumnStreamClient) fetchFragment(exInfo *pb.ExtentInfo) fetchFunc {
+	nodes := smclient.ShardNodes(exInfo)
+	return func(ctx context.Context, pos int, offset, size uint64) ([]byte, error) {
+		nodeInfo := sc.em.GetNodeInfo(nodes[pos])
+		if nodeInfo == nil {
+			return nil, errors.Errorf("node %d not exist", nodes[pos])
+		}
+		pool := conn.GetPools().Connect(nodeInfo.Address)
+		if pool == nil {
+			return nil, errors.Errorf("can not connected to %s", nodeInfo.Address)
+		}
+		stream, err := pb.NewExtentServiceClient(pool.Get()).CopyExtent(ctx, &pb.CopyExtentRequest{
+			ExtentID: exInfo.ExtentID,
+			Eversion: exInfo.Eversion,
+			Offset:   offset,
+			Size_:    size,
+		})
+		if err != nil {
+			return nil, err
+		}
+		res, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		header := res.GetHeader()
+		if header == nil {
+			return nil, errors.New("the first message of CopyExtent is not header")
+		}
+		if header.Code != pb.Code_OK {
+			return nil, wire_errors.FromPBCode(header.Code, header.CodeDes)
+		}
+		data := make([]byte, 0, size)
+		for {
+			res, err = stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+			data = append(data, res.GetPayload()...)
+		}
+		if uint64(len(data)) != size {
+			return nil, errors.Errorf("fragment %d of extent %d: got %d bytes, expected %d", pos, exInfo.ExtentID, len(data), size)
+		}
+		return data, nil
+	}
+}
+
+func (sc *AutumnStreamClient) fragmentRead(ctx context.Context, exInfo *pb.ExtentInfo, offset uint32, numOfBlocks uint32, onlyReadLast bool) ([][]byte, []uint32, uint32, error) {
+	positions := sc.em.SortedShards(exInfo)
+	if len(positions) == 0 {
+		return nil, nil, 0, errors.Errorf("extent %d: only %d fragments are available", exInfo.ExtentID, len(positions))
+	}
+	r := newFragmentReader(ctx, exInfo, positions, sc.fetchFragment(exInfo))
+	return readFragmentBlocks(r, offset, numOfBlocks, onlyReadLast)
+}
@@ -0,0 +1,161 @@
+package streamclient
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/journeymidnight/autumn/erasure_code"
+	"github.com/journeymidnight/autumn/extent/record"
+	"github.com/journeymidnight/autumn/proto/pb"
+	"github.com/journeymidnight/autumn/wire_errors"
+	"github.com/journeymidnight/autumn/xlog"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func init() {
+	xlog.InitLog([]string{"test.log"}, zap.DebugLevel)
+}
+
+func TestFragmentRead(t *testing.T) {
+	testFragmentRead(t, nil, 3, 2)
+	testFragmentRead(t, &pb.ErasureCodec{Type: pb.CodecType_LRC, LocalGroups: 2}, 4, 3)
+}
+
+func testFragmentRead(t *testing.T, codec *pb.ErasureCodec, dataShard, parityShard int) {
+	buf := new(bytes.Buffer)
+	w := record.NewLogWriter(buf, 0, 0)
+	var blocks []block
+	var offsets []uint32
+	for i := 0; i < 50; i++ {
+		b := newTestBlock(uint32(1000 + i*997))
+		start, _, err := w.WriteRecord(b)
+		require.Nil(t, err)
+		blocks = append(blocks, b)
+		offsets = append(offsets, uint32(start))
+	}
+	require.Nil(t, w.Close())
+
+	//split extent into fragments like ConvertExtent
+	length := uint64(buf.Len())
+	fragmentSize := (length + uint64(dataShard) - 1) / uint64(dataShard)
+	padded := make([]byte, fragmentSize*uint64(dataShard))
+	copy(padded, buf.Bytes())
+	fragments := make([][]byte, dataShard+parityShard)
+	data := make([]io.Reader, dataShard)
+	for i := range data {
+		fragments[i] = padded[uint64(i)*fragmentSize : uint64(i+1)*fragmentSize]
+		data[i] = bytes.NewReader(fragments[i])
+	}
+	parity := make([]io.Writer, parityShard)
+	parityBufs := make([]*bytes.Buffer, parityShard)
+	for i := range parity {
+		parityBufs[i] = new(bytes.Buffer)
+		parity[i] = parityBufs[i]
+	}
+	require.Nil(t, erasure_code.NewCodec(codec).EncodeStream(data, dataShard, parityShard, parity))
+	for i := range parityBufs {
+		fragments[dataShard+i] = parityBufs[i].Bytes()
+	}
+
+	exInfo := &pb.ExtentInfo{
+		ExtentID:     100,
+		Replicates:   make([]uint64, dataShard),
+		Parity:       make([]uint64, parityShard),
+		SealedLength: length,
+		FragmentSize: fragmentSize,
+		Codec:        codec,
+	}
+	//fragment 1 is lost
+	fetch := func(ctx context.Context, pos int, offset, size uint64) ([]byte, error) {
+		if pos == 1 {
+			return nil, errors.New("fragment is lost")
+		}
+		return append([]byte(nil), fragments[pos][offset:offset+size]...), nil
+	}
+	//parity fragments are the fastest
+	order := []int{0, 1, 2, 3, 4, 5, 6}[:dataShard+parityShard]
+	for i, j := 0, len(order)-1; i < j; i, j = i+1, j-1 {
+		order[i], order[j] = order[j], order[i]
+	}
+
+	var got []block
+	var gotOffsets []uint32
+	offset := uint32(0)
+	for {
+		r := newFragmentReader(context.Background(), exInfo, order, fetch)
+		ret, retOffsets, end, err := readFragmentBlocks(r, offset, 7, false)
+		got = append(got, ret...)
+		gotOffsets = append(gotOffsets, retOffsets...)
+		if err == wire_errors.EndOfExtent {
+			break
+		}
+		require.Nil(t, err)
+		offset = end
+	}
+	require.Equal(t, blocks, got)
+	require.Equal(t, offsets, gotOffsets)
+
+	r := newFragmentReader(context.Background(), exInfo, order, fetch)
+	last, lastOffsets, end, err := readFragmentBlocks(r, 0, 1, true)
+	require.Nil(t, err)
+	require.Equal(t, blocks[len(blocks)-1:], last)
+	require.Equal(t, offsets[len(offsets)-1:], lastOffsets)
+	require.Equal(t, uint32(length), end)
+}
+
+func TestFragmentReadRange(t *testing.T) {
+	buf := new(bytes.Buffer)
+	w := record.NewLogWriter(buf, 0, 0)
+	var blocks []block
+	var offsets []uint32
+	for i := 0; i < 100; i++ {
+		b := newTestBlock(20 << 10)
+		start, _, err := w.WriteRecord(b)
+		require.Nil(t, err)
+		blocks = append(blocks, b)
+		offsets = append(offsets, uint32(start))
+	}
+	require.Nil(t, w.Close())
+
+	dataShard := 2
+	length := uint64(buf.Len())
+	fragmentSize := (length + uint64(dataShard) - 1) / uint64(dataShard)
+	padded := make([]byte, fragmentSize*uint64(dataShard))
+	copy(padded, buf.Bytes())
+	exInfo := &pb.ExtentInfo{
+		ExtentID:     100,
+		Replicates:   make([]uint64, dataShard),
+		Parity:       make([]uint64, 1),
+		SealedLength: length,
+		FragmentSize: fragmentSize,
+	}
+	var fetched uint64
+	fetch := func(ctx context.Context, pos int, offset, size uint64) ([]byte, error) {
+		fetched += size
+		start := uint64(pos)*fragmentSize + offset
+		return append([]byte(nil), padded[start:start+size]...), nil
+	}
+
+	//a small block only fetches the record block it is in
+	r := newFragmentReader(context.Background(), exInfo, []int{0, 1, 2}, fetch)
+	ret, _, _, err := readFragmentBlocks(r, offsets[50], 1, false)
+	require.Nil(t, err)
+	require.Equal(t, blocks[50], ret[0])
+	require.True(t, fetched <= record.BlockSize, "fetched %d bytes", fetched)
+
+	//windows grow if blocks are read sequentially
+	fetched = 0
+	var loads int
+	r = newFragmentReader(context.Background(), exInfo, []int{0, 1, 2}, func(ctx context.Context, pos int, offset, size uint64) ([]byte, error) {
+		loads++
+		return fetch(ctx, pos, offset, size)
+	})
+	ret, _, _, err = readFragmentBlocks(r, 0, 100, false)
+	require.Nil(t, err)
+	require.Equal(t, blocks, ret)
+	require.True(t, loads < int(length/record.BlockSize), "%d loads", loads)
+}
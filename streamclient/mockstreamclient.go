@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"io"
 	"math/rand"
-	"os"
 
 	"github.com/journeymidnight/autumn/extent"
 	"github.com/journeymidnight/autumn/proto/pb"
@@ -152,7 +151,7 @@ func (client *MockStreamClient) Truncate(ctx context.Context, extentID uint64) e
 		ex := client.exs[exID]
 		ex.Close()
 		delete(client.exs, exID)
-		os.Remove(name)
+		extent.RemoveExtentFile(name)
 	}
 	client.stream = client.stream[i:]
 
@@ -211,6 +210,13 @@ func (client *MockStreamClient) Append(ctx context.Context, blocks []block, must
 	return uint64(exID), offsets, end, err
 }
 
+//AppendAsync appends blocks synchronously
+func (client *MockStreamClient) AppendAsync(ctx context.Context, blocks []block, mustSync bool) *AppendFuture {
+	f := newAppendFuture()
+	f.resolve(client.Append(ctx, blocks, mustSync))
+	return f
+}
+
 func (client *MockStreamClient) Close() {
 	for _, exID := range client.stream {
 		name := fileName(exID, client.suffix)
@@ -221,7 +227,7 @@ func (client *MockStreamClient) Close() {
 		ex.Close()
 		delete(client.exs, exID)
 		client.Unlock()
-		os.Remove(name)
+		extent.RemoveExtentFile(name)
 	}
 }
 
@@ -279,7 +285,7 @@ func (client *MockStreamClient) PunchHoles(ctx context.Context, extentIDs []uint
 			//exluce this extent and delete file
 			name := fileName(client.stream[i], client.suffix)
 			//fmt.Printf("delete hole %s\n", name)
-			os.Remove(name)
+			extent.RemoveExtentFile(name)
 			client.stream = append(client.stream[:i], client.stream[i+1:]...)
 
 		}
@@ -0,0 +1,212 @@
+package streamclient
+
+import (
+	"context"
+	"sync"
+
+	"github.com/journeymidnight/autumn/extent/record"
+	"github.com/journeymidnight/autumn/proto/pb"
+	"github.com/journeymidnight/autumn/utils"
+	"github.com/journeymidnight/autumn/xlog"
+	"github.com/pkg/errors"
+)
+
+/*
+AppendAsync sends blocks without waiting for previous appends, up to MaxInflightAppends windows
+could be in flight on the last extent. the commit offset of each window is the end of the
+previous window computed by record.ComputeEnd, extent nodes wait for previous windows if a
+window arrives early.
+
+ackLoop acknowledges windows in order. if a window fails, all windows after it are canceled,
+then they are appended again by Append in order, Append truncates the extent to sc.end which is
+the last acknowledged offset. if the extent is full or sealed, the pipeline is drained in the
+same way, and Append allocates a new extent
+*/
+
+const MaxInflightAppends = 4
+
+type AppendFuture struct {
+	done     chan struct{}
+	extentID uint64
+	offsets  []uint32
+	end      uint32
+	err      error
+}
+
+func newAppendFuture() *AppendFuture {
+	return &AppendFuture{done: make(chan struct{})}
+}
+
+func (f *AppendFuture) resolve(extentID uint64, offsets []uint32, end uint32, err error) {
+	f.extentID, f.offsets, f.end, f.err = extentID, offsets, end, err
+	close(f.done)
+}
+
+//Wait returns the same results as Append
+func (f *AppendFuture) Wait() (uint64, []uint32, uint32, error) {
+	<-f.done
+	return f.extentID, f.offsets, f.end, f.err
+}
+
+type appendWindow struct {
+	parent   context.Context
+	cancel   context.CancelFunc
+	blocks   []block
+	mustSync bool
+	sync     bool //appended by Append in ackLoop
+
+	exInfo  *pb.ExtentInfo
+	end     uint32        //expected end
+	sent    chan struct{} //closed when sendBlocks returns
+	offsets []uint32
+	err     error
+	future  *AppendFuture
+}
+
+type appendPipeline struct {
+	sync.Mutex //protect tail, sync and the order of queue
+	slots      chan struct{}
+	queue      chan *appendWindow
+	tail       uint32 //end of the last window in flight
+	sync       bool   //new windows are appended by ackLoop until the pipeline is drained
+	stopper    *utils.Stopper
+}
+
+func (sc *AutumnStreamClient) startPipeline() {
+	sc.pipeline = &appendPipeline{
+		slots:   make(chan struct{}, MaxInflightAppends),
+		queue:   make(chan *appendWindow, MaxInflightAppends),
+		tail:    sc.CommitEnd(),
+		stopper: utils.NewStopper(),
+	}
+	sc.pipeline.stopper.RunWorker(sc.ackLoop)
+}
+
+//AppendAsync appends blocks without waiting for previous appends, appends are done in the order
+//of calls. AppendAsync and Append can not be called concurrently
+func (sc *AutumnStreamClient) AppendAsync(ctx context.Context, blocks []block, mustSync bool) *AppendFuture {
+	sc.pipelineOnce.Do(sc.startPipeline)
+	p := sc.pipeline
+	p.slots <- struct{}{}
+
+	w := &appendWindow{
+		parent:   ctx,
+		blocks:   blocks,
+		mustSync: mustSync,
+		sent:     make(chan struct{}),
+		future:   newAppendFuture(),
+	}
+
+	p.Lock()
+	defer p.Unlock()
+	if !p.sync {
+		if err := sc.launch(w); err != nil {
+			xlog.Logger.Debugf("stream %d: drain pipeline, %v", sc.streamID, err)
+			p.sync = true
+		}
+	}
+	if p.sync {
+		w.sync = true
+		close(w.sent)
+	}
+	p.queue <- w
+	return w.future
+}
+
+//launch sends w after the last window, p must be locked
+func (sc *AutumnStreamClient) launch(w *appendWindow) error {
+	p := sc.pipeline
+	if p.tail > sc.maxExtentSize {
+		return errors.Errorf("extent is full")
+	}
+	extentID, err := sc.getLastExtent()
+	if err != nil {
+		return err
+	}
+	exInfo := sc.em.GetExtentInfo(extentID)
+	if exInfo == nil || exInfo.Avali > 0 {
+		return errors.Errorf("extent %d is sealed", extentID)
+	}
+	peers, err := sc.encodeBlocks(exInfo, w.blocks)
+	if err != nil {
+		return err
+	}
+
+	commit := p.tail
+	end := commit
+	//all peers have blocks of the same size
+	for _, b := range peers.data[0] {
+		end = record.ComputeEnd(end, uint32(len(b)))
+	}
+	w.exInfo = exInfo
+	w.end = end
+	p.tail = end
+
+	var ctx context.Context
+	ctx, w.cancel = context.WithCancel(w.parent)
+	go func() {
+		defer close(w.sent)
+		defer peers.decrRef()
+		var end uint32
+		w.offsets, end, w.err = sc.sendBlocks(ctx, exInfo, commit, peers, w.mustSync)
+		if w.err == nil && end != w.end {
+			w.err = errors.Errorf("extent %d: end is %d, expected %d", exInfo.ExtentID, end, w.end)
+		}
+	}()
+	return nil
+}
+
+func (sc *AutumnStreamClient) ackLoop() {
+	p := sc.pipeline
+	for {
+		select {
+		case <-p.stopper.ShouldStop():
+			return
+		case w := <-p.queue:
+			<-w.sent
+			if w.sync || w.err != nil {
+				sc.drainPipeline(w)
+				continue
+			}
+			w.cancel()
+			sc.setEnd(w.end)
+			w.future.resolve(w.exInfo.ExtentID, w.offsets, w.end, nil)
+			<-p.slots
+		}
+	}
+}
+
+//drainPipeline appends w and all windows after w by Append
+func (sc *AutumnStreamClient) drainPipeline(w *appendWindow) {
+	p := sc.pipeline
+	p.Lock()
+	defer p.Unlock()
+
+	windows := []*appendWindow{w}
+	for len(p.queue) > 0 {
+		windows = append(windows, <-p.queue)
+	}
+	for _, w := range windows {
+		if w.cancel != nil {
+			w.cancel()
+		}
+	}
+	for _, w := range windows {
+		<-w.sent
+	}
+	if w.err != nil {
+		xlog.Logger.Warnf("stream %d: pipelined append failed, retry from %d, %v", sc.streamID, sc.CommitEnd(), w.err)
+	}
+
+	if sc.CommitEnd() > sc.maxExtentSize {
+		if err := sc.MustAllocNewExtent(); err != nil {
+			xlog.Logger.Errorf(err.Error())
+		}
+	}
+	for _, w := range windows {
+		w.future.resolve(sc.Append(w.parent, w.blocks, w.mustSync))
+		<-p.slots
+	}
+	p.tail = sc.CommitEnd()
+	p.sync = false
+}
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"io"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/journeymidnight/autumn/conn"
@@ -53,6 +54,8 @@ type StreamClient interface {
 	//AppendEntries(ctx context.Context, entries []block, mustSync bool) (uint64, uint32, error)
 	ReadLastBlock(ctx context.Context) (block, error)
 	Append(ctx context.Context, blocks []block, mustSync bool) (extentID uint64, offsets []uint32, end uint32, err error)
+	//pipelined Append, results are in the order of calls
+	AppendAsync(ctx context.Context, blocks []block, mustSync bool) *AppendFuture
 	PunchHoles(ctx context.Context, extentIDs []uint64) error
 	NewLogEntryIter(opt ...ReadOption) LogEntryIter
 	//truncate extent BEFORE extentID
@@ -131,7 +134,12 @@ retry:
 		return nil, nil, 0, err
 	}
 
-	buf := new(bytes.Buffer)
+	sum := uint32(0)
+	for i := range header.BlockSizes {
+		sum += header.BlockSizes[i]
+	}
+	//blocks are returned to caller, so buf is not pooled, but it is allocated only once
+	buf := make([]byte, 0, sum)
 	for {
 		res, err := stream.Recv()
 		if err != nil && err != io.EOF {
@@ -141,27 +149,129 @@ retry:
 		if payload == nil {
 			break
 		}
-		buf.Write(payload)
+		buf = append(buf, payload...)
 	}
 
 	//verfiy all blocks have been received
-	sum := uint32(0)
-	for i := range header.BlockSizes {
-		sum += header.BlockSizes[i]
-	}
-	if sum != uint32(buf.Len()) {
+	if sum != uint32(len(buf)) {
 		return nil, nil, 0, errors.Errorf("read response payload length is not equal to header")
 	}
 	//each block point to slice of buf
 	blocks := make([][]byte, len(header.BlockSizes))
 	n := 0
 	for i := 0; i < len(blocks); i++ {
-		blocks[i] = buf.Bytes()[n : n+int(header.BlockSizes[i])]
+		blocks[i] = buf[n : n+int(header.BlockSizes[i]) : n+int(header.BlockSizes[i])]
 		n += int(header.BlockSizes[i])
 	}
 	return blocks, header.Offsets, header.End, err
 }
 
+//readShard reads from nodeID and records the latency
+func (sc *AutumnStreamClient) readShard(ctx context.Context, nodeID uint64, exInfo *pb.ExtentInfo, request *pb.ReadBlocksRequest) ([]block, []uint32, uint32, error) {
+	nodeInfo := sc.em.GetNodeInfo(nodeID)
+	if nodeInfo == nil {
+		return nil, nil, 0, errors.Errorf("node %d not exist", nodeID)
+	}
+	pool := conn.GetPools().Connect(nodeInfo.Address)
+	if pool == nil {
+		return nil, nil, 0, errors.Errorf("can not connected to %s", nodeInfo.Address)
+	}
+	start := time.Now()
+	blocks, offsets, end, err := sc.readBlockData(ctx, pool.Get(), exInfo, request)
+	switch {
+	case err == nil || err == wire_errors.EndOfExtent:
+		sc.em.RecordLatency(nodeID, time.Since(start), nil)
+	case ctx.Err() != nil:
+		//canceled by hedged read, the latency is at least time.Since(start)
+		sc.em.RecordLatency(nodeID, time.Since(start), nil)
+	default:
+		sc.em.RecordLatency(nodeID, time.Since(start), err)
+	}
+	return blocks, offsets, end, err
+}
+
+//hedge calls read(ctx, 0), if it does not return in delay or fails, read(ctx, 1) is called, and so on,
+//the timer is re-armed after each launch. it returns the index of the first successful read or the last
+//error, reads still running are canceled
+func hedge(ctx context.Context, n int, delay time.Duration, read func(ctx context.Context, i int) error) (int, error) {
+	hctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type result struct {
+		i   int
+		err error
+	}
+	results := make(chan result, n)
+	launched := 0
+	hedgeTimer := time.NewTimer(delay)
+	defer hedgeTimer.Stop()
+	launch := func() {
+		i := launched
+		launched++
+		go func() {
+			results <- result{i, read(hctx, i)}
+		}()
+		if !hedgeTimer.Stop() {
+			select {
+			case <-hedgeTimer.C:
+			default:
+			}
+		}
+		hedgeTimer.Reset(delay)
+	}
+
+	launch()
+	var lastErr error
+	for finished := 0; finished < launched; {
+		select {
+		case <-hedgeTimer.C:
+			if launched < n {
+				launch()
+			}
+		case r := <-results:
+			finished++
+			if r.err == nil {
+				return r.i, nil
+			}
+			lastErr = r.err
+			if launched < n {
+				launch()
+			}
+		}
+	}
+	return -1, lastErr
+}
+
+//hedgedRead reads from the fastest replicate, if it does not return in HedgeDelay or fails,
+//the request is sent to the next replicate. the first successful result is returned
+func (sc *AutumnStreamClient) hedgedRead(ctx context.Context, exInfo *pb.ExtentInfo, request *pb.ReadBlocksRequest) ([]block, []uint32, uint32, error) {
+	nodes := smclient.ShardNodes(exInfo)
+	positions := sc.em.SortedShards(exInfo)
+	if len(positions) == 0 {
+		return nil, nil, 0, errors.Errorf("unable to get extent connection.")
+	}
+
+	type result struct {
+		blocks  []block
+		offsets []uint32
+		end     uint32
+		err     error
+	}
+	results := make([]result, len(positions))
+	i, err := hedge(ctx, len(positions), sc.em.HedgeDelay(), func(ctx context.Context, i int) error {
+		blocks, offsets, end, err := sc.readShard(ctx, nodes[positions[i]], exInfo, request)
+		results[i] = result{blocks, offsets, end, err}
+		if err == wire_errors.EndOfExtent {
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	return results[i].blocks, results[i].offsets, results[i].end, results[i].err
+}
+
 //err could be [nil, error, wire_errors.EndOfExtent]
 func (sc *AutumnStreamClient) smartRead(gctx context.Context, extentID uint64, offset uint32, numOfBlocks uint32, onlyReadLast bool) ([][]byte, []uint32, uint32, error) {
 	if onlyReadLast {
@@ -176,13 +286,14 @@ func (sc *AutumnStreamClient) smartRead(gctx context.Context, extentID uint64, o
 		return nil, nil, 0, errors.Errorf("extent %d not exist", extentID)
 	}
 
+	//extent is converted to EC in background
+	if exInfo.FragmentSize > 0 {
+		return sc.fragmentRead(ctx, exInfo, offset, numOfBlocks, onlyReadLast)
+	}
+
 	//replicate read
 	if len(exInfo.Parity) == 0 {
-		conn := sc.em.GetExtentConn(exInfo.ExtentID, smclient.AlivePolicy{})
-		if conn == nil {
-			return nil, nil, 0, errors.Errorf("unable to get extent connection.")
-		}
-		return sc.readBlockData(ctx, conn, exInfo, &pb.ReadBlocksRequest{
+		return sc.hedgedRead(ctx, exInfo, &pb.ReadBlocksRequest{
 			ExtentID:      extentID,
 			Offset:        offset,
 			NumOfBlocks:   numOfBlocks,
@@ -195,6 +306,7 @@ func (sc *AutumnStreamClient) smartRead(gctx context.Context, extentID uint64, o
 	dataShards := len(exInfo.Replicates)
 	parityShards := len(exInfo.Parity)
 	n := dataShards + parityShards
+	codec := erasure_code.NewCodec(exInfo.Codec)
 
 	//if we read from n > dataShard, call cancel() to stop
 	pctx, cancel := context.WithTimeout(ctx, 5*time.Second)
@@ -203,6 +315,7 @@ func (sc *AutumnStreamClient) smartRead(gctx context.Context, extentID uint64, o
 
 	//channel
 	type Result struct {
+		Pos     int
 		Error   error
 		End     uint32
 		Len     int
@@ -220,14 +333,9 @@ func (sc *AutumnStreamClient) smartRead(gctx context.Context, extentID uint64, o
 		OnlyLastBlock: onlyReadLast,
 	}
 
-	submitReq := func(pool *conn.Pool, pos int) {
-		if pool == nil {
-			errChan <- Result{
-				Error: errors.New("can not get conn"),
-			}
-			return
-		}
-		blocks, offsets, end, err := sc.readBlockData(pctx, pool.Get(), exInfo, req)
+	nodes := smclient.ShardNodes(exInfo)
+	submitReq := func(pos int) {
+		blocks, offsets, end, err := sc.readShard(pctx, nodes[pos], exInfo, req)
 		if err != nil && err != wire_errors.EndOfExtent {
 			errChan <- Result{
 				Error: err,
@@ -237,6 +345,7 @@ func (sc *AutumnStreamClient) smartRead(gctx context.Context, extentID uint64, o
 		//successful read
 		dataBlocks[pos] = blocks
 		retChan <- Result{
+			Pos:     pos,
 			Error:   err,
 			End:     end,
 			Len:     len(blocks),
@@ -244,58 +353,58 @@ func (sc *AutumnStreamClient) smartRead(gctx context.Context, extentID uint64, o
 		}
 	}
 
-	//pools里面有可能有nil
-	pools, err := sc.em.ConnPool(sc.em.GetPeers(req.ExtentID))
-	if err != nil {
+	//read from the fastest dataShards shards, other shards are read after HedgeDelay
+	//or if any read fails
+	positions := sc.em.SortedShards(exInfo)
+	if len(positions) < dataShards {
 		cancel()
-		return nil, nil, 0, err
+		return nil, nil, 0, errors.Errorf("extent %d: only %d shards are available", extentID, len(positions))
 	}
-
-
-	//only read from avali data
-	//read data shards
-	for i := 0; i < dataShards; i++ {
-		if exInfo.Avali > 0 && (exInfo.Avali&(1<<i)) == 0 {
-			continue
-		}
+	launched := 0
+	launch := func() {
+		pos := positions[launched]
+		launched++
 		wg.Add(1)
-		go func(i int) {
+		go func() {
 			defer wg.Done()
-			submitReq(pools[i], i)
-		}(i)
+			submitReq(pos)
+		}()
 	}
-
-	//read parity data
-	for i := 0; i < parityShards; i++ {
-		if exInfo.Avali > 0 && exInfo.Avali & (1<<(i+dataShards)) == 0 {
-			continue
-		}
-		wg.Add(1)
-		go func(i int) {
-			defer wg.Done()
-			select {
-			case <-pctx.Done():
-				return
-			case <- time.After(20 * time.Millisecond):
-				submitReq(pools[i+dataShards], i+dataShards)
-			}
-		}(i)
+	for launched < dataShards {
+		launch()
 	}
+	hedge := time.NewTimer(sc.em.HedgeDelay())
+	defer hedge.Stop()
 
-	successRet := make([]Result, 0, dataShards)
+	successRet := make([]Result, 0, n)
+	failedRet := make([]Result, 0, n)
+	present := make([]bool, n)
 	var success bool
 waitResult:
-	for {
+	for len(successRet)+len(failedRet) < launched {
 		select {
 		case <-pctx.Done(): //time out
 			break waitResult
+		case <-hedge.C:
+			for launched < len(positions) {
+				launch()
+			}
+		case r := <-errChan:
+			failedRet = append(failedRet, r)
+			if launched < len(positions) {
+				launch()
+			}
 		case r := <-retChan:
 			successRet = append(successRet, r)
-			if len(successRet) == dataShards {
+			present[r.Pos] = true
+			if codec.CanDecode(present, dataShards, parityShards) {
 				success = true
 				break waitResult
 			}
-
+			//shards could not be decoded by codec, read more shards
+			if len(successRet)+len(failedRet) == launched && launched < len(positions) {
+				launch()
+			}
 		}
 	}
 
@@ -303,6 +412,9 @@ waitResult:
 	wg.Wait()
 	close(retChan)
 	close(errChan)
+	for r := range errChan {
+		failedRet = append(failedRet, r)
+	}
 
 	span.LogKV("READ BLOCKS DONE", 0)
 
@@ -312,7 +424,7 @@ waitResult:
 	var offsets []uint32
 	if success {
 		//collect successRet, END/ERROR should be the saved
-		for i := 0; i < dataShards-1; i++ {
+		for i := 0; i < len(successRet)-1; i++ {
 			//if err is EndExtent or EndStream, err must be the save
 			if successRet[i].End != successRet[i+1].End {
 				return nil, nil, 0, errors.Errorf("extent %d: wrong successRet, %+v != %+v ", exInfo.ExtentID, successRet[i], successRet[i+1])
@@ -323,9 +435,9 @@ waitResult:
 		finalErr = successRet[0].Error //Could be EndOfExtent or nil
 		offsets = successRet[0].Offsets
 	} else {
-		//collect errChan, if err is not wire_errors.NotFound
+		//collect failedRet, if err is not wire_errors.NotFound
 		errBuf := new(bytes.Buffer)
-		for r := range errChan {
+		for _, r := range failedRet {
 			if r.Error == wire_errors.NotFound {
 				return nil, nil, 0, r.Error
 			}
@@ -346,7 +458,7 @@ waitResult:
 				data[j] = dataBlocks[j][i]
 			}
 		}
-		output, err := erasure_code.ReedSolomon{}.Decode(data, dataShards, parityShards)
+		output, err := codec.Decode(data, dataShards, parityShards)
 		//EC decode error
 		if err != nil {
 			return nil, nil, 0, err
@@ -405,10 +517,13 @@ type AutumnStreamClient struct {
 	em            *smclient.ExtentManager
 	streamID      uint64
 	streamLock    StreamLock
-	end           uint32
+	end           uint32 //commit offset of the last extent, written by Append and ackLoop, use atomic
 	maxExtentSize uint32
 
 	utils.SafeMutex //protect streamInfo from allocStream, Truncate, PunchHole
+
+	pipeline     *appendPipeline //started by the first AppendAsync
+	pipelineOnce sync.Once
 }
 
 func NewStreamClient(sm *smclient.SMClient, em *smclient.ExtentManager, maxExtentSize uint32, streamID uint64, streamLock StreamLock) *AutumnStreamClient {
@@ -465,7 +580,7 @@ func (sc *AutumnStreamClient) checkCommitLength() {
 		cancel()
 		if err == nil {
 			sc.em.WaitVersion(lastEx.ExtentID, lastEx.Eversion)
-			sc.end = end
+			sc.setEnd(end)
 			break
 		}
 		xlog.Logger.Warnf(err.Error())
@@ -595,6 +710,10 @@ retry:
 		return nil
 	case wire_errors.EndOfExtent:
 		iter.currentOffset = 0
+		//extents before current extent could be punched while reading, find it again
+		if i := iter.sc.getExtentIndexFromID(extentID); i >= 0 {
+			iter.currentExtentIndex = i
+		}
 		iter.currentExtentIndex++
 		iter.n++
 		if iter.currentExtentIndex == len(iter.sc.streamInfo.ExtentIDs) || iter.n >= iter.opt.MaxExtentRead {
@@ -698,7 +817,11 @@ func (sc *AutumnStreamClient) getLastExtent() (uint64, error) {
 }
 
 func (sc *AutumnStreamClient) CommitEnd() uint32 {
-	return sc.end
+	return atomic.LoadUint32(&sc.end)
+}
+
+func (sc *AutumnStreamClient) setEnd(end uint32) {
+	atomic.StoreUint32(&sc.end, end)
 }
 
 //alloc new extent, and reset sc.end = 0
@@ -714,12 +837,12 @@ func (sc *AutumnStreamClient) MustAllocNewExtent() error {
 	if err != nil {
 		return err
 	}
-	fmt.Printf("Seal stream %d on extent %d: sealedLength is %d\n", sc.streamID, lastExID, sc.end)
+	fmt.Printf("Seal stream %d on extent %d: sealedLength is %d\n", sc.streamID, lastExID, sc.CommitEnd())
 
 	for {
 		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 		updatedStream, newExInfo, err = sc.smClient.StreamAllocExtent(ctx, sc.streamID,
-			sc.streamLock.ownerKey, sc.streamLock.revision, sc.end)
+			sc.streamLock.ownerKey, sc.streamLock.revision, sc.CommitEnd())
 		cancel()
 		if err == nil {
 			break
@@ -729,7 +852,7 @@ func (sc *AutumnStreamClient) MustAllocNewExtent() error {
 	}
 	sc.streamInfo = updatedStream
 	sc.em.WaitVersion(newExInfo.ExtentID, 1)
-	sc.end = 0
+	sc.setEnd(0)
 	fmt.Printf("created new extent %d on stream %d\n", newExInfo.ExtentID, sc.streamID)
 	xlog.Logger.Debugf("created new extent %d on stream %d", newExInfo.ExtentID, sc.streamID)
 	return nil
@@ -747,123 +870,188 @@ func (sc *AutumnStreamClient) Connect() error {
 	sc.checkCommitLength()
 
 	extentID := sc.streamInfo.ExtentIDs[len(sc.streamInfo.ExtentIDs)-1] //last extent
-	fmt.Printf("connected :stream %d, extent %d's end is %d\n", sc.streamID, extentID, sc.end)
+	fmt.Printf("connected :stream %d, extent %d's end is %d\n", sc.streamID, extentID, sc.CommitEnd())
 
 	return nil
 }
 
 func (sc *AutumnStreamClient) Close() {
+	if sc.pipeline != nil {
+		sc.pipeline.stopper.Stop()
+	}
+}
 
+//peerBlocks are blocks sent to each peer. in EC, shards of each block except full data shards
+//are in a pooled buffer shared by all peers, the last peer done puts it back
+type peerBlocks struct {
+	data [][]block
+	bufs []*utils.Buffer
+}
+
+func (p *peerBlocks) incrRef() {
+	for _, b := range p.bufs {
+		b.IncrRef()
+	}
+}
+
+func (p *peerBlocks) decrRef() {
+	for _, b := range p.bufs {
+		b.DecrRef()
+	}
+}
+
+//encodeBlocks returns blocks to send to each peer, blocks are striped in EC.
+//caller holds a reference of returned peerBlocks
+func (sc *AutumnStreamClient) encodeBlocks(exInfo *pb.ExtentInfo, blocks []block) (*peerBlocks, error) {
+	n := len(exInfo.Replicates) + len(exInfo.Parity)
+	p := &peerBlocks{
+		data: make([][]block, n),
+	}
+	//replicates share the same blocks
+	if len(exInfo.Parity) == 0 {
+		for i := 0; i < n; i++ {
+			p.data[i] = blocks
+		}
+		return p, nil
+	}
+
+	//EC, prepare data
+	dataShard := len(exInfo.Replicates)
+	parityShard := len(exInfo.Parity)
+	codec := erasure_code.NewCodec(exInfo.Codec)
+	for j := 0; j < n; j++ {
+		p.data[j] = make([]block, 0, len(blocks))
+	}
+	p.bufs = make([]*utils.Buffer, 0, len(blocks))
+	for i := range blocks {
+		striped, buf, err := codec.EncodeBuffer(blocks[i], dataShard, parityShard)
+		if err != nil {
+			p.decrRef()
+			return nil, err
+		}
+		p.bufs = append(p.bufs, buf)
+		for j := 0; j < len(striped); j++ {
+			p.data[j] = append(p.data[j], striped[j])
+		}
+	}
+	return p, nil
 }
 
 //appendBlocks is a helper function for Append
 func (sc *AutumnStreamClient) appendBlocks(ctx context.Context, exInfo *pb.ExtentInfo, blocks []block, mustSync bool) ([]uint32, uint32, error) {
+	peers, err := sc.encodeBlocks(exInfo, blocks)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer peers.decrRef()
+	return sc.sendBlocks(ctx, exInfo, sc.CommitEnd(), peers, mustSync)
+}
+
+type appendResult struct {
+	Error   error
+	Offsets []uint32
+	End     uint32
+}
 
-	pools, err := sc.em.ConnPool(sc.em.GetPeers(exInfo.ExtentID))
+//appendOnPeer sends header and blocks by Append, or by ChainAppend if header.Chain is not empty
+func appendOnPeer(ctx context.Context, pool *conn.Pool, header *pb.AppendRequestHeader, blocks []block) appendResult {
+	client := pb.NewExtentServiceClient(pool.Get())
+
+	var stream pb.ExtentService_AppendClient
+	var err error
+	if len(header.Chain) > 0 {
+		stream, err = client.ChainAppend(ctx)
+	} else {
+		stream, err = client.Append(ctx)
+	}
 	if err != nil {
-		return nil, 0, errors.Errorf("extent %d's append can not get pool[%s]", exInfo.ExtentID, err.Error())
+		return appendResult{Error: err}
+	}
+
+	//send header
+	header.Blocks = make([]uint32, len(blocks))
+	for j := range blocks {
+		header.Blocks[j] = uint32(len(blocks[j]))
 	}
+	if err = stream.Send(&pb.AppendRequest{
+		Data: &pb.AppendRequest_Header{
+			Header: header,
+		}}); err != nil {
+		return appendResult{Error: err}
+	}
+
+	//send data
+	//grpc payload could be as large as 32MB
+	for j := range blocks {
+		if err = stream.Send(&pb.AppendRequest{
+			Data: &pb.AppendRequest_Payload{
+				Payload: blocks[j],
+			},
+		}); err != nil {
+			return appendResult{Error: err}
+		}
+	}
+
+	res, err := stream.CloseAndRecv()
+	xlog.Logger.Debugf("append remote done [%s], %v", pool.Addr, err)
+	if err != nil {
+		return appendResult{Error: err}
+	}
+	if res.Code != pb.Code_OK {
+		err = wire_errors.FromPBCode(res.Code, res.CodeDes)
+	}
+	return appendResult{Error: err, Offsets: res.Offsets, End: res.End}
+}
 
-	//choose offset
-	offset := sc.end
+//sendBlocks appends peers.data[i] on the ith peer at offset commit
+func (sc *AutumnStreamClient) sendBlocks(ctx context.Context, exInfo *pb.ExtentInfo, commit uint32, peers *peerBlocks, mustSync bool) ([]uint32, uint32, error) {
+
+	addrs := sc.em.GetPeers(exInfo.ExtentID)
+	pools, err := sc.em.ConnPool(addrs)
+	if err != nil {
+		return nil, 0, errors.Errorf("extent %d's append can not get pool[%s]", exInfo.ExtentID, err.Error())
+	}
 
 	pctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
 	n := len(pools)
-
-	dataBlocks := make([][]block, n)
-	//erasure code
-	if len(exInfo.Parity) > 0 {
-		//EC, prepare data
-		dataShard := len(exInfo.Replicates)
-		parityShard := len(exInfo.Parity)
-
-		for i := range blocks {
-			striped, err := erasure_code.ReedSolomon{}.Encode(blocks[i], dataShard, parityShard)
-			if err != nil {
-				return nil, 0, err
-			}
-			for j := 0; j < len(striped); j++ {
-				dataBlocks[j] = append(dataBlocks[j], striped[j])
-			}
-
+	dataBlocks := peers.data
+	utils.AssertTrue(n == len(dataBlocks))
+
+	newHeader := func() *pb.AppendRequestHeader {
+		return &pb.AppendRequestHeader{
+			ExtentID: exInfo.ExtentID,
+			Commit:   commit,
+			Revision: sc.streamLock.revision,
+			MustSync: mustSync,
+			Eversion: exInfo.Eversion,
 		}
-		//replicate code
-	} else {
-		for i := 0; i < len(dataBlocks); i++ {
-			dataBlocks[i] = blocks
+	}
+
+	//chain mode, the first peer forwards blocks to others, all replicates have the same blocks
+	if sc.replication() == pb.ReplicationMode_Chain && len(exInfo.Parity) == 0 && n > 1 {
+		header := newHeader()
+		header.Chain = addrs[1:]
+		result := appendOnPeer(pctx, pools[0], header, dataBlocks[0])
+		if result.Error != nil {
+			xlog.Logger.Warnf("chain append on extent %d error: %v", exInfo.ExtentID, result.Error)
+			return nil, 0, result.Error
 		}
+		return result.Offsets, result.End, nil
 	}
 
 	var wg sync.WaitGroup
-	type Result struct {
-		Error   error
-		Offsets []uint32
-		End     uint32
-	}
-	retChan := make(chan Result, n)
+	retChan := make(chan appendResult, n)
 
 	//leaderless append mode, send Append to all peers
 	for i := 0; i < n; i++ {
 		wg.Add(1)
+		peers.incrRef()
 		go func(i int) {
 			defer wg.Done()
-			conn := pools[i].Get()
-			client := pb.NewExtentServiceClient(conn)
-
-			stream, err := client.Append(pctx)
-			if err != nil {
-				retChan <- Result{err, nil, 0}
-				return
-			}
-
-			//send header
-			sizeOfBlocks := make([]uint32, len(dataBlocks[i]))
-			for j := range sizeOfBlocks {
-				sizeOfBlocks[j] = uint32(len(dataBlocks[i][j]))
-			}
-			if err = stream.Send(&pb.AppendRequest{
-				Data: &pb.AppendRequest_Header{
-					Header: &pb.AppendRequestHeader{
-						ExtentID: exInfo.ExtentID,
-						Commit:   offset,
-						Revision: sc.streamLock.revision,
-						MustSync: mustSync,
-						Blocks:   sizeOfBlocks,
-						Eversion: exInfo.Eversion,
-					}}}); err != nil {
-				retChan <- Result{err, nil, 0}
-				return
-			}
-
-			//send data
-			//grpc payload could be as large as 32MB
-			for j := range dataBlocks[i] {
-				if err = stream.Send(&pb.AppendRequest{
-					Data: &pb.AppendRequest_Payload{
-						Payload: dataBlocks[i][j],
-					},
-				}); err != nil {
-					break
-				}
-			}
-			if err != nil {
-				retChan <- Result{err, nil, 0}
-				return
-			}
-
-			res, err := stream.CloseAndRecv()
-
-			if err == nil {
-				if res.Code != pb.Code_OK {
-					err = wire_errors.FromPBCode(res.Code, res.CodeDes)
-				}
-				retChan <- Result{Error: err, Offsets: res.Offsets, End: res.End}
-			} else {
-				retChan <- Result{Error: err}
-			}
-			xlog.Logger.Debugf("append remote done [%s], %v", pools[i].Addr, err)
+			defer peers.decrRef()
+			retChan <- appendOnPeer(pctx, pools[i], newHeader(), dataBlocks[i])
 		}(i)
 	}
 
@@ -940,7 +1128,7 @@ retry:
 		goto retry
 	}
 
-	sc.end = end
+	sc.setEnd(end)
 	//检查offset结果, 如果已经超过MaxExtentSize, 调用StreamAllocExtent
 	utils.AssertTrue(end > 0)
 
@@ -953,6 +1141,13 @@ retry:
 	return extentID, offsets, end, nil
 }
 
+//replication is the mode of stream, it never changes
+func (sc *AutumnStreamClient) replication() pb.ReplicationMode {
+	sc.RLock()
+	defer sc.RUnlock()
+	return sc.streamInfo.Replication
+}
+
 func (sc *AutumnStreamClient) StreamInfo() *pb.StreamInfo {
 	//copy sc.streamInfo
 	sc.RLock()
@@ -0,0 +1,45 @@
+package streamclient
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHedge(t *testing.T) {
+	//the first two reads hang, the timer is re-armed so the third one is launched
+	var calls int32
+	start := time.Now()
+	i, err := hedge(context.Background(), 3, 10*time.Millisecond, func(ctx context.Context, i int) error {
+		atomic.AddInt32(&calls, 1)
+		if i < 2 {
+			<-ctx.Done()
+			return ctx.Err()
+		}
+		return nil
+	})
+	require.Nil(t, err)
+	require.Equal(t, 2, i)
+	require.Equal(t, int32(3), atomic.LoadInt32(&calls))
+	require.True(t, time.Since(start) >= 20*time.Millisecond)
+
+	//failed reads launch the next one immediately
+	i, err = hedge(context.Background(), 3, time.Hour, func(ctx context.Context, i int) error {
+		if i < 2 {
+			return errors.New("read failed")
+		}
+		return nil
+	})
+	require.Nil(t, err)
+	require.Equal(t, 2, i)
+
+	//all failed
+	_, err = hedge(context.Background(), 2, time.Hour, func(ctx context.Context, i int) error {
+		return errors.Errorf("read %d failed", i)
+	})
+	require.NotNil(t, err)
+}
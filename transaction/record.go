@@ -0,0 +1,122 @@
+package transaction
+
+import (
+	"time"
+
+	"github.com/journeymidnight/autumn/etcd_utils"
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/journeymidnight/autumn/utils"
+	"github.com/pkg/errors"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+/*
+TXN/{txnID} => {TxnRecord}
+
+1. coordinator creates a PENDING record
+2. coordinator writes intents on every partition(TxnPrepare)
+3. coordinator changes the record to COMMITTED, this is the commit point
+4. intents are resolved(TxnResolve) and the record is removed
+
+if the coordinator crashes, anyone can abort a PENDING record after its deadline.
+a missing record means the txn is aborted, because the record is removed only after
+all intents are resolved
+*/
+
+const (
+	EtcdKeyPrefix = "TXN/"
+)
+
+func FormatTxnKey(txnID string) string {
+	return EtcdKeyPrefix + txnID
+}
+
+//Create saves a new record, fails if the record exists
+func Create(c *clientv3.Client, record *pspb.TxnRecord) error {
+	key := FormatTxnKey(record.TxnID)
+	return etcd_utils.EtcdSetKVS(c, []clientv3.Cmp{
+		clientv3.Compare(clientv3.CreateRevision(key), "=", 0),
+	}, []clientv3.Op{
+		clientv3.OpPut(key, string(utils.MustMarshal(record))),
+	})
+}
+
+func get(c *clientv3.Client, txnID string) (*pspb.TxnRecord, []byte, error) {
+	data, _, err := etcd_utils.EtcdGetKV(c, FormatTxnKey(txnID))
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(data) == 0 {
+		return nil, nil, nil
+	}
+	var record pspb.TxnRecord
+	if err = record.Unmarshal(data); err != nil {
+		return nil, nil, err
+	}
+	return &record, data, nil
+}
+
+//Decide changes a PENDING record to status, returns the final status of txn
+func Decide(c *clientv3.Client, txnID string, status pspb.TxnStatus) (pspb.TxnStatus, error) {
+	key := FormatTxnKey(txnID)
+	for i := 0; i < 3; i++ {
+		record, data, err := get(c, txnID)
+		if err != nil {
+			return pspb.TxnStatus_PENDING, err
+		}
+		if record == nil {
+			return pspb.TxnStatus_ABORTED, nil
+		}
+		if record.Status != pspb.TxnStatus_PENDING {
+			return record.Status, nil
+		}
+		record.Status = status
+		err = etcd_utils.EtcdSetKVS(c, []clientv3.Cmp{
+			clientv3.Compare(clientv3.Value(key), "=", string(data)),
+		}, []clientv3.Op{
+			clientv3.OpPut(key, string(utils.MustMarshal(record))),
+		})
+		if err == nil {
+			return status, nil
+		}
+	}
+	return pspb.TxnStatus_PENDING, errors.Errorf("update %s conflicts", key)
+}
+
+//GetStatus returns status of txn, PENDING txn after deadline is aborted
+func GetStatus(c *clientv3.Client, txnID string) (pspb.TxnStatus, error) {
+	record, _, err := get(c, txnID)
+	if err != nil {
+		return pspb.TxnStatus_PENDING, err
+	}
+	if record == nil {
+		return pspb.TxnStatus_ABORTED, nil
+	}
+	if record.Status == pspb.TxnStatus_PENDING && time.Now().UnixNano() > record.Deadline {
+		return Decide(c, txnID, pspb.TxnStatus_ABORTED)
+	}
+	return record.Status, nil
+}
+
+func Delete(c *clientv3.Client, txnID string) error {
+	return etcd_utils.EtcdSetKVS(c, nil, []clientv3.Op{
+		clientv3.OpDelete(FormatTxnKey(txnID)),
+	})
+}
+
+//List returns all records
+func List(c *clientv3.Client) ([]*pspb.TxnRecord, error) {
+	kvs, _, err := etcd_utils.EtcdRange(c, EtcdKeyPrefix)
+	if err != nil {
+		return nil, err
+	}
+	records := make([]*pspb.TxnRecord, 0, len(kvs))
+	for _, kv := range kvs {
+		var record pspb.TxnRecord
+		if err = record.Unmarshal(kv.Value); err != nil {
+			continue
+		}
+		records = append(records, &record)
+	}
+	return records, nil
+}
@@ -0,0 +1,70 @@
+package utils
+
+import (
+	"math/bits"
+	"sync"
+	"sync/atomic"
+)
+
+/*
+Buffer is a size-classed buffer from pools, used for blocks on append and read paths.
+a Buffer could be shared by several goroutines(e.g. all replicates of an append), each of them
+holds a reference, the last DecrRef puts it back to pool. no one should use Buffer.B after
+releasing its reference
+*/
+
+const (
+	minBufferShift = 12 //4KB
+	maxBufferShift = 26 //64MB, larger buffers are not pooled
+)
+
+var bufferPools [maxBufferShift - minBufferShift + 1]sync.Pool
+
+type Buffer struct {
+	B     []byte
+	ref   int32
+	class int //index of bufferPools, -1 if not pooled
+}
+
+func bufferClass(size int) int {
+	if size <= 1<<minBufferShift {
+		return 0
+	}
+	shift := bits.Len(uint(size - 1))
+	if shift > maxBufferShift {
+		return -1
+	}
+	return shift - minBufferShift
+}
+
+//GetBuffer returns a buffer whose len(B) is size, the reference is 1
+func GetBuffer(size int) *Buffer {
+	class := bufferClass(size)
+	if class < 0 {
+		return &Buffer{B: make([]byte, size), ref: 1, class: -1}
+	}
+	if v := bufferPools[class].Get(); v != nil {
+		b := v.(*Buffer)
+		b.B = b.B[:size]
+		b.ref = 1
+		return b
+	}
+	return &Buffer{
+		B:     make([]byte, size, 1<<(class+minBufferShift)),
+		ref:   1,
+		class: class,
+	}
+}
+
+func (b *Buffer) IncrRef() {
+	atomic.AddInt32(&b.ref, 1)
+}
+
+func (b *Buffer) DecrRef() {
+	n := atomic.AddInt32(&b.ref, -1)
+	AssertTruef(n >= 0, "buffer is released twice")
+	if n > 0 || b.class < 0 {
+		return
+	}
+	bufferPools[b.class].Put(b)
+}
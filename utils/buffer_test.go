@@ -0,0 +1,31 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBufferClass(t *testing.T) {
+	require.Equal(t, 0, bufferClass(0))
+	require.Equal(t, 0, bufferClass(4096))
+	require.Equal(t, 1, bufferClass(4097))
+	require.Equal(t, 1, bufferClass(8192))
+	require.Equal(t, maxBufferShift-minBufferShift, bufferClass(64<<20))
+	require.Equal(t, -1, bufferClass(64<<20+1))
+}
+
+func TestBufferRef(t *testing.T) {
+	b := GetBuffer(5000)
+	require.Equal(t, 5000, len(b.B))
+	require.Equal(t, 8192, cap(b.B))
+
+	b.IncrRef()
+	b.DecrRef()
+	b.DecrRef()
+	require.Panics(t, func() { b.DecrRef() })
+
+	big := GetBuffer(64<<20 + 1)
+	require.Equal(t, -1, big.class)
+	big.DecrRef()
+}